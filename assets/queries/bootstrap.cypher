@@ -27,6 +27,7 @@ ON CREATE SET i.created_at = datetime();
 UNWIND [
   'Attachment',
   'Comment',
+  'Component',
   'Document',
   'Folder',
   'Installation',
@@ -36,7 +37,9 @@ UNWIND [
   'Organization',
   'Permission',
   'Project',
+  'Release',
   'Role',
+  'Sprint',
   'Team',
   'Todo',
   'User'
@@ -105,6 +108,19 @@ CREATE CONSTRAINT project_id_unique IF NOT EXISTS FOR (n:Project) REQUIRE n.id I
 // Issue
 CREATE TEXT INDEX issue_id_idx IF NOT EXISTS FOR (n:Issue) ON (n.id);
 CREATE CONSTRAINT issue_id_unique IF NOT EXISTS FOR (n:Issue) REQUIRE n.id IS UNIQUE;
+CREATE INDEX issue_rank_idx IF NOT EXISTS FOR (n:Issue) ON (n.rank);
+
+// Component
+CREATE TEXT INDEX component_id_idx IF NOT EXISTS FOR (n:Component) ON (n.id);
+CREATE CONSTRAINT component_id_unique IF NOT EXISTS FOR (n:Component) REQUIRE n.id IS UNIQUE;
+
+// Release
+CREATE TEXT INDEX release_id_idx IF NOT EXISTS FOR (n:Release) ON (n.id);
+CREATE CONSTRAINT release_id_unique IF NOT EXISTS FOR (n:Release) REQUIRE n.id IS UNIQUE;
+
+// Sprint
+CREATE TEXT INDEX sprint_id_idx IF NOT EXISTS FOR (n:Sprint) ON (n.id);
+CREATE CONSTRAINT sprint_id_unique IF NOT EXISTS FOR (n:Sprint) REQUIRE n.id IS UNIQUE;
 
 // Document / Folder
 CREATE TEXT INDEX document_id_idx IF NOT EXISTS FOR (n:Document) ON (n.id);
@@ -122,3 +138,68 @@ CREATE TEXT INDEX label_name_idx IF NOT EXISTS FOR (n:Label) ON (n.name);
 CREATE CONSTRAINT label_id_unique IF NOT EXISTS FOR (n:Label) REQUIRE n.id IS UNIQUE;
 CREATE TEXT INDEX todo_id_idx IF NOT EXISTS FOR (n:Todo) ON (n.id);
 CREATE CONSTRAINT todo_id_unique IF NOT EXISTS FOR (n:Todo) REQUIRE n.id IS UNIQUE;
+
+// ============================================================================
+// Label actions
+//
+// The label.manage and label.attach actions were added to the role templates
+// after organizations had copied them. The roles copied from the templates,
+// and the grants copied from them to the creators of namespaces, projects,
+// issues, documents and folders, get the label actions of their template. The
+// grants are told by the template actions they hold. The Installation records
+// the upgrade, so it runs once and the label actions later removed from a
+// role or grant are not added back.
+// ============================================================================
+MATCH (installation:Installation {id: '00000000000000000000'})
+WHERE NOT 'label-actions' IN coalesce(installation.upgrades, [])
+SET installation.upgrades = coalesce(installation.upgrades, []) + 'label-actions'
+WITH installation
+UNWIND [
+  {key: 'org-admin', scopes: [], held: [], actions: ['label.manage', 'label.attach']},
+  {key: 'namespace-admin', scopes: ['Namespace'], held: ['namespace.update', 'namespace.delete'], actions: ['label.manage', 'label.attach']},
+  {key: 'project-maintainer', scopes: ['Project'], held: ['project.update', 'project.members.manage'], actions: ['label.manage', 'label.attach']},
+  {key: 'issue-maintainer', scopes: ['Issue'], held: ['issue.update', 'issue.assign'], actions: ['label.attach']},
+  {key: 'document-maintainer', scopes: ['Document', 'Folder'], held: ['document.update', 'document.delete'], actions: ['label.attach']}
+] AS template
+CALL (template) {
+  MATCH (:Organization)-[:DEFINES_ROLE]->(r:Role {key: template.key})
+  SET r.actions = coalesce(r.actions, []) + [a IN template.actions WHERE NOT a IN coalesce(r.actions, [])]
+}
+CALL (template) {
+  MATCH ()-[g:GRANTED]->(scope)
+  WHERE coalesce(g.role_id, '') = ''
+    AND any(l IN labels(scope) WHERE l IN template.scopes)
+    AND all(a IN template.held WHERE a IN coalesce(g.actions, []))
+  SET g.actions = coalesce(g.actions, []) + [a IN template.actions WHERE NOT a IN coalesce(g.actions, [])]
+}
+RETURN count(template) AS upgraded;
+
+// ============================================================================
+// Webhook actions
+//
+// The webhook.manage action was added to the role templates after
+// organizations had copied them. As for the label actions, the roles copied
+// from the templates and the grants copied from them to the creators of
+// namespaces and projects get it once.
+// ============================================================================
+MATCH (installation:Installation {id: '00000000000000000000'})
+WHERE NOT 'webhook-actions' IN coalesce(installation.upgrades, [])
+SET installation.upgrades = coalesce(installation.upgrades, []) + 'webhook-actions'
+WITH installation
+UNWIND [
+  {key: 'org-admin', scopes: [], held: [], actions: ['webhook.manage']},
+  {key: 'namespace-admin', scopes: ['Namespace'], held: ['namespace.update', 'namespace.delete'], actions: ['webhook.manage']},
+  {key: 'project-maintainer', scopes: ['Project'], held: ['project.update', 'project.members.manage'], actions: ['webhook.manage']}
+] AS template
+CALL (template) {
+  MATCH (:Organization)-[:DEFINES_ROLE]->(r:Role {key: template.key})
+  SET r.actions = coalesce(r.actions, []) + [a IN template.actions WHERE NOT a IN coalesce(r.actions, [])]
+}
+CALL (template) {
+  MATCH ()-[g:GRANTED]->(scope)
+  WHERE coalesce(g.role_id, '') = ''
+    AND any(l IN labels(scope) WHERE l IN template.scopes)
+    AND all(a IN template.held WHERE a IN coalesce(g.actions, []))
+  SET g.actions = coalesce(g.actions, []) + [a IN template.actions WHERE NOT a IN coalesce(g.actions, [])]
+}
+RETURN count(template) AS upgraded;
@@ -12,7 +12,27 @@ CREATE TABLE IF NOT EXISTS notifications (
   recipient VARCHAR(35) NOT NULL,
   read BOOLEAN NOT NULL,
   created_at TIMESTAMP NOT NULL,
-  updated_at TIMESTAMP
+  updated_at TIMESTAMP,
+  event VARCHAR(64) NOT NULL DEFAULT '',
+  actor VARCHAR(35),
+  resource VARCHAR(35),
+  changed_fields TEXT[] NOT NULL DEFAULT '{}'
+);
+
+-- Structured notification payload columns for installations created before
+-- notifications carried the triggering event.
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS event VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS actor VARCHAR(35);
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS resource VARCHAR(35);
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS changed_fields TEXT[] NOT NULL DEFAULT '{}';
+
+-- Mentions table, the users notified of being mentioned in an issue, document
+-- or comment
+CREATE TABLE IF NOT EXISTS mentions (
+  source VARCHAR(35) NOT NULL,
+  recipient VARCHAR(35) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (source, recipient)
 );
 
 -- User tokens table
@@ -29,3 +49,142 @@ CREATE TABLE IF NOT EXISTS user_tokens (
 CREATE INDEX IF NOT EXISTS user_tokens_sent_to_index ON user_tokens USING btree (sent_to);
 CREATE INDEX IF NOT EXISTS user_tokens_context_index ON user_tokens USING btree (context);
 CREATE UNIQUE INDEX IF NOT EXISTS user_tokens_sent_to_context_idx ON user_tokens (sent_to, context);
+
+-- Webhooks table
+CREATE TABLE IF NOT EXISTS webhooks (
+  id VARCHAR(35) PRIMARY KEY,
+  scope VARCHAR(35) NOT NULL,
+  url TEXT NOT NULL CONSTRAINT webhooks_url_length CHECK (LENGTH (url)<=2048),
+  secret VARCHAR(128) NOT NULL,
+  events TEXT[] NOT NULL DEFAULT '{}',
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+-- Webhook deliveries table
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id VARCHAR(35) PRIMARY KEY,
+  webhook_id VARCHAR(35) NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+  event VARCHAR(64) NOT NULL,
+  payload TEXT NOT NULL,
+  status CHARACTER VARYING(9) CHECK (status IN ('pending', 'succeeded', 'failed')) NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  response_status INTEGER,
+  error TEXT,
+  created_at TIMESTAMP NOT NULL,
+  delivered_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_scope_index ON webhooks USING btree (scope);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_index ON webhook_deliveries USING btree (webhook_id);
+
+-- Issue activities table
+CREATE TABLE IF NOT EXISTS issue_activities (
+  id VARCHAR(35) PRIMARY KEY,
+  issue_id VARCHAR(35) NOT NULL,
+  kind CHARACTER VARYING(16) CHECK (kind IN ('field_changed', 'relation_added', 'relation_updated', 'relation_removed', 'comment_added', 'comment_updated', 'comment_deleted')) NOT NULL,
+  field VARCHAR(64),
+  old_value TEXT[] NOT NULL DEFAULT '{}',
+  new_value TEXT[] NOT NULL DEFAULT '{}',
+  subject VARCHAR(35),
+  actor VARCHAR(35),
+  created_at TIMESTAMP NOT NULL
+);
+
+-- Custom field changes are recorded as "custom_fields.<key>", keys being up
+-- to 32 characters long.
+ALTER TABLE issue_activities ALTER COLUMN field TYPE VARCHAR(64);
+
+CREATE INDEX IF NOT EXISTS issue_activities_issue_id_index ON issue_activities USING btree (issue_id);
+
+-- Project workflows table
+CREATE TABLE IF NOT EXISTS project_workflows (
+  project_id VARCHAR(35) PRIMARY KEY,
+  statuses JSONB NOT NULL DEFAULT '[]',
+  transitions JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+-- Project custom fields table
+CREATE TABLE IF NOT EXISTS project_custom_fields (
+  project_id VARCHAR(35) PRIMARY KEY,
+  fields JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+-- Project WIP limits table
+CREATE TABLE IF NOT EXISTS project_wip_limits (
+  project_id VARCHAR(35) PRIMARY KEY,
+  limits JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+-- Sprint scope changes table
+CREATE TABLE IF NOT EXISTS sprint_scope_changes (
+  id VARCHAR(35) PRIMARY KEY,
+  sprint_id VARCHAR(35) NOT NULL,
+  issue_id VARCHAR(35) NOT NULL,
+  kind CHARACTER VARYING(7) CHECK (kind IN ('added', 'removed')) NOT NULL,
+  actor VARCHAR(35),
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS sprint_scope_changes_sprint_id_index ON sprint_scope_changes USING btree (sprint_id);
+
+-- Work logs table
+CREATE TABLE IF NOT EXISTS work_logs (
+  id VARCHAR(35) PRIMARY KEY,
+  issue_id VARCHAR(35) NOT NULL,
+  user_id VARCHAR(35) NOT NULL,
+  duration INTEGER CHECK (duration > 0) NOT NULL,
+  date DATE NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS work_logs_issue_id_index ON work_logs USING btree (issue_id);
+CREATE INDEX IF NOT EXISTS work_logs_user_id_date_index ON work_logs USING btree (user_id, date);
+
+-- Issue templates table
+CREATE TABLE IF NOT EXISTS issue_templates (
+  id VARCHAR(35) PRIMARY KEY,
+  project_id VARCHAR(35) NOT NULL,
+  name VARCHAR(120) NOT NULL,
+  kind VARCHAR(5) NOT NULL,
+  title_pattern VARCHAR(120) NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  priority VARCHAR(7) NOT NULL DEFAULT '',
+  labels TEXT[] NOT NULL DEFAULT '{}',
+  children JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS issue_templates_project_id_index ON issue_templates USING btree (project_id);
+
+-- Issue recurrences table
+CREATE TABLE IF NOT EXISTS issue_recurrences (
+  template_id VARCHAR(35) PRIMARY KEY REFERENCES issue_templates (id) ON DELETE CASCADE,
+  rule VARCHAR(500) NOT NULL,
+  starts_at TIMESTAMP NOT NULL,
+  creator_id VARCHAR(35) NOT NULL,
+  next_occurrence_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP
+);
+
+-- Issue recurrence occurrences table
+CREATE TABLE IF NOT EXISTS issue_recurrence_occurrences (
+  template_id VARCHAR(35) NOT NULL REFERENCES issue_templates (id) ON DELETE CASCADE,
+  occurs_at TIMESTAMP NOT NULL,
+  issue_id VARCHAR(35),
+  created_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (template_id, occurs_at)
+);
+
+CREATE INDEX IF NOT EXISTS issue_recurrences_next_occurrence_at_index ON issue_recurrences USING btree (next_occurrence_at);
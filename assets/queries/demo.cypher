@@ -493,6 +493,24 @@ MERGE (l:Label {id: 'd9tcjmf92rs8isainmj0'})
 MERGE (l:Label {id: 'd9tcjmf92rs8isainmjg'})
   ON CREATE SET l += { name: 'security', description: 'Auth, secrets, and access control.', created_at: datetime() };
 
+// Labels are scoped to ACME, so every ACME project can use them.
+MATCH (o:Organization {id: '9bsv0s4vl6gg02sv7jrg'})
+UNWIND [
+  {label_id: 'd9tcjmf92rs8isainjkg', rel_id: 'd9tcjmf92rs8isait50'},
+  {label_id: 'd9tcjmf92rs8isainjl0', rel_id: 'd9tcjmf92rs8isait60'},
+  {label_id: 'd9tcjmf92rs8isainjlg', rel_id: 'd9tcjmf92rs8isait70'},
+  {label_id: 'd9tcjmf92rs8isainmgg', rel_id: 'd9tcjmf92rs8isait80'},
+  {label_id: 'd9tcjmf92rs8isainmh0', rel_id: 'd9tcjmf92rs8isait90'},
+  {label_id: 'd9tcjmf92rs8isainmhg', rel_id: 'd9tcjmf92rs8isaita0'},
+  {label_id: 'd9tcjmf92rs8isainmi0', rel_id: 'd9tcjmf92rs8isaitb0'},
+  {label_id: 'd9tcjmf92rs8isainmig', rel_id: 'd9tcjmf92rs8isaitc0'},
+  {label_id: 'd9tcjmf92rs8isainmj0', rel_id: 'd9tcjmf92rs8isaitd0'},
+  {label_id: 'd9tcjmf92rs8isainmjg', rel_id: 'd9tcjmf92rs8isaite0'}
+] AS spec
+MATCH (l:Label {id: spec.label_id})
+MERGE (l)-[s:IN_SCOPE_OF {id: spec.rel_id}]->(o)
+  ON CREATE SET s.created_at = datetime();
+
 // ============================================================================
 // 7. Documents and folders
 // ============================================================================
@@ -1629,6 +1647,7 @@ UNWIND [
         'project.create', 'project.read', 'project.update', 'project.delete', 'project.members.manage',
         'issue.create', 'issue.read', 'issue.update', 'issue.delete', 'issue.assign',
         'document.create', 'document.read', 'document.update', 'document.delete', 'folder.create',
+        'label.manage', 'label.attach', 'webhook.manage',
         'role.manage', 'team.manage', 'permission.manage'
       ]},
       {id: 'd9tcjmf92rs8isainr10', key: 'org-member', name: 'Organization member', description: 'Read the organization they belong to.', actions: ['organization.read']},
@@ -1637,22 +1656,24 @@ UNWIND [
         'project.create', 'project.read', 'project.update', 'project.delete', 'project.members.manage',
         'issue.create', 'issue.read', 'issue.update', 'issue.delete', 'issue.assign',
         'document.create', 'document.read', 'document.update', 'document.delete', 'folder.create',
+        'label.manage', 'label.attach', 'webhook.manage',
         'team.manage', 'permission.manage'
       ]},
       {id: 'd9tcjmf92rs8isainr30', key: 'project-maintainer', name: 'Project maintainer', description: 'Maintain a project and its issues and documents.', actions: [
         'project.read', 'project.update', 'project.delete', 'project.members.manage',
         'issue.create', 'issue.read', 'issue.update', 'issue.delete', 'issue.assign',
         'document.create', 'document.read', 'document.update', 'document.delete', 'folder.create',
+        'label.manage', 'label.attach', 'webhook.manage',
         'team.manage', 'permission.manage'
       ]},
       {id: 'd9tcjmf92rs8isainr40', key: 'project-viewer', name: 'Project viewer', description: 'Read a project and its issues and documents.', actions: [
         'project.read', 'issue.read', 'document.read'
       ]},
       {id: 'd9tcjmf92rs8isainr50', key: 'issue-maintainer', name: 'Issue maintainer', description: 'Update and assign an issue.', actions: [
-        'issue.read', 'issue.update', 'issue.delete', 'issue.assign'
+        'issue.read', 'issue.update', 'issue.delete', 'issue.assign', 'label.attach'
       ]},
       {id: 'd9tcjmf92rs8isainr60', key: 'document-maintainer', name: 'Document maintainer', description: 'Update a document or folder.', actions: [
-        'document.read', 'document.update', 'document.delete', 'folder.create'
+        'document.read', 'document.update', 'document.delete', 'folder.create', 'label.attach'
       ]}
     ]
   },
@@ -1665,6 +1686,7 @@ UNWIND [
         'project.create', 'project.read', 'project.update', 'project.delete', 'project.members.manage',
         'issue.create', 'issue.read', 'issue.update', 'issue.delete', 'issue.assign',
         'document.create', 'document.read', 'document.update', 'document.delete', 'folder.create',
+        'label.manage', 'label.attach', 'webhook.manage',
         'role.manage', 'team.manage', 'permission.manage'
       ]},
       {id: 'd9tcjmf92rs8isainr90', key: 'org-member', name: 'Organization member', description: 'Read the organization they belong to.', actions: ['organization.read']},
@@ -1673,22 +1695,24 @@ UNWIND [
         'project.create', 'project.read', 'project.update', 'project.delete', 'project.members.manage',
         'issue.create', 'issue.read', 'issue.update', 'issue.delete', 'issue.assign',
         'document.create', 'document.read', 'document.update', 'document.delete', 'folder.create',
+        'label.manage', 'label.attach', 'webhook.manage',
         'team.manage', 'permission.manage'
       ]},
       {id: 'd9tcjmf92rs8isainrb0', key: 'project-maintainer', name: 'Project maintainer', description: 'Maintain a project and its issues and documents.', actions: [
         'project.read', 'project.update', 'project.delete', 'project.members.manage',
         'issue.create', 'issue.read', 'issue.update', 'issue.delete', 'issue.assign',
         'document.create', 'document.read', 'document.update', 'document.delete', 'folder.create',
+        'label.manage', 'label.attach', 'webhook.manage',
         'team.manage', 'permission.manage'
       ]},
       {id: 'd9tcjmf92rs8isainrc0', key: 'project-viewer', name: 'Project viewer', description: 'Read a project and its issues and documents.', actions: [
         'project.read', 'issue.read', 'document.read'
       ]},
       {id: 'd9tcjmf92rs8isainrd0', key: 'issue-maintainer', name: 'Issue maintainer', description: 'Update and assign an issue.', actions: [
-        'issue.read', 'issue.update', 'issue.delete', 'issue.assign'
+        'issue.read', 'issue.update', 'issue.delete', 'issue.assign', 'label.attach'
       ]},
       {id: 'd9tcjmf92rs8isainre0', key: 'document-maintainer', name: 'Document maintainer', description: 'Update a document or folder.', actions: [
-        'document.read', 'document.update', 'document.delete', 'folder.create'
+        'document.read', 'document.update', 'document.delete', 'folder.create', 'label.attach'
       ]}
     ]
   }
@@ -34,7 +34,7 @@ func searchReindexOptions() service.SearchReindexOptions {
 	return opts
 }
 
-func initSearchService() (*repository.Neo4jDatabase, service.SearchService, error) {
+func initSearchService(opts ...service.Option) (*repository.Neo4jDatabase, service.SearchService, error) {
 	graphDB, err := initGraphDatabase()
 	if err != nil {
 		return nil, nil, err
@@ -65,9 +65,11 @@ func initSearchService() (*repository.Neo4jDatabase, service.SearchService, erro
 
 	searchService, err := service.NewSearchService(
 		searchRepo,
-		service.WithPermissionService(permissionService),
-		service.WithLogger(logger.Named("search_service")),
-		service.WithTracer(tracer),
+		append([]service.Option{
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("search_service")),
+			service.WithTracer(tracer),
+		}, opts...)...,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -355,6 +355,94 @@ var startServerCmd = &cobra.Command{
 			}
 		}
 
+		var componentRepo repository.ComponentRepository
+		{
+			repo, err := repository.NewNeo4jComponentRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("component_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize component repository", slog.Any("error", err))
+			}
+
+			componentRepo, err = repository.NewCachedComponentRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_component_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached component repository", slog.Any("error", err))
+			}
+		}
+
+		var viewRepo repository.ViewRepository
+		{
+			repo, err := repository.NewNeo4jViewRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("view_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize view repository", slog.Any("error", err))
+			}
+
+			viewRepo, err = repository.NewCachedViewRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_view_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached view repository", slog.Any("error", err))
+			}
+		}
+
+		var releaseRepo repository.ReleaseRepository
+		{
+			repo, err := repository.NewNeo4jReleaseRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("release_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize release repository", slog.Any("error", err))
+			}
+
+			releaseRepo, err = repository.NewCachedReleaseRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_release_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached release repository", slog.Any("error", err))
+			}
+		}
+
+		var sprintRepo repository.SprintRepository
+		{
+			repo, err := repository.NewNeo4jSprintRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("sprint_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize sprint repository", slog.Any("error", err))
+			}
+
+			sprintRepo, err = repository.NewCachedSprintRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_sprint_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached sprint repository", slog.Any("error", err))
+			}
+		}
+
 		var documentRepo repository.DocumentRepository
 		{
 			repo, err := repository.NewNeo4jDocumentRepository(
@@ -399,6 +487,50 @@ var startServerCmd = &cobra.Command{
 			}
 		}
 
+		var commentRepo repository.CommentRepository
+		{
+			repo, err := repository.NewNeo4jCommentRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("comment_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize comment repository", slog.Any("error", err))
+			}
+
+			commentRepo, err = repository.NewCachedCommentRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_comment_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached comment repository", slog.Any("error", err))
+			}
+		}
+
+		var attachmentRepo repository.AttachmentRepository
+		{
+			repo, err := repository.NewNeo4jAttachmentRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("attachment_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize attachment repository", slog.Any("error", err))
+			}
+
+			attachmentRepo, err = repository.NewCachedAttachmentRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_attachment_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached attachment repository", slog.Any("error", err))
+			}
+		}
+
 		var notificationRepo repository.NotificationRepository
 		{
 			repo, err := repository.NewNotificationRepository(
@@ -421,6 +553,96 @@ var startServerCmd = &cobra.Command{
 			}
 		}
 
+		webhookRepo, err := repository.NewWebhookRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("webhook_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize webhook repository", slog.Any("error", err))
+		}
+
+		issueActivityRepo, err := repository.NewIssueActivityRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("issue_activity_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue activity repository", slog.Any("error", err))
+		}
+
+		sprintScopeChangeRepo, err := repository.NewSprintScopeChangeRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("sprint_scope_change_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize sprint scope change repository", slog.Any("error", err))
+		}
+
+		workLogRepo, err := repository.NewWorkLogRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("work_log_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize work log repository", slog.Any("error", err))
+		}
+
+		issueTemplateRepo, err := repository.NewIssueTemplateRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("issue_template_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue template repository", slog.Any("error", err))
+		}
+
+		issueRecurrenceRepo, err := repository.NewIssueRecurrenceRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("issue_recurrence_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue recurrence repository", slog.Any("error", err))
+		}
+
+		mentionRepo, err := repository.NewMentionRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("mention_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize mention repository", slog.Any("error", err))
+		}
+
+		workflowRepo, err := repository.NewWorkflowRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("workflow_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize workflow repository", slog.Any("error", err))
+		}
+
+		customFieldRepo, err := repository.NewCustomFieldRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("custom_field_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize custom field repository", slog.Any("error", err))
+		}
+
+		wipLimitRepo, err := repository.NewWIPLimitRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("wip_limit_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize WIP limit repository", slog.Any("error", err))
+		}
+
 		var staticFileRepo repository.StaticFileRepository
 		{
 			repo, err := repository.NewStaticFileRepository(
@@ -435,8 +657,18 @@ var startServerCmd = &cobra.Command{
 			staticFileRepo = repo
 		}
 
+		eventRepo, err := repository.NewRedisEventRepository(
+			repository.WithEventDatabase(cacheDB),
+			repository.WithEventRepositoryLogger(logger.Named("event_repository")),
+			repository.WithEventRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize event repository", slog.Any("error", err))
+		}
+
 		notificationService, err := service.NewNotificationService(
 			notificationRepo,
+			service.WithEventRepository(eventRepo),
 			service.WithLogger(logger.Named("notification_service")),
 			service.WithTracer(tracer),
 		)
@@ -595,6 +827,23 @@ var startServerCmd = &cobra.Command{
 			service.WithLogger(logger.Named("issue_service")),
 			service.WithTracer(tracer),
 			service.WithSearchService(searchService),
+			service.WithNotificationTaskEnqueuer(messageQueue),
+			service.WithUserRepository(userRepo),
+			service.WithNotificationService(notificationService),
+			service.WithMentionRepository(mentionRepo),
+			service.WithEventRepository(eventRepo),
+			service.WithWebhookRepository(webhookRepo),
+			service.WithWebhookTaskEnqueuer(messageQueue),
+			service.WithIssueActivityRepository(issueActivityRepo),
+			service.WithWorkflowRepository(workflowRepo),
+			service.WithCustomFieldRepository(customFieldRepo),
+			service.WithComponentRepository(componentRepo),
+			service.WithSprintScopeChangeRepository(sprintScopeChangeRepo),
+			service.WithIssueTemplateRepository(issueTemplateRepo),
+			service.WithIssueRecurrenceRepository(issueRecurrenceRepo),
+			service.WithDocumentRepository(documentRepo),
+			service.WithWIPLimitRepository(wipLimitRepo),
+			service.WithViewRepository(viewRepo),
 		)
 		if err != nil {
 			logger.Fatal(context.Background(), "failed to initialize issue service", slog.Any("error", err))
@@ -608,11 +857,147 @@ var startServerCmd = &cobra.Command{
 			service.WithLogger(logger.Named("document_service")),
 			service.WithTracer(tracer),
 			service.WithSearchService(searchService),
+			service.WithUserRepository(userRepo),
+			service.WithNotificationService(notificationService),
+			service.WithMentionRepository(mentionRepo),
+			service.WithEventRepository(eventRepo),
+			service.WithWebhookRepository(webhookRepo),
+			service.WithWebhookTaskEnqueuer(messageQueue),
 		)
 		if err != nil {
 			logger.Fatal(context.Background(), "failed to initialize document service", slog.Any("error", err))
 		}
 
+		eventService, err := service.NewEventService(
+			service.WithEventRepository(eventRepo),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("event_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize event service", slog.Any("error", err))
+		}
+
+		webhookService, err := service.NewWebhookService(
+			service.WithWebhookRepository(webhookRepo),
+			service.WithWebhookTaskEnqueuer(messageQueue),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("webhook_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize webhook service", slog.Any("error", err))
+		}
+
+		workflowService, err := service.NewWorkflowService(
+			service.WithWorkflowRepository(workflowRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("workflow_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize workflow service", slog.Any("error", err))
+		}
+
+		customFieldService, err := service.NewCustomFieldService(
+			service.WithCustomFieldRepository(customFieldRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("custom_field_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize custom field service", slog.Any("error", err))
+		}
+
+		componentService, err := service.NewComponentService(
+			service.WithComponentRepository(componentRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("component_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize component service", slog.Any("error", err))
+		}
+
+		releaseService, err := service.NewReleaseService(
+			service.WithReleaseRepository(releaseRepo),
+			service.WithIssueRepository(issueRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("release_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize release service", slog.Any("error", err))
+		}
+
+		sprintService, err := service.NewSprintService(
+			service.WithSprintRepository(sprintRepo),
+			service.WithSprintScopeChangeRepository(sprintScopeChangeRepo),
+			service.WithIssueRepository(issueRepo),
+			service.WithIssueActivityRepository(issueActivityRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("sprint_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize sprint service", slog.Any("error", err))
+		}
+
+		workLogService, err := service.NewWorkLogService(
+			service.WithWorkLogRepository(workLogRepo),
+			service.WithIssueRepository(issueRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("work_log_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize work log service", slog.Any("error", err))
+		}
+
+		issueTemplateService, err := service.NewIssueTemplateService(
+			service.WithIssueTemplateRepository(issueTemplateRepo),
+			service.WithIssueRecurrenceRepository(issueRecurrenceRepo),
+			service.WithLabelRepository(labelRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("issue_template_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue template service", slog.Any("error", err))
+		}
+
+		viewService, err := service.NewViewService(
+			service.WithViewRepository(viewRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("view_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize view service", slog.Any("error", err))
+		}
+
+		boardService, err := service.NewBoardService(
+			service.WithIssueRepository(issueRepo),
+			service.WithWorkflowRepository(workflowRepo),
+			service.WithWIPLimitRepository(wipLimitRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("board_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize board service", slog.Any("error", err))
+		}
+
 		folderService, err := service.NewFolderService(
 			service.WithFolderRepository(folderRepo),
 			service.WithPermissionService(permissionService),
@@ -625,6 +1010,8 @@ var startServerCmd = &cobra.Command{
 
 		labelService, err := service.NewLabelService(
 			service.WithLabelRepository(labelRepo),
+			service.WithLicenseService(licenseService),
+			service.WithPermissionService(permissionService),
 			service.WithLogger(logger.Named("label_service")),
 			service.WithTracer(tracer),
 		)
@@ -632,6 +1019,35 @@ var startServerCmd = &cobra.Command{
 			logger.Fatal(context.Background(), "failed to initialize label service", slog.Any("error", err))
 		}
 
+		commentService, err := service.NewCommentService(
+			service.WithCommentRepository(commentRepo),
+			service.WithIssueRepository(issueRepo),
+			service.WithPermissionService(permissionService),
+			service.WithLicenseService(licenseService),
+			service.WithNotificationTaskEnqueuer(messageQueue),
+			service.WithUserRepository(userRepo),
+			service.WithNotificationService(notificationService),
+			service.WithMentionRepository(mentionRepo),
+			service.WithIssueActivityRepository(issueActivityRepo),
+			service.WithLogger(logger.Named("comment_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize comment service", slog.Any("error", err))
+		}
+
+		attachmentService, err := service.NewAttachmentService(
+			service.WithAttachmentRepository(attachmentRepo),
+			service.WithStaticFileService(staticFileService),
+			service.WithPermissionService(permissionService),
+			service.WithLicenseService(licenseService),
+			service.WithLogger(logger.Named("attachment_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize attachment service", slog.Any("error", err))
+		}
+
 		organizationService, err := service.NewOrganizationService(
 			service.WithOrganizationRepository(organizationRepo),
 			service.WithUserRepository(userRepo),
@@ -664,6 +1080,8 @@ var startServerCmd = &cobra.Command{
 			elemoHttp.WithDocumentService(documentService),
 			elemoHttp.WithFolderService(folderService),
 			elemoHttp.WithLabelService(labelService),
+			elemoHttp.WithCommentService(commentService),
+			elemoHttp.WithAttachmentService(attachmentService),
 			elemoHttp.WithRoleService(roleService),
 			elemoHttp.WithTeamService(teamService),
 			elemoHttp.WithUserService(userService),
@@ -673,6 +1091,17 @@ var startServerCmd = &cobra.Command{
 			elemoHttp.WithLicenseService(licenseService),
 			elemoHttp.WithPermissionService(permissionService),
 			elemoHttp.WithNotificationService(notificationService),
+			elemoHttp.WithEventService(eventService),
+			elemoHttp.WithWebhookService(webhookService),
+			elemoHttp.WithWorkflowService(workflowService),
+			elemoHttp.WithCustomFieldService(customFieldService),
+			elemoHttp.WithComponentService(componentService),
+			elemoHttp.WithIssueTemplateService(issueTemplateService),
+			elemoHttp.WithViewService(viewService),
+			elemoHttp.WithBoardService(boardService),
+			elemoHttp.WithReleaseService(releaseService),
+			elemoHttp.WithSprintService(sprintService),
+			elemoHttp.WithWorkLogService(workLogService),
 			elemoHttp.WithSearchService(searchService),
 			elemoHttp.WithLogger(logger.Named("http_server")),
 			elemoHttp.WithTracer(tracer),
@@ -686,8 +1115,14 @@ var startServerCmd = &cobra.Command{
 			logger.Fatal(context.Background(), "failed to initialize system license expiry task", slog.Any("error", err))
 		}
 
+		issueRecurrenceTask, err := queue.NewIssueRecurrenceTask()
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue recurrence task", slog.Any("error", err))
+		}
+
 		taskScheduler, err := queue.NewScheduler(
 			queue.WithSchedulerTask("@every 1m", systemLicenseExpiryTask),
+			queue.WithSchedulerTask("@every 1m", issueRecurrenceTask),
 			queue.WithSchedulerConfig(&cfg.Worker),
 			queue.WithSchedulerLogger(logger.Named("task_scheduler")),
 			queue.WithSchedulerTracer(tracer),
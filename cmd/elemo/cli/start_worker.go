@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
 	"github.com/opcotech/elemo/internal/service"
 	"github.com/opcotech/elemo/internal/transport/async"
 )
@@ -24,7 +25,8 @@ configured port.`,
 	Run: func(_ *cobra.Command, _ []string) {
 		initTracer("worker")
 
-		if _, err := parseLicense(&cfg.License); err != nil {
+		license, err := parseLicense(&cfg.License)
+		if err != nil {
 			logger.Fatal(context.Background(), "failed to parse license", slog.Any("error", err))
 		}
 
@@ -62,11 +64,6 @@ configured port.`,
 			logger.Fatal(context.Background(), "failed to initialize system license expiry task handler", slog.Any("error", err))
 		}
 
-		graphDB, searchService, err := initSearchService()
-		if err != nil {
-			logger.Fatal(context.Background(), "failed to initialize search service", slog.Any("error", err))
-		}
-
 		messageQueue, err := queue.NewClient(
 			queue.WithClientConfig(&cfg.Worker),
 			queue.WithClientLogger(logger.Named("message_queue")),
@@ -76,6 +73,11 @@ configured port.`,
 			logger.Fatal(context.Background(), "failed to initialize message queue", slog.Any("error", err))
 		}
 
+		graphDB, searchService, err := initSearchService(service.WithSearchTaskEnqueuer(messageQueue))
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize search service", slog.Any("error", err))
+		}
+
 		searchIndexHandler, err := async.NewSearchIndexTaskHandler(
 			async.WithTaskSearchService(searchService),
 			async.WithTaskGraphDatabase(graphDB),
@@ -109,13 +111,349 @@ configured port.`,
 			logger.Fatal(context.Background(), "failed to initialize search reindex batch task handler", slog.Any("error", err))
 		}
 
+		cacheDB, err := initCacheDatabase()
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize cache database", slog.Any("error", err))
+		}
+
+		relDB, _, err := initRelationalDatabase()
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize relational database", slog.Any("error", err))
+		}
+
+		var issueRepo repository.IssueRepository
+		{
+			repo, err := repository.NewNeo4jIssueRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("issue_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize issue repository", slog.Any("error", err))
+			}
+
+			issueRepo, err = repository.NewCachedIssueRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_issue_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached issue repository", slog.Any("error", err))
+			}
+		}
+
+		var notificationRepo repository.NotificationRepository
+		{
+			repo, err := repository.NewNotificationRepository(
+				repository.WithPGDatabase(relDB),
+				repository.WithPGRepositoryLogger(logger.Named("notification_repository")),
+				repository.WithPGRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize notification repository", slog.Any("error", err))
+			}
+
+			notificationRepo, err = repository.NewCachedNotificationRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_notification_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached notification repository", slog.Any("error", err))
+			}
+		}
+
+		eventRepo, err := repository.NewRedisEventRepository(
+			repository.WithEventDatabase(cacheDB),
+			repository.WithEventRepositoryLogger(logger.Named("event_repository")),
+			repository.WithEventRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize event repository", slog.Any("error", err))
+		}
+
+		notificationService, err := service.NewNotificationService(
+			notificationRepo,
+			service.WithEventRepository(eventRepo),
+			service.WithLogger(logger.Named("notification_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize notification service", slog.Any("error", err))
+		}
+
 		async.SetRateLimiter(cfg.Worker.RateLimit, cfg.Worker.RateLimitBurst)
+		webhookRepo, err := repository.NewWebhookRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("webhook_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize webhook repository", slog.Any("error", err))
+		}
+
+		webhookDeliveryHandler, err := async.NewWebhookDeliveryTaskHandler(
+			async.WithTaskWebhookRepository(webhookRepo),
+			async.WithTaskLogger(logger.Named("webhook_delivery_task")),
+			async.WithTaskTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize webhook delivery task handler", slog.Any("error", err))
+		}
+
+		licenseRepo, err := repository.NewNeo4jLicenseRepository(
+			repository.WithNeo4jDatabase(graphDB),
+			repository.WithNeo4jRepositoryLogger(logger.Named("license_repository")),
+			repository.WithNeo4jRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize license repository", slog.Any("error", err))
+		}
+
+		var permissionRepo repository.PermissionRepository
+		{
+			repo, err := repository.NewNeo4jPermissionRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("permission_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize permission repository", slog.Any("error", err))
+			}
+
+			permissionRepo, err = repository.NewCachedPermissionRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_permission_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached permission repository", slog.Any("error", err))
+			}
+		}
+
+		var roleRepo repository.RoleRepository
+		{
+			repo, err := repository.NewNeo4jRoleRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("role_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize role repository", slog.Any("error", err))
+			}
+
+			roleRepo, err = repository.NewCachedRoleRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_role_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached role repository", slog.Any("error", err))
+			}
+		}
+
+		var userRepo repository.UserRepository
+		{
+			repo, err := repository.NewNeo4jUserRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("user_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize user repository", slog.Any("error", err))
+			}
+
+			userRepo, err = repository.NewCachedUserRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_user_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached user repository", slog.Any("error", err))
+			}
+		}
+
+		var assignmentRepo repository.AssignmentRepository
+		{
+			repo, err := repository.NewNeo4jAssignmentRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("assignment_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize assignment repository", slog.Any("error", err))
+			}
+
+			assignmentRepo, err = repository.NewCachedAssignmentRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_assignment_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached assignment repository", slog.Any("error", err))
+			}
+		}
+
+		var labelRepo repository.LabelRepository
+		{
+			repo, err := repository.NewNeo4jLabelRepository(
+				repository.WithNeo4jDatabase(graphDB),
+				repository.WithNeo4jRepositoryLogger(logger.Named("label_repository")),
+				repository.WithNeo4jRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize label repository", slog.Any("error", err))
+			}
+
+			labelRepo, err = repository.NewCachedLabelRepository(
+				repo,
+				repository.WithRedisDatabase(cacheDB),
+				repository.WithRedisRepositoryLogger(logger.Named("cached_label_repository")),
+				repository.WithRedisRepositoryTracer(tracer),
+			)
+			if err != nil {
+				logger.Fatal(context.Background(), "failed to initialize cached label repository", slog.Any("error", err))
+			}
+		}
+
+		issueActivityRepo, err := repository.NewIssueActivityRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("issue_activity_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue activity repository", slog.Any("error", err))
+		}
+
+		issueTemplateRepo, err := repository.NewIssueTemplateRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("issue_template_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue template repository", slog.Any("error", err))
+		}
+
+		issueRecurrenceRepo, err := repository.NewIssueRecurrenceRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("issue_recurrence_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue recurrence repository", slog.Any("error", err))
+		}
+
+		mentionRepo, err := repository.NewMentionRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("mention_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize mention repository", slog.Any("error", err))
+		}
+
+		workflowRepo, err := repository.NewWorkflowRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("workflow_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize workflow repository", slog.Any("error", err))
+		}
+
+		customFieldRepo, err := repository.NewCustomFieldRepository(
+			repository.WithPGDatabase(relDB),
+			repository.WithPGRepositoryLogger(logger.Named("custom_field_repository")),
+			repository.WithPGRepositoryTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize custom field repository", slog.Any("error", err))
+		}
+
+		permissionService, err := service.NewPermissionService(
+			permissionRepo,
+			service.WithRoleRepository(roleRepo),
+			service.WithLogger(logger.Named("permission_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize permission service", slog.Any("error", err))
+		}
+
+		issueNotificationHandler, err := async.NewIssueNotificationTaskHandler(
+			async.WithTaskIssueRepository(issueRepo),
+			async.WithTaskNotificationService(notificationService),
+			async.WithTaskPermissionService(permissionService),
+			async.WithTaskLogger(logger.Named("issue_notification_task")),
+			async.WithTaskTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue notification task handler", slog.Any("error", err))
+		}
+
+		licenseService, err := service.NewLicenseService(
+			license,
+			licenseRepo,
+			service.WithPermissionService(permissionService),
+			service.WithLogger(logger.Named("license_service")),
+			service.WithTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize license service", slog.Any("error", err))
+		}
+
+		issueService, err := service.NewIssueService(
+			service.WithIssueRepository(issueRepo),
+			service.WithAssignmentRepository(assignmentRepo),
+			service.WithLabelRepository(labelRepo),
+			service.WithPermissionService(permissionService),
+			service.WithLicenseService(licenseService),
+			service.WithLogger(logger.Named("issue_service")),
+			service.WithTracer(tracer),
+			service.WithSearchService(searchService),
+			service.WithNotificationTaskEnqueuer(messageQueue),
+			service.WithUserRepository(userRepo),
+			service.WithNotificationService(notificationService),
+			service.WithMentionRepository(mentionRepo),
+			service.WithEventRepository(eventRepo),
+			service.WithWebhookRepository(webhookRepo),
+			service.WithWebhookTaskEnqueuer(messageQueue),
+			service.WithIssueActivityRepository(issueActivityRepo),
+			service.WithWorkflowRepository(workflowRepo),
+			service.WithCustomFieldRepository(customFieldRepo),
+			service.WithIssueTemplateRepository(issueTemplateRepo),
+			service.WithIssueRecurrenceRepository(issueRecurrenceRepo),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue service", slog.Any("error", err))
+		}
+
+		issueRecurrenceHandler, err := async.NewIssueRecurrenceTaskHandler(
+			async.WithTaskIssueService(issueService),
+			async.WithTaskLogger(logger.Named("issue_recurrence_task")),
+			async.WithTaskTracer(tracer),
+		)
+		if err != nil {
+			logger.Fatal(context.Background(), "failed to initialize issue recurrence task handler", slog.Any("error", err))
+		}
+
 		worker, err := async.NewWorker(
 			async.WithWorkerTaskHandler(queue.TaskTypeSystemHealthCheck, systemHealthCheckHandler),
 			async.WithWorkerTaskHandler(queue.TaskTypeSystemLicenseExpiry, systemLicenseExpiryTaskHandler),
 			async.WithWorkerTaskHandler(queue.TaskTypeSearchIndex, searchIndexHandler),
 			async.WithWorkerTaskHandler(queue.TaskTypeSearchReindex, searchReindexHandler),
 			async.WithWorkerTaskHandler(queue.TaskTypeSearchReindexBatch, searchReindexBatchHandler),
+			async.WithWorkerTaskHandler(queue.TaskTypeNotificationIssueUpdated, issueNotificationHandler),
+			async.WithWorkerTaskHandler(queue.TaskTypeNotificationIssueRelation, issueNotificationHandler),
+			async.WithWorkerTaskHandler(queue.TaskTypeNotificationIssueComment, issueNotificationHandler),
+			async.WithWorkerTaskHandler(queue.TaskTypeWebhookDelivery, webhookDeliveryHandler),
+			async.WithWorkerTaskHandler(queue.TaskTypeIssueRecurrence, issueRecurrenceHandler),
 			async.WithWorkerConfig(&cfg.Worker),
 			async.WithWorkerLogger(logger.Named("worker")),
 			async.WithWorkerTracer(tracer),
@@ -29,6 +29,12 @@ const (
 	ActionDocumentUpdate            Action = "document.update"
 	ActionDocumentDelete            Action = "document.delete"
 	ActionFolderCreate              Action = "folder.create"
+	ActionViewRead                  Action = "view.read"
+	ActionViewUpdate                Action = "view.update"
+	ActionViewDelete                Action = "view.delete"
+	ActionLabelManage               Action = "label.manage"
+	ActionLabelAttach               Action = "label.attach"
+	ActionWebhookManage             Action = "webhook.manage"
 	ActionRoleManage                Action = "role.manage"
 	ActionTeamManage                Action = "team.manage"
 	ActionPermissionManage          Action = "permission.manage"
@@ -108,6 +114,12 @@ var Actions = []Action{
 	ActionDocumentUpdate,
 	ActionDocumentDelete,
 	ActionFolderCreate,
+	ActionViewRead,
+	ActionViewUpdate,
+	ActionViewDelete,
+	ActionLabelManage,
+	ActionLabelAttach,
+	ActionWebhookManage,
 	ActionRoleManage,
 	ActionTeamManage,
 	ActionPermissionManage,
@@ -162,6 +174,9 @@ var orgScopedActions = []Action{
 	ActionDocumentUpdate,
 	ActionDocumentDelete,
 	ActionFolderCreate,
+	ActionLabelManage,
+	ActionLabelAttach,
+	ActionWebhookManage,
 	ActionRoleManage,
 	ActionTeamManage,
 	ActionPermissionManage,
@@ -186,6 +201,9 @@ var namespaceScopedActions = []Action{
 	ActionDocumentUpdate,
 	ActionDocumentDelete,
 	ActionFolderCreate,
+	ActionLabelManage,
+	ActionLabelAttach,
+	ActionWebhookManage,
 	ActionTeamManage,
 	ActionPermissionManage,
 }
@@ -205,6 +223,9 @@ var projectMaintainerActions = []Action{
 	ActionDocumentUpdate,
 	ActionDocumentDelete,
 	ActionFolderCreate,
+	ActionLabelManage,
+	ActionLabelAttach,
+	ActionWebhookManage,
 	ActionTeamManage,
 	ActionPermissionManage,
 }
@@ -220,6 +241,7 @@ var issueMaintainerActions = []Action{
 	ActionIssueUpdate,
 	ActionIssueDelete,
 	ActionIssueAssign,
+	ActionLabelAttach,
 }
 
 var documentMaintainerActions = []Action{
@@ -227,9 +249,12 @@ var documentMaintainerActions = []Action{
 	ActionDocumentUpdate,
 	ActionDocumentDelete,
 	ActionFolderCreate,
+	ActionLabelAttach,
 }
 
 // RoleTemplates are copied onto each new organization. None include organization.create.
+// Actions added to a template later reach the roles of existing organizations
+// through an upgrade in assets/queries/bootstrap.cypher only.
 var RoleTemplates = []RoleTemplate{
 	{
 		Key:         RoleKeyOrgAdmin,
@@ -356,6 +381,8 @@ func ReadActionFor(rt ResourceType) (Action, bool) {
 		return ActionIssueRead, true
 	case ResourceTypeDocument, ResourceTypeFolder:
 		return ActionDocumentRead, true
+	case ResourceTypeView:
+		return ActionViewRead, true
 	default:
 		return "", false
 	}
@@ -18,6 +18,7 @@ func TestAction_Valid(t *testing.T) {
 		{"organization.create", ActionOrganizationCreate, true},
 		{"organization.read", ActionOrganizationRead, true},
 		{"permission.manage", ActionPermissionManage, true},
+		{"view.read", ActionViewRead, true},
 		{"empty", Action(""), false},
 		{"star", Action("*"), false},
 		{"legacy write", Action("write"), false},
@@ -132,6 +133,7 @@ func TestReadActionFor(t *testing.T) {
 		{"issue", ResourceTypeIssue, ActionIssueRead, true},
 		{"document", ResourceTypeDocument, ActionDocumentRead, true},
 		{"folder", ResourceTypeFolder, ActionDocumentRead, true},
+		{"view", ResourceTypeView, ActionViewRead, true},
 		{"user", ResourceTypeUser, "", false},
 		{"team", ResourceTypeTeam, "", false},
 		{"role", ResourceTypeRole, "", false},
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	BoardGroupByStatus         BoardGroupBy = "status"
+	BoardGroupByWorkflowStatus BoardGroupBy = "workflow_status"
+	BoardGroupByPriority       BoardGroupBy = "priority"
+	BoardGroupByKind           BoardGroupBy = "kind"
+)
+
+const (
+	maxWIPLimits = 100
+)
+
+// BoardGroupBy is the issue field the issues of a board are grouped into
+// columns by.
+type BoardGroupBy string
+
+// Valid reports whether the grouping is one of the known BoardGroupBy values.
+func (g BoardGroupBy) Valid() bool {
+	switch g {
+	case BoardGroupByStatus,
+		BoardGroupByWorkflowStatus,
+		BoardGroupByPriority,
+		BoardGroupByKind:
+		return true
+	default:
+		return false
+	}
+}
+
+// Columns returns the columns of the board in display order. The columns of
+// the workflow statuses are defined by the workflow of the project, so nil is
+// returned for them.
+func (g BoardGroupBy) Columns() []string {
+	switch g {
+	case BoardGroupByStatus:
+		return IssueStatusStrings()
+	case BoardGroupByPriority:
+		return IssuePriorityStrings()
+	case BoardGroupByKind:
+		return IssueKindStrings()
+	default:
+		return nil
+	}
+}
+
+// HasColumn reports whether the column is a column of the board. Any workflow
+// status key is accepted for the workflow statuses.
+func (g BoardGroupBy) HasColumn(column string) bool {
+	if g == BoardGroupByWorkflowStatus {
+		return workflowStatusKeyPattern.MatchString(column)
+	}
+	return slices.Contains(g.Columns(), column)
+}
+
+// WIPLimit caps the number of issues in a column of the board of a project.
+// The limit is not enforced, exceeding it is reported only.
+type WIPLimit struct {
+	GroupBy BoardGroupBy `json:"group_by" validate:"required"`
+	Column  string       `json:"column" validate:"required,min=1,max=32"`
+	Limit   int          `json:"limit" validate:"required,min=1,max=1000"`
+}
+
+// Validate validates the WIP limit.
+func (l *WIPLimit) Validate() error {
+	if err := validate.Struct(l); err != nil {
+		return errors.Join(ErrInvalidWIPLimitDetails, err)
+	}
+	if !l.GroupBy.Valid() {
+		return errors.Join(ErrInvalidWIPLimitDetails, fmt.Errorf("invalid grouping %q", l.GroupBy))
+	}
+	if !l.GroupBy.HasColumn(l.Column) {
+		return errors.Join(ErrInvalidWIPLimitDetails, fmt.Errorf("invalid %s column %q", l.GroupBy, l.Column))
+	}
+	return nil
+}
+
+// WIPLimits is the set of WIP limits of the columns of a project board.
+type WIPLimits []WIPLimit
+
+// Validate validates the WIP limits. Every column can have one limit only.
+func (l WIPLimits) Validate() error {
+	if len(l) > maxWIPLimits {
+		return errors.Join(ErrInvalidWIPLimitDetails, errors.New("too many limits"))
+	}
+
+	columns := make(map[WIPLimit]struct{}, len(l))
+	for _, limit := range l {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		column := WIPLimit{GroupBy: limit.GroupBy, Column: limit.Column}
+		if _, ok := columns[column]; ok {
+			return errors.Join(ErrInvalidWIPLimitDetails, fmt.Errorf("duplicate limit of %s column %q", limit.GroupBy, limit.Column))
+		}
+		columns[column] = struct{}{}
+	}
+
+	return nil
+}
+
+// Limit returns the WIP limit of the column of the board.
+func (l WIPLimits) Limit(groupBy BoardGroupBy, column string) (int, bool) {
+	for _, limit := range l {
+		if limit.GroupBy == groupBy && limit.Column == column {
+			return limit.Limit, true
+		}
+	}
+	return 0, false
+}
+
+// NewWIPLimits creates a new set of WIP limits.
+func NewWIPLimits(limits []WIPLimit) (WIPLimits, error) {
+	if limits == nil {
+		limits = make([]WIPLimit, 0)
+	}
+
+	set := WIPLimits(limits)
+	if err := set.Validate(); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardGroupBy_Columns(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"open", "in progress", "blocked", "review", "done", "closed"}, BoardGroupByStatus.Columns())
+	assert.Equal(t, []string{"lowest", "low", "normal", "high", "highest"}, BoardGroupByPriority.Columns())
+	assert.Equal(t, []string{"epic", "story", "task", "bug"}, BoardGroupByKind.Columns())
+	assert.Nil(t, BoardGroupByWorkflowStatus.Columns())
+	assert.False(t, BoardGroupBy("assignee").Valid())
+}
+
+func TestBoardGroupBy_HasColumn(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, BoardGroupByStatus.HasColumn("in progress"))
+	assert.False(t, BoardGroupByStatus.HasColumn("in_progress"))
+	assert.True(t, BoardGroupByWorkflowStatus.HasColumn("code_review"))
+	assert.False(t, BoardGroupByWorkflowStatus.HasColumn("Code review"))
+	assert.False(t, BoardGroupBy("assignee").HasColumn("open"))
+}
+
+func TestWIPLimit_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		limit   WIPLimit
+		wantErr error
+	}{
+		{
+			name:  "valid status limit",
+			limit: WIPLimit{GroupBy: BoardGroupByStatus, Column: "in progress", Limit: 5},
+		},
+		{
+			name:  "valid workflow status limit",
+			limit: WIPLimit{GroupBy: BoardGroupByWorkflowStatus, Column: "code_review", Limit: 3},
+		},
+		{
+			name:    "unknown grouping",
+			limit:   WIPLimit{GroupBy: "assignee", Column: "open", Limit: 5},
+			wantErr: ErrInvalidWIPLimitDetails,
+		},
+		{
+			name:    "unknown column",
+			limit:   WIPLimit{GroupBy: BoardGroupByPriority, Column: "urgent", Limit: 5},
+			wantErr: ErrInvalidWIPLimitDetails,
+		},
+		{
+			name:    "zero limit",
+			limit:   WIPLimit{GroupBy: BoardGroupByStatus, Column: "open", Limit: 0},
+			wantErr: ErrInvalidWIPLimitDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.limit.Validate()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewWIPLimits(t *testing.T) {
+	t.Parallel()
+
+	limits, err := NewWIPLimits(nil)
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	limits, err = NewWIPLimits([]WIPLimit{
+		{GroupBy: BoardGroupByStatus, Column: "in progress", Limit: 5},
+		{GroupBy: BoardGroupByPriority, Column: "highest", Limit: 2},
+	})
+	require.NoError(t, err)
+
+	limit, ok := limits.Limit(BoardGroupByStatus, "in progress")
+	assert.True(t, ok)
+	assert.Equal(t, 5, limit)
+	_, ok = limits.Limit(BoardGroupByStatus, "review")
+	assert.False(t, ok)
+
+	_, err = NewWIPLimits([]WIPLimit{
+		{GroupBy: BoardGroupByStatus, Column: "review", Limit: 5},
+		{GroupBy: BoardGroupByStatus, Column: "review", Limit: 3},
+	})
+	assert.ErrorIs(t, err, ErrInvalidWIPLimitDetails)
+}
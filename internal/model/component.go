@@ -0,0 +1,54 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+// Component is a part of a project, like a module or a service, that issues
+// belong to. The lead of the component is assigned to the new issues of the
+// component that have no assignees.
+type Component struct {
+	ID          ID         `json:"id" validate:"required"`
+	Project     ID         `json:"project" validate:"required"`
+	Name        string     `json:"name" validate:"required,min=1,max=120"`
+	Description string     `json:"description" validate:"omitempty,max=500"`
+	Lead        *ID        `json:"lead" validate:"omitempty"`
+	CreatedAt   *time.Time `json:"created_at" validate:"omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at" validate:"omitempty"`
+}
+
+func (c *Component) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return errors.Join(ErrInvalidComponentDetails, err)
+	}
+	if err := c.ID.Validate(); err != nil {
+		return errors.Join(ErrInvalidComponentDetails, err)
+	}
+	if err := c.Project.Validate(); err != nil || c.Project.Type != ResourceTypeProject {
+		return errors.Join(ErrInvalidComponentDetails, ErrInvalidID)
+	}
+	if c.Lead != nil {
+		if err := c.Lead.Validate(); err != nil || c.Lead.Type != ResourceTypeUser {
+			return errors.Join(ErrInvalidComponentDetails, ErrInvalidID)
+		}
+	}
+	return nil
+}
+
+// NewComponent creates a new Component in the project.
+func NewComponent(project ID, name string) (*Component, error) {
+	component := &Component{
+		ID:      MustNewNilID(ResourceTypeComponent),
+		Project: project,
+		Name:    name,
+	}
+
+	if err := component.Validate(); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComponent(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+
+	type args struct {
+		project ID
+		name    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Component
+		wantErr error
+	}{
+		{
+			name: "create Component with valid details",
+			args: args{
+				project: project,
+				name:    "API",
+			},
+			want: &Component{
+				ID:      ID{Inner: xid.NilID(), Type: ResourceTypeComponent},
+				Project: project,
+				Name:    "API",
+			},
+		},
+		{
+			name: "create Component with empty name",
+			args: args{
+				project: project,
+				name:    "",
+			},
+			wantErr: ErrInvalidComponentDetails,
+		},
+		{
+			name: "create Component with invalid project",
+			args: args{
+				project: MustNewID(ResourceTypeOrganization),
+				name:    "API",
+			},
+			wantErr: ErrInvalidComponentDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewComponent(tt.args.project, tt.args.name)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestComponent_Validate(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+	lead := MustNewID(ResourceTypeUser)
+	team := MustNewID(ResourceTypeTeam)
+
+	tests := []struct {
+		name      string
+		component Component
+		wantErr   error
+	}{
+		{
+			name: "validate Component with valid details",
+			component: Component{
+				ID:          MustNewID(ResourceTypeComponent),
+				Project:     project,
+				Name:        "API",
+				Description: "Public REST API",
+				Lead:        &lead,
+			},
+		},
+		{
+			name: "validate Component with invalid ID",
+			component: Component{
+				ID:      ID{},
+				Project: project,
+				Name:    "API",
+			},
+			wantErr: ErrInvalidComponentDetails,
+		},
+		{
+			name: "validate Component with too long description",
+			component: Component{
+				ID:          MustNewID(ResourceTypeComponent),
+				Project:     project,
+				Name:        "API",
+				Description: strings.Repeat("a", 501),
+			},
+			wantErr: ErrInvalidComponentDetails,
+		},
+		{
+			name: "validate Component with invalid lead",
+			component: Component{
+				ID:      MustNewID(ResourceTypeComponent),
+				Project: project,
+				Name:    "API",
+				Lead:    &team,
+			},
+			wantErr: ErrInvalidComponentDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, tt.component.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	CustomFieldTypeText         CustomFieldType = iota + 1 // text
+	CustomFieldTypeNumber                                  // number
+	CustomFieldTypeDate                                    // date
+	CustomFieldTypeSingleSelect                            // single_select
+	CustomFieldTypeMultiSelect                             // multi_select
+	CustomFieldTypeUser                                    // user
+)
+
+const (
+	customFieldDateFormat   = "2006-01-02"
+	customFieldMaxTextValue = 1000
+)
+
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// CustomFieldType is the type of the values of a custom field.
+//
+//go:generate go tool enumer -type=CustomFieldType -text -transform=noop -linecomment -output=custom_field_type_gen.go
+type CustomFieldType uint8
+
+// IsCustomFieldKey reports whether the key can identify a custom field.
+func IsCustomFieldKey(key string) bool {
+	return len(key) <= 32 && customFieldKeyPattern.MatchString(key)
+}
+
+// CustomField is a typed field of the issues in a project. The key identifies
+// the field on the issues, while the name is displayed to the users. Select
+// fields accept one or more of their options only.
+type CustomField struct {
+	Key      string          `json:"key" validate:"required,min=1,max=32"`
+	Name     string          `json:"name" validate:"required,min=1,max=60"`
+	Type     CustomFieldType `json:"type" validate:"required,min=1,max=6"`
+	Options  []string        `json:"options" validate:"max=100,dive,required,max=60"`
+	Required bool            `json:"required"`
+}
+
+// Validate validates the custom field.
+func (f *CustomField) Validate() error {
+	if err := validate.Struct(f); err != nil {
+		return errors.Join(ErrInvalidCustomFieldDetails, err)
+	}
+
+	if !IsCustomFieldKey(f.Key) {
+		return errors.Join(ErrInvalidCustomFieldDetails, fmt.Errorf("invalid field key %q", f.Key))
+	}
+
+	switch f.Type {
+	case CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect:
+		if len(f.Options) == 0 {
+			return errors.Join(ErrInvalidCustomFieldDetails, fmt.Errorf("field %q has no options", f.Key))
+		}
+	default:
+		if len(f.Options) != 0 {
+			return errors.Join(ErrInvalidCustomFieldDetails, fmt.Errorf("field %q cannot have options", f.Key))
+		}
+	}
+
+	options := make(map[string]struct{}, len(f.Options))
+	for _, option := range f.Options {
+		if _, ok := options[option]; ok {
+			return errors.Join(ErrInvalidCustomFieldDetails, fmt.Errorf("duplicate option %q of field %q", option, f.Key))
+		}
+		options[option] = struct{}{}
+	}
+
+	return nil
+}
+
+// Sortable reports whether the issues can be ordered by the field.
+func (f *CustomField) Sortable() bool {
+	return f.Type != CustomFieldTypeMultiSelect
+}
+
+// Value validates a value of the field and returns it in the form stored on
+// the issues: a string for text, date, select and user fields, a float64 for
+// number fields and a list of strings for multi-select fields. Values already
+// in the stored form are accepted too.
+func (f *CustomField) Value(value any) (any, error) {
+	switch f.Type {
+	case CustomFieldTypeText:
+		s, ok := value.(string)
+		if !ok || s == "" || len(s) > customFieldMaxTextValue {
+			return nil, f.invalidValue(value)
+		}
+		return s, nil
+	case CustomFieldTypeNumber:
+		switch n := value.(type) {
+		case float64:
+			return n, nil
+		case float32:
+			return float64(n), nil
+		case int:
+			return float64(n), nil
+		case int64:
+			return float64(n), nil
+		default:
+			return nil, f.invalidValue(value)
+		}
+	case CustomFieldTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return nil, f.invalidValue(value)
+		}
+		return f.parseDate(s)
+	case CustomFieldTypeSingleSelect:
+		s, ok := value.(string)
+		if !ok || !slices.Contains(f.Options, s) {
+			return nil, f.invalidValue(value)
+		}
+		return s, nil
+	case CustomFieldTypeMultiSelect:
+		var items []any
+		switch v := value.(type) {
+		case []any:
+			items = v
+		case []string:
+			for _, item := range v {
+				items = append(items, item)
+			}
+		default:
+			return nil, f.invalidValue(value)
+		}
+
+		selected := make([]string, 0, len(items))
+		for _, item := range items {
+			s, ok := item.(string)
+			if !ok || !slices.Contains(f.Options, s) {
+				return nil, f.invalidValue(value)
+			}
+			if !slices.Contains(selected, s) {
+				selected = append(selected, s)
+			}
+		}
+		return selected, nil
+	case CustomFieldTypeUser:
+		s, ok := value.(string)
+		if !ok {
+			return nil, f.invalidValue(value)
+		}
+		if _, err := NewIDFromString(s, ResourceTypeUser.String()); err != nil {
+			return nil, errors.Join(f.invalidValue(value), err)
+		}
+		return s, nil
+	default:
+		return nil, f.invalidValue(value)
+	}
+}
+
+// ParseValue parses a single value of the field from its text form, as used
+// in query strings. A multi-select field is parsed to one of its options.
+func (f *CustomField) ParseValue(value string) (any, error) {
+	switch f.Type {
+	case CustomFieldTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.Join(f.invalidValue(value), err)
+		}
+		return n, nil
+	case CustomFieldTypeMultiSelect:
+		if !slices.Contains(f.Options, value) {
+			return nil, f.invalidValue(value)
+		}
+		return value, nil
+	default:
+		return f.Value(value)
+	}
+}
+
+func (f *CustomField) parseDate(value string) (string, error) {
+	if date, err := time.Parse(customFieldDateFormat, value); err == nil {
+		return date.Format(customFieldDateFormat), nil
+	}
+	if date, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return date.UTC().Format(customFieldDateFormat), nil
+	}
+	return "", f.invalidValue(value)
+}
+
+func (f *CustomField) invalidValue(value any) error {
+	return errors.Join(ErrInvalidCustomFieldValue, fmt.Errorf("invalid value %v of field %q", value, f.Key))
+}
+
+// CustomFields is the set of custom fields defined for the issues of a
+// project.
+type CustomFields []CustomField
+
+// Validate validates the custom fields. The field keys must be unique.
+func (f CustomFields) Validate() error {
+	if len(f) > 50 {
+		return errors.Join(ErrInvalidCustomFieldDetails, errors.New("too many fields"))
+	}
+
+	keys := make(map[string]struct{}, len(f))
+	for _, field := range f {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+		if _, ok := keys[field.Key]; ok {
+			return errors.Join(ErrInvalidCustomFieldDetails, fmt.Errorf("duplicate field key %q", field.Key))
+		}
+		keys[field.Key] = struct{}{}
+	}
+
+	return nil
+}
+
+// Field returns the custom field by its key.
+func (f CustomFields) Field(key string) (CustomField, bool) {
+	for _, field := range f {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return CustomField{}, false
+}
+
+// Values validates the values set on an issue and returns them in the stored
+// form. A nil value clears the field. If required is set, every required
+// field must have a value.
+func (f CustomFields) Values(values map[string]any, required bool) (map[string]any, error) {
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		field, ok := f.Field(key)
+		if !ok {
+			return nil, errors.Join(ErrInvalidCustomFieldValue, fmt.Errorf("unknown field %q", key))
+		}
+
+		var stored any
+		if value != nil {
+			var err error
+			if stored, err = field.Value(value); err != nil {
+				return nil, err
+			}
+			// Unselecting every option of a multi-select field clears it.
+			if selected, ok := stored.([]string); ok && len(selected) == 0 {
+				stored = nil
+			}
+		}
+
+		if stored == nil && field.Required {
+			return nil, errors.Join(ErrInvalidCustomFieldValue, fmt.Errorf("field %q is required", key))
+		}
+		out[key] = stored
+	}
+
+	if required {
+		for _, field := range f {
+			if _, ok := out[field.Key]; field.Required && !ok {
+				return nil, errors.Join(ErrInvalidCustomFieldValue, fmt.Errorf("field %q is required", field.Key))
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// Known returns the stored values of the defined fields, dropping the values
+// of removed fields and the values not matching the type of their field.
+func (f CustomFields) Known(values map[string]any) map[string]any {
+	out := make(map[string]any, len(values))
+	for _, field := range f {
+		value, ok := values[field.Key]
+		if !ok || value == nil {
+			continue
+		}
+		if stored, err := field.Value(value); err == nil {
+			out[field.Key] = stored
+		}
+	}
+	return out
+}
+
+// FormatCustomFieldValue returns the text form of a stored value.
+func FormatCustomFieldValue(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			out = append(out, FormatCustomFieldValue(item)...)
+		}
+		return out
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// NewCustomFields creates a new set of custom fields.
+func NewCustomFields(fields []CustomField) (CustomFields, error) {
+	if fields == nil {
+		fields = make([]CustomField, 0)
+	}
+
+	set := CustomFields(fields)
+	if err := set.Validate(); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
@@ -0,0 +1,241 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFieldType_String(t *testing.T) {
+	tests := []struct {
+		name string
+		t    CustomFieldType
+		want string
+	}{
+		{"Text", CustomFieldTypeText, "text"},
+		{"Number", CustomFieldTypeNumber, "number"},
+		{"Date", CustomFieldTypeDate, "date"},
+		{"SingleSelect", CustomFieldTypeSingleSelect, "single_select"},
+		{"MultiSelect", CustomFieldTypeMultiSelect, "multi_select"},
+		{"User", CustomFieldTypeUser, "user"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.t.String())
+		})
+	}
+}
+
+func TestCustomField_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   CustomField
+		wantErr error
+	}{
+		{
+			name:  "valid text field",
+			field: CustomField{Key: "customer", Name: "Customer", Type: CustomFieldTypeText},
+		},
+		{
+			name:  "valid select field",
+			field: CustomField{Key: "severity", Name: "Severity", Type: CustomFieldTypeSingleSelect, Options: []string{"minor", "major"}},
+		},
+		{
+			name:    "invalid key",
+			field:   CustomField{Key: "Severity", Name: "Severity", Type: CustomFieldTypeText},
+			wantErr: ErrInvalidCustomFieldDetails,
+		},
+		{
+			name:    "invalid type",
+			field:   CustomField{Key: "severity", Name: "Severity", Type: CustomFieldType(100)},
+			wantErr: ErrInvalidCustomFieldDetails,
+		},
+		{
+			name:    "select field without options",
+			field:   CustomField{Key: "severity", Name: "Severity", Type: CustomFieldTypeMultiSelect},
+			wantErr: ErrInvalidCustomFieldDetails,
+		},
+		{
+			name:    "text field with options",
+			field:   CustomField{Key: "customer", Name: "Customer", Type: CustomFieldTypeText, Options: []string{"acme"}},
+			wantErr: ErrInvalidCustomFieldDetails,
+		},
+		{
+			name:    "duplicate options",
+			field:   CustomField{Key: "severity", Name: "Severity", Type: CustomFieldTypeSingleSelect, Options: []string{"minor", "minor"}},
+			wantErr: ErrInvalidCustomFieldDetails,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.field.Validate()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomField_Value(t *testing.T) {
+	userID := MustNewID(ResourceTypeUser)
+	options := []string{"ios", "android", "web"}
+
+	tests := []struct {
+		name    string
+		field   CustomField
+		value   any
+		want    any
+		wantErr bool
+	}{
+		{"text", CustomField{Key: "f", Type: CustomFieldTypeText}, "acme", "acme", false},
+		{"empty text", CustomField{Key: "f", Type: CustomFieldTypeText}, "", nil, true},
+		{"number", CustomField{Key: "f", Type: CustomFieldTypeNumber}, float64(3.5), float64(3.5), false},
+		{"integer number", CustomField{Key: "f", Type: CustomFieldTypeNumber}, 3, float64(3), false},
+		{"number as text", CustomField{Key: "f", Type: CustomFieldTypeNumber}, "3", nil, true},
+		{"date", CustomField{Key: "f", Type: CustomFieldTypeDate}, "2024-05-01", "2024-05-01", false},
+		{"date time", CustomField{Key: "f", Type: CustomFieldTypeDate}, "2024-05-01T23:30:00-02:00", "2024-05-02", false},
+		{"invalid date", CustomField{Key: "f", Type: CustomFieldTypeDate}, "tomorrow", nil, true},
+		{"single select", CustomField{Key: "f", Type: CustomFieldTypeSingleSelect, Options: options}, "ios", "ios", false},
+		{"unknown option", CustomField{Key: "f", Type: CustomFieldTypeSingleSelect, Options: options}, "linux", nil, true},
+		{"multi select", CustomField{Key: "f", Type: CustomFieldTypeMultiSelect, Options: options}, []any{"web", "ios", "web"}, []string{"web", "ios"}, false},
+		{"stored multi select", CustomField{Key: "f", Type: CustomFieldTypeMultiSelect, Options: options}, []string{"android"}, []string{"android"}, false},
+		{"multi select unknown option", CustomField{Key: "f", Type: CustomFieldTypeMultiSelect, Options: options}, []any{"linux"}, nil, true},
+		{"user", CustomField{Key: "f", Type: CustomFieldTypeUser}, userID.String(), userID.String(), false},
+		{"invalid user", CustomField{Key: "f", Type: CustomFieldTypeUser}, "nobody", nil, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.field.Value(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomField_ParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   CustomField
+		value   string
+		want    any
+		wantErr bool
+	}{
+		{"number", CustomField{Key: "f", Type: CustomFieldTypeNumber}, "2.5", float64(2.5), false},
+		{"invalid number", CustomField{Key: "f", Type: CustomFieldTypeNumber}, "two", nil, true},
+		{"multi select option", CustomField{Key: "f", Type: CustomFieldTypeMultiSelect, Options: []string{"ios"}}, "ios", "ios", false},
+		{"text", CustomField{Key: "f", Type: CustomFieldTypeText}, "acme", "acme", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.field.ParseValue(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCustomFields(t *testing.T) {
+	t.Parallel()
+
+	fields, err := NewCustomFields(nil)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+
+	_, err = NewCustomFields([]CustomField{
+		{Key: "customer", Name: "Customer", Type: CustomFieldTypeText},
+		{Key: "customer", Name: "Client", Type: CustomFieldTypeText},
+	})
+	assert.ErrorIs(t, err, ErrInvalidCustomFieldDetails)
+}
+
+func TestCustomFields_Values(t *testing.T) {
+	fields := CustomFields{
+		{Key: "customer", Name: "Customer", Type: CustomFieldTypeText, Required: true},
+		{Key: "points", Name: "Points", Type: CustomFieldTypeNumber},
+		{Key: "platforms", Name: "Platforms", Type: CustomFieldTypeMultiSelect, Options: []string{"ios", "web"}},
+	}
+
+	tests := []struct {
+		name     string
+		values   map[string]any
+		required bool
+		want     map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "valid values",
+			values:   map[string]any{"customer": "acme", "points": float64(3)},
+			required: true,
+			want:     map[string]any{"customer": "acme", "points": float64(3)},
+		},
+		{
+			name:   "clear field",
+			values: map[string]any{"points": nil, "platforms": []any{}},
+			want:   map[string]any{"points": nil, "platforms": nil},
+		},
+		{
+			name:     "missing required field",
+			values:   map[string]any{"points": float64(3)},
+			required: true,
+			wantErr:  true,
+		},
+		{
+			name:    "clear required field",
+			values:  map[string]any{"customer": nil},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			values:  map[string]any{"team": "core"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := fields.Values(tt.values, tt.required)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomFields_Known(t *testing.T) {
+	t.Parallel()
+
+	fields := CustomFields{
+		{Key: "customer", Name: "Customer", Type: CustomFieldTypeText},
+		{Key: "points", Name: "Points", Type: CustomFieldTypeNumber},
+	}
+
+	got := fields.Known(map[string]any{
+		"customer": "acme",
+		"points":   "three",
+		"removed":  "value",
+	})
+	assert.Equal(t, map[string]any{"customer": "acme"}, got)
+}
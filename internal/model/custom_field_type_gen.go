@@ -0,0 +1,107 @@
+// Code generated by "enumer -type=CustomFieldType -text -transform=noop -linecomment -output=custom_field_type_gen.go"; DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _CustomFieldTypeName = "textnumberdatesingle_selectmulti_selectuser"
+
+var _CustomFieldTypeIndex = [...]uint8{0, 4, 10, 14, 27, 39, 43}
+
+const _CustomFieldTypeLowerName = "textnumberdatesingle_selectmulti_selectuser"
+
+func (i CustomFieldType) String() string {
+	i -= 1
+	if i >= CustomFieldType(len(_CustomFieldTypeIndex)-1) {
+		return fmt.Sprintf("CustomFieldType(%d)", i+1)
+	}
+	return _CustomFieldTypeName[_CustomFieldTypeIndex[i]:_CustomFieldTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _CustomFieldTypeNoOp() {
+	var x [1]struct{}
+	_ = x[CustomFieldTypeText-(1)]
+	_ = x[CustomFieldTypeNumber-(2)]
+	_ = x[CustomFieldTypeDate-(3)]
+	_ = x[CustomFieldTypeSingleSelect-(4)]
+	_ = x[CustomFieldTypeMultiSelect-(5)]
+	_ = x[CustomFieldTypeUser-(6)]
+}
+
+var _CustomFieldTypeValues = []CustomFieldType{CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate, CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect, CustomFieldTypeUser}
+
+var _CustomFieldTypeNameToValueMap = map[string]CustomFieldType{
+	_CustomFieldTypeName[0:4]:        CustomFieldTypeText,
+	_CustomFieldTypeLowerName[0:4]:   CustomFieldTypeText,
+	_CustomFieldTypeName[4:10]:       CustomFieldTypeNumber,
+	_CustomFieldTypeLowerName[4:10]:  CustomFieldTypeNumber,
+	_CustomFieldTypeName[10:14]:      CustomFieldTypeDate,
+	_CustomFieldTypeLowerName[10:14]: CustomFieldTypeDate,
+	_CustomFieldTypeName[14:27]:      CustomFieldTypeSingleSelect,
+	_CustomFieldTypeLowerName[14:27]: CustomFieldTypeSingleSelect,
+	_CustomFieldTypeName[27:39]:      CustomFieldTypeMultiSelect,
+	_CustomFieldTypeLowerName[27:39]: CustomFieldTypeMultiSelect,
+	_CustomFieldTypeName[39:43]:      CustomFieldTypeUser,
+	_CustomFieldTypeLowerName[39:43]: CustomFieldTypeUser,
+}
+
+var _CustomFieldTypeNames = []string{
+	_CustomFieldTypeName[0:4],
+	_CustomFieldTypeName[4:10],
+	_CustomFieldTypeName[10:14],
+	_CustomFieldTypeName[14:27],
+	_CustomFieldTypeName[27:39],
+	_CustomFieldTypeName[39:43],
+}
+
+// CustomFieldTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func CustomFieldTypeString(s string) (CustomFieldType, error) {
+	if val, ok := _CustomFieldTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _CustomFieldTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to CustomFieldType values", s)
+}
+
+// CustomFieldTypeValues returns all values of the enum
+func CustomFieldTypeValues() []CustomFieldType {
+	return _CustomFieldTypeValues
+}
+
+// CustomFieldTypeStrings returns a slice of all String values of the enum
+func CustomFieldTypeStrings() []string {
+	strs := make([]string, len(_CustomFieldTypeNames))
+	copy(strs, _CustomFieldTypeNames)
+	return strs
+}
+
+// IsACustomFieldType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i CustomFieldType) IsACustomFieldType() bool {
+	for _, v := range _CustomFieldTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for CustomFieldType
+func (i CustomFieldType) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for CustomFieldType
+func (i *CustomFieldType) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = CustomFieldTypeString(string(text))
+	return err
+}
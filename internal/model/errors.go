@@ -7,6 +7,9 @@ var (
 	ErrInvalidAssignmentKind            = errors.New("invalid assigned to kind")                // the assigned to kind is invalid
 	ErrInvalidAttachmentDetails         = errors.New("invalid attachment details")              // the attachment details are invalid
 	ErrInvalidCommentDetails            = errors.New("invalid comment details")                 // the comment details are invalid
+	ErrInvalidComponentDetails          = errors.New("invalid component details")               // the component details are invalid
+	ErrInvalidCustomFieldDetails        = errors.New("invalid custom field details")            // the custom field details are invalid
+	ErrInvalidCustomFieldValue          = errors.New("invalid custom field value")              // the custom field value is invalid
 	ErrInvalidDocumentDetails           = errors.New("invalid document details")                // the document details are invalid
 	ErrInvalidFolderDetails             = errors.New("invalid folder details")                  // the folder details are invalid
 	ErrInvalidHealthStatus              = errors.New("invalid health status")                   // health status is invalid
@@ -18,7 +21,7 @@ var (
 	ErrInvalidIssueRelationKind         = errors.New("invalid issue relation kind")             // the issue relation kind is invalid
 	ErrInvalidIssueResolution           = errors.New("invalid issue resolution")                // the issue resolution is invalid
 	ErrInvalidIssueStatus               = errors.New("invalid issue status")                    // the issue status is invalid
-	ErrInvalidLabelDetails              = errors.New("invalid Type details")                    // the Type details are invalid
+	ErrInvalidLabelDetails              = errors.New("invalid label details")                   // the label details are invalid
 	ErrInvalidLanguage                  = errors.New("invalid language code")                   // Language is not valid
 	ErrInvalidNamespaceDetails          = errors.New("invalid namespace details")               // the namespace details are invalid
 	ErrInvalidNotificationDetails       = errors.New("invalid notification details")            // the notification details are invalid
@@ -28,9 +31,16 @@ var (
 	ErrInvalidOrganizationStatus        = errors.New("invalid organization status")             // the organization status is invalid
 	ErrInvalidPartialDocumentDetails    = errors.New("invalid partial document details")        // the partial document details are invalid
 	ErrInvalidPartialProjectDetails     = errors.New("invalid partial project details")         // the partial project details are invalid
+	ErrInvalidReleaseDetails            = errors.New("invalid release details")                 // the release details are invalid
+	ErrInvalidSprintDetails             = errors.New("invalid sprint details")                  // the sprint details are invalid
+	ErrInvalidWorkLogDetails            = errors.New("invalid work log details")                // the work log details are invalid
+	ErrInvalidIssueTemplateDetails      = errors.New("invalid issue template details")          // the issue template details are invalid
+	ErrInvalidIssueRecurrenceDetails    = errors.New("invalid issue recurrence details")        // the issue recurrence details are invalid
+	ErrInvalidViewDetails               = errors.New("invalid view details")                    // the view details are invalid
 	ErrInvalidAction                    = errors.New("invalid action")                          // the action is not in the registry
 	ErrInvalidGrant                     = errors.New("invalid grant")                           // the grant details are invalid
 	ErrInvalidPermissionDetails         = errors.New("invalid permission details")              // the permission details are invalid
+	ErrIssueDependencyCycle             = errors.New("issue dependency cycle")                  // the issue dependencies form a cycle
 	ErrGrantCycle                       = errors.New("authorization scope cycle")               // IN_SCOPE_OF would create a cycle
 	ErrNotAPrincipal                    = errors.New("subject is not a principal")              // grant subject is not a principal
 	ErrPrivilegeEscalation              = errors.New("privilege escalation denied")             // caller cannot grant an unheld action
@@ -46,5 +56,8 @@ var (
 	ErrInvalidUserStatus                = errors.New("invalid user status")                     // the user status is invalid
 	ErrInvalidUserToken                 = errors.New("invalid user token")                      // the user token is invalid
 	ErrInvalidUserTokenContext          = errors.New("invalid user token context")              // the provided user token context is invalid
+	ErrInvalidWIPLimitDetails           = errors.New("invalid WIP limit details")               // the WIP limit details are invalid
+	ErrInvalidWebhookDetails            = errors.New("invalid webhook details")                 // the webhook details are invalid
+	ErrInvalidWorkflowDetails           = errors.New("invalid workflow details")                // the workflow details are invalid
 	ErrPermissionSubjectTargetEqual     = errors.New("permission subject and target are equal") // the permission subject and target are equal
 )
@@ -114,25 +114,29 @@ type IssueLink struct {
 }
 
 // Issue represents an issue in the system that can be assigned to a
-// user and belong to a project or another Issue.
+// user and belong to a project or another Issue. The original and remaining
+// estimates of the issue are in minutes.
 type Issue struct {
-	ID          ID              `json:"id" validate:"required"`
-	NumericID   uint            `json:"numeric_id" validate:"required"`
-	Parent      *ID             `json:"parent" validate:"omitempty"`
-	Kind        IssueKind       `json:"kind" validate:"required,min=1,max=4"`
-	Title       string          `json:"title" validate:"required,min=3,max=120"`
-	Description string          `json:"description" validate:"omitempty,min=3"`
-	Status      IssueStatus     `json:"status" validate:"required,min=1,max=6"`
-	Priority    IssuePriority   `json:"priority" validate:"required,min=1,max=5"`
-	Resolution  IssueResolution `json:"resolution" validate:"required,min=1,max=7"`
-	ReportedBy  ID              `json:"reported_by" validate:"required"`
-	Assignees   []ID            `json:"assignees" validate:"omitempty,dive"`
-	Labels      []ID            `json:"labels" validate:"omitempty,dive"`
-	Links       []IssueLink     `json:"links" validate:"omitempty,dive"`
-	DueDate     *time.Time      `json:"due_date" validate:"omitempty"`
-	StartDate   *time.Time      `json:"start_date" validate:"omitempty"`
-	CreatedAt   *time.Time      `json:"created_at" validate:"omitempty"`
-	UpdatedAt   *time.Time      `json:"updated_at" validate:"omitempty"`
+	ID                ID              `json:"id" validate:"required"`
+	NumericID         uint            `json:"numeric_id" validate:"required"`
+	Parent            *ID             `json:"parent" validate:"omitempty"`
+	Kind              IssueKind       `json:"kind" validate:"required,min=1,max=4"`
+	Title             string          `json:"title" validate:"required,min=3,max=120"`
+	Description       string          `json:"description" validate:"omitempty,min=3"`
+	Status            IssueStatus     `json:"status" validate:"required,min=1,max=6"`
+	Priority          IssuePriority   `json:"priority" validate:"required,min=1,max=5"`
+	Resolution        IssueResolution `json:"resolution" validate:"required,min=1,max=7"`
+	ReportedBy        ID              `json:"reported_by" validate:"required"`
+	Assignees         []ID            `json:"assignees" validate:"omitempty,dive"`
+	Labels            []ID            `json:"labels" validate:"omitempty,dive"`
+	Links             []IssueLink     `json:"links" validate:"omitempty,dive"`
+	StoryPoints       *float64        `json:"story_points" validate:"omitempty,gte=0,lte=1000"`
+	OriginalEstimate  *uint           `json:"original_estimate" validate:"omitempty"`
+	RemainingEstimate *uint           `json:"remaining_estimate" validate:"omitempty"`
+	DueDate           *time.Time      `json:"due_date" validate:"omitempty"`
+	StartDate         *time.Time      `json:"start_date" validate:"omitempty"`
+	CreatedAt         *time.Time      `json:"created_at" validate:"omitempty"`
+	UpdatedAt         *time.Time      `json:"updated_at" validate:"omitempty"`
 }
 
 // Validate validates the issue details.
@@ -0,0 +1,170 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// IsBlocking returns true if the relation kind orders the related issues, so
+// one of them has to be done before the other can start.
+func (k IssueRelationKind) IsBlocking() bool {
+	return k == IssueRelationKindBlocks || k == IssueRelationKindBlockedBy || k == IssueRelationKindDependsOn
+}
+
+// IsDependency returns true if the relation kind is part of the dependency
+// graph of the issues, which are the blocking and the subtask relations.
+func (k IssueRelationKind) IsDependency() bool {
+	return k.IsBlocking() || k == IssueRelationKindSubtaskOf
+}
+
+// BlockingIssueRelationKinds returns the relation kinds that order the
+// related issues.
+func BlockingIssueRelationKinds() []IssueRelationKind {
+	return []IssueRelationKind{
+		IssueRelationKindBlockedBy,
+		IssueRelationKindBlocks,
+		IssueRelationKindDependsOn,
+	}
+}
+
+// DependencyIssueRelationKinds returns the relation kinds of the dependency
+// graph of the issues.
+func DependencyIssueRelationKinds() []IssueRelationKind {
+	return append(BlockingIssueRelationKinds(), IssueRelationKindSubtaskOf)
+}
+
+// IssueDependency is an ordering between two issues, where the Before issue
+// has to be done before the After issue can start.
+type IssueDependency struct {
+	Before ID
+	After  ID
+}
+
+// NewIssueDependency returns the ordering of a relation from the source to
+// the target issue. If the relation kind is not blocking, false is returned.
+func NewIssueDependency(source, target ID, kind IssueRelationKind) (IssueDependency, bool) {
+	switch kind {
+	case IssueRelationKindBlocks:
+		return IssueDependency{Before: source, After: target}, true
+	case IssueRelationKindBlockedBy, IssueRelationKindDependsOn:
+		return IssueDependency{Before: target, After: source}, true
+	default:
+		return IssueDependency{}, false
+	}
+}
+
+// ScheduledIssue is an issue with the dates it is planned for.
+type ScheduledIssue struct {
+	ID        ID
+	StartDate *time.Time
+	DueDate   *time.Time
+}
+
+// Duration returns the time planned for the issue. Issues without a start or
+// due date, or due before they start, take no time.
+func (i ScheduledIssue) Duration() time.Duration {
+	if i.StartDate == nil || i.DueDate == nil || i.DueDate.Before(*i.StartDate) {
+		return 0
+	}
+	return i.DueDate.Sub(*i.StartDate)
+}
+
+// CriticalPathStep is an issue on the critical path. The earliest start and
+// finish are relative to the start of the path.
+type CriticalPathStep struct {
+	ID             ID
+	Duration       time.Duration
+	EarliestStart  time.Duration
+	EarliestFinish time.Duration
+}
+
+// CriticalPath is the longest chain of dependent issues, which determines the
+// shortest time the issues can be done in.
+type CriticalPath struct {
+	Steps    []CriticalPathStep
+	Duration time.Duration
+}
+
+// NewCriticalPath returns the critical path of the issues, ordered by their
+// dependencies. The duration of an issue is the time between its start and
+// due date. Dependencies on issues not in the list are ignored. If the
+// dependencies form a cycle, an error is returned. Between paths of the same
+// duration, the one ending with the issue listed first is returned.
+func NewCriticalPath(issues []ScheduledIssue, dependencies []IssueDependency) (*CriticalPath, error) {
+	index := make(map[ID]int, len(issues))
+	for i, issue := range issues {
+		index[issue.ID] = i
+	}
+
+	successors := make([][]int, len(issues))
+	inDegree := make([]int, len(issues))
+	for _, dependency := range dependencies {
+		before, ok := index[dependency.Before]
+		if !ok {
+			continue
+		}
+		after, ok := index[dependency.After]
+		if !ok {
+			continue
+		}
+		successors[before] = append(successors[before], after)
+		inDegree[after]++
+	}
+
+	queue := make([]int, 0, len(issues))
+	for i := range issues {
+		if inDegree[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	start := make([]time.Duration, len(issues))
+	predecessor := make([]int, len(issues))
+	for i := range predecessor {
+		predecessor[i] = -1
+	}
+
+	for visited := 0; visited < len(queue); visited++ {
+		current := queue[visited]
+		finish := start[current] + issues[current].Duration()
+		for _, next := range successors[current] {
+			if predecessor[next] == -1 || finish > start[next] {
+				start[next] = finish
+				predecessor[next] = current
+			}
+			if inDegree[next]--; inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if len(queue) != len(issues) {
+		return nil, ErrIssueDependencyCycle
+	}
+
+	path := &CriticalPath{Steps: make([]CriticalPathStep, 0)}
+	if len(issues) == 0 {
+		return path, nil
+	}
+
+	last := 0
+	for i := range issues {
+		if start[i]+issues[i].Duration() > start[last]+issues[last].Duration() {
+			last = i
+		}
+	}
+
+	for current := last; current != -1; current = predecessor[current] {
+		path.Steps = append(path.Steps, CriticalPathStep{
+			ID:             issues[current].ID,
+			Duration:       issues[current].Duration(),
+			EarliestStart:  start[current],
+			EarliestFinish: start[current] + issues[current].Duration(),
+		})
+	}
+
+	slices.Reverse(path.Steps)
+
+	path.Duration = path.Steps[len(path.Steps)-1].EarliestFinish
+	return path, nil
+}
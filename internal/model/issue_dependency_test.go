@@ -0,0 +1,153 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueRelationKind_IsBlocking(t *testing.T) {
+	tests := []struct {
+		kind         IssueRelationKind
+		isBlocking   bool
+		isDependency bool
+	}{
+		{IssueRelationKindBlockedBy, true, true},
+		{IssueRelationKindBlocks, true, true},
+		{IssueRelationKindDependsOn, true, true},
+		{IssueRelationKindDuplicatedBy, false, false},
+		{IssueRelationKindDuplicates, false, false},
+		{IssueRelationKindRelatedTo, false, false},
+		{IssueRelationKindSubtaskOf, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.isBlocking, tt.kind.IsBlocking())
+			assert.Equal(t, tt.isDependency, tt.kind.IsDependency())
+		})
+	}
+}
+
+func TestNewIssueDependency(t *testing.T) {
+	source := MustNewID(ResourceTypeIssue)
+	target := MustNewID(ResourceTypeIssue)
+
+	tests := []struct {
+		kind   IssueRelationKind
+		want   IssueDependency
+		wantOK bool
+	}{
+		{IssueRelationKindBlocks, IssueDependency{Before: source, After: target}, true},
+		{IssueRelationKindBlockedBy, IssueDependency{Before: target, After: source}, true},
+		{IssueRelationKindDependsOn, IssueDependency{Before: target, After: source}, true},
+		{IssueRelationKindSubtaskOf, IssueDependency{}, false},
+		{IssueRelationKindRelatedTo, IssueDependency{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			t.Parallel()
+			got, ok := NewIssueDependency(source, target, tt.kind)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScheduledIssue_Duration(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := start.Add(48 * time.Hour)
+
+	tests := []struct {
+		name  string
+		issue ScheduledIssue
+		want  time.Duration
+	}{
+		{"scheduled issue", ScheduledIssue{StartDate: &start, DueDate: &due}, 48 * time.Hour},
+		{"issue without start date", ScheduledIssue{DueDate: &due}, 0},
+		{"issue without due date", ScheduledIssue{StartDate: &start}, 0},
+		{"issue due before start", ScheduledIssue{StartDate: &due, DueDate: &start}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.issue.Duration())
+		})
+	}
+}
+
+func TestNewCriticalPath(t *testing.T) {
+	day := 24 * time.Hour
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduled := func(days int) ScheduledIssue {
+		due := start.Add(time.Duration(days) * day)
+		return ScheduledIssue{ID: MustNewID(ResourceTypeIssue), StartDate: &start, DueDate: &due}
+	}
+
+	t.Run("longest chain of issues", func(t *testing.T) {
+		t.Parallel()
+
+		design, build, test, docs, release := scheduled(2), scheduled(5), scheduled(3), scheduled(1), scheduled(1)
+		unscheduled := ScheduledIssue{ID: MustNewID(ResourceTypeIssue)}
+
+		got, err := NewCriticalPath(
+			[]ScheduledIssue{release, design, build, test, docs, unscheduled},
+			[]IssueDependency{
+				{Before: design.ID, After: build.ID},
+				{Before: design.ID, After: docs.ID},
+				{Before: build.ID, After: test.ID},
+				{Before: docs.ID, After: release.ID},
+				{Before: test.ID, After: release.ID},
+				{Before: unscheduled.ID, After: design.ID},
+				{Before: MustNewID(ResourceTypeIssue), After: design.ID},
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, &CriticalPath{
+			Steps: []CriticalPathStep{
+				{ID: unscheduled.ID, Duration: 0, EarliestStart: 0, EarliestFinish: 0},
+				{ID: design.ID, Duration: 2 * day, EarliestStart: 0, EarliestFinish: 2 * day},
+				{ID: build.ID, Duration: 5 * day, EarliestStart: 2 * day, EarliestFinish: 7 * day},
+				{ID: test.ID, Duration: 3 * day, EarliestStart: 7 * day, EarliestFinish: 10 * day},
+				{ID: release.ID, Duration: day, EarliestStart: 10 * day, EarliestFinish: 11 * day},
+			},
+			Duration: 11 * day,
+		}, got)
+	})
+
+	t.Run("independent issues", func(t *testing.T) {
+		t.Parallel()
+
+		short, long := scheduled(1), scheduled(4)
+
+		got, err := NewCriticalPath([]ScheduledIssue{short, long}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &CriticalPath{
+			Steps:    []CriticalPathStep{{ID: long.ID, Duration: 4 * day, EarliestFinish: 4 * day}},
+			Duration: 4 * day,
+		}, got)
+	})
+
+	t.Run("no issues", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := NewCriticalPath(nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &CriticalPath{Steps: make([]CriticalPathStep, 0)}, got)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		t.Parallel()
+
+		a, b, c := scheduled(1), scheduled(1), scheduled(1)
+
+		_, err := NewCriticalPath([]ScheduledIssue{a, b, c}, []IssueDependency{
+			{Before: a.ID, After: b.ID},
+			{Before: b.ID, After: c.ID},
+			{Before: c.ID, After: a.ID},
+		})
+		assert.ErrorIs(t, err, ErrIssueDependencyCycle)
+	})
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+)
+
+// Category returns the workflow status category the issue status belongs to.
+// Blocked and review issues are still in progress, while closed issues are
+// done.
+func (s IssueStatus) Category() WorkflowStatusCategory {
+	switch s {
+	case IssueStatusInProgress, IssueStatusBlocked, IssueStatusReview:
+		return WorkflowStatusCategoryInProgress
+	case IssueStatusDone, IssueStatusClosed:
+		return WorkflowStatusCategoryDone
+	default:
+		return WorkflowStatusCategoryTodo
+	}
+}
+
+// RollupIssue is a descendant of an issue that contributes to its rollup.
+type RollupIssue struct {
+	Status            IssueStatus `json:"status"`
+	StoryPoints       *float64    `json:"story_points"`
+	OriginalEstimate  *uint       `json:"original_estimate"`
+	RemainingEstimate *uint       `json:"remaining_estimate"`
+	StartDate         *time.Time  `json:"start_date"`
+	DueDate           *time.Time  `json:"due_date"`
+}
+
+// IssueRollup is the progress of an issue aggregated from its subtasks at any
+// depth. The estimate totals and the dates are nil if none of the subtasks
+// has them set. The estimates are in minutes.
+type IssueRollup struct {
+	Todo              int        `json:"todo"`
+	InProgress        int        `json:"in_progress"`
+	Done              int        `json:"done"`
+	StoryPoints       *float64   `json:"story_points"`
+	OriginalEstimate  *uint      `json:"original_estimate"`
+	RemainingEstimate *uint      `json:"remaining_estimate"`
+	StartDate         *time.Time `json:"start_date"`
+	DueDate           *time.Time `json:"due_date"`
+}
+
+// NewIssueRollup aggregates the descendants of an issue into its rollup.
+func NewIssueRollup(issues []RollupIssue) *IssueRollup {
+	rollup := new(IssueRollup)
+	for _, issue := range issues {
+		switch issue.Status.Category() {
+		case WorkflowStatusCategoryInProgress:
+			rollup.InProgress++
+		case WorkflowStatusCategoryDone:
+			rollup.Done++
+		default:
+			rollup.Todo++
+		}
+
+		rollup.StoryPoints = addRollupValue(rollup.StoryPoints, issue.StoryPoints)
+		rollup.OriginalEstimate = addRollupValue(rollup.OriginalEstimate, issue.OriginalEstimate)
+		rollup.RemainingEstimate = addRollupValue(rollup.RemainingEstimate, issue.RemainingEstimate)
+
+		if issue.StartDate != nil && (rollup.StartDate == nil || issue.StartDate.Before(*rollup.StartDate)) {
+			rollup.StartDate = issue.StartDate
+		}
+		if issue.DueDate != nil && (rollup.DueDate == nil || issue.DueDate.After(*rollup.DueDate)) {
+			rollup.DueDate = issue.DueDate
+		}
+	}
+	return rollup
+}
+
+// Total returns the number of subtasks rolled up.
+func (r IssueRollup) Total() int {
+	return r.Todo + r.InProgress + r.Done
+}
+
+// PercentDone returns the percentage of the subtasks that are done. An issue
+// without subtasks is 0 percent done.
+func (r IssueRollup) PercentDone() float64 {
+	if r.Total() == 0 {
+		return 0
+	}
+	return float64(r.Done) * 100 / float64(r.Total())
+}
+
+func addRollupValue[T float64 | uint](total, value *T) *T {
+	if value == nil {
+		return total
+	}
+	sum := *value
+	if total != nil {
+		sum += *total
+	}
+	return &sum
+}
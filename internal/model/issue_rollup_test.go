@@ -0,0 +1,104 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueStatus_Category(t *testing.T) {
+	tests := []struct {
+		status IssueStatus
+		want   WorkflowStatusCategory
+	}{
+		{IssueStatusOpen, WorkflowStatusCategoryTodo},
+		{IssueStatusInProgress, WorkflowStatusCategoryInProgress},
+		{IssueStatusBlocked, WorkflowStatusCategoryInProgress},
+		{IssueStatusReview, WorkflowStatusCategoryInProgress},
+		{IssueStatusDone, WorkflowStatusCategoryDone},
+		{IssueStatusClosed, WorkflowStatusCategoryDone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.status.Category())
+		})
+	}
+}
+
+func TestNewIssueRollup(t *testing.T) {
+	first := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(24 * time.Hour)
+	third := second.Add(24 * time.Hour)
+
+	points := func(v float64) *float64 { return &v }
+	minutes := func(v uint) *uint { return &v }
+
+	tests := []struct {
+		name   string
+		issues []RollupIssue
+		want   *IssueRollup
+	}{
+		{
+			name:   "issue without subtasks",
+			issues: nil,
+			want:   &IssueRollup{},
+		},
+		{
+			name: "subtasks per status category",
+			issues: []RollupIssue{
+				{Status: IssueStatusOpen},
+				{Status: IssueStatusReview},
+				{Status: IssueStatusDone},
+				{Status: IssueStatusClosed},
+			},
+			want: &IssueRollup{Todo: 1, InProgress: 1, Done: 2},
+		},
+		{
+			name: "estimates and dates of the subtasks having them",
+			issues: []RollupIssue{
+				{Status: IssueStatusDone, StoryPoints: points(3), OriginalEstimate: minutes(60), StartDate: &second, DueDate: &second},
+				{Status: IssueStatusInProgress, StoryPoints: points(2.5), RemainingEstimate: minutes(30), StartDate: &first},
+				{Status: IssueStatusOpen, OriginalEstimate: minutes(120), DueDate: &third},
+			},
+			want: &IssueRollup{
+				Todo:              1,
+				InProgress:        1,
+				Done:              1,
+				StoryPoints:       points(5.5),
+				OriginalEstimate:  minutes(180),
+				RemainingEstimate: minutes(30),
+				StartDate:         &first,
+				DueDate:           &third,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NewIssueRollup(tt.issues))
+		})
+	}
+}
+
+func TestIssueRollup_PercentDone(t *testing.T) {
+	tests := []struct {
+		name   string
+		rollup IssueRollup
+		total  int
+		want   float64
+	}{
+		{"no subtasks", IssueRollup{}, 0, 0},
+		{"nothing done", IssueRollup{Todo: 2, InProgress: 1}, 3, 0},
+		{"partly done", IssueRollup{Todo: 1, InProgress: 2, Done: 1}, 4, 25},
+		{"everything done", IssueRollup{Done: 3}, 3, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.total, tt.rollup.Total())
+			assert.Equal(t, tt.want, tt.rollup.PercentDone())
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+// IssueTemplateTitlePlaceholder is replaced in the title patterns of a
+// template by the title given when creating an issue from the template.
+const IssueTemplateTitlePlaceholder = "{title}"
+
+// IssueTemplateTitle returns the title of an issue created from the pattern.
+// An empty pattern keeps the given title.
+func IssueTemplateTitle(pattern, title string) string {
+	if pattern == "" {
+		return title
+	}
+	return strings.ReplaceAll(pattern, IssueTemplateTitlePlaceholder, title)
+}
+
+// IssueTemplateChild is an issue created as a subtask of the issue created
+// from a template.
+type IssueTemplateChild struct {
+	Kind         IssueKind     `json:"kind" validate:"required,min=1,max=4"`
+	TitlePattern string        `json:"title_pattern" validate:"required,min=1,max=120"`
+	Description  string        `json:"description" validate:"omitempty,min=3"`
+	Priority     IssuePriority `json:"priority" validate:"omitempty,min=1,max=5"`
+}
+
+func (c *IssueTemplateChild) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return errors.Join(ErrInvalidIssueTemplateDetails, err)
+	}
+	return nil
+}
+
+// Title returns the title of the child issue for the given title.
+func (c *IssueTemplateChild) Title(title string) string {
+	return IssueTemplateTitle(c.TitlePattern, title)
+}
+
+// IssueTemplate prefills the issues created in a project from it. The title
+// patterns may contain the IssueTemplateTitlePlaceholder, and the children are
+// created as subtasks of the issue.
+type IssueTemplate struct {
+	ID           ID                   `json:"id" validate:"required"`
+	Project      ID                   `json:"project" validate:"required"`
+	Name         string               `json:"name" validate:"required,min=1,max=120"`
+	Kind         IssueKind            `json:"kind" validate:"required,min=1,max=4"`
+	TitlePattern string               `json:"title_pattern" validate:"omitempty,max=120"`
+	Description  string               `json:"description" validate:"omitempty,min=3"`
+	Priority     IssuePriority        `json:"priority" validate:"omitempty,min=1,max=5"`
+	Labels       []ID                 `json:"labels" validate:"max=20"`
+	Children     []IssueTemplateChild `json:"children" validate:"max=50"`
+	CreatedAt    *time.Time           `json:"created_at" validate:"omitempty"`
+	UpdatedAt    *time.Time           `json:"updated_at" validate:"omitempty"`
+}
+
+func (t *IssueTemplate) Validate() error {
+	if err := validate.Struct(t); err != nil {
+		return errors.Join(ErrInvalidIssueTemplateDetails, err)
+	}
+	if err := t.ID.Validate(); err != nil {
+		return errors.Join(ErrInvalidIssueTemplateDetails, err)
+	}
+	if err := t.Project.Validate(); err != nil || t.Project.Type != ResourceTypeProject {
+		return errors.Join(ErrInvalidIssueTemplateDetails, ErrInvalidID)
+	}
+	for _, label := range t.Labels {
+		if err := label.Validate(); err != nil || label.Type != ResourceTypeLabel {
+			return errors.Join(ErrInvalidIssueTemplateDetails, ErrInvalidID)
+		}
+	}
+	for _, child := range t.Children {
+		if err := child.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Title returns the title of the issue created from the template for the
+// given title.
+func (t *IssueTemplate) Title(title string) string {
+	return IssueTemplateTitle(t.TitlePattern, title)
+}
+
+// NewIssueTemplate creates a new IssueTemplate of the given kind in the
+// project.
+func NewIssueTemplate(project ID, name string, kind IssueKind) (*IssueTemplate, error) {
+	template := &IssueTemplate{
+		ID:       MustNewNilID(ResourceTypeIssueTemplate),
+		Project:  project,
+		Name:     name,
+		Kind:     kind,
+		Labels:   make([]ID, 0),
+		Children: make([]IssueTemplateChild, 0),
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,181 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIssueTemplate(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+
+	type args struct {
+		project ID
+		name    string
+		kind    IssueKind
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *IssueTemplate
+		wantErr error
+	}{
+		{
+			name: "create IssueTemplate with valid details",
+			args: args{
+				project: project,
+				name:    "Bug report",
+				kind:    IssueKindBug,
+			},
+			want: &IssueTemplate{
+				ID:       ID{Inner: xid.NilID(), Type: ResourceTypeIssueTemplate},
+				Project:  project,
+				Name:     "Bug report",
+				Kind:     IssueKindBug,
+				Labels:   make([]ID, 0),
+				Children: make([]IssueTemplateChild, 0),
+			},
+		},
+		{
+			name: "create IssueTemplate with empty name",
+			args: args{
+				project: project,
+				kind:    IssueKindBug,
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+		{
+			name: "create IssueTemplate without kind",
+			args: args{
+				project: project,
+				name:    "Bug report",
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+		{
+			name: "create IssueTemplate with invalid project",
+			args: args{
+				project: MustNewID(ResourceTypeNamespace),
+				name:    "Bug report",
+				kind:    IssueKindBug,
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewIssueTemplate(tt.args.project, tt.args.name, tt.args.kind)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIssueTemplate_Validate(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+	label := MustNewID(ResourceTypeLabel)
+
+	tests := []struct {
+		name     string
+		template IssueTemplate
+		wantErr  error
+	}{
+		{
+			name: "validate IssueTemplate with valid details",
+			template: IssueTemplate{
+				ID:           MustNewID(ResourceTypeIssueTemplate),
+				Project:      project,
+				Name:         "Release",
+				Kind:         IssueKindEpic,
+				TitlePattern: "Release {title}",
+				Description:  "Ship the release",
+				Priority:     IssuePriorityHigh,
+				Labels:       []ID{label},
+				Children: []IssueTemplateChild{
+					{Kind: IssueKindTask, TitlePattern: "Changelog for {title}"},
+				},
+			},
+		},
+		{
+			name: "validate IssueTemplate with invalid label",
+			template: IssueTemplate{
+				ID:      MustNewID(ResourceTypeIssueTemplate),
+				Project: project,
+				Name:    "Release",
+				Kind:    IssueKindEpic,
+				Labels:  []ID{MustNewID(ResourceTypeComponent)},
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+		{
+			name: "validate IssueTemplate with too long title pattern",
+			template: IssueTemplate{
+				ID:           MustNewID(ResourceTypeIssueTemplate),
+				Project:      project,
+				Name:         "Release",
+				Kind:         IssueKindEpic,
+				TitlePattern: strings.Repeat("a", 121),
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+		{
+			name: "validate IssueTemplate with child without title pattern",
+			template: IssueTemplate{
+				ID:       MustNewID(ResourceTypeIssueTemplate),
+				Project:  project,
+				Name:     "Release",
+				Kind:     IssueKindEpic,
+				Children: []IssueTemplateChild{{Kind: IssueKindTask}},
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+		{
+			name: "validate IssueTemplate with child of invalid kind",
+			template: IssueTemplate{
+				ID:       MustNewID(ResourceTypeIssueTemplate),
+				Project:  project,
+				Name:     "Release",
+				Kind:     IssueKindEpic,
+				Children: []IssueTemplateChild{{Kind: IssueKind(9), TitlePattern: "Changelog"}},
+			},
+			wantErr: ErrInvalidIssueTemplateDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, tt.template.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestIssueTemplate_Title(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		title   string
+		want    string
+	}{
+		{"title without pattern", "", "Login fails", "Login fails"},
+		{"title with placeholder", "Bug: {title}", "Login fails", "Bug: Login fails"},
+		{"title with repeated placeholder", "{title} / {title}", "Login", "Login / Login"},
+		{"title without placeholder", "Weekly report", "Login fails", "Weekly report"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			template := &IssueTemplate{TitlePattern: tt.pattern}
+			assert.Equal(t, tt.want, template.Title(tt.title))
+
+			child := &IssueTemplateChild{TitlePattern: tt.pattern}
+			assert.Equal(t, tt.want, child.Title(tt.title))
+		})
+	}
+}
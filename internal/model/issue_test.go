@@ -7,6 +7,8 @@ import (
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/opcotech/elemo/internal/pkg/convert"
 )
 
 func TestIssueKind_String(t *testing.T) {
@@ -655,6 +657,7 @@ func TestIssue_Validate(t *testing.T) {
 		Assignees   []ID
 		Labels      []ID
 		Links       []IssueLink
+		StoryPoints *float64
 		DueDate     *time.Time
 		CreatedAt   *time.Time
 		UpdatedAt   *time.Time
@@ -903,6 +906,25 @@ func TestIssue_Validate(t *testing.T) {
 			},
 			wantErr: ErrInvalidIssueDetails,
 		},
+		{
+			name: "invalid issue story points",
+			fields: fields{
+				ID:          ID{Inner: xid.NilID(), Type: ResourceTypeIssue},
+				NumericID:   1,
+				Kind:        IssueKindStory,
+				Title:       "title",
+				Description: "description",
+				Status:      IssueStatusOpen,
+				Priority:    IssuePriorityNormal,
+				Resolution:  IssueResolutionNone,
+				ReportedBy:  ID{Inner: xid.NilID(), Type: ResourceTypeUser},
+				Assignees:   make([]ID, 0),
+				Labels:      make([]ID, 0),
+				Links:       make([]IssueLink, 0),
+				StoryPoints: convert.ToPointer(-1.0),
+			},
+			wantErr: ErrInvalidIssueDetails,
+		},
 		{
 			name: "invalid issue link label",
 			fields: fields{
@@ -942,6 +964,7 @@ func TestIssue_Validate(t *testing.T) {
 				Assignees:   tt.fields.Assignees,
 				Labels:      tt.fields.Labels,
 				Links:       tt.fields.Links,
+				StoryPoints: tt.fields.StoryPoints,
 				DueDate:     tt.fields.DueDate,
 				CreatedAt:   tt.fields.CreatedAt,
 				UpdatedAt:   tt.fields.UpdatedAt,
@@ -8,12 +8,13 @@ import (
 )
 
 // Label is an entity that can be attached to a resource to provide additional
-// information about it. For example, a Type can be used to indicate the
+// information about it. For example, a label can be used to indicate the
 // environment a resource belongs to.
 type Label struct {
 	ID          ID         `json:"id" validate:"required"`
 	Name        string     `json:"name" validate:"required,min=3,max=120"`
 	Description string     `json:"description" validate:"omitempty,min=5,max=500"`
+	Color       string     `json:"color" validate:"omitempty,hexcolor"`
 	CreatedAt   *time.Time `json:"created_at" validate:"omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at" validate:"omitempty"`
 }
@@ -62,6 +62,7 @@ func TestLabel_Validate(t *testing.T) {
 		ID          ID
 		Name        string
 		Description string
+		Color       string
 	}
 	tests := []struct {
 		name    string
@@ -100,6 +101,23 @@ func TestLabel_Validate(t *testing.T) {
 			},
 			wantErr: ErrInvalidLabelDetails,
 		},
+		{
+			name: "validate Type with color",
+			fields: fields{
+				ID:    ID{Inner: xid.NilID(), Type: ResourceTypeLabel},
+				Name:  "test",
+				Color: "#1f6feb",
+			},
+		},
+		{
+			name: "validate Type with invalid color",
+			fields: fields{
+				ID:    ID{Inner: xid.NilID(), Type: ResourceTypeLabel},
+				Name:  "test",
+				Color: "blue",
+			},
+			wantErr: ErrInvalidLabelDetails,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -109,6 +127,7 @@ func TestLabel_Validate(t *testing.T) {
 				ID:          tt.fields.ID,
 				Name:        tt.fields.Name,
 				Description: tt.fields.Description,
+				Color:       tt.fields.Color,
 			}
 			err := l.Validate()
 			require.ErrorIs(t, err, tt.wantErr)
@@ -0,0 +1,388 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+const (
+	maxRecurrenceRuleLength = 500
+	maxRecurrenceInterval   = 99
+	maxRecurrenceCatchUp    = 10000
+
+	// The rules are searched day by day for at most this many periods, which
+	// covers the leap days of yearly rules.
+	recurrenceSearchYears = 8
+)
+
+var (
+	rruleWeekdays = map[string]time.Weekday{
+		"MO": time.Monday,
+		"TU": time.Tuesday,
+		"WE": time.Wednesday,
+		"TH": time.Thursday,
+		"FR": time.Friday,
+		"SA": time.Saturday,
+		"SU": time.Sunday,
+	}
+)
+
+// RecurrenceFrequency is the frequency of an RRULE recurrence rule.
+type RecurrenceFrequency string
+
+// recurrenceSchedule returns the next occurrence of a rule after the given
+// time, or the zero time if the rule has no more occurrences.
+type recurrenceSchedule interface {
+	Next(after time.Time) time.Time
+}
+
+// RecurrenceRule is a parsed recurrence rule. The rule is either a standard
+// five-field cron expression, including the descriptors like "@weekly", or an
+// RFC 5545 RRULE like "RRULE:FREQ=WEEKLY;BYDAY=MO;BYHOUR=9". The occurrences
+// are in UTC and never precede the start of the rule.
+//
+// The supported RRULE parts are FREQ (DAILY, WEEKLY, MONTHLY or YEARLY),
+// INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, BYHOUR, BYMINUTE and UNTIL. The parts
+// not given default to the start of the rule, so a weekly rule recurs on the
+// weekday and at the time of day the rule starts. Ordinal weekdays, like
+// "-1FR" for the last Friday, are supported by monthly rules only.
+type RecurrenceRule struct {
+	rule     string
+	start    time.Time
+	schedule recurrenceSchedule
+}
+
+// String returns the rule as given.
+func (r *RecurrenceRule) String() string {
+	return r.rule
+}
+
+// Start returns the start of the rule.
+func (r *RecurrenceRule) Start() time.Time {
+	return r.start
+}
+
+// Next returns the first occurrence after the given time. The zero time is
+// returned if the rule has no more occurrences.
+func (r *RecurrenceRule) Next(after time.Time) time.Time {
+	after = after.UTC()
+	if after.Before(r.start) {
+		after = r.start.Add(-time.Second)
+	}
+	return r.schedule.Next(after)
+}
+
+// Latest returns the latest occurrence that is not after now, starting from
+// the occurrence given. The occurrence given is returned if the next
+// occurrence is after now. Missed occurrences are skipped this way, though at
+// most a bounded number of them per call.
+func (r *RecurrenceRule) Latest(from, now time.Time) time.Time {
+	latest := from
+	for i := 0; i < maxRecurrenceCatchUp; i++ {
+		next := r.Next(latest)
+		if next.IsZero() || next.After(now) {
+			break
+		}
+		latest = next
+	}
+	return latest
+}
+
+// ParseRecurrenceRule parses the cron expression or RRULE starting at the
+// given time. The rule must have at least one occurrence.
+func ParseRecurrenceRule(rule string, start time.Time) (*RecurrenceRule, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" || len(rule) > maxRecurrenceRuleLength {
+		return nil, errors.Join(ErrInvalidIssueRecurrenceDetails, errors.New("invalid rule length"))
+	}
+
+	start = start.UTC().Truncate(time.Second)
+
+	var schedule recurrenceSchedule
+	var err error
+	upper := strings.ToUpper(rule)
+	if strings.HasPrefix(upper, "RRULE:") || strings.HasPrefix(upper, "FREQ=") {
+		schedule, err = parseRRule(strings.TrimPrefix(upper, "RRULE:"), start)
+	} else {
+		schedule, err = cron.ParseStandard(rule)
+	}
+	if err != nil {
+		return nil, errors.Join(ErrInvalidIssueRecurrenceDetails, err)
+	}
+
+	r := &RecurrenceRule{rule: rule, start: start, schedule: schedule}
+	if r.Next(start.Add(-time.Second)).IsZero() {
+		return nil, errors.Join(ErrInvalidIssueRecurrenceDetails, errors.New("rule has no occurrences"))
+	}
+
+	return r, nil
+}
+
+// rruleWeekday is a BYDAY value of an RRULE. The ordinal is the nth weekday
+// of the month, counted from the end of the month if negative, or zero for
+// every weekday of the month.
+type rruleWeekday struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+// rrule is the supported subset of an RFC 5545 RRULE.
+type rrule struct {
+	start      time.Time
+	freq       RecurrenceFrequency
+	interval   int
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byMonth    []time.Month
+	times      []time.Duration
+	until      *time.Time
+}
+
+// Next returns the first occurrence after the given time by searching the
+// days following it.
+func (r *rrule) Next(after time.Time) time.Time {
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC)
+	last := day.AddDate(recurrenceSearchYears*r.interval, 0, 0)
+
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if r.until != nil && day.After(*r.until) {
+			break
+		}
+		if !r.matchesDay(day) {
+			continue
+		}
+		for _, offset := range r.times {
+			occurrence := day.Add(offset)
+			if r.until != nil && occurrence.After(*r.until) {
+				return time.Time{}
+			}
+			if occurrence.After(after) && !occurrence.Before(r.start) {
+				return occurrence
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchesDay reports whether the rule recurs on the day.
+func (r *rrule) matchesDay(day time.Time) bool {
+	if r.period(day)%r.interval != 0 {
+		return false
+	}
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, day.Month()) {
+		return false
+	}
+	if len(r.byMonthDay) > 0 && !slices.ContainsFunc(r.byMonthDay, func(d int) bool { return monthDayMatches(day, d) }) {
+		return false
+	}
+	if len(r.byDay) > 0 && !slices.ContainsFunc(r.byDay, func(d rruleWeekday) bool { return weekdayMatches(day, d) }) {
+		return false
+	}
+	return true
+}
+
+// period returns the number of periods of the rule frequency between the
+// start of the rule and the day. The weeks start on Monday.
+func (r *rrule) period(day time.Time) int {
+	start := time.Date(r.start.Year(), r.start.Month(), r.start.Day(), 0, 0, 0, 0, time.UTC)
+	switch r.freq {
+	case RecurrenceFrequencyWeekly:
+		monday := func(t time.Time) time.Time {
+			return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+		}
+		return int(monday(day).Sub(monday(start)).Hours()/24) / 7
+	case RecurrenceFrequencyMonthly:
+		return (day.Year()-start.Year())*12 + int(day.Month()) - int(start.Month())
+	case RecurrenceFrequencyYearly:
+		return day.Year() - start.Year()
+	default:
+		return int(day.Sub(start).Hours() / 24)
+	}
+}
+
+func monthDayMatches(day time.Time, monthDay int) bool {
+	if monthDay > 0 {
+		return day.Day() == monthDay
+	}
+	days := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return day.Day() == days+monthDay+1
+}
+
+func weekdayMatches(day time.Time, weekday rruleWeekday) bool {
+	if day.Weekday() != weekday.weekday {
+		return false
+	}
+	if weekday.ordinal > 0 {
+		return (day.Day()-1)/7+1 == weekday.ordinal
+	}
+	if weekday.ordinal < 0 {
+		days := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		return (days-day.Day())/7+1 == -weekday.ordinal
+	}
+	return true
+}
+
+// parseRRule parses the parts of an RRULE, defaulting the parts not given to
+// the start of the rule.
+func parseRRule(value string, start time.Time) (*rrule, error) {
+	r := &rrule{start: start, interval: 1}
+	var hours, minutes []int
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate rule part %q", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.freq = RecurrenceFrequency(value)
+			switch r.freq {
+			case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyYearly:
+			default:
+				err = fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err == nil && (r.interval < 1 || r.interval > maxRecurrenceInterval) {
+				err = fmt.Errorf("invalid interval %d", r.interval)
+			}
+		case "BYDAY":
+			r.byDay, err = parseRRuleWeekdays(value)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRuleInts(value, -31, 31, false)
+		case "BYMONTH":
+			var months []int
+			if months, err = parseRRuleInts(value, 1, 12, false); err == nil {
+				for _, month := range months {
+					r.byMonth = append(r.byMonth, time.Month(month))
+				}
+			}
+		case "BYHOUR":
+			hours, err = parseRRuleInts(value, 0, 23, true)
+		case "BYMINUTE":
+			minutes, err = parseRRuleInts(value, 0, 59, true)
+		case "UNTIL":
+			r.until, err = parseRRuleUntil(value)
+		default:
+			err = fmt.Errorf("unsupported rule part %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.freq == "" {
+		return nil, errors.New("missing frequency")
+	}
+	if r.freq == RecurrenceFrequencyWeekly && len(r.byMonthDay) > 0 {
+		return nil, errors.New("BYMONTHDAY is not supported by weekly rules")
+	}
+	for _, d := range r.byDay {
+		if d.ordinal != 0 && r.freq != RecurrenceFrequencyMonthly {
+			return nil, errors.New("ordinal weekdays are supported by monthly rules only")
+		}
+	}
+
+	// The parts not given default to the start of the rule.
+	switch {
+	case r.freq == RecurrenceFrequencyWeekly && len(r.byDay) == 0:
+		r.byDay = []rruleWeekday{{weekday: start.Weekday()}}
+	case r.freq == RecurrenceFrequencyMonthly && len(r.byDay) == 0 && len(r.byMonthDay) == 0:
+		r.byMonthDay = []int{start.Day()}
+	case r.freq == RecurrenceFrequencyYearly && len(r.byDay) == 0 && len(r.byMonthDay) == 0:
+		r.byMonthDay = []int{start.Day()}
+		if len(r.byMonth) == 0 {
+			r.byMonth = []time.Month{start.Month()}
+		}
+	}
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			r.times = append(r.times, time.Duration(hour)*time.Hour+time.Duration(minute)*time.Minute+time.Duration(start.Second())*time.Second)
+		}
+	}
+	slices.Sort(r.times)
+
+	return r, nil
+}
+
+func parseRRuleInts(value string, lower, upper int, zero bool) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+		if v < lower || v > upper || (v == 0 && !zero) {
+			return nil, fmt.Errorf("value %d out of range", v)
+		}
+		if !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+func parseRRuleWeekdays(value string) ([]rruleWeekday, error) {
+	var weekdays []rruleWeekday
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		weekday, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+
+		d := rruleWeekday{weekday: weekday}
+		if ordinal := item[:len(item)-2]; ordinal != "" {
+			var err error
+			if d.ordinal, err = strconv.Atoi(ordinal); err != nil || d.ordinal == 0 || d.ordinal < -5 || d.ordinal > 5 {
+				return nil, fmt.Errorf("invalid weekday %q", item)
+			}
+		}
+		weekdays = append(weekdays, d)
+	}
+	return weekdays, nil
+}
+
+// parseRRuleUntil parses the UNTIL part, which is either a UTC date-time or a
+// date including the whole day.
+func parseRRuleUntil(value string) (*time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return &until, nil
+	}
+	until, err := time.Parse("20060102", value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid until %q", value)
+	}
+	until = until.Add(24*time.Hour - time.Second)
+	return &until, nil
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr error
+	}{
+		{name: "cron expression", rule: "0 9 * * 1"},
+		{name: "cron descriptor", rule: "@weekly"},
+		{name: "rrule", rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,TH;BYHOUR=9;BYMINUTE=30"},
+		{name: "rrule without prefix", rule: "FREQ=MONTHLY;BYDAY=-1FR"},
+		{name: "empty rule", rule: " ", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "invalid cron expression", rule: "0 25 * * *", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "missing frequency", rule: "RRULE:BYDAY=MO", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "unsupported frequency", rule: "FREQ=HOURLY", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "unsupported part", rule: "FREQ=DAILY;COUNT=3", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "duplicate part", rule: "FREQ=DAILY;FREQ=WEEKLY", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "invalid interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "invalid weekday", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "ordinal weekday of weekly rule", rule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "month day of weekly rule", rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "no occurrences", rule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", wantErr: ErrInvalidIssueRecurrenceDetails},
+		{name: "ended before start", rule: "FREQ=DAILY;UNTIL=20260101", wantErr: ErrInvalidIssueRecurrenceDetails},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := ParseRecurrenceRule(tt.rule, start)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.rule, rule.String())
+			assert.Equal(t, start, rule.Start())
+		})
+	}
+}
+
+func TestRecurrenceRule_Next(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-05 is a Monday.
+	start := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		after time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "cron expression starts at the start",
+			rule:  "0 9 * * 1",
+			after: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:  []time.Time{at(10, 5, 9, 0), at(10, 12, 9, 0), at(10, 19, 9, 0)},
+		},
+		{
+			name:  "cron descriptor",
+			rule:  "@monthly",
+			after: start,
+			want:  []time.Time{at(11, 1, 0, 0), at(12, 1, 0, 0)},
+		},
+		{
+			name:  "daily rule with interval",
+			rule:  "RRULE:FREQ=DAILY;INTERVAL=3",
+			after: start,
+			want:  []time.Time{at(10, 8, 9, 0), at(10, 11, 9, 0)},
+		},
+		{
+			name:  "weekly rule defaults to the start weekday and time",
+			rule:  "FREQ=WEEKLY",
+			after: start.Add(-time.Minute),
+			want:  []time.Time{at(10, 5, 9, 0), at(10, 12, 9, 0)},
+		},
+		{
+			name:  "biweekly rule on several days and times",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,FR;BYHOUR=8,16;BYMINUTE=15",
+			after: start,
+			want:  []time.Time{at(10, 6, 8, 15), at(10, 6, 16, 15), at(10, 9, 8, 15), at(10, 9, 16, 15), at(10, 20, 8, 15)},
+		},
+		{
+			name:  "monthly rule defaults to the start day",
+			rule:  "FREQ=MONTHLY",
+			after: start,
+			want:  []time.Time{at(11, 5, 9, 0), at(12, 5, 9, 0)},
+		},
+		{
+			name:  "monthly rule on the last day of month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1;BYHOUR=17;BYMINUTE=0",
+			after: start,
+			want:  []time.Time{at(10, 31, 17, 0), at(11, 30, 17, 0), at(12, 31, 17, 0)},
+		},
+		{
+			name:  "monthly rule on ordinal weekdays",
+			rule:  "FREQ=MONTHLY;BYDAY=1MO,-1FR",
+			after: start,
+			want:  []time.Time{at(10, 30, 9, 0), at(11, 2, 9, 0), at(11, 27, 9, 0), at(12, 7, 9, 0)},
+		},
+		{
+			name:  "quarterly rule",
+			rule:  "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=1",
+			after: start,
+			want:  []time.Time{time.Date(2027, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2027, 4, 1, 9, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:  "yearly rule defaults to the start date",
+			rule:  "FREQ=YEARLY",
+			after: start,
+			want:  []time.Time{time.Date(2027, 10, 5, 9, 0, 0, 0, time.UTC), time.Date(2028, 10, 5, 9, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:  "rule ends at until",
+			rule:  "FREQ=DAILY;UNTIL=20261007",
+			after: start,
+			want:  []time.Time{at(10, 6, 9, 0), at(10, 7, 9, 0), {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := ParseRecurrenceRule(tt.rule, start)
+			require.NoError(t, err)
+
+			after := tt.after
+			for _, want := range tt.want {
+				next := rule.Next(after)
+				assert.Equal(t, want, next)
+				after = next
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Latest(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY", start)
+	require.NoError(t, err)
+
+	assert.Equal(t, start, rule.Latest(start, start.Add(24*time.Hour)))
+	assert.Equal(t, start.AddDate(0, 0, 21), rule.Latest(start, start.AddDate(0, 0, 25)))
+	assert.Equal(t, start.AddDate(0, 0, 21), rule.Latest(start, start.AddDate(0, 0, 21)))
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	ReleaseStatusPlanned  ReleaseStatus = iota + 1 // planned
+	ReleaseStatusReleased                          // released
+	ReleaseStatusArchived                          // archived
+)
+
+// ReleaseStatus represents the status of a release.
+//
+//go:generate go tool enumer -type=ReleaseStatus -text -transform=noop -linecomment -output=release_status_gen.go
+type ReleaseStatus uint8
+
+// Release is a version of a project that issues are fixed in.
+type Release struct {
+	ID          ID            `json:"id" validate:"required"`
+	Project     ID            `json:"project" validate:"required"`
+	Name        string        `json:"name" validate:"required,min=1,max=120"`
+	Description string        `json:"description" validate:"omitempty,max=2000"`
+	Status      ReleaseStatus `json:"status" validate:"required,min=1,max=3"`
+	TargetDate  *time.Time    `json:"target_date" validate:"omitempty"`
+	ReleasedAt  *time.Time    `json:"released_at" validate:"omitempty"`
+	CreatedAt   *time.Time    `json:"created_at" validate:"omitempty"`
+	UpdatedAt   *time.Time    `json:"updated_at" validate:"omitempty"`
+}
+
+func (r *Release) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return errors.Join(ErrInvalidReleaseDetails, err)
+	}
+	if err := r.ID.Validate(); err != nil {
+		return errors.Join(ErrInvalidReleaseDetails, err)
+	}
+	if err := r.Project.Validate(); err != nil || r.Project.Type != ResourceTypeProject {
+		return errors.Join(ErrInvalidReleaseDetails, ErrInvalidID)
+	}
+	return nil
+}
+
+// NewRelease creates a new planned Release in the project.
+func NewRelease(project ID, name string) (*Release, error) {
+	release := &Release{
+		ID:      MustNewNilID(ResourceTypeRelease),
+		Project: project,
+		Name:    name,
+		Status:  ReleaseStatusPlanned,
+	}
+
+	if err := release.Validate(); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
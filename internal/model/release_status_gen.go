@@ -0,0 +1,95 @@
+// Code generated by "enumer -type=ReleaseStatus -text -transform=noop -linecomment -output=release_status_gen.go"; DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _ReleaseStatusName = "plannedreleasedarchived"
+
+var _ReleaseStatusIndex = [...]uint8{0, 7, 15, 23}
+
+const _ReleaseStatusLowerName = "plannedreleasedarchived"
+
+func (i ReleaseStatus) String() string {
+	i -= 1
+	if i >= ReleaseStatus(len(_ReleaseStatusIndex)-1) {
+		return fmt.Sprintf("ReleaseStatus(%d)", i+1)
+	}
+	return _ReleaseStatusName[_ReleaseStatusIndex[i]:_ReleaseStatusIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ReleaseStatusNoOp() {
+	var x [1]struct{}
+	_ = x[ReleaseStatusPlanned-(1)]
+	_ = x[ReleaseStatusReleased-(2)]
+	_ = x[ReleaseStatusArchived-(3)]
+}
+
+var _ReleaseStatusValues = []ReleaseStatus{ReleaseStatusPlanned, ReleaseStatusReleased, ReleaseStatusArchived}
+
+var _ReleaseStatusNameToValueMap = map[string]ReleaseStatus{
+	_ReleaseStatusName[0:7]:        ReleaseStatusPlanned,
+	_ReleaseStatusLowerName[0:7]:   ReleaseStatusPlanned,
+	_ReleaseStatusName[7:15]:       ReleaseStatusReleased,
+	_ReleaseStatusLowerName[7:15]:  ReleaseStatusReleased,
+	_ReleaseStatusName[15:23]:      ReleaseStatusArchived,
+	_ReleaseStatusLowerName[15:23]: ReleaseStatusArchived,
+}
+
+var _ReleaseStatusNames = []string{
+	_ReleaseStatusName[0:7],
+	_ReleaseStatusName[7:15],
+	_ReleaseStatusName[15:23],
+}
+
+// ReleaseStatusString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ReleaseStatusString(s string) (ReleaseStatus, error) {
+	if val, ok := _ReleaseStatusNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ReleaseStatusNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ReleaseStatus values", s)
+}
+
+// ReleaseStatusValues returns all values of the enum
+func ReleaseStatusValues() []ReleaseStatus {
+	return _ReleaseStatusValues
+}
+
+// ReleaseStatusStrings returns a slice of all String values of the enum
+func ReleaseStatusStrings() []string {
+	strs := make([]string, len(_ReleaseStatusNames))
+	copy(strs, _ReleaseStatusNames)
+	return strs
+}
+
+// IsAReleaseStatus returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ReleaseStatus) IsAReleaseStatus() bool {
+	for _, v := range _ReleaseStatusValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for ReleaseStatus
+func (i ReleaseStatus) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for ReleaseStatus
+func (i *ReleaseStatus) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ReleaseStatusString(string(text))
+	return err
+}
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseStatus_String(t *testing.T) {
+	tests := []struct {
+		name string
+		s    ReleaseStatus
+		want string
+	}{
+		{"planned", ReleaseStatusPlanned, "planned"},
+		{"released", ReleaseStatusReleased, "released"},
+		{"archived", ReleaseStatusArchived, "archived"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.s.String())
+		})
+	}
+}
+
+func TestReleaseStatus_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    ReleaseStatus
+		wantErr bool
+	}{
+		{"planned", []byte("planned"), ReleaseStatusPlanned, false},
+		{"released", []byte("released"), ReleaseStatusReleased, false},
+		{"archived", []byte("archived"), ReleaseStatusArchived, false},
+		{"status invalid", []byte("shipped"), ReleaseStatus(0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var s ReleaseStatus
+			err := s.UnmarshalText(tt.text)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s)
+		})
+	}
+}
+
+func TestNewRelease(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+
+	type args struct {
+		project ID
+		name    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Release
+		wantErr error
+	}{
+		{
+			name: "create Release with valid details",
+			args: args{
+				project: project,
+				name:    "v1.2.0",
+			},
+			want: &Release{
+				ID:      ID{Inner: xid.NilID(), Type: ResourceTypeRelease},
+				Project: project,
+				Name:    "v1.2.0",
+				Status:  ReleaseStatusPlanned,
+			},
+		},
+		{
+			name: "create Release with empty name",
+			args: args{
+				project: project,
+				name:    "",
+			},
+			wantErr: ErrInvalidReleaseDetails,
+		},
+		{
+			name: "create Release with invalid project",
+			args: args{
+				project: MustNewID(ResourceTypeNamespace),
+				name:    "v1.2.0",
+			},
+			wantErr: ErrInvalidReleaseDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewRelease(tt.args.project, tt.args.name)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRelease_Validate(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+
+	tests := []struct {
+		name    string
+		release Release
+		wantErr error
+	}{
+		{
+			name: "validate Release with valid details",
+			release: Release{
+				ID:      MustNewID(ResourceTypeRelease),
+				Project: project,
+				Name:    "v1.2.0",
+				Status:  ReleaseStatusReleased,
+			},
+		},
+		{
+			name: "validate Release with invalid status",
+			release: Release{
+				ID:      MustNewID(ResourceTypeRelease),
+				Project: project,
+				Name:    "v1.2.0",
+				Status:  ReleaseStatus(4),
+			},
+			wantErr: ErrInvalidReleaseDetails,
+		},
+		{
+			name: "validate Release with invalid ID",
+			release: Release{
+				ID:      ID{},
+				Project: project,
+				Name:    "v1.2.0",
+				Status:  ReleaseStatusPlanned,
+			},
+			wantErr: ErrInvalidReleaseDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, tt.release.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -6,26 +6,36 @@ import (
 )
 
 const (
-	ResourceTypeKind          ResourceType = iota + 1 // ResourceType
-	ResourceTypeAssignment                            // Assignment
-	ResourceTypeAttachment                            // Attachment
-	ResourceTypeComment                               // Comment
-	ResourceTypeDocument                              // Document
-	ResourceTypeIssue                                 // Issue
-	ResourceTypeIssueRelation                         // IssueRelation
-	ResourceTypeLabel                                 // Label
-	ResourceTypeNamespace                             // Namespace
-	ResourceTypeNotification                          // Notification
-	ResourceTypeOrganization                          // Organization
-	ResourceTypePermission                            // Permission
-	ResourceTypeProject                               // Project
-	ResourceTypeRole                                  // Role
-	ResourceTypeTodo                                  // Todo
-	ResourceTypeUser                                  // User
-	ResourceTypeUserToken                             // UserToken
-	ResourceTypeFolder                                // Folder
-	ResourceTypeInstallation                          // Installation
-	ResourceTypeTeam                                  // Team
+	ResourceTypeKind              ResourceType = iota + 1 // ResourceType
+	ResourceTypeAssignment                                // Assignment
+	ResourceTypeAttachment                                // Attachment
+	ResourceTypeComment                                   // Comment
+	ResourceTypeDocument                                  // Document
+	ResourceTypeIssue                                     // Issue
+	ResourceTypeIssueRelation                             // IssueRelation
+	ResourceTypeLabel                                     // Label
+	ResourceTypeNamespace                                 // Namespace
+	ResourceTypeNotification                              // Notification
+	ResourceTypeOrganization                              // Organization
+	ResourceTypePermission                                // Permission
+	ResourceTypeProject                                   // Project
+	ResourceTypeRole                                      // Role
+	ResourceTypeTodo                                      // Todo
+	ResourceTypeUser                                      // User
+	ResourceTypeUserToken                                 // UserToken
+	ResourceTypeFolder                                    // Folder
+	ResourceTypeInstallation                              // Installation
+	ResourceTypeTeam                                      // Team
+	ResourceTypeWebhook                                   // Webhook
+	ResourceTypeWebhookDelivery                           // WebhookDelivery
+	ResourceTypeIssueActivity                             // IssueActivity
+	ResourceTypeComponent                                 // Component
+	ResourceTypeRelease                                   // Release
+	ResourceTypeSprint                                    // Sprint
+	ResourceTypeSprintScopeChange                         // SprintScopeChange
+	ResourceTypeWorkLog                                   // WorkLog
+	ResourceTypeIssueTemplate                             // IssueTemplate
+	ResourceTypeView                                      // View
 )
 
 // ResourceType is the type of resource that is being managed in the system.
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ResourceTypeName = "ResourceTypeAssignmentAttachmentCommentDocumentIssueIssueRelationLabelNamespaceNotificationOrganizationPermissionProjectRoleTodoUserUserTokenFolderInstallationTeam"
+const _ResourceTypeName = "ResourceTypeAssignmentAttachmentCommentDocumentIssueIssueRelationLabelNamespaceNotificationOrganizationPermissionProjectRoleTodoUserUserTokenFolderInstallationTeamWebhookWebhookDeliveryIssueActivityComponentReleaseSprintSprintScopeChangeWorkLogIssueTemplateView"
 
-var _ResourceTypeIndex = [...]uint8{0, 12, 22, 32, 39, 47, 52, 65, 70, 79, 91, 103, 113, 120, 124, 128, 132, 141, 147, 159, 163}
+var _ResourceTypeIndex = [...]uint16{0, 12, 22, 32, 39, 47, 52, 65, 70, 79, 91, 103, 113, 120, 124, 128, 132, 141, 147, 159, 163, 170, 185, 198, 207, 214, 220, 237, 244, 257, 261}
 
-const _ResourceTypeLowerName = "resourcetypeassignmentattachmentcommentdocumentissueissuerelationlabelnamespacenotificationorganizationpermissionprojectroletodouserusertokenfolderinstallationteam"
+const _ResourceTypeLowerName = "resourcetypeassignmentattachmentcommentdocumentissueissuerelationlabelnamespacenotificationorganizationpermissionprojectroletodouserusertokenfolderinstallationteamwebhookwebhookdeliveryissueactivitycomponentreleasesprintsprintscopechangeworklogissuetemplateview"
 
 func (i ResourceType) String() string {
 	i -= 1
@@ -45,9 +45,19 @@ func _ResourceTypeNoOp() {
 	_ = x[ResourceTypeFolder-(18)]
 	_ = x[ResourceTypeInstallation-(19)]
 	_ = x[ResourceTypeTeam-(20)]
+	_ = x[ResourceTypeWebhook-(21)]
+	_ = x[ResourceTypeWebhookDelivery-(22)]
+	_ = x[ResourceTypeIssueActivity-(23)]
+	_ = x[ResourceTypeComponent-(24)]
+	_ = x[ResourceTypeRelease-(25)]
+	_ = x[ResourceTypeSprint-(26)]
+	_ = x[ResourceTypeSprintScopeChange-(27)]
+	_ = x[ResourceTypeWorkLog-(28)]
+	_ = x[ResourceTypeIssueTemplate-(29)]
+	_ = x[ResourceTypeView-(30)]
 }
 
-var _ResourceTypeValues = []ResourceType{ResourceTypeKind, ResourceTypeAssignment, ResourceTypeAttachment, ResourceTypeComment, ResourceTypeDocument, ResourceTypeIssue, ResourceTypeIssueRelation, ResourceTypeLabel, ResourceTypeNamespace, ResourceTypeNotification, ResourceTypeOrganization, ResourceTypePermission, ResourceTypeProject, ResourceTypeRole, ResourceTypeTodo, ResourceTypeUser, ResourceTypeUserToken, ResourceTypeFolder, ResourceTypeInstallation, ResourceTypeTeam}
+var _ResourceTypeValues = []ResourceType{ResourceTypeKind, ResourceTypeAssignment, ResourceTypeAttachment, ResourceTypeComment, ResourceTypeDocument, ResourceTypeIssue, ResourceTypeIssueRelation, ResourceTypeLabel, ResourceTypeNamespace, ResourceTypeNotification, ResourceTypeOrganization, ResourceTypePermission, ResourceTypeProject, ResourceTypeRole, ResourceTypeTodo, ResourceTypeUser, ResourceTypeUserToken, ResourceTypeFolder, ResourceTypeInstallation, ResourceTypeTeam, ResourceTypeWebhook, ResourceTypeWebhookDelivery, ResourceTypeIssueActivity, ResourceTypeComponent, ResourceTypeRelease, ResourceTypeSprint, ResourceTypeSprintScopeChange, ResourceTypeWorkLog, ResourceTypeIssueTemplate, ResourceTypeView}
 
 var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeName[0:12]:         ResourceTypeKind,
@@ -90,6 +100,26 @@ var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeLowerName[147:159]: ResourceTypeInstallation,
 	_ResourceTypeName[159:163]:      ResourceTypeTeam,
 	_ResourceTypeLowerName[159:163]: ResourceTypeTeam,
+	_ResourceTypeName[163:170]:      ResourceTypeWebhook,
+	_ResourceTypeLowerName[163:170]: ResourceTypeWebhook,
+	_ResourceTypeName[170:185]:      ResourceTypeWebhookDelivery,
+	_ResourceTypeLowerName[170:185]: ResourceTypeWebhookDelivery,
+	_ResourceTypeName[185:198]:      ResourceTypeIssueActivity,
+	_ResourceTypeLowerName[185:198]: ResourceTypeIssueActivity,
+	_ResourceTypeName[198:207]:      ResourceTypeComponent,
+	_ResourceTypeLowerName[198:207]: ResourceTypeComponent,
+	_ResourceTypeName[207:214]:      ResourceTypeRelease,
+	_ResourceTypeLowerName[207:214]: ResourceTypeRelease,
+	_ResourceTypeName[214:220]:      ResourceTypeSprint,
+	_ResourceTypeLowerName[214:220]: ResourceTypeSprint,
+	_ResourceTypeName[220:237]:      ResourceTypeSprintScopeChange,
+	_ResourceTypeLowerName[220:237]: ResourceTypeSprintScopeChange,
+	_ResourceTypeName[237:244]:      ResourceTypeWorkLog,
+	_ResourceTypeLowerName[237:244]: ResourceTypeWorkLog,
+	_ResourceTypeName[244:257]:      ResourceTypeIssueTemplate,
+	_ResourceTypeLowerName[244:257]: ResourceTypeIssueTemplate,
+	_ResourceTypeName[257:261]:      ResourceTypeView,
+	_ResourceTypeLowerName[257:261]: ResourceTypeView,
 }
 
 var _ResourceTypeNames = []string{
@@ -113,6 +143,16 @@ var _ResourceTypeNames = []string{
 	_ResourceTypeName[141:147],
 	_ResourceTypeName[147:159],
 	_ResourceTypeName[159:163],
+	_ResourceTypeName[163:170],
+	_ResourceTypeName[170:185],
+	_ResourceTypeName[185:198],
+	_ResourceTypeName[198:207],
+	_ResourceTypeName[207:214],
+	_ResourceTypeName[214:220],
+	_ResourceTypeName[220:237],
+	_ResourceTypeName[237:244],
+	_ResourceTypeName[244:257],
+	_ResourceTypeName[257:261],
 }
 
 // ResourceTypeString retrieves an enum value from the enum constants string name.
@@ -31,6 +31,16 @@ func TestResourceType_String(t *testing.T) {
 		{"Folder", ResourceTypeFolder, "Folder"},
 		{"Installation", ResourceTypeInstallation, "Installation"},
 		{"Team", ResourceTypeTeam, "Team"},
+		{"Webhook", ResourceTypeWebhook, "Webhook"},
+		{"WebhookDelivery", ResourceTypeWebhookDelivery, "WebhookDelivery"},
+		{"IssueActivity", ResourceTypeIssueActivity, "IssueActivity"},
+		{"Component", ResourceTypeComponent, "Component"},
+		{"Release", ResourceTypeRelease, "Release"},
+		{"Sprint", ResourceTypeSprint, "Sprint"},
+		{"SprintScopeChange", ResourceTypeSprintScopeChange, "SprintScopeChange"},
+		{"WorkLog", ResourceTypeWorkLog, "WorkLog"},
+		{"IssueTemplate", ResourceTypeIssueTemplate, "IssueTemplate"},
+		{"View", ResourceTypeView, "View"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -67,6 +77,16 @@ func TestResourceType_MarshalText(t *testing.T) {
 		{"Folder", ResourceTypeFolder, []byte("Folder"), nil},
 		{"Installation", ResourceTypeInstallation, []byte("Installation"), nil},
 		{"Team", ResourceTypeTeam, []byte("Team"), nil},
+		{"Webhook", ResourceTypeWebhook, []byte("Webhook"), nil},
+		{"WebhookDelivery", ResourceTypeWebhookDelivery, []byte("WebhookDelivery"), nil},
+		{"IssueActivity", ResourceTypeIssueActivity, []byte("IssueActivity"), nil},
+		{"Component", ResourceTypeComponent, []byte("Component"), nil},
+		{"Release", ResourceTypeRelease, []byte("Release"), nil},
+		{"Sprint", ResourceTypeSprint, []byte("Sprint"), nil},
+		{"SprintScopeChange", ResourceTypeSprintScopeChange, []byte("SprintScopeChange"), nil},
+		{"WorkLog", ResourceTypeWorkLog, []byte("WorkLog"), nil},
+		{"IssueTemplate", ResourceTypeIssueTemplate, []byte("IssueTemplate"), nil},
+		{"View", ResourceTypeView, []byte("View"), nil},
 		{"type high", ResourceType(100), []byte("ResourceType(100)"), nil},
 		{"type low", ResourceType(0), []byte("ResourceType(0)"), nil},
 	}
@@ -107,6 +127,16 @@ func TestResourceType_UnmarshalText(t *testing.T) {
 		{"Folder", []byte("Folder"), ResourceTypeFolder, false},
 		{"Installation", []byte("Installation"), ResourceTypeInstallation, false},
 		{"Team", []byte("Team"), ResourceTypeTeam, false},
+		{"Webhook", []byte("Webhook"), ResourceTypeWebhook, false},
+		{"WebhookDelivery", []byte("WebhookDelivery"), ResourceTypeWebhookDelivery, false},
+		{"IssueActivity", []byte("IssueActivity"), ResourceTypeIssueActivity, false},
+		{"Component", []byte("Component"), ResourceTypeComponent, false},
+		{"Release", []byte("Release"), ResourceTypeRelease, false},
+		{"Sprint", []byte("Sprint"), ResourceTypeSprint, false},
+		{"SprintScopeChange", []byte("SprintScopeChange"), ResourceTypeSprintScopeChange, false},
+		{"WorkLog", []byte("WorkLog"), ResourceTypeWorkLog, false},
+		{"IssueTemplate", []byte("IssueTemplate"), ResourceTypeIssueTemplate, false},
+		{"View", []byte("View"), ResourceTypeView, false},
 		{"invalid", []byte("invalid"), 0, true},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	SprintStatePlanned SprintState = iota + 1 // planned
+	SprintStateActive                         // active
+	SprintStateClosed                         // closed
+)
+
+// SprintState represents the state of a sprint.
+//
+//go:generate go tool enumer -type=SprintState -text -transform=noop -linecomment -output=sprint_state_gen.go
+type SprintState uint8
+
+// Sprint is a time-boxed iteration of a project that issues are planned in.
+type Sprint struct {
+	ID        ID          `json:"id" validate:"required"`
+	Project   ID          `json:"project" validate:"required"`
+	Name      string      `json:"name" validate:"required,min=1,max=120"`
+	Goal      string      `json:"goal" validate:"omitempty,max=2000"`
+	State     SprintState `json:"state" validate:"required,min=1,max=3"`
+	StartDate time.Time   `json:"start_date" validate:"required"`
+	EndDate   time.Time   `json:"end_date" validate:"required,gtfield=StartDate"`
+	ClosedAt  *time.Time  `json:"closed_at" validate:"omitempty"`
+	CreatedAt *time.Time  `json:"created_at" validate:"omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at" validate:"omitempty"`
+}
+
+func (s *Sprint) Validate() error {
+	if err := validate.Struct(s); err != nil {
+		return errors.Join(ErrInvalidSprintDetails, err)
+	}
+	if err := s.ID.Validate(); err != nil {
+		return errors.Join(ErrInvalidSprintDetails, err)
+	}
+	if err := s.Project.Validate(); err != nil || s.Project.Type != ResourceTypeProject {
+		return errors.Join(ErrInvalidSprintDetails, ErrInvalidID)
+	}
+	return nil
+}
+
+// CanTransitionTo reports whether the sprint can move to the given state.
+// Sprints only move forward, from planned through active to closed, and a
+// closed sprint cannot be changed.
+func (s SprintState) CanTransitionTo(state SprintState) bool {
+	return state.IsASprintState() && s != SprintStateClosed && state >= s
+}
+
+// NewSprint creates a new planned Sprint in the project.
+func NewSprint(project ID, name string, startDate, endDate time.Time) (*Sprint, error) {
+	sprint := &Sprint{
+		ID:        MustNewNilID(ResourceTypeSprint),
+		Project:   project,
+		Name:      name,
+		State:     SprintStatePlanned,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	if err := sprint.Validate(); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
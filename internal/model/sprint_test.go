@@ -0,0 +1,208 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSprintState_String(t *testing.T) {
+	tests := []struct {
+		name string
+		s    SprintState
+		want string
+	}{
+		{"planned", SprintStatePlanned, "planned"},
+		{"active", SprintStateActive, "active"},
+		{"closed", SprintStateClosed, "closed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.s.String())
+		})
+	}
+}
+
+func TestSprintState_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    []byte
+		want    SprintState
+		wantErr bool
+	}{
+		{"planned", []byte("planned"), SprintStatePlanned, false},
+		{"active", []byte("active"), SprintStateActive, false},
+		{"closed", []byte("closed"), SprintStateClosed, false},
+		{"state invalid", []byte("done"), SprintState(0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var s SprintState
+			err := s.UnmarshalText(tt.text)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s)
+		})
+	}
+}
+
+func TestSprintState_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name  string
+		from  SprintState
+		to    SprintState
+		allow bool
+	}{
+		{"planned to active", SprintStatePlanned, SprintStateActive, true},
+		{"planned to closed", SprintStatePlanned, SprintStateClosed, true},
+		{"active to closed", SprintStateActive, SprintStateClosed, true},
+		{"active to active", SprintStateActive, SprintStateActive, true},
+		{"active to planned", SprintStateActive, SprintStatePlanned, false},
+		{"closed to active", SprintStateClosed, SprintStateActive, false},
+		{"closed to closed", SprintStateClosed, SprintStateClosed, false},
+		{"invalid state", SprintStatePlanned, SprintState(4), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.allow, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestNewSprint(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	end := start.Add(14 * 24 * time.Hour)
+
+	type args struct {
+		project   ID
+		name      string
+		startDate time.Time
+		endDate   time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Sprint
+		wantErr error
+	}{
+		{
+			name: "create Sprint with valid details",
+			args: args{
+				project:   project,
+				name:      "Sprint 1",
+				startDate: start,
+				endDate:   end,
+			},
+			want: &Sprint{
+				ID:        ID{Inner: xid.NilID(), Type: ResourceTypeSprint},
+				Project:   project,
+				Name:      "Sprint 1",
+				State:     SprintStatePlanned,
+				StartDate: start,
+				EndDate:   end,
+			},
+		},
+		{
+			name: "create Sprint with empty name",
+			args: args{
+				project:   project,
+				startDate: start,
+				endDate:   end,
+			},
+			wantErr: ErrInvalidSprintDetails,
+		},
+		{
+			name: "create Sprint ending before it starts",
+			args: args{
+				project:   project,
+				name:      "Sprint 1",
+				startDate: end,
+				endDate:   start,
+			},
+			wantErr: ErrInvalidSprintDetails,
+		},
+		{
+			name: "create Sprint with invalid project",
+			args: args{
+				project:   MustNewID(ResourceTypeNamespace),
+				name:      "Sprint 1",
+				startDate: start,
+				endDate:   end,
+			},
+			wantErr: ErrInvalidSprintDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewSprint(tt.args.project, tt.args.name, tt.args.startDate, tt.args.endDate)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSprint_Validate(t *testing.T) {
+	project := MustNewID(ResourceTypeProject)
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	end := start.Add(14 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		sprint  Sprint
+		wantErr error
+	}{
+		{
+			name: "validate Sprint with valid details",
+			sprint: Sprint{
+				ID:        MustNewID(ResourceTypeSprint),
+				Project:   project,
+				Name:      "Sprint 1",
+				State:     SprintStateActive,
+				StartDate: start,
+				EndDate:   end,
+			},
+		},
+		{
+			name: "validate Sprint with invalid state",
+			sprint: Sprint{
+				ID:        MustNewID(ResourceTypeSprint),
+				Project:   project,
+				Name:      "Sprint 1",
+				State:     SprintState(4),
+				StartDate: start,
+				EndDate:   end,
+			},
+			wantErr: ErrInvalidSprintDetails,
+		},
+		{
+			name: "validate Sprint without dates",
+			sprint: Sprint{
+				ID:      MustNewID(ResourceTypeSprint),
+				Project: project,
+				Name:    "Sprint 1",
+				State:   SprintStatePlanned,
+			},
+			wantErr: ErrInvalidSprintDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, tt.sprint.Validate(), tt.wantErr)
+		})
+	}
+}
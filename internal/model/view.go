@@ -0,0 +1,172 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	ViewColumnKey            ViewColumn = "key"
+	ViewColumnTitle          ViewColumn = "title"
+	ViewColumnKind           ViewColumn = "kind"
+	ViewColumnStatus         ViewColumn = "status"
+	ViewColumnWorkflowStatus ViewColumn = "workflow_status"
+	ViewColumnPriority       ViewColumn = "priority"
+	ViewColumnAssignees      ViewColumn = "assignees"
+	ViewColumnReviewers      ViewColumn = "reviewers"
+	ViewColumnReportedBy     ViewColumn = "reported_by"
+	ViewColumnLabels         ViewColumn = "labels"
+	ViewColumnComponents     ViewColumn = "components"
+	ViewColumnParent         ViewColumn = "parent"
+	ViewColumnProject        ViewColumn = "project"
+	ViewColumnStartDate      ViewColumn = "start_date"
+	ViewColumnDueDate        ViewColumn = "due_date"
+	ViewColumnCreatedAt      ViewColumn = "created_at"
+	ViewColumnUpdatedAt      ViewColumn = "updated_at"
+)
+
+// ViewColumn is an issue field shown as a column of a saved view.
+type ViewColumn string
+
+// Valid reports whether the column is one of the known ViewColumn values.
+func (c ViewColumn) Valid() bool {
+	switch c {
+	case ViewColumnKey,
+		ViewColumnTitle,
+		ViewColumnKind,
+		ViewColumnStatus,
+		ViewColumnWorkflowStatus,
+		ViewColumnPriority,
+		ViewColumnAssignees,
+		ViewColumnReviewers,
+		ViewColumnReportedBy,
+		ViewColumnLabels,
+		ViewColumnComponents,
+		ViewColumnParent,
+		ViewColumnProject,
+		ViewColumnStartDate,
+		ViewColumnDueDate,
+		ViewColumnCreatedAt,
+		ViewColumnUpdatedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	ViewGroupingNone      ViewGrouping = "none"
+	ViewGroupingStatus    ViewGrouping = "status"
+	ViewGroupingPriority  ViewGrouping = "priority"
+	ViewGroupingKind      ViewGrouping = "kind"
+	ViewGroupingAssignee  ViewGrouping = "assignee"
+	ViewGroupingLabel     ViewGrouping = "label"
+	ViewGroupingComponent ViewGrouping = "component"
+	ViewGroupingProject   ViewGrouping = "project"
+)
+
+// ViewGrouping is the issue field the issues of a saved view are grouped by
+// when displayed.
+type ViewGrouping string
+
+// Valid reports whether the grouping is one of the known ViewGrouping values.
+func (g ViewGrouping) Valid() bool {
+	switch g {
+	case ViewGroupingNone,
+		ViewGroupingStatus,
+		ViewGroupingPriority,
+		ViewGroupingKind,
+		ViewGroupingAssignee,
+		ViewGroupingLabel,
+		ViewGroupingComponent,
+		ViewGroupingProject:
+		return true
+	default:
+		return false
+	}
+}
+
+// View is a saved issue list of a user. It lists the issues of a project or
+// namespace with its filter and sort, and displays them with its columns and
+// grouping. The owner can share the view with teams and organizations.
+type View struct {
+	ID          ID           `json:"id" validate:"required"`
+	Owner       ID           `json:"owner" validate:"required"`
+	Scope       ID           `json:"scope" validate:"required"`
+	Name        string       `json:"name" validate:"required,min=1,max=120"`
+	Description string       `json:"description" validate:"omitempty,max=500"`
+	Columns     []ViewColumn `json:"columns" validate:"max=20"`
+	Grouping    ViewGrouping `json:"grouping" validate:"required"`
+	CreatedAt   *time.Time   `json:"created_at" validate:"omitempty"`
+	UpdatedAt   *time.Time   `json:"updated_at" validate:"omitempty"`
+}
+
+func (v *View) Validate() error {
+	if err := validate.Struct(v); err != nil {
+		return errors.Join(ErrInvalidViewDetails, err)
+	}
+	if err := v.ID.Validate(); err != nil {
+		return errors.Join(ErrInvalidViewDetails, err)
+	}
+	if err := v.Owner.Validate(); err != nil || v.Owner.Type != ResourceTypeUser {
+		return errors.Join(ErrInvalidViewDetails, ErrInvalidID)
+	}
+	if err := ValidateViewScope(v.Scope); err != nil {
+		return err
+	}
+	if err := ValidateViewColumns(v.Columns); err != nil {
+		return err
+	}
+	if !v.Grouping.Valid() {
+		return ErrInvalidViewDetails
+	}
+	return nil
+}
+
+// ValidateViewScope validates the scope of a view, which is the project or
+// namespace the issues of the view are listed from.
+func ValidateViewScope(scope ID) error {
+	if err := scope.Validate(); err != nil {
+		return errors.Join(ErrInvalidViewDetails, ErrInvalidID)
+	}
+	if scope.Type != ResourceTypeProject && scope.Type != ResourceTypeNamespace {
+		return errors.Join(ErrInvalidViewDetails, ErrInvalidID)
+	}
+	return nil
+}
+
+// ValidateViewColumns validates the columns of a view. Every column must be
+// known and listed once.
+func ValidateViewColumns(columns []ViewColumn) error {
+	seen := make(map[ViewColumn]struct{}, len(columns))
+	for _, column := range columns {
+		if !column.Valid() {
+			return ErrInvalidViewDetails
+		}
+		if _, ok := seen[column]; ok {
+			return ErrInvalidViewDetails
+		}
+		seen[column] = struct{}{}
+	}
+	return nil
+}
+
+// NewView creates a new View of the owner listing the issues of the scope.
+func NewView(owner, scope ID, name string) (*View, error) {
+	view := &View{
+		ID:       MustNewNilID(ResourceTypeView),
+		Owner:    owner,
+		Scope:    scope,
+		Name:     name,
+		Columns:  make([]ViewColumn, 0),
+		Grouping: ViewGroupingNone,
+	}
+
+	if err := view.Validate(); err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewView(t *testing.T) {
+	owner := MustNewID(ResourceTypeUser)
+	project := MustNewID(ResourceTypeProject)
+
+	type args struct {
+		owner ID
+		scope ID
+		name  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *View
+		wantErr error
+	}{
+		{
+			name: "create View with valid details",
+			args: args{
+				owner: owner,
+				scope: project,
+				name:  "My open bugs",
+			},
+			want: &View{
+				ID:       ID{Inner: xid.NilID(), Type: ResourceTypeView},
+				Owner:    owner,
+				Scope:    project,
+				Name:     "My open bugs",
+				Columns:  []ViewColumn{},
+				Grouping: ViewGroupingNone,
+			},
+		},
+		{
+			name: "create View with namespace scope",
+			args: args{
+				owner: owner,
+				scope: MustNewID(ResourceTypeNamespace),
+				name:  "My open bugs",
+			},
+		},
+		{
+			name: "create View with empty name",
+			args: args{
+				owner: owner,
+				scope: project,
+				name:  "",
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+		{
+			name: "create View with invalid owner",
+			args: args{
+				owner: MustNewID(ResourceTypeTeam),
+				scope: project,
+				name:  "My open bugs",
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+		{
+			name: "create View with invalid scope",
+			args: args{
+				owner: owner,
+				scope: MustNewID(ResourceTypeOrganization),
+				name:  "My open bugs",
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewView(tt.args.owner, tt.args.scope, tt.args.name)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestView_Validate(t *testing.T) {
+	valid := func() View {
+		return View{
+			ID:       MustNewID(ResourceTypeView),
+			Owner:    MustNewID(ResourceTypeUser),
+			Scope:    MustNewID(ResourceTypeProject),
+			Name:     "Triage",
+			Columns:  []ViewColumn{ViewColumnKey, ViewColumnTitle, ViewColumnPriority},
+			Grouping: ViewGroupingStatus,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(v *View)
+		wantErr error
+	}{
+		{
+			name:   "validate View with valid details",
+			mutate: func(_ *View) {},
+		},
+		{
+			name: "validate View with unknown column",
+			mutate: func(v *View) {
+				v.Columns = append(v.Columns, ViewColumn("estimate"))
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+		{
+			name: "validate View with duplicate column",
+			mutate: func(v *View) {
+				v.Columns = append(v.Columns, ViewColumnTitle)
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+		{
+			name: "validate View with unknown grouping",
+			mutate: func(v *View) {
+				v.Grouping = ViewGrouping("sprint")
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+		{
+			name: "validate View with invalid ID",
+			mutate: func(v *View) {
+				v.ID = ID{}
+			},
+			wantErr: ErrInvalidViewDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			view := valid()
+			tt.mutate(&view)
+			require.ErrorIs(t, view.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+// WorkLog is the time a user spent working on an issue on a given day. The
+// duration is in minutes.
+type WorkLog struct {
+	ID        ID         `json:"id" validate:"required"`
+	Issue     ID         `json:"issue" validate:"required"`
+	User      ID         `json:"user" validate:"required"`
+	Duration  uint       `json:"duration" validate:"required,min=1,max=1440"`
+	Date      time.Time  `json:"date" validate:"required"`
+	Note      string     `json:"note" validate:"omitempty,max=2000"`
+	CreatedAt *time.Time `json:"created_at" validate:"omitempty"`
+	UpdatedAt *time.Time `json:"updated_at" validate:"omitempty"`
+}
+
+func (w *WorkLog) Validate() error {
+	if err := validate.Struct(w); err != nil {
+		return errors.Join(ErrInvalidWorkLogDetails, err)
+	}
+	if err := w.ID.Validate(); err != nil {
+		return errors.Join(ErrInvalidWorkLogDetails, err)
+	}
+	if err := w.Issue.Validate(); err != nil || w.Issue.Type != ResourceTypeIssue {
+		return errors.Join(ErrInvalidWorkLogDetails, ErrInvalidID)
+	}
+	if err := w.User.Validate(); err != nil || w.User.Type != ResourceTypeUser {
+		return errors.Join(ErrInvalidWorkLogDetails, ErrInvalidID)
+	}
+	return nil
+}
+
+// NewWorkLog creates a new WorkLog of the user on the issue.
+func NewWorkLog(issue, user ID, duration uint, date time.Time) (*WorkLog, error) {
+	workLog := &WorkLog{
+		ID:       MustNewNilID(ResourceTypeWorkLog),
+		Issue:    issue,
+		User:     user,
+		Duration: duration,
+		Date:     date,
+	}
+
+	if err := workLog.Validate(); err != nil {
+		return nil, err
+	}
+
+	return workLog, nil
+}
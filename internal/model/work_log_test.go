@@ -0,0 +1,158 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkLog(t *testing.T) {
+	issue := MustNewID(ResourceTypeIssue)
+	user := MustNewID(ResourceTypeUser)
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		issue    ID
+		user     ID
+		duration uint
+		date     time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *WorkLog
+		wantErr error
+	}{
+		{
+			name: "create WorkLog with valid details",
+			args: args{
+				issue:    issue,
+				user:     user,
+				duration: 90,
+				date:     date,
+			},
+			want: &WorkLog{
+				ID:       ID{Inner: xid.NilID(), Type: ResourceTypeWorkLog},
+				Issue:    issue,
+				User:     user,
+				Duration: 90,
+				Date:     date,
+			},
+		},
+		{
+			name: "create WorkLog without duration",
+			args: args{
+				issue: issue,
+				user:  user,
+				date:  date,
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+		{
+			name: "create WorkLog longer than a day",
+			args: args{
+				issue:    issue,
+				user:     user,
+				duration: 1441,
+				date:     date,
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+		{
+			name: "create WorkLog without date",
+			args: args{
+				issue:    issue,
+				user:     user,
+				duration: 90,
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+		{
+			name: "create WorkLog with invalid issue",
+			args: args{
+				issue:    MustNewID(ResourceTypeProject),
+				user:     user,
+				duration: 90,
+				date:     date,
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+		{
+			name: "create WorkLog with invalid user",
+			args: args{
+				issue:    issue,
+				user:     MustNewID(ResourceTypeTeam),
+				duration: 90,
+				date:     date,
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewWorkLog(tt.args.issue, tt.args.user, tt.args.duration, tt.args.date)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWorkLog_Validate(t *testing.T) {
+	issue := MustNewID(ResourceTypeIssue)
+	user := MustNewID(ResourceTypeUser)
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		workLog WorkLog
+		wantErr error
+	}{
+		{
+			name: "validate WorkLog with valid details",
+			workLog: WorkLog{
+				ID:       MustNewID(ResourceTypeWorkLog),
+				Issue:    issue,
+				User:     user,
+				Duration: 30,
+				Date:     date,
+				Note:     "Pairing on the release",
+			},
+		},
+		{
+			name: "validate WorkLog with too long note",
+			workLog: WorkLog{
+				ID:       MustNewID(ResourceTypeWorkLog),
+				Issue:    issue,
+				User:     user,
+				Duration: 30,
+				Date:     date,
+				Note:     string(make([]byte, 2001)),
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+		{
+			name: "validate WorkLog with invalid id",
+			workLog: WorkLog{
+				ID:       ID{Inner: xid.NilID(), Type: ResourceType(0)},
+				Issue:    issue,
+				User:     user,
+				Duration: 30,
+				Date:     date,
+			},
+			wantErr: ErrInvalidWorkLogDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, tt.workLog.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	WorkflowStatusCategoryTodo       WorkflowStatusCategory = iota + 1 // todo
+	WorkflowStatusCategoryInProgress                                   // in progress
+	WorkflowStatusCategoryDone                                         // done
+)
+
+var workflowStatusKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// WorkflowStatusCategory groups the statuses of a workflow by progress.
+//
+//go:generate go tool enumer -type=WorkflowStatusCategory -text -transform=noop -linecomment -output=workflow_status_category_gen.go
+type WorkflowStatusCategory uint8
+
+// IssueStatus returns the built-in status of the issues being in a status of
+// the category.
+func (c WorkflowStatusCategory) IssueStatus() IssueStatus {
+	switch c {
+	case WorkflowStatusCategoryInProgress:
+		return IssueStatusInProgress
+	case WorkflowStatusCategoryDone:
+		return IssueStatusDone
+	default:
+		return IssueStatusOpen
+	}
+}
+
+// IsWorkflowStatusKey reports whether the key can identify a workflow status.
+func IsWorkflowStatusKey(key string) bool {
+	return len(key) <= 32 && workflowStatusKeyPattern.MatchString(key)
+}
+
+// WorkflowStatus is a named status of a workflow. The key identifies the
+// status on the issues, while the name is displayed to the users.
+type WorkflowStatus struct {
+	Key      string                 `json:"key" validate:"required,min=1,max=32"`
+	Name     string                 `json:"name" validate:"required,min=1,max=60"`
+	Category WorkflowStatusCategory `json:"category" validate:"required,min=1,max=3"`
+}
+
+// WorkflowTransition allows moving issues from one status to another.
+type WorkflowTransition struct {
+	From string `json:"from" validate:"required"`
+	To   string `json:"to" validate:"required"`
+}
+
+// Workflow defines the statuses of the issues in a project and the allowed
+// transitions between them. The first status is the initial status of new
+// issues.
+type Workflow struct {
+	Statuses    []WorkflowStatus     `json:"statuses" validate:"required,min=1,max=50,dive"`
+	Transitions []WorkflowTransition `json:"transitions" validate:"max=500,dive"`
+}
+
+// Validate validates the workflow. The status keys must be unique, and the
+// transitions must connect two different, existing statuses.
+func (w *Workflow) Validate() error {
+	if err := validate.Struct(w); err != nil {
+		return errors.Join(ErrInvalidWorkflowDetails, err)
+	}
+
+	keys := make(map[string]struct{}, len(w.Statuses))
+	for _, status := range w.Statuses {
+		if !workflowStatusKeyPattern.MatchString(status.Key) {
+			return errors.Join(ErrInvalidWorkflowDetails, fmt.Errorf("invalid status key %q", status.Key))
+		}
+		if _, ok := keys[status.Key]; ok {
+			return errors.Join(ErrInvalidWorkflowDetails, fmt.Errorf("duplicate status key %q", status.Key))
+		}
+		keys[status.Key] = struct{}{}
+	}
+
+	transitions := make(map[WorkflowTransition]struct{}, len(w.Transitions))
+	for _, transition := range w.Transitions {
+		_, fromOK := keys[transition.From]
+		_, toOK := keys[transition.To]
+		if !fromOK || !toOK || transition.From == transition.To {
+			return errors.Join(ErrInvalidWorkflowDetails, fmt.Errorf("invalid transition from %q to %q", transition.From, transition.To))
+		}
+		if _, ok := transitions[transition]; ok {
+			return errors.Join(ErrInvalidWorkflowDetails, fmt.Errorf("duplicate transition from %q to %q", transition.From, transition.To))
+		}
+		transitions[transition] = struct{}{}
+	}
+
+	return nil
+}
+
+// InitialStatus returns the status of the new issues.
+func (w *Workflow) InitialStatus() WorkflowStatus {
+	return w.Statuses[0]
+}
+
+// StatusKeys returns the keys of the statuses in their order.
+func (w *Workflow) StatusKeys() []string {
+	keys := make([]string, len(w.Statuses))
+	for i, status := range w.Statuses {
+		keys[i] = status.Key
+	}
+	return keys
+}
+
+// Status returns the status of the workflow by its key.
+func (w *Workflow) Status(key string) (WorkflowStatus, bool) {
+	for _, status := range w.Statuses {
+		if status.Key == key {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// CanTransition reports whether an issue can be moved from one status to the
+// other. Staying in the same status is always allowed, and so is entering any
+// status from a status that is not part of the workflow, for example after
+// the workflow was changed.
+func (w *Workflow) CanTransition(from, to string) bool {
+	if _, ok := w.Status(to); !ok {
+		return false
+	}
+	if _, ok := w.Status(from); !ok || from == to {
+		return true
+	}
+
+	for _, transition := range w.Transitions {
+		if transition.From == from && transition.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+// NewWorkflow creates a new Workflow.
+func NewWorkflow(statuses []WorkflowStatus, transitions []WorkflowTransition) (*Workflow, error) {
+	if transitions == nil {
+		transitions = make([]WorkflowTransition, 0)
+	}
+
+	workflow := &Workflow{
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+
+	if err := workflow.Validate(); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
@@ -0,0 +1,95 @@
+// Code generated by "enumer -type=WorkflowStatusCategory -text -transform=noop -linecomment -output=workflow_status_category_gen.go"; DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _WorkflowStatusCategoryName = "todoin progressdone"
+
+var _WorkflowStatusCategoryIndex = [...]uint8{0, 4, 15, 19}
+
+const _WorkflowStatusCategoryLowerName = "todoin progressdone"
+
+func (i WorkflowStatusCategory) String() string {
+	i -= 1
+	if i >= WorkflowStatusCategory(len(_WorkflowStatusCategoryIndex)-1) {
+		return fmt.Sprintf("WorkflowStatusCategory(%d)", i+1)
+	}
+	return _WorkflowStatusCategoryName[_WorkflowStatusCategoryIndex[i]:_WorkflowStatusCategoryIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _WorkflowStatusCategoryNoOp() {
+	var x [1]struct{}
+	_ = x[WorkflowStatusCategoryTodo-(1)]
+	_ = x[WorkflowStatusCategoryInProgress-(2)]
+	_ = x[WorkflowStatusCategoryDone-(3)]
+}
+
+var _WorkflowStatusCategoryValues = []WorkflowStatusCategory{WorkflowStatusCategoryTodo, WorkflowStatusCategoryInProgress, WorkflowStatusCategoryDone}
+
+var _WorkflowStatusCategoryNameToValueMap = map[string]WorkflowStatusCategory{
+	_WorkflowStatusCategoryName[0:4]:        WorkflowStatusCategoryTodo,
+	_WorkflowStatusCategoryLowerName[0:4]:   WorkflowStatusCategoryTodo,
+	_WorkflowStatusCategoryName[4:15]:       WorkflowStatusCategoryInProgress,
+	_WorkflowStatusCategoryLowerName[4:15]:  WorkflowStatusCategoryInProgress,
+	_WorkflowStatusCategoryName[15:19]:      WorkflowStatusCategoryDone,
+	_WorkflowStatusCategoryLowerName[15:19]: WorkflowStatusCategoryDone,
+}
+
+var _WorkflowStatusCategoryNames = []string{
+	_WorkflowStatusCategoryName[0:4],
+	_WorkflowStatusCategoryName[4:15],
+	_WorkflowStatusCategoryName[15:19],
+}
+
+// WorkflowStatusCategoryString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func WorkflowStatusCategoryString(s string) (WorkflowStatusCategory, error) {
+	if val, ok := _WorkflowStatusCategoryNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _WorkflowStatusCategoryNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to WorkflowStatusCategory values", s)
+}
+
+// WorkflowStatusCategoryValues returns all values of the enum
+func WorkflowStatusCategoryValues() []WorkflowStatusCategory {
+	return _WorkflowStatusCategoryValues
+}
+
+// WorkflowStatusCategoryStrings returns a slice of all String values of the enum
+func WorkflowStatusCategoryStrings() []string {
+	strs := make([]string, len(_WorkflowStatusCategoryNames))
+	copy(strs, _WorkflowStatusCategoryNames)
+	return strs
+}
+
+// IsAWorkflowStatusCategory returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i WorkflowStatusCategory) IsAWorkflowStatusCategory() bool {
+	for _, v := range _WorkflowStatusCategoryValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for WorkflowStatusCategory
+func (i WorkflowStatusCategory) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for WorkflowStatusCategory
+func (i *WorkflowStatusCategory) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = WorkflowStatusCategoryString(string(text))
+	return err
+}
@@ -0,0 +1,261 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStatusCategory_String(t *testing.T) {
+	tests := []struct {
+		name string
+		c    WorkflowStatusCategory
+		want string
+	}{
+		{"Todo", WorkflowStatusCategoryTodo, "todo"},
+		{"InProgress", WorkflowStatusCategoryInProgress, "in progress"},
+		{"Done", WorkflowStatusCategoryDone, "done"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.c.String())
+		})
+	}
+}
+
+func TestWorkflowStatusCategory_MarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        WorkflowStatusCategory
+		wantText []byte
+		wantErr  error
+	}{
+		{"Todo", WorkflowStatusCategoryTodo, []byte("todo"), nil},
+		{"InProgress", WorkflowStatusCategoryInProgress, []byte("in progress"), nil},
+		{"Done", WorkflowStatusCategoryDone, []byte("done"), nil},
+		{"category high", WorkflowStatusCategory(100), []byte("WorkflowStatusCategory(100)"), nil},
+		{"category low", WorkflowStatusCategory(0), []byte("WorkflowStatusCategory(0)"), nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotText, err := tt.c.MarshalText()
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantText, gotText)
+		})
+	}
+}
+
+func TestWorkflowStatusCategory_UnmarshalText(t *testing.T) {
+	type args struct {
+		text []byte
+	}
+	tests := []struct {
+		name    string
+		c       WorkflowStatusCategory
+		args    args
+		wantErr bool
+	}{
+		{"Todo", WorkflowStatusCategoryTodo, args{[]byte("todo")}, false},
+		{"InProgress", WorkflowStatusCategoryInProgress, args{[]byte("in progress")}, false},
+		{"Done", WorkflowStatusCategoryDone, args{[]byte("done")}, false},
+		{"category high", WorkflowStatusCategory(100), args{[]byte("")}, true},
+		{"category low", WorkflowStatusCategory(0), args{[]byte("")}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.c.UnmarshalText(tt.args.text)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkflowStatusCategory_IssueStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		c    WorkflowStatusCategory
+		want IssueStatus
+	}{
+		{"Todo", WorkflowStatusCategoryTodo, IssueStatusOpen},
+		{"InProgress", WorkflowStatusCategoryInProgress, IssueStatusInProgress},
+		{"Done", WorkflowStatusCategoryDone, IssueStatusDone},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.c.IssueStatus())
+		})
+	}
+}
+
+func TestIsWorkflowStatusKey(t *testing.T) {
+	assert.True(t, IsWorkflowStatusKey("code_review"))
+	assert.True(t, IsWorkflowStatusKey("qa2"))
+	assert.False(t, IsWorkflowStatusKey("Code_review"))
+	assert.False(t, IsWorkflowStatusKey("2nd_review"))
+	assert.False(t, IsWorkflowStatusKey("code review"))
+	assert.False(t, IsWorkflowStatusKey(""))
+	assert.False(t, IsWorkflowStatusKey(strings.Repeat("a", 33)))
+}
+
+func TestNewWorkflow(t *testing.T) {
+	statuses := []WorkflowStatus{
+		{Key: "backlog", Name: "Backlog", Category: WorkflowStatusCategoryTodo},
+		{Key: "in_review", Name: "In review", Category: WorkflowStatusCategoryInProgress},
+		{Key: "shipped", Name: "Shipped", Category: WorkflowStatusCategoryDone},
+	}
+
+	type args struct {
+		statuses    []WorkflowStatus
+		transitions []WorkflowTransition
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Workflow
+		wantErr error
+	}{
+		{
+			name: "create workflow with valid details",
+			args: args{
+				statuses:    statuses,
+				transitions: []WorkflowTransition{{From: "backlog", To: "in_review"}},
+			},
+			want: &Workflow{
+				Statuses:    statuses,
+				Transitions: []WorkflowTransition{{From: "backlog", To: "in_review"}},
+			},
+		},
+		{
+			name: "create workflow without transitions",
+			args: args{
+				statuses: statuses,
+			},
+			want: &Workflow{
+				Statuses:    statuses,
+				Transitions: []WorkflowTransition{},
+			},
+		},
+		{
+			name:    "create workflow without statuses",
+			args:    args{},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+		{
+			name: "create workflow with invalid status key",
+			args: args{
+				statuses: []WorkflowStatus{{Key: "In Review", Name: "In review", Category: WorkflowStatusCategoryTodo}},
+			},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+		{
+			name: "create workflow with invalid status category",
+			args: args{
+				statuses: []WorkflowStatus{{Key: "backlog", Name: "Backlog", Category: WorkflowStatusCategory(4)}},
+			},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+		{
+			name: "create workflow with duplicate status key",
+			args: args{
+				statuses: []WorkflowStatus{statuses[0], statuses[0]},
+			},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+		{
+			name: "create workflow with transition to unknown status",
+			args: args{
+				statuses:    statuses,
+				transitions: []WorkflowTransition{{From: "backlog", To: "unknown"}},
+			},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+		{
+			name: "create workflow with transition to the same status",
+			args: args{
+				statuses:    statuses,
+				transitions: []WorkflowTransition{{From: "backlog", To: "backlog"}},
+			},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+		{
+			name: "create workflow with duplicate transition",
+			args: args{
+				statuses: statuses,
+				transitions: []WorkflowTransition{
+					{From: "backlog", To: "shipped"},
+					{From: "backlog", To: "shipped"},
+				},
+			},
+			wantErr: ErrInvalidWorkflowDetails,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewWorkflow(tt.args.statuses, tt.args.transitions)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWorkflow_CanTransition(t *testing.T) {
+	workflow := &Workflow{
+		Statuses: []WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: WorkflowStatusCategoryInProgress},
+			{Key: "shipped", Name: "Shipped", Category: WorkflowStatusCategoryDone},
+		},
+		Transitions: []WorkflowTransition{
+			{From: "backlog", To: "in_review"},
+			{From: "in_review", To: "shipped"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     bool
+	}{
+		{"allowed transition", "backlog", "in_review", true},
+		{"disallowed transition", "backlog", "shipped", false},
+		{"disallowed reverse transition", "in_review", "backlog", false},
+		{"same status", "shipped", "shipped", true},
+		{"from unknown status", "removed", "shipped", true},
+		{"from empty status", "", "backlog", true},
+		{"to unknown status", "backlog", "removed", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, workflow.CanTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestWorkflow_StatusKeys(t *testing.T) {
+	workflow := &Workflow{
+		Statuses: []WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: WorkflowStatusCategoryInProgress},
+			{Key: "shipped", Name: "Shipped", Category: WorkflowStatusCategoryDone},
+		},
+	}
+
+	assert.Equal(t, []string{"backlog", "in_review", "shipped"}, workflow.StatusKeys())
+}
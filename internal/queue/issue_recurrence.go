@@ -0,0 +1,25 @@
+package queue
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	IssueRecurrenceTaskTimeout = time.Minute // The timeout of materializing the due issue recurrences.
+)
+
+// NewIssueRecurrenceTask creates a task materializing the issues of the due
+// occurrences of the recurring issue templates. The task is not retried, as
+// it is scheduled periodically and the next run picks up what is left.
+func NewIssueRecurrenceTask() (*asynq.Task, error) {
+	return asynq.NewTask(
+		TaskTypeIssueRecurrence.String(),
+		nil,
+		asynq.Timeout(IssueRecurrenceTaskTimeout),
+		asynq.MaxRetry(0),
+		asynq.Retention(DefaultTaskRetention),
+		asynq.Queue(MessageQueueDefaultPriority),
+	), nil
+}
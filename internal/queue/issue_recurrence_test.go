@@ -0,0 +1,25 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIssueRecurrenceTask(t *testing.T) {
+	t.Parallel()
+
+	got, err := NewIssueRecurrenceTask()
+	require.NoError(t, err)
+	assert.Equal(t, asynq.NewTask(
+		TaskTypeIssueRecurrence.String(),
+		nil,
+		asynq.Timeout(IssueRecurrenceTaskTimeout),
+		asynq.MaxRetry(0),
+		asynq.Retention(DefaultTaskRetention),
+		asynq.Queue(MessageQueueDefaultPriority),
+	), got)
+	assert.Equal(t, "issue:recurrence", got.Type())
+}
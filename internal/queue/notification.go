@@ -0,0 +1,60 @@
+package queue
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+const (
+	NotificationTaskTimeout = 30 * time.Second
+)
+
+// IssueEventTaskPayload describes a change on an issue whose watchers and
+// assignees should be notified.
+type IssueEventTaskPayload struct {
+	Actor         string   `json:"actor"`
+	ResourceID    string   `json:"resource_id"`
+	ChangedFields []string `json:"changed_fields"`
+}
+
+// NewIssueEventTask creates a notification fan-out task for an issue event.
+// The task type must be one of the issue notification task types.
+func NewIssueEventTask(taskType TaskType, actor, issue model.ID, changedFields []string) (*asynq.Task, error) {
+	switch taskType {
+	case TaskTypeNotificationIssueUpdated, TaskTypeNotificationIssueRelation, TaskTypeNotificationIssueComment:
+	default:
+		return nil, ErrInvalidTaskType
+	}
+
+	if err := actor.Validate(); err != nil {
+		return nil, err
+	}
+	if err := issue.Validate(); err != nil {
+		return nil, err
+	}
+
+	if changedFields == nil {
+		changedFields = make([]string, 0)
+	}
+
+	payload, err := json.Marshal(IssueEventTaskPayload{
+		Actor:         actor.Composite(),
+		ResourceID:    issue.Composite(),
+		ChangedFields: changedFields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(
+		taskType.String(),
+		payload,
+		asynq.Timeout(NotificationTaskTimeout),
+		asynq.Retention(DefaultTaskRetention),
+		asynq.Queue(MessageQueueDefaultPriority),
+	), nil
+}
@@ -0,0 +1,44 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+func TestNewIssueEventTask(t *testing.T) {
+	t.Parallel()
+
+	actor := model.MustNewID(model.ResourceTypeUser)
+	issue := model.MustNewID(model.ResourceTypeIssue)
+
+	got, err := NewIssueEventTask(TaskTypeNotificationIssueUpdated, actor, issue, []string{"status", "priority"})
+	require.NoError(t, err)
+	assert.Equal(t, TaskTypeNotificationIssueUpdated.String(), got.Type())
+	assert.Contains(t, string(got.Payload()), actor.Composite())
+	assert.Contains(t, string(got.Payload()), issue.Composite())
+	assert.Contains(t, string(got.Payload()), `"changed_fields":["status","priority"]`)
+
+	got, err = NewIssueEventTask(TaskTypeNotificationIssueComment, actor, issue, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(got.Payload()), `"changed_fields":[]`)
+}
+
+func TestNewIssueEventTask_Invalid(t *testing.T) {
+	t.Parallel()
+
+	actor := model.MustNewID(model.ResourceTypeUser)
+	issue := model.MustNewID(model.ResourceTypeIssue)
+
+	_, err := NewIssueEventTask(TaskTypeSearchIndex, actor, issue, nil)
+	assert.ErrorIs(t, err, ErrInvalidTaskType)
+
+	_, err = NewIssueEventTask(TaskTypeNotificationIssueUpdated, model.ID{}, issue, nil)
+	assert.ErrorIs(t, err, model.ErrInvalidID)
+
+	_, err = NewIssueEventTask(TaskTypeNotificationIssueRelation, actor, model.ID{}, nil)
+	assert.ErrorIs(t, err, model.ErrInvalidID)
+}
@@ -12,11 +12,16 @@ const (
 )
 
 const (
-	TaskTypeSystemHealthCheck   TaskType = iota + 1 // system:health_check
-	TaskTypeSystemLicenseExpiry                     // system:license_expiry
-	TaskTypeSearchIndex                             // search:index
-	TaskTypeSearchReindex                           // search:reindex
-	TaskTypeSearchReindexBatch                      // search:reindex_batch
+	TaskTypeSystemHealthCheck         TaskType = iota + 1 // system:health_check
+	TaskTypeSystemLicenseExpiry                           // system:license_expiry
+	TaskTypeSearchIndex                                   // search:index
+	TaskTypeSearchReindex                                 // search:reindex
+	TaskTypeSearchReindexBatch                            // search:reindex_batch
+	TaskTypeNotificationIssueUpdated                      // notification:issue_updated
+	TaskTypeNotificationIssueRelation                     // notification:issue_relation
+	TaskTypeNotificationIssueComment                      // notification:issue_comment
+	TaskTypeWebhookDelivery                               // webhook:delivery
+	TaskTypeIssueRecurrence                               // issue:recurrence
 )
 
 // TaskType is the type for system tasks.
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _TaskTypeName = "system:health_checksystem:license_expirysearch:indexsearch:reindexsearch:reindex_batch"
+const _TaskTypeName = "system:health_checksystem:license_expirysearch:indexsearch:reindexsearch:reindex_batchnotification:issue_updatednotification:issue_relationnotification:issue_commentwebhook:deliveryissue:recurrence"
 
-var _TaskTypeIndex = [...]uint8{0, 19, 40, 52, 66, 86}
+var _TaskTypeIndex = [...]uint8{0, 19, 40, 52, 66, 86, 112, 139, 165, 181, 197}
 
-const _TaskTypeLowerName = "system:health_checksystem:license_expirysearch:indexsearch:reindexsearch:reindex_batch"
+const _TaskTypeLowerName = "system:health_checksystem:license_expirysearch:indexsearch:reindexsearch:reindex_batchnotification:issue_updatednotification:issue_relationnotification:issue_commentwebhook:deliveryissue:recurrence"
 
 func (i TaskType) String() string {
 	i -= 1
@@ -30,21 +30,36 @@ func _TaskTypeNoOp() {
 	_ = x[TaskTypeSearchIndex-(3)]
 	_ = x[TaskTypeSearchReindex-(4)]
 	_ = x[TaskTypeSearchReindexBatch-(5)]
+	_ = x[TaskTypeNotificationIssueUpdated-(6)]
+	_ = x[TaskTypeNotificationIssueRelation-(7)]
+	_ = x[TaskTypeNotificationIssueComment-(8)]
+	_ = x[TaskTypeWebhookDelivery-(9)]
+	_ = x[TaskTypeIssueRecurrence-(10)]
 }
 
-var _TaskTypeValues = []TaskType{TaskTypeSystemHealthCheck, TaskTypeSystemLicenseExpiry, TaskTypeSearchIndex, TaskTypeSearchReindex, TaskTypeSearchReindexBatch}
+var _TaskTypeValues = []TaskType{TaskTypeSystemHealthCheck, TaskTypeSystemLicenseExpiry, TaskTypeSearchIndex, TaskTypeSearchReindex, TaskTypeSearchReindexBatch, TaskTypeNotificationIssueUpdated, TaskTypeNotificationIssueRelation, TaskTypeNotificationIssueComment, TaskTypeWebhookDelivery, TaskTypeIssueRecurrence}
 
 var _TaskTypeNameToValueMap = map[string]TaskType{
-	_TaskTypeName[0:19]:       TaskTypeSystemHealthCheck,
-	_TaskTypeLowerName[0:19]:  TaskTypeSystemHealthCheck,
-	_TaskTypeName[19:40]:      TaskTypeSystemLicenseExpiry,
-	_TaskTypeLowerName[19:40]: TaskTypeSystemLicenseExpiry,
-	_TaskTypeName[40:52]:      TaskTypeSearchIndex,
-	_TaskTypeLowerName[40:52]: TaskTypeSearchIndex,
-	_TaskTypeName[52:66]:      TaskTypeSearchReindex,
-	_TaskTypeLowerName[52:66]: TaskTypeSearchReindex,
-	_TaskTypeName[66:86]:      TaskTypeSearchReindexBatch,
-	_TaskTypeLowerName[66:86]: TaskTypeSearchReindexBatch,
+	_TaskTypeName[0:19]:         TaskTypeSystemHealthCheck,
+	_TaskTypeLowerName[0:19]:    TaskTypeSystemHealthCheck,
+	_TaskTypeName[19:40]:        TaskTypeSystemLicenseExpiry,
+	_TaskTypeLowerName[19:40]:   TaskTypeSystemLicenseExpiry,
+	_TaskTypeName[40:52]:        TaskTypeSearchIndex,
+	_TaskTypeLowerName[40:52]:   TaskTypeSearchIndex,
+	_TaskTypeName[52:66]:        TaskTypeSearchReindex,
+	_TaskTypeLowerName[52:66]:   TaskTypeSearchReindex,
+	_TaskTypeName[66:86]:        TaskTypeSearchReindexBatch,
+	_TaskTypeLowerName[66:86]:   TaskTypeSearchReindexBatch,
+	_TaskTypeName[86:112]:       TaskTypeNotificationIssueUpdated,
+	_TaskTypeLowerName[86:112]:  TaskTypeNotificationIssueUpdated,
+	_TaskTypeName[112:139]:      TaskTypeNotificationIssueRelation,
+	_TaskTypeLowerName[112:139]: TaskTypeNotificationIssueRelation,
+	_TaskTypeName[139:165]:      TaskTypeNotificationIssueComment,
+	_TaskTypeLowerName[139:165]: TaskTypeNotificationIssueComment,
+	_TaskTypeName[165:181]:      TaskTypeWebhookDelivery,
+	_TaskTypeLowerName[165:181]: TaskTypeWebhookDelivery,
+	_TaskTypeName[181:197]:      TaskTypeIssueRecurrence,
+	_TaskTypeLowerName[181:197]: TaskTypeIssueRecurrence,
 }
 
 var _TaskTypeNames = []string{
@@ -53,6 +68,11 @@ var _TaskTypeNames = []string{
 	_TaskTypeName[40:52],
 	_TaskTypeName[52:66],
 	_TaskTypeName[66:86],
+	_TaskTypeName[86:112],
+	_TaskTypeName[112:139],
+	_TaskTypeName[139:165],
+	_TaskTypeName[165:181],
+	_TaskTypeName[181:197],
 }
 
 // TaskTypeString retrieves an enum value from the enum constants string name.
@@ -17,6 +17,9 @@ func TestTaskType_String(t *testing.T) {
 		{"search index task", TaskTypeSearchIndex, "search:index"},
 		{"search reindex task", TaskTypeSearchReindex, "search:reindex"},
 		{"search reindex batch task", TaskTypeSearchReindexBatch, "search:reindex_batch"},
+		{"issue updated notification task", TaskTypeNotificationIssueUpdated, "notification:issue_updated"},
+		{"issue relation notification task", TaskTypeNotificationIssueRelation, "notification:issue_relation"},
+		{"issue comment notification task", TaskTypeNotificationIssueComment, "notification:issue_comment"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -70,6 +70,39 @@ func NewSearchReindexTask(payload SearchReindexTaskPayload) (*asynq.Task, error)
 	), nil
 }
 
+// NewSearchIndexBatchTask creates a write-through index task for a batch of
+// resources of a single type. Unlike the reindex batches, it has no task ID,
+// so indexing the same resources again is never deduplicated.
+func NewSearchIndexBatchTask(resourceType model.ResourceType, ids []model.ID) (*asynq.Task, error) {
+	if !resourceType.IsAResourceType() {
+		return nil, model.ErrInvalidResourceType
+	}
+
+	rawIDs := make([]string, len(ids))
+	for i, id := range ids {
+		if err := id.Validate(); err != nil {
+			return nil, err
+		}
+		rawIDs[i] = id.Composite()
+	}
+
+	payload, err := json.Marshal(SearchReindexBatchTaskPayload{
+		ResourceType: resourceType.String(),
+		IDs:          rawIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(
+		TaskTypeSearchReindexBatch.String(),
+		payload,
+		asynq.Timeout(SearchReindexBatchTaskTimeout),
+		asynq.Retention(DefaultTaskRetention),
+		asynq.Queue(MessageQueueDefaultPriority),
+	), nil
+}
+
 // NewSearchReindexBatchTask creates a batch index task.
 func NewSearchReindexBatchTask(resourceType model.ResourceType, ids []model.ID) (*asynq.Task, error) {
 	if !resourceType.IsAResourceType() {
@@ -42,6 +42,23 @@ func TestNewSearchReindexBatchTask(t *testing.T) {
 	assert.ErrorIs(t, err, model.ErrInvalidResourceType)
 }
 
+func TestNewSearchIndexBatchTask(t *testing.T) {
+	t.Parallel()
+
+	ids := []model.ID{model.MustNewID(model.ResourceTypeIssue), model.MustNewID(model.ResourceTypeIssue)}
+	got, err := NewSearchIndexBatchTask(model.ResourceTypeIssue, ids)
+	require.NoError(t, err)
+	assert.Equal(t, TaskTypeSearchReindexBatch.String(), got.Type())
+	assert.Contains(t, string(got.Payload()), ids[0].Composite())
+	assert.Contains(t, string(got.Payload()), ids[1].Composite())
+
+	_, err = NewSearchIndexBatchTask(model.ResourceType(0), nil)
+	assert.ErrorIs(t, err, model.ErrInvalidResourceType)
+
+	_, err = NewSearchIndexBatchTask(model.ResourceTypeIssue, []model.ID{{}})
+	assert.Error(t, err)
+}
+
 func TestNewSearchIndexTask_InvalidID(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,68 @@
+package queue
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+const (
+	WebhookDeliveryTaskTimeout = 30 * time.Second // The timeout of a delivery attempt.
+	WebhookDeliveryMaxRetry    = 8                // The number of retries of a failed delivery.
+
+	webhookDeliveryBaseDelay = 30 * time.Second
+	webhookDeliveryMaxDelay  = 6 * time.Hour
+)
+
+// WebhookDeliveryTaskPayload identifies the webhook delivery to send.
+type WebhookDeliveryTaskPayload struct {
+	DeliveryID string `json:"delivery_id"`
+}
+
+// NewWebhookDeliveryTask creates a task sending the webhook delivery to its
+// receiver. Failed attempts are retried with WebhookDeliveryRetryDelay.
+func NewWebhookDeliveryTask(delivery model.ID) (*asynq.Task, error) {
+	if err := delivery.Validate(); err != nil {
+		return nil, err
+	}
+
+	if delivery.Type != model.ResourceTypeWebhookDelivery {
+		return nil, model.ErrInvalidID
+	}
+
+	payload, err := json.Marshal(WebhookDeliveryTaskPayload{DeliveryID: delivery.Composite()})
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(
+		TaskTypeWebhookDelivery.String(),
+		payload,
+		asynq.Timeout(WebhookDeliveryTaskTimeout),
+		asynq.MaxRetry(WebhookDeliveryMaxRetry),
+		asynq.Retention(DefaultTaskRetention),
+		asynq.Queue(MessageQueueDefaultPriority),
+	), nil
+}
+
+// WebhookDeliveryRetryDelay returns the delay before the nth retry of a
+// webhook delivery. The delay doubles with every retry, starting from 30
+// seconds, and it is capped at 6 hours.
+func WebhookDeliveryRetryDelay(n int) time.Duration {
+	if n < 0 {
+		n = 0
+	}
+
+	delay := webhookDeliveryBaseDelay
+	for i := 0; i < n; i++ {
+		delay *= 2
+		if delay >= webhookDeliveryMaxDelay {
+			return webhookDeliveryMaxDelay
+		}
+	}
+
+	return delay
+}
@@ -0,0 +1,49 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+func TestNewWebhookDeliveryTask(t *testing.T) {
+	t.Parallel()
+
+	delivery := model.MustNewID(model.ResourceTypeWebhookDelivery)
+
+	got, err := NewWebhookDeliveryTask(delivery)
+	require.NoError(t, err)
+	assert.Equal(t, TaskTypeWebhookDelivery.String(), got.Type())
+	assert.JSONEq(t, `{"delivery_id":"`+delivery.Composite()+`"}`, string(got.Payload()))
+
+	_, err = NewWebhookDeliveryTask(model.ID{})
+	assert.ErrorIs(t, err, model.ErrInvalidID)
+
+	_, err = NewWebhookDeliveryTask(model.MustNewID(model.ResourceTypeWebhook))
+	assert.ErrorIs(t, err, model.ErrInvalidID)
+}
+
+func TestWebhookDeliveryRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		want time.Duration
+	}{
+		{n: -1, want: 30 * time.Second},
+		{n: 0, want: 30 * time.Second},
+		{n: 1, want: time.Minute},
+		{n: 2, want: 2 * time.Minute},
+		{n: 5, want: 16 * time.Minute},
+		{n: 9, want: 4*time.Hour + 16*time.Minute},
+		{n: 10, want: 6 * time.Hour},
+		{n: 100, want: 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, WebhookDeliveryRetryDelay(tt.n), "retry %d", tt.n)
+	}
+}
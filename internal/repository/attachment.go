@@ -22,6 +22,7 @@ type Attachment struct {
 	ID        model.ID   `json:"id"`
 	Name      string     `json:"name"`
 	FileID    string     `json:"file_id"`
+	BelongsTo model.ID   `json:"belongs_to"`
 	CreatedBy model.ID   `json:"created_by"`
 	CreatedAt *time.Time `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at"`
@@ -47,6 +48,7 @@ type AttachmentRepository interface {
 	ListBelongsTo(ctx context.Context, belongsTo model.ID, page CursorPage, proj AttachmentProjection) (Page[*Attachment], error)
 	Update(ctx context.Context, id model.ID, opts UpdateAttachmentOpts) (*Attachment, error)
 	Delete(ctx context.Context, id model.ID) error
+	ResolveOrganization(ctx context.Context, belongsTo model.ID) (model.ID, error)
 }
 
 // Neo4jAttachmentRepository is a repository for managing attachments.
@@ -54,7 +56,7 @@ type Neo4jAttachmentRepository struct {
 	*neo4jBaseRepository
 }
 
-func (r *Neo4jAttachmentRepository) scan(cp, op string) func(rec *neo4j.Record) (*Attachment, error) {
+func (r *Neo4jAttachmentRepository) scan(cp, op, bp string) func(rec *neo4j.Record) (*Attachment, error) {
 	return func(rec *neo4j.Record) (*Attachment, error) {
 		attachment := new(Attachment)
 
@@ -68,10 +70,19 @@ func (r *Neo4jAttachmentRepository) scan(cp, op string) func(rec *neo4j.Record)
 			return nil, err
 		}
 
+		belongsTo, err := Neo4jRecordNode(rec, bp)
+		if err != nil {
+			return nil, err
+		}
+
 		if err := Neo4jScanIntoStruct(&val, &attachment, []string{"id", "created_by"}); err != nil {
 			return nil, err
 		}
 
+		if attachment.BelongsTo, err = Neo4jDecodeIDFromLabel(belongsTo); err != nil {
+			return nil, err
+		}
+
 		attachment.ID, _ = model.NewIDFromString(val.GetProperties()["id"].(string), model.ResourceTypeAttachment.String())
 		attachment.CreatedBy, _ = model.NewIDFromString(createdBy, model.ResourceTypeUser.String())
 
@@ -129,7 +140,7 @@ func (r *Neo4jAttachmentRepository) Get(ctx context.Context, id model.ID, proj A
 	var attachment *Attachment
 	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
 		var runErr error
-		attachment, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("a", "o"))
+		attachment, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("a", "o", "b"))
 		return runErr
 	})
 	if err != nil {
@@ -160,7 +171,7 @@ func (r *Neo4jAttachmentRepository) ListBelongsTo(ctx context.Context, belongsTo
 	attachments := make([]*Attachment, 0)
 	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
 		var runErr error
-		attachments, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("a", "o"))
+		attachments, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("a", "o", "b"))
 		return runErr
 	})
 	if err != nil {
@@ -211,6 +222,34 @@ func (r *Neo4jAttachmentRepository) Delete(ctx context.Context, id model.ID) err
 	return nil
 }
 
+func (r *Neo4jAttachmentRepository) ResolveOrganization(ctx context.Context, belongsTo model.ID) (model.ID, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.AttachmentRepository/ResolveOrganization")
+	defer span.End()
+
+	cypher := `
+	MATCH (:` + belongsTo.Label() + ` {id: $id})-[:` + EdgeKindInScopeOf.String() + `*0..4]->(org:` + model.ResourceTypeOrganization.String() + `)
+	RETURN org.id AS id
+	LIMIT 1`
+	params := map[string]any{"id": belongsTo.String()}
+
+	orgID, err := Neo4jExecuteReadAndReadSingle(ctx, r.db, cypher, params, func(rec *neo4j.Record) (*model.ID, error) {
+		val, err := Neo4jParseValueFromRecord[string](rec, "id")
+		if err != nil {
+			return nil, err
+		}
+		id, err := model.NewIDFromString(val, model.ResourceTypeOrganization.String())
+		if err != nil {
+			return nil, err
+		}
+		return &id, nil
+	})
+	if err != nil {
+		return model.ID{}, errors.Join(ErrAttachmentRead, err)
+	}
+
+	return *orgID, nil
+}
+
 // NewNeo4jAttachmentRepository creates a new attachment neo4jBaseRepository.
 func NewNeo4jAttachmentRepository(opts ...Neo4jRepositoryOption) (*Neo4jAttachmentRepository, error) {
 	baseRepo, err := newNeo4jRepository(opts...)
@@ -366,6 +405,10 @@ func (r *RedisCachedAttachmentRepository) Delete(ctx context.Context, id model.I
 	return r.attachmentRepo.Delete(ctx, id)
 }
 
+func (r *RedisCachedAttachmentRepository) ResolveOrganization(ctx context.Context, belongsTo model.ID) (model.ID, error) {
+	return r.attachmentRepo.ResolveOrganization(ctx, belongsTo)
+}
+
 // NewCachedAttachmentRepository returns a new CachedAttachmentRepository.
 func NewCachedAttachmentRepository(repo AttachmentRepository, opts ...RedisRepositoryOption) (*RedisCachedAttachmentRepository, error) {
 	r, err := newRedisBaseRepository(opts...)
@@ -66,6 +66,7 @@ func (s *AttachmentRepositoryIntegrationTestSuite) TestGet() {
 	s.Assert().Equal(s.createOpts.Name, attachment.Name)
 	s.Assert().Equal(s.createOpts.FileID, attachment.FileID)
 	s.Assert().Equal(s.createOpts.CreatedBy, attachment.CreatedBy)
+	s.Assert().Equal(s.createOpts.BelongsTo, attachment.BelongsTo)
 	s.Assert().WithinDuration(*created.CreatedAt, *attachment.CreatedAt, 100*time.Millisecond)
 }
 
@@ -104,6 +105,12 @@ func (s *AttachmentRepositoryIntegrationTestSuite) TestDelete() {
 	s.Assert().ErrorIs(err, repository.ErrNotFound)
 }
 
+func (s *AttachmentRepositoryIntegrationTestSuite) TestResolveOrganization() {
+	orgID, err := s.AttachmentRepo.ResolveOrganization(context.Background(), s.testDoc.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.testOrg.ID, orgID)
+}
+
 func TestAttachmentRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(AttachmentRepositoryIntegrationTestSuite))
 }
@@ -100,6 +100,21 @@ func (mr *MockAttachmentRepositoryMockRecorder) ListBelongsTo(ctx, belongsTo, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBelongsTo", reflect.TypeOf((*MockAttachmentRepository)(nil).ListBelongsTo), ctx, belongsTo, page, proj)
 }
 
+// ResolveOrganization mocks base method.
+func (m *MockAttachmentRepository) ResolveOrganization(ctx context.Context, belongsTo model.ID) (model.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOrganization", ctx, belongsTo)
+	ret0, _ := ret[0].(model.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOrganization indicates an expected call of ResolveOrganization.
+func (mr *MockAttachmentRepositoryMockRecorder) ResolveOrganization(ctx, belongsTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOrganization", reflect.TypeOf((*MockAttachmentRepository)(nil).ResolveOrganization), ctx, belongsTo)
+}
+
 // Update mocks base method.
 func (m *MockAttachmentRepository) Update(ctx context.Context, id model.ID, opts UpdateAttachmentOpts) (*Attachment, error) {
 	m.ctrl.T.Helper()
@@ -38,7 +38,8 @@ func (q AttachmentGetQuery) Compile() (QueryPlan, error) {
 			Name: "attachment.get",
 			Cypher: `
 				MATCH (a:` + q.ID.Label() + ` {id: $id})<-[:` + EdgeKindCreated.String() + `]-(o:` + model.ResourceTypeUser.String() + `)
-				RETURN a, o.id AS o`,
+				MATCH (b)-[:` + EdgeKindHasAttachment.String() + `]->(a)
+				RETURN a, o.id AS o, b`,
 			Params: map[string]any{"id": q.ID.String()},
 		},
 	}
@@ -66,10 +67,10 @@ func (q AttachmentListBelongsToQuery) Compile() (QueryPlan, error) {
 		Root: CompiledQuery{
 			Name: "attachment.list_belongs_to",
 			Cypher: strings.TrimSpace(`
-				MATCH (:` + q.BelongsTo.Label() + ` {id: $id})-[:` + EdgeKindHasAttachment.String() + `]->(a:` + model.ResourceTypeAttachment.String() + `)
+				MATCH (b:` + q.BelongsTo.Label() + ` {id: $id})-[:` + EdgeKindHasAttachment.String() + `]->(a:` + model.ResourceTypeAttachment.String() + `)
 				MATCH (o:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindCreated.String() + `]->(a)
 				` + cursorWherePrefix(bounds.Where, "WHERE ") + `
-				RETURN a, o.id AS o
+				RETURN a, o.id AS o, b
 				ORDER BY a.id ` + bounds.Order.Cypher() + `
 				LIMIT $limit`),
 			Params: params,
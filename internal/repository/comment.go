@@ -21,6 +21,7 @@ var (
 type Comment struct {
 	ID        model.ID   `json:"id"`
 	Content   string     `json:"content"`
+	BelongsTo model.ID   `json:"belongs_to"`
 	CreatedBy model.ID   `json:"created_by"`
 	CreatedAt *time.Time `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at"`
@@ -52,7 +53,7 @@ type Neo4jCommentRepository struct {
 	*neo4jBaseRepository
 }
 
-func (r *Neo4jCommentRepository) scan(cp, op string) func(rec *neo4j.Record) (*Comment, error) {
+func (r *Neo4jCommentRepository) scan(cp, op, bp string) func(rec *neo4j.Record) (*Comment, error) {
 	return func(rec *neo4j.Record) (*Comment, error) {
 		comment := new(Comment)
 
@@ -66,10 +67,19 @@ func (r *Neo4jCommentRepository) scan(cp, op string) func(rec *neo4j.Record) (*C
 			return nil, err
 		}
 
+		belongsTo, err := Neo4jRecordNode(rec, bp)
+		if err != nil {
+			return nil, err
+		}
+
 		if err := Neo4jScanIntoStruct(&val, &comment, []string{"id", "created_by"}); err != nil {
 			return nil, err
 		}
 
+		if comment.BelongsTo, err = Neo4jDecodeIDFromLabel(belongsTo); err != nil {
+			return nil, err
+		}
+
 		comment.ID, _ = model.NewIDFromString(val.GetProperties()["id"].(string), model.ResourceTypeComment.String())
 		comment.CreatedBy, _ = model.NewIDFromString(createdBy, model.ResourceTypeUser.String())
 
@@ -123,7 +133,7 @@ func (r *Neo4jCommentRepository) Get(ctx context.Context, id model.ID) (*Comment
 	var comment *Comment
 	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
 		var runErr error
-		comment, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("c", "o"))
+		comment, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("c", "o", "b"))
 		return runErr
 	})
 	if err != nil {
@@ -153,7 +163,7 @@ func (r *Neo4jCommentRepository) ListBelongsTo(ctx context.Context, belongsTo mo
 	comments := make([]*Comment, 0)
 	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
 		var runErr error
-		comments, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("c", "o"))
+		comments, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("c", "o", "b"))
 		return runErr
 	})
 	if err != nil {
@@ -67,6 +67,7 @@ func (s *CommentRepositoryIntegrationTestSuite) TestGet() {
 	s.Require().NoError(err)
 
 	s.Assert().Equal(created.ID, comment.ID)
+	s.Assert().Equal(s.createOpts.BelongsTo, comment.BelongsTo)
 	s.Assert().Equal(s.createOpts.CreatedBy, comment.CreatedBy)
 	s.Assert().Equal(s.createOpts.Content, comment.Content)
 	s.Assert().WithinDuration(*created.CreatedAt, *comment.CreatedAt, 100*time.Millisecond)
@@ -26,7 +26,8 @@ func (q CommentGetQuery) Compile() (QueryPlan, error) {
 			Name: "comment.get",
 			Cypher: `
 				MATCH (c:` + q.ID.Label() + ` {id: $id})<-[:` + EdgeKindCommented.String() + `]-(o:` + model.ResourceTypeUser.String() + `)
-				RETURN c, o.id AS o`,
+				MATCH (b)-[:` + EdgeKindHasComment.String() + `]->(c)
+				RETURN c, o.id AS o, b`,
 			Params: map[string]any{"id": q.ID.String()},
 		},
 	}
@@ -54,10 +55,10 @@ func (q CommentListBelongsToQuery) Compile() (QueryPlan, error) {
 		Root: CompiledQuery{
 			Name: "comment.list_belongs_to",
 			Cypher: strings.TrimSpace(`
-				MATCH (:` + q.BelongsTo.Label() + ` {id: $id})-[:` + EdgeKindHasComment.String() + `]->(c:` + model.ResourceTypeComment.String() + `)
+				MATCH (b:` + q.BelongsTo.Label() + ` {id: $id})-[:` + EdgeKindHasComment.String() + `]->(c:` + model.ResourceTypeComment.String() + `)
 				MATCH (o:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindCommented.String() + `]->(c)
 				` + cursorWherePrefix(bounds.Where, "WHERE ") + `
-				RETURN c, o.id AS o
+				RETURN c, o.id AS o, b
 				ORDER BY c.id ` + bounds.Order.Cypher() + `
 				LIMIT $limit`),
 			Params: params,
@@ -0,0 +1,494 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+var (
+	ErrComponentAttach = errors.New("failed to attach component") // the component could not be attached
+	ErrComponentCreate = errors.New("failed to create component") // the component could not be created
+	ErrComponentDelete = errors.New("failed to delete component") // the component could not be deleted
+	ErrComponentDetach = errors.New("failed to detach component") // the component could not be detached
+	ErrComponentRead   = errors.New("failed to read component")   // the component could not be retrieved
+	ErrComponentUpdate = errors.New("failed to update component") // the component could not be updated
+)
+
+// PartialComponent is a lean component used on issue reads.
+type PartialComponent struct {
+	ID   model.ID `json:"id"`
+	Name string   `json:"name"`
+}
+
+// Component represents a project component persisted by the repository.
+type Component struct {
+	ID          model.ID   `json:"id"`
+	Project     model.ID   `json:"project"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Lead        *model.ID  `json:"lead"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+// CreateComponentOpts holds the data required to create a component.
+type CreateComponentOpts struct {
+	Project     model.ID
+	Name        string
+	Description string
+	Lead        *model.ID
+}
+
+// UpdateComponentOpts holds the fields that can be updated on a component.
+// Undefined fields (Defined == false) are left unchanged, and a defined but
+// nil Lead removes the lead of the component.
+type UpdateComponentOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Lead        optional.Optional[model.ID]
+}
+
+// patch builds a Neo4j property map from defined optional fields.
+func (o UpdateComponentOpts) patch() map[string]any {
+	p := make(map[string]any)
+
+	if o.Name.Defined {
+		p["name"] = *o.Name.Value
+	}
+	if o.Description.Defined {
+		p["description"] = *o.Description.Value
+	}
+
+	return p
+}
+
+//go:generate go tool mockgen -source=component.go -destination=component_mock_gen.go -package=repository -mock_names "ComponentRepository=MockComponentRepository"
+type ComponentRepository interface {
+	Create(ctx context.Context, opts CreateComponentOpts) (*Component, error)
+	Get(ctx context.Context, id model.ID, proj ComponentProjection) (*Component, error)
+	ListForProject(ctx context.Context, project model.ID, page CursorPage, proj ComponentProjection) (Page[*Component], error)
+	Update(ctx context.Context, id model.ID, opts UpdateComponentOpts) (*Component, error)
+	AttachTo(ctx context.Context, componentID, issueID model.ID) error
+	DetachFrom(ctx context.Context, componentID, issueID model.ID) error
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// Neo4jComponentRepository is a repository for managing project components.
+type Neo4jComponentRepository struct {
+	*neo4jBaseRepository
+}
+
+func (r *Neo4jComponentRepository) scan(cp, pp, lp string) func(rec *neo4j.Record) (*Component, error) {
+	return func(rec *neo4j.Record) (*Component, error) {
+		c := new(Component)
+
+		node, err := Neo4jRecordNode(rec, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := Neo4jScanIntoStruct(&node, &c, []string{"id", "project", "lead"}); err != nil {
+			return nil, err
+		}
+
+		if c.ID, err = Neo4jDecodeID(node, model.ResourceTypeComponent); err != nil {
+			return nil, err
+		}
+
+		projectID, err := Neo4jParseValueFromRecord[string](rec, pp)
+		if err != nil {
+			return nil, err
+		}
+		if c.Project, err = model.NewIDFromString(projectID, model.ResourceTypeProject.String()); err != nil {
+			return nil, errors.Join(ErrMalformedResult, err)
+		}
+
+		leadID, isNil, err := neo4j.GetRecordValue[string](rec, lp)
+		if err != nil {
+			return nil, errors.Join(ErrMalformedResult, err)
+		}
+		if !isNil {
+			lead, err := model.NewIDFromString(leadID, model.ResourceTypeUser.String())
+			if err != nil {
+				return nil, errors.Join(ErrMalformedResult, err)
+			}
+			c.Lead = &lead
+		}
+
+		return c, nil
+	}
+}
+
+func (r *Neo4jComponentRepository) Create(ctx context.Context, opts CreateComponentOpts) (*Component, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/Create")
+	defer span.End()
+
+	if err := opts.Project.Validate(); err != nil || opts.Project.Type != model.ResourceTypeProject {
+		return nil, errors.Join(ErrComponentCreate, model.ErrInvalidID)
+	}
+
+	createdAt := time.Now().UTC()
+	id := model.MustNewID(model.ResourceTypeComponent)
+
+	params := map[string]any{
+		"id":          id.String(),
+		"project_id":  opts.Project.String(),
+		"rel_id":      model.NewRawID(),
+		"name":        opts.Name,
+		"description": opts.Description,
+		"created_at":  createdAt.Format(time.RFC3339Nano),
+	}
+
+	// The lead is matched before the component is created, so a missing user
+	// fails the query instead of creating a component without a lead.
+	leadMatch, leadCreate := "", ""
+	if opts.Lead != nil {
+		leadMatch = `MATCH (u:` + opts.Lead.Label() + ` {id: $lead_id})`
+		leadCreate = `, (u)-[:` + EdgeKindLeads.String() + ` {id: $lead_rel_id, created_at: datetime($created_at)}]->(c)`
+		params["lead_id"] = opts.Lead.String()
+		params["lead_rel_id"] = model.NewRawID()
+	}
+
+	cypher := `
+	MATCH (p:` + opts.Project.Label() + ` {id: $project_id})
+	` + leadMatch + `
+	CREATE
+		(c:` + id.Label() + ` {id: $id, name: $name, description: $description, created_at: datetime($created_at)}),
+		(c)-[:` + EdgeKindBelongsTo.String() + ` {id: $rel_id, created_at: datetime($created_at)}]->(p)` + leadCreate + `
+	RETURN c.id AS id`
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrComponentCreate, err)
+	}
+
+	return r.Get(ctx, id, ComponentDetailProjection())
+}
+
+func (r *Neo4jComponentRepository) Get(ctx context.Context, id model.ID, proj ComponentProjection) (*Component, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/Get")
+	defer span.End()
+
+	plan, err := CompileQuery(ComponentGetQuery{
+		ID:         id,
+		Projection: proj,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrComponentRead, err)
+	}
+
+	var component *Component
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		component, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("c", "project_id", "lead_id"))
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrComponentRead, err)
+	}
+
+	return component, nil
+}
+
+func (r *Neo4jComponentRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj ComponentProjection) (Page[*Component], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/ListForProject")
+	defer span.End()
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Component]{}, errors.Join(ErrComponentRead, err)
+	}
+	plan, err := CompileQuery(ComponentListForProjectQuery{
+		ProjectID:  project,
+		Page:       normalized,
+		Order:      SortDirectionDesc,
+		Projection: proj,
+	})
+	if err != nil {
+		return Page[*Component]{}, errors.Join(ErrComponentRead, err)
+	}
+
+	items := make([]*Component, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("c", "project_id", "lead_id"))
+		return runErr
+	})
+	if err != nil {
+		return Page[*Component]{}, errors.Join(ErrComponentRead, err)
+	}
+
+	return PaginateSlice(items, normalized.Size, func(component *Component) model.ID {
+		return component.ID
+	})
+}
+
+func (r *Neo4jComponentRepository) Update(ctx context.Context, id model.ID, opts UpdateComponentOpts) (*Component, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/Update")
+	defer span.End()
+
+	params := map[string]any{
+		"id":    id.String(),
+		"patch": opts.patch(),
+	}
+
+	leadUpdate := ""
+	if opts.Lead.Defined {
+		leadUpdate = `
+		WITH c
+		OPTIONAL MATCH (:` + model.ResourceTypeUser.String() + `)-[r:` + EdgeKindLeads.String() + `]->(c)
+		DELETE r
+		WITH DISTINCT c`
+
+		if opts.Lead.Value != nil {
+			leadUpdate += `
+			MATCH (u:` + opts.Lead.Value.Label() + ` {id: $lead_id})
+			CREATE (u)-[:` + EdgeKindLeads.String() + ` {id: $lead_rel_id, created_at: datetime()}]->(c)`
+			params["lead_id"] = opts.Lead.Value.String()
+			params["lead_rel_id"] = model.NewRawID()
+		}
+	}
+
+	cypher := `
+	MATCH (c:` + id.Label() + ` {id: $id})
+	SET c += $patch, c.updated_at = datetime()` + leadUpdate + `
+	RETURN c.id AS id`
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrComponentUpdate, err)
+	}
+
+	return r.Get(ctx, id, ComponentDetailProjection())
+}
+
+func (r *Neo4jComponentRepository) AttachTo(ctx context.Context, componentID, issueID model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/AttachTo")
+	defer span.End()
+
+	cypher := `
+	MATCH (c:` + componentID.Label() + ` {id: $component_id})
+	MATCH (i:` + issueID.Label() + ` {id: $issue_id})
+	MERGE (i)-[r:` + EdgeKindInComponent.String() + `]->(c)
+	ON CREATE SET r.id = $rel_id, r.created_at = datetime()`
+
+	params := map[string]any{
+		"component_id": componentID.String(),
+		"issue_id":     issueID.String(),
+		"rel_id":       model.NewRawID(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrComponentAttach, err)
+	}
+
+	return nil
+}
+
+func (r *Neo4jComponentRepository) DetachFrom(ctx context.Context, componentID, issueID model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/DetachFrom")
+	defer span.End()
+
+	cypher := `
+	MATCH (i:` + issueID.Label() + ` {id: $issue_id})-[r:` + EdgeKindInComponent.String() + `]->(c:` + componentID.Label() + ` {id: $component_id})
+	DELETE r`
+
+	params := map[string]any{
+		"component_id": componentID.String(),
+		"issue_id":     issueID.String(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrComponentDetach, err)
+	}
+
+	return nil
+}
+
+func (r *Neo4jComponentRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ComponentRepository/Delete")
+	defer span.End()
+
+	cypher := `MATCH (c:` + id.Label() + ` {id: $id}) DETACH DELETE c`
+	params := map[string]any{
+		"id": id.String(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrComponentDelete, err)
+	}
+
+	return nil
+}
+
+// NewNeo4jComponentRepository creates a new component neo4jBaseRepository.
+func NewNeo4jComponentRepository(opts ...Neo4jRepositoryOption) (*Neo4jComponentRepository, error) {
+	baseRepo, err := newNeo4jRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Neo4jComponentRepository{
+		neo4jBaseRepository: baseRepo,
+	}, nil
+}
+
+func clearComponentsPattern(ctx context.Context, r *redisBaseRepository, pattern ...string) error {
+	return r.DeletePattern(ctx, composeCacheKey(model.ResourceTypeComponent.String(), pattern))
+}
+
+func clearComponentsKey(ctx context.Context, r *redisBaseRepository, id model.ID) error {
+	return clearComponentsPattern(ctx, r, "Get", id.String(), "*")
+}
+
+func clearComponentAllLists(ctx context.Context, r *redisBaseRepository) error {
+	return clearComponentsPattern(ctx, r, "List", "*", "*", "*", "*")
+}
+
+// RedisCachedComponentRepository implements caching on the
+// ComponentRepository.
+type RedisCachedComponentRepository struct {
+	cacheRepo     *redisBaseRepository
+	componentRepo ComponentRepository
+}
+
+func (r *RedisCachedComponentRepository) Create(ctx context.Context, opts CreateComponentOpts) (*Component, error) {
+	if err := clearComponentAllLists(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	return r.componentRepo.Create(ctx, opts)
+}
+
+func (r *RedisCachedComponentRepository) Get(ctx context.Context, id model.ID, proj ComponentProjection) (*Component, error) {
+	var component *Component
+	var err error
+
+	key := composeCacheKey(model.ResourceTypeComponent.String(), "Get", id.String(), projectionCacheValue(proj))
+	if err = r.cacheRepo.Get(ctx, key, &component); err != nil {
+		return nil, err
+	}
+
+	if component != nil {
+		return component, nil
+	}
+
+	if component, err = r.componentRepo.Get(ctx, id, proj); err != nil {
+		return nil, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, component); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+func (r *RedisCachedComponentRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj ComponentProjection) (Page[*Component], error) {
+	var components Page[*Component]
+	var err error
+
+	normalized, err := normalizedPage(page)
+	if err != nil {
+		return Page[*Component]{}, err
+	}
+
+	key := composeCacheKey(
+		model.ResourceTypeComponent.String(),
+		"List",
+		project.String(),
+		projectionCacheValue(proj),
+		pageTokenValue(normalized.Token),
+		normalized.Size,
+	)
+	if err = r.cacheRepo.Get(ctx, key, &components); err != nil {
+		return Page[*Component]{}, err
+	}
+
+	if components.Items != nil {
+		return components, nil
+	}
+
+	if components, err = r.componentRepo.ListForProject(ctx, project, normalized, proj); err != nil {
+		return Page[*Component]{}, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, components); err != nil {
+		return Page[*Component]{}, err
+	}
+
+	return components, nil
+}
+
+func (r *RedisCachedComponentRepository) Update(ctx context.Context, id model.ID, opts UpdateComponentOpts) (*Component, error) {
+	component, err := r.componentRepo.Update(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := composeCacheKey(model.ResourceTypeComponent.String(), "Get", id.String(), projectionCacheValue(ComponentDetailProjection()))
+	if err := r.cacheRepo.Set(ctx, key, component); err != nil {
+		return nil, err
+	}
+
+	if err := clearComponentAllLists(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	if err := clearIssuesPattern(ctx, r.cacheRepo, "*"); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+func (r *RedisCachedComponentRepository) AttachTo(ctx context.Context, componentID, issueID model.ID) error {
+	if err := clearIssuesPattern(ctx, r.cacheRepo, "*"); err != nil {
+		return err
+	}
+
+	return r.componentRepo.AttachTo(ctx, componentID, issueID)
+}
+
+func (r *RedisCachedComponentRepository) DetachFrom(ctx context.Context, componentID, issueID model.ID) error {
+	if err := clearIssuesPattern(ctx, r.cacheRepo, "*"); err != nil {
+		return err
+	}
+
+	return r.componentRepo.DetachFrom(ctx, componentID, issueID)
+}
+
+func (r *RedisCachedComponentRepository) Delete(ctx context.Context, id model.ID) error {
+	if err := clearComponentsKey(ctx, r.cacheRepo, id); err != nil {
+		return err
+	}
+	if err := clearComponentAllLists(ctx, r.cacheRepo); err != nil {
+		return err
+	}
+	if err := clearIssuesPattern(ctx, r.cacheRepo, "*"); err != nil {
+		return err
+	}
+
+	return r.componentRepo.Delete(ctx, id)
+}
+
+// NewCachedComponentRepository returns a new CachedComponentRepository.
+func NewCachedComponentRepository(repo ComponentRepository, opts ...RedisRepositoryOption) (*RedisCachedComponentRepository, error) {
+	r, err := newRedisBaseRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCachedComponentRepository{
+		cacheRepo:     r,
+		componentRepo: repo,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComponentRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.Neo4jContainerIntegrationTestSuite
+
+	testUser      *repository.User
+	testOrg       *repository.Organization
+	testNamespace *repository.Namespace
+	testProject   *repository.Project
+	createOpts    repository.CreateComponentOpts
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	s.SetupNeo4j(&s.ContainerIntegrationTestSuite, reflect.TypeOf(s).Elem().String())
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) SetupTest() {
+	var err error
+	s.testUser, err = s.UserRepo.Create(context.Background(), testModel.NewCreateUserOpts())
+	s.Require().NoError(err)
+	s.testOrg, err = s.OrganizationRepo.Create(context.Background(), testModel.NewCreateOrganizationOpts(s.testUser.ID))
+	s.Require().NoError(err)
+	s.testNamespace, err = s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	s.testProject, err = s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(s.testNamespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	s.createOpts = testModel.NewCreateComponentOpts(s.testProject.ID, &s.testUser.ID)
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupNeo4j(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TestCreate() {
+	component, err := s.ComponentRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	s.Assert().NotEqual(model.MustNewNilID(model.ResourceTypeComponent), component.ID)
+	s.Assert().Equal(s.testProject.ID, component.Project)
+	s.Assert().Equal(s.createOpts.Name, component.Name)
+	s.Assert().Equal(&s.testUser.ID, component.Lead)
+	s.Assert().NotNil(component.CreatedAt)
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TestCreateMissingLead() {
+	lead := model.MustNewID(model.ResourceTypeUser)
+	_, err := s.ComponentRepo.Create(context.Background(), testModel.NewCreateComponentOpts(s.testProject.ID, &lead))
+	s.Assert().ErrorIs(err, repository.ErrComponentCreate)
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TestListForProject() {
+	for range 3 {
+		_, err := s.ComponentRepo.Create(context.Background(), testModel.NewCreateComponentOpts(s.testProject.ID, nil))
+		s.Require().NoError(err)
+	}
+
+	components, err := s.ComponentRepo.ListForProject(context.Background(), s.testProject.ID, repository.CursorPage{Size: 2}, repository.ComponentListProjection())
+	s.Require().NoError(err)
+	s.Assert().Len(components.Items, 2)
+	s.Assert().True(components.PageInfo.HasMore)
+	for _, component := range components.Items {
+		s.Assert().Nil(component.Lead)
+	}
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TestUpdate() {
+	component, err := s.ComponentRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	updated, err := s.ComponentRepo.Update(context.Background(), component.ID, repository.UpdateComponentOpts{
+		Name: optional.Some("API"),
+		Lead: optional.Null[model.ID](),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("API", updated.Name)
+	s.Assert().Equal(component.Description, updated.Description)
+	s.Assert().Nil(updated.Lead)
+	s.Assert().NotNil(updated.UpdatedAt)
+
+	updated, err = s.ComponentRepo.Update(context.Background(), component.ID, repository.UpdateComponentOpts{
+		Lead: optional.Some(s.testUser.ID),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&s.testUser.ID, updated.Lead)
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TestAttachAndFilterIssues() {
+	component, err := s.ComponentRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	issue, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	_, err = s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ComponentRepo.AttachTo(context.Background(), component.ID, issue.ID))
+
+	got, err := s.IssueRepo.Get(context.Background(), issue.ID, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal([]repository.PartialComponent{{ID: component.ID, Name: component.Name}}, got.Components)
+
+	issues, err := s.IssueRepo.ListForProject(context.Background(), repository.IssueListQuery{
+		ProjectID:  s.testProject.ID,
+		Page:       repository.CursorPage{Size: 10},
+		Filter:     repository.IssueListFilter{Components: []model.ID{component.ID}},
+		Projection: repository.IssueListForProjectProjection(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(issues.Items, 1)
+	s.Assert().Equal(issue.ID, issues.Items[0].ID)
+
+	s.Require().NoError(s.ComponentRepo.DetachFrom(context.Background(), component.ID, issue.ID))
+
+	got, err = s.IssueRepo.Get(context.Background(), issue.ID, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Empty(got.Components)
+}
+
+func (s *ComponentRepositoryIntegrationTestSuite) TestDelete() {
+	component, err := s.ComponentRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ComponentRepo.Delete(context.Background(), component.ID))
+
+	_, err = s.ComponentRepo.Get(context.Background(), component.ID, repository.ComponentDetailProjection())
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func TestComponentRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ComponentRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+// ComponentProjection selects bounded fields for component reads.
+type ComponentProjection struct{}
+
+func ComponentListProjection() ComponentProjection {
+	return ComponentProjection{}
+}
+
+func ComponentDetailProjection() ComponentProjection {
+	return ComponentProjection{}
+}
+
+type ComponentGetQuery struct {
+	ID         model.ID
+	Projection ComponentProjection
+}
+
+// ComponentListForProjectQuery lists the components of a project.
+type ComponentListForProjectQuery struct {
+	ProjectID  model.ID
+	Page       CursorPage
+	Order      SortDirection
+	Projection ComponentProjection
+}
+
+func (q ComponentGetQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "component.get",
+			Cypher: `
+				MATCH (c:` + q.ID.Label() + ` {id: $id})-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				OPTIONAL MATCH (u:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindLeads.String() + `]->(c)
+				RETURN c, p.id AS project_id, u.id AS lead_id`,
+			Params: map[string]any{"id": q.ID.String()},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q ComponentListForProjectQuery) Compile() (QueryPlan, error) {
+	if err := q.ProjectID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+	params := map[string]any{
+		"project_id": q.ProjectID.String(),
+	}
+	bounds, err := compileCursorBounds("c", q.Page, q.Order, params)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "component.list_for_project",
+			Cypher: strings.TrimSpace(`
+				MATCH (c:` + model.ResourceTypeComponent.String() + `)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + q.ProjectID.Label() + ` {id: $project_id})
+				` + cursorWherePrefix(bounds.Where, "WHERE ") + `
+				WITH c, p
+				ORDER BY c.id ` + bounds.Order.Cypher() + `
+				LIMIT $limit
+				OPTIONAL MATCH (u:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindLeads.String() + `]->(c)
+				RETURN c, p.id AS project_id, u.id AS lead_id`,
+			),
+			Params: params,
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentGetQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	componentID := model.MustNewID(model.ResourceTypeComponent)
+
+	t.Run("root query matches component with project and lead", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ComponentGetQuery{
+			ID:         componentID,
+			Projection: ComponentDetailProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "component.get", plan.Root.Name)
+		assert.Empty(t, plan.Loaders)
+		assert.Contains(t, plan.Root.Cypher, EdgeKindBelongsTo.String())
+		assert.Contains(t, plan.Root.Cypher, EdgeKindLeads.String())
+		assert.Contains(t, plan.Root.Cypher, "RETURN c, p.id AS project_id, u.id AS lead_id")
+		assert.Equal(t, componentID.String(), plan.Root.Params["id"])
+	})
+
+	t.Run("invalid component id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ComponentGetQuery{ID: model.ID{}})
+		require.Error(t, err)
+	})
+}
+
+func TestComponentListForProjectQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("root query lists components of the project", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ComponentListForProjectQuery{
+			ProjectID:  projectID,
+			Page:       CursorPage{Size: 10},
+			Order:      SortDirectionDesc,
+			Projection: ComponentListProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "component.list_for_project", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY c.id DESC")
+		assert.Equal(t, projectID.String(), plan.Root.Params["project_id"])
+		assert.Equal(t, 11, plan.Root.Params["limit"])
+	})
+
+	t.Run("invalid project id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ComponentListForProjectQuery{
+			ProjectID: model.ID{},
+			Page:      CursorPage{Size: 10},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ComponentListForProjectQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: MaxPageSize + 1},
+		})
+		require.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
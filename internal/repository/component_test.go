@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newDeletePatternCacheRepo returns a redisBaseRepository expecting the given
+// key patterns to be cleared in order.
+func newDeletePatternCacheRepo(t *testing.T, ctrl *gomock.Controller, ctx context.Context, keys ...string) *redisBaseRepository {
+	dbClient := mock.NewUniversalClient(ctrl)
+	cacheRepo := mock.NewCacheBackend(ctrl)
+	for _, key := range keys {
+		keyCmd := new(redis.StringSliceCmd)
+		keyCmd.SetVal([]string{key})
+		dbClient.EXPECT().Keys(ctx, key).Return(keyCmd)
+		cacheRepo.EXPECT().Delete(ctx, key).Return(nil)
+	}
+
+	db, err := NewRedisDatabase(
+		WithRedisClient(dbClient),
+	)
+	require.NoError(t, err)
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).Times(len(keys))
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(len(keys))
+
+	return &redisBaseRepository{
+		db:     db,
+		cache:  cacheRepo,
+		tracer: tracer,
+		logger: mock.NewMockLogger(ctrl),
+	}
+}
+
+func TestCachedComponentRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	opts := CreateComponentOpts{
+		Project: model.MustNewID(model.ResourceTypeProject),
+		Name:    "API",
+	}
+
+	listKey := composeCacheKey(model.ResourceTypeComponent.String(), "List", "*", "*", "*", "*")
+
+	componentRepo := NewMockComponentRepository(ctrl)
+	componentRepo.EXPECT().Create(ctx, opts).Return(&Component{Name: opts.Name}, nil)
+
+	r := &RedisCachedComponentRepository{
+		cacheRepo:     newDeletePatternCacheRepo(t, ctrl, ctx, listKey),
+		componentRepo: componentRepo,
+	}
+
+	got, err := r.Create(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, opts.Name, got.Name)
+}
+
+func TestCachedComponentRepository_AttachTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{
+			name: "attach component to issue",
+		},
+		{
+			name:    "attach component to issue with error",
+			repoErr: ErrComponentAttach,
+			wantErr: ErrComponentAttach,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			componentID := model.MustNewID(model.ResourceTypeComponent)
+			issueID := model.MustNewID(model.ResourceTypeIssue)
+
+			issuesKey := composeCacheKey(model.ResourceTypeIssue.String(), "*")
+
+			componentRepo := NewMockComponentRepository(ctrl)
+			componentRepo.EXPECT().AttachTo(ctx, componentID, issueID).Return(tt.repoErr)
+
+			r := &RedisCachedComponentRepository{
+				cacheRepo:     newDeletePatternCacheRepo(t, ctrl, ctx, issuesKey),
+				componentRepo: componentRepo,
+			}
+
+			require.ErrorIs(t, r.AttachTo(ctx, componentID, issueID), tt.wantErr)
+		})
+	}
+}
+
+func TestCachedComponentRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeComponent)
+
+	getKey := composeCacheKey(model.ResourceTypeComponent.String(), "Get", id.String(), "*")
+	listKey := composeCacheKey(model.ResourceTypeComponent.String(), "List", "*", "*", "*", "*")
+	issuesKey := composeCacheKey(model.ResourceTypeIssue.String(), "*")
+
+	componentRepo := NewMockComponentRepository(ctrl)
+	componentRepo.EXPECT().Delete(ctx, id).Return(nil)
+
+	r := &RedisCachedComponentRepository{
+		cacheRepo:     newDeletePatternCacheRepo(t, ctrl, ctx, getKey, listKey, issuesKey),
+		componentRepo: componentRepo,
+	}
+
+	require.NoError(t, r.Delete(ctx, id))
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+var (
+	ErrCustomFieldRead = errors.New("failed to read custom fields") // the custom fields could not be retrieved
+	ErrCustomFieldSave = errors.New("failed to save custom fields") // the custom fields could not be saved
+)
+
+//go:generate go tool mockgen -source=custom_field.go -destination=custom_field_mock_gen.go -package=repository -mock_names "CustomFieldRepository=MockCustomFieldRepository"
+type CustomFieldRepository interface {
+	// List returns the custom fields defined for the issues of the project.
+	// If the project has no custom fields, an empty list is returned.
+	List(ctx context.Context, project model.ID) (model.CustomFields, error)
+	// Save replaces the custom fields of the project.
+	Save(ctx context.Context, project model.ID, fields model.CustomFields) (model.CustomFields, error)
+}
+
+// PGCustomFieldRepository is a repository for managing the custom fields of
+// project issues.
+type PGCustomFieldRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGCustomFieldRepository) List(ctx context.Context, project model.ID) (model.CustomFields, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.CustomFieldRepository/List")
+	defer span.End()
+
+	fields := make(model.CustomFields, 0)
+	if err := r.db.pool.QueryRow(ctx, "SELECT fields FROM project_custom_fields WHERE project_id = $1", project).Scan(&fields); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return make(model.CustomFields, 0), nil
+		}
+		return nil, errors.Join(ErrCustomFieldRead, err)
+	}
+
+	return fields, nil
+}
+
+func (r *PGCustomFieldRepository) Save(ctx context.Context, project model.ID, fields model.CustomFields) (model.CustomFields, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.CustomFieldRepository/Save")
+	defer span.End()
+
+	if fields == nil {
+		fields = make(model.CustomFields, 0)
+	}
+
+	saved := make(model.CustomFields, 0)
+	if err := r.db.pool.QueryRow(ctx,
+		`INSERT INTO project_custom_fields (project_id, fields, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE SET fields = EXCLUDED.fields, updated_at = timezone('utc', now())
+		RETURNING fields`,
+		project, fields, convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	).Scan(&saved); err != nil {
+		return nil, errors.Join(ErrCustomFieldSave, err)
+	}
+
+	return saved, nil
+}
+
+// NewCustomFieldRepository creates a new CustomFieldRepository.
+func NewCustomFieldRepository(opts ...PGRepositoryOption) (*PGCustomFieldRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGCustomFieldRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type CustomFieldRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	project model.ID
+	fields  model.CustomFields
+}
+
+func (s *CustomFieldRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *CustomFieldRepositoryIntegrationTestSuite) SetupTest() {
+	s.project = model.MustNewID(model.ResourceTypeProject)
+	s.fields = model.CustomFields{
+		{Key: "customer", Name: "Customer", Type: model.CustomFieldTypeText, Required: true},
+		{Key: "platforms", Name: "Platforms", Type: model.CustomFieldTypeMultiSelect, Options: []string{"ios", "web"}},
+	}
+}
+
+func (s *CustomFieldRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *CustomFieldRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *CustomFieldRepositoryIntegrationTestSuite) TestSave() {
+	fields, err := s.CustomFieldRepo.Save(context.Background(), s.project, s.fields)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.fields, fields)
+
+	fields, err = s.CustomFieldRepo.Save(context.Background(), s.project, s.fields[:1])
+	s.Require().NoError(err)
+	s.Assert().Equal(s.fields[:1], fields)
+}
+
+func (s *CustomFieldRepositoryIntegrationTestSuite) TestList() {
+	fields, err := s.CustomFieldRepo.List(context.Background(), s.project)
+	s.Require().NoError(err)
+	s.Assert().Empty(fields)
+
+	_, err = s.CustomFieldRepo.Save(context.Background(), s.project, s.fields)
+	s.Require().NoError(err)
+
+	fields, err = s.CustomFieldRepo.List(context.Background(), s.project)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.fields, fields)
+}
+
+func TestCustomFieldRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomFieldRepositoryIntegrationTestSuite))
+}
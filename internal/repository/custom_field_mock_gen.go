@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: custom_field.go
+//
+// Generated by this command:
+//
+//	mockgen -source=custom_field.go -destination=custom_field_mock_gen.go -package=repository -mock_names CustomFieldRepository=MockCustomFieldRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldRepository is a mock of CustomFieldRepository interface.
+type MockCustomFieldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldRepositoryMockRecorder is the mock recorder for MockCustomFieldRepository.
+type MockCustomFieldRepositoryMockRecorder struct {
+	mock *MockCustomFieldRepository
+}
+
+// NewMockCustomFieldRepository creates a new mock instance.
+func NewMockCustomFieldRepository(ctrl *gomock.Controller) *MockCustomFieldRepository {
+	mock := &MockCustomFieldRepository{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldRepository) EXPECT() *MockCustomFieldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockCustomFieldRepository) List(ctx context.Context, project model.ID) (model.CustomFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, project)
+	ret0, _ := ret[0].(model.CustomFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomFieldRepositoryMockRecorder) List(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomFieldRepository)(nil).List), ctx, project)
+}
+
+// Save mocks base method.
+func (m *MockCustomFieldRepository) Save(ctx context.Context, project model.ID, fields model.CustomFields) (model.CustomFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, project, fields)
+	ret0, _ := ret[0].(model.CustomFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCustomFieldRepositoryMockRecorder) Save(ctx, project, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCustomFieldRepository)(nil).Save), ctx, project, fields)
+}
@@ -330,6 +330,41 @@ func Neo4jRecordPartialLabels(record *neo4j.Record, key string) ([]PartialLabel,
 	return parsePartialLabels(val)
 }
 
+func partialComponentFromNode(node neo4j.Node) (PartialComponent, error) {
+	id, err := Neo4jDecodeID(node, model.ResourceTypeComponent)
+	if err != nil {
+		return PartialComponent{}, err
+	}
+	name, err := Neo4jNodeProperty[string](node, "name")
+	if err != nil {
+		return PartialComponent{}, errors.Join(ErrMalformedResult, err)
+	}
+	return PartialComponent{ID: id, Name: name}, nil
+}
+
+func Neo4jRecordPartialComponents(record *neo4j.Record, key string) ([]PartialComponent, error) {
+	val, err := Neo4jParseValueFromRecord[[]any](record, key)
+	if err != nil {
+		return nil, err
+	}
+	components := make([]PartialComponent, 0, len(val))
+	for _, item := range val {
+		if item == nil {
+			continue
+		}
+		node, ok := item.(neo4j.Node)
+		if !ok {
+			return nil, ErrMalformedResult
+		}
+		component, err := partialComponentFromNode(node)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
+
 func partialUserFromNode(node neo4j.Node) (*PartialUser, error) {
 	id, err := Neo4jDecodeID(node, model.ResourceTypeUser)
 	if err != nil {
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/tracing"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+)
+
+const (
+	// DefaultEventReplaySize is the number of recent events kept for
+	// reconnecting subscribers.
+	DefaultEventReplaySize = 1000
+
+	eventChannel     = "events:stream"
+	eventReplayKey   = "events:replay"
+	eventSequenceKey = "events:sequence"
+)
+
+var (
+	ErrEventPublish   = errors.New("failed to publish event")       // the event could not be published
+	ErrEventRead      = errors.New("failed to read events")         // the events could not be retrieved
+	ErrEventSubscribe = errors.New("failed to subscribe to events") // the event stream could not be subscribed
+)
+
+// Event represents a change published to the connected clients. Events
+// addressed to a single user, like notifications, have a Recipient.
+type Event struct {
+	ID            int64
+	Type          string
+	Resource      model.ID
+	Actor         *model.ID
+	Recipient     *model.ID
+	ChangedFields []string
+	CreatedAt     time.Time
+}
+
+// eventRecord is the serialized form of an Event. IDs are stored in their
+// composite form, so the resource type survives the round trip.
+type eventRecord struct {
+	ID            int64     `json:"id"`
+	Type          string    `json:"type"`
+	Resource      string    `json:"resource"`
+	Actor         string    `json:"actor,omitempty"`
+	Recipient     string    `json:"recipient,omitempty"`
+	ChangedFields []string  `json:"changed_fields"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func encodeEvent(event *Event) ([]byte, error) {
+	record := eventRecord{
+		ID:            event.ID,
+		Type:          event.Type,
+		Resource:      event.Resource.Composite(),
+		ChangedFields: event.ChangedFields,
+		CreatedAt:     event.CreatedAt,
+	}
+	if event.Actor != nil {
+		record.Actor = event.Actor.Composite()
+	}
+	if event.Recipient != nil {
+		record.Recipient = event.Recipient.Composite()
+	}
+
+	return json.Marshal(record)
+}
+
+func decodeEvent(data []byte) (*Event, error) {
+	var record eventRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+
+	resource, err := model.ParseCompositeID(record.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		ID:            record.ID,
+		Type:          record.Type,
+		Resource:      resource,
+		ChangedFields: record.ChangedFields,
+		CreatedAt:     record.CreatedAt,
+	}
+	if event.ChangedFields == nil {
+		event.ChangedFields = make([]string, 0)
+	}
+
+	if record.Actor != "" {
+		actor, err := model.ParseCompositeID(record.Actor)
+		if err != nil {
+			return nil, err
+		}
+		event.Actor = &actor
+	}
+
+	if record.Recipient != "" {
+		recipient, err := model.ParseCompositeID(record.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		event.Recipient = &recipient
+	}
+
+	return event, nil
+}
+
+// EventRepository publishes events to every server instance and keeps a
+// bounded buffer of the recent ones for reconnecting subscribers.
+//
+//go:generate go tool mockgen -source=event.go -destination=event_mock_gen.go -package=repository -mock_names "EventRepository=MockEventRepository"
+type EventRepository interface {
+	// Publish assigns the next sequence number to the event and publishes it.
+	Publish(ctx context.Context, event *Event) error
+	// Since returns the buffered events published after the given ID ordered
+	// by their ID.
+	Since(ctx context.Context, id int64) ([]*Event, error)
+	// Subscribe returns the stream of events published after the call. The
+	// channel is closed when the context is done.
+	Subscribe(ctx context.Context) (<-chan *Event, error)
+}
+
+// EventRepositoryOption configures a RedisEventRepository.
+type EventRepositoryOption func(*RedisEventRepository) error
+
+// WithEventDatabase sets the Redis database of the RedisEventRepository.
+func WithEventDatabase(db *RedisDatabase) EventRepositoryOption {
+	return func(r *RedisEventRepository) error {
+		if db == nil {
+			return ErrNoDriver
+		}
+		r.db = db
+
+		return nil
+	}
+}
+
+// WithEventReplaySize sets the number of recent events kept for reconnecting
+// subscribers.
+func WithEventReplaySize(size int64) EventRepositoryOption {
+	return func(r *RedisEventRepository) error {
+		if size < 1 {
+			return ErrInvalidConfig
+		}
+		r.replaySize = size
+
+		return nil
+	}
+}
+
+// WithEventRepositoryLogger sets the logger of the RedisEventRepository.
+func WithEventRepositoryLogger(logger log.Logger) EventRepositoryOption {
+	return func(r *RedisEventRepository) error {
+		if logger == nil {
+			return log.ErrNoLogger
+		}
+		r.logger = logger
+
+		return nil
+	}
+}
+
+// WithEventRepositoryTracer sets the tracer of the RedisEventRepository.
+func WithEventRepositoryTracer(tracer tracing.Tracer) EventRepositoryOption {
+	return func(r *RedisEventRepository) error {
+		if tracer == nil {
+			return tracing.ErrNoTracer
+		}
+		r.tracer = tracer
+
+		return nil
+	}
+}
+
+// RedisEventRepository implements the EventRepository using Redis pub/sub.
+// The replay buffer is a capped list next to the channel.
+type RedisEventRepository struct {
+	db         *RedisDatabase `validate:"required"`
+	logger     log.Logger     `validate:"required"`
+	tracer     tracing.Tracer `validate:"required"`
+	replaySize int64          `validate:"required,min=1"`
+}
+
+func (r *RedisEventRepository) Publish(ctx context.Context, event *Event) error {
+	ctx, span := r.tracer.Start(ctx, "repository.redis.EventRepository/Publish")
+	defer span.End()
+
+	id, err := r.db.Client().Incr(ctx, eventSequenceKey).Result()
+	if err != nil {
+		return errors.Join(ErrEventPublish, err)
+	}
+
+	event.ID = id
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+
+	payload, err := encodeEvent(event)
+	if err != nil {
+		return errors.Join(ErrEventPublish, err)
+	}
+
+	if _, err := r.db.Client().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.RPush(ctx, eventReplayKey, payload)
+		pipe.LTrim(ctx, eventReplayKey, -r.replaySize, -1)
+		pipe.Publish(ctx, eventChannel, payload)
+		return nil
+	}); err != nil {
+		return errors.Join(ErrEventPublish, err)
+	}
+
+	return nil
+}
+
+func (r *RedisEventRepository) Since(ctx context.Context, id int64) ([]*Event, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.redis.EventRepository/Since")
+	defer span.End()
+
+	payloads, err := r.db.Client().LRange(ctx, eventReplayKey, 0, -1).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Join(ErrEventRead, err)
+	}
+
+	events := make([]*Event, 0)
+	for _, payload := range payloads {
+		event, err := decodeEvent([]byte(payload))
+		if err != nil {
+			return nil, errors.Join(ErrEventRead, err)
+		}
+		if event.ID > id {
+			events = append(events, event)
+		}
+	}
+
+	// Concurrent publishers may push events in a different order than their
+	// sequence numbers were assigned.
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+func (r *RedisEventRepository) Subscribe(ctx context.Context) (<-chan *Event, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.redis.EventRepository/Subscribe")
+	defer span.End()
+
+	pubsub := r.db.Client().Subscribe(ctx, eventChannel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, errors.Join(ErrEventSubscribe, err)
+	}
+
+	events := make(chan *Event)
+	go func() {
+		defer close(events)
+		defer func() { _ = pubsub.Close() }()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				event, err := decodeEvent([]byte(message.Payload))
+				if err != nil {
+					r.logger.Warn(ctx, "failed to decode event", log.WithError(err))
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// NewRedisEventRepository creates a new RedisEventRepository.
+func NewRedisEventRepository(opts ...EventRepositoryOption) (*RedisEventRepository, error) {
+	r := &RedisEventRepository{
+		logger:     log.DefaultLogger(),
+		tracer:     tracing.NoopTracer(),
+		replaySize: DefaultEventReplaySize,
+	}
+
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.db == nil {
+		return nil, ErrNoDriver
+	}
+
+	if err := validate.Struct(r); err != nil {
+		return nil, errors.Join(ErrInvalidRepository, err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.RedisContainerIntegrationTestSuite
+
+	eventRepo *repository.RedisEventRepository
+}
+
+func (s *EventRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+
+	s.SetupRedis(&s.ContainerIntegrationTestSuite, reflect.TypeOf(s).Elem().String())
+
+	var err error
+	s.eventRepo, err = repository.NewRedisEventRepository(
+		repository.WithEventDatabase(s.RedisDB),
+		repository.WithEventReplaySize(2),
+	)
+	s.Require().NoError(err)
+}
+
+func (s *EventRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupRedis(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *EventRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *EventRepositoryIntegrationTestSuite) newEvent() *repository.Event {
+	actor := model.MustNewID(model.ResourceTypeUser)
+	return &repository.Event{
+		Type:          "issue.updated",
+		Resource:      model.MustNewID(model.ResourceTypeIssue),
+		Actor:         &actor,
+		ChangedFields: []string{"status"},
+	}
+}
+
+func (s *EventRepositoryIntegrationTestSuite) TestPublishAndSubscribe() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := s.eventRepo.Subscribe(ctx)
+	s.Require().NoError(err)
+
+	published := s.newEvent()
+	s.Require().NoError(s.eventRepo.Publish(context.Background(), published))
+	s.Assert().Positive(published.ID)
+
+	select {
+	case received := <-events:
+		s.Assert().Equal(published.ID, received.ID)
+		s.Assert().Equal(published.Type, received.Type)
+		s.Assert().Equal(published.Resource, received.Resource)
+		s.Assert().Equal(published.Actor, received.Actor)
+		s.Assert().Nil(received.Recipient)
+		s.Assert().Equal(published.ChangedFields, received.ChangedFields)
+	case <-time.After(5 * time.Second):
+		s.Fail("event not received")
+	}
+
+	cancel()
+	_, ok := <-events
+	s.Assert().False(ok)
+}
+
+func (s *EventRepositoryIntegrationTestSuite) TestSince() {
+	first, second, third := s.newEvent(), s.newEvent(), s.newEvent()
+	for _, event := range []*repository.Event{first, second, third} {
+		s.Require().NoError(s.eventRepo.Publish(context.Background(), event))
+	}
+
+	events, err := s.eventRepo.Since(context.Background(), 0)
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Assert().Equal(second.ID, events[0].ID)
+	s.Assert().Equal(third.ID, events[1].ID)
+
+	events, err = s.eventRepo.Since(context.Background(), second.ID)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Assert().Equal(third.ID, events[0].ID)
+}
+
+func TestEventRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(EventRepositoryIntegrationTestSuite))
+}
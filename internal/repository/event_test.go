@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/tracing"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func TestEncodeDecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	actor := model.MustNewID(model.ResourceTypeUser)
+	recipient := model.MustNewID(model.ResourceTypeUser)
+
+	tests := []struct {
+		name  string
+		event *Event
+	}{
+		{
+			name: "issue event",
+			event: &Event{
+				ID:            1,
+				Type:          "issue.updated",
+				Resource:      model.MustNewID(model.ResourceTypeIssue),
+				Actor:         &actor,
+				ChangedFields: []string{"status", "priority"},
+				CreatedAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+		{
+			name: "notification event",
+			event: &Event{
+				ID:            2,
+				Type:          "notification.created",
+				Resource:      model.MustNewID(model.ResourceTypeNotification),
+				Recipient:     &recipient,
+				ChangedFields: []string{},
+				CreatedAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := encodeEvent(tt.event)
+			require.NoError(t, err)
+
+			got, err := decodeEvent(data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.event, got)
+		})
+	}
+}
+
+func TestDecodeEvent_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := decodeEvent([]byte(`{"id":1,"type":"issue.updated","resource":"invalid"}`))
+	assert.ErrorIs(t, err, model.ErrInvalidID)
+
+	_, err = decodeEvent([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestNewRedisEventRepository(t *testing.T) {
+	t.Parallel()
+
+	db, err := NewRedisDatabase(WithRedisClient(mock.NewUniversalClient(gomock.NewController(t))))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		opts    []EventRepositoryOption
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "default replay size",
+			opts: []EventRepositoryOption{WithEventDatabase(db)},
+			want: DefaultEventReplaySize,
+		},
+		{
+			name: "custom replay size",
+			opts: []EventRepositoryOption{
+				WithEventDatabase(db),
+				WithEventReplaySize(10),
+				WithEventRepositoryLogger(log.DefaultLogger()),
+				WithEventRepositoryTracer(tracing.NoopTracer()),
+			},
+			want: 10,
+		},
+		{
+			name:    "no database",
+			opts:    []EventRepositoryOption{WithEventDatabase(nil)},
+			wantErr: ErrNoDriver,
+		},
+		{
+			name:    "missing database",
+			wantErr: ErrNoDriver,
+		},
+		{
+			name:    "invalid replay size",
+			opts:    []EventRepositoryOption{WithEventDatabase(db), WithEventReplaySize(0)},
+			wantErr: ErrInvalidConfig,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewRedisEventRepository(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got.replaySize)
+			}
+		})
+	}
+}
@@ -15,17 +15,22 @@ import (
 )
 
 var (
-	ErrIssueAddRelation    = errors.New("failed to add relation to issue")      // the relation could not be added to the issue
-	ErrIssueAddWatcher     = errors.New("failed to add watcher to issue")       // the watcher could not be added to the issue
-	ErrIssueCreate         = errors.New("failed to create issue")               // the issue could not be created
-	ErrIssueDelete         = errors.New("failed to delete issue")               // the issue could not be deleted
-	ErrIssueGetRelation    = errors.New("failed to get issue relation")         // the relation could not be retrieved
-	ErrIssueGetRelations   = errors.New("failed to get relations for issue")    // the relations could not be retrieved for the issue
-	ErrIssueGetWatchers    = errors.New("failed to get watchers for issue")     // the watchers could not be retrieved for the issue
-	ErrIssueRead           = errors.New("failed to read issue")                 // the issue could not be retrieved
-	ErrIssueRemoveRelation = errors.New("failed to remove relation from issue") // the relation could not be removed from the issue
-	ErrIssueRemoveWatcher  = errors.New("failed to remove watcher from issue")  // the watcher could not be removed from the issue
-	ErrIssueUpdate         = errors.New("failed to update issue")               // the issue could not be updated
+	ErrIssueAddRelation     = errors.New("failed to add relation to issue")      // the relation could not be added to the issue
+	ErrIssueAddWatcher      = errors.New("failed to add watcher to issue")       // the watcher could not be added to the issue
+	ErrIssueCount           = errors.New("failed to count issues")               // the issues could not be counted
+	ErrIssueCreate          = errors.New("failed to create issue")               // the issue could not be created
+	ErrIssueDelete          = errors.New("failed to delete issue")               // the issue could not be deleted
+	ErrIssueGetDependencies = errors.New("failed to get issue dependencies")     // the dependencies could not be retrieved for the issue
+	ErrIssueGetEstimates    = errors.New("failed to get issue estimates")        // the estimates could not be retrieved for the issue
+	ErrIssueGetRelation     = errors.New("failed to get issue relation")         // the relation could not be retrieved
+	ErrIssueGetRelations    = errors.New("failed to get relations for issue")    // the relations could not be retrieved for the issue
+	ErrIssueGetRollups      = errors.New("failed to get issue rollups")          // the rollups could not be retrieved for the issues
+	ErrIssueGetWatchers     = errors.New("failed to get watchers for issue")     // the watchers could not be retrieved for the issue
+	ErrIssueMove            = errors.New("failed to move issue")                 // the issue could not be moved
+	ErrIssueRead            = errors.New("failed to read issue")                 // the issue could not be retrieved
+	ErrIssueRemoveRelation  = errors.New("failed to remove relation from issue") // the relation could not be removed from the issue
+	ErrIssueRemoveWatcher   = errors.New("failed to remove watcher from issue")  // the watcher could not be removed from the issue
+	ErrIssueUpdate          = errors.New("failed to update issue")               // the issue could not be updated
 )
 
 // PartialAssignee is a lean assignment of a user to an issue.
@@ -39,53 +44,64 @@ type PartialAssignee struct {
 
 // PartialIssue represents a simplified issue that can be used in lists.
 type PartialIssue struct {
-	ID          model.ID            `json:"id"`
-	Key         string              `json:"key"`
-	NumericID   uint                `json:"numeric_id"`
-	Parent      *PartialIssue       `json:"parent"`
-	Kind        model.IssueKind     `json:"kind"`
-	Title       string              `json:"title"`
-	Description string              `json:"description"`
-	Status      model.IssueStatus   `json:"status"`
-	Priority    model.IssuePriority `json:"priority"`
-	Assignments []PartialAssignee   `json:"assignments"`
-	Labels      []PartialLabel      `json:"labels"`
-	Project     *PartialProject     `json:"project"`
-	Namespace   *PartialNamespace   `json:"namespace"`
-	ReportedBy  *PartialUser        `json:"reported_by"`
-	DueDate     *time.Time          `json:"due_date"`
-	StartDate   *time.Time          `json:"start_date"`
-	CreatedAt   *time.Time          `json:"created_at"`
-	UpdatedAt   *time.Time          `json:"updated_at"`
+	ID             model.ID            `json:"id"`
+	Key            string              `json:"key"`
+	NumericID      uint                `json:"numeric_id"`
+	Rank           string              `json:"rank"`
+	Parent         *PartialIssue       `json:"parent"`
+	Kind           model.IssueKind     `json:"kind"`
+	Title          string              `json:"title"`
+	Description    string              `json:"description"`
+	Status         model.IssueStatus   `json:"status"`
+	WorkflowStatus *string             `json:"workflow_status"`
+	Priority       model.IssuePriority `json:"priority"`
+	Assignments    []PartialAssignee   `json:"assignments"`
+	Labels         []PartialLabel      `json:"labels"`
+	Components     []PartialComponent  `json:"components"`
+	CustomFields   map[string]any      `json:"custom_fields"`
+	Project        *PartialProject     `json:"project"`
+	Namespace      *PartialNamespace   `json:"namespace"`
+	ReportedBy     *PartialUser        `json:"reported_by"`
+	DueDate        *time.Time          `json:"due_date"`
+	StartDate      *time.Time          `json:"start_date"`
+	CreatedAt      *time.Time          `json:"created_at"`
+	UpdatedAt      *time.Time          `json:"updated_at"`
 }
 
 // Issue represents an issue persisted by the repository.
 type Issue struct {
-	ID              model.ID              `json:"id"`
-	Key             string                `json:"key"`
-	NumericID       uint                  `json:"numeric_id"`
-	Parent          *PartialIssue         `json:"parent"`
-	Kind            model.IssueKind       `json:"kind"`
-	Title           string                `json:"title"`
-	Description     string                `json:"description"`
-	Status          model.IssueStatus     `json:"status"`
-	Priority        model.IssuePriority   `json:"priority"`
-	Resolution      model.IssueResolution `json:"resolution"`
-	ReportedBy      *PartialUser          `json:"reported_by"`
-	Assignments     []PartialAssignee     `json:"assignments"`
-	Labels          []PartialLabel        `json:"labels"`
-	Project         *PartialProject       `json:"project"`
-	Namespace       *PartialNamespace     `json:"namespace"`
-	CommentCount    *int64                `json:"comment_count"`
-	DocumentCount   *int64                `json:"document_count"`
-	AttachmentCount *int64                `json:"attachment_count"`
-	WatcherCount    *int64                `json:"watcher_count"`
-	RelationCount   *int64                `json:"relation_count"`
-	Links           []model.IssueLink     `json:"links"`
-	DueDate         *time.Time            `json:"due_date"`
-	StartDate       *time.Time            `json:"start_date"`
-	CreatedAt       *time.Time            `json:"created_at"`
-	UpdatedAt       *time.Time            `json:"updated_at"`
+	ID                model.ID              `json:"id"`
+	Key               string                `json:"key"`
+	NumericID         uint                  `json:"numeric_id"`
+	Parent            *PartialIssue         `json:"parent"`
+	Kind              model.IssueKind       `json:"kind"`
+	Title             string                `json:"title"`
+	Description       string                `json:"description"`
+	Status            model.IssueStatus     `json:"status"`
+	WorkflowStatus    *string               `json:"workflow_status"`
+	Priority          model.IssuePriority   `json:"priority"`
+	Resolution        model.IssueResolution `json:"resolution"`
+	ReportedBy        *PartialUser          `json:"reported_by"`
+	Assignments       []PartialAssignee     `json:"assignments"`
+	Labels            []PartialLabel        `json:"labels"`
+	Components        []PartialComponent    `json:"components"`
+	Project           *PartialProject       `json:"project"`
+	Namespace         *PartialNamespace     `json:"namespace"`
+	CommentCount      *int64                `json:"comment_count"`
+	DocumentCount     *int64                `json:"document_count"`
+	AttachmentCount   *int64                `json:"attachment_count"`
+	WatcherCount      *int64                `json:"watcher_count"`
+	RelationCount     *int64                `json:"relation_count"`
+	Links             []model.IssueLink     `json:"links"`
+	CustomFields      map[string]any        `json:"custom_fields"`
+	StoryPoints       *float64              `json:"story_points"`
+	OriginalEstimate  *uint                 `json:"original_estimate"`
+	RemainingEstimate *uint                 `json:"remaining_estimate"`
+	DueDate           *time.Time            `json:"due_date"`
+	StartDate         *time.Time            `json:"start_date"`
+	Rollup            *model.IssueRollup    `json:"rollup"`
+	CreatedAt         *time.Time            `json:"created_at"`
+	UpdatedAt         *time.Time            `json:"updated_at"`
 }
 
 // IssueRelation represents a relation between issues persisted by the repository.
@@ -98,6 +114,15 @@ type IssueRelation struct {
 	UpdatedAt *time.Time              `json:"updated_at"`
 }
 
+// IssueEstimate holds the estimates of an issue. The original and remaining
+// estimates are in minutes.
+type IssueEstimate struct {
+	ID                model.ID `json:"id"`
+	StoryPoints       *float64 `json:"story_points"`
+	OriginalEstimate  *uint    `json:"original_estimate"`
+	RemainingEstimate *uint    `json:"remaining_estimate"`
+}
+
 // IssueRelationItem is a paginated relation row with the related issue loaded
 // as a lean PartialIssue. Source and Target follow startNode/endNode.
 type IssueRelationItem struct {
@@ -112,18 +137,22 @@ type IssueRelationItem struct {
 // CreateIssueOpts holds the data required to create an issue.
 // NumericID is allocated atomically from the project's next_issue_id counter.
 type CreateIssueOpts struct {
-	ProjectID   model.ID
-	Parent      *model.ID
-	Kind        model.IssueKind
-	Title       string
-	Description string
-	Status      model.IssueStatus
-	Priority    model.IssuePriority
-	Resolution  model.IssueResolution
-	ReportedBy  model.ID
-	Links       []model.IssueLink
-	DueDate     *time.Time
-	StartDate   *time.Time
+	ProjectID        model.ID
+	Parent           *model.ID
+	Kind             model.IssueKind
+	Title            string
+	Description      string
+	Status           model.IssueStatus
+	WorkflowStatus   *string
+	Priority         model.IssuePriority
+	Resolution       model.IssueResolution
+	ReportedBy       model.ID
+	Links            []model.IssueLink
+	CustomFields     map[string]any
+	StoryPoints      *float64
+	OriginalEstimate *uint // in minutes, the remaining estimate starts from it
+	DueDate          *time.Time
+	StartDate        *time.Time
 }
 
 // CreateIssueRelationOpts holds the data required to create an issue relation.
@@ -136,16 +165,24 @@ type CreateIssueRelationOpts struct {
 // UpdateIssueOpts holds the fields that can be updated on an issue.
 // Undefined fields (Defined == false) are left unchanged.
 type UpdateIssueOpts struct {
-	Kind        optional.Optional[model.IssueKind]
-	Title       optional.Optional[string]
-	Description optional.Optional[string]
-	Status      optional.Optional[model.IssueStatus]
-	Priority    optional.Optional[model.IssuePriority]
-	Resolution  optional.Optional[model.IssueResolution]
-	Links       optional.Optional[[]model.IssueLink]
-	DueDate     optional.Optional[time.Time]
-	StartDate   optional.Optional[time.Time]
-	Parent      optional.Optional[model.ID]
+	Kind              optional.Optional[model.IssueKind]
+	Title             optional.Optional[string]
+	Description       optional.Optional[string]
+	Status            optional.Optional[model.IssueStatus]
+	WorkflowStatus    optional.Optional[string]
+	Priority          optional.Optional[model.IssuePriority]
+	Resolution        optional.Optional[model.IssueResolution]
+	Links             optional.Optional[[]model.IssueLink]
+	StoryPoints       optional.Optional[float64]
+	OriginalEstimate  optional.Optional[uint] // in minutes
+	RemainingEstimate optional.Optional[uint] // in minutes
+	DueDate           optional.Optional[time.Time]
+	StartDate         optional.Optional[time.Time]
+	Parent            optional.Optional[model.ID]
+	CustomFields      map[string]any // values by field key, a nil value clears the field
+	// Rank moves the issue in the backlog of its project in the same
+	// transaction as the update, if set.
+	Rank *RankIssueOpts
 }
 
 // patch builds a Neo4j property map from defined optional fields.
@@ -168,6 +205,13 @@ func (o UpdateIssueOpts) patch() map[string]any {
 	if o.Status.Defined {
 		p["status"] = o.Status.Value.String()
 	}
+	if o.WorkflowStatus.Defined {
+		if o.WorkflowStatus.Value == nil {
+			p["workflow_status"] = nil
+		} else {
+			p["workflow_status"] = *o.WorkflowStatus.Value
+		}
+	}
 	if o.Priority.Defined {
 		p["priority"] = o.Priority.Value.String()
 	}
@@ -182,6 +226,27 @@ func (o UpdateIssueOpts) patch() map[string]any {
 		p["links"] = encodeIssueLinks(links)
 		p["link_labels"] = nil
 	}
+	if o.StoryPoints.Defined {
+		if o.StoryPoints.Value == nil {
+			p["story_points"] = nil
+		} else {
+			p["story_points"] = *o.StoryPoints.Value
+		}
+	}
+	if o.OriginalEstimate.Defined {
+		if o.OriginalEstimate.Value == nil {
+			p["original_estimate"] = nil
+		} else {
+			p["original_estimate"] = int64(*o.OriginalEstimate.Value)
+		}
+	}
+	if o.RemainingEstimate.Defined {
+		if o.RemainingEstimate.Value == nil {
+			p["remaining_estimate"] = nil
+		} else {
+			p["remaining_estimate"] = int64(*o.RemainingEstimate.Value)
+		}
+	}
 	if o.DueDate.Defined {
 		if o.DueDate.Value == nil {
 			p["due_date"] = nil
@@ -196,10 +261,46 @@ func (o UpdateIssueOpts) patch() map[string]any {
 			p["start_date"] = o.StartDate.Value.Format(time.RFC3339Nano)
 		}
 	}
+	for name, value := range issueCustomFieldProperties(o.CustomFields) {
+		p[name] = value
+	}
 
 	return p
 }
 
+// issueCustomFieldPrefix prefixes the names of the issue properties storing
+// the values of custom fields, keeping them apart from the built-in fields.
+const issueCustomFieldPrefix = "cf_"
+
+// issueCustomFieldProperty returns the name of the issue property storing the
+// value of a custom field.
+func issueCustomFieldProperty(key string) string {
+	return issueCustomFieldPrefix + key
+}
+
+func issueCustomFieldProperties(values map[string]any) map[string]any {
+	props := make(map[string]any, len(values))
+	for key, value := range values {
+		props[issueCustomFieldProperty(key)] = value
+	}
+	return props
+}
+
+func decodeIssueCustomFields(props map[string]any) map[string]any {
+	var values map[string]any
+	for name, value := range props {
+		key, found := strings.CutPrefix(name, issueCustomFieldPrefix)
+		if !found || value == nil {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]any)
+		}
+		values[key] = value
+	}
+	return values
+}
+
 // issueLinkLabelSep separates an optional label from the URL in a stored link
 // entry. Neo4j cannot persist MAP or LIST<LIST<STRING>> properties, so each
 // pair is one STRING in a homogeneous LIST<STRING>: "url" or "url\tlabel".
@@ -342,7 +443,11 @@ type IssueRepository interface {
 	ListForNamespace(ctx context.Context, query IssueListForNamespaceQuery) (Page[*PartialIssue], error)
 	ListForUser(ctx context.Context, query IssueListForUserQuery) (Page[*PartialIssue], error)
 	ListForIssue(ctx context.Context, query IssueListForIssueQuery) (Page[*Issue], error)
+	// GetEstimates returns the estimates of the issue followed by the
+	// estimates of its subtasks at any depth.
+	GetEstimates(ctx context.Context, issue model.ID) ([]*IssueEstimate, error)
 	AddWatcher(ctx context.Context, issue model.ID, user model.ID) error
+	AutoWatch(ctx context.Context, issue model.ID, users []model.ID) error
 	GetWatchers(ctx context.Context, issue model.ID) ([]*User, error)
 	RemoveWatcher(ctx context.Context, issue model.ID, user model.ID) error
 	AddRelation(ctx context.Context, opts CreateIssueRelationOpts) (*IssueRelation, error)
@@ -351,8 +456,41 @@ type IssueRepository interface {
 	ListRelations(ctx context.Context, query IssueRelationListQuery) (Page[*IssueRelationItem], error)
 	RemoveRelation(ctx context.Context, source, target model.ID, kind model.IssueRelationKind) error
 	RemoveRelationByID(ctx context.Context, relationID model.ID) error
+	// HasRelationCycle returns true if adding the relation would close a
+	// cycle of blocking or subtask relations, leaving out the relation with
+	// the ignore ID.
+	HasRelationCycle(ctx context.Context, opts CreateIssueRelationOpts, ignore *model.ID) (bool, error)
+	// GetDependencyGraph returns the issues reachable from the issue through
+	// blocking and subtask relations up to the depth, with their relations.
+	GetDependencyGraph(ctx context.Context, issue model.ID, depth int) (*IssueDependencyGraph, error)
+	// GetProjectDependencies returns the scheduled and ordered issues of the
+	// project with the blocking relations between them.
+	GetProjectDependencies(ctx context.Context, project model.ID) (*IssueDependencyGraph, error)
+	// GetRollups returns the progress of the issues aggregated from their
+	// subtasks at any depth. Issues that do not exist are left out.
+	GetRollups(ctx context.Context, ids []model.ID) (map[model.ID]*model.IssueRollup, error)
+	// GetAncestors returns the IDs of the issues the issue is a subtask of at
+	// any depth.
+	GetAncestors(ctx context.Context, issue model.ID) ([]model.ID, error)
+	// CountByField returns the number of issues of the project by the value
+	// of the field. Issues without a value are left out.
+	CountByField(ctx context.Context, project model.ID, field IssueListConditionField) (map[string]int64, error)
 	Update(ctx context.Context, id model.ID, opts UpdateIssueOpts, proj IssueProjection) (*Issue, error)
+	// GetBatchScopes returns what the caches of a batch of issues depend on
+	// in a single read. Issues that do not exist are left out.
+	GetBatchScopes(ctx context.Context, ids []model.ID) ([]*IssueBatchScope, error)
+	// UpdateMany updates a batch of issues, invalidating the caches once for
+	// the whole batch. Issues that do not exist are left out of the result.
+	UpdateMany(ctx context.Context, updates []IssueUpdate, proj IssueProjection) ([]*Issue, error)
+	// Rank moves the issue in the backlog of its project.
+	Rank(ctx context.Context, id model.ID, opts RankIssueOpts) error
+	// Move moves the issue and its subtasks to another project, keeping
+	// their previous keys as aliases.
+	Move(ctx context.Context, id, projectID model.ID, opts MoveIssueOpts) ([]*MovedIssue, error)
 	Delete(ctx context.Context, id model.ID) error
+	// DeleteMany deletes a batch of issues, invalidating the caches once for
+	// the whole batch.
+	DeleteMany(ctx context.Context, ids []model.ID) error
 }
 
 // Neo4jIssueRepository is a repository for managing user issues.
@@ -384,16 +522,18 @@ func decodePartialIssueNode(node neo4j.Node, projectKey string) (*PartialIssue,
 	}
 
 	var tempIssue struct {
-		NumericID   uint       `json:"numeric_id"`
-		Title       string     `json:"title"`
-		Description string     `json:"description"`
-		Kind        string     `json:"kind"`
-		Status      string     `json:"status"`
-		Priority    string     `json:"priority"`
-		DueDate     *time.Time `json:"due_date"`
-		StartDate   *time.Time `json:"start_date"`
-		CreatedAt   *time.Time `json:"created_at"`
-		UpdatedAt   *time.Time `json:"updated_at"`
+		NumericID      uint       `json:"numeric_id"`
+		Rank           string     `json:"rank"`
+		Title          string     `json:"title"`
+		Description    string     `json:"description"`
+		Kind           string     `json:"kind"`
+		Status         string     `json:"status"`
+		WorkflowStatus *string    `json:"workflow_status"`
+		Priority       string     `json:"priority"`
+		DueDate        *time.Time `json:"due_date"`
+		StartDate      *time.Time `json:"start_date"`
+		CreatedAt      *time.Time `json:"created_at"`
+		UpdatedAt      *time.Time `json:"updated_at"`
 	}
 	if err := Neo4jScanIntoStruct(&node, &tempIssue, []string{"id"}); err != nil {
 		return nil, err
@@ -415,20 +555,24 @@ func decodePartialIssueNode(node neo4j.Node, projectKey string) (*PartialIssue,
 	}
 
 	return &PartialIssue{
-		ID:          issueID,
-		Key:         model.FormatIssueKey(projectKey, tempIssue.NumericID),
-		NumericID:   tempIssue.NumericID,
-		Kind:        kind,
-		Title:       tempIssue.Title,
-		Description: tempIssue.Description,
-		Status:      status,
-		Priority:    priority,
-		Assignments: make([]PartialAssignee, 0),
-		Labels:      make([]PartialLabel, 0),
-		DueDate:     tempIssue.DueDate,
-		StartDate:   tempIssue.StartDate,
-		CreatedAt:   tempIssue.CreatedAt,
-		UpdatedAt:   tempIssue.UpdatedAt,
+		ID:             issueID,
+		Key:            model.FormatIssueKey(projectKey, tempIssue.NumericID),
+		NumericID:      tempIssue.NumericID,
+		Rank:           tempIssue.Rank,
+		Kind:           kind,
+		Title:          tempIssue.Title,
+		Description:    tempIssue.Description,
+		Status:         status,
+		WorkflowStatus: tempIssue.WorkflowStatus,
+		Priority:       priority,
+		Assignments:    make([]PartialAssignee, 0),
+		Labels:         make([]PartialLabel, 0),
+		Components:     make([]PartialComponent, 0),
+		CustomFields:   decodeIssueCustomFields(node.GetProperties()),
+		DueDate:        tempIssue.DueDate,
+		StartDate:      tempIssue.StartDate,
+		CreatedAt:      tempIssue.CreatedAt,
+		UpdatedAt:      tempIssue.UpdatedAt,
 	}, nil
 }
 
@@ -475,6 +619,9 @@ func (r *Neo4jIssueRepository) scanDetail(proj IssueProjection) func(rec *neo4j.
 			return nil, err
 		}
 		applyDecodedIssueLinks(issue, node.GetProperties())
+		if proj.CustomFields {
+			issue.CustomFields = decodeIssueCustomFields(node.GetProperties())
+		}
 		issue.ID, err = Neo4jDecodeID(node, model.ResourceTypeIssue)
 		if err != nil {
 			return nil, err
@@ -489,6 +636,9 @@ func (r *Neo4jIssueRepository) scanDetail(proj IssueProjection) func(rec *neo4j.
 		if proj.Labels {
 			issue.Labels = make([]PartialLabel, 0)
 		}
+		if proj.Components {
+			issue.Components = make([]PartialComponent, 0)
+		}
 		if proj.CommentCount {
 			issue.CommentCount = convert.ToPointer(int64(0))
 		}
@@ -504,6 +654,9 @@ func (r *Neo4jIssueRepository) scanDetail(proj IssueProjection) func(rec *neo4j.
 		if proj.RelationCount {
 			issue.RelationCount = convert.ToPointer(int64(0))
 		}
+		if proj.Rollup {
+			issue.Rollup = model.NewIssueRollup(nil)
+		}
 
 		return &issueDetailRow{projectKey: project.Key, issue: issue}, nil
 	}
@@ -528,6 +681,7 @@ func (r *Neo4jIssueRepository) applyIssueLoaders(ctx context.Context, tx neo4j.M
 			parent:      &row.issue.Parent,
 			assignments: &row.issue.Assignments,
 			labels:      &row.issue.Labels,
+			components:  &row.issue.Components,
 		}
 		ids = append(ids, id)
 	}
@@ -572,6 +726,16 @@ func (r *Neo4jIssueRepository) applyIssueLoaders(ctx context.Context, tx neo4j.M
 			}); err != nil {
 				return err
 			}
+		case "issue.load_rollup":
+			rollups, _, err := Neo4jRunQuery(ctx, tx, query, scanIssueRollup)
+			if err != nil {
+				return err
+			}
+			for _, rollup := range rollups {
+				if issueRow := rowByID[rollup.issueID]; issueRow != nil && issueRow.issue != nil {
+					issueRow.issue.Rollup = rollup.rollup
+				}
+			}
 		default:
 			return ErrQueryCompile
 		}
@@ -585,6 +749,7 @@ type issueRelationTarget struct {
 	parent      **PartialIssue
 	assignments *[]PartialAssignee
 	labels      *[]PartialLabel
+	components  *[]PartialComponent
 }
 
 func loaderQueryWithIDs(loader CompiledQuery, ids []string) CompiledQuery {
@@ -608,6 +773,8 @@ func applyIssueRelationLoader(
 		return true, applyIssueAssignmentsLoader(ctx, tx, query, targets)
 	case "issue.load_labels":
 		return true, applyIssueLabelsLoader(ctx, tx, query, targets)
+	case "issue.load_components":
+		return true, applyIssueComponentsLoader(ctx, tx, query, targets)
 	default:
 		return false, nil
 	}
@@ -747,6 +914,43 @@ func applyIssueLabelsLoader(ctx context.Context, tx neo4j.ManagedTransaction, qu
 	return nil
 }
 
+func applyIssueComponentsLoader(ctx context.Context, tx neo4j.ManagedTransaction, query CompiledQuery, targets map[string]issueRelationTarget) error {
+	rows, _, err := Neo4jRunQuery(ctx, tx, query, func(rec *neo4j.Record) (struct {
+		IssueID    string
+		Components []PartialComponent
+	}, error) {
+		issueID, err := Neo4jParseValueFromRecord[string](rec, "issue_id")
+		if err != nil {
+			return struct {
+				IssueID    string
+				Components []PartialComponent
+			}{}, err
+		}
+		components, err := Neo4jRecordPartialComponents(rec, "components")
+		if err != nil {
+			return struct {
+				IssueID    string
+				Components []PartialComponent
+			}{}, err
+		}
+		return struct {
+			IssueID    string
+			Components []PartialComponent
+		}{IssueID: issueID, Components: components}, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		target, ok := targets[row.IssueID]
+		if !ok || target.components == nil {
+			continue
+		}
+		*target.components = row.Components
+	}
+	return nil
+}
+
 func applyIssueCountLoader(ctx context.Context, tx neo4j.ManagedTransaction, query CompiledQuery, rowByID map[string]*issueDetailRow, field string, assign func(issue *Issue, count int64)) error {
 	rows, _, err := Neo4jRunQuery(ctx, tx, query, func(rec *neo4j.Record) (struct {
 		IssueID string
@@ -870,14 +1074,16 @@ func (r *Neo4jIssueRepository) Create(ctx context.Context, opts CreateIssueOpts)
 	WITH p, u, p.next_issue_id AS numeric_id
 	CREATE
 		(i:` + id.Label() + ` {
-			id: $id, numeric_id: numeric_id, kind: $kind, title: $title, description: $description, status: $status,
-			priority: $priority, resolution: $resolution, links: $links, due_date: datetime($due_date),
+			id: $id, numeric_id: numeric_id, rank: $rank, kind: $kind, title: $title, description: $description, status: $status,
+			workflow_status: $workflow_status, priority: $priority, resolution: $resolution, links: $links, story_points: $story_points,
+			original_estimate: $original_estimate, remaining_estimate: $original_estimate, due_date: datetime($due_date),
 			start_date: datetime($start_date), created_at: datetime($created_at)
 		}),
 		(u)-[:` + EdgeKindCreated.String() + ` {id: $created_rel_id, created_at: datetime($created_at)}]->(i),
 		(u)-[:` + EdgeKindWatches.String() + ` {id: $watches_rel_id, created_at: datetime($created_at)}]->(i),
 		(i)-[:` + EdgeKindInScopeOf.String() + ` {id: $scope_id, created_at: datetime($created_at)}]->(p),
-		(i)-[:` + EdgeKindBelongsTo.String() + ` {id: $belongs_to_rel_id, created_at: datetime($created_at)}]->(p)`
+		(i)-[:` + EdgeKindBelongsTo.String() + ` {id: $belongs_to_rel_id, created_at: datetime($created_at)}]->(p)
+	SET i += $custom_fields`
 
 	params := map[string]any{
 		"project_id":        opts.ProjectID.String(),
@@ -887,9 +1093,13 @@ func (r *Neo4jIssueRepository) Create(ctx context.Context, opts CreateIssueOpts)
 		"title":             opts.Title,
 		"description":       opts.Description,
 		"status":            opts.Status.String(),
+		"workflow_status":   nil,
 		"priority":          opts.Priority.String(),
 		"resolution":        opts.Resolution.String(),
 		"links":             encodeIssueLinks(links),
+		"custom_fields":     issueCustomFieldProperties(opts.CustomFields),
+		"story_points":      nil,
+		"original_estimate": nil,
 		"due_date":          nil,
 		"start_date":        nil,
 		"created_at":        createdAt.Format(time.RFC3339Nano),
@@ -899,6 +1109,15 @@ func (r *Neo4jIssueRepository) Create(ctx context.Context, opts CreateIssueOpts)
 		"belongs_to_rel_id": model.NewRawID(),
 	}
 
+	if opts.WorkflowStatus != nil {
+		params["workflow_status"] = *opts.WorkflowStatus
+	}
+	if opts.StoryPoints != nil {
+		params["story_points"] = *opts.StoryPoints
+	}
+	if opts.OriginalEstimate != nil {
+		params["original_estimate"] = int64(*opts.OriginalEstimate)
+	}
 	if opts.DueDate != nil {
 		params["due_date"] = opts.DueDate.Format(time.RFC3339Nano)
 	}
@@ -920,8 +1139,17 @@ func (r *Neo4jIssueRepository) Create(ctx context.Context, opts CreateIssueOpts)
 	cypher += `
 	RETURN i.id AS id`
 
-	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
-		return &struct{}{}, nil
+	if err := neo4jExecuteWrite(ctx, r.db, func(tx neo4j.ManagedTransaction) error {
+		rank, err := nextIssueRank(ctx, tx, opts.ProjectID)
+		if err != nil {
+			return err
+		}
+		params["rank"] = rank
+
+		_, err = neo4jTxReadSingle(ctx, tx, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+			return &struct{}{}, nil
+		})
+		return err
 	}); err != nil {
 		return nil, errors.Join(ErrIssueCreate, err)
 	}
@@ -1002,10 +1230,11 @@ func (r *Neo4jIssueRepository) ListForProject(ctx context.Context, query IssueLi
 		return Page[*PartialIssue]{}, errors.Join(ErrIssueRead, err)
 	}
 	query.Page = page
-	sort := IssueListSort{Field: query.SortField, Direction: query.Order}.normalize()
+	sort := IssueListSort{Field: query.SortField, Direction: query.Order, StatusOrder: query.StatusOrder}.normalize()
 	filter := normalizeIssueListFilter(query.Filter)
 	query.SortField = sort.Field
 	query.Order = sort.Direction
+	query.StatusOrder = sort.StatusOrder
 	query.Filter = filter
 
 	plan, err := CompileQuery(query)
@@ -1115,6 +1344,7 @@ func (r *Neo4jIssueRepository) executePartialIssueList(ctx context.Context, plan
 				parent:      &row.issue.Parent,
 				assignments: &row.issue.Assignments,
 				labels:      &row.issue.Labels,
+				components:  &row.issue.Components,
 			}
 		}
 
@@ -1208,6 +1438,48 @@ func (r *Neo4jIssueRepository) ListForIssue(ctx context.Context, query IssueList
 	return Page[*Issue]{Items: items, PageInfo: pagedRows.PageInfo}, nil
 }
 
+func (r *Neo4jIssueRepository) GetEstimates(ctx context.Context, issue model.ID) ([]*IssueEstimate, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetEstimates")
+	defer span.End()
+
+	query, err := IssueEstimatesQuery(issue)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetEstimates, err)
+	}
+
+	var estimates []*IssueEstimate
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query}, func(tx neo4j.ManagedTransaction) error {
+		var readErr error
+		estimates, _, readErr = Neo4jRunQuery(ctx, tx, query, func(rec *neo4j.Record) (*IssueEstimate, error) {
+			node, err := Neo4jRecordNode(rec, "i")
+			if err != nil {
+				return nil, err
+			}
+
+			estimate := new(IssueEstimate)
+			if err := Neo4jScanIntoStruct(&node, estimate, []string{"id"}); err != nil {
+				return nil, err
+			}
+			if estimate.ID, err = Neo4jDecodeID(node, model.ResourceTypeIssue); err != nil {
+				return nil, err
+			}
+
+			return estimate, nil
+		})
+		return readErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetEstimates, err)
+	}
+	if len(estimates) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return estimates, nil
+}
+
+// AddWatcher subscribes the user to the issue explicitly. Any previous opt-out
+// of the user is removed.
 func (r *Neo4jIssueRepository) AddWatcher(ctx context.Context, issue model.ID, user model.ID) error {
 	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/AddWatcher")
 	defer span.End()
@@ -1215,7 +1487,10 @@ func (r *Neo4jIssueRepository) AddWatcher(ctx context.Context, issue model.ID, u
 	cypher := `
 	MATCH (i:` + issue.Label() + ` {id: $issue_id})
 	MATCH (u:` + user.Label() + ` {id: $user_id})
-	CREATE (u)-[:` + EdgeKindWatches.String() + ` {id: $rel_id, created_at: datetime($created_at)}]->(i)`
+	OPTIONAL MATCH (u)-[o:` + EdgeKindUnwatched.String() + `]->(i)
+	DELETE o
+	MERGE (u)-[w:` + EdgeKindWatches.String() + `]->(i)
+	ON CREATE SET w.id = $rel_id, w.created_at = datetime($created_at)`
 
 	params := map[string]any{
 		"issue_id":   issue.String(),
@@ -1231,6 +1506,45 @@ func (r *Neo4jIssueRepository) AddWatcher(ctx context.Context, issue model.ID, u
 	return nil
 }
 
+// AutoWatch subscribes the users to the issue unless they opted out of
+// watching it before. Users already watching the issue are left untouched.
+func (r *Neo4jIssueRepository) AutoWatch(ctx context.Context, issue model.ID, users []model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/AutoWatch")
+	defer span.End()
+
+	if len(users) == 0 {
+		return nil
+	}
+
+	watchers := make([]map[string]any, 0, len(users))
+	for _, user := range users {
+		watchers = append(watchers, map[string]any{
+			"user_id": user.String(),
+			"rel_id":  model.NewRawID(),
+		})
+	}
+
+	cypher := `
+	MATCH (i:` + issue.Label() + ` {id: $issue_id})
+	UNWIND $watchers AS watcher
+	MATCH (u:` + model.ResourceTypeUser.String() + ` {id: watcher.user_id})
+	WHERE NOT (u)-[:` + EdgeKindUnwatched.String() + `]->(i)
+	MERGE (u)-[w:` + EdgeKindWatches.String() + `]->(i)
+	ON CREATE SET w.id = watcher.rel_id, w.created_at = datetime($created_at)`
+
+	params := map[string]any{
+		"issue_id":   issue.String(),
+		"watchers":   watchers,
+		"created_at": time.Now().UTC().Format(time.RFC3339Nano),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrIssueAddWatcher, err)
+	}
+
+	return nil
+}
+
 func (r *Neo4jIssueRepository) GetWatchers(ctx context.Context, issue model.ID) ([]*User, error) {
 	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetWatchers")
 	defer span.End()
@@ -1268,17 +1582,25 @@ func (r *Neo4jIssueRepository) GetWatchers(ctx context.Context, issue model.ID)
 	return users, nil
 }
 
+// RemoveWatcher unsubscribes the user from the issue and records the opt-out,
+// so the user is not subscribed again by AutoWatch.
 func (r *Neo4jIssueRepository) RemoveWatcher(ctx context.Context, issue model.ID, user model.ID) error {
 	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/RemoveWatcher")
 	defer span.End()
 
 	cypher := `
-	MATCH (:` + issue.Label() + ` {id: $issue_id})<-[r:` + EdgeKindWatches.String() + `]-(:` + user.Label() + ` {id: $user_id})
-	DELETE r`
+	MATCH (i:` + issue.Label() + ` {id: $issue_id})
+	MATCH (u:` + user.Label() + ` {id: $user_id})
+	OPTIONAL MATCH (u)-[w:` + EdgeKindWatches.String() + `]->(i)
+	DELETE w
+	MERGE (u)-[o:` + EdgeKindUnwatched.String() + `]->(i)
+	ON CREATE SET o.id = $rel_id, o.created_at = datetime($created_at)`
 
 	params := map[string]any{
-		"issue_id": issue.String(),
-		"user_id":  user.String(),
+		"issue_id":   issue.String(),
+		"user_id":    user.String(),
+		"rel_id":     model.NewRawID(),
+		"created_at": time.Now().UTC().Format(time.RFC3339Nano),
 	}
 
 	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
@@ -1467,6 +1789,29 @@ func (r *Neo4jIssueRepository) Update(ctx context.Context, id model.ID, opts Upd
 		"patch": opts.patch(),
 	}
 
+	if opts.Rank != nil {
+		if err := opts.Rank.validate(id); err != nil {
+			return nil, errors.Join(ErrIssueUpdate, ErrIssueRank, err)
+		}
+
+		// The issue is ranked and updated together, so a rejected update
+		// leaves its rank untouched.
+		err := neo4jExecuteWrite(ctx, r.db, func(tx neo4j.ManagedTransaction) error {
+			if err := rankIssue(ctx, tx, id, *opts.Rank); err != nil {
+				return errors.Join(ErrIssueRank, err)
+			}
+			_, err := neo4jTxReadSingle(ctx, tx, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+				return &struct{}{}, nil
+			})
+			return err
+		})
+		if err != nil {
+			return nil, errors.Join(ErrIssueUpdate, err)
+		}
+
+		return r.Get(ctx, id, proj)
+	}
+
 	_, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
 		return &struct{}{}, nil
 	})
@@ -1505,8 +1850,12 @@ func NewNeo4jIssueRepository(opts ...Neo4jRepositoryOption) (*Neo4jIssueReposito
 	}, nil
 }
 
+func issuesPattern(pattern ...string) string {
+	return composeCacheKey(model.ResourceTypeIssue.String(), pattern)
+}
+
 func clearIssuesPattern(ctx context.Context, r *redisBaseRepository, pattern ...string) error {
-	return r.DeletePattern(ctx, composeCacheKey(model.ResourceTypeIssue.String(), pattern))
+	return r.DeletePattern(ctx, issuesPattern(pattern...))
 }
 
 func clearIssuesKey(ctx context.Context, r *redisBaseRepository, id model.ID) error {
@@ -1518,7 +1867,7 @@ func clearIssueForIssue(ctx context.Context, r *redisBaseRepository, issueID mod
 }
 
 func clearIssueWatchers(ctx context.Context, r *redisBaseRepository, issueID model.ID) error {
-	return clearIssuesPattern(ctx, r, "GetWatchers", issueID.String(), "*")
+	return clearIssuesPattern(ctx, r, "GetWatchers", issueID.String())
 }
 
 func clearIssueRelations(ctx context.Context, r *redisBaseRepository, issueID model.ID) error {
@@ -1690,6 +2039,12 @@ func (r *RedisCachedIssueRepository) Create(ctx context.Context, opts CreateIssu
 		return nil, err
 	}
 
+	if opts.Parent != nil {
+		if err := r.clearAncestorRollups(ctx, issue.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	return issue, nil
 }
 
@@ -1942,6 +2297,10 @@ func (r *RedisCachedIssueRepository) ListForIssue(ctx context.Context, query Iss
 	return issues, nil
 }
 
+func (r *RedisCachedIssueRepository) GetEstimates(ctx context.Context, issue model.ID) ([]*IssueEstimate, error) {
+	return r.issueRepo.GetEstimates(ctx, issue)
+}
+
 func (r *RedisCachedIssueRepository) AddWatcher(ctx context.Context, issue model.ID, user model.ID) error {
 	if err := clearIssuesKey(ctx, r.cacheRepo, issue); err != nil {
 		return err
@@ -1954,6 +2313,18 @@ func (r *RedisCachedIssueRepository) AddWatcher(ctx context.Context, issue model
 	return r.issueRepo.AddWatcher(ctx, issue, user)
 }
 
+func (r *RedisCachedIssueRepository) AutoWatch(ctx context.Context, issue model.ID, users []model.ID) error {
+	if err := clearIssuesKey(ctx, r.cacheRepo, issue); err != nil {
+		return err
+	}
+
+	if err := clearIssueWatchers(ctx, r.cacheRepo, issue); err != nil {
+		return err
+	}
+
+	return r.issueRepo.AutoWatch(ctx, issue, users)
+}
+
 func (r *RedisCachedIssueRepository) GetWatchers(ctx context.Context, issue model.ID) ([]*User, error) {
 	var users []*User
 	var err error
@@ -1990,11 +2361,27 @@ func (r *RedisCachedIssueRepository) RemoveWatcher(ctx context.Context, issue mo
 	return r.issueRepo.RemoveWatcher(ctx, issue, user)
 }
 
+func (r *RedisCachedIssueRepository) HasRelationCycle(ctx context.Context, opts CreateIssueRelationOpts, ignore *model.ID) (bool, error) {
+	return r.issueRepo.HasRelationCycle(ctx, opts, ignore)
+}
+
+func (r *RedisCachedIssueRepository) GetDependencyGraph(ctx context.Context, issue model.ID, depth int) (*IssueDependencyGraph, error) {
+	return r.issueRepo.GetDependencyGraph(ctx, issue, depth)
+}
+
+func (r *RedisCachedIssueRepository) GetProjectDependencies(ctx context.Context, project model.ID) (*IssueDependencyGraph, error) {
+	return r.issueRepo.GetProjectDependencies(ctx, project)
+}
+
 func (r *RedisCachedIssueRepository) AddRelation(ctx context.Context, opts CreateIssueRelationOpts) (*IssueRelation, error) {
 	if err := clearIssueRelationPair(ctx, r.cacheRepo, opts.Source, opts.Target); err != nil {
 		return nil, err
 	}
 
+	if err := r.clearSubtaskRelationRollups(ctx, opts.Target, opts.Kind); err != nil {
+		return nil, err
+	}
+
 	return r.issueRepo.AddRelation(ctx, opts)
 }
 
@@ -2066,6 +2453,10 @@ func (r *RedisCachedIssueRepository) RemoveRelation(ctx context.Context, source,
 		return err
 	}
 
+	if err := r.clearSubtaskRelationRollups(ctx, target, kind); err != nil {
+		return err
+	}
+
 	return r.issueRepo.RemoveRelation(ctx, source, target, kind)
 }
 
@@ -2079,6 +2470,10 @@ func (r *RedisCachedIssueRepository) RemoveRelationByID(ctx context.Context, rel
 		return err
 	}
 
+	if err := r.clearSubtaskRelationRollups(ctx, rel.Target, rel.Kind); err != nil {
+		return err
+	}
+
 	return r.issueRepo.RemoveRelationByID(ctx, relationID)
 }
 
@@ -2087,6 +2482,14 @@ func (r *RedisCachedIssueRepository) Update(ctx context.Context, id model.ID, op
 		Assignments: true,
 	})
 
+	// Moving the issue under another parent changes the rollups of its
+	// previous ancestors as well.
+	if opts.Parent.Defined {
+		if err := r.clearAncestorRollups(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
 	issue, err := r.issueRepo.Update(ctx, id, opts, proj)
 	if err != nil {
 		return nil, err
@@ -2103,6 +2506,10 @@ func (r *RedisCachedIssueRepository) Update(ctx context.Context, id model.ID, op
 		}
 	}
 
+	if err := r.clearAncestorRollups(ctx, id); err != nil {
+		return nil, err
+	}
+
 	if err := bumpIssueListProjectGeneration(ctx, r.cacheRepo, issue.Project.ID); err != nil {
 		return nil, err
 	}
@@ -2146,6 +2553,37 @@ func (r *RedisCachedIssueRepository) Update(ctx context.Context, id model.ID, op
 	return issue, nil
 }
 
+func (r *RedisCachedIssueRepository) Rank(ctx context.Context, id model.ID, opts RankIssueOpts) error {
+	issue, err := r.issueRepo.Get(ctx, id, IssueProjection{
+		Assignments: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.issueRepo.Rank(ctx, id, opts); err != nil {
+		return err
+	}
+
+	if issue.Project != nil {
+		if err := bumpIssueListProjectGeneration(ctx, r.cacheRepo, issue.Project.ID); err != nil {
+			return err
+		}
+	}
+	if issue.Namespace != nil {
+		if err := bumpIssueListNamespaceGeneration(ctx, r.cacheRepo, issue.Namespace.ID); err != nil {
+			return err
+		}
+	}
+	for _, assigneeID := range uniqueIDs(issueAssigneeIDs(issue)) {
+		if err := bumpIssueListUserGeneration(ctx, r.cacheRepo, assigneeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *RedisCachedIssueRepository) Delete(ctx context.Context, id model.ID) error {
 	issue, _ := r.issueRepo.Get(ctx, id, IssueProjection{
 		Assignments: true,
@@ -2171,6 +2609,14 @@ func (r *RedisCachedIssueRepository) Delete(ctx context.Context, id model.ID) er
 		return err
 	}
 
+	if err := clearIssueRollup(ctx, r.cacheRepo, id); err != nil {
+		return err
+	}
+
+	if err := r.clearAncestorRollups(ctx, id); err != nil {
+		return err
+	}
+
 	if err := r.issueRepo.Delete(ctx, id); err != nil {
 		return err
 	}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+const (
+	IssueActivityKindFieldChanged    = "field_changed"    // a field of the issue was changed
+	IssueActivityKindRelationAdded   = "relation_added"   // a relation to another issue was added
+	IssueActivityKindRelationUpdated = "relation_updated" // the kind of a relation was changed
+	IssueActivityKindRelationRemoved = "relation_removed" // a relation to another issue was removed
+	IssueActivityKindCommentAdded    = "comment_added"    // a comment was added to the issue
+	IssueActivityKindCommentUpdated  = "comment_updated"  // a comment of the issue was edited
+	IssueActivityKindCommentDeleted  = "comment_deleted"  // a comment of the issue was deleted
+)
+
+var (
+	ErrIssueActivityCreate = errors.New("failed to create issue activity") // the issue activity could not be created
+	ErrIssueActivityDelete = errors.New("failed to delete issue activity") // the issue activity could not be deleted
+	ErrIssueActivityRead   = errors.New("failed to read issue activity")   // the issue activity could not be retrieved
+)
+
+// IssueActivity represents an entry of the activity timeline of an issue.
+//
+// Field changes set the Field, and the OldValue and NewValue of the field,
+// which are empty if the field was unset. Relation changes set the Subject to
+// the related issue and the values to the kind of the relation. Comment
+// events set the Subject to the comment.
+type IssueActivity struct {
+	ID        model.ID   `json:"id"`
+	Issue     model.ID   `json:"issue"`
+	Kind      string     `json:"kind"`
+	Field     *string    `json:"field"`
+	OldValue  []string   `json:"old_value"`
+	NewValue  []string   `json:"new_value"`
+	Subject   *model.ID  `json:"subject"`
+	Actor     *model.ID  `json:"actor"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// CreateIssueActivityOpts holds the data required to record an activity.
+type CreateIssueActivityOpts struct {
+	Issue    model.ID
+	Kind     string
+	Field    *string
+	OldValue []string
+	NewValue []string
+	Subject  *model.ID
+	Actor    *model.ID
+}
+
+//go:generate go tool mockgen -source=issue_activity.go -destination=issue_activity_mock_gen.go -package=repository -mock_names "IssueActivityRepository=MockIssueActivityRepository"
+type IssueActivityRepository interface {
+	// Create records the activities in a single batch, preserving their
+	// order in the timeline.
+	Create(ctx context.Context, opts []CreateIssueActivityOpts) ([]*IssueActivity, error)
+	// ListByIssue returns the activities of the issue, newest first.
+	ListByIssue(ctx context.Context, issue model.ID, page CursorPage) (Page[*IssueActivity], error)
+	// ListFieldChanges returns the changes of the field on the issues, oldest
+	// first.
+	ListFieldChanges(ctx context.Context, issues []model.ID, field string) ([]*IssueActivity, error)
+	// DeleteByIssues deletes the activities of the issues.
+	DeleteByIssues(ctx context.Context, issues []model.ID) error
+}
+
+// PGIssueActivityRepository is a repository for managing the activity
+// timeline of issues.
+type PGIssueActivityRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGIssueActivityRepository) Create(ctx context.Context, opts []CreateIssueActivityOpts) ([]*IssueActivity, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueActivityRepository/Create")
+	defer span.End()
+
+	createdAt := convert.ToPointer(time.Now().UTC().Round(time.Microsecond))
+
+	batch := &pgx.Batch{}
+	activities := make([]*IssueActivity, 0, len(opts))
+	for _, o := range opts {
+		activity := &IssueActivity{
+			ID:        model.MustNewID(model.ResourceTypeIssueActivity),
+			Issue:     o.Issue,
+			Kind:      o.Kind,
+			Field:     o.Field,
+			OldValue:  o.OldValue,
+			NewValue:  o.NewValue,
+			Subject:   o.Subject,
+			Actor:     o.Actor,
+			CreatedAt: createdAt,
+		}
+		if activity.OldValue == nil {
+			activity.OldValue = make([]string, 0)
+		}
+		if activity.NewValue == nil {
+			activity.NewValue = make([]string, 0)
+		}
+
+		batch.Queue(
+			"INSERT INTO issue_activities (id, issue_id, kind, field, old_value, new_value, subject, actor, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+			activity.ID, activity.Issue, activity.Kind, activity.Field, activity.OldValue, activity.NewValue,
+			activity.Subject, activity.Actor, *activity.CreatedAt,
+		)
+		activities = append(activities, activity)
+	}
+
+	if batch.Len() == 0 {
+		return activities, nil
+	}
+
+	if err := r.db.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, errors.Join(ErrIssueActivityCreate, err)
+	}
+
+	return activities, nil
+}
+
+func (r *PGIssueActivityRepository) ListByIssue(ctx context.Context, issue model.ID, page CursorPage) (Page[*IssueActivity], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueActivityRepository/ListByIssue")
+	defer span.End()
+
+	activities, normalized, err := listPG(ctx, r.db, "issue_activities", "issue_id", issue, page, scanIssueActivity)
+	if err != nil {
+		return Page[*IssueActivity]{}, errors.Join(ErrIssueActivityRead, err)
+	}
+
+	return PaginateSlice(activities, normalized.Size, func(activity *IssueActivity) model.ID {
+		return activity.ID
+	})
+}
+
+func (r *PGIssueActivityRepository) ListFieldChanges(ctx context.Context, issues []model.ID, field string) ([]*IssueActivity, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueActivityRepository/ListFieldChanges")
+	defer span.End()
+
+	activities := make([]*IssueActivity, 0)
+	if len(issues) == 0 {
+		return activities, nil
+	}
+
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.Composite()
+	}
+
+	rows, err := r.db.pool.Query(ctx,
+		"SELECT * FROM issue_activities WHERE issue_id = ANY($1) AND kind = $2 AND field = $3 ORDER BY created_at ASC, id ASC",
+		ids, IssueActivityKindFieldChanged, field,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrIssueActivityRead, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		activity, err := scanIssueActivity(rows)
+		if err != nil {
+			return nil, errors.Join(ErrIssueActivityRead, err)
+		}
+		activities = append(activities, activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrIssueActivityRead, err)
+	}
+
+	return activities, nil
+}
+
+func (r *PGIssueActivityRepository) DeleteByIssues(ctx context.Context, issues []model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueActivityRepository/DeleteByIssues")
+	defer span.End()
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.Composite()
+	}
+
+	if _, err := r.db.pool.Exec(ctx, "DELETE FROM issue_activities WHERE issue_id = ANY($1)", ids); err != nil {
+		return errors.Join(ErrIssueActivityDelete, err)
+	}
+
+	return nil
+}
+
+func scanIssueActivity(row pgx.Row) (*IssueActivity, error) {
+	var a IssueActivity
+	if err := row.Scan(
+		&a.ID, &a.Issue, &a.Kind, &a.Field, &a.OldValue, &a.NewValue, &a.Subject, &a.Actor, &a.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// NewIssueActivityRepository creates a new IssueActivityRepository.
+func NewIssueActivityRepository(opts ...PGRepositoryOption) (*PGIssueActivityRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGIssueActivityRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
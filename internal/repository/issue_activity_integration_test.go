@@ -0,0 +1,207 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type IssueActivityRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	issue model.ID
+	actor model.ID
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) SetupTest() {
+	s.issue = model.MustNewID(model.ResourceTypeIssue)
+	s.actor = model.MustNewID(model.ResourceTypeUser)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TestCreate() {
+	comment := model.MustNewID(model.ResourceTypeComment)
+
+	activities, err := s.IssueActivityRepo.Create(context.Background(), []repository.CreateIssueActivityOpts{
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("status"),
+			OldValue: []string{"open"},
+			NewValue: []string{"in_progress"},
+			Actor:    &s.actor,
+		},
+		{
+			Issue:   s.issue,
+			Kind:    repository.IssueActivityKindCommentAdded,
+			Subject: &comment,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(activities, 2)
+	s.Assert().NotEqual(activities[0].ID, activities[1].ID)
+	s.Assert().Equal([]string{}, activities[1].OldValue)
+	s.Assert().NotNil(activities[0].CreatedAt)
+
+	activities, err = s.IssueActivityRepo.Create(context.Background(), nil)
+	s.Require().NoError(err)
+	s.Assert().Empty(activities)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TestCreateCustomFieldChange() {
+	field := "custom_fields." + strings.Repeat("k", 32)
+
+	activities, err := s.IssueActivityRepo.Create(context.Background(), []repository.CreateIssueActivityOpts{
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    &field,
+			NewValue: []string{"value"},
+			Actor:    &s.actor,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(activities, 1)
+	s.Assert().Equal(&field, activities[0].Field)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TestListByIssue() {
+	related := model.MustNewID(model.ResourceTypeIssue)
+
+	created, err := s.IssueActivityRepo.Create(context.Background(), []repository.CreateIssueActivityOpts{
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("due_date"),
+			NewValue: []string{"2024-01-01T00:00:00Z"},
+			Actor:    &s.actor,
+		},
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindRelationAdded,
+			NewValue: []string{"blocks"},
+			Subject:  &related,
+			Actor:    &s.actor,
+		},
+		{
+			Issue: related,
+			Kind:  repository.IssueActivityKindRelationAdded,
+		},
+	})
+	s.Require().NoError(err)
+
+	activities, err := s.IssueActivityRepo.ListByIssue(context.Background(), s.issue, repository.CursorPage{Size: 1})
+	s.Require().NoError(err)
+	s.Require().Len(activities.Items, 1)
+	s.Assert().True(activities.PageInfo.HasMore)
+	s.Assert().Equal(created[1].ID, activities.Items[0].ID)
+	s.Assert().Equal(&related, activities.Items[0].Subject)
+	s.Assert().Nil(activities.Items[0].Field)
+
+	activities, err = s.IssueActivityRepo.ListByIssue(context.Background(), s.issue, repository.CursorPage{Size: 1, Token: activities.PageInfo.NextPageToken})
+	s.Require().NoError(err)
+	s.Require().Len(activities.Items, 1)
+	s.Assert().False(activities.PageInfo.HasMore)
+	s.Assert().Equal(created[0].ID, activities.Items[0].ID)
+	s.Assert().Equal(convert.ToPointer("due_date"), activities.Items[0].Field)
+	s.Assert().Equal([]string{}, activities.Items[0].OldValue)
+	s.Assert().Equal([]string{"2024-01-01T00:00:00Z"}, activities.Items[0].NewValue)
+	s.Assert().Equal(&s.actor, activities.Items[0].Actor)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TestListFieldChanges() {
+	other := model.MustNewID(model.ResourceTypeIssue)
+
+	created, err := s.IssueActivityRepo.Create(context.Background(), []repository.CreateIssueActivityOpts{
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("status"),
+			OldValue: []string{"open"},
+			NewValue: []string{"done"},
+		},
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("title"),
+			OldValue: []string{"old"},
+			NewValue: []string{"new"},
+		},
+		{
+			Issue:    model.MustNewID(model.ResourceTypeIssue),
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("status"),
+			OldValue: []string{"open"},
+			NewValue: []string{"closed"},
+		},
+	})
+	s.Require().NoError(err)
+
+	activities, err := s.IssueActivityRepo.ListFieldChanges(context.Background(), []model.ID{s.issue, other}, "status")
+	s.Require().NoError(err)
+	s.Require().Len(activities, 1)
+	s.Assert().Equal(created[0].ID, activities[0].ID)
+	s.Assert().Equal([]string{"done"}, activities[0].NewValue)
+
+	activities, err = s.IssueActivityRepo.ListFieldChanges(context.Background(), nil, "status")
+	s.Require().NoError(err)
+	s.Assert().Empty(activities)
+}
+
+func (s *IssueActivityRepositoryIntegrationTestSuite) TestDeleteByIssues() {
+	other := model.MustNewID(model.ResourceTypeIssue)
+
+	_, err := s.IssueActivityRepo.Create(context.Background(), []repository.CreateIssueActivityOpts{
+		{
+			Issue:    s.issue,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("status"),
+			OldValue: []string{"open"},
+			NewValue: []string{"done"},
+		},
+		{
+			Issue:    other,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("status"),
+			OldValue: []string{"open"},
+			NewValue: []string{"closed"},
+		},
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.IssueActivityRepo.DeleteByIssues(context.Background(), []model.ID{s.issue}))
+	s.Require().NoError(s.IssueActivityRepo.DeleteByIssues(context.Background(), nil))
+
+	activities, err := s.IssueActivityRepo.ListFieldChanges(context.Background(), []model.ID{s.issue, other}, "status")
+	s.Require().NoError(err)
+	s.Require().Len(activities, 1)
+	s.Assert().Equal(other, activities[0].Issue)
+}
+
+func TestIssueActivityRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IssueActivityRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: issue_activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=issue_activity.go -destination=issue_activity_mock_gen.go -package=repository -mock_names IssueActivityRepository=MockIssueActivityRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIssueActivityRepository is a mock of IssueActivityRepository interface.
+type MockIssueActivityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueActivityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueActivityRepositoryMockRecorder is the mock recorder for MockIssueActivityRepository.
+type MockIssueActivityRepositoryMockRecorder struct {
+	mock *MockIssueActivityRepository
+}
+
+// NewMockIssueActivityRepository creates a new mock instance.
+func NewMockIssueActivityRepository(ctrl *gomock.Controller) *MockIssueActivityRepository {
+	mock := &MockIssueActivityRepository{ctrl: ctrl}
+	mock.recorder = &MockIssueActivityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueActivityRepository) EXPECT() *MockIssueActivityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIssueActivityRepository) Create(ctx context.Context, opts []CreateIssueActivityOpts) ([]*IssueActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].([]*IssueActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIssueActivityRepositoryMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIssueActivityRepository)(nil).Create), ctx, opts)
+}
+
+// DeleteByIssues mocks base method.
+func (m *MockIssueActivityRepository) DeleteByIssues(ctx context.Context, issues []model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIssues", ctx, issues)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByIssues indicates an expected call of DeleteByIssues.
+func (mr *MockIssueActivityRepositoryMockRecorder) DeleteByIssues(ctx, issues any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIssues", reflect.TypeOf((*MockIssueActivityRepository)(nil).DeleteByIssues), ctx, issues)
+}
+
+// ListByIssue mocks base method.
+func (m *MockIssueActivityRepository) ListByIssue(ctx context.Context, issue model.ID, page CursorPage) (Page[*IssueActivity], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIssue", ctx, issue, page)
+	ret0, _ := ret[0].(Page[*IssueActivity])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIssue indicates an expected call of ListByIssue.
+func (mr *MockIssueActivityRepositoryMockRecorder) ListByIssue(ctx, issue, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIssue", reflect.TypeOf((*MockIssueActivityRepository)(nil).ListByIssue), ctx, issue, page)
+}
+
+// ListFieldChanges mocks base method.
+func (m *MockIssueActivityRepository) ListFieldChanges(ctx context.Context, issues []model.ID, field string) ([]*IssueActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFieldChanges", ctx, issues, field)
+	ret0, _ := ret[0].([]*IssueActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFieldChanges indicates an expected call of ListFieldChanges.
+func (mr *MockIssueActivityRepositoryMockRecorder) ListFieldChanges(ctx, issues, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFieldChanges", reflect.TypeOf((*MockIssueActivityRepository)(nil).ListFieldChanges), ctx, issues, field)
+}
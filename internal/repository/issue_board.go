@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+type issueCountRow struct {
+	value string
+	count int64
+}
+
+func (r *Neo4jIssueRepository) CountByField(ctx context.Context, project model.ID, field IssueListConditionField) (map[string]int64, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/CountByField")
+	defer span.End()
+
+	query, err := IssueCountByFieldQuery(project, field)
+	if err != nil {
+		return nil, errors.Join(ErrIssueCount, err)
+	}
+
+	var rows []issueCountRow
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query}, func(tx neo4j.ManagedTransaction) error {
+		var readErr error
+		rows, _, readErr = Neo4jRunQuery(ctx, tx, query, func(rec *neo4j.Record) (issueCountRow, error) {
+			value, err := Neo4jParseValueFromRecord[string](rec, "value")
+			if err != nil {
+				return issueCountRow{}, err
+			}
+			count, err := Neo4jParseValueFromRecord[int64](rec, "count")
+			if err != nil {
+				return issueCountRow{}, err
+			}
+			return issueCountRow{value: value, count: count}, nil
+		})
+		return readErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueCount, err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.value] = row.count
+	}
+
+	return counts, nil
+}
+
+// CountByField is not cached, as the counts change with every issue of the
+// project.
+func (r *RedisCachedIssueRepository) CountByField(ctx context.Context, project model.ID, field IssueListConditionField) (map[string]int64, error) {
+	return r.issueRepo.CountByField(ctx, project, field)
+}
@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+// IssueBatchScope is what the caches of an issue in a batch depend on: the
+// parent, project, namespace and assignees of the issue, and the issues it
+// is a subtask of at any depth. Sprints are the open sprints the issue is
+// planned in.
+type IssueBatchScope struct {
+	ID        model.ID
+	Parent    *model.ID
+	Project   *model.ID
+	Namespace *model.ID
+	Assignees []model.ID
+	Ancestors []model.ID
+	Sprints   []model.ID
+}
+
+// IssueUpdate is the update of one issue in a batch of updates.
+type IssueUpdate struct {
+	ID   model.ID
+	Opts UpdateIssueOpts
+}
+
+// UpdateMany updates the issues in a single write and returns the updated
+// issues in the order of the updates. Issues that do not exist are skipped.
+func (r *Neo4jIssueRepository) UpdateMany(ctx context.Context, updates []IssueUpdate, proj IssueProjection) ([]*Issue, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/UpdateMany")
+	defer span.End()
+
+	if len(updates) == 0 {
+		return make([]*Issue, 0), nil
+	}
+
+	cypher := `
+	UNWIND $updates AS u
+	MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: u.id})
+	SET i += u.patch, i.updated_at = datetime()
+	RETURN collect(i.id) AS ids`
+
+	rows := make([]map[string]any, len(updates))
+	for i, update := range updates {
+		rows[i] = map[string]any{
+			"id":    update.ID.String(),
+			"patch": update.Opts.patch(),
+		}
+	}
+
+	updated, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, map[string]any{"updates": rows}, func(record *neo4j.Record) (*[]any, error) {
+		ids, err := Neo4jParseValueFromRecord[[]any](record, "ids")
+		if err != nil {
+			return nil, err
+		}
+		return &ids, nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueUpdate, err)
+	}
+
+	found := make(map[string]struct{}, len(*updated))
+	for _, id := range *updated {
+		if id, ok := id.(string); ok {
+			found[id] = struct{}{}
+		}
+	}
+
+	issues := make([]*Issue, 0, len(found))
+	for _, update := range updates {
+		if _, ok := found[update.ID.String()]; !ok {
+			continue
+		}
+		issue, err := r.Get(ctx, update.ID, proj)
+		if err != nil {
+			return nil, errors.Join(ErrIssueUpdate, err)
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// DeleteMany deletes the issues in a single write.
+func (r *Neo4jIssueRepository) DeleteMany(ctx context.Context, ids []model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/DeleteMany")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	cypher := `
+	UNWIND $ids AS id
+	MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: id})
+	DETACH DELETE i`
+
+	rawIDs := make([]string, len(ids))
+	for i, id := range ids {
+		rawIDs[i] = id.String()
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, map[string]any{"ids": rawIDs}); err != nil {
+		return errors.Join(ErrIssueDelete, err)
+	}
+
+	return nil
+}
+
+// GetBatchScopes returns the parent, project, namespace, assignees, ancestors
+// and open sprints of the issues with one query for the whole batch.
+func (r *Neo4jIssueRepository) GetBatchScopes(ctx context.Context, ids []model.ID) ([]*IssueBatchScope, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetBatchScopes")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return make([]*IssueBatchScope, 0), nil
+	}
+
+	cypher := `
+	UNWIND $ids AS id
+	MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: id})-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+	OPTIONAL MATCH (n:` + model.ResourceTypeNamespace.String() + `)-[:` + EdgeKindHasProject.String() + `]->(p)
+	RETURN
+		i.id AS id, p.id AS project, n.id AS namespace,
+		[(i)-[:` + EdgeKindRelatedTo.String() + ` {kind: $subtask_kind}]->(parent:` + model.ResourceTypeIssue.String() + `) | parent.id] AS parents,
+		[(u:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindAssignedTo.String() + ` {kind: $assignee_kind}]->(i) | u.id] AS assignees,
+		[(i)-[:` + EdgeKindRelatedTo.String() + `*1.. {kind: $subtask_kind}]->(a:` + model.ResourceTypeIssue.String() + `) | a.id] AS ancestors,
+		[(i)-[:` + EdgeKindInSprint.String() + `]->(s:` + model.ResourceTypeSprint.String() + `) WHERE s.state <> $closed | s.id] AS sprints`
+
+	rawIDs := make([]string, len(ids))
+	for i, id := range ids {
+		rawIDs[i] = id.String()
+	}
+
+	params := map[string]any{
+		"ids":           rawIDs,
+		"subtask_kind":  model.IssueRelationKindSubtaskOf.String(),
+		"assignee_kind": model.AssignmentKindAssignee.String(),
+		"closed":        model.SprintStateClosed.String(),
+	}
+
+	scopes, err := Neo4jExecuteReadAndReadAll(ctx, r.db, cypher, params, func(record *neo4j.Record) (*IssueBatchScope, error) {
+		row := new(issueBatchScopeRow)
+		if err := convert.AnyToAny(record.AsMap(), row); err != nil {
+			return nil, err
+		}
+		return row.scope()
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueRead, err)
+	}
+
+	return scopes, nil
+}
+
+// issueBatchScopeRow is the scope of an issue as read from the database.
+type issueBatchScopeRow struct {
+	ID        string   `json:"id"`
+	Project   string   `json:"project"`
+	Namespace *string  `json:"namespace"`
+	Parents   []string `json:"parents"`
+	Assignees []string `json:"assignees"`
+	Ancestors []string `json:"ancestors"`
+	Sprints   []string `json:"sprints"`
+}
+
+func (row *issueBatchScopeRow) scope() (*IssueBatchScope, error) {
+	scope := new(IssueBatchScope)
+
+	var err error
+	if scope.ID, err = model.NewIDFromString(row.ID, model.ResourceTypeIssue.String()); err != nil {
+		return nil, err
+	}
+	project, err := model.NewIDFromString(row.Project, model.ResourceTypeProject.String())
+	if err != nil {
+		return nil, err
+	}
+	scope.Project = &project
+	if row.Namespace != nil {
+		namespace, err := model.NewIDFromString(*row.Namespace, model.ResourceTypeNamespace.String())
+		if err != nil {
+			return nil, err
+		}
+		scope.Namespace = &namespace
+	}
+	if len(row.Parents) > 0 {
+		parent, err := model.NewIDFromString(row.Parents[0], model.ResourceTypeIssue.String())
+		if err != nil {
+			return nil, err
+		}
+		scope.Parent = &parent
+	}
+	if scope.Assignees, err = idsFromStrings(row.Assignees, model.ResourceTypeUser); err != nil {
+		return nil, err
+	}
+	if scope.Ancestors, err = idsFromStrings(row.Ancestors, model.ResourceTypeIssue); err != nil {
+		return nil, err
+	}
+	if scope.Sprints, err = idsFromStrings(row.Sprints, model.ResourceTypeSprint); err != nil {
+		return nil, err
+	}
+
+	return scope, nil
+}
+
+func idsFromStrings(raw []string, resourceType model.ResourceType) ([]model.ID, error) {
+	ids := make([]model.ID, 0, len(raw))
+	for _, id := range raw {
+		parsed, err := model.NewIDFromString(id, resourceType.String())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, parsed)
+	}
+	return uniqueIDs(ids), nil
+}
+
+// clearIssueBatch invalidates the caches depending on a batch of issues,
+// given by their scopes before the change and the issues after the change,
+// along with the keys matching the patterns. Each project, namespace and
+// user list generation is bumped once, and the keys of the whole batch are
+// cleared in one pass.
+func clearIssueBatch(ctx context.Context, r *redisBaseRepository, scopes []*IssueBatchScope, issues []*Issue, patterns ...string) error {
+	var parents, projects, namespaces, users, ancestors []model.ID
+	for _, scope := range scopes {
+		if scope.Parent != nil {
+			parents = append(parents, *scope.Parent)
+		}
+		if scope.Project != nil {
+			projects = append(projects, *scope.Project)
+		}
+		if scope.Namespace != nil {
+			namespaces = append(namespaces, *scope.Namespace)
+		}
+		users = append(users, scope.Assignees...)
+		ancestors = append(ancestors, scope.Ancestors...)
+	}
+	for _, issue := range issues {
+		if issue.Parent != nil {
+			parents = append(parents, issue.Parent.ID)
+		}
+		if issue.Project != nil {
+			projects = append(projects, issue.Project.ID)
+		}
+		if issue.Namespace != nil {
+			namespaces = append(namespaces, issue.Namespace.ID)
+		}
+		users = append(users, issueAssigneeIDs(issue)...)
+	}
+
+	for _, projectID := range uniqueIDs(projects) {
+		if err := bumpIssueListProjectGeneration(ctx, r, projectID); err != nil {
+			return err
+		}
+	}
+	for _, namespaceID := range uniqueIDs(namespaces) {
+		if err := bumpIssueListNamespaceGeneration(ctx, r, namespaceID); err != nil {
+			return err
+		}
+	}
+	for _, userID := range uniqueIDs(users) {
+		if err := bumpIssueListUserGeneration(ctx, r, userID); err != nil {
+			return err
+		}
+	}
+
+	// The ancestors carry the rollups of the issues, in their own cached
+	// rollups, issues and subtask lists.
+	for _, parentID := range uniqueIDs(parents) {
+		patterns = append(patterns, issuesPattern("*", "ListForIssue", parentID.String(), "*"))
+	}
+	for _, ancestorID := range uniqueIDs(ancestors) {
+		patterns = append(patterns,
+			issuesPattern("GetRollup", ancestorID.String()),
+			issuesPattern("Get", ancestorID.String(), "*"),
+			issuesPattern("*", "ListForIssue", ancestorID.String(), "*"),
+		)
+	}
+	patterns = append(patterns,
+		issuesPattern("GetByKey", "*"),
+		composeCacheKey(model.ResourceTypeProject.String(), "*"),
+	)
+
+	return r.DeletePatterns(ctx, patterns...)
+}
+
+func (r *RedisCachedIssueRepository) GetBatchScopes(ctx context.Context, ids []model.ID) ([]*IssueBatchScope, error) {
+	return r.issueRepo.GetBatchScopes(ctx, ids)
+}
+
+func (r *RedisCachedIssueRepository) UpdateMany(ctx context.Context, updates []IssueUpdate, proj IssueProjection) ([]*Issue, error) {
+	ids := make([]model.ID, len(updates))
+	reparented := make([]model.ID, 0)
+	for i, update := range updates {
+		ids[i] = update.ID
+		if update.Opts.Parent.Defined {
+			reparented = append(reparented, update.ID)
+		}
+	}
+
+	before, err := r.issueRepo.GetBatchScopes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := r.issueRepo.UpdateMany(ctx, updates, proj)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issues moved under another parent roll up to new ancestors too.
+	if len(reparented) > 0 {
+		after, err := r.issueRepo.GetBatchScopes(ctx, reparented)
+		if err != nil {
+			return nil, err
+		}
+		before = append(before, after...)
+	}
+
+	for _, issue := range issues {
+		key := composeCacheKey(model.ResourceTypeIssue.String(), "Get", issue.ID.String(), projectionCacheValue(proj))
+		if err := r.cacheRepo.Set(ctx, key, issue); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := clearIssueBatch(ctx, r.cacheRepo, before, issues); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+func (r *RedisCachedIssueRepository) DeleteMany(ctx context.Context, ids []model.ID) error {
+	before, err := r.issueRepo.GetBatchScopes(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	if err := r.issueRepo.DeleteMany(ctx, ids); err != nil {
+		return err
+	}
+
+	patterns := make([]string, 0, 5*len(ids))
+	for _, id := range ids {
+		patterns = append(patterns,
+			issuesPattern("Get", id.String(), "*"),
+			issuesPattern("GetWatchers", id.String()),
+			issuesPattern("GetRelations", id.String(), "*"),
+			issuesPattern("*", "ListRelations", id.String(), "*"),
+			issuesPattern("GetRollup", id.String()),
+		)
+	}
+
+	return clearIssueBatch(ctx, r.cacheRepo, before, nil, patterns...)
+}
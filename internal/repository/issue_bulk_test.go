@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-redis/cache/v9"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newBulkIssues returns two issues of the same project assigned to the same
+// user, so the list generations must be bumped once for the batch.
+func newBulkIssues() []*Issue {
+	project := &PartialProject{ID: model.MustNewID(model.ResourceTypeProject)}
+	assignee := PartialAssignee{ID: model.MustNewID(model.ResourceTypeUser), Kind: model.AssignmentKindAssignee}
+	return []*Issue{
+		{ID: model.MustNewID(model.ResourceTypeIssue), Project: project, Assignments: []PartialAssignee{assignee}},
+		{ID: model.MustNewID(model.ResourceTypeIssue), Project: project, Assignments: []PartialAssignee{assignee}},
+	}
+}
+
+// newBulkIssueScopes returns the scopes of the issues before the change.
+func newBulkIssueScopes(issues []*Issue) []*IssueBatchScope {
+	scopes := make([]*IssueBatchScope, len(issues))
+	for i, issue := range issues {
+		scopes[i] = &IssueBatchScope{
+			ID:        issue.ID,
+			Project:   &issue.Project.ID,
+			Assignees: issueAssigneeIDs(issue),
+		}
+	}
+	return scopes
+}
+
+// redisClientExpectingKeys expects the issue and project keys to be listed
+// once each, and the keys matching the patterns to be deleted together.
+func redisClientExpectingKeys(ctrl *gomock.Controller, ctx context.Context, issueKeys, projectKeys, deleted []string) *RedisDatabase {
+	dbClient := mock.NewUniversalClient(ctrl)
+	for prefix, keys := range map[string][]string{
+		model.ResourceTypeIssue.String():   issueKeys,
+		model.ResourceTypeProject.String(): projectKeys,
+	} {
+		cmd := new(redis.StringSliceCmd)
+		cmd.SetVal(keys)
+		dbClient.EXPECT().Keys(ctx, prefix+"*").Return(cmd)
+	}
+	dbClient.EXPECT().Del(ctx, deleted).Return(new(redis.IntCmd))
+
+	db, err := NewRedisDatabase(WithRedisClient(dbClient))
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+func TestCachedIssueRepository_UpdateMany(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	issues := newBulkIssues()
+	ids := []model.ID{issues[0].ID, issues[1].ID}
+	opts := UpdateIssueOpts{Title: optional.Some("updated title")}
+	updates := []IssueUpdate{{ID: issues[0].ID, Opts: opts}, {ID: issues[1].ID, Opts: opts}}
+
+	getByKey := composeCacheKey(model.ResourceTypeIssue.String(), "GetByKey", "PRJ-1")
+	watchers := composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", issues[0].ID.String())
+	project := composeCacheKey(model.ResourceTypeProject.String(), "Get", issues[0].Project.ID.String())
+	projectGenKey := issueListProjectGenKey(issues[0].Project.ID)
+	assigneeGenKey := issueListUserGenKey(issues[0].Assignments[0].ID)
+
+	db := redisClientExpectingKeys(ctrl, ctx, []string{getByKey, watchers}, []string{project}, []string{getByKey, project})
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).Times(7)
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(4)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePatterns", gomock.Len(0)).Return(ctx, span)
+
+	cacheRepo := mock.NewCacheBackend(ctrl)
+	for _, issue := range issues {
+		key := composeCacheKey(model.ResourceTypeIssue.String(), "Get", issue.ID.String(), projectionCacheValue(IssueDetailProjection()))
+		cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: key, Value: issue}).Return(nil)
+	}
+	cacheRepo.EXPECT().Get(ctx, projectGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: projectGenKey, Value: int64(1)}).Return(nil)
+	cacheRepo.EXPECT().Get(ctx, assigneeGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: assigneeGenKey, Value: int64(1)}).Return(nil)
+
+	issueRepo := NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().GetBatchScopes(ctx, ids).Return(newBulkIssueScopes(issues[:1]), nil)
+	issueRepo.EXPECT().UpdateMany(ctx, updates, IssueDetailProjection()).Return(issues, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: &redisBaseRepository{
+			db:     db,
+			cache:  cacheRepo,
+			tracer: tracer,
+			logger: mock.NewMockLogger(ctrl),
+		},
+		issueRepo: issueRepo,
+	}
+	got, err := r.UpdateMany(ctx, updates, IssueDetailProjection())
+	require.NoError(t, err)
+	assert.Equal(t, issues, got)
+}
+
+func TestCachedIssueRepository_UpdateMany_reparented(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	issue := newBulkIssues()[0]
+	issue.Assignments = nil
+	oldParentID := model.MustNewID(model.ResourceTypeIssue)
+	newParentID := model.MustNewID(model.ResourceTypeIssue)
+	issue.Parent = &PartialIssue{ID: newParentID}
+	updates := []IssueUpdate{{ID: issue.ID, Opts: UpdateIssueOpts{Parent: optional.Some(newParentID)}}}
+
+	oldRollup := issueRollupCacheKey(oldParentID)
+	newSubtasks := composeCacheKey(model.ResourceTypeIssue.String(), "Project", "ListForIssue", newParentID.String(), "0")
+	unrelated := composeCacheKey(model.ResourceTypeIssue.String(), "GetRollup", issue.ID.String())
+	projectGenKey := issueListProjectGenKey(issue.Project.ID)
+
+	db := redisClientExpectingKeys(ctrl, ctx, []string{oldRollup, newSubtasks, unrelated}, nil, []string{oldRollup, newSubtasks})
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).Times(4)
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(2)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePatterns", gomock.Len(0)).Return(ctx, span)
+
+	cacheRepo := mock.NewCacheBackend(ctrl)
+	key := composeCacheKey(model.ResourceTypeIssue.String(), "Get", issue.ID.String(), projectionCacheValue(IssueDetailProjection()))
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: key, Value: issue}).Return(nil)
+	cacheRepo.EXPECT().Get(ctx, projectGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: projectGenKey, Value: int64(1)}).Return(nil)
+
+	issueRepo := NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().GetBatchScopes(ctx, []model.ID{issue.ID}).Return([]*IssueBatchScope{
+		{ID: issue.ID, Parent: &oldParentID, Project: &issue.Project.ID, Ancestors: []model.ID{oldParentID}},
+	}, nil)
+	issueRepo.EXPECT().UpdateMany(ctx, updates, IssueDetailProjection()).Return([]*Issue{issue}, nil)
+	issueRepo.EXPECT().GetBatchScopes(ctx, []model.ID{issue.ID}).Return([]*IssueBatchScope{
+		{ID: issue.ID, Parent: &newParentID, Project: &issue.Project.ID, Ancestors: []model.ID{newParentID}},
+	}, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: &redisBaseRepository{
+			db:     db,
+			cache:  cacheRepo,
+			tracer: tracer,
+			logger: mock.NewMockLogger(ctrl),
+		},
+		issueRepo: issueRepo,
+	}
+	got, err := r.UpdateMany(ctx, updates, IssueDetailProjection())
+	require.NoError(t, err)
+	assert.Equal(t, []*Issue{issue}, got)
+}
+
+func TestCachedIssueRepository_UpdateMany_error(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeIssue)
+	updates := []IssueUpdate{{ID: id, Opts: UpdateIssueOpts{Title: optional.Some("updated title")}}}
+
+	issueRepo := NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().GetBatchScopes(ctx, []model.ID{id}).Return(nil, nil)
+	issueRepo.EXPECT().UpdateMany(ctx, updates, IssueDetailProjection()).Return(nil, ErrIssueUpdate)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: redisCacheExpectingPatterns(ctrl, ctx, nil, -1, nil),
+		issueRepo: issueRepo,
+	}
+	got, err := r.UpdateMany(ctx, updates, IssueDetailProjection())
+	require.ErrorIs(t, err, ErrIssueUpdate)
+	assert.Nil(t, got)
+}
+
+func TestCachedIssueRepository_DeleteMany(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	issues := newBulkIssues()
+	ids := []model.ID{issues[0].ID, issues[1].ID}
+
+	var issueKeys []string
+	for _, id := range ids {
+		issueKeys = append(issueKeys,
+			composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), projectionCacheValue(IssueDetailProjection())),
+			composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
+			composeCacheKey(model.ResourceTypeIssue.String(), "GetRelations", id.String(), "0"),
+			composeCacheKey(model.ResourceTypeIssue.String(), "Project", "ListRelations", id.String(), "0"),
+			issueRollupCacheKey(id),
+		)
+	}
+	issueKeys = append(issueKeys, composeCacheKey(model.ResourceTypeIssue.String(), "GetByKey", "PRJ-1"))
+	unrelated := composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", model.MustNewID(model.ResourceTypeIssue).String())
+	projectKeys := []string{composeCacheKey(model.ResourceTypeProject.String(), "Get", issues[0].Project.ID.String())}
+	projectGenKey := issueListProjectGenKey(issues[0].Project.ID)
+	assigneeGenKey := issueListUserGenKey(issues[0].Assignments[0].ID)
+
+	db := redisClientExpectingKeys(ctrl, ctx, append(slices.Clone(issueKeys), unrelated), projectKeys, append(issueKeys, projectKeys...))
+
+	cacheRepo := mock.NewCacheBackend(ctrl)
+	cacheRepo.EXPECT().Get(ctx, projectGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: projectGenKey, Value: int64(1)}).Return(nil)
+	cacheRepo.EXPECT().Get(ctx, assigneeGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: assigneeGenKey, Value: int64(1)}).Return(nil)
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).Times(5)
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePatterns", gomock.Len(0)).Return(ctx, span)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(2)
+
+	issueRepo := NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().GetBatchScopes(ctx, ids).Return(newBulkIssueScopes(issues), nil)
+	issueRepo.EXPECT().DeleteMany(ctx, ids).Return(nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: &redisBaseRepository{
+			db:     db,
+			cache:  cacheRepo,
+			tracer: tracer,
+			logger: mock.NewMockLogger(ctrl),
+		},
+		issueRepo: issueRepo,
+	}
+	require.NoError(t, r.DeleteMany(ctx, ids))
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+// IssueDependencyGraph is a set of issues with the blocking and subtask
+// relations between them.
+type IssueDependencyGraph struct {
+	Issues    []*PartialIssue
+	Relations []*IssueRelation
+}
+
+// HasRelationCycle returns true if adding the relation would close a cycle of
+// blocking or subtask relations. The relation with the ignore ID is left out,
+// so a relation can be checked before it replaces another.
+func (r *Neo4jIssueRepository) HasRelationCycle(ctx context.Context, opts CreateIssueRelationOpts, ignore *model.ID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/HasRelationCycle")
+	defer span.End()
+
+	if !opts.Kind.IsDependency() {
+		return false, nil
+	}
+
+	query, err := IssueRelationCycleQuery(opts, ignore)
+	if err != nil {
+		return false, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	var cycle bool
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query}, func(tx neo4j.ManagedTransaction) error {
+		rows, _, readErr := Neo4jRunQuery(ctx, tx, query, func(rec *neo4j.Record) (bool, error) {
+			return Neo4jParseValueFromRecord[bool](rec, "cycle")
+		})
+		for _, row := range rows {
+			cycle = cycle || row
+		}
+		return readErr
+	})
+	if err != nil {
+		return false, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	return cycle, nil
+}
+
+// GetDependencyGraph returns the issue and the issues reachable from it
+// through blocking and subtask relations, following at most depth relations,
+// with the relations between them.
+func (r *Neo4jIssueRepository) GetDependencyGraph(ctx context.Context, issue model.ID, depth int) (*IssueDependencyGraph, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetDependencyGraph")
+	defer span.End()
+
+	query, err := IssueDependencyGraphQuery(issue, depth)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	var graph *IssueDependencyGraph
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query}, func(tx neo4j.ManagedTransaction) error {
+		issues, _, err := Neo4jRunQuery(ctx, tx, query, r.scanDependencyIssue)
+		if err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			return ErrNotFound
+		}
+
+		ids := make([]model.ID, len(issues))
+		for i, issue := range issues {
+			ids[i] = issue.ID
+		}
+
+		edges, err := IssueDependencyEdgesQuery(ids)
+		if err != nil {
+			return err
+		}
+
+		relations, _, err := Neo4jRunQuery(ctx, tx, edges, r.scanRelation("source_id", "r", "target_id"))
+		if err != nil {
+			return err
+		}
+
+		graph = &IssueDependencyGraph{Issues: issues, Relations: relations}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	return graph, nil
+}
+
+// GetProjectDependencies returns the issues of the project that are scheduled
+// or ordered against another issue of the project, with the blocking
+// relations between them.
+func (r *Neo4jIssueRepository) GetProjectDependencies(ctx context.Context, project model.ID) (*IssueDependencyGraph, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetProjectDependencies")
+	defer span.End()
+
+	query, err := ProjectDependencyIssuesQuery(project)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	edges, err := ProjectDependencyEdgesQuery(project)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	graph := new(IssueDependencyGraph)
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query, Loaders: []CompiledQuery{edges}}, func(tx neo4j.ManagedTransaction) error {
+		var readErr error
+		if graph.Issues, _, readErr = Neo4jRunQuery(ctx, tx, query, r.scanDependencyIssue); readErr != nil {
+			return readErr
+		}
+		graph.Relations, _, readErr = Neo4jRunQuery(ctx, tx, edges, r.scanRelation("source_id", "r", "target_id"))
+		return readErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetDependencies, err)
+	}
+
+	return graph, nil
+}
+
+func (r *Neo4jIssueRepository) scanDependencyIssue(rec *neo4j.Record) (*PartialIssue, error) {
+	node, err := Neo4jRecordNode(rec, "i")
+	if err != nil {
+		return nil, err
+	}
+
+	projectKey, err := Neo4jParseValueFromRecord[string](rec, "project_key")
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePartialIssueNode(node, projectKey)
+}
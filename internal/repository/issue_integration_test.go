@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/opcotech/elemo/internal/pkg/optional"
 	"github.com/opcotech/elemo/internal/repository"
 	"github.com/opcotech/elemo/internal/testutil"
@@ -181,6 +182,46 @@ func (s *IssueRepositoryIntegrationTestSuite) TestGetAllForProject() {
 	s.Assert().Len(issues.Items, 2)
 }
 
+func (s *IssueRepositoryIntegrationTestSuite) TestGetAllForProjectWithConditions() {
+	ctx := context.Background()
+
+	dueDate := time.Date(2026, 11, 15, 12, 0, 0, 0, time.UTC)
+
+	parent, err := s.IssueRepo.Create(ctx, s.createOpts)
+	s.Require().NoError(err)
+
+	matchingOpts := testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID)
+	matchingOpts.Parent = &parent.ID
+	matchingOpts.Priority = model.IssuePriorityHighest
+	matchingOpts.DueDate = &dueDate
+	matching, err := s.IssueRepo.Create(ctx, matchingOpts)
+	s.Require().NoError(err)
+
+	otherOpts := testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID)
+	otherOpts.Parent = &parent.ID
+	otherOpts.Priority = model.IssuePriorityLow
+	otherOpts.DueDate = &dueDate
+	_, err = s.IssueRepo.Create(ctx, otherOpts)
+	s.Require().NoError(err)
+
+	issues, err := s.IssueRepo.ListForProject(ctx, repository.IssueListQuery{
+		ProjectID: s.testProject.ID,
+		Page:      repository.CursorPage{Size: 10},
+		Filter: repository.IssueListFilter{
+			Conditions: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldPriority, Operator: repository.IssueListOperatorGte, Values: []string{"high"}},
+				{Field: repository.IssueListConditionFieldDueDate, Operator: repository.IssueListOperatorLt, Values: []string{"2026-12-01"}},
+				{Field: repository.IssueListConditionFieldParent, Operator: repository.IssueListOperatorIn, Values: []string{parent.Key}},
+				{Field: repository.IssueListConditionFieldStatus, Operator: repository.IssueListOperatorIn, Values: []string{model.IssueStatusDone.String()}, Negated: true},
+			},
+		},
+		Projection: repository.IssueListForProjectProjection(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(issues.Items, 1)
+	s.Assert().Equal(matching.ID, issues.Items[0].ID)
+}
+
 func (s *IssueRepositoryIntegrationTestSuite) TestGetAllForNamespace() {
 	_, err := s.IssueRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
@@ -289,6 +330,31 @@ func (s *IssueRepositoryIntegrationTestSuite) TestRemoveWatcher() {
 	s.Assert().Empty(watchers)
 }
 
+func (s *IssueRepositoryIntegrationTestSuite) TestAutoWatch() {
+	created, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	s.Require().NotNil(created.ReportedBy)
+
+	watcher, err := s.UserRepo.Create(context.Background(), testModel.NewCreateUserOpts())
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.IssueRepo.AutoWatch(context.Background(), created.ID, []model.ID{created.ReportedBy.ID, watcher.ID}))
+	watchers, err := s.IssueRepo.GetWatchers(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Assert().Len(watchers, 2)
+
+	s.Require().NoError(s.IssueRepo.RemoveWatcher(context.Background(), created.ID, watcher.ID))
+	s.Require().NoError(s.IssueRepo.AutoWatch(context.Background(), created.ID, []model.ID{watcher.ID}))
+	watchers, err = s.IssueRepo.GetWatchers(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Assert().Len(watchers, 1)
+
+	s.Require().NoError(s.IssueRepo.AddWatcher(context.Background(), created.ID, watcher.ID))
+	watchers, err = s.IssueRepo.GetWatchers(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Assert().Len(watchers, 2)
+}
+
 func (s *IssueRepositoryIntegrationTestSuite) TestAddRelation() {
 	created, err := s.IssueRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
@@ -347,6 +413,384 @@ func (s *IssueRepositoryIntegrationTestSuite) TestUpdate() {
 	s.Assert().NotNil(issue.UpdatedAt)
 }
 
+func (s *IssueRepositoryIntegrationTestSuite) TestUpdateMany() {
+	first, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	second, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	patch := repository.UpdateIssueOpts{
+		Priority: optional.Some(model.IssuePriorityHighest),
+		Status:   optional.Some(model.IssueStatusClosed),
+	}
+	issues, err := s.IssueRepo.UpdateMany(context.Background(), []repository.IssueUpdate{
+		{ID: second.ID, Opts: patch},
+		{ID: model.MustNewID(model.ResourceTypeIssue), Opts: patch},
+		{ID: first.ID, Opts: patch},
+	}, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Require().Len(issues, 2)
+	s.Assert().Equal(second.ID, issues[0].ID)
+	s.Assert().Equal(first.ID, issues[1].ID)
+	for _, issue := range issues {
+		s.Assert().Equal(model.IssuePriorityHighest, issue.Priority)
+		s.Assert().Equal(model.IssueStatusClosed, issue.Status)
+		s.Assert().NotNil(issue.UpdatedAt)
+	}
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestRank() {
+	ids := make([]model.ID, 3)
+	for i := range ids {
+		issue, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+		s.Require().NoError(err)
+		ids[i] = issue.ID
+	}
+
+	listIDs := func(size int, token *string) ([]model.ID, *string) {
+		page, err := s.IssueRepo.ListForProject(context.Background(), repository.IssueListQuery{
+			ProjectID:  s.testProject.ID,
+			SortField:  repository.IssueListSortFieldRank,
+			Page:       repository.CursorPage{Size: size, Token: token},
+			Projection: repository.IssueListForProjectProjection(),
+		})
+		s.Require().NoError(err)
+		out := make([]model.ID, len(page.Items))
+		for i, issue := range page.Items {
+			out[i] = issue.ID
+		}
+		return out, page.PageInfo.NextPageToken
+	}
+
+	got, _ := listIDs(10, nil)
+	s.Assert().Equal(ids, got)
+
+	s.Require().NoError(s.IssueRepo.Rank(context.Background(), ids[2], repository.RankIssueOpts{Before: &ids[0]}))
+	got, _ = listIDs(10, nil)
+	s.Assert().Equal([]model.ID{ids[2], ids[0], ids[1]}, got)
+
+	s.Require().NoError(s.IssueRepo.Rank(context.Background(), ids[2], repository.RankIssueOpts{After: &ids[0], Before: &ids[1]}))
+	got, _ = listIDs(10, nil)
+	s.Assert().Equal([]model.ID{ids[0], ids[2], ids[1]}, got)
+
+	// Moving the last issue of a page continues the next page after the
+	// new position of the issue.
+	first, cursor := listIDs(1, nil)
+	s.Require().Equal([]model.ID{ids[0]}, first)
+	s.Require().NoError(s.IssueRepo.Rank(context.Background(), ids[0], repository.RankIssueOpts{After: &ids[1]}))
+	rest, _ := listIDs(10, cursor)
+	s.Assert().Empty(rest)
+
+	err := s.IssueRepo.Rank(context.Background(), ids[0], repository.RankIssueOpts{Before: &ids[0]})
+	s.Assert().ErrorIs(err, repository.ErrIssueRankAnchor)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestMove() {
+	existing, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	issueOpts := s.createOpts
+	issueOpts.Status = model.IssueStatusDone
+	issueOpts.WorkflowStatus = convert.ToPointer("shipped")
+	issueOpts.CustomFields = map[string]any{"team": "mobile", "size": "large"}
+	issue, err := s.IssueRepo.Create(context.Background(), issueOpts)
+	s.Require().NoError(err)
+	subOpts := testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID)
+	subOpts.Parent = &issue.ID
+	subIssue, err := s.IssueRepo.Create(context.Background(), subOpts)
+	s.Require().NoError(err)
+
+	target, err := s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(s.testNamespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	targetIssue, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(target.ID, s.testUser.ID))
+	s.Require().NoError(err)
+
+	moved, err := s.IssueRepo.Move(context.Background(), issue.ID, target.ID, repository.MoveIssueOpts{
+		Status:         model.IssueStatusOpen,
+		WorkflowStatus: convert.ToPointer("backlog"),
+		CustomFields:   []string{"team"},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(moved, 2)
+	s.Assert().Equal(issue.ID, moved[0].ID)
+	s.Assert().Equal(issue.Key, moved[0].OldKey)
+	s.Assert().Equal(model.IssueStatusDone, moved[0].OldStatus)
+	s.Assert().Equal(model.IssueStatusOpen, moved[0].NewStatus)
+	s.Assert().Equal(convert.ToPointer("shipped"), moved[0].OldWorkflowStatus)
+	s.Assert().Equal(convert.ToPointer("backlog"), moved[0].NewWorkflowStatus)
+	s.Assert().Equal(subIssue.ID, moved[1].ID)
+	s.Assert().Equal(subIssue.Key, moved[1].OldKey)
+
+	got, err := s.IssueRepo.Get(context.Background(), issue.ID, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(target.ID, got.Project.ID)
+	s.Assert().Equal(targetIssue.NumericID+1, got.NumericID)
+	s.Assert().Equal(moved[0].NewKey, got.Key)
+	s.Assert().Equal(model.IssueStatusOpen, got.Status)
+	s.Assert().Equal(convert.ToPointer("backlog"), got.WorkflowStatus)
+	s.Assert().Equal(map[string]any{"team": "mobile"}, got.CustomFields)
+
+	gotSub, err := s.IssueRepo.Get(context.Background(), subIssue.ID, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(target.ID, gotSub.Project.ID)
+	s.Assert().Equal(moved[1].NewKey, gotSub.Key)
+	s.Require().NotNil(gotSub.Parent)
+	s.Assert().Equal(issue.ID, gotSub.Parent.ID)
+	s.Assert().Equal(convert.ToPointer("backlog"), gotSub.WorkflowStatus)
+
+	other, err := s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(s.testNamespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	cleared, err := s.IssueRepo.Move(context.Background(), issue.ID, other.ID, repository.MoveIssueOpts{})
+	s.Require().NoError(err)
+	s.Require().Len(cleared, 2)
+	s.Assert().Equal(model.IssueStatusOpen, cleared[0].NewStatus)
+	s.Assert().Nil(cleared[0].NewWorkflowStatus)
+
+	got, err = s.IssueRepo.Get(context.Background(), issue.ID, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Nil(got.WorkflowStatus)
+	s.Assert().Empty(got.CustomFields)
+
+	byOldKey, err := s.IssueRepo.GetByKey(context.Background(), s.testNamespace.ID, issue.Key, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(issue.ID, byOldKey.ID)
+	s.Assert().Equal(got.Key, byOldKey.Key)
+
+	byNewKey, err := s.IssueRepo.GetByKey(context.Background(), s.testNamespace.ID, got.Key, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(issue.ID, byNewKey.ID)
+
+	byExistingKey, err := s.IssueRepo.GetByKey(context.Background(), s.testNamespace.ID, existing.Key, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(existing.ID, byExistingKey.ID)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestGetEstimates() {
+	opts := s.createOpts
+	opts.Kind = model.IssueKindEpic
+	opts.StoryPoints = convert.ToPointer(8.0)
+	opts.OriginalEstimate = convert.ToPointer(uint(600))
+	epic, err := s.IssueRepo.Create(context.Background(), opts)
+	s.Require().NoError(err)
+	s.Require().NotNil(epic.RemainingEstimate)
+	s.Assert().Equal(uint(600), *epic.RemainingEstimate)
+
+	story := testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID)
+	story.Parent = &epic.ID
+	story.StoryPoints = convert.ToPointer(3.0)
+	child, err := s.IssueRepo.Create(context.Background(), story)
+	s.Require().NoError(err)
+
+	task := testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID)
+	task.Parent = &child.ID
+	grandchild, err := s.IssueRepo.Create(context.Background(), task)
+	s.Require().NoError(err)
+	_, err = s.IssueRepo.Update(context.Background(), grandchild.ID, repository.UpdateIssueOpts{
+		OriginalEstimate:  optional.Some(uint(120)),
+		RemainingEstimate: optional.Some(uint(30)),
+	}, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+
+	estimates, err := s.IssueRepo.GetEstimates(context.Background(), epic.ID)
+	s.Require().NoError(err)
+	s.Require().Len(estimates, 3)
+	s.Assert().Equal(epic.ID, estimates[0].ID)
+	s.Assert().Equal(convert.ToPointer(8.0), estimates[0].StoryPoints)
+
+	byID := make(map[model.ID]*repository.IssueEstimate, len(estimates))
+	for _, estimate := range estimates {
+		byID[estimate.ID] = estimate
+	}
+	s.Assert().Equal(convert.ToPointer(3.0), byID[child.ID].StoryPoints)
+	s.Assert().Nil(byID[child.ID].OriginalEstimate)
+	s.Assert().Equal(convert.ToPointer(uint(30)), byID[grandchild.ID].RemainingEstimate)
+
+	_, err = s.IssueRepo.GetEstimates(context.Background(), model.MustNewID(model.ResourceTypeIssue))
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestHasRelationCycle() {
+	ctx := context.Background()
+
+	issues := make([]*repository.Issue, 3)
+	for i := range issues {
+		created, err := s.IssueRepo.Create(ctx, s.createOpts)
+		s.Require().NoError(err)
+		issues[i] = created
+	}
+	a, b, c := issues[0], issues[1], issues[2]
+
+	// a blocks b, and c is blocked by b, so a is ordered before c.
+	_, err := s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: a.ID, Target: b.ID, Kind: model.IssueRelationKindBlocks})
+	s.Require().NoError(err)
+	relation, err := s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: c.ID, Target: b.ID, Kind: model.IssueRelationKindBlockedBy})
+	s.Require().NoError(err)
+
+	cycle, err := s.IssueRepo.HasRelationCycle(ctx, repository.CreateIssueRelationOpts{Source: c.ID, Target: a.ID, Kind: model.IssueRelationKindBlocks}, nil)
+	s.Require().NoError(err)
+	s.Assert().True(cycle)
+
+	cycle, err = s.IssueRepo.HasRelationCycle(ctx, repository.CreateIssueRelationOpts{Source: a.ID, Target: c.ID, Kind: model.IssueRelationKindDependsOn}, nil)
+	s.Require().NoError(err)
+	s.Assert().True(cycle)
+
+	cycle, err = s.IssueRepo.HasRelationCycle(ctx, repository.CreateIssueRelationOpts{Source: a.ID, Target: c.ID, Kind: model.IssueRelationKindBlocks}, nil)
+	s.Require().NoError(err)
+	s.Assert().False(cycle)
+
+	cycle, err = s.IssueRepo.HasRelationCycle(ctx, repository.CreateIssueRelationOpts{Source: c.ID, Target: a.ID, Kind: model.IssueRelationKindBlocks}, &relation.ID)
+	s.Require().NoError(err)
+	s.Assert().False(cycle)
+
+	cycle, err = s.IssueRepo.HasRelationCycle(ctx, repository.CreateIssueRelationOpts{Source: c.ID, Target: a.ID, Kind: model.IssueRelationKindRelatedTo}, nil)
+	s.Require().NoError(err)
+	s.Assert().False(cycle)
+
+	// b is a subtask of a, and c is a subtask of b.
+	_, err = s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: b.ID, Target: a.ID, Kind: model.IssueRelationKindSubtaskOf})
+	s.Require().NoError(err)
+	_, err = s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: c.ID, Target: b.ID, Kind: model.IssueRelationKindSubtaskOf})
+	s.Require().NoError(err)
+
+	cycle, err = s.IssueRepo.HasRelationCycle(ctx, repository.CreateIssueRelationOpts{Source: a.ID, Target: c.ID, Kind: model.IssueRelationKindSubtaskOf}, nil)
+	s.Require().NoError(err)
+	s.Assert().True(cycle)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestGetDependencyGraph() {
+	ctx := context.Background()
+
+	issues := make([]*repository.Issue, 4)
+	for i := range issues {
+		created, err := s.IssueRepo.Create(ctx, s.createOpts)
+		s.Require().NoError(err)
+		issues[i] = created
+	}
+	a, b, c, d := issues[0], issues[1], issues[2], issues[3]
+
+	_, err := s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: a.ID, Target: b.ID, Kind: model.IssueRelationKindBlocks})
+	s.Require().NoError(err)
+	_, err = s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: c.ID, Target: b.ID, Kind: model.IssueRelationKindSubtaskOf})
+	s.Require().NoError(err)
+	_, err = s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: a.ID, Target: d.ID, Kind: model.IssueRelationKindRelatedTo})
+	s.Require().NoError(err)
+
+	graph, err := s.IssueRepo.GetDependencyGraph(ctx, a.ID, 1)
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]model.ID{a.ID, b.ID}, dependencyGraphIssueIDs(graph))
+	s.Assert().Len(graph.Relations, 1)
+
+	graph, err = s.IssueRepo.GetDependencyGraph(ctx, a.ID, 2)
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]model.ID{a.ID, b.ID, c.ID}, dependencyGraphIssueIDs(graph))
+	s.Assert().Len(graph.Relations, 2)
+
+	_, err = s.IssueRepo.GetDependencyGraph(ctx, model.MustNewID(model.ResourceTypeIssue), 1)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestGetProjectDependencies() {
+	ctx := context.Background()
+
+	startDate := time.Now().UTC().Truncate(time.Second)
+	dueDate := startDate.Add(48 * time.Hour)
+
+	scheduledOpts := s.createOpts
+	scheduledOpts.StartDate = &startDate
+	scheduledOpts.DueDate = &dueDate
+	scheduled, err := s.IssueRepo.Create(ctx, scheduledOpts)
+	s.Require().NoError(err)
+
+	blocker, err := s.IssueRepo.Create(ctx, s.createOpts)
+	s.Require().NoError(err)
+	_, err = s.IssueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{Source: scheduled.ID, Target: blocker.ID, Kind: model.IssueRelationKindBlockedBy})
+	s.Require().NoError(err)
+
+	unrelated, err := s.IssueRepo.Create(ctx, s.createOpts)
+	s.Require().NoError(err)
+
+	graph, err := s.IssueRepo.GetProjectDependencies(ctx, s.testProject.ID)
+	s.Require().NoError(err)
+	s.Assert().Contains(dependencyGraphIssueIDs(graph), scheduled.ID)
+	s.Assert().Contains(dependencyGraphIssueIDs(graph), blocker.ID)
+	s.Assert().NotContains(dependencyGraphIssueIDs(graph), unrelated.ID)
+	s.Require().NotEmpty(graph.Relations)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestGetRollups() {
+	ctx := context.Background()
+
+	epic, err := s.IssueRepo.Create(ctx, s.createOpts)
+	s.Require().NoError(err)
+
+	startDate := time.Now().UTC().Truncate(time.Second)
+	dueDate := startDate.Add(48 * time.Hour)
+	estimate := uint(60)
+
+	childOpts := s.createOpts
+	childOpts.Parent = &epic.ID
+	childOpts.OriginalEstimate = &estimate
+	childOpts.StartDate = &startDate
+	child, err := s.IssueRepo.Create(ctx, childOpts)
+	s.Require().NoError(err)
+
+	grandchildOpts := s.createOpts
+	grandchildOpts.Parent = &child.ID
+	grandchildOpts.Status = model.IssueStatusDone
+	grandchildOpts.DueDate = &dueDate
+	grandchild, err := s.IssueRepo.Create(ctx, grandchildOpts)
+	s.Require().NoError(err)
+
+	missing := model.MustNewID(model.ResourceTypeIssue)
+	rollups, err := s.IssueRepo.GetRollups(ctx, []model.ID{epic.ID, child.ID, grandchild.ID, missing})
+	s.Require().NoError(err)
+	s.Require().Len(rollups, 3)
+	s.Assert().Equal(1, rollups[epic.ID].Todo)
+	s.Assert().Equal(1, rollups[epic.ID].Done)
+	s.Assert().Equal(estimate, *rollups[epic.ID].OriginalEstimate)
+	s.Assert().True(startDate.Equal(*rollups[epic.ID].StartDate))
+	s.Assert().True(dueDate.Equal(*rollups[epic.ID].DueDate))
+	s.Assert().Equal(1, rollups[child.ID].Total())
+	s.Assert().Zero(rollups[grandchild.ID].Total())
+
+	issue, err := s.IssueRepo.Get(ctx, epic.ID, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(rollups[epic.ID].Total(), issue.Rollup.Total())
+
+	ancestors, err := s.IssueRepo.GetAncestors(ctx, grandchild.ID)
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]model.ID{epic.ID, child.ID}, ancestors)
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestCountByField() {
+	ctx := context.Background()
+
+	for _, status := range []model.IssueStatus{model.IssueStatusOpen, model.IssueStatusInProgress, model.IssueStatusInProgress} {
+		opts := testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID)
+		opts.Status = status
+		_, err := s.IssueRepo.Create(ctx, opts)
+		s.Require().NoError(err)
+	}
+
+	counts, err := s.IssueRepo.CountByField(ctx, s.testProject.ID, repository.IssueListConditionFieldStatus)
+	s.Require().NoError(err)
+	s.Assert().Equal(map[string]int64{
+		model.IssueStatusOpen.String():       1,
+		model.IssueStatusInProgress.String(): 2,
+	}, counts)
+
+	counts, err = s.IssueRepo.CountByField(ctx, s.testProject.ID, repository.IssueListConditionFieldWorkflowStatus)
+	s.Require().NoError(err)
+	s.Assert().Empty(counts)
+}
+
+func dependencyGraphIssueIDs(graph *repository.IssueDependencyGraph) []model.ID {
+	ids := make([]model.ID, len(graph.Issues))
+	for i, issue := range graph.Issues {
+		ids[i] = issue.ID
+	}
+	return ids
+}
+
 func (s *IssueRepositoryIntegrationTestSuite) TestDelete() {
 	created, err := s.IssueRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
@@ -355,6 +799,45 @@ func (s *IssueRepositoryIntegrationTestSuite) TestDelete() {
 	s.Assert().ErrorIs(err, repository.ErrNotFound)
 }
 
+func (s *IssueRepositoryIntegrationTestSuite) TestDeleteMany() {
+	first, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	second, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.IssueRepo.DeleteMany(context.Background(), []model.ID{first.ID, second.ID}))
+	for _, id := range []model.ID{first.ID, second.ID} {
+		_, err = s.IssueRepo.Get(context.Background(), id, repository.IssueDetailProjection())
+		s.Assert().ErrorIs(err, repository.ErrNotFound)
+	}
+}
+
+func (s *IssueRepositoryIntegrationTestSuite) TestGetBatchScopes() {
+	issue, err := s.IssueRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	open, err := s.SprintRepo.Create(context.Background(), testModel.NewCreateSprintOpts(s.testProject.ID))
+	s.Require().NoError(err)
+	closed, err := s.SprintRepo.Create(context.Background(), testModel.NewCreateSprintOpts(s.testProject.ID))
+	s.Require().NoError(err)
+	_, err = s.SprintRepo.AddIssue(context.Background(), closed.ID, issue.ID)
+	s.Require().NoError(err)
+	_, err = s.SprintRepo.Update(context.Background(), closed.ID, repository.UpdateSprintOpts{
+		State: optional.Some(model.SprintStateClosed),
+	})
+	s.Require().NoError(err)
+	_, err = s.SprintRepo.AddIssue(context.Background(), open.ID, issue.ID)
+	s.Require().NoError(err)
+
+	scopes, err := s.IssueRepo.GetBatchScopes(context.Background(), []model.ID{issue.ID})
+	s.Require().NoError(err)
+	s.Require().Len(scopes, 1)
+	s.Assert().Equal(issue.ID, scopes[0].ID)
+	s.Assert().Equal(s.testProject.ID, *scopes[0].Project)
+	s.Assert().Equal(s.testNamespace.ID, *scopes[0].Namespace)
+	s.Assert().Equal([]model.ID{open.ID}, scopes[0].Sprints)
+}
+
 func TestIssueRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IssueRepositoryIntegrationTestSuite))
 }
@@ -478,6 +961,32 @@ func (s *CachedIssueRepositoryIntegrationTestSuite) TestUpdateInvalidatesProject
 	s.Assert().Equal(model.IssueStatusDone, page.Items[0].Status)
 }
 
+func (s *CachedIssueRepositoryIntegrationTestSuite) TestUpdateInvalidatesAncestorRollups() {
+	ctx := context.Background()
+
+	epic, err := s.issueRepo.Create(ctx, s.createOpts)
+	s.Require().NoError(err)
+
+	childOpts := s.createOpts
+	childOpts.Parent = &epic.ID
+	child, err := s.issueRepo.Create(ctx, childOpts)
+	s.Require().NoError(err)
+
+	rollups, err := s.issueRepo.GetRollups(ctx, []model.ID{epic.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(1, rollups[epic.ID].Todo)
+
+	_, err = s.issueRepo.Update(ctx, child.ID, repository.UpdateIssueOpts{
+		Status: optional.Some(model.IssueStatusDone),
+	}, repository.IssueDetailProjection())
+	s.Require().NoError(err)
+
+	rollups, err = s.issueRepo.GetRollups(ctx, []model.ID{epic.ID})
+	s.Require().NoError(err)
+	s.Assert().Equal(0, rollups[epic.ID].Todo)
+	s.Assert().Equal(1, rollups[epic.ID].Done)
+}
+
 func (s *CachedIssueRepositoryIntegrationTestSuite) TestGetAllForNamespace() {
 	_, err := s.issueRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -26,9 +27,33 @@ const (
 	IssueListSortFieldID        IssueListSortField = "id"
 )
 
+// issueListSortFieldCustomPrefix prefixes the key of the custom field the
+// issues are sorted by.
+const issueListSortFieldCustomPrefix = "custom_fields."
+
+// IssueListSortFieldCustom returns the sort field ordering the issues by the
+// value of a custom field.
+func IssueListSortFieldCustom(key string) IssueListSortField {
+	return IssueListSortField(issueListSortFieldCustomPrefix + key)
+}
+
+// CustomFieldKey returns the key of the custom field if the issues are sorted
+// by a custom field.
+func (f IssueListSortField) CustomFieldKey() (string, bool) {
+	key, found := strings.CutPrefix(string(f), issueListSortFieldCustomPrefix)
+	if !found || !model.IsCustomFieldKey(key) {
+		return "", false
+	}
+	return key, true
+}
+
 type IssueListSort struct {
 	Field     IssueListSortField
 	Direction SortDirection
+	// StatusOrder holds the keys of the workflow statuses in their order. If
+	// set, the issues sorted by status follow the position of their workflow
+	// status instead of the order of the built-in statuses.
+	StatusOrder []string
 }
 
 func (s IssueListSort) normalize() IssueListSort {
@@ -43,7 +68,9 @@ func (s IssueListSort) normalize() IssueListSort {
 		IssueListSortFieldUpdatedAt,
 		IssueListSortFieldID:
 	default:
-		field = IssueListSortFieldRank
+		if _, ok := field.CustomFieldKey(); !ok {
+			field = IssueListSortFieldRank
+		}
 	}
 
 	direction := s.Direction
@@ -51,9 +78,22 @@ func (s IssueListSort) normalize() IssueListSort {
 		direction = SortDirectionAsc
 	}
 
+	var statusOrder []string
+	if field == IssueListSortFieldStatus && len(s.StatusOrder) > 0 {
+		statusOrder = s.StatusOrder
+	}
+
 	return IssueListSort{
-		Field:     field,
-		Direction: direction,
+		Field:       field,
+		Direction:   direction,
+		StatusOrder: statusOrder,
+	}
+}
+
+// params sets the parameters of the sort expression.
+func (s IssueListSort) params(params map[string]any) {
+	if s.StatusOrder != nil {
+		params["status_order"] = s.StatusOrder
 	}
 }
 
@@ -62,7 +102,8 @@ func (s IssueListSort) nullable() bool {
 	case IssueListSortFieldDueDate, IssueListSortFieldUpdatedAt:
 		return true
 	default:
-		return false
+		_, custom := s.Field.CustomFieldKey()
+		return custom
 	}
 }
 
@@ -102,10 +143,21 @@ func issueStatusRank(status model.IssueStatus) int64 {
 	}
 }
 
-func issueListSortExpression(alias string, field IssueListSortField) string {
-	switch field {
+// issueWorkflowStatusRank returns the position of the workflow status in the
+// order of the workflow statuses.
+func issueWorkflowStatusRank(status *string, order []string) int64 {
+	if status != nil {
+		if i := slices.Index(order, *status); i >= 0 {
+			return int64(i)
+		}
+	}
+	return 99
+}
+
+func issueListSortExpression(alias string, sort IssueListSort) string {
+	switch field := sort.Field; field {
 	case IssueListSortFieldRank:
-		return alias + ".numeric_id"
+		return issueRankSortExpression(alias)
 	case IssueListSortFieldTitle:
 		return "toLower(" + alias + ".title)"
 	case IssueListSortFieldPriority:
@@ -117,6 +169,10 @@ func issueListSortExpression(alias string, field IssueListSortField) string {
 			"WHEN 'highest' THEN 4 " +
 			"ELSE 99 END"
 	case IssueListSortFieldStatus:
+		if sort.StatusOrder != nil {
+			idx := alias + "_status_idx"
+			return "coalesce(head([" + idx + " IN range(0, size($status_order) - 1) WHERE $status_order[" + idx + "] = " + alias + ".workflow_status]), 99)"
+		}
 		return "CASE " + alias + ".status " +
 			"WHEN 'open' THEN 0 " +
 			"WHEN 'in progress' THEN 1 " +
@@ -134,12 +190,15 @@ func issueListSortExpression(alias string, field IssueListSortField) string {
 	case IssueListSortFieldID:
 		return alias + ".id"
 	default:
-		return alias + ".numeric_id"
+		if key, ok := field.CustomFieldKey(); ok {
+			return alias + "." + issueCustomFieldProperty(key)
+		}
+		return issueRankSortExpression(alias)
 	}
 }
 
 func issueListOrderClause(alias string, sort IssueListSort) string {
-	expr := issueListSortExpression(alias, sort.Field)
+	expr := issueListSortExpression(alias, sort)
 	orderDir := sort.Direction.Cypher()
 	tieDir := sort.Direction.Cypher()
 	if sort.nullable() {
@@ -150,9 +209,142 @@ func issueListOrderClause(alias string, sort IssueListSort) string {
 }
 
 type IssueListFilter struct {
-	Text       string
-	Statuses   []model.IssueStatus
-	Priorities []model.IssuePriority
+	Text         string
+	Statuses     []model.IssueStatus
+	Priorities   []model.IssuePriority
+	CustomFields []IssueListCustomFieldFilter
+	Components   []model.ID
+	Conditions   []IssueListCondition
+}
+
+// IssueListConditionField is the field of the issues an issue list condition
+// matches.
+type IssueListConditionField string
+
+const (
+	IssueListConditionFieldText           IssueListConditionField = "text"
+	IssueListConditionFieldStatus         IssueListConditionField = "status"
+	IssueListConditionFieldWorkflowStatus IssueListConditionField = "workflow_status"
+	IssueListConditionFieldPriority       IssueListConditionField = "priority"
+	IssueListConditionFieldKind           IssueListConditionField = "kind"
+	IssueListConditionFieldAssignee       IssueListConditionField = "assignee"
+	IssueListConditionFieldLabel          IssueListConditionField = "label"
+	IssueListConditionFieldParent         IssueListConditionField = "parent"
+	IssueListConditionFieldDueDate        IssueListConditionField = "due_date"
+	IssueListConditionFieldStartDate      IssueListConditionField = "start_date"
+	IssueListConditionFieldCreatedAt      IssueListConditionField = "created_at"
+	IssueListConditionFieldUpdatedAt      IssueListConditionField = "updated_at"
+)
+
+// Ordered reports whether the values of the field can be compared.
+func (f IssueListConditionField) Ordered() bool {
+	switch f {
+	case IssueListConditionFieldPriority,
+		IssueListConditionFieldDueDate,
+		IssueListConditionFieldStartDate,
+		IssueListConditionFieldCreatedAt,
+		IssueListConditionFieldUpdatedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// nullable reports whether the field may be unset on an issue.
+func (f IssueListConditionField) nullable() bool {
+	switch f {
+	case IssueListConditionFieldWorkflowStatus,
+		IssueListConditionFieldDueDate,
+		IssueListConditionFieldStartDate,
+		IssueListConditionFieldUpdatedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// IssueListOperator compares a field of the issues with the values of an
+// issue list condition.
+type IssueListOperator string
+
+const (
+	IssueListOperatorIn  IssueListOperator = "in"
+	IssueListOperatorLt  IssueListOperator = "lt"
+	IssueListOperatorLte IssueListOperator = "lte"
+	IssueListOperatorGt  IssueListOperator = "gt"
+	IssueListOperatorGte IssueListOperator = "gte"
+)
+
+func (o IssueListOperator) cypher() string {
+	switch o {
+	case IssueListOperatorLt:
+		return "<"
+	case IssueListOperatorLte:
+		return "<="
+	case IssueListOperatorGt:
+		return ">"
+	case IssueListOperatorGte:
+		return ">="
+	default:
+		return "IN"
+	}
+}
+
+// IssueListCondition matches the issues whose field equals any of the values
+// or, for the comparison operators, compares to the only value. Text values
+// match as case-insensitive substrings, the labels by their name, the
+// assignees by their ID and the parents by their ID or key. The dates are in
+// the form of 2006-01-02 and compared by the day. Negated conditions match the
+// issues the condition does not, including the issues the field is unset on.
+type IssueListCondition struct {
+	Field    IssueListConditionField `json:"field"`
+	Operator IssueListOperator       `json:"operator"`
+	Values   []string                `json:"values"`
+	Negated  bool                    `json:"negated,omitempty"`
+}
+
+func (c IssueListCondition) valid() bool {
+	switch c.Field {
+	case IssueListConditionFieldText,
+		IssueListConditionFieldStatus,
+		IssueListConditionFieldWorkflowStatus,
+		IssueListConditionFieldPriority,
+		IssueListConditionFieldKind,
+		IssueListConditionFieldAssignee,
+		IssueListConditionFieldLabel,
+		IssueListConditionFieldParent,
+		IssueListConditionFieldDueDate,
+		IssueListConditionFieldStartDate,
+		IssueListConditionFieldCreatedAt,
+		IssueListConditionFieldUpdatedAt:
+	default:
+		return false
+	}
+
+	switch c.Operator {
+	case IssueListOperatorIn:
+		return len(c.Values) > 0
+	case IssueListOperatorLt, IssueListOperatorLte, IssueListOperatorGt, IssueListOperatorGte:
+		if !c.Field.Ordered() || len(c.Values) != 1 {
+			return false
+		}
+		if c.Field == IssueListConditionFieldPriority {
+			_, err := model.IssuePriorityString(c.Values[0])
+			return err == nil
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// IssueListCustomFieldFilter matches the issues having any of the values in a
+// custom field. The values are in the form stored on the issues, and a
+// multi-select field matches if any of its options is selected.
+type IssueListCustomFieldFilter struct {
+	Key    string                `json:"key"`
+	Type   model.CustomFieldType `json:"type"`
+	Values []any                 `json:"values"`
 }
 
 func normalizeIssueListFilter(filter IssueListFilter) IssueListFilter {
@@ -184,6 +376,38 @@ func normalizeIssueListFilter(filter IssueListFilter) IssueListFilter {
 		out.Priorities = append(out.Priorities, priority)
 	}
 
+	for _, field := range filter.CustomFields {
+		if !model.IsCustomFieldKey(field.Key) || len(field.Values) == 0 {
+			continue
+		}
+		out.CustomFields = append(out.CustomFields, field)
+	}
+	slices.SortStableFunc(out.CustomFields, func(a, b IssueListCustomFieldFilter) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	for _, condition := range filter.Conditions {
+		if !condition.valid() {
+			continue
+		}
+		out.Conditions = append(out.Conditions, condition)
+	}
+
+	componentSeen := make(map[string]struct{}, len(filter.Components))
+	for _, component := range filter.Components {
+		if component.Validate() != nil || component.Type != model.ResourceTypeComponent {
+			continue
+		}
+		if _, ok := componentSeen[component.String()]; ok {
+			continue
+		}
+		componentSeen[component.String()] = struct{}{}
+		out.Components = append(out.Components, component)
+	}
+	slices.SortFunc(out.Components, func(a, b model.ID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
 	for i := 1; i < len(out.Statuses); i++ {
 		j := i
 		for j > 0 && out.Statuses[j-1].String() > out.Statuses[j].String() {
@@ -225,10 +449,94 @@ func issueListFilterWhere(issueAlias, projectAlias string, filter IssueListFilte
 		params["priorities"] = priorities
 		parts = append(parts, issueAlias+".priority IN $priorities")
 	}
+	for i, field := range filter.CustomFields {
+		param := "custom_field_" + strconv.Itoa(i)
+		property := issueAlias + "." + issueCustomFieldProperty(field.Key)
+		params[param] = field.Values
+		if field.Type == model.CustomFieldTypeMultiSelect {
+			parts = append(parts, "any(option IN coalesce("+property+", []) WHERE option IN $"+param+")")
+			continue
+		}
+		parts = append(parts, property+" IN $"+param)
+	}
+	if len(filter.Components) > 0 {
+		components := make([]string, 0, len(filter.Components))
+		for _, component := range filter.Components {
+			components = append(components, component.String())
+		}
+		params["components"] = components
+		parts = append(parts, "EXISTS { MATCH ("+issueAlias+")-[:"+EdgeKindInComponent.String()+"]->(c:"+model.ResourceTypeComponent.String()+") WHERE c.id IN $components }")
+	}
+	for i, condition := range filter.Conditions {
+		param := "condition_" + strconv.Itoa(i)
+		where := issueListConditionWhere(issueAlias, projectAlias, param, condition, params)
+		if condition.Negated {
+			// The predicate on an unset field is null, so the issues without
+			// the field are matched explicitly.
+			where = "NOT (" + where + ")"
+			if condition.Field.nullable() {
+				where = "(" + issueAlias + "." + string(condition.Field) + " IS NULL OR " + where + ")"
+			}
+		}
+		parts = append(parts, where)
+	}
 
 	return strings.Join(parts, " AND ")
 }
 
+// issueListConditionWhere compiles the condition into a predicate on the
+// issue. The variables of the subqueries are prefixed with the name of the
+// parameter to keep them apart from the variables of the enclosing query.
+func issueListConditionWhere(issueAlias, projectAlias, param string, condition IssueListCondition, params map[string]any) string {
+	op := condition.Operator.cypher()
+
+	switch condition.Field {
+	case IssueListConditionFieldText:
+		values := make([]string, len(condition.Values))
+		for i, value := range condition.Values {
+			values[i] = strings.ToLower(value)
+		}
+		params[param] = values
+		return "any(" + param + "_text IN $" + param + " WHERE toLower(" + issueAlias + ".title) CONTAINS " + param + "_text OR toLower(coalesce(" + issueAlias + ".description, '')) CONTAINS " + param + "_text OR toLower(coalesce(" + projectAlias + ".key, '') + '-' + toString(" + issueAlias + ".numeric_id)) CONTAINS " + param + "_text)"
+	case IssueListConditionFieldStatus, IssueListConditionFieldWorkflowStatus, IssueListConditionFieldKind:
+		params[param] = condition.Values
+		return issueAlias + "." + string(condition.Field) + " IN $" + param
+	case IssueListConditionFieldPriority:
+		if condition.Operator == IssueListOperatorIn {
+			params[param] = condition.Values
+			return issueAlias + ".priority IN $" + param
+		}
+		priority, _ := model.IssuePriorityString(condition.Values[0])
+		params[param] = issuePriorityRank(priority)
+		return issueListSortExpression(issueAlias, IssueListSort{Field: IssueListSortFieldPriority}) + " " + op + " $" + param
+	case IssueListConditionFieldAssignee:
+		params[param] = condition.Values
+		params[param+"_kind"] = model.AssignmentKindAssignee.String()
+		return "EXISTS { MATCH (" + param + "_user:" + model.ResourceTypeUser.String() + ")-[:" + EdgeKindAssignedTo.String() + " {kind: $" + param + "_kind}]->(" + issueAlias + ") WHERE " + param + "_user.id IN $" + param + " }"
+	case IssueListConditionFieldLabel:
+		values := make([]string, len(condition.Values))
+		for i, value := range condition.Values {
+			values[i] = strings.ToLower(value)
+		}
+		params[param] = values
+		return "EXISTS { MATCH (" + issueAlias + ")-[:" + EdgeKindHasLabel.String() + "]->(" + param + "_label:" + model.ResourceTypeLabel.String() + ") WHERE toLower(" + param + "_label.name) IN $" + param + " }"
+	case IssueListConditionFieldParent:
+		params[param] = condition.Values
+		params[param+"_kind"] = model.IssueRelationKindSubtaskOf.String()
+		return "EXISTS { MATCH (" + issueAlias + ")-[:" + EdgeKindRelatedTo.String() + " {kind: $" + param + "_kind}]->(" + param + "_parent:" + model.ResourceTypeIssue.String() + ")-[:" + EdgeKindBelongsTo.String() + "]->(" + param + "_project:" + model.ResourceTypeProject.String() + ") " +
+			"WHERE " + param + "_parent.id IN $" + param + " OR " + param + "_project.key + '-' + toString(" + param + "_parent.numeric_id) IN $" + param + " }"
+	default:
+		// The dates are stored either as datetimes or as RFC 3339 strings.
+		property := "date(datetime(toString(" + issueAlias + "." + string(condition.Field) + ")))"
+		if condition.Operator == IssueListOperatorIn {
+			params[param] = condition.Values
+			return property + " IN [" + param + "_date IN $" + param + " | date(" + param + "_date)]"
+		}
+		params[param] = condition.Values[0]
+		return property + " " + op + " date($" + param + ")"
+	}
+}
+
 type issueListCursorPayload struct {
 	Version   int                `json:"v"`
 	Hash      string             `json:"h"`
@@ -248,12 +556,16 @@ type issueListCursor struct {
 
 func issueListCursorHash(scopeID model.ID, filter IssueListFilter, sort IssueListSort) string {
 	type hashInput struct {
-		Scope      string   `json:"scope"`
-		SortField  string   `json:"sort_field"`
-		Direction  string   `json:"direction"`
-		Text       string   `json:"text"`
-		Statuses   []string `json:"statuses"`
-		Priorities []string `json:"priorities"`
+		Scope        string                       `json:"scope"`
+		SortField    string                       `json:"sort_field"`
+		Direction    string                       `json:"direction"`
+		Text         string                       `json:"text"`
+		Statuses     []string                     `json:"statuses"`
+		Priorities   []string                     `json:"priorities"`
+		CustomFields []IssueListCustomFieldFilter `json:"custom_fields,omitempty"`
+		Components   []string                     `json:"components,omitempty"`
+		Conditions   []IssueListCondition         `json:"conditions,omitempty"`
+		StatusOrder  []string                     `json:"status_order,omitempty"`
 	}
 
 	statuses := make([]string, 0, len(filter.Statuses))
@@ -266,13 +578,22 @@ func issueListCursorHash(scopeID model.ID, filter IssueListFilter, sort IssueLis
 		priorities = append(priorities, priority.String())
 	}
 
+	components := make([]string, 0, len(filter.Components))
+	for _, component := range filter.Components {
+		components = append(components, component.String())
+	}
+
 	raw, _ := json.Marshal(hashInput{
-		Scope:      scopeID.String(),
-		SortField:  string(sort.Field),
-		Direction:  sort.Direction.String(),
-		Text:       filter.Text,
-		Statuses:   statuses,
-		Priorities: priorities,
+		Scope:        scopeID.String(),
+		SortField:    string(sort.Field),
+		Direction:    sort.Direction.String(),
+		Text:         filter.Text,
+		Statuses:     statuses,
+		Priorities:   priorities,
+		CustomFields: filter.CustomFields,
+		Components:   components,
+		Conditions:   filter.Conditions,
+		StatusOrder:  sort.StatusOrder,
 	})
 
 	sum := sha256.Sum256(raw)
@@ -284,7 +605,7 @@ func encodeIssueListCursor(issue *PartialIssue, sort IssueListSort, cursorHash s
 		return "", ErrInvalidCursor
 	}
 
-	sortValue, sortNull, err := issueListSortValue(issue, sort.Field)
+	sortValue, sortNull, err := issueListSortValue(issue, sort)
 	if err != nil {
 		return "", err
 	}
@@ -359,10 +680,10 @@ func decodeIssueListCursor(token string, cursorHash string, sort IssueListSort)
 	}, nil
 }
 
-func issueListSortValue(issue *PartialIssue, field IssueListSortField) (*string, bool, error) {
-	switch field {
+func issueListSortValue(issue *PartialIssue, sort IssueListSort) (*string, bool, error) {
+	switch field := sort.Field; field {
 	case IssueListSortFieldRank:
-		v := strconv.FormatUint(uint64(issue.NumericID), 10)
+		v := issueRankSortKey(issue.Rank, issue.NumericID)
 		return &v, false, nil
 	case IssueListSortFieldTitle:
 		v := strings.ToLower(issue.Title)
@@ -371,7 +692,11 @@ func issueListSortValue(issue *PartialIssue, field IssueListSortField) (*string,
 		v := strconv.FormatInt(issuePriorityRank(issue.Priority), 10)
 		return &v, false, nil
 	case IssueListSortFieldStatus:
-		v := strconv.FormatInt(issueStatusRank(issue.Status), 10)
+		rank := issueStatusRank(issue.Status)
+		if sort.StatusOrder != nil {
+			rank = issueWorkflowStatusRank(issue.WorkflowStatus, sort.StatusOrder)
+		}
+		v := strconv.FormatInt(rank, 10)
 		return &v, false, nil
 	case IssueListSortFieldDueDate:
 		if issue.DueDate == nil {
@@ -395,7 +720,20 @@ func issueListSortValue(issue *PartialIssue, field IssueListSortField) (*string,
 		v := issue.ID.String()
 		return &v, false, nil
 	default:
-		return nil, false, ErrUnsupportedOrder
+		key, ok := field.CustomFieldKey()
+		if !ok {
+			return nil, false, ErrUnsupportedOrder
+		}
+		value, ok := issue.CustomFields[key]
+		if !ok || value == nil {
+			return nil, true, nil
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, false, errors.Join(ErrInvalidCursor, err)
+		}
+		v := string(raw)
+		return &v, false, nil
 	}
 }
 
@@ -408,14 +746,29 @@ func issueListCursorWhere(alias string, sort IssueListSort, cursor issueListCurs
 		return CursorWhereCypher(alias, sort.Direction)
 	}
 
-	expr := issueListSortExpression(alias, sort.Field)
+	expr := issueListSortExpression(alias, sort)
 	cursorValue := "cursor_sort"
+	if sort.Field == IssueListSortFieldRank {
+		if cursor.Sort == nil {
+			return "", ErrInvalidCursor
+		}
+		params[cursorValue] = *cursor.Sort
+		return issueListRankCursorWhere(alias, sort, expr, cursorValue), nil
+	}
 	if !cursor.SortNull {
 		if cursor.Sort == nil {
 			return "", ErrInvalidCursor
 		}
-		switch sort.Field {
-		case IssueListSortFieldRank, IssueListSortFieldPriority, IssueListSortFieldStatus:
+		_, custom := sort.Field.CustomFieldKey()
+		switch {
+		case custom:
+			// The values of custom fields are encoded as JSON to keep their type.
+			var value any
+			if err := json.Unmarshal([]byte(*cursor.Sort), &value); err != nil {
+				return "", errors.Join(ErrInvalidCursor, err)
+			}
+			params[cursorValue] = value
+		case sort.Field == IssueListSortFieldPriority, sort.Field == IssueListSortFieldStatus:
 			n, err := strconv.ParseInt(*cursor.Sort, 10, 64)
 			if err != nil {
 				return "", errors.Join(ErrInvalidCursor, err)
@@ -440,3 +793,20 @@ func issueListCursorWhere(alias string, sort IssueListSort, cursor issueListCurs
 
 	return fmt.Sprintf("(%s %s $%s OR (%s = $%s AND %s.id %s $cursor_id))", expr, greaterThan, cursorValue, expr, cursorValue, alias, greaterThan), nil
 }
+
+// issueListRankCursorWhere continues the list after the current rank of the
+// cursor issue rather than the rank it had when the page was read. Moving or
+// rebalancing issues between two pages therefore continues the list right
+// after the last issue returned. The encoded rank is used only if the cursor
+// issue no longer exists.
+func issueListRankCursorWhere(alias string, sort IssueListSort, expr, cursorValue string) string {
+	greaterThan := ">"
+	if sort.Direction == SortDirectionDesc {
+		greaterThan = "<"
+	}
+
+	anchor := "coalesce(head([(cursor_issue:" + model.ResourceTypeIssue.String() + " {id: $cursor_id}) | " +
+		issueRankSortExpression("cursor_issue") + "]), $" + cursorValue + ")"
+
+	return fmt.Sprintf("(%s %s %s OR (%s = %s AND %s.id %s $cursor_id))", expr, greaterThan, anchor, expr, anchor, alias, greaterThan)
+}
@@ -70,6 +70,35 @@ func (mr *MockIssueRepositoryMockRecorder) AddWatcher(ctx, issue, user any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWatcher", reflect.TypeOf((*MockIssueRepository)(nil).AddWatcher), ctx, issue, user)
 }
 
+// AutoWatch mocks base method.
+func (m *MockIssueRepository) AutoWatch(ctx context.Context, issue model.ID, users []model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoWatch", ctx, issue, users)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AutoWatch indicates an expected call of AutoWatch.
+func (mr *MockIssueRepositoryMockRecorder) AutoWatch(ctx, issue, users any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoWatch", reflect.TypeOf((*MockIssueRepository)(nil).AutoWatch), ctx, issue, users)
+}
+
+// CountByField mocks base method.
+func (m *MockIssueRepository) CountByField(ctx context.Context, project model.ID, field IssueListConditionField) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByField", ctx, project, field)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByField indicates an expected call of CountByField.
+func (mr *MockIssueRepositoryMockRecorder) CountByField(ctx, project, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByField", reflect.TypeOf((*MockIssueRepository)(nil).CountByField), ctx, project, field)
+}
+
 // Create mocks base method.
 func (m *MockIssueRepository) Create(ctx context.Context, opts CreateIssueOpts) (*Issue, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +128,20 @@ func (mr *MockIssueRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIssueRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteMany mocks base method.
+func (m *MockIssueRepository) DeleteMany(ctx context.Context, ids []model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockIssueRepositoryMockRecorder) DeleteMany(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockIssueRepository)(nil).DeleteMany), ctx, ids)
+}
+
 // Get mocks base method.
 func (m *MockIssueRepository) Get(ctx context.Context, id model.ID, proj IssueProjection) (*Issue, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +157,36 @@ func (mr *MockIssueRepositoryMockRecorder) Get(ctx, id, proj any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIssueRepository)(nil).Get), ctx, id, proj)
 }
 
+// GetAncestors mocks base method.
+func (m *MockIssueRepository) GetAncestors(ctx context.Context, issue model.ID) ([]model.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAncestors", ctx, issue)
+	ret0, _ := ret[0].([]model.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAncestors indicates an expected call of GetAncestors.
+func (mr *MockIssueRepositoryMockRecorder) GetAncestors(ctx, issue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestors", reflect.TypeOf((*MockIssueRepository)(nil).GetAncestors), ctx, issue)
+}
+
+// GetBatchScopes mocks base method.
+func (m *MockIssueRepository) GetBatchScopes(ctx context.Context, ids []model.ID) ([]*IssueBatchScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBatchScopes", ctx, ids)
+	ret0, _ := ret[0].([]*IssueBatchScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBatchScopes indicates an expected call of GetBatchScopes.
+func (mr *MockIssueRepositoryMockRecorder) GetBatchScopes(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchScopes", reflect.TypeOf((*MockIssueRepository)(nil).GetBatchScopes), ctx, ids)
+}
+
 // GetByKey mocks base method.
 func (m *MockIssueRepository) GetByKey(ctx context.Context, namespaceID model.ID, key string, proj IssueProjection) (*Issue, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +202,51 @@ func (mr *MockIssueRepositoryMockRecorder) GetByKey(ctx, namespaceID, key, proj
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockIssueRepository)(nil).GetByKey), ctx, namespaceID, key, proj)
 }
 
+// GetDependencyGraph mocks base method.
+func (m *MockIssueRepository) GetDependencyGraph(ctx context.Context, issue model.ID, depth int) (*IssueDependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", ctx, issue, depth)
+	ret0, _ := ret[0].(*IssueDependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockIssueRepositoryMockRecorder) GetDependencyGraph(ctx, issue, depth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockIssueRepository)(nil).GetDependencyGraph), ctx, issue, depth)
+}
+
+// GetEstimates mocks base method.
+func (m *MockIssueRepository) GetEstimates(ctx context.Context, issue model.ID) ([]*IssueEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstimates", ctx, issue)
+	ret0, _ := ret[0].([]*IssueEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstimates indicates an expected call of GetEstimates.
+func (mr *MockIssueRepositoryMockRecorder) GetEstimates(ctx, issue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstimates", reflect.TypeOf((*MockIssueRepository)(nil).GetEstimates), ctx, issue)
+}
+
+// GetProjectDependencies mocks base method.
+func (m *MockIssueRepository) GetProjectDependencies(ctx context.Context, project model.ID) (*IssueDependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDependencies", ctx, project)
+	ret0, _ := ret[0].(*IssueDependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectDependencies indicates an expected call of GetProjectDependencies.
+func (mr *MockIssueRepositoryMockRecorder) GetProjectDependencies(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDependencies", reflect.TypeOf((*MockIssueRepository)(nil).GetProjectDependencies), ctx, project)
+}
+
 // GetRelation mocks base method.
 func (m *MockIssueRepository) GetRelation(ctx context.Context, relationID model.ID) (*IssueRelation, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +277,21 @@ func (mr *MockIssueRepositoryMockRecorder) GetRelations(ctx, issue any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelations", reflect.TypeOf((*MockIssueRepository)(nil).GetRelations), ctx, issue)
 }
 
+// GetRollups mocks base method.
+func (m *MockIssueRepository) GetRollups(ctx context.Context, ids []model.ID) (map[model.ID]*model.IssueRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollups", ctx, ids)
+	ret0, _ := ret[0].(map[model.ID]*model.IssueRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRollups indicates an expected call of GetRollups.
+func (mr *MockIssueRepositoryMockRecorder) GetRollups(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollups", reflect.TypeOf((*MockIssueRepository)(nil).GetRollups), ctx, ids)
+}
+
 // GetWatchers mocks base method.
 func (m *MockIssueRepository) GetWatchers(ctx context.Context, issue model.ID) ([]*User, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +307,21 @@ func (mr *MockIssueRepositoryMockRecorder) GetWatchers(ctx, issue any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchers", reflect.TypeOf((*MockIssueRepository)(nil).GetWatchers), ctx, issue)
 }
 
+// HasRelationCycle mocks base method.
+func (m *MockIssueRepository) HasRelationCycle(ctx context.Context, opts CreateIssueRelationOpts, ignore *model.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasRelationCycle", ctx, opts, ignore)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasRelationCycle indicates an expected call of HasRelationCycle.
+func (mr *MockIssueRepositoryMockRecorder) HasRelationCycle(ctx, opts, ignore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasRelationCycle", reflect.TypeOf((*MockIssueRepository)(nil).HasRelationCycle), ctx, opts, ignore)
+}
+
 // ListForIssue mocks base method.
 func (m *MockIssueRepository) ListForIssue(ctx context.Context, query IssueListForIssueQuery) (Page[*Issue], error) {
 	m.ctrl.T.Helper()
@@ -249,6 +397,35 @@ func (mr *MockIssueRepositoryMockRecorder) ListRelations(ctx, query any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelations", reflect.TypeOf((*MockIssueRepository)(nil).ListRelations), ctx, query)
 }
 
+// Move mocks base method.
+func (m *MockIssueRepository) Move(ctx context.Context, id, projectID model.ID, opts MoveIssueOpts) ([]*MovedIssue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, id, projectID, opts)
+	ret0, _ := ret[0].([]*MovedIssue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockIssueRepositoryMockRecorder) Move(ctx, id, projectID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockIssueRepository)(nil).Move), ctx, id, projectID, opts)
+}
+
+// Rank mocks base method.
+func (m *MockIssueRepository) Rank(ctx context.Context, id model.ID, opts RankIssueOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rank", ctx, id, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rank indicates an expected call of Rank.
+func (mr *MockIssueRepositoryMockRecorder) Rank(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rank", reflect.TypeOf((*MockIssueRepository)(nil).Rank), ctx, id, opts)
+}
+
 // RemoveRelation mocks base method.
 func (m *MockIssueRepository) RemoveRelation(ctx context.Context, source, target model.ID, kind model.IssueRelationKind) error {
 	m.ctrl.T.Helper()
@@ -305,3 +482,18 @@ func (mr *MockIssueRepositoryMockRecorder) Update(ctx, id, opts, proj any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIssueRepository)(nil).Update), ctx, id, opts, proj)
 }
+
+// UpdateMany mocks base method.
+func (m *MockIssueRepository) UpdateMany(ctx context.Context, updates []IssueUpdate, proj IssueProjection) ([]*Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMany", ctx, updates, proj)
+	ret0, _ := ret[0].([]*Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMany indicates an expected call of UpdateMany.
+func (mr *MockIssueRepositoryMockRecorder) UpdateMany(ctx, updates, proj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMany", reflect.TypeOf((*MockIssueRepository)(nil).UpdateMany), ctx, updates, proj)
+}
@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+// MoveIssueOpts holds the fields of the moved issues that depend on the
+// project they are moved to.
+type MoveIssueOpts struct {
+	Status         model.IssueStatus // status of the initial workflow status, kept if zero
+	WorkflowStatus *string           // initial status of the target workflow, cleared if nil
+	CustomFields   []string          // keys of the custom fields defined for the target project
+}
+
+// MovedIssue is an issue moved to another project with the keys and statuses
+// it had before and after the move. Sprints are the open sprints of the
+// previous project the issue was removed from.
+type MovedIssue struct {
+	ID                model.ID
+	OldKey            string
+	NewKey            string
+	OldStatus         model.IssueStatus
+	NewStatus         model.IssueStatus
+	OldWorkflowStatus *string
+	NewWorkflowStatus *string
+	Sprints           []model.ID
+}
+
+// issueMoveTree is the issue being moved followed by its subtasks.
+type issueMoveTree struct {
+	ID          string   `json:"id"`
+	Descendants []string `json:"descendants"`
+}
+
+// issueMoveResult is the outcome of moving a single issue.
+type issueMoveResult struct {
+	OldKey            string            `json:"old_key"`
+	NewKey            string            `json:"new_key"`
+	OldStatus         model.IssueStatus `json:"old_status"`
+	NewStatus         model.IssueStatus `json:"new_status"`
+	OldWorkflowStatus *string           `json:"old_workflow_status"`
+	NewWorkflowStatus *string           `json:"new_workflow_status"`
+	Sprints           []string          `json:"sprints"`
+}
+
+// Move moves the issue and its subtasks of the same project to another
+// project. Every moved issue gets the next number of the target project and
+// is appended to its backlog, while the key it had before is kept as an alias
+// through a MOVED_FROM edge. The components, the unreleased fix versions and
+// the open sprints of the previous project are detached, the closed sprints
+// and released fix versions are kept as history.
+//
+// The moved issues start over in the initial status of the target workflow,
+// and keep the values of the custom fields the target project defines only.
+func (r *Neo4jIssueRepository) Move(ctx context.Context, id, projectID model.ID, opts MoveIssueOpts) ([]*MovedIssue, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/Move")
+	defer span.End()
+
+	treeCypher := `
+	MATCH (root:` + id.Label() + ` {id: $id})-[:` + EdgeKindBelongsTo.String() + `]->(source:` + model.ResourceTypeProject.String() + `)
+	MATCH (:` + projectID.Label() + ` {id: $project_id})
+	OPTIONAL MATCH (d:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindRelatedTo.String() + `*1.. {kind: $subtask_kind}]->(root)
+	WHERE (d)-[:` + EdgeKindBelongsTo.String() + `]->(source)
+	WITH DISTINCT root, d
+	ORDER BY d.numeric_id ASC
+	RETURN root.id AS id, collect(d.id) AS descendants`
+
+	moveCypher := `
+	MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: $id})-[b:` + EdgeKindBelongsTo.String() + `]->(source:` + model.ResourceTypeProject.String() + `)
+	MATCH (target:` + projectID.Label() + ` {id: $project_id})
+	SET target.next_issue_id = coalesce(target.next_issue_id, 0) + 1
+	WITH i, b, source, target, source.key + "-" + toString(i.numeric_id) AS old_key, i.status AS old_status, i.workflow_status AS old_workflow_status
+	CREATE (i)-[:` + EdgeKindMovedFrom.String() + ` {id: $moved_from_rel_id, numeric_id: i.numeric_id, created_at: datetime($moved_at)}]->(source)
+	DELETE b
+	CREATE
+		(i)-[:` + EdgeKindBelongsTo.String() + ` {id: $belongs_to_rel_id, created_at: datetime($moved_at)}]->(target),
+		(i)-[:` + EdgeKindInScopeOf.String() + ` {id: $scope_id, created_at: datetime($moved_at)}]->(target)
+	SET
+		i.numeric_id = target.next_issue_id,
+		i.rank = $rank,
+		i.status = coalesce($status, i.status),
+		i.workflow_status = $workflow_status,
+		i.updated_at = datetime($moved_at)
+	FOREACH (key IN [k IN keys(i) WHERE k STARTS WITH $custom_field_prefix AND NOT k IN $custom_fields] | REMOVE i[key])
+	WITH i, source, target, old_key, old_status, old_workflow_status
+	OPTIONAL MATCH (i)-[scope:` + EdgeKindInScopeOf.String() + `]->(source)
+	DELETE scope
+	WITH DISTINCT i, target, old_key, old_status, old_workflow_status
+	OPTIONAL MATCH (i)-[c:` + EdgeKindInComponent.String() + `]->(:` + model.ResourceTypeComponent.String() + `)
+	DELETE c
+	WITH DISTINCT i, target, old_key, old_status, old_workflow_status
+	OPTIONAL MATCH (i)-[f:` + EdgeKindHasFixVersion.String() + `]->(r:` + model.ResourceTypeRelease.String() + `)
+	WHERE r.status <> $released
+	DELETE f
+	WITH DISTINCT i, target, old_key, old_status, old_workflow_status
+	OPTIONAL MATCH (i)-[e:` + EdgeKindInSprint.String() + `]->(s:` + model.ResourceTypeSprint.String() + `)
+	WHERE s.state <> $closed
+	WITH i, target, old_key, old_status, old_workflow_status, collect(e) AS sprint_edges, collect(s.id) AS sprints
+	FOREACH (e IN sprint_edges | DELETE e)
+	RETURN
+		old_key, target.key + "-" + toString(i.numeric_id) AS new_key,
+		old_status, i.status AS new_status,
+		old_workflow_status, i.workflow_status AS new_workflow_status,
+		sprints`
+
+	var status, workflowStatus any
+	if opts.Status != 0 {
+		status = opts.Status.String()
+	}
+	if opts.WorkflowStatus != nil {
+		workflowStatus = *opts.WorkflowStatus
+	}
+
+	customFieldProperties := make([]string, len(opts.CustomFields))
+	for i, key := range opts.CustomFields {
+		customFieldProperties[i] = issueCustomFieldProperty(key)
+	}
+
+	movedAt := time.Now().UTC().Format(time.RFC3339Nano)
+
+	var moved []*MovedIssue
+	err := neo4jExecuteWrite(ctx, r.db, func(tx neo4j.ManagedTransaction) error {
+		moved = nil
+
+		treeParams := map[string]any{
+			"id":           id.String(),
+			"project_id":   projectID.String(),
+			"subtask_kind": model.IssueRelationKindSubtaskOf.String(),
+		}
+
+		tree, err := neo4jTxReadSingle(ctx, tx, treeCypher, treeParams, func(record *neo4j.Record) (*issueMoveTree, error) {
+			tree := new(issueMoveTree)
+			return tree, convert.AnyToAny(record.AsMap(), tree)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, rawID := range append([]string{tree.ID}, tree.Descendants...) {
+			issueID, err := model.NewIDFromString(rawID, model.ResourceTypeIssue.String())
+			if err != nil {
+				return err
+			}
+
+			rank, err := nextIssueRank(ctx, tx, projectID)
+			if err != nil {
+				return err
+			}
+
+			params := map[string]any{
+				"id":                  rawID,
+				"project_id":          projectID.String(),
+				"rank":                rank,
+				"status":              status,
+				"workflow_status":     workflowStatus,
+				"custom_field_prefix": issueCustomFieldPrefix,
+				"custom_fields":       customFieldProperties,
+				"released":            model.ReleaseStatusReleased.String(),
+				"closed":              model.SprintStateClosed.String(),
+				"moved_at":            movedAt,
+				"moved_from_rel_id":   model.NewRawID(),
+				"belongs_to_rel_id":   model.NewRawID(),
+				"scope_id":            model.NewRawID(),
+			}
+
+			result, err := neo4jTxReadSingle(ctx, tx, moveCypher, params, func(record *neo4j.Record) (*issueMoveResult, error) {
+				result := new(issueMoveResult)
+				return result, convert.AnyToAny(record.AsMap(), result)
+			})
+			if err != nil {
+				return err
+			}
+
+			issue := &MovedIssue{
+				ID:                issueID,
+				OldKey:            result.OldKey,
+				NewKey:            result.NewKey,
+				OldStatus:         result.OldStatus,
+				NewStatus:         result.NewStatus,
+				OldWorkflowStatus: result.OldWorkflowStatus,
+				NewWorkflowStatus: result.NewWorkflowStatus,
+				Sprints:           make([]model.ID, len(result.Sprints)),
+			}
+			for i, sprint := range result.Sprints {
+				if issue.Sprints[i], err = model.NewIDFromString(sprint, model.ResourceTypeSprint.String()); err != nil {
+					return err
+				}
+			}
+			moved = append(moved, issue)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueMove, err)
+	}
+
+	return moved, nil
+}
+
+// Move invalidates every cache that may contain the keys or the project of
+// the moved issues. The issue list caches are invalidated through the
+// authorization epoch, as the moved issues change their permission scope.
+func (r *RedisCachedIssueRepository) Move(ctx context.Context, id, projectID model.ID, opts MoveIssueOpts) ([]*MovedIssue, error) {
+	moved, err := r.issueRepo.Move(ctx, id, projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range moved {
+		if err := clearIssuesKey(ctx, r.cacheRepo, issue.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The relations and sub-issues of other issues embed the keys of the
+	// moved issues.
+	for _, pattern := range [][]string{
+		{"GetRelations", "*"},
+		{"*", "ListRelations", "*"},
+		{"*", "ListForIssue", "*"},
+	} {
+		if err := clearIssuesPattern(ctx, r.cacheRepo, pattern...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := clearIssueAllGetByKey(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+	if err := bumpIssueListAuthzEpoch(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+	if err := clearIssueAllCrossCache(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
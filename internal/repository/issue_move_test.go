@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/cache/v9"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedIssueRepository_Move(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeIssue)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	moved := []*MovedIssue{
+		{ID: id, OldKey: "MOB-12", NewKey: "WEB-3"},
+		{ID: model.MustNewID(model.ResourceTypeIssue), OldKey: "MOB-13", NewKey: "WEB-4"},
+	}
+
+	var patterns []string
+	for _, issue := range moved {
+		patterns = append(patterns, composeCacheKey(model.ResourceTypeIssue.String(), "Get", issue.ID.String(), "*"))
+	}
+	patterns = append(patterns,
+		composeCacheKey(model.ResourceTypeIssue.String(), "GetRelations", "*"),
+		composeCacheKey(model.ResourceTypeIssue.String(), "*", "ListRelations", "*"),
+		composeCacheKey(model.ResourceTypeIssue.String(), "*", "ListForIssue", "*"),
+		composeCacheKey(model.ResourceTypeIssue.String(), "GetByKey", "*"),
+		composeCacheKey(model.ResourceTypeProject.String(), "*"),
+	)
+	authzEpochKey := issueListAuthzEpochKey()
+
+	dbClient := mock.NewUniversalClient(ctrl)
+	cacheRepo := mock.NewCacheBackend(ctrl)
+	for _, pattern := range patterns {
+		cmd := new(redis.StringSliceCmd)
+		cmd.SetVal([]string{pattern})
+		dbClient.EXPECT().Keys(ctx, pattern).Return(cmd)
+		cacheRepo.EXPECT().Delete(ctx, pattern).Return(nil)
+	}
+	cacheRepo.EXPECT().Get(ctx, authzEpochKey, gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: authzEpochKey, Value: int64(1)}).Return(nil)
+
+	db, err := NewRedisDatabase(WithRedisClient(dbClient))
+	require.NoError(t, err)
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).Times(len(patterns) + 2)
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(len(patterns))
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span)
+
+	issueRepo := NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().Move(ctx, id, projectID, MoveIssueOpts{}).Return(moved, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: &redisBaseRepository{
+			db:     db,
+			cache:  cacheRepo,
+			tracer: tracer,
+			logger: mock.NewMockLogger(ctrl),
+		},
+		issueRepo: issueRepo,
+	}
+	got, err := r.Move(ctx, id, projectID, MoveIssueOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, moved, got)
+}
+
+func TestCachedIssueRepository_Move_error(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeIssue)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	issueRepo := NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().Move(ctx, id, projectID, MoveIssueOpts{}).Return(nil, ErrIssueMove)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: redisCacheExpectingPatterns(ctrl, ctx, nil, -1, nil),
+		issueRepo: issueRepo,
+	}
+	got, err := r.Move(ctx, id, projectID, MoveIssueOpts{})
+	require.ErrorIs(t, err, ErrIssueMove)
+	assert.Nil(t, got)
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/opcotech/elemo/internal/model"
@@ -10,11 +11,14 @@ type IssueProjection struct {
 	Parent          bool
 	Assignments     bool
 	Labels          bool
+	Components      bool
 	CommentCount    bool
 	DocumentCount   bool
 	AttachmentCount bool
 	WatcherCount    bool
 	RelationCount   bool
+	CustomFields    bool
+	Rollup          bool
 }
 
 func IssueDetailProjection() IssueProjection {
@@ -22,11 +26,14 @@ func IssueDetailProjection() IssueProjection {
 		Parent:          true,
 		Assignments:     true,
 		Labels:          true,
+		Components:      true,
 		CommentCount:    true,
 		DocumentCount:   true,
 		AttachmentCount: true,
 		WatcherCount:    true,
 		RelationCount:   true,
+		CustomFields:    true,
+		Rollup:          true,
 	}
 }
 
@@ -35,6 +42,7 @@ type IssueListProjection struct {
 	Parent      bool
 	Assignments bool
 	Labels      bool
+	Components  bool
 }
 
 // IssueListForProjectProjection is the allowlisted projection for project issues.
@@ -43,6 +51,7 @@ func IssueListForProjectProjection() IssueListProjection {
 		Parent:      true,
 		Assignments: true,
 		Labels:      true,
+		Components:  true,
 	}
 }
 
@@ -56,6 +65,7 @@ func (p IssueListProjection) issueProjection() IssueProjection {
 		Parent:      p.Parent,
 		Assignments: p.Assignments,
 		Labels:      p.Labels,
+		Components:  p.Components,
 	}
 }
 
@@ -88,6 +98,9 @@ type IssueListQuery struct {
 	Order      SortDirection
 	Filter     IssueListFilter
 	Projection IssueListProjection
+	// StatusOrder holds the keys of the statuses of the project workflow in
+	// their order, if the project has a workflow.
+	StatusOrder []string
 }
 
 // IssueListForNamespaceQuery compiles a cursor-paginated issue list for a namespace.
@@ -140,6 +153,8 @@ func (q IssueGetQuery) Compile() (QueryPlan, error) {
 	})
 }
 
+// Compile resolves the key against the current keys of the issues and the
+// keys the issues had before being moved to another project.
 func (q IssueGetByKeyQuery) Compile() (QueryPlan, error) {
 	if err := q.NamespaceID.Validate(); err != nil {
 		return QueryPlan{}, err
@@ -149,10 +164,16 @@ func (q IssueGetByKeyQuery) Compile() (QueryPlan, error) {
 		Root: CompiledQuery{
 			Name: "issue.get_by_key",
 			Cypher: `
-				MATCH (n:` + q.NamespaceID.Label() + ` {id: $namespace_id})-[:` + EdgeKindHasProject.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
-				MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(i:` + model.ResourceTypeIssue.String() + ` {numeric_id: toInteger(split($issue_key, "-")[1])})
+				MATCH (:` + q.NamespaceID.Label() + ` {id: $namespace_id})-[:` + EdgeKindHasProject.String() + `]->(kp:` + model.ResourceTypeProject.String() + `)
+				WITH kp, toInteger(split($issue_key, "-")[1]) AS number
+				WHERE $issue_key = kp.key + "-" + toString(number)
+				OPTIONAL MATCH (kp)<-[:` + EdgeKindBelongsTo.String() + `]-(current:` + model.ResourceTypeIssue.String() + ` {numeric_id: number})
+				OPTIONAL MATCH (kp)<-[:` + EdgeKindMovedFrom.String() + ` {numeric_id: number}]-(moved:` + model.ResourceTypeIssue.String() + `)
+				WITH coalesce(current, moved) AS i
+				WHERE i IS NOT NULL
+				MATCH (i)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				OPTIONAL MATCH (n:` + model.ResourceTypeNamespace.String() + `)-[:` + EdgeKindHasProject.String() + `]->(p)
 				MATCH (u:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindCreated.String() + `]->(i)
-				WHERE $issue_key = p.key + "-" + toString(i.numeric_id)
 				RETURN i, p, n, u`,
 			Params: map[string]any{
 				"namespace_id": q.NamespaceID.String(),
@@ -205,8 +226,9 @@ func (q IssueListQuery) Compile() (QueryPlan, error) {
 	}
 
 	sort := IssueListSort{
-		Field:     q.SortField,
-		Direction: q.Order,
+		Field:       q.SortField,
+		Direction:   q.Order,
+		StatusOrder: q.StatusOrder,
 	}.normalize()
 	filter := normalizeIssueListFilter(q.Filter)
 	cursorHash := issueListCursorHash(q.ProjectID, filter, sort)
@@ -215,6 +237,7 @@ func (q IssueListQuery) Compile() (QueryPlan, error) {
 		"project_id": q.ProjectID.String(),
 		"scope_ids":  issueListScopeIDs(q.ScopeIDs),
 	}
+	sort.params(params)
 	normalizedPage, err := q.Page.Normalize()
 	if err != nil {
 		return QueryPlan{}, err
@@ -439,6 +462,18 @@ func issueRelationLoaders(proj IssueProjection) []CompiledQuery {
 		})
 	}
 
+	if proj.Components {
+		loaders = append(loaders, CompiledQuery{
+			Name: "issue.load_components",
+			Cypher: `
+				UNWIND $ids AS issue_id
+				MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: issue_id})
+				OPTIONAL MATCH (i)-[:` + EdgeKindInComponent.String() + `]->(c:` + model.ResourceTypeComponent.String() + `)
+				RETURN issue_id, collect(DISTINCT c) AS components`,
+			Params: map[string]any{},
+		})
+	}
+
 	if proj.CommentCount {
 		loaders = append(loaders, CompiledQuery{
 			Name: "issue.load_comment_count",
@@ -494,9 +529,27 @@ func issueRelationLoaders(proj IssueProjection) []CompiledQuery {
 		})
 	}
 
+	if proj.Rollup {
+		loaders = append(loaders, issueRollupLoader())
+	}
+
 	return loaders
 }
 
+// issueRollupLoader returns the subtasks at any depth of the issues with the
+// IDs in the ids parameter.
+func issueRollupLoader() CompiledQuery {
+	return CompiledQuery{
+		Name: "issue.load_rollup",
+		Cypher: `
+			UNWIND $ids AS issue_id
+			MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: issue_id})
+			OPTIONAL MATCH (d:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindRelatedTo.String() + `*1.. {kind: $subtask_kind}]->(i)
+			RETURN issue_id, collect(DISTINCT d) AS descendants`,
+		Params: map[string]any{"subtask_kind": model.IssueRelationKindSubtaskOf.String()},
+	}
+}
+
 func IssueWatchersQuery(issueID model.ID) (CompiledQuery, error) {
 	if err := issueID.Validate(); err != nil {
 		return CompiledQuery{}, err
@@ -510,6 +563,28 @@ func IssueWatchersQuery(issueID model.ID) (CompiledQuery, error) {
 	}, nil
 }
 
+// IssueEstimatesQuery returns the issue and its subtasks at any depth, the
+// issue first.
+func IssueEstimatesQuery(issueID model.ID) (CompiledQuery, error) {
+	if err := issueID.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+
+	return CompiledQuery{
+		Name: "issue.get_estimates",
+		Cypher: `
+			MATCH (root:` + issueID.Label() + ` {id: $issue_id})
+			OPTIONAL MATCH (d:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindRelatedTo.String() + `*1.. {kind: $subtask_kind}]->(root)
+			WITH root, collect(DISTINCT d) AS descendants
+			UNWIND [root] + descendants AS i
+			RETURN i`,
+		Params: map[string]any{
+			"issue_id":     issueID.String(),
+			"subtask_kind": model.IssueRelationKindSubtaskOf.String(),
+		},
+	}, nil
+}
+
 func IssueRelationsQuery(issueID model.ID) (CompiledQuery, error) {
 	if err := issueID.Validate(); err != nil {
 		return CompiledQuery{}, err
@@ -538,6 +613,234 @@ func IssueRelationByIDQuery(relationID model.ID) (CompiledQuery, error) {
 	}, nil
 }
 
+// IssueDependencyGraphMaxDepth is the most relations the dependency graph of
+// an issue can be followed to.
+const IssueDependencyGraphMaxDepth = 10
+
+func issueRelationKindStrings(kinds []model.IssueRelationKind) []string {
+	out := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		out = append(out, kind.String())
+	}
+	return out
+}
+
+// IssueRollupsQuery returns the subtasks at any depth of the issues. Issues
+// that do not exist are left out.
+func IssueRollupsQuery(issueIDs []model.ID) (CompiledQuery, error) {
+	for _, id := range issueIDs {
+		if err := id.Validate(); err != nil {
+			return CompiledQuery{}, err
+		}
+	}
+
+	return loaderQueryWithIDs(issueRollupLoader(), issueListScopeIDs(issueIDs)), nil
+}
+
+// IssueAncestorsQuery returns the IDs of the issues the issue is a subtask of
+// at any depth.
+func IssueAncestorsQuery(issueID model.ID) (CompiledQuery, error) {
+	if err := issueID.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+
+	return CompiledQuery{
+		Name: "issue.get_ancestors",
+		Cypher: `
+			MATCH (:` + issueID.Label() + ` {id: $issue_id})-[:` + EdgeKindRelatedTo.String() + `*1.. {kind: $subtask_kind}]->(a:` + model.ResourceTypeIssue.String() + `)
+			RETURN DISTINCT a.id AS id`,
+		Params: map[string]any{
+			"issue_id":     issueID.String(),
+			"subtask_kind": model.IssueRelationKindSubtaskOf.String(),
+		},
+	}, nil
+}
+
+// IssueCountByFieldQuery returns the number of issues of the project by the
+// value of the field. Only the fields the issues of a board are grouped by
+// can be counted.
+func IssueCountByFieldQuery(projectID model.ID, field IssueListConditionField) (CompiledQuery, error) {
+	if err := projectID.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+
+	switch field {
+	case IssueListConditionFieldStatus,
+		IssueListConditionFieldWorkflowStatus,
+		IssueListConditionFieldPriority,
+		IssueListConditionFieldKind:
+	default:
+		return CompiledQuery{}, ErrQueryCompile
+	}
+
+	return CompiledQuery{
+		Name: "issue.count_by_field",
+		Cypher: `
+			MATCH (:` + projectID.Label() + ` {id: $project_id})<-[:` + EdgeKindBelongsTo.String() + `]-(i:` + model.ResourceTypeIssue.String() + `)
+			WHERE i.` + string(field) + ` IS NOT NULL
+			RETURN i.` + string(field) + ` AS value, count(i) AS count`,
+		Params: map[string]any{
+			"project_id": projectID.String(),
+		},
+	}, nil
+}
+
+// IssueRelationCycleQuery returns whether adding the relation would close a
+// cycle. A subtask relation closes a cycle if the target is already a subtask
+// of the source at any depth, while a blocking relation closes a cycle if the
+// issue it orders after the other is already ordered before it. The relation
+// with the ignore ID is left out, as it is about to be replaced.
+func IssueRelationCycleQuery(opts CreateIssueRelationOpts, ignore *model.ID) (CompiledQuery, error) {
+	if err := opts.Source.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+	if err := opts.Target.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+
+	ignoreID := ""
+	if ignore != nil {
+		ignoreID = ignore.String()
+	}
+
+	if opts.Kind == model.IssueRelationKindSubtaskOf {
+		return CompiledQuery{
+			Name: "issue.relation_cycle.subtask",
+			Cypher: `
+			MATCH (s:` + opts.Source.Label() + ` {id: $source_id}), (t:` + opts.Target.Label() + ` {id: $target_id})
+			RETURN EXISTS {
+				MATCH (t)(()-[r:` + EdgeKindRelatedTo.String() + `]->() WHERE r.kind = $subtask_kind AND r.id <> $ignore_id){1,}(s)
+			} AS cycle`,
+			Params: map[string]any{
+				"source_id":    opts.Source.String(),
+				"target_id":    opts.Target.String(),
+				"subtask_kind": model.IssueRelationKindSubtaskOf.String(),
+				"ignore_id":    ignoreID,
+			},
+		}, nil
+	}
+
+	dependency, ok := model.NewIssueDependency(opts.Source, opts.Target, opts.Kind)
+	if !ok {
+		return CompiledQuery{}, model.ErrInvalidIssueRelationKind
+	}
+
+	// A step from x to y of the path orders x before y, either as "x blocks
+	// y" or as "y is blocked by x" and "y depends on x".
+	return CompiledQuery{
+		Name: "issue.relation_cycle.blocking",
+		Cypher: `
+			MATCH (before:` + dependency.Before.Label() + ` {id: $before_id}), (after:` + dependency.After.Label() + ` {id: $after_id})
+			RETURN EXISTS {
+				MATCH (after)((x)-[r:` + EdgeKindRelatedTo.String() + `]-(y) WHERE r.id <> $ignore_id AND ((r.kind = $blocks_kind AND startNode(r) = x) OR (r.kind IN $blocked_kinds AND endNode(r) = x))){1,}(before)
+			} AS cycle`,
+		Params: map[string]any{
+			"before_id":   dependency.Before.String(),
+			"after_id":    dependency.After.String(),
+			"blocks_kind": model.IssueRelationKindBlocks.String(),
+			"blocked_kinds": issueRelationKindStrings([]model.IssueRelationKind{
+				model.IssueRelationKindBlockedBy,
+				model.IssueRelationKindDependsOn,
+			}),
+			"ignore_id": ignoreID,
+		},
+	}, nil
+}
+
+// IssueDependencyGraphQuery returns the issue and the issues reachable from it
+// through blocking and subtask relations in either direction, following at
+// most depth relations.
+func IssueDependencyGraphQuery(issueID model.ID, depth int) (CompiledQuery, error) {
+	if err := issueID.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+	if depth < 1 || depth > IssueDependencyGraphMaxDepth {
+		return CompiledQuery{}, ErrQueryCompile
+	}
+
+	return CompiledQuery{
+		Name: "issue.dependency_graph.issues",
+		Cypher: `
+			MATCH (root:` + issueID.Label() + ` {id: $issue_id})
+			OPTIONAL MATCH (root)(()-[r:` + EdgeKindRelatedTo.String() + `]-() WHERE r.kind IN $kinds){1,` + strconv.Itoa(depth) + `}(n:` + model.ResourceTypeIssue.String() + `)
+			WITH root, collect(DISTINCT n) AS related
+			UNWIND [root] + related AS i
+			MATCH (i)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+			RETURN i, p.key AS project_key`,
+		Params: map[string]any{
+			"issue_id": issueID.String(),
+			"kinds":    issueRelationKindStrings(model.DependencyIssueRelationKinds()),
+		},
+	}, nil
+}
+
+// IssueDependencyEdgesQuery returns the blocking and subtask relations between
+// the issues.
+func IssueDependencyEdgesQuery(issueIDs []model.ID) (CompiledQuery, error) {
+	for _, id := range issueIDs {
+		if err := id.Validate(); err != nil {
+			return CompiledQuery{}, err
+		}
+	}
+
+	return CompiledQuery{
+		Name: "issue.dependency_graph.relations",
+		Cypher: `
+			MATCH (s:` + model.ResourceTypeIssue.String() + `)-[r:` + EdgeKindRelatedTo.String() + `]->(t:` + model.ResourceTypeIssue.String() + `)
+			WHERE s.id IN $issue_ids AND t.id IN $issue_ids AND r.kind IN $kinds
+			RETURN s.id AS source_id, r, t.id AS target_id`,
+		Params: map[string]any{
+			"issue_ids": issueListScopeIDs(issueIDs),
+			"kinds":     issueRelationKindStrings(model.DependencyIssueRelationKinds()),
+		},
+	}, nil
+}
+
+// ProjectDependencyIssuesQuery returns the issues of the project that are
+// scheduled or ordered against another issue of the project.
+func ProjectDependencyIssuesQuery(projectID model.ID) (CompiledQuery, error) {
+	if err := projectID.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+
+	return CompiledQuery{
+		Name: "project.dependencies.issues",
+		Cypher: `
+			MATCH (p:` + projectID.Label() + ` {id: $project_id})<-[:` + EdgeKindBelongsTo.String() + `]-(i:` + model.ResourceTypeIssue.String() + `)
+			WHERE (i.start_date IS NOT NULL AND i.due_date IS NOT NULL)
+				OR EXISTS {
+					MATCH (i)-[r:` + EdgeKindRelatedTo.String() + `]-(:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindBelongsTo.String() + `]->(p)
+					WHERE r.kind IN $kinds
+				}
+			RETURN i, p.key AS project_key
+			ORDER BY i.numeric_id ASC`,
+		Params: map[string]any{
+			"project_id": projectID.String(),
+			"kinds":      issueRelationKindStrings(model.BlockingIssueRelationKinds()),
+		},
+	}, nil
+}
+
+// ProjectDependencyEdgesQuery returns the blocking relations between the
+// issues of the project.
+func ProjectDependencyEdgesQuery(projectID model.ID) (CompiledQuery, error) {
+	if err := projectID.Validate(); err != nil {
+		return CompiledQuery{}, err
+	}
+
+	return CompiledQuery{
+		Name: "project.dependencies.relations",
+		Cypher: `
+			MATCH (p:` + projectID.Label() + ` {id: $project_id})<-[:` + EdgeKindBelongsTo.String() + `]-(s:` + model.ResourceTypeIssue.String() + `)-[r:` + EdgeKindRelatedTo.String() + `]->(t:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindBelongsTo.String() + `]->(p)
+			WHERE r.kind IN $kinds
+			RETURN s.id AS source_id, r, t.id AS target_id`,
+		Params: map[string]any{
+			"project_id": projectID.String(),
+			"kinds":      issueRelationKindStrings(model.BlockingIssueRelationKinds()),
+		},
+	}, nil
+}
+
 func issueListScopeIDs(ids []model.ID) []string {
 	out := make([]string, 0, len(ids))
 	for _, id := range ids {
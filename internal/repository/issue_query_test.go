@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -71,7 +72,7 @@ func TestIssueListForNamespaceQuery_Compile(t *testing.T) {
 		assert.Contains(t, plan.Root.Cypher, "RETURN i, p, n, u")
 		assert.Equal(t, namespaceID.String(), plan.Root.Params["namespace_id"])
 		assert.Equal(t, 11, plan.Root.Params["limit"])
-		require.Len(t, plan.Loaders, 3)
+		require.Len(t, plan.Loaders, 4)
 	})
 
 	t.Run("authz filters projects before expanding issues", func(t *testing.T) {
@@ -128,7 +129,7 @@ func TestIssueListForUserQuery_Compile(t *testing.T) {
 		assert.Equal(t, userID.String(), plan.Root.Params["user_id"])
 		assert.Equal(t, model.AssignmentKindAssignee.String(), plan.Root.Params["assignee_kind"])
 		assert.Equal(t, 11, plan.Root.Params["limit"])
-		require.Len(t, plan.Loaders, 3)
+		require.Len(t, plan.Loaders, 4)
 	})
 
 	t.Run("authz filter does not rebind the namespace alias", func(t *testing.T) {
@@ -181,10 +182,247 @@ func TestIssueListQuery_Compile(t *testing.T) {
 		assert.Equal(t, "issue.list_for_project", plan.Root.Name)
 		assert.Contains(t, plan.Root.Cypher, "RETURN i, p, n, u")
 		assert.Equal(t, projectID.String(), plan.Root.Params["project_id"])
-		require.Len(t, plan.Loaders, 3)
+		require.Len(t, plan.Loaders, 4)
 		assert.Equal(t, "issue.load_parent", plan.Loaders[0].Name)
 		assert.Contains(t, plan.Loaders[0].Cypher, "pp.key AS parent_project_key")
 	})
+
+	t.Run("filters and sorts by custom fields", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID: projectID,
+			SortField: IssueListSortFieldCustom("points"),
+			Order:     SortDirectionDesc,
+			Page:      CursorPage{Size: 10},
+			Filter: IssueListFilter{
+				CustomFields: []IssueListCustomFieldFilter{
+					{Key: "platforms", Type: model.CustomFieldTypeMultiSelect, Values: []any{"ios"}},
+					{Key: "customer", Type: model.CustomFieldTypeText, Values: []any{"acme"}},
+				},
+			},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "i.cf_customer IN $custom_field_0")
+		assert.Contains(t, plan.Root.Cypher, "any(option IN coalesce(i.cf_platforms, []) WHERE option IN $custom_field_1)")
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY CASE WHEN i.cf_points IS NULL THEN 1 ELSE 0 END ASC, i.cf_points DESC")
+		assert.Equal(t, []any{"acme"}, plan.Root.Params["custom_field_0"])
+		assert.Equal(t, []any{"ios"}, plan.Root.Params["custom_field_1"])
+	})
+
+	t.Run("filters by components", func(t *testing.T) {
+		t.Parallel()
+
+		first := model.MustNewID(model.ResourceTypeComponent)
+		second := model.MustNewID(model.ResourceTypeComponent)
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: 10},
+			Filter: IssueListFilter{
+				Components: []model.ID{second, first, second, model.MustNewID(model.ResourceTypeLabel)},
+			},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "EXISTS { MATCH (i)-[:IN_COMPONENT]->(c:Component) WHERE c.id IN $components }")
+		assert.ElementsMatch(t, []string{first.String(), second.String()}, plan.Root.Params["components"])
+	})
+
+	t.Run("filters by conditions", func(t *testing.T) {
+		t.Parallel()
+
+		userID := model.MustNewID(model.ResourceTypeUser)
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: 10},
+			Filter: IssueListFilter{
+				Conditions: []IssueListCondition{
+					{Field: IssueListConditionFieldStatus, Operator: IssueListOperatorIn, Values: []string{"open", "review"}},
+					{Field: IssueListConditionFieldPriority, Operator: IssueListOperatorGte, Values: []string{"high"}},
+					{Field: IssueListConditionFieldAssignee, Operator: IssueListOperatorIn, Values: []string{userID.String()}},
+					{Field: IssueListConditionFieldLabel, Operator: IssueListOperatorIn, Values: []string{"WontDo"}, Negated: true},
+					{Field: IssueListConditionFieldDueDate, Operator: IssueListOperatorLt, Values: []string{"2026-12-01"}},
+					{Field: IssueListConditionFieldParent, Operator: IssueListOperatorIn, Values: []string{"MOB-4"}},
+					{Field: IssueListConditionFieldText, Operator: IssueListOperatorIn, Values: []string{"Login"}},
+					{Field: IssueListConditionFieldWorkflowStatus, Operator: IssueListOperatorIn, Values: []string{"code_review"}},
+				},
+			},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "i.status IN $condition_0")
+		assert.Contains(t, plan.Root.Cypher, "ELSE 99 END >= $condition_1")
+		assert.Contains(t, plan.Root.Cypher, "EXISTS { MATCH (condition_2_user:User)-[:ASSIGNED_TO {kind: $condition_2_kind}]->(i) WHERE condition_2_user.id IN $condition_2 }")
+		assert.Contains(t, plan.Root.Cypher, "NOT (EXISTS { MATCH (i)-[:HAS_LABEL]->(condition_3_label:Label) WHERE toLower(condition_3_label.name) IN $condition_3 })")
+		assert.Contains(t, plan.Root.Cypher, "date(datetime(toString(i.due_date))) < date($condition_4)")
+		assert.Contains(t, plan.Root.Cypher, "condition_5_project.key + '-' + toString(condition_5_parent.numeric_id) IN $condition_5")
+		assert.Contains(t, plan.Root.Cypher, "any(condition_6_text IN $condition_6 WHERE toLower(i.title) CONTAINS condition_6_text")
+		assert.Equal(t, []string{"open", "review"}, plan.Root.Params["condition_0"])
+		assert.Equal(t, int64(3), plan.Root.Params["condition_1"])
+		assert.Equal(t, []string{"wontdo"}, plan.Root.Params["condition_3"])
+		assert.Equal(t, "2026-12-01", plan.Root.Params["condition_4"])
+		assert.Equal(t, model.IssueRelationKindSubtaskOf.String(), plan.Root.Params["condition_5_kind"])
+		assert.Equal(t, []string{"login"}, plan.Root.Params["condition_6"])
+		assert.Contains(t, plan.Root.Cypher, "i.workflow_status IN $condition_7")
+	})
+
+	t.Run("negated conditions match unset fields", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: 10},
+			Filter: IssueListFilter{
+				Conditions: []IssueListCondition{
+					{Field: IssueListConditionFieldDueDate, Operator: IssueListOperatorLt, Values: []string{"2026-01-01"}, Negated: true},
+					{Field: IssueListConditionFieldWorkflowStatus, Operator: IssueListOperatorIn, Values: []string{"code_review"}, Negated: true},
+					{Field: IssueListConditionFieldStatus, Operator: IssueListOperatorIn, Values: []string{"done"}, Negated: true},
+				},
+			},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "(i.due_date IS NULL OR NOT (date(datetime(toString(i.due_date))) < date($condition_0)))")
+		assert.Contains(t, plan.Root.Cypher, "(i.workflow_status IS NULL OR NOT (i.workflow_status IN $condition_1))")
+		assert.Contains(t, plan.Root.Cypher, "NOT (i.status IN $condition_2)")
+		assert.NotContains(t, plan.Root.Cypher, "i.status IS NULL")
+	})
+
+	t.Run("skips invalid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: 10},
+			Filter: IssueListFilter{
+				Conditions: []IssueListCondition{
+					{Field: IssueListConditionFieldStatus, Operator: IssueListOperatorGt, Values: []string{"open"}},
+					{Field: IssueListConditionFieldPriority, Operator: IssueListOperatorLt, Values: []string{"urgent"}},
+					{Field: IssueListConditionFieldKind, Operator: IssueListOperatorIn},
+					{Field: "unknown", Operator: IssueListOperatorIn, Values: []string{"value"}},
+				},
+			},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, plan.Root.Cypher, "condition_")
+	})
+
+	t.Run("sorts by the order of the workflow statuses", func(t *testing.T) {
+		t.Parallel()
+
+		order := []string{"backlog", "in_review", "shipped"}
+		sort := IssueListSort{Field: IssueListSortFieldStatus, Direction: SortDirectionAsc, StatusOrder: order}
+		filter := IssueListFilter{}
+		token, err := encodeIssueListCursor(&PartialIssue{
+			ID:             model.MustNewID(model.ResourceTypeIssue),
+			Status:         model.IssueStatusInProgress,
+			WorkflowStatus: convert.ToPointer("in_review"),
+		}, sort, issueListCursorHash(projectID, filter, sort))
+		require.NoError(t, err)
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID:   projectID,
+			SortField:   sort.Field,
+			Order:       sort.Direction,
+			Page:        CursorPage{Size: 10, Token: &token},
+			Projection:  IssueListForProjectProjection(),
+			StatusOrder: order,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY coalesce(head([i_status_idx IN range(0, size($status_order) - 1) WHERE $status_order[i_status_idx] = i.workflow_status]), 99) ASC")
+		assert.NotContains(t, plan.Root.Cypher, "WHEN 'open'")
+		assert.Equal(t, order, plan.Root.Params["status_order"])
+		assert.Equal(t, int64(1), plan.Root.Params["cursor_sort"])
+
+		// The cursor of the built-in order does not continue the workflow order.
+		_, err = CompileQuery(IssueListQuery{
+			ProjectID:  projectID,
+			SortField:  sort.Field,
+			Order:      sort.Direction,
+			Page:       CursorPage{Size: 10, Token: &token},
+			Projection: IssueListForProjectProjection(),
+		})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("sorts by the built-in statuses without workflow", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID:  projectID,
+			SortField:  IssueListSortFieldStatus,
+			Page:       CursorPage{Size: 10},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY CASE i.status WHEN 'open' THEN 0")
+		assert.NotContains(t, plan.Root.Params, "status_order")
+	})
+
+	t.Run("invalid custom field key falls back to rank", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID:  projectID,
+			SortField:  IssueListSortFieldCustom("points) DETACH DELETE i //"),
+			Page:       CursorPage{Size: 10},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY "+issueRankSortExpression("i")+" ASC")
+		assert.NotContains(t, plan.Root.Cypher, "DETACH DELETE")
+	})
+
+	t.Run("custom field cursor keeps the value type", func(t *testing.T) {
+		t.Parallel()
+
+		sort := IssueListSort{Field: IssueListSortFieldCustom("points"), Direction: SortDirectionAsc}
+		filter := IssueListFilter{}
+		token, err := encodeIssueListCursor(&PartialIssue{
+			ID:           model.MustNewID(model.ResourceTypeIssue),
+			CustomFields: map[string]any{"points": float64(3)},
+		}, sort, issueListCursorHash(projectID, filter, sort))
+		require.NoError(t, err)
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID:  projectID,
+			SortField:  sort.Field,
+			Order:      sort.Direction,
+			Page:       CursorPage{Size: 10, Token: &token},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, float64(3), plan.Root.Params["cursor_sort"])
+	})
+
+	t.Run("rank cursor continues after the current rank of the cursor issue", func(t *testing.T) {
+		t.Parallel()
+
+		sort := IssueListSort{Field: IssueListSortFieldRank, Direction: SortDirectionAsc}
+		filter := IssueListFilter{}
+		token, err := encodeIssueListCursor(&PartialIssue{
+			ID:        model.MustNewID(model.ResourceTypeIssue),
+			NumericID: 7,
+			Rank:      "0001",
+		}, sort, issueListCursorHash(projectID, filter, sort))
+		require.NoError(t, err)
+
+		plan, err := CompileQuery(IssueListQuery{
+			ProjectID:  projectID,
+			SortField:  sort.Field,
+			Order:      sort.Direction,
+			Page:       CursorPage{Size: 10, Token: &token},
+			Projection: IssueListForProjectProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "0001", plan.Root.Params["cursor_sort"])
+		assert.Contains(t, plan.Root.Cypher, "(cursor_issue:Issue {id: $cursor_id})")
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY "+issueRankSortExpression("i")+" ASC, i.id ASC")
+	})
 }
 
 func TestIssueListForIssueQuery_Compile(t *testing.T) {
@@ -258,6 +496,31 @@ func TestIssueGetQuery_Compile(t *testing.T) {
 	})
 }
 
+func TestIssueGetByKeyQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resolves moved issue keys", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(IssueGetByKeyQuery{
+			NamespaceID: model.MustNewID(model.ResourceTypeNamespace),
+			IssueKey:    "MOB-12",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "issue.get_by_key", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, "(kp)<-[:MOVED_FROM {numeric_id: number}]-(moved:Issue)")
+		assert.Contains(t, plan.Root.Cypher, "coalesce(current, moved) AS i")
+		assert.Equal(t, "MOB-12", plan.Root.Params["issue_key"])
+	})
+
+	t.Run("invalid namespace", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(IssueGetByKeyQuery{IssueKey: "MOB-12"})
+		require.Error(t, err)
+	})
+}
+
 func TestIssueListQuery_CompileOmitsDocumentCount(t *testing.T) {
 	t.Parallel()
 
@@ -319,6 +582,174 @@ func TestIssueWatchersQuery(t *testing.T) {
 	})
 }
 
+func TestIssueEstimatesQuery(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	query, err := IssueEstimatesQuery(issueID)
+	require.NoError(t, err)
+	assert.Equal(t, "issue.get_estimates", query.Name)
+	assert.Contains(t, query.Cypher, "*1.. {kind: $subtask_kind}]->(root)")
+	assert.Contains(t, query.Cypher, "UNWIND [root] + descendants AS i")
+	assert.Equal(t, issueID.String(), query.Params["issue_id"])
+	assert.Equal(t, model.IssueRelationKindSubtaskOf.String(), query.Params["subtask_kind"])
+
+	_, err = IssueEstimatesQuery(model.ID{})
+	require.Error(t, err)
+}
+
+func TestIssueRelationCycleQuery(t *testing.T) {
+	t.Parallel()
+
+	source := model.MustNewID(model.ResourceTypeIssue)
+	target := model.MustNewID(model.ResourceTypeIssue)
+	ignore := model.MustNewID(model.ResourceTypeIssueRelation)
+
+	t.Run("subtask relation", func(t *testing.T) {
+		t.Parallel()
+
+		query, err := IssueRelationCycleQuery(CreateIssueRelationOpts{Source: source, Target: target, Kind: model.IssueRelationKindSubtaskOf}, &ignore)
+		require.NoError(t, err)
+		assert.Equal(t, "issue.relation_cycle.subtask", query.Name)
+		assert.Contains(t, query.Cypher, "MATCH (t)(()-[r:RELATED_TO]->() WHERE r.kind = $subtask_kind AND r.id <> $ignore_id){1,}(s)")
+		assert.Equal(t, source.String(), query.Params["source_id"])
+		assert.Equal(t, target.String(), query.Params["target_id"])
+		assert.Equal(t, ignore.String(), query.Params["ignore_id"])
+	})
+
+	t.Run("blocking relation", func(t *testing.T) {
+		t.Parallel()
+
+		query, err := IssueRelationCycleQuery(CreateIssueRelationOpts{Source: source, Target: target, Kind: model.IssueRelationKindBlocks}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "issue.relation_cycle.blocking", query.Name)
+		assert.Contains(t, query.Cypher, "MATCH (after)((x)-[r:RELATED_TO]-(y)")
+		assert.Equal(t, source.String(), query.Params["before_id"])
+		assert.Equal(t, target.String(), query.Params["after_id"])
+		assert.Equal(t, "", query.Params["ignore_id"])
+		assert.Equal(t, []string{"blocked by", "depends on"}, query.Params["blocked_kinds"])
+	})
+
+	t.Run("blocked by relation", func(t *testing.T) {
+		t.Parallel()
+
+		query, err := IssueRelationCycleQuery(CreateIssueRelationOpts{Source: source, Target: target, Kind: model.IssueRelationKindBlockedBy}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, target.String(), query.Params["before_id"])
+		assert.Equal(t, source.String(), query.Params["after_id"])
+	})
+
+	t.Run("relation without ordering", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := IssueRelationCycleQuery(CreateIssueRelationOpts{Source: source, Target: target, Kind: model.IssueRelationKindRelatedTo}, nil)
+		assert.ErrorIs(t, err, model.ErrInvalidIssueRelationKind)
+	})
+
+	t.Run("invalid issue", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := IssueRelationCycleQuery(CreateIssueRelationOpts{Source: model.ID{}, Target: target, Kind: model.IssueRelationKindBlocks}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestIssueRollupsQuery(t *testing.T) {
+	t.Parallel()
+
+	ids := []model.ID{model.MustNewID(model.ResourceTypeIssue), model.MustNewID(model.ResourceTypeIssue)}
+	query, err := IssueRollupsQuery(ids)
+	require.NoError(t, err)
+	assert.Equal(t, "issue.load_rollup", query.Name)
+	assert.Contains(t, query.Cypher, "OPTIONAL MATCH (d:Issue)-[:RELATED_TO*1.. {kind: $subtask_kind}]->(i)")
+	assert.Contains(t, query.Cypher, "RETURN issue_id, collect(DISTINCT d) AS descendants")
+	assert.Equal(t, []string{ids[0].String(), ids[1].String()}, query.Params["ids"])
+	assert.Equal(t, "subtask of", query.Params["subtask_kind"])
+
+	plan, err := CompileQuery(IssueGetQuery{ID: ids[0], Projection: IssueDetailProjection()})
+	require.NoError(t, err)
+	names := make([]string, 0, len(plan.Loaders))
+	for _, loader := range plan.Loaders {
+		names = append(names, loader.Name)
+	}
+	assert.Contains(t, names, "issue.load_rollup")
+
+	_, err = IssueRollupsQuery([]model.ID{{}})
+	require.Error(t, err)
+}
+
+func TestIssueAncestorsQuery(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	query, err := IssueAncestorsQuery(issueID)
+	require.NoError(t, err)
+	assert.Equal(t, "issue.get_ancestors", query.Name)
+	assert.Contains(t, query.Cypher, "-[:RELATED_TO*1.. {kind: $subtask_kind}]->(a:Issue)")
+	assert.Equal(t, issueID.String(), query.Params["issue_id"])
+
+	_, err = IssueAncestorsQuery(model.ID{})
+	require.Error(t, err)
+}
+
+func TestIssueDependencyGraphQuery(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	query, err := IssueDependencyGraphQuery(issueID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "issue.dependency_graph.issues", query.Name)
+	assert.Contains(t, query.Cypher, "WHERE r.kind IN $kinds){1,3}(n:Issue)")
+	assert.Contains(t, query.Cypher, "UNWIND [root] + related AS i")
+	assert.Equal(t, issueID.String(), query.Params["issue_id"])
+	assert.Equal(t, []string{"blocked by", "blocks", "depends on", "subtask of"}, query.Params["kinds"])
+
+	_, err = IssueDependencyGraphQuery(issueID, 0)
+	assert.ErrorIs(t, err, ErrQueryCompile)
+	_, err = IssueDependencyGraphQuery(issueID, IssueDependencyGraphMaxDepth+1)
+	assert.ErrorIs(t, err, ErrQueryCompile)
+	_, err = IssueDependencyGraphQuery(model.ID{}, 1)
+	require.Error(t, err)
+}
+
+func TestIssueDependencyEdgesQuery(t *testing.T) {
+	t.Parallel()
+
+	ids := []model.ID{model.MustNewID(model.ResourceTypeIssue), model.MustNewID(model.ResourceTypeIssue)}
+	query, err := IssueDependencyEdgesQuery(ids)
+	require.NoError(t, err)
+	assert.Equal(t, "issue.dependency_graph.relations", query.Name)
+	assert.Contains(t, query.Cypher, "WHERE s.id IN $issue_ids AND t.id IN $issue_ids AND r.kind IN $kinds")
+	assert.Equal(t, []string{ids[0].String(), ids[1].String()}, query.Params["issue_ids"])
+
+	_, err = IssueDependencyEdgesQuery([]model.ID{{}})
+	require.Error(t, err)
+}
+
+func TestProjectDependencyQueries(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	issues, err := ProjectDependencyIssuesQuery(projectID)
+	require.NoError(t, err)
+	assert.Equal(t, "project.dependencies.issues", issues.Name)
+	assert.Contains(t, issues.Cypher, "i.start_date IS NOT NULL AND i.due_date IS NOT NULL")
+	assert.Equal(t, projectID.String(), issues.Params["project_id"])
+	assert.Equal(t, []string{"blocked by", "blocks", "depends on"}, issues.Params["kinds"])
+
+	edges, err := ProjectDependencyEdgesQuery(projectID)
+	require.NoError(t, err)
+	assert.Equal(t, "project.dependencies.relations", edges.Name)
+	assert.Contains(t, edges.Cypher, "RETURN s.id AS source_id, r, t.id AS target_id")
+	assert.Equal(t, projectID.String(), edges.Params["project_id"])
+
+	_, err = ProjectDependencyIssuesQuery(model.ID{})
+	require.Error(t, err)
+	_, err = ProjectDependencyEdgesQuery(model.ID{})
+	require.Error(t, err)
+}
+
 func TestIssueRelationByIDQuery(t *testing.T) {
 	t.Parallel()
 
@@ -330,3 +761,20 @@ func TestIssueRelationByIDQuery(t *testing.T) {
 	assert.Contains(t, query.Cypher, "endNode(r).id AS target_id")
 	assert.Equal(t, relationID.String(), query.Params["id"])
 }
+
+func TestIssueCountByFieldQuery(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	query, err := IssueCountByFieldQuery(projectID, IssueListConditionFieldWorkflowStatus)
+	require.NoError(t, err)
+	assert.Equal(t, "issue.count_by_field", query.Name)
+	assert.Contains(t, query.Cypher, "WHERE i.workflow_status IS NOT NULL")
+	assert.Contains(t, query.Cypher, "RETURN i.workflow_status AS value, count(i) AS count")
+	assert.Equal(t, projectID.String(), query.Params["project_id"])
+
+	_, err = IssueCountByFieldQuery(projectID, IssueListConditionFieldAssignee)
+	assert.ErrorIs(t, err, ErrQueryCompile)
+	_, err = IssueCountByFieldQuery(model.ID{}, IssueListConditionFieldStatus)
+	require.Error(t, err)
+}
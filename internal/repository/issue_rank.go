@@ -0,0 +1,489 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/log"
+)
+
+const (
+	// issueRankDigits are the digits of the rank keys in ascending order. The
+	// keys are compared byte by byte, so the digits must be sorted by their
+	// code points.
+	issueRankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	// issueRankWidth is the number of digits the evenly spaced rank keys are
+	// computed with before their trailing zeros are trimmed.
+	issueRankWidth = 8
+	// issueRankStep is the gap left between evenly spaced rank keys, leaving
+	// room for many inserts before the keys grow.
+	issueRankStep uint64 = 36 * 36 * 36 * 36
+	// issueRankLimit is the number of keys that fit in issueRankWidth digits.
+	issueRankLimit uint64 = issueRankStep * issueRankStep
+	// maxIssueRankLength is the longest rank key kept. Longer keys rebalance
+	// the ranks of the project.
+	maxIssueRankLength = 32
+	// issueRankUnrankedPrefix prefixes the sort key of issues without a rank.
+	// It sorts after every digit, so unranked issues follow the ranked ones
+	// in the order of their numeric IDs.
+	issueRankUnrankedPrefix = "~"
+	// issueRankNumericIDWidth is the width the numeric ID of an unranked
+	// issue is padded to in its sort key.
+	issueRankNumericIDWidth = 10
+)
+
+var (
+	ErrInvalidRank     = errors.New("invalid rank")              // the rank key is invalid or out of order
+	ErrIssueRank       = errors.New("failed to rank issue")      // the issue could not be ranked
+	ErrIssueRankAnchor = errors.New("invalid issue rank anchor") // the issue cannot be ranked relative to the anchors
+)
+
+// issueRankBetween returns a rank key sorting after a and before b. An empty
+// a means no lower bound, an empty b means no upper bound. The returned key
+// never ends with the zero digit, so there is always room before it.
+func issueRankBetween(a, b string) (string, error) {
+	if !validIssueRank(a) || !validIssueRank(b) || (b != "" && a >= b) {
+		return "", ErrInvalidRank
+	}
+	return issueRankMidpoint(a, b), nil
+}
+
+// issueRankAfter returns a rank key sorting after a, keeping the key short by
+// stepping over the gap evenly spaced keys leave between each other.
+func issueRankAfter(a string) (string, error) {
+	if a == "" {
+		return issueRankFormat(issueRankStep), nil
+	}
+	if !validIssueRank(a) {
+		return "", ErrInvalidRank
+	}
+
+	width := min(len(a), issueRankWidth)
+	value, err := strconv.ParseUint(a[:width]+strings.Repeat("0", issueRankWidth-width), len(issueRankDigits), 64)
+	if err != nil {
+		return "", errors.Join(ErrInvalidRank, err)
+	}
+	if value+issueRankStep < issueRankLimit {
+		return issueRankFormat(value + issueRankStep), nil
+	}
+
+	return issueRankMidpoint(a, ""), nil
+}
+
+// issueRankSpread returns n evenly spaced rank keys in ascending order.
+func issueRankSpread(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = issueRankFormat(uint64(i+1) * issueRankStep)
+	}
+	return keys
+}
+
+// issueRankSortKey returns the key the issue is sorted by in rank order.
+func issueRankSortKey(rank string, numericID uint) string {
+	if rank != "" {
+		return rank
+	}
+	id := strings.Repeat("0", issueRankNumericIDWidth) + strconv.FormatUint(uint64(numericID), 10)
+	return issueRankUnrankedPrefix + id[len(id)-issueRankNumericIDWidth:]
+}
+
+// issueRankSortExpression returns the Cypher expression of the key the issue
+// bound to alias is sorted by in rank order. It matches issueRankSortKey.
+func issueRankSortExpression(alias string) string {
+	return "coalesce(" + alias + ".rank, '" + issueRankUnrankedPrefix + "' + right('" +
+		strings.Repeat("0", issueRankNumericIDWidth) + "' + toString(" + alias + ".numeric_id), " +
+		strconv.Itoa(issueRankNumericIDWidth) + "))"
+}
+
+func validIssueRank(key string) bool {
+	if strings.HasSuffix(key, issueRankDigits[:1]) {
+		return false
+	}
+	for _, r := range key {
+		if !strings.ContainsRune(issueRankDigits, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// issueRankMidpoint returns the key halfway between a and b digit by digit.
+// It assumes a sorts before b, and an empty b means no upper bound.
+func issueRankMidpoint(a, b string) string {
+	zero := issueRankDigits[0]
+	if b != "" {
+		n := 0
+		for n < len(b) && issueRankDigitAt(a, n, zero) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + issueRankMidpoint(issueRankSuffix(a, n), b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(issueRankDigits, a[0])
+	}
+	digitB := len(issueRankDigits)
+	if b != "" {
+		digitB = strings.IndexByte(issueRankDigits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(issueRankDigits[(digitA+digitB+1)/2])
+	}
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(issueRankDigits[digitA]) + issueRankMidpoint(issueRankSuffix(a, 1), "")
+}
+
+func issueRankDigitAt(key string, i int, fallback byte) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return fallback
+}
+
+func issueRankSuffix(key string, n int) string {
+	if n >= len(key) {
+		return ""
+	}
+	return key[n:]
+}
+
+// issueRankFormat formats the value with issueRankWidth digits and trims the
+// trailing zeros, which keeps the order of the keys.
+func issueRankFormat(value uint64) string {
+	key := strings.Repeat("0", issueRankWidth) + strconv.FormatUint(value, len(issueRankDigits))
+	return strings.TrimRight(key[len(key)-issueRankWidth:], issueRankDigits[:1])
+}
+
+// RankIssueOpts positions an issue in the backlog of its project. The issue is
+// moved right after the After issue and right before the Before issue. If only
+// one of them is set, the issue is moved next to it.
+type RankIssueOpts struct {
+	Before *model.ID
+	After  *model.ID
+}
+
+// issueRankBounds are the ranks the issue being ranked must be placed between.
+type issueRankBounds struct {
+	ProjectID    string  `json:"project_id"`
+	Unbalanced   bool    `json:"unbalanced"`
+	BeforeID     *string `json:"before_id"`
+	BeforeRank   *string `json:"before_rank"`
+	AfterID      *string `json:"after_id"`
+	AfterRank    *string `json:"after_rank"`
+	PreviousRank *string `json:"previous_rank"`
+	NextRank     *string `json:"next_rank"`
+}
+
+// issueRankTail is the last rank of the backlog of a project.
+type issueRankTail struct {
+	Unbalanced bool    `json:"unbalanced"`
+	LastRank   *string `json:"last_rank"`
+}
+
+// between returns the lower and upper bound of the new rank of the issue.
+func (b *issueRankBounds) between(opts RankIssueOpts) (string, string, error) {
+	if (opts.Before != nil && b.BeforeID == nil) || (opts.After != nil && b.AfterID == nil) {
+		return "", "", ErrIssueRankAnchor
+	}
+
+	var lower, upper string
+	switch {
+	case opts.Before != nil && opts.After != nil:
+		lower, upper = *b.AfterRank, *b.BeforeRank
+		if lower >= upper {
+			return "", "", ErrIssueRankAnchor
+		}
+	case opts.Before != nil:
+		upper = *b.BeforeRank
+		if b.PreviousRank != nil {
+			lower = *b.PreviousRank
+		}
+	case opts.After != nil:
+		lower = *b.AfterRank
+		if b.NextRank != nil {
+			upper = *b.NextRank
+		}
+	default:
+		return "", "", ErrIssueRankAnchor
+	}
+
+	return lower, upper, nil
+}
+
+// validate checks that the issue is ranked relative to other issues.
+func (o RankIssueOpts) validate(id model.ID) error {
+	if o.Before == nil && o.After == nil {
+		return ErrIssueRankAnchor
+	}
+	if (o.Before != nil && *o.Before == id) || (o.After != nil && *o.After == id) {
+		return ErrIssueRankAnchor
+	}
+	return nil
+}
+
+// Rank moves the issue before or after other issues of its project. The ranks
+// of the project are rebalanced if the issues are not ranked yet, the anchors
+// share their rank with other issues, or the new rank would be too long.
+func (r *Neo4jIssueRepository) Rank(ctx context.Context, id model.ID, opts RankIssueOpts) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/Rank")
+	defer span.End()
+
+	if err := opts.validate(id); err != nil {
+		return errors.Join(ErrIssueRank, err)
+	}
+
+	err := neo4jExecuteWrite(ctx, r.db, func(tx neo4j.ManagedTransaction) error {
+		return rankIssue(ctx, tx, id, opts)
+	})
+	if err != nil {
+		return errors.Join(ErrIssueRank, err)
+	}
+
+	return nil
+}
+
+// rankIssue sets the rank of the issue in the transaction.
+func rankIssue(ctx context.Context, tx neo4j.ManagedTransaction, id model.ID, opts RankIssueOpts) error {
+	bounds, err := readIssueRankBounds(ctx, tx, id, opts)
+	if err != nil {
+		return err
+	}
+
+	rebalanced := false
+	if bounds.Unbalanced {
+		if bounds, err = rebalanceAndReadIssueRankBounds(ctx, tx, id, opts, bounds); err != nil {
+			return err
+		}
+		rebalanced = true
+	}
+
+	lower, upper, err := bounds.between(opts)
+	if err != nil {
+		return err
+	}
+
+	rank, err := issueRankBetween(lower, upper)
+	if (err != nil || len(rank) > maxIssueRankLength) && !rebalanced {
+		if bounds, err = rebalanceAndReadIssueRankBounds(ctx, tx, id, opts, bounds); err != nil {
+			return err
+		}
+		if lower, upper, err = bounds.between(opts); err != nil {
+			return err
+		}
+		rank, err = issueRankBetween(lower, upper)
+	}
+	if err != nil {
+		return err
+	}
+
+	cypher := `
+	MATCH (i:` + id.Label() + ` {id: $id})
+	SET i.rank = $rank`
+
+	return Neo4jExecuteAndConsumeResult(ctx, tx, cypher, map[string]any{"id": id.String(), "rank": rank})
+}
+
+// readIssueRankBounds reads the ranks around the anchors of the issue. The
+// bounds are unbalanced if the project has unranked issues or an anchor shares
+// its rank with another issue.
+func readIssueRankBounds(ctx context.Context, tx neo4j.ManagedTransaction, id model.ID, opts RankIssueOpts) (*issueRankBounds, error) {
+	cypher := `
+	MATCH (i:` + id.Label() + ` {id: $id})-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+	OPTIONAL MATCH (b:` + model.ResourceTypeIssue.String() + ` {id: $before_id})-[:` + EdgeKindBelongsTo.String() + `]->(p)
+	OPTIONAL MATCH (a:` + model.ResourceTypeIssue.String() + ` {id: $after_id})-[:` + EdgeKindBelongsTo.String() + `]->(p)
+	RETURN
+		p.id AS project_id,
+		EXISTS {
+			MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(x:` + model.ResourceTypeIssue.String() + `)
+			WHERE x.rank IS NULL OR (x.id <> i.id AND (
+				(b IS NOT NULL AND x.id <> b.id AND x.rank = b.rank) OR
+				(a IS NOT NULL AND x.id <> a.id AND x.rank = a.rank)
+			))
+		} AS unbalanced,
+		b.id AS before_id,
+		b.rank AS before_rank,
+		a.id AS after_id,
+		a.rank AS after_rank,
+		head(COLLECT {
+			MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(x:` + model.ResourceTypeIssue.String() + `)
+			WHERE x.rank < b.rank AND x.id <> i.id
+			RETURN x.rank ORDER BY x.rank DESC LIMIT 1
+		}) AS previous_rank,
+		head(COLLECT {
+			MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(x:` + model.ResourceTypeIssue.String() + `)
+			WHERE x.rank > a.rank AND x.id <> i.id
+			RETURN x.rank ORDER BY x.rank ASC LIMIT 1
+		}) AS next_rank`
+
+	params := map[string]any{
+		"id":        id.String(),
+		"before_id": nil,
+		"after_id":  nil,
+	}
+	if opts.Before != nil {
+		params["before_id"] = opts.Before.String()
+	}
+	if opts.After != nil {
+		params["after_id"] = opts.After.String()
+	}
+
+	return neo4jTxReadSingle(ctx, tx, cypher, params, func(record *neo4j.Record) (*issueRankBounds, error) {
+		bounds := new(issueRankBounds)
+		return bounds, convert.AnyToAny(record.AsMap(), bounds)
+	})
+}
+
+func rebalanceAndReadIssueRankBounds(ctx context.Context, tx neo4j.ManagedTransaction, id model.ID, opts RankIssueOpts, bounds *issueRankBounds) (*issueRankBounds, error) {
+	projectID, err := model.NewIDFromString(bounds.ProjectID, model.ResourceTypeProject.String())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rebalanceIssueRanks(ctx, tx, projectID); err != nil {
+		return nil, err
+	}
+	return readIssueRankBounds(ctx, tx, id, opts)
+}
+
+// nextIssueRank returns the rank of a new issue appended to the backlog of the
+// project, rebalancing the ranks of the project if needed.
+func nextIssueRank(ctx context.Context, tx neo4j.ManagedTransaction, projectID model.ID) (string, error) {
+	cypher := `
+	MATCH (p:` + projectID.Label() + ` {id: $project_id})
+	RETURN
+		EXISTS { MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(x:` + model.ResourceTypeIssue.String() + `) WHERE x.rank IS NULL } AS unbalanced,
+		head(COLLECT {
+			MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(x:` + model.ResourceTypeIssue.String() + `)
+			RETURN x.rank ORDER BY x.rank DESC LIMIT 1
+		}) AS last_rank`
+
+	params := map[string]any{
+		"project_id": projectID.String(),
+	}
+
+	last, err := neo4jTxReadSingle(ctx, tx, cypher, params, func(record *neo4j.Record) (*issueRankTail, error) {
+		tail := new(issueRankTail)
+		return tail, convert.AnyToAny(record.AsMap(), tail)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	lastRank := ""
+	if last.LastRank != nil {
+		lastRank = *last.LastRank
+	}
+	if last.Unbalanced {
+		if lastRank, err = rebalanceIssueRanks(ctx, tx, projectID); err != nil {
+			return "", err
+		}
+	}
+
+	rank, err := issueRankAfter(lastRank)
+	if err != nil || len(rank) > maxIssueRankLength {
+		if lastRank, err = rebalanceIssueRanks(ctx, tx, projectID); err != nil {
+			return "", err
+		}
+		return issueRankAfter(lastRank)
+	}
+
+	return rank, nil
+}
+
+// rebalanceIssueRanks spreads the ranks of the issues of the project evenly,
+// keeping their order, and returns the last rank assigned. The project is
+// written first, so concurrent rebalances of the same project are serialized.
+func rebalanceIssueRanks(ctx context.Context, tx neo4j.ManagedTransaction, projectID model.ID) (string, error) {
+	cypher := `
+	MATCH (p:` + projectID.Label() + ` {id: $project_id})
+	SET p.issue_ranks_rebalanced_at = datetime()
+	WITH p
+	MATCH (p)<-[:` + EdgeKindBelongsTo.String() + `]-(i:` + model.ResourceTypeIssue.String() + `)
+	WITH i ORDER BY ` + issueRankSortExpression("i") + ` ASC, i.id ASC
+	RETURN collect(i.id) AS ids`
+
+	params := map[string]any{
+		"project_id": projectID.String(),
+	}
+
+	ids, err := neo4jTxReadSingle(ctx, tx, cypher, params, func(record *neo4j.Record) (*[]any, error) {
+		ids, err := Neo4jParseValueFromRecord[[]any](record, "ids")
+		if err != nil {
+			return nil, err
+		}
+		return &ids, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(*ids) == 0 {
+		return "", nil
+	}
+
+	keys := issueRankSpread(len(*ids))
+	ranks := make([]map[string]any, len(*ids))
+	for i, id := range *ids {
+		ranks[i] = map[string]any{"id": id, "rank": keys[i]}
+	}
+
+	cypher = `
+	UNWIND $ranks AS r
+	MATCH (i:` + model.ResourceTypeIssue.String() + ` {id: r.id})
+	SET i.rank = r.rank`
+
+	if err := Neo4jExecuteAndConsumeResult(ctx, tx, cypher, map[string]any{"ranks": ranks}); err != nil {
+		return "", err
+	}
+
+	return keys[len(keys)-1], nil
+}
+
+// neo4jExecuteWrite runs the function in a write transaction.
+func neo4jExecuteWrite(ctx context.Context, db *Neo4jDatabase, fn func(tx neo4j.ManagedTransaction) error) error {
+	session := db.WriteSession(ctx)
+	defer func(ctx context.Context, sess neo4j.Session) {
+		if err := sess.Close(ctx); err != nil {
+			log.Error(ctx, err)
+		}
+	}(ctx, session)
+
+	_, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (any, error) {
+		return new(struct{}), fn(tx)
+	})
+	return err
+}
+
+// neo4jTxReadSingle runs a query in the transaction and reads its only
+// record.
+func neo4jTxReadSingle[T any](ctx context.Context, tx neo4j.ManagedTransaction, query string, params map[string]any, reader func(record *neo4j.Record) (*T, error)) (res *T, err error) {
+	started := time.Now()
+	defer func() { observeUncompiledNeo4j(started, err) }()
+
+	result, err := tx.Run(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = neo4j.SingleT(ctx, result, reader)
+	if err != nil {
+		if errors.As(err, &ErrNoMoreRecords) {
+			err = ErrNotFound
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+func TestIssueRankBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    string
+		wantErr error
+	}{
+		{name: "no bounds", want: "i"},
+		{name: "no upper bound", a: "i", want: "r"},
+		{name: "no lower bound", b: "i", want: "9"},
+		{name: "adjacent digits", a: "a", b: "b", want: "ai"},
+		{name: "common prefix", a: "0001", b: "0002", want: "0001i"},
+		{name: "before a leading zero key", b: "0001", want: "0000i"},
+		{name: "shorter upper bound", a: "a5", b: "b", want: "al"},
+		{name: "longer upper bound", a: "a", b: "b5", want: "b"},
+		{name: "equal bounds", a: "a", b: "a", wantErr: ErrInvalidRank},
+		{name: "reversed bounds", a: "b", b: "a", wantErr: ErrInvalidRank},
+		{name: "trailing zero", a: "a0", wantErr: ErrInvalidRank},
+		{name: "invalid digit", a: "A", wantErr: ErrInvalidRank},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := issueRankBetween(tt.a, tt.b)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				assert.Greater(t, got, tt.a)
+				if tt.b != "" {
+					assert.Less(t, got, tt.b)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueRankBetween_repeatedInserts(t *testing.T) {
+	t.Parallel()
+
+	keys := issueRankSpread(3)
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 500 {
+		i := rng.IntN(len(keys) + 1)
+		var a, b string
+		if i > 0 {
+			a = keys[i-1]
+		}
+		if i < len(keys) {
+			b = keys[i]
+		}
+
+		key, err := issueRankBetween(a, b)
+		require.NoError(t, err)
+		require.True(t, validIssueRank(key))
+		keys = slices.Insert(keys, i, key)
+	}
+
+	assert.True(t, slices.IsSorted(keys))
+	assert.Len(t, slices.Compact(slices.Clone(keys)), len(keys))
+}
+
+func TestIssueRankAfter(t *testing.T) {
+	t.Parallel()
+
+	first, err := issueRankAfter("")
+	require.NoError(t, err)
+	assert.Equal(t, "0001", first)
+
+	second, err := issueRankAfter(first)
+	require.NoError(t, err)
+	assert.Equal(t, "0002", second)
+
+	inserted, err := issueRankAfter("0001i")
+	require.NoError(t, err)
+	assert.Equal(t, "0002i", inserted)
+
+	last, err := issueRankAfter("zzzz")
+	require.NoError(t, err)
+	assert.Greater(t, last, "zzzz")
+	assert.True(t, validIssueRank(last))
+
+	_, err = issueRankAfter("a0")
+	assert.ErrorIs(t, err, ErrInvalidRank)
+
+	key := ""
+	for range 1000 {
+		next, err := issueRankAfter(key)
+		require.NoError(t, err)
+		require.Greater(t, next, key)
+		key = next
+	}
+	assert.LessOrEqual(t, len(key), issueRankWidth)
+}
+
+func TestIssueRankSpread(t *testing.T) {
+	t.Parallel()
+
+	keys := issueRankSpread(40)
+	assert.Equal(t, []string{"0001", "0002", "0003"}, keys[:3])
+	assert.Equal(t, "001", keys[35])
+	assert.True(t, slices.IsSorted(keys))
+	for _, key := range keys {
+		assert.True(t, validIssueRank(key), key)
+	}
+}
+
+func TestIssueRankSortKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0001", issueRankSortKey("0001", 7))
+	assert.Equal(t, "~0000000007", issueRankSortKey("", 7))
+	assert.Less(t, issueRankSortKey("zzzz", 1), issueRankSortKey("", 1))
+	assert.Less(t, issueRankSortKey("", 9), issueRankSortKey("", 10))
+	assert.True(t, strings.HasPrefix(issueRankSortExpression("i"), "coalesce(i.rank, '~'"))
+}
+
+func TestIssueRankBounds_between(t *testing.T) {
+	t.Parallel()
+
+	id := func() *model.ID {
+		id := model.MustNewID(model.ResourceTypeIssue)
+		return &id
+	}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		bounds    issueRankBounds
+		opts      RankIssueOpts
+		wantLower string
+		wantUpper string
+		wantErr   error
+	}{
+		{
+			name:    "no anchor",
+			wantErr: ErrIssueRankAnchor,
+		},
+		{
+			name:      "before the first issue",
+			bounds:    issueRankBounds{BeforeID: str("b"), BeforeRank: str("0001")},
+			opts:      RankIssueOpts{Before: id()},
+			wantUpper: "0001",
+		},
+		{
+			name:      "before an issue",
+			bounds:    issueRankBounds{BeforeID: str("b"), BeforeRank: str("0002"), PreviousRank: str("0001")},
+			opts:      RankIssueOpts{Before: id()},
+			wantLower: "0001",
+			wantUpper: "0002",
+		},
+		{
+			name:      "after the last issue",
+			bounds:    issueRankBounds{AfterID: str("a"), AfterRank: str("0002")},
+			opts:      RankIssueOpts{After: id()},
+			wantLower: "0002",
+		},
+		{
+			name:      "between issues",
+			bounds:    issueRankBounds{BeforeID: str("b"), BeforeRank: str("0003"), AfterID: str("a"), AfterRank: str("0001")},
+			opts:      RankIssueOpts{Before: id(), After: id()},
+			wantLower: "0001",
+			wantUpper: "0003",
+		},
+		{
+			name:    "anchors in the wrong order",
+			bounds:  issueRankBounds{BeforeID: str("b"), BeforeRank: str("0001"), AfterID: str("a"), AfterRank: str("0003")},
+			opts:    RankIssueOpts{Before: id(), After: id()},
+			wantErr: ErrIssueRankAnchor,
+		},
+		{
+			name:    "anchor not in the project",
+			opts:    RankIssueOpts{After: id()},
+			wantErr: ErrIssueRankAnchor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			lower, upper, err := tt.bounds.between(tt.opts)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantLower, lower)
+			assert.Equal(t, tt.wantUpper, upper)
+		})
+	}
+}
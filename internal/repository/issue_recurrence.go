@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+var (
+	ErrIssueRecurrenceDelete = errors.New("failed to delete issue recurrence") // the issue recurrence could not be deleted
+	ErrIssueRecurrenceRead   = errors.New("failed to read issue recurrence")   // the issue recurrence could not be retrieved
+	ErrIssueRecurrenceSave   = errors.New("failed to save issue recurrence")   // the issue recurrence could not be saved
+	ErrIssueRecurrenceUpdate = errors.New("failed to update issue recurrence") // the issue recurrence could not be updated
+)
+
+// IssueRecurrence creates issues from an issue template on the occurrences
+// of its rule. The issues are created on behalf of the creator of the
+// recurrence.
+type IssueRecurrence struct {
+	Template         model.ID   `json:"template"`
+	Rule             string     `json:"rule"`
+	StartsAt         time.Time  `json:"starts_at"`
+	Creator          model.ID   `json:"creator"`
+	NextOccurrenceAt *time.Time `json:"next_occurrence_at"`
+	CreatedAt        *time.Time `json:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at"`
+}
+
+// SaveIssueRecurrenceOpts holds the data required to save the recurrence of
+// an issue template.
+type SaveIssueRecurrenceOpts struct {
+	Template         model.ID
+	Rule             string
+	StartsAt         time.Time
+	Creator          model.ID
+	NextOccurrenceAt *time.Time
+}
+
+//go:generate go tool mockgen -source=issue_recurrence.go -destination=issue_recurrence_mock_gen.go -package=repository -mock_names "IssueRecurrenceRepository=MockIssueRecurrenceRepository"
+type IssueRecurrenceRepository interface {
+	// Get returns the recurrence of the issue template.
+	Get(ctx context.Context, template model.ID) (*IssueRecurrence, error)
+	// Save creates or replaces the recurrence of the issue template.
+	Save(ctx context.Context, opts SaveIssueRecurrenceOpts) (*IssueRecurrence, error)
+	// Delete deletes the recurrence of the issue template. The claimed
+	// occurrences are kept, so they are never claimed again.
+	Delete(ctx context.Context, template model.ID) error
+	// ListDue returns at most limit recurrences having their next occurrence
+	// before or at now, the earliest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*IssueRecurrence, error)
+	// ClaimOccurrence claims the occurrence of the recurrence of the issue
+	// template. It reports false if the occurrence has been claimed already.
+	ClaimOccurrence(ctx context.Context, template model.ID, occursAt time.Time) (bool, error)
+	// ReleaseOccurrence releases the claim of an occurrence, so it can be
+	// claimed again.
+	ReleaseOccurrence(ctx context.Context, template model.ID, occursAt time.Time) error
+	// SetOccurrenceIssue records the issue created for a claimed occurrence.
+	SetOccurrenceIssue(ctx context.Context, template model.ID, occursAt time.Time, issue model.ID) error
+	// Advance moves the next occurrence of the recurrence from the given
+	// occurrence to the next one. A nil next occurrence ends the recurrence.
+	// The recurrence is left unchanged if its next occurrence is not the
+	// given one anymore, because it has been saved or advanced meanwhile.
+	Advance(ctx context.Context, template model.ID, from time.Time, next *time.Time) error
+}
+
+// PGIssueRecurrenceRepository is a repository for managing the recurrences
+// of issue templates.
+type PGIssueRecurrenceRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGIssueRecurrenceRepository) Get(ctx context.Context, template model.ID) (*IssueRecurrence, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/Get")
+	defer span.End()
+
+	recurrence, err := scanIssueRecurrence(r.db.pool.QueryRow(ctx, "SELECT * FROM issue_recurrences WHERE template_id = $1", template))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrIssueRecurrenceRead, err)
+	}
+
+	return recurrence, nil
+}
+
+func (r *PGIssueRecurrenceRepository) Save(ctx context.Context, opts SaveIssueRecurrenceOpts) (*IssueRecurrence, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/Save")
+	defer span.End()
+
+	recurrence, err := scanIssueRecurrence(r.db.pool.QueryRow(ctx,
+		`INSERT INTO issue_recurrences (template_id, rule, starts_at, creator_id, next_occurrence_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (template_id) DO UPDATE SET rule = EXCLUDED.rule, starts_at = EXCLUDED.starts_at,
+			creator_id = EXCLUDED.creator_id, next_occurrence_at = EXCLUDED.next_occurrence_at,
+			updated_at = timezone('utc', now())
+		RETURNING *`,
+		opts.Template, opts.Rule, opts.StartsAt.UTC(), opts.Creator, opts.NextOccurrenceAt,
+		convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	))
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceSave, err)
+	}
+
+	return recurrence, nil
+}
+
+func (r *PGIssueRecurrenceRepository) Delete(ctx context.Context, template model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/Delete")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx, "DELETE FROM issue_recurrences WHERE template_id = $1", template)
+	if err != nil {
+		return errors.Join(ErrIssueRecurrenceDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PGIssueRecurrenceRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*IssueRecurrence, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/ListDue")
+	defer span.End()
+
+	rows, err := r.db.pool.Query(ctx,
+		`SELECT * FROM issue_recurrences WHERE next_occurrence_at <= $1
+		ORDER BY next_occurrence_at, template_id LIMIT $2`,
+		now.UTC(), limit,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceRead, err)
+	}
+	defer rows.Close()
+
+	recurrences := make([]*IssueRecurrence, 0)
+	for rows.Next() {
+		recurrence, err := scanIssueRecurrence(rows)
+		if err != nil {
+			return nil, errors.Join(ErrIssueRecurrenceRead, err)
+		}
+		recurrences = append(recurrences, recurrence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceRead, err)
+	}
+
+	return recurrences, nil
+}
+
+func (r *PGIssueRecurrenceRepository) ClaimOccurrence(ctx context.Context, template model.ID, occursAt time.Time) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/ClaimOccurrence")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx,
+		`INSERT INTO issue_recurrence_occurrences (template_id, occurs_at, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (template_id, occurs_at) DO NOTHING`,
+		template, occursAt.UTC(), time.Now().UTC().Round(time.Microsecond),
+	)
+	if err != nil {
+		return false, errors.Join(ErrIssueRecurrenceUpdate, err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *PGIssueRecurrenceRepository) ReleaseOccurrence(ctx context.Context, template model.ID, occursAt time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/ReleaseOccurrence")
+	defer span.End()
+
+	if _, err := r.db.pool.Exec(ctx,
+		"DELETE FROM issue_recurrence_occurrences WHERE template_id = $1 AND occurs_at = $2 AND issue_id IS NULL",
+		template, occursAt.UTC(),
+	); err != nil {
+		return errors.Join(ErrIssueRecurrenceUpdate, err)
+	}
+
+	return nil
+}
+
+func (r *PGIssueRecurrenceRepository) SetOccurrenceIssue(ctx context.Context, template model.ID, occursAt time.Time, issue model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/SetOccurrenceIssue")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx,
+		"UPDATE issue_recurrence_occurrences SET issue_id = $3 WHERE template_id = $1 AND occurs_at = $2",
+		template, occursAt.UTC(), issue,
+	)
+	if err != nil {
+		return errors.Join(ErrIssueRecurrenceUpdate, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PGIssueRecurrenceRepository) Advance(ctx context.Context, template model.ID, from time.Time, next *time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueRecurrenceRepository/Advance")
+	defer span.End()
+
+	if next != nil {
+		next = convert.ToPointer(next.UTC())
+	}
+
+	if _, err := r.db.pool.Exec(ctx,
+		"UPDATE issue_recurrences SET next_occurrence_at = $3 WHERE template_id = $1 AND next_occurrence_at = $2",
+		template, from.UTC(), next,
+	); err != nil {
+		return errors.Join(ErrIssueRecurrenceUpdate, err)
+	}
+
+	return nil
+}
+
+func scanIssueRecurrence(row pgx.Row) (*IssueRecurrence, error) {
+	var recurrence IssueRecurrence
+	if err := row.Scan(
+		&recurrence.Template, &recurrence.Rule, &recurrence.StartsAt, &recurrence.Creator,
+		&recurrence.NextOccurrenceAt, &recurrence.CreatedAt, &recurrence.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &recurrence, nil
+}
+
+// NewIssueRecurrenceRepository creates a new IssueRecurrenceRepository.
+func NewIssueRecurrenceRepository(opts ...PGRepositoryOption) (*PGIssueRecurrenceRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGIssueRecurrenceRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
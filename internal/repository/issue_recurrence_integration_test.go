@@ -0,0 +1,178 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type IssueRecurrenceRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	template *repository.IssueTemplate
+	creator  model.ID
+	start    time.Time
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) SetupTest() {
+	var err error
+	s.template, err = s.IssueTemplateRepo.Create(context.Background(), repository.CreateIssueTemplateOpts{
+		Project: model.MustNewID(model.ResourceTypeProject),
+		Name:    "On-call handover",
+		Kind:    model.IssueKindTask,
+	})
+	s.Require().NoError(err)
+
+	s.creator = model.MustNewID(model.ResourceTypeUser)
+	s.start = time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) save(next time.Time) *repository.IssueRecurrence {
+	recurrence, err := s.IssueRecurrenceRepo.Save(context.Background(), repository.SaveIssueRecurrenceOpts{
+		Template:         s.template.ID,
+		Rule:             "FREQ=WEEKLY",
+		StartsAt:         s.start,
+		Creator:          s.creator,
+		NextOccurrenceAt: &next,
+	})
+	s.Require().NoError(err)
+	return recurrence
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TestSaveAndGet() {
+	saved := s.save(s.start)
+	s.Assert().Equal(s.template.ID, saved.Template)
+	s.Assert().Equal("FREQ=WEEKLY", saved.Rule)
+	s.Assert().Equal(s.start, saved.StartsAt)
+	s.Assert().Equal(s.creator, saved.Creator)
+	s.Assert().Equal(s.start, *saved.NextOccurrenceAt)
+	s.Assert().Nil(saved.UpdatedAt)
+
+	next := s.start.AddDate(0, 0, 7)
+	saved = s.save(next)
+	s.Assert().Equal(next, *saved.NextOccurrenceAt)
+	s.Assert().NotNil(saved.UpdatedAt)
+
+	recurrence, err := s.IssueRecurrenceRepo.Get(context.Background(), s.template.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(saved, recurrence)
+
+	_, err = s.IssueRecurrenceRepo.Get(context.Background(), model.MustNewID(model.ResourceTypeIssueTemplate))
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TestDelete() {
+	s.save(s.start)
+
+	s.Require().NoError(s.IssueRecurrenceRepo.Delete(context.Background(), s.template.ID))
+
+	_, err := s.IssueRecurrenceRepo.Get(context.Background(), s.template.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+	s.Assert().ErrorIs(s.IssueRecurrenceRepo.Delete(context.Background(), s.template.ID), repository.ErrNotFound)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TestDeleteTemplate() {
+	s.save(s.start)
+
+	s.Require().NoError(s.IssueTemplateRepo.Delete(context.Background(), s.template.ID))
+
+	_, err := s.IssueRecurrenceRepo.Get(context.Background(), s.template.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TestListDue() {
+	s.save(s.start)
+
+	recurrences, err := s.IssueRecurrenceRepo.ListDue(context.Background(), s.start.Add(-time.Second), 10)
+	s.Require().NoError(err)
+	s.Assert().Empty(recurrences)
+
+	recurrences, err = s.IssueRecurrenceRepo.ListDue(context.Background(), s.start, 10)
+	s.Require().NoError(err)
+	s.Require().Len(recurrences, 1)
+	s.Assert().Equal(s.template.ID, recurrences[0].Template)
+
+	recurrences, err = s.IssueRecurrenceRepo.ListDue(context.Background(), s.start, 0)
+	s.Require().NoError(err)
+	s.Assert().Empty(recurrences)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TestClaimOccurrence() {
+	ctx := context.Background()
+
+	claimed, err := s.IssueRecurrenceRepo.ClaimOccurrence(ctx, s.template.ID, s.start)
+	s.Require().NoError(err)
+	s.Assert().True(claimed)
+
+	claimed, err = s.IssueRecurrenceRepo.ClaimOccurrence(ctx, s.template.ID, s.start)
+	s.Require().NoError(err)
+	s.Assert().False(claimed)
+
+	s.Require().NoError(s.IssueRecurrenceRepo.ReleaseOccurrence(ctx, s.template.ID, s.start))
+
+	claimed, err = s.IssueRecurrenceRepo.ClaimOccurrence(ctx, s.template.ID, s.start)
+	s.Require().NoError(err)
+	s.Assert().True(claimed)
+
+	// The occurrences having an issue are never released.
+	s.Require().NoError(s.IssueRecurrenceRepo.SetOccurrenceIssue(ctx, s.template.ID, s.start, model.MustNewID(model.ResourceTypeIssue)))
+	s.Require().NoError(s.IssueRecurrenceRepo.ReleaseOccurrence(ctx, s.template.ID, s.start))
+
+	claimed, err = s.IssueRecurrenceRepo.ClaimOccurrence(ctx, s.template.ID, s.start)
+	s.Require().NoError(err)
+	s.Assert().False(claimed)
+
+	err = s.IssueRecurrenceRepo.SetOccurrenceIssue(ctx, s.template.ID, s.start.Add(time.Hour), model.MustNewID(model.ResourceTypeIssue))
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueRecurrenceRepositoryIntegrationTestSuite) TestAdvance() {
+	ctx := context.Background()
+	s.save(s.start)
+	next := s.start.AddDate(0, 0, 7)
+
+	s.Require().NoError(s.IssueRecurrenceRepo.Advance(ctx, s.template.ID, s.start, &next))
+	recurrence, err := s.IssueRecurrenceRepo.Get(ctx, s.template.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(next, *recurrence.NextOccurrenceAt)
+
+	// Advancing from a stale occurrence leaves the recurrence unchanged.
+	s.Require().NoError(s.IssueRecurrenceRepo.Advance(ctx, s.template.ID, s.start, convert.ToPointer(next.AddDate(0, 0, 7))))
+	recurrence, err = s.IssueRecurrenceRepo.Get(ctx, s.template.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(next, *recurrence.NextOccurrenceAt)
+
+	s.Require().NoError(s.IssueRecurrenceRepo.Advance(ctx, s.template.ID, next, nil))
+	recurrence, err = s.IssueRecurrenceRepo.Get(ctx, s.template.ID)
+	s.Require().NoError(err)
+	s.Assert().Nil(recurrence.NextOccurrenceAt)
+}
+
+func TestIssueRecurrenceRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IssueRecurrenceRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+type issueRollupRow struct {
+	issueID string
+	rollup  *model.IssueRollup
+}
+
+func scanIssueRollup(rec *neo4j.Record) (*issueRollupRow, error) {
+	issueID, err := Neo4jParseValueFromRecord[string](rec, "issue_id")
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := Neo4jParseValueFromRecord[[]any](rec, "descendants")
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]model.RollupIssue, 0, len(descendants))
+	for _, item := range descendants {
+		node, ok := item.(neo4j.Node)
+		if !ok {
+			continue
+		}
+
+		var issue model.RollupIssue
+		if err := Neo4jScanIntoStruct(&node, &issue, []string{"id"}); err != nil {
+			return nil, err
+		}
+		issues = append(issues, issue)
+	}
+
+	return &issueRollupRow{issueID: issueID, rollup: model.NewIssueRollup(issues)}, nil
+}
+
+func (r *Neo4jIssueRepository) GetRollups(ctx context.Context, ids []model.ID) (map[model.ID]*model.IssueRollup, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetRollups")
+	defer span.End()
+
+	query, err := IssueRollupsQuery(ids)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetRollups, err)
+	}
+
+	var rows []*issueRollupRow
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query}, func(tx neo4j.ManagedTransaction) error {
+		var readErr error
+		rows, _, readErr = Neo4jRunQuery(ctx, tx, query, scanIssueRollup)
+		return readErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetRollups, err)
+	}
+
+	rollups := make(map[model.ID]*model.IssueRollup, len(rows))
+	for _, row := range rows {
+		id, err := model.NewIDFromString(row.issueID, model.ResourceTypeIssue.String())
+		if err != nil {
+			return nil, errors.Join(ErrIssueGetRollups, err)
+		}
+		rollups[id] = row.rollup
+	}
+
+	return rollups, nil
+}
+
+func (r *Neo4jIssueRepository) GetAncestors(ctx context.Context, issue model.ID) ([]model.ID, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.IssueRepository/GetAncestors")
+	defer span.End()
+
+	query, err := IssueAncestorsQuery(issue)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetRollups, err)
+	}
+
+	var ancestors []model.ID
+	err = Neo4jExecuteReadPlan(ctx, r.db, QueryPlan{Root: query}, func(tx neo4j.ManagedTransaction) error {
+		var readErr error
+		ancestors, _, readErr = Neo4jRunQuery(ctx, tx, query, func(rec *neo4j.Record) (model.ID, error) {
+			id, err := Neo4jParseValueFromRecord[string](rec, "id")
+			if err != nil {
+				return model.ID{}, err
+			}
+			return model.NewIDFromString(id, model.ResourceTypeIssue.String())
+		})
+		return readErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetRollups, err)
+	}
+
+	return ancestors, nil
+}
+
+func issueRollupCacheKey(id model.ID) string {
+	return composeCacheKey(model.ResourceTypeIssue.String(), "GetRollup", id.String())
+}
+
+func clearIssueRollup(ctx context.Context, r *redisBaseRepository, issueID model.ID) error {
+	return clearIssuesPattern(ctx, r, "GetRollup", issueID.String())
+}
+
+// clearIssueRollups clears the cached rollups of the issues, together with the
+// cached issues and subtask lists carrying them.
+func clearIssueRollups(ctx context.Context, r *redisBaseRepository, ids []model.ID) error {
+	for _, id := range ids {
+		if err := clearIssueRollup(ctx, r, id); err != nil {
+			return err
+		}
+		if err := clearIssuesKey(ctx, r, id); err != nil {
+			return err
+		}
+		if err := clearIssueForIssue(ctx, r, id); err != nil {
+			return err
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return clearIssueAllGetByKey(ctx, r)
+}
+
+// clearAncestorRollups clears the cached rollups of the ancestors of the
+// issue, which the changes of the issue roll up to.
+func (r *RedisCachedIssueRepository) clearAncestorRollups(ctx context.Context, id model.ID) error {
+	ancestors, err := r.issueRepo.GetAncestors(ctx, id)
+	if err != nil {
+		return err
+	}
+	return clearIssueRollups(ctx, r.cacheRepo, ancestors)
+}
+
+// clearSubtaskRelationRollups clears the cached rollups of the target of a
+// subtask relation and its ancestors. Other relations do not change rollups.
+func (r *RedisCachedIssueRepository) clearSubtaskRelationRollups(ctx context.Context, target model.ID, kind model.IssueRelationKind) error {
+	if kind != model.IssueRelationKindSubtaskOf {
+		return nil
+	}
+	if err := clearIssueRollups(ctx, r.cacheRepo, []model.ID{target}); err != nil {
+		return err
+	}
+	return r.clearAncestorRollups(ctx, target)
+}
+
+func (r *RedisCachedIssueRepository) GetRollups(ctx context.Context, ids []model.ID) (map[model.ID]*model.IssueRollup, error) {
+	rollups := make(map[model.ID]*model.IssueRollup, len(ids))
+	missing := make([]model.ID, 0, len(ids))
+	for _, id := range ids {
+		var rollup *model.IssueRollup
+		if err := r.cacheRepo.Get(ctx, issueRollupCacheKey(id), &rollup); err != nil {
+			return nil, err
+		}
+		if rollup == nil {
+			missing = append(missing, id)
+			continue
+		}
+		rollups[id] = rollup
+	}
+
+	if len(missing) == 0 {
+		return rollups, nil
+	}
+
+	loaded, err := r.issueRepo.GetRollups(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, rollup := range loaded {
+		if err := r.cacheRepo.Set(ctx, issueRollupCacheKey(id), rollup); err != nil {
+			return nil, err
+		}
+		rollups[id] = rollup
+	}
+
+	return rollups, nil
+}
+
+func (r *RedisCachedIssueRepository) GetAncestors(ctx context.Context, issue model.ID) ([]model.ID, error) {
+	return r.issueRepo.GetAncestors(ctx, issue)
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/cache/v9"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// issueRollupCachePatterns returns the cache patterns cleared when the rollups
+// of the issues change.
+func issueRollupCachePatterns(ids ...model.ID) []string {
+	patterns := make([]string, 0, 3*len(ids)+1)
+	for _, id := range ids {
+		patterns = append(patterns,
+			issueRollupCacheKey(id),
+			composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
+			composeCacheKey(model.ResourceTypeIssue.String(), "*", "ListForIssue", id.String(), "*"),
+		)
+	}
+	return append(patterns, composeCacheKey(model.ResourceTypeIssue.String(), "GetByKey", "*"))
+}
+
+func TestCachedIssueRepository_GetRollups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cachedID := model.MustNewID(model.ResourceTypeIssue)
+	uncachedID := model.MustNewID(model.ResourceTypeIssue)
+	missingID := model.MustNewID(model.ResourceTypeIssue)
+	cached := &model.IssueRollup{Todo: 1, Done: 1}
+	uncached := &model.IssueRollup{InProgress: 2}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).Times(4)
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(3)
+	tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span)
+
+	cacheRepo := mock.NewCacheBackend(ctrl)
+	cacheRepo.EXPECT().Get(ctx, issueRollupCacheKey(cachedID), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, dest any) error {
+		*(dest.(**model.IssueRollup)) = cached
+		return nil
+	})
+	cacheRepo.EXPECT().Get(ctx, issueRollupCacheKey(uncachedID), gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Get(ctx, issueRollupCacheKey(missingID), gomock.Any()).Return(cache.ErrCacheMiss)
+	cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: issueRollupCacheKey(uncachedID), Value: uncached}).Return(nil)
+
+	db, err := NewRedisDatabase(WithRedisClient(mock.NewUniversalClient(ctrl)))
+	require.NoError(t, err)
+
+	repo := NewMockIssueRepository(ctrl)
+	repo.EXPECT().GetRollups(ctx, []model.ID{uncachedID, missingID}).Return(map[model.ID]*model.IssueRollup{
+		uncachedID: uncached,
+	}, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: &redisBaseRepository{db: db, cache: cacheRepo, tracer: tracer, logger: mock.NewMockLogger(ctrl)},
+		issueRepo: repo,
+	}
+
+	got, err := r.GetRollups(ctx, []model.ID{cachedID, uncachedID, missingID})
+	require.NoError(t, err)
+	assert.Equal(t, map[model.ID]*model.IssueRollup{
+		cachedID:   cached,
+		uncachedID: uncached,
+	}, got)
+}
+
+func TestCachedIssueRepository_AddRelation_subtask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ancestor := model.MustNewID(model.ResourceTypeIssue)
+	opts := CreateIssueRelationOpts{
+		Source: model.MustNewID(model.ResourceTypeIssue),
+		Target: model.MustNewID(model.ResourceTypeIssue),
+		Kind:   model.IssueRelationKindSubtaskOf,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	patterns := issueRelationPairCachePatterns(opts.Source, opts.Target)
+	patterns = append(patterns, issueRollupCachePatterns(opts.Target)...)
+	patterns = append(patterns, issueRollupCachePatterns(ancestor)...)
+
+	repo := NewMockIssueRepository(ctrl)
+	repo.EXPECT().GetAncestors(ctx, opts.Target).Return([]model.ID{ancestor}, nil)
+	repo.EXPECT().AddRelation(ctx, opts).Return(&IssueRelation{}, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: redisCacheExpectingPatterns(ctrl, ctx, patterns, -1, nil),
+		issueRepo: repo,
+	}
+
+	_, err := r.AddRelation(ctx, opts)
+	require.NoError(t, err)
+}
+
+func TestCachedIssueRepository_GetAncestors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	want := []model.ID{model.MustNewID(model.ResourceTypeIssue)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := NewMockIssueRepository(ctrl)
+	repo.EXPECT().GetAncestors(ctx, issueID).Return(want, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: redisCacheExpectingPatterns(ctrl, ctx, nil, -1, nil),
+		issueRepo: repo,
+	}
+
+	got, err := r.GetAncestors(ctx, issueID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
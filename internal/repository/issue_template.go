@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+var (
+	ErrIssueTemplateCreate = errors.New("failed to create issue template") // the issue template could not be created
+	ErrIssueTemplateDelete = errors.New("failed to delete issue template") // the issue template could not be deleted
+	ErrIssueTemplateRead   = errors.New("failed to read issue template")   // the issue template could not be retrieved
+	ErrIssueTemplateUpdate = errors.New("failed to update issue template") // the issue template could not be updated
+)
+
+// IssueTemplate prefills the issues created from it in a project.
+type IssueTemplate struct {
+	ID           model.ID                   `json:"id"`
+	Project      model.ID                   `json:"project"`
+	Name         string                     `json:"name"`
+	Kind         model.IssueKind            `json:"kind"`
+	TitlePattern string                     `json:"title_pattern"`
+	Description  string                     `json:"description"`
+	Priority     model.IssuePriority        `json:"priority"`
+	Labels       []model.ID                 `json:"labels"`
+	Children     []model.IssueTemplateChild `json:"children"`
+	CreatedAt    *time.Time                 `json:"created_at"`
+	UpdatedAt    *time.Time                 `json:"updated_at"`
+}
+
+// CreateIssueTemplateOpts holds the data required to create an issue
+// template.
+type CreateIssueTemplateOpts struct {
+	Project      model.ID
+	Name         string
+	Kind         model.IssueKind
+	TitlePattern string
+	Description  string
+	Priority     model.IssuePriority
+	Labels       []model.ID
+	Children     []model.IssueTemplateChild
+}
+
+// UpdateIssueTemplateOpts holds the fields that can be updated on an issue
+// template. Undefined fields (Defined == false) are left unchanged.
+type UpdateIssueTemplateOpts struct {
+	Name         optional.Optional[string]
+	Kind         optional.Optional[model.IssueKind]
+	TitlePattern optional.Optional[string]
+	Description  optional.Optional[string]
+	Priority     optional.Optional[model.IssuePriority]
+	Labels       optional.Optional[[]model.ID]
+	Children     optional.Optional[[]model.IssueTemplateChild]
+}
+
+//go:generate go tool mockgen -source=issue_template.go -destination=issue_template_mock_gen.go -package=repository -mock_names "IssueTemplateRepository=MockIssueTemplateRepository"
+type IssueTemplateRepository interface {
+	Create(ctx context.Context, opts CreateIssueTemplateOpts) (*IssueTemplate, error)
+	Get(ctx context.Context, id model.ID) (*IssueTemplate, error)
+	ListForProject(ctx context.Context, project model.ID, page CursorPage) (Page[*IssueTemplate], error)
+	Update(ctx context.Context, id model.ID, opts UpdateIssueTemplateOpts) (*IssueTemplate, error)
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// PGIssueTemplateRepository is a repository for managing the issue templates
+// of projects.
+type PGIssueTemplateRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGIssueTemplateRepository) Create(ctx context.Context, opts CreateIssueTemplateOpts) (*IssueTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueTemplateRepository/Create")
+	defer span.End()
+
+	template := &IssueTemplate{
+		ID:           model.MustNewID(model.ResourceTypeIssueTemplate),
+		Project:      opts.Project,
+		Name:         opts.Name,
+		Kind:         opts.Kind,
+		TitlePattern: opts.TitlePattern,
+		Description:  opts.Description,
+		Priority:     opts.Priority,
+		Labels:       opts.Labels,
+		Children:     opts.Children,
+		CreatedAt:    convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	}
+	if template.Labels == nil {
+		template.Labels = make([]model.ID, 0)
+	}
+	if template.Children == nil {
+		template.Children = make([]model.IssueTemplateChild, 0)
+	}
+
+	_, err := r.db.pool.Exec(ctx,
+		`INSERT INTO issue_templates (id, project_id, name, kind, title_pattern, description, priority, labels, children, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		template.ID, template.Project, template.Name, template.Kind.String(), template.TitlePattern, template.Description,
+		issueTemplatePriority(template.Priority), issueTemplateLabels(template.Labels), template.Children, *template.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrIssueTemplateCreate, err)
+	}
+
+	return template, nil
+}
+
+func (r *PGIssueTemplateRepository) Get(ctx context.Context, id model.ID) (*IssueTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueTemplateRepository/Get")
+	defer span.End()
+
+	template, err := scanIssueTemplate(r.db.pool.QueryRow(ctx, "SELECT * FROM issue_templates WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrIssueTemplateRead, err)
+	}
+
+	return template, nil
+}
+
+func (r *PGIssueTemplateRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage) (Page[*IssueTemplate], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueTemplateRepository/ListForProject")
+	defer span.End()
+
+	templates, normalized, err := listPG(ctx, r.db, "issue_templates", "project_id", project, page, scanIssueTemplate)
+	if err != nil {
+		return Page[*IssueTemplate]{}, errors.Join(ErrIssueTemplateRead, err)
+	}
+
+	return PaginateSlice(templates, normalized.Size, func(template *IssueTemplate) model.ID {
+		return template.ID
+	})
+}
+
+func (r *PGIssueTemplateRepository) Update(ctx context.Context, id model.ID, opts UpdateIssueTemplateOpts) (*IssueTemplate, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueTemplateRepository/Update")
+	defer span.End()
+
+	sets := []string{"updated_at = timezone('utc', now())"}
+	args := []any{id}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if opts.Name.Defined && opts.Name.Value != nil {
+		set("name", *opts.Name.Value)
+	}
+	if opts.Kind.Defined && opts.Kind.Value != nil {
+		set("kind", opts.Kind.Value.String())
+	}
+	if opts.TitlePattern.Defined {
+		var pattern string
+		if opts.TitlePattern.Value != nil {
+			pattern = *opts.TitlePattern.Value
+		}
+		set("title_pattern", pattern)
+	}
+	if opts.Description.Defined {
+		var description string
+		if opts.Description.Value != nil {
+			description = *opts.Description.Value
+		}
+		set("description", description)
+	}
+	if opts.Priority.Defined {
+		var priority model.IssuePriority
+		if opts.Priority.Value != nil {
+			priority = *opts.Priority.Value
+		}
+		set("priority", issueTemplatePriority(priority))
+	}
+	if opts.Labels.Defined {
+		var labels []model.ID
+		if opts.Labels.Value != nil {
+			labels = *opts.Labels.Value
+		}
+		set("labels", issueTemplateLabels(labels))
+	}
+	if opts.Children.Defined {
+		children := make([]model.IssueTemplateChild, 0)
+		if opts.Children.Value != nil {
+			children = append(children, *opts.Children.Value...)
+		}
+		set("children", children)
+	}
+
+	row := r.db.pool.QueryRow(ctx,
+		fmt.Sprintf("UPDATE issue_templates SET %s WHERE id = $1 RETURNING *", strings.Join(sets, ", ")),
+		args...,
+	)
+	template, err := scanIssueTemplate(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrIssueTemplateUpdate, err)
+	}
+
+	return template, nil
+}
+
+func (r *PGIssueTemplateRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.IssueTemplateRepository/Delete")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx, "DELETE FROM issue_templates WHERE id = $1", id)
+	if err != nil {
+		return errors.Join(ErrIssueTemplateDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// issueTemplatePriority returns the stored form of the priority. An unset
+// priority is stored as an empty string.
+func issueTemplatePriority(priority model.IssuePriority) string {
+	if priority == 0 {
+		return ""
+	}
+	return priority.String()
+}
+
+// issueTemplateLabels returns the stored form of the label IDs.
+func issueTemplateLabels(labels []model.ID) []string {
+	out := make([]string, len(labels))
+	for i, label := range labels {
+		out[i] = label.Composite()
+	}
+	return out
+}
+
+func scanIssueTemplate(row pgx.Row) (*IssueTemplate, error) {
+	var t IssueTemplate
+	var kind, priority string
+	var labels []string
+	if err := row.Scan(
+		&t.ID, &t.Project, &t.Name, &kind, &t.TitlePattern, &t.Description, &priority, &labels, &t.Children,
+		&t.CreatedAt, &t.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if t.Kind, err = model.IssueKindString(kind); err != nil {
+		return nil, err
+	}
+	if priority != "" {
+		if t.Priority, err = model.IssuePriorityString(priority); err != nil {
+			return nil, err
+		}
+	}
+
+	t.Labels = make([]model.ID, len(labels))
+	for i, label := range labels {
+		if t.Labels[i], err = model.ParseCompositeID(label); err != nil {
+			return nil, err
+		}
+	}
+	if t.Children == nil {
+		t.Children = make([]model.IssueTemplateChild, 0)
+	}
+
+	return &t, nil
+}
+
+// NewIssueTemplateRepository creates a new IssueTemplateRepository.
+func NewIssueTemplateRepository(opts ...PGRepositoryOption) (*PGIssueTemplateRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGIssueTemplateRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
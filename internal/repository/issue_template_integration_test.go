@@ -0,0 +1,138 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type IssueTemplateRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	project model.ID
+	label   model.ID
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) SetupTest() {
+	s.project = model.MustNewID(model.ResourceTypeProject)
+	s.label = model.MustNewID(model.ResourceTypeLabel)
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) createTemplate(project model.ID) *repository.IssueTemplate {
+	template, err := s.IssueTemplateRepo.Create(context.Background(), repository.CreateIssueTemplateOpts{
+		Project:      project,
+		Name:         "Release",
+		Kind:         model.IssueKindEpic,
+		TitlePattern: "Release {title}",
+		Description:  "Ship the release",
+		Priority:     model.IssuePriorityHigh,
+		Labels:       []model.ID{s.label},
+		Children: []model.IssueTemplateChild{
+			{Kind: model.IssueKindTask, TitlePattern: "Changelog for {title}"},
+			{Kind: model.IssueKindTask, TitlePattern: "Announce {title}", Priority: model.IssuePriorityLow},
+		},
+	})
+	s.Require().NoError(err)
+	return template
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) TestCreateAndGet() {
+	created := s.createTemplate(s.project)
+
+	template, err := s.IssueTemplateRepo.Get(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(created.ID, template.ID)
+	s.Assert().Equal(s.project, template.Project)
+	s.Assert().Equal("Release", template.Name)
+	s.Assert().Equal(model.IssueKindEpic, template.Kind)
+	s.Assert().Equal("Release {title}", template.TitlePattern)
+	s.Assert().Equal("Ship the release", template.Description)
+	s.Assert().Equal(model.IssuePriorityHigh, template.Priority)
+	s.Assert().Equal([]model.ID{s.label}, template.Labels)
+	s.Assert().Equal(created.Children, template.Children)
+	s.Assert().Nil(template.UpdatedAt)
+
+	_, err = s.IssueTemplateRepo.Get(context.Background(), model.MustNewID(model.ResourceTypeIssueTemplate))
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) TestListForProject() {
+	s.createTemplate(s.project)
+	s.createTemplate(s.project)
+	s.createTemplate(model.MustNewID(model.ResourceTypeProject))
+
+	page, err := s.IssueTemplateRepo.ListForProject(context.Background(), s.project, repository.CursorPage{Size: 1})
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Assert().True(page.PageInfo.HasMore)
+
+	page, err = s.IssueTemplateRepo.ListForProject(context.Background(), s.project, repository.CursorPage{Size: 1, Token: page.PageInfo.NextPageToken})
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Assert().False(page.PageInfo.HasMore)
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) TestUpdate() {
+	created := s.createTemplate(s.project)
+
+	updated, err := s.IssueTemplateRepo.Update(context.Background(), created.ID, repository.UpdateIssueTemplateOpts{
+		Name:        optional.Some("Hotfix"),
+		Kind:        optional.Some(model.IssueKindBug),
+		Description: optional.Null[string](),
+		Priority:    optional.Null[model.IssuePriority](),
+		Labels:      optional.Null[[]model.ID](),
+		Children: optional.Some([]model.IssueTemplateChild{
+			{Kind: model.IssueKindTask, TitlePattern: "Backport {title}"},
+		}),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("Hotfix", updated.Name)
+	s.Assert().Equal(model.IssueKindBug, updated.Kind)
+	s.Assert().Equal("Release {title}", updated.TitlePattern)
+	s.Assert().Empty(updated.Description)
+	s.Assert().Zero(updated.Priority)
+	s.Assert().Empty(updated.Labels)
+	s.Assert().Equal([]model.IssueTemplateChild{{Kind: model.IssueKindTask, TitlePattern: "Backport {title}"}}, updated.Children)
+	s.Assert().NotNil(updated.UpdatedAt)
+
+	_, err = s.IssueTemplateRepo.Update(context.Background(), model.MustNewID(model.ResourceTypeIssueTemplate), repository.UpdateIssueTemplateOpts{})
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *IssueTemplateRepositoryIntegrationTestSuite) TestDelete() {
+	created := s.createTemplate(s.project)
+
+	s.Require().NoError(s.IssueTemplateRepo.Delete(context.Background(), created.ID))
+
+	_, err := s.IssueTemplateRepo.Get(context.Background(), created.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+	s.Assert().ErrorIs(s.IssueTemplateRepo.Delete(context.Background(), created.ID), repository.ErrNotFound)
+}
+
+func TestIssueTemplateRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IssueTemplateRepositoryIntegrationTestSuite))
+}
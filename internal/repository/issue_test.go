@@ -1497,7 +1497,7 @@ func TestCachedIssueRepository_AddWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, -1, nil)
 				},
 				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id, watcher model.ID) IssueRepository {
@@ -1518,7 +1518,7 @@ func TestCachedIssueRepository_AddWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, -1, nil)
 				},
 				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id, watcher model.ID) IssueRepository {
@@ -1540,7 +1540,7 @@ func TestCachedIssueRepository_AddWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, 0, ErrCacheDelete)
 				},
 				issueRepo: func(ctrl *gomock.Controller, _ context.Context, _, _ model.ID) IssueRepository {
@@ -1559,7 +1559,7 @@ func TestCachedIssueRepository_AddWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, 1, ErrCacheDelete)
 				},
 				issueRepo: func(ctrl *gomock.Controller, _ context.Context, _, _ model.ID) IssueRepository {
@@ -1588,6 +1588,103 @@ func TestCachedIssueRepository_AddWatcher(t *testing.T) {
 	}
 }
 
+func TestCachedIssueRepository_AutoWatch(t *testing.T) {
+	type fields struct {
+		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository
+		issueRepo func(ctrl *gomock.Controller, ctx context.Context, id model.ID, users []model.ID) IssueRepository
+	}
+	type args struct {
+		ctx   context.Context
+		id    model.ID
+		users []model.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "auto watch",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
+					return redisCacheExpectingPatterns(ctrl, ctx, []string{
+						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
+					}, -1, nil)
+				},
+				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, users []model.ID) IssueRepository {
+					repo := NewMockIssueRepository(ctrl)
+					repo.EXPECT().AutoWatch(ctx, id, users).Return(nil)
+					return repo
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  model.MustNewID(model.ResourceTypeIssue),
+				users: []model.ID{
+					model.MustNewID(model.ResourceTypeUser),
+					model.MustNewID(model.ResourceTypeUser),
+				},
+			},
+		},
+		{
+			name: "auto watch with repository error",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
+					return redisCacheExpectingPatterns(ctrl, ctx, []string{
+						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
+					}, -1, nil)
+				},
+				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, users []model.ID) IssueRepository {
+					repo := NewMockIssueRepository(ctrl)
+					repo.EXPECT().AutoWatch(ctx, id, users).Return(ErrIssueAddWatcher)
+					return repo
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				id:    model.MustNewID(model.ResourceTypeIssue),
+				users: []model.ID{model.MustNewID(model.ResourceTypeUser)},
+			},
+			wantErr: ErrIssueAddWatcher,
+		},
+		{
+			name: "auto watch with clear watchers cache error",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
+					return redisCacheExpectingPatterns(ctrl, ctx, []string{
+						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
+					}, 1, ErrCacheDelete)
+				},
+				issueRepo: func(ctrl *gomock.Controller, _ context.Context, _ model.ID, _ []model.ID) IssueRepository {
+					return NewMockIssueRepository(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  model.MustNewID(model.ResourceTypeIssue),
+			},
+			wantErr: ErrCacheDelete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			r := &RedisCachedIssueRepository{
+				cacheRepo: tt.fields.cacheRepo(ctrl, tt.args.ctx, tt.args.id),
+				issueRepo: tt.fields.issueRepo(ctrl, tt.args.ctx, tt.args.id, tt.args.users),
+			}
+			err := r.AutoWatch(tt.args.ctx, tt.args.id, tt.args.users)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestCachedIssueRepository_GetWatchers(t *testing.T) {
 	type fields struct {
 		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, id model.ID, watchers []*User) *redisBaseRepository
@@ -1900,7 +1997,7 @@ func TestCachedIssueRepository_RemoveWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, -1, nil)
 				},
 				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id, watcher model.ID) IssueRepository {
@@ -1921,7 +2018,7 @@ func TestCachedIssueRepository_RemoveWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, -1, nil)
 				},
 				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id, watcher model.ID) IssueRepository {
@@ -1943,7 +2040,7 @@ func TestCachedIssueRepository_RemoveWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, 0, ErrCacheDelete)
 				},
 				issueRepo: func(ctrl *gomock.Controller, _ context.Context, _, _ model.ID) IssueRepository {
@@ -1962,7 +2059,7 @@ func TestCachedIssueRepository_RemoveWatcher(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id, _ model.ID) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 					}, 1, ErrCacheDelete)
 				},
 				issueRepo: func(ctrl *gomock.Controller, _ context.Context, _, _ model.ID) IssueRepository {
@@ -2109,6 +2206,64 @@ func TestCachedIssueRepository_GetRelation(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestCachedIssueRepository_GetEstimates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	want := []*IssueEstimate{{ID: issueID, StoryPoints: convert.ToPointer(5.0)}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := NewMockIssueRepository(ctrl)
+	repo.EXPECT().GetEstimates(ctx, issueID).Return(want, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: redisCacheExpectingPatterns(ctrl, ctx, nil, -1, nil),
+		issueRepo: repo,
+	}
+
+	got, err := r.GetEstimates(ctx, issueID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestCachedIssueRepository_Dependencies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	opts := CreateIssueRelationOpts{Source: issueID, Target: model.MustNewID(model.ResourceTypeIssue), Kind: model.IssueRelationKindBlocks}
+	want := &IssueDependencyGraph{Issues: []*PartialIssue{{ID: issueID}}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := NewMockIssueRepository(ctrl)
+	repo.EXPECT().HasRelationCycle(ctx, opts, nil).Return(true, nil)
+	repo.EXPECT().GetDependencyGraph(ctx, issueID, 2).Return(want, nil)
+	repo.EXPECT().GetProjectDependencies(ctx, projectID).Return(want, nil)
+
+	r := &RedisCachedIssueRepository{
+		cacheRepo: redisCacheExpectingPatterns(ctrl, ctx, nil, -1, nil),
+		issueRepo: repo,
+	}
+
+	cycle, err := r.HasRelationCycle(ctx, opts, nil)
+	require.NoError(t, err)
+	assert.True(t, cycle)
+
+	got, err := r.GetDependencyGraph(ctx, issueID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = r.GetProjectDependencies(ctx, projectID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
 func TestCachedIssueRepository_ListRelations(t *testing.T) {
 	issueID := model.MustNewID(model.ResourceTypeIssue)
 	query := IssueRelationListQuery{IssueID: issueID, Page: CursorPage{Size: 10}}
@@ -2665,6 +2820,7 @@ func TestCachedIssueRepository_Update(t *testing.T) {
 					repo := NewMockIssueRepository(ctrl)
 					repo.EXPECT().Get(ctx, id, IssueProjection{Assignments: true}).Return(&Issue{}, nil)
 					repo.EXPECT().Update(ctx, id, opts, IssueDetailProjection()).Return(issue, nil)
+					repo.EXPECT().GetAncestors(ctx, id).Return(nil, nil)
 					return repo
 				},
 			},
@@ -2788,16 +2944,17 @@ func TestCachedIssueRepository_Delete(t *testing.T) {
 			fields: fields{
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, issue *Issue) *redisBaseRepository {
 					getKey := composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*")
-					watchersKey := composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*")
+					watchersKey := composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String())
 					relationsKey := composeCacheKey(model.ResourceTypeIssue.String(), "GetRelations", id.String(), "*")
 					listRelationsKey := composeCacheKey(model.ResourceTypeIssue.String(), "*", "ListRelations", id.String(), "*")
 					getByKeyPattern := composeCacheKey(model.ResourceTypeIssue.String(), "GetByKey", "*")
 					projectPattern := composeCacheKey(model.ResourceTypeProject.String(), "*")
+					rollupKey := issueRollupCacheKey(id)
 					projectGenKey := issueListProjectGenKey(issue.Project.ID)
 					assigneeGenKey := issueListUserGenKey(issue.Assignments[0].ID)
 
 					dbClient := mock.NewUniversalClient(ctrl)
-					for _, pattern := range []string{getKey, watchersKey, relationsKey, listRelationsKey, getByKeyPattern, projectPattern} {
+					for _, pattern := range []string{getKey, watchersKey, relationsKey, listRelationsKey, getByKeyPattern, projectPattern, rollupKey} {
 						cmd := new(redis.StringSliceCmd)
 						cmd.SetVal([]string{pattern})
 						dbClient.EXPECT().Keys(ctx, pattern).Return(cmd)
@@ -2807,10 +2964,10 @@ func TestCachedIssueRepository_Delete(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(10)
+					span.EXPECT().End(gomock.Len(0)).Times(11)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(6)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(7)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(2)
 
@@ -2821,6 +2978,7 @@ func TestCachedIssueRepository_Delete(t *testing.T) {
 					cacheRepo.EXPECT().Delete(ctx, listRelationsKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getByKeyPattern).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, projectPattern).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, rollupKey).Return(nil)
 					cacheRepo.EXPECT().Get(ctx, projectGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
 					cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: projectGenKey, Value: int64(1)}).Return(nil)
 					cacheRepo.EXPECT().Get(ctx, assigneeGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
@@ -2836,6 +2994,7 @@ func TestCachedIssueRepository_Delete(t *testing.T) {
 				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, issue *Issue) IssueRepository {
 					repo := NewMockIssueRepository(ctrl)
 					repo.EXPECT().Get(ctx, id, IssueProjection{Assignments: true}).Return(issue, nil)
+					repo.EXPECT().GetAncestors(ctx, id).Return(nil, nil)
 					repo.EXPECT().Delete(ctx, id).Return(nil)
 					return repo
 				},
@@ -2852,16 +3011,18 @@ func TestCachedIssueRepository_Delete(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, _ *Issue) *redisBaseRepository {
 					return redisCacheExpectingPatterns(ctrl, ctx, []string{
 						composeCacheKey(model.ResourceTypeIssue.String(), "Get", id.String(), "*"),
-						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String(), "*"),
+						composeCacheKey(model.ResourceTypeIssue.String(), "GetWatchers", id.String()),
 						composeCacheKey(model.ResourceTypeIssue.String(), "GetRelations", id.String(), "*"),
 						composeCacheKey(model.ResourceTypeIssue.String(), "*", "ListRelations", id.String(), "*"),
 						composeCacheKey(model.ResourceTypeIssue.String(), "GetByKey", "*"),
 						composeCacheKey(model.ResourceTypeProject.String(), "*"),
+						issueRollupCacheKey(id),
 					}, -1, nil)
 				},
 				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, issue *Issue) IssueRepository {
 					repo := NewMockIssueRepository(ctrl)
 					repo.EXPECT().Get(ctx, id, IssueProjection{Assignments: true}).Return(issue, nil)
+					repo.EXPECT().GetAncestors(ctx, id).Return(nil, nil)
 					repo.EXPECT().Delete(ctx, id).Return(ErrNotFound)
 					return repo
 				},
@@ -2916,6 +3077,115 @@ func TestCachedIssueRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestCachedIssueRepository_Rank(t *testing.T) {
+	type fields struct {
+		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, issue *Issue) *redisBaseRepository
+		issueRepo func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts, issue *Issue) IssueRepository
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "rank issue",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, issue *Issue) *redisBaseRepository {
+					projectGenKey := issueListProjectGenKey(issue.Project.ID)
+					assigneeGenKey := issueListUserGenKey(issue.Assignments[0].ID)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(4)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(2)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, projectGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+					cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: projectGenKey, Value: int64(1)}).Return(nil)
+					cacheRepo.EXPECT().Get(ctx, assigneeGenKey, gomock.Any()).Return(cache.ErrCacheMiss)
+					cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: assigneeGenKey, Value: int64(1)}).Return(nil)
+
+					return &redisBaseRepository{
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts, issue *Issue) IssueRepository {
+					repo := NewMockIssueRepository(ctrl)
+					repo.EXPECT().Get(ctx, id, IssueProjection{Assignments: true}).Return(issue, nil)
+					repo.EXPECT().Rank(ctx, id, opts).Return(nil)
+					return repo
+				},
+			},
+		},
+		{
+			name: "rank issue with error",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, _ context.Context, _ *Issue) *redisBaseRepository {
+					return &redisBaseRepository{
+						cache:  mock.NewCacheBackend(ctrl),
+						tracer: mock.NewMockTracer(ctrl),
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts, issue *Issue) IssueRepository {
+					repo := NewMockIssueRepository(ctrl)
+					repo.EXPECT().Get(ctx, id, IssueProjection{Assignments: true}).Return(issue, nil)
+					repo.EXPECT().Rank(ctx, id, opts).Return(ErrIssueRankAnchor)
+					return repo
+				},
+			},
+			wantErr: ErrIssueRankAnchor,
+		},
+		{
+			name: "rank missing issue",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, _ context.Context, _ *Issue) *redisBaseRepository {
+					return &redisBaseRepository{
+						cache:  mock.NewCacheBackend(ctrl),
+						tracer: mock.NewMockTracer(ctrl),
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				issueRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, _ RankIssueOpts, _ *Issue) IssueRepository {
+					repo := NewMockIssueRepository(ctrl)
+					repo.EXPECT().Get(ctx, id, IssueProjection{Assignments: true}).Return(nil, ErrNotFound)
+					return repo
+				},
+			},
+			wantErr: ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			id := model.MustNewID(model.ResourceTypeIssue)
+			before := model.MustNewID(model.ResourceTypeIssue)
+			opts := RankIssueOpts{Before: &before}
+			issue := &Issue{
+				Project: &PartialProject{ID: model.MustNewID(model.ResourceTypeProject)},
+				Assignments: []PartialAssignee{
+					{ID: model.MustNewID(model.ResourceTypeUser), Kind: model.AssignmentKindAssignee},
+				},
+			}
+
+			r := &RedisCachedIssueRepository{
+				cacheRepo: tt.fields.cacheRepo(ctrl, ctx, issue),
+				issueRepo: tt.fields.issueRepo(ctrl, ctx, id, opts, issue),
+			}
+			err := r.Rank(ctx, id, opts)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestDecodeIssueLinks(t *testing.T) {
 	t.Parallel()
 
@@ -3008,6 +3278,43 @@ func TestUpdateIssueOpts_patch(t *testing.T) {
 		_, ok := got["description"]
 		assert.False(t, ok)
 	})
+
+	t.Run("estimates", func(t *testing.T) {
+		t.Parallel()
+
+		got := UpdateIssueOpts{
+			StoryPoints:       optional.Some(2.5),
+			OriginalEstimate:  optional.Some(uint(90)),
+			RemainingEstimate: optional.Null[uint](),
+		}.patch()
+		assert.Equal(t, 2.5, got["story_points"])
+		assert.Equal(t, int64(90), got["original_estimate"])
+		require.Contains(t, got, "remaining_estimate")
+		assert.Nil(t, got["remaining_estimate"])
+	})
+
+	t.Run("custom fields are prefixed", func(t *testing.T) {
+		t.Parallel()
+
+		got := UpdateIssueOpts{
+			CustomFields: map[string]any{"customer": "acme", "points": nil},
+		}.patch()
+		assert.Equal(t, "acme", got["cf_customer"])
+		require.Contains(t, got, "cf_points")
+		assert.Nil(t, got["cf_points"])
+	})
+}
+
+func TestDecodeIssueCustomFields(t *testing.T) {
+	t.Parallel()
+
+	got := decodeIssueCustomFields(map[string]any{
+		"title":        "Implement authentication",
+		"cf_customer":  "acme",
+		"cf_platforms": []any{"ios"},
+	})
+	assert.Equal(t, map[string]any{"customer": "acme", "platforms": []any{"ios"}}, got)
+	assert.Nil(t, decodeIssueCustomFields(map[string]any{"title": "Implement authentication"}))
 }
 
 func TestParentProjectKeyFromRecord(t *testing.T) {
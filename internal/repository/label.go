@@ -26,19 +26,25 @@ type PartialLabel struct {
 	Name string   `json:"name"`
 }
 
-// Label represents a label persisted by the repository.
+// Label represents a label persisted by the repository. Scope is the
+// organization or project the label is defined in, and it is nil for labels
+// created before labels were scoped.
 type Label struct {
 	ID          model.ID   `json:"id"`
 	Name        string     `json:"name"`
 	Description string     `json:"description"`
+	Color       string     `json:"color"`
+	Scope       *model.ID  `json:"scope"`
 	CreatedAt   *time.Time `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at"`
 }
 
 // CreateLabelOpts holds the data required to create a label.
 type CreateLabelOpts struct {
+	Scope       model.ID
 	Name        string
 	Description string
+	Color       string
 }
 
 // UpdateLabelOpts holds the fields that can be updated on a label.
@@ -46,6 +52,7 @@ type CreateLabelOpts struct {
 type UpdateLabelOpts struct {
 	Name        optional.Optional[string]
 	Description optional.Optional[string]
+	Color       optional.Optional[string]
 }
 
 // patch builds a Neo4j property map from defined optional fields.
@@ -58,6 +65,9 @@ func (o UpdateLabelOpts) patch() map[string]any {
 	if o.Description.Defined {
 		p["description"] = *o.Description.Value
 	}
+	if o.Color.Defined {
+		p["color"] = *o.Color.Value
+	}
 
 	return p
 }
@@ -67,6 +77,7 @@ type LabelRepository interface {
 	Create(ctx context.Context, opts CreateLabelOpts) (*Label, error)
 	Get(ctx context.Context, id model.ID, proj LabelProjection) (*Label, error)
 	List(ctx context.Context, page CursorPage, proj LabelProjection) (Page[*Label], error)
+	ListForScope(ctx context.Context, scope model.ID, page CursorPage, proj LabelProjection) (Page[*Label], error)
 	Update(ctx context.Context, id model.ID, opts UpdateLabelOpts) (*Label, error)
 	AttachTo(ctx context.Context, labelID, attachTo model.ID) error
 	DetachFrom(ctx context.Context, labelID, detachFrom model.ID) error
@@ -78,7 +89,7 @@ type Neo4jLabelRepository struct {
 	*neo4jBaseRepository
 }
 
-func (r *Neo4jLabelRepository) scan(lp string) func(rec *neo4j.Record) (*Label, error) {
+func (r *Neo4jLabelRepository) scan(lp, sp string) func(rec *neo4j.Record) (*Label, error) {
 	return func(rec *neo4j.Record) (*Label, error) {
 		l := new(Label)
 
@@ -87,12 +98,25 @@ func (r *Neo4jLabelRepository) scan(lp string) func(rec *neo4j.Record) (*Label,
 			return nil, err
 		}
 
-		if err := Neo4jScanIntoStruct(&val, &l, []string{"id"}); err != nil {
+		scope, err := Neo4jRecordOptionalNode(rec, sp)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := Neo4jScanIntoStruct(&val, &l, []string{"id", "scope"}); err != nil {
 			return nil, err
 		}
 
 		l.ID, _ = model.NewIDFromString(val.GetProperties()["id"].(string), model.ResourceTypeLabel.String())
 
+		if scope != nil {
+			scopeID, err := Neo4jDecodeIDFromLabel(*scope)
+			if err != nil {
+				return nil, err
+			}
+			l.Scope = &scopeID
+		}
+
 		return l, nil
 	}
 }
@@ -101,14 +125,25 @@ func (r *Neo4jLabelRepository) Create(ctx context.Context, opts CreateLabelOpts)
 	ctx, span := r.tracer.Start(ctx, "repository.neo4j.LabelRepository/Create")
 	defer span.End()
 
+	if err := validateLabelScope(opts.Scope); err != nil {
+		return nil, errors.Join(ErrLabelCreate, err)
+	}
+
 	createdAt := time.Now().UTC()
 	id := model.MustNewID(model.ResourceTypeLabel)
 
-	cypher := `CREATE (l:` + id.Label() + ` {id: $id, name: $name, description: $description, created_at: datetime($created_at)})`
+	cypher := `
+	MATCH (s:` + opts.Scope.Label() + ` {id: $scope_id})
+	CREATE
+		(l:` + id.Label() + ` {id: $id, name: $name, description: $description, color: $color, created_at: datetime($created_at)}),
+		(l)-[:` + EdgeKindInScopeOf.String() + ` {id: $rel_id, created_at: datetime($created_at)}]->(s)`
 	params := map[string]any{
 		"id":          id.String(),
+		"scope_id":    opts.Scope.String(),
+		"rel_id":      model.NewRawID(),
 		"name":        opts.Name,
 		"description": opts.Description,
+		"color":       opts.Color,
 		"created_at":  createdAt.Format(time.RFC3339Nano),
 	}
 
@@ -134,7 +169,7 @@ func (r *Neo4jLabelRepository) Get(ctx context.Context, id model.ID, proj LabelP
 	var label *Label
 	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
 		var runErr error
-		label, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("l"))
+		label, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("l", "s"))
 		return runErr
 	})
 	if err != nil {
@@ -164,7 +199,43 @@ func (r *Neo4jLabelRepository) List(ctx context.Context, page CursorPage, proj L
 	items := make([]*Label, 0)
 	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
 		var runErr error
-		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("l"))
+		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("l", "s"))
+		return runErr
+	})
+	if err != nil {
+		return Page[*Label]{}, errors.Join(ErrLabelRead, err)
+	}
+
+	return PaginateSlice(items, normalized.Size, func(label *Label) model.ID {
+		return label.ID
+	})
+}
+
+// ListForScope returns the labels defined in the scope and in any of its
+// ancestors, so a project lists its own labels and the labels of its
+// organization.
+func (r *Neo4jLabelRepository) ListForScope(ctx context.Context, scope model.ID, page CursorPage, proj LabelProjection) (Page[*Label], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.LabelRepository/ListForScope")
+	defer span.End()
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Label]{}, errors.Join(ErrLabelRead, err)
+	}
+	plan, err := CompileQuery(LabelListQuery{
+		Scope:      &scope,
+		Page:       normalized,
+		Order:      SortDirectionDesc,
+		Projection: proj,
+	})
+	if err != nil {
+		return Page[*Label]{}, errors.Join(ErrLabelRead, err)
+	}
+
+	items := make([]*Label, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("l", "s"))
 		return runErr
 	})
 	if err != nil {
@@ -206,7 +277,7 @@ func (r *Neo4jLabelRepository) AttachTo(ctx context.Context, labelID, attachTo m
 	cypher := `
 	MATCH (l:` + labelID.Label() + ` {id: $label_id})
 	MATCH (n:` + attachTo.Label() + ` {id: $node_id})
-	CREATE (n)-[:` + EdgeKindHasLabel.String() + `]->(l)`
+	MERGE (n)-[:` + EdgeKindHasLabel.String() + `]->(l)`
 
 	params := map[string]any{
 		"label_id": labelID.String(),
@@ -256,6 +327,17 @@ func (r *Neo4jLabelRepository) Delete(ctx context.Context, id model.ID) error {
 	return nil
 }
 
+// validateLabelScope checks that the scope is an organization or a project.
+func validateLabelScope(scope model.ID) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+	if scope.Type != model.ResourceTypeOrganization && scope.Type != model.ResourceTypeProject {
+		return model.ErrInvalidID
+	}
+	return nil
+}
+
 // NewNeo4jLabelRepository creates a new label neo4jBaseRepository.
 func NewNeo4jLabelRepository(opts ...Neo4jRepositoryOption) (*Neo4jLabelRepository, error) {
 	baseRepo, err := newNeo4jRepository(opts...)
@@ -371,6 +453,42 @@ func (r *RedisCachedLabelRepository) List(ctx context.Context, page CursorPage,
 	return labels, nil
 }
 
+func (r *RedisCachedLabelRepository) ListForScope(ctx context.Context, scope model.ID, page CursorPage, proj LabelProjection) (Page[*Label], error) {
+	var labels Page[*Label]
+	var err error
+
+	normalized, err := normalizedPage(page)
+	if err != nil {
+		return Page[*Label]{}, err
+	}
+
+	key := composeCacheKey(
+		model.ResourceTypeLabel.String(),
+		"List",
+		scope.String(),
+		projectionCacheValue(proj),
+		pageTokenValue(normalized.Token),
+		normalized.Size,
+	)
+	if err = r.cacheRepo.Get(ctx, key, &labels); err != nil {
+		return Page[*Label]{}, err
+	}
+
+	if labels.Items != nil {
+		return labels, nil
+	}
+
+	if labels, err = r.labelRepo.ListForScope(ctx, scope, normalized, proj); err != nil {
+		return Page[*Label]{}, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, labels); err != nil {
+		return Page[*Label]{}, err
+	}
+
+	return labels, nil
+}
+
 func (r *RedisCachedLabelRepository) Update(ctx context.Context, id model.ID, opts UpdateLabelOpts) (*Label, error) {
 	label, err := r.labelRepo.Update(ctx, id, opts)
 	if err != nil {
@@ -42,7 +42,7 @@ func (s *LabelRepositoryIntegrationTestSuite) SetupTest() {
 	s.testDoc, err = s.DocumentRepo.Create(context.Background(), testModel.NewCreateDocumentOpts(s.testOrg.ID, s.testUser.ID))
 	s.Require().NoError(err)
 
-	s.createOpts = testModel.NewCreateLabelOpts()
+	s.createOpts = testModel.NewCreateLabelOpts(s.testOrg.ID)
 }
 
 func (s *LabelRepositoryIntegrationTestSuite) TearDownTest() {
@@ -70,6 +70,9 @@ func (s *LabelRepositoryIntegrationTestSuite) TestGet() {
 	s.Assert().Equal(created.ID, label.ID)
 	s.Assert().Equal(s.createOpts.Name, label.Name)
 	s.Assert().Equal(s.createOpts.Description, label.Description)
+	s.Assert().Equal(s.createOpts.Color, label.Color)
+	s.Require().NotNil(label.Scope)
+	s.Assert().Equal(s.testOrg.ID, *label.Scope)
 	s.Assert().WithinDuration(*created.CreatedAt, *label.CreatedAt, 100*time.Millisecond)
 	s.Assert().Nil(label.UpdatedAt)
 }
@@ -77,9 +80,9 @@ func (s *LabelRepositoryIntegrationTestSuite) TestGet() {
 func (s *LabelRepositoryIntegrationTestSuite) TestGetAll() {
 	_, err := s.LabelRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
-	_, err = s.LabelRepo.Create(context.Background(), testModel.NewCreateLabelOpts())
+	_, err = s.LabelRepo.Create(context.Background(), testModel.NewCreateLabelOpts(s.testOrg.ID))
 	s.Require().NoError(err)
-	_, err = s.LabelRepo.Create(context.Background(), testModel.NewCreateLabelOpts())
+	_, err = s.LabelRepo.Create(context.Background(), testModel.NewCreateLabelOpts(s.testOrg.ID))
 	s.Require().NoError(err)
 
 	labels, err := s.LabelRepo.List(context.Background(), repository.CursorPage{Size: 10}, repository.LabelListProjection())
@@ -97,6 +100,28 @@ func (s *LabelRepositoryIntegrationTestSuite) TestGetAll() {
 	s.Assert().False(labels.PageInfo.HasMore)
 }
 
+func (s *LabelRepositoryIntegrationTestSuite) TestListForScope() {
+	namespace, err := s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	project, err := s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(namespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+
+	orgLabel, err := s.LabelRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	projectLabel, err := s.LabelRepo.Create(context.Background(), testModel.NewCreateLabelOpts(project.ID))
+	s.Require().NoError(err)
+
+	labels, err := s.LabelRepo.ListForScope(context.Background(), s.testOrg.ID, repository.CursorPage{Size: 10}, repository.LabelListProjection())
+	s.Require().NoError(err)
+	s.Require().Len(labels.Items, 1)
+	s.Assert().Equal(orgLabel.ID, labels.Items[0].ID)
+
+	labels, err = s.LabelRepo.ListForScope(context.Background(), project.ID, repository.CursorPage{Size: 10}, repository.LabelListProjection())
+	s.Require().NoError(err)
+	s.Require().Len(labels.Items, 2)
+	s.Assert().ElementsMatch([]model.ID{orgLabel.ID, projectLabel.ID}, []model.ID{labels.Items[0].ID, labels.Items[1].ID})
+}
+
 func (s *LabelRepositoryIntegrationTestSuite) TestUpdate() {
 	created, err := s.LabelRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
@@ -104,6 +129,7 @@ func (s *LabelRepositoryIntegrationTestSuite) TestUpdate() {
 	updateOpts := repository.UpdateLabelOpts{
 		Name:        optional.Some("new name"),
 		Description: optional.Some("new description"),
+		Color:       optional.Some("#d73a4a"),
 	}
 
 	label, err := s.LabelRepo.Update(context.Background(), created.ID, updateOpts)
@@ -112,6 +138,7 @@ func (s *LabelRepositoryIntegrationTestSuite) TestUpdate() {
 	s.Assert().Equal(created.ID, label.ID)
 	s.Assert().Equal("new name", label.Name)
 	s.Assert().Equal("new description", label.Description)
+	s.Assert().Equal("#d73a4a", label.Color)
 	s.Assert().WithinDuration(*created.CreatedAt, *label.CreatedAt, 100*time.Millisecond)
 	s.Assert().NotNil(label.UpdatedAt)
 }
@@ -120,6 +147,7 @@ func (s *LabelRepositoryIntegrationTestSuite) TestAttachTo() {
 	created, err := s.LabelRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
 
+	s.Require().NoError(s.LabelRepo.AttachTo(context.Background(), created.ID, s.testDoc.ID))
 	s.Require().NoError(s.LabelRepo.AttachTo(context.Background(), created.ID, s.testDoc.ID))
 
 	document, err := s.DocumentRepo.Get(context.Background(), s.testDoc.ID, repository.DocumentDetailProjection())
@@ -153,6 +181,11 @@ func (s *LabelRepositoryIntegrationTestSuite) TestDelete() {
 	s.Assert().ErrorIs(err, repository.ErrNotFound)
 }
 
+func (s *LabelRepositoryIntegrationTestSuite) TestCreateInvalidScope() {
+	_, err := s.LabelRepo.Create(context.Background(), testModel.NewCreateLabelOpts(s.testDoc.ID))
+	s.Assert().ErrorIs(err, model.ErrInvalidID)
+}
+
 func TestLabelRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(LabelRepositoryIntegrationTestSuite))
 }
@@ -191,7 +224,7 @@ func (s *CachedLabelRepositoryIntegrationTestSuite) SetupTest() {
 	s.testDoc, err = s.DocumentRepo.Create(context.Background(), testModel.NewCreateDocumentOpts(s.testOrg.ID, s.testUser.ID))
 	s.Require().NoError(err)
 
-	s.createOpts = testModel.NewCreateLabelOpts()
+	s.createOpts = testModel.NewCreateLabelOpts(s.testOrg.ID)
 	s.Require().Len(s.Keys(&s.ContainerIntegrationTestSuite, "*"), 0)
 }
 
@@ -234,7 +267,7 @@ func (s *CachedLabelRepositoryIntegrationTestSuite) TestGet() {
 func (s *CachedLabelRepositoryIntegrationTestSuite) TestGetAll() {
 	_, err := s.labelRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
-	_, err = s.labelRepo.Create(context.Background(), testModel.NewCreateLabelOpts())
+	_, err = s.labelRepo.Create(context.Background(), testModel.NewCreateLabelOpts(s.testOrg.ID))
 	s.Require().NoError(err)
 
 	original, err := s.LabelRepo.List(context.Background(), repository.CursorPage{Size: 10}, repository.LabelListProjection())
@@ -128,6 +128,21 @@ func (mr *MockLabelRepositoryMockRecorder) List(ctx, page, proj any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLabelRepository)(nil).List), ctx, page, proj)
 }
 
+// ListForScope mocks base method.
+func (m *MockLabelRepository) ListForScope(ctx context.Context, scope model.ID, page CursorPage, proj LabelProjection) (Page[*Label], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForScope", ctx, scope, page, proj)
+	ret0, _ := ret[0].(Page[*Label])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForScope indicates an expected call of ListForScope.
+func (mr *MockLabelRepositoryMockRecorder) ListForScope(ctx, scope, page, proj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForScope", reflect.TypeOf((*MockLabelRepository)(nil).ListForScope), ctx, scope, page, proj)
+}
+
 // Update mocks base method.
 func (m *MockLabelRepository) Update(ctx context.Context, id model.ID, opts UpdateLabelOpts) (*Label, error) {
 	m.ctrl.T.Helper()
@@ -22,7 +22,10 @@ type LabelGetQuery struct {
 	Projection LabelProjection
 }
 
+// LabelListQuery lists labels. When Scope is set, only labels defined in the
+// scope or in one of its ancestors are listed.
 type LabelListQuery struct {
+	Scope      *model.ID
 	Page       CursorPage
 	Order      SortDirection
 	Projection LabelProjection
@@ -35,8 +38,11 @@ func (q LabelGetQuery) Compile() (QueryPlan, error) {
 
 	plan := QueryPlan{
 		Root: CompiledQuery{
-			Name:   "label.get",
-			Cypher: `MATCH (l:` + q.ID.Label() + ` {id: $id}) RETURN l`,
+			Name: "label.get",
+			Cypher: `
+				MATCH (l:` + q.ID.Label() + ` {id: $id})
+				OPTIONAL MATCH (l)-[:` + EdgeKindInScopeOf.String() + `]->(s)
+				RETURN l, s`,
 			Params: map[string]any{"id": q.ID.String()},
 		},
 	}
@@ -55,16 +61,30 @@ func (q LabelListQuery) Compile() (QueryPlan, error) {
 		return QueryPlan{}, err
 	}
 
+	name := "label.list"
+	match := `MATCH (l:` + model.ResourceTypeLabel.String() + `)`
+	if q.Scope != nil {
+		if err := q.Scope.Validate(); err != nil {
+			return QueryPlan{}, err
+		}
+		params["scope_id"] = q.Scope.String()
+		name = "label.list_for_scope"
+		match = `
+				MATCH (:` + q.Scope.Label() + ` {id: $scope_id})-[:` + EdgeKindInScopeOf.String() + `*0..4]->(a)
+				MATCH (l:` + model.ResourceTypeLabel.String() + `)-[:` + EdgeKindInScopeOf.String() + `]->(a)`
+	}
+
 	plan := QueryPlan{
 		Root: CompiledQuery{
-			Name: "label.list",
+			Name: name,
 			Cypher: strings.TrimSpace(`
-				MATCH (l:` + model.ResourceTypeLabel.String() + `)
+				` + match + `
 				` + cursorWherePrefix(bounds.Where, "WHERE ") + `
-				WITH l
+				WITH DISTINCT l
 				ORDER BY l.id ` + bounds.Order.Cypher() + `
 				LIMIT $limit
-				RETURN l`,
+				OPTIONAL MATCH (l)-[:` + EdgeKindInScopeOf.String() + `]->(s)
+				RETURN l, s`,
 			),
 			Params: params,
 		},
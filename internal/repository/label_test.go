@@ -843,6 +843,138 @@ func TestCachedLabelRepository_GetAll(t *testing.T) {
 	}
 }
 
+func TestCachedLabelRepository_ListForScope(t *testing.T) {
+	type fields struct {
+		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, scope model.ID, limit int, labels []*Label) *redisBaseRepository
+		labelRepo func(ctrl *gomock.Controller, ctx context.Context, scope model.ID, limit int, labels []*Label) LabelRepository
+	}
+	type args struct {
+		ctx   context.Context
+		scope model.ID
+		limit int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*Label
+		wantErr error
+	}{
+		{
+			name: "get uncached labels",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, scope model.ID, limit int, labels []*Label) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeLabel.String(), "List", scope.String(), projectionCacheValue(LabelListProjection()), "", limit)
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(2)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Return(cache.ErrCacheMiss)
+					cacheRepo.EXPECT().Set(&cache.Item{
+						Ctx:   ctx,
+						Key:   key,
+						Value: Page[*Label]{Items: labels},
+					}).Return(nil)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				labelRepo: func(ctrl *gomock.Controller, ctx context.Context, scope model.ID, limit int, labels []*Label) LabelRepository {
+					repo := NewMockLabelRepository(ctrl)
+					repo.EXPECT().ListForScope(ctx, scope, CursorPage{Size: limit}, LabelListProjection()).Return(Page[*Label]{Items: labels}, nil)
+					return repo
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				scope: model.MustNewID(model.ResourceTypeProject),
+				limit: 10,
+			},
+			want: []*Label{
+				{
+					ID:   model.MustNewID(model.ResourceTypeLabel),
+					Name: "test label",
+				},
+			},
+		},
+		{
+			name: "get cached labels",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, scope model.ID, limit int, labels []*Label) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeLabel.String(), "List", scope.String(), projectionCacheValue(LabelListProjection()), "", limit)
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(1)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Do(func(_ context.Context, _ string, dst any) {
+						if ptr, ok := dst.(*Page[*Label]); ok {
+							*ptr = Page[*Label]{Items: labels}
+						}
+					}).Return(nil)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				labelRepo: func(_ *gomock.Controller, _ context.Context, _ model.ID, _ int, _ []*Label) LabelRepository {
+					return NewMockLabelRepository(nil)
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				scope: model.MustNewID(model.ResourceTypeOrganization),
+				limit: 10,
+			},
+			want: []*Label{
+				{
+					ID:   model.MustNewID(model.ResourceTypeLabel),
+					Name: "test label",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			r := &RedisCachedLabelRepository{
+				cacheRepo: tt.fields.cacheRepo(ctrl, tt.args.ctx, tt.args.scope, testPageSize(tt.args.limit), tt.want),
+				labelRepo: tt.fields.labelRepo(ctrl, tt.args.ctx, tt.args.scope, testPageSize(tt.args.limit), tt.want),
+			}
+			got, err := r.ListForScope(tt.args.ctx, tt.args.scope, CursorPage{Size: testPageSize(tt.args.limit)}, LabelListProjection())
+			require.ErrorIs(t, err, tt.wantErr)
+			require.ElementsMatch(t, tt.want, got.Items)
+		})
+	}
+}
+
 func TestCachedLabelRepository_Update(t *testing.T) {
 	type fields struct {
 		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, id model.ID, label *Label) *redisBaseRepository
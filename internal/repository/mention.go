@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+var (
+	ErrMentionCreate = errors.New("failed to create mention")  // the mention could not be created
+	ErrMentionDelete = errors.New("failed to delete mentions") // the mentions could not be deleted
+	ErrMentionRead   = errors.New("failed to read mentions")   // the mentions could not be retrieved
+)
+
+//go:generate go tool mockgen -source=mention.go -destination=mention_mock_gen.go -package=repository -mock_names "MentionRepository=MockMentionRepository"
+type MentionRepository interface {
+	// Create records that the recipients were notified of being mentioned in
+	// the source. Recipients already recorded for the source are skipped.
+	Create(ctx context.Context, source model.ID, recipients []model.ID) error
+	// ListRecipients returns the users already notified of being mentioned in
+	// the source.
+	ListRecipients(ctx context.Context, source model.ID) ([]model.ID, error)
+	// DeleteBySources deletes the mentions recorded for the sources.
+	DeleteBySources(ctx context.Context, sources []model.ID) error
+}
+
+// PGMentionRepository is a repository for managing the users notified of
+// being mentioned in issues, documents and comments.
+type PGMentionRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGMentionRepository) Create(ctx context.Context, source model.ID, recipients []model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.MentionRepository/Create")
+	defer span.End()
+
+	createdAt := time.Now().UTC().Round(time.Microsecond)
+
+	batch := &pgx.Batch{}
+	for _, recipient := range recipients {
+		batch.Queue(
+			"INSERT INTO mentions (source, recipient, created_at) VALUES ($1, $2, $3) ON CONFLICT (source, recipient) DO NOTHING",
+			source, recipient, createdAt,
+		)
+	}
+
+	if batch.Len() == 0 {
+		return nil
+	}
+
+	if err := r.db.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return errors.Join(ErrMentionCreate, err)
+	}
+
+	return nil
+}
+
+func (r *PGMentionRepository) ListRecipients(ctx context.Context, source model.ID) ([]model.ID, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.MentionRepository/ListRecipients")
+	defer span.End()
+
+	rows, err := r.db.pool.Query(ctx,
+		"SELECT recipient FROM mentions WHERE source = $1 ORDER BY created_at ASC, recipient ASC",
+		source,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrMentionRead, err)
+	}
+	defer rows.Close()
+
+	recipients := make([]model.ID, 0)
+	for rows.Next() {
+		var recipient model.ID
+		if err := rows.Scan(&recipient); err != nil {
+			return nil, errors.Join(ErrMentionRead, err)
+		}
+		recipients = append(recipients, recipient)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrMentionRead, err)
+	}
+
+	return recipients, nil
+}
+
+func (r *PGMentionRepository) DeleteBySources(ctx context.Context, sources []model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.MentionRepository/DeleteBySources")
+	defer span.End()
+
+	if len(sources) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(sources))
+	for i, source := range sources {
+		ids[i] = source.Composite()
+	}
+
+	if _, err := r.db.pool.Exec(ctx, "DELETE FROM mentions WHERE source = ANY($1)", ids); err != nil {
+		return errors.Join(ErrMentionDelete, err)
+	}
+
+	return nil
+}
+
+// NewMentionRepository creates a new MentionRepository.
+func NewMentionRepository(opts ...PGRepositoryOption) (*PGMentionRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGMentionRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type MentionRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	source model.ID
+}
+
+func (s *MentionRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *MentionRepositoryIntegrationTestSuite) SetupTest() {
+	s.source = model.MustNewID(model.ResourceTypeIssue)
+}
+
+func (s *MentionRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *MentionRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *MentionRepositoryIntegrationTestSuite) TestCreateAndListRecipients() {
+	jane := model.MustNewID(model.ResourceTypeUser)
+	john := model.MustNewID(model.ResourceTypeUser)
+
+	s.Require().NoError(s.MentionRepo.Create(context.Background(), s.source, []model.ID{jane}))
+	s.Require().NoError(s.MentionRepo.Create(context.Background(), s.source, []model.ID{jane, john}))
+	s.Require().NoError(s.MentionRepo.Create(context.Background(), model.MustNewID(model.ResourceTypeComment), []model.ID{john}))
+	s.Require().NoError(s.MentionRepo.Create(context.Background(), s.source, nil))
+
+	recipients, err := s.MentionRepo.ListRecipients(context.Background(), s.source)
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]model.ID{jane, john}, recipients)
+
+	recipients, err = s.MentionRepo.ListRecipients(context.Background(), model.MustNewID(model.ResourceTypeDocument))
+	s.Require().NoError(err)
+	s.Assert().Empty(recipients)
+}
+
+func (s *MentionRepositoryIntegrationTestSuite) TestDeleteBySources() {
+	other := model.MustNewID(model.ResourceTypeComment)
+	jane := model.MustNewID(model.ResourceTypeUser)
+
+	s.Require().NoError(s.MentionRepo.Create(context.Background(), s.source, []model.ID{jane}))
+	s.Require().NoError(s.MentionRepo.Create(context.Background(), other, []model.ID{jane}))
+
+	s.Require().NoError(s.MentionRepo.DeleteBySources(context.Background(), []model.ID{s.source}))
+	s.Require().NoError(s.MentionRepo.DeleteBySources(context.Background(), nil))
+
+	recipients, err := s.MentionRepo.ListRecipients(context.Background(), s.source)
+	s.Require().NoError(err)
+	s.Assert().Empty(recipients)
+
+	recipients, err = s.MentionRepo.ListRecipients(context.Background(), other)
+	s.Require().NoError(err)
+	s.Assert().Equal([]model.ID{jane}, recipients)
+}
+
+func TestMentionRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(MentionRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mention.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mention.go -destination=mention_mock_gen.go -package=repository -mock_names MentionRepository=MockMentionRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMentionRepository is a mock of MentionRepository interface.
+type MockMentionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMentionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMentionRepositoryMockRecorder is the mock recorder for MockMentionRepository.
+type MockMentionRepositoryMockRecorder struct {
+	mock *MockMentionRepository
+}
+
+// NewMockMentionRepository creates a new mock instance.
+func NewMockMentionRepository(ctrl *gomock.Controller) *MockMentionRepository {
+	mock := &MockMentionRepository{ctrl: ctrl}
+	mock.recorder = &MockMentionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMentionRepository) EXPECT() *MockMentionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMentionRepository) Create(ctx context.Context, source model.ID, recipients []model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, source, recipients)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMentionRepositoryMockRecorder) Create(ctx, source, recipients any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMentionRepository)(nil).Create), ctx, source, recipients)
+}
+
+// DeleteBySources mocks base method.
+func (m *MockMentionRepository) DeleteBySources(ctx context.Context, sources []model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBySources", ctx, sources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBySources indicates an expected call of DeleteBySources.
+func (mr *MockMentionRepositoryMockRecorder) DeleteBySources(ctx, sources any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBySources", reflect.TypeOf((*MockMentionRepository)(nil).DeleteBySources), ctx, sources)
+}
+
+// ListRecipients mocks base method.
+func (m *MockMentionRepository) ListRecipients(ctx context.Context, source model.ID) ([]model.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecipients", ctx, source)
+	ret0, _ := ret[0].([]model.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecipients indicates an expected call of ListRecipients.
+func (mr *MockMentionRepositoryMockRecorder) ListRecipients(ctx, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecipients", reflect.TypeOf((*MockMentionRepository)(nil).ListRecipients), ctx, source)
+}
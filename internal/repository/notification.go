@@ -19,22 +19,32 @@ var (
 	ErrNotificationUpdate = errors.New("failed to update notification") // the notification could not be updates
 )
 
-// Notification represents a notification persisted by the repository.
+// Notification represents a notification persisted by the repository. The
+// Event, Actor, Resource and ChangedFields fields hold the structured payload
+// of the event that triggered the notification.
 type Notification struct {
-	ID          model.ID   `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Recipient   model.ID   `json:"recipient"`
-	Read        bool       `json:"read"`
-	CreatedAt   *time.Time `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+	ID            model.ID   `json:"id"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	Recipient     model.ID   `json:"recipient"`
+	Read          bool       `json:"read"`
+	CreatedAt     *time.Time `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+	Event         string     `json:"event"`
+	Actor         *model.ID  `json:"actor"`
+	Resource      *model.ID  `json:"resource"`
+	ChangedFields []string   `json:"changed_fields"`
 }
 
 // CreateNotificationOpts holds the data required to create a notification.
 type CreateNotificationOpts struct {
-	Title       string
-	Description string
-	Recipient   model.ID
+	Title         string
+	Description   string
+	Recipient     model.ID
+	Event         string
+	Actor         *model.ID
+	Resource      *model.ID
+	ChangedFields []string
 }
 
 // UpdateNotificationOpts holds the fields that can be updated on a notification.
@@ -60,20 +70,30 @@ func (r *PGNotificationRepository) Create(ctx context.Context, opts CreateNotifi
 	ctx, span := r.tracer.Start(ctx, "repository.pg.NotificationRepository/Create")
 	defer span.End()
 
+	changedFields := opts.ChangedFields
+	if changedFields == nil {
+		changedFields = make([]string, 0)
+	}
+
 	notification := &Notification{
-		ID:          model.MustNewID(model.ResourceTypeNotification),
-		Title:       opts.Title,
-		Description: opts.Description,
-		Recipient:   opts.Recipient,
-		Read:        false,
-		CreatedAt:   convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
-		UpdatedAt:   nil,
+		ID:            model.MustNewID(model.ResourceTypeNotification),
+		Title:         opts.Title,
+		Description:   opts.Description,
+		Recipient:     opts.Recipient,
+		Read:          false,
+		CreatedAt:     convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+		UpdatedAt:     nil,
+		Event:         opts.Event,
+		Actor:         opts.Actor,
+		Resource:      opts.Resource,
+		ChangedFields: changedFields,
 	}
 
 	_, err := r.db.pool.Exec(ctx,
-		"INSERT INTO notifications (id, title, description, recipient, read, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		"INSERT INTO notifications (id, title, description, recipient, read, created_at, event, actor, resource, changed_fields) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
 		notification.ID, notification.Title, notification.Description, notification.Recipient,
-		notification.Read, *notification.CreatedAt,
+		notification.Read, *notification.CreatedAt, notification.Event, notification.Actor,
+		notification.Resource, notification.ChangedFields,
 	)
 	if err != nil {
 		return nil, errors.Join(ErrNotificationCreate, err)
@@ -156,7 +176,10 @@ func (r *PGNotificationRepository) Update(ctx context.Context, id, recipient mod
 		"UPDATE notifications SET read = $3, updated_at = timezone('utc', now()) WHERE id = $1 AND recipient = $2 RETURNING *",
 		id, recipient, opts.Read,
 	)
-	if err := row.Scan(&n.ID, &n.Title, &n.Description, &n.Recipient, &n.Read, &n.CreatedAt, &n.UpdatedAt); err != nil {
+	if err := row.Scan(
+		&n.ID, &n.Title, &n.Description, &n.Recipient, &n.Read, &n.CreatedAt, &n.UpdatedAt,
+		&n.Event, &n.Actor, &n.Resource, &n.ChangedFields,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
 		}
@@ -325,7 +348,10 @@ func NewCachedNotificationRepository(repo NotificationRepository, opts ...RedisR
 
 func scanNotificationRow(row pgx.Row) (*Notification, error) {
 	var n Notification
-	if err := row.Scan(&n.ID, &n.Title, &n.Description, &n.Recipient, &n.Read, &n.CreatedAt, &n.UpdatedAt); err != nil {
+	if err := row.Scan(
+		&n.ID, &n.Title, &n.Description, &n.Recipient, &n.Read, &n.CreatedAt, &n.UpdatedAt,
+		&n.Event, &n.Actor, &n.Resource, &n.ChangedFields,
+	); err != nil {
 		return nil, err
 	}
 
@@ -334,7 +360,10 @@ func scanNotificationRow(row pgx.Row) (*Notification, error) {
 
 func scanNotificationRows(rows pgx.Rows) (*Notification, error) {
 	var n Notification
-	if err := rows.Scan(&n.ID, &n.Title, &n.Description, &n.Recipient, &n.Read, &n.CreatedAt, &n.UpdatedAt); err != nil {
+	if err := rows.Scan(
+		&n.ID, &n.Title, &n.Description, &n.Recipient, &n.Read, &n.CreatedAt, &n.UpdatedAt,
+		&n.Event, &n.Actor, &n.Resource, &n.ChangedFields,
+	); err != nil {
 		return nil, err
 	}
 
@@ -67,6 +67,27 @@ func (s *NotificationRepositoryIntegrationTestSuite) TestGet() {
 	s.Assert().WithinDuration(*created.CreatedAt, *notification.CreatedAt, 100*time.Millisecond)
 }
 
+func (s *NotificationRepositoryIntegrationTestSuite) TestGetWithEventPayload() {
+	actor := s.testUser.ID
+	resource := model.MustNewID(model.ResourceTypeIssue)
+
+	s.createOpts.Event = "notification:issue_updated"
+	s.createOpts.Actor = &actor
+	s.createOpts.Resource = &resource
+	s.createOpts.ChangedFields = []string{"status", "priority"}
+
+	created, err := s.NotificationRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	notification, err := s.NotificationRepo.Get(context.Background(), created.ID, created.Recipient, repository.NotificationDetailProjection())
+	s.Require().NoError(err)
+
+	s.Assert().Equal(s.createOpts.Event, notification.Event)
+	s.Assert().Equal(&actor, notification.Actor)
+	s.Assert().Equal(&resource, notification.Resource)
+	s.Assert().Equal(s.createOpts.ChangedFields, notification.ChangedFields)
+}
+
 func (s *NotificationRepositoryIntegrationTestSuite) TestGetAllByRecipient() {
 	_, err := s.NotificationRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
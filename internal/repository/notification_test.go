@@ -99,9 +99,10 @@ func TestNotificationRepository_Create(t *testing.T) {
 					require.NoError(t, err)
 
 					mockDBPool.EXPECT().Exec(ctx,
-						"INSERT INTO notifications (id, title, description, recipient, read, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+						"INSERT INTO notifications (id, title, description, recipient, read, created_at, event, actor, resource, changed_fields) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
 						gomock.Any(), opts.Title, opts.Description, opts.Recipient,
-						false, gomock.Any(),
+						false, gomock.Any(), opts.Event, opts.Actor,
+						opts.Resource, gomock.Any(),
 					).Return(pgconn.CommandTag{}, nil)
 
 					return &pgBaseRepository{
@@ -120,6 +121,47 @@ func TestNotificationRepository_Create(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "create new notification with event payload",
+			fields: fields{
+				pgBaseRepository: func(ctx context.Context, ctrl *gomock.Controller, opts CreateNotificationOpts) *pgBaseRepository {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End().Return()
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.pg.NotificationRepository/Create").Return(ctx, span)
+
+					mockDBPool := mock.NewPGPool(ctrl)
+					mockDB, err := NewPGDatabase(WithDatabasePool(mockDBPool))
+					require.NoError(t, err)
+
+					mockDBPool.EXPECT().Exec(ctx,
+						"INSERT INTO notifications (id, title, description, recipient, read, created_at, event, actor, resource, changed_fields) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+						gomock.Any(), opts.Title, opts.Description, opts.Recipient,
+						false, gomock.Any(), opts.Event, opts.Actor,
+						opts.Resource, opts.ChangedFields,
+					).Return(pgconn.CommandTag{}, nil)
+
+					return &pgBaseRepository{
+						db:     mockDB,
+						logger: mock.NewMockLogger(nil),
+						tracer: tracer,
+					}
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				opts: CreateNotificationOpts{
+					Title:         "test notification",
+					Description:   "test description",
+					Recipient:     model.MustNewNilID(model.ResourceTypeUser),
+					Event:         "notification:issue_updated",
+					Actor:         convert.ToPointer(model.MustNewNilID(model.ResourceTypeUser)),
+					Resource:      convert.ToPointer(model.MustNewNilID(model.ResourceTypeIssue)),
+					ChangedFields: []string{"status", "priority"},
+				},
+			},
+		},
 		{
 			name: "create new notification with error",
 			fields: fields{
@@ -135,9 +177,10 @@ func TestNotificationRepository_Create(t *testing.T) {
 					require.NoError(t, err)
 
 					mockDBPool.EXPECT().Exec(ctx,
-						"INSERT INTO notifications (id, title, description, recipient, read, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+						"INSERT INTO notifications (id, title, description, recipient, read, created_at, event, actor, resource, changed_fields) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
 						gomock.Any(), opts.Title, opts.Description, opts.Recipient,
-						false, gomock.Any(),
+						false, gomock.Any(), opts.Event, opts.Actor,
+						opts.Resource, gomock.Any(),
 					).Return(pgconn.CommandTag{}, assert.AnError)
 
 					return &pgBaseRepository{
@@ -176,6 +219,10 @@ func TestNotificationRepository_Create(t *testing.T) {
 				assert.Equal(t, tt.args.opts.Recipient, got.Recipient)
 				assert.False(t, got.Read)
 				assert.NotNil(t, got.CreatedAt)
+				assert.Equal(t, tt.args.opts.Event, got.Event)
+				assert.Equal(t, tt.args.opts.Actor, got.Actor)
+				assert.Equal(t, tt.args.opts.Resource, got.Resource)
+				assert.NotNil(t, got.ChangedFields)
 			}
 		})
 	}
@@ -216,7 +263,7 @@ func TestNotificationRepository_Get(t *testing.T) {
 
 					mockRow := mock.NewPGRow(ctrl)
 					mockRow.EXPECT().
-						Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						DoAndReturn(func(dest ...any) error {
 							*(dest[0].(*model.ID)) = notification.ID
 							*(dest[1].(*string)) = notification.Title
@@ -225,6 +272,10 @@ func TestNotificationRepository_Get(t *testing.T) {
 							*(dest[4].(*bool)) = notification.Read
 							*(dest[5].(**time.Time)) = notification.CreatedAt
 							*(dest[6].(**time.Time)) = notification.UpdatedAt
+							*(dest[7].(*string)) = notification.Event
+							*(dest[8].(**model.ID)) = notification.Actor
+							*(dest[9].(**model.ID)) = notification.Resource
+							*(dest[10].(*[]string)) = notification.ChangedFields
 							return nil
 						})
 
@@ -387,7 +438,7 @@ func TestNotificationRepository_ListByRecipient(t *testing.T) {
 
 					for _, notification := range notifications[offset:] {
 						mockRows.EXPECT().
-							Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+							Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 							DoAndReturn(func(dest ...any) error {
 								*(dest[0].(*model.ID)) = notification.ID
 								*(dest[1].(*string)) = notification.Title
@@ -396,6 +447,10 @@ func TestNotificationRepository_ListByRecipient(t *testing.T) {
 								*(dest[4].(*bool)) = notification.Read
 								*(dest[5].(**time.Time)) = notification.CreatedAt
 								*(dest[6].(**time.Time)) = notification.UpdatedAt
+								*(dest[7].(*string)) = notification.Event
+								*(dest[8].(**model.ID)) = notification.Actor
+								*(dest[9].(**model.ID)) = notification.Resource
+								*(dest[10].(*[]string)) = notification.ChangedFields
 								return nil
 							}).
 							Times(1)
@@ -570,7 +625,7 @@ func TestNotificationRepository_Update(t *testing.T) {
 
 					mockRow := mock.NewPGRow(ctrl)
 					mockRow.EXPECT().
-						Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Scan(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 						DoAndReturn(func(dest ...any) error {
 							*(dest[0].(*model.ID)) = notification.ID
 							*(dest[1].(*string)) = notification.Title
@@ -579,6 +634,10 @@ func TestNotificationRepository_Update(t *testing.T) {
 							*(dest[4].(*bool)) = notification.Read
 							*(dest[5].(**time.Time)) = notification.CreatedAt
 							*(dest[6].(**time.Time)) = notification.UpdatedAt
+							*(dest[7].(*string)) = notification.Event
+							*(dest[8].(**model.ID)) = notification.Actor
+							*(dest[9].(**model.ID)) = notification.Resource
+							*(dest[10].(*[]string)) = notification.ChangedFields
 							return nil
 						})
 
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
@@ -87,6 +88,7 @@ type PermissionRepository interface {
 	Get(ctx context.Context, id model.ID) (*Grant, error)
 	ListByPrincipal(ctx context.Context, principal model.ID) ([]*Grant, error)
 	ListByScope(ctx context.Context, scope model.ID) ([]*Grant, error)
+	ListByScopes(ctx context.Context, scopes []model.ID) ([]*Grant, error)
 	Delete(ctx context.Context, id model.ID) error
 	Has(ctx context.Context, actor, resource model.ID, action model.Action) (bool, error)
 	EffectiveActions(ctx context.Context, actor, resource model.ID) ([]model.Action, error)
@@ -279,6 +281,44 @@ func (r *Neo4jPermissionRepository) ListByScope(ctx context.Context, scope model
 	return grants, nil
 }
 
+// ListByScopes returns grants whose scope is any of the given resources.
+func (r *Neo4jPermissionRepository) ListByScopes(ctx context.Context, scopes []model.ID) ([]*Grant, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.PermissionRepository/ListByScopes")
+	defer span.End()
+
+	if len(scopes) == 0 {
+		return []*Grant{}, nil
+	}
+
+	scopeIDs := make([]string, len(scopes))
+	labels := make([]string, 0, 1)
+	for i, scope := range scopes {
+		if err := scope.Validate(); err != nil {
+			return nil, errors.Join(ErrPermissionRead, err)
+		}
+		scopeIDs[i] = scope.String()
+		if label := "scope:" + scope.Label(); !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+
+	cypher := `
+	MATCH (principal)-[g:` + EdgeKindGranted.String() + `]->(scope)
+	WHERE (` + strings.Join(labels, " OR ") + `) AND scope.id IN $scope_ids
+	RETURN principal, g, scope`
+
+	grants, err := Neo4jExecuteReadAndReadAll(ctx, r.db, cypher, map[string]any{
+		"scope_ids": scopeIDs,
+	}, r.scanGrant())
+	if err != nil {
+		return nil, errors.Join(ErrPermissionRead, err)
+	}
+	if grants == nil {
+		grants = []*Grant{}
+	}
+	return grants, nil
+}
+
 // Delete removes a grant by ID.
 func (r *Neo4jPermissionRepository) Delete(ctx context.Context, id model.ID) error {
 	ctx, span := r.tracer.Start(ctx, "repository.neo4j.PermissionRepository/Delete")
@@ -851,6 +891,10 @@ func (c *RedisCachedPermissionRepository) ListByScope(ctx context.Context, scope
 	return c.permissionRepo.ListByScope(ctx, scope)
 }
 
+func (c *RedisCachedPermissionRepository) ListByScopes(ctx context.Context, scopes []model.ID) ([]*Grant, error) {
+	return c.permissionRepo.ListByScopes(ctx, scopes)
+}
+
 func (c *RedisCachedPermissionRepository) Delete(ctx context.Context, id model.ID) error {
 	grant, getErr := c.permissionRepo.Get(ctx, id)
 	if err := c.permissionRepo.Delete(ctx, id); err != nil {
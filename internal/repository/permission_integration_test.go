@@ -322,6 +322,10 @@ func (s *PermissionRepositoryIntegrationTestSuite) TestGrantCRUD() {
 	s.Require().NoError(err)
 	s.Assert().Len(byScope, 2)
 
+	byScopes, err := s.PermissionRepo.ListByScopes(s.ctx, []model.ID{org.ID, otherOrg.ID})
+	s.Require().NoError(err)
+	s.Assert().Len(byScopes, 3)
+
 	s.Require().NoError(s.PermissionRepo.Delete(s.ctx, grant.ID))
 	s.Assert().False(s.has(actor.ID, org.ID, model.ActionOrganizationRead))
 	_, err = s.PermissionRepo.Get(s.ctx, grant.ID)
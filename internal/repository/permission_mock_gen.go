@@ -188,6 +188,21 @@ func (mr *MockPermissionRepositoryMockRecorder) ListByScope(ctx, scope any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByScope", reflect.TypeOf((*MockPermissionRepository)(nil).ListByScope), ctx, scope)
 }
 
+// ListByScopes mocks base method.
+func (m *MockPermissionRepository) ListByScopes(ctx context.Context, scopes []model.ID) ([]*Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByScopes", ctx, scopes)
+	ret0, _ := ret[0].([]*Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByScopes indicates an expected call of ListByScopes.
+func (mr *MockPermissionRepositoryMockRecorder) ListByScopes(ctx, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByScopes", reflect.TypeOf((*MockPermissionRepository)(nil).ListByScopes), ctx, scopes)
+}
+
 // ListGrantScopes mocks base method.
 func (m *MockPermissionRepository) ListGrantScopes(ctx context.Context, actor model.ID, action model.Action) ([]model.ID, error) {
 	m.ctrl.T.Helper()
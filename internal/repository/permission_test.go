@@ -344,6 +344,18 @@ func TestCachedPermissionRepository_Passthrough(t *testing.T) {
 		require.Equal(t, []*Grant{grant}, got)
 	})
 
+	t.Run("ListByScopes", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		inner := NewMockPermissionRepository(ctrl)
+		inner.EXPECT().ListByScopes(ctx, []model.ID{resource}).Return([]*Grant{grant}, nil)
+		r := &RedisCachedPermissionRepository{permissionRepo: inner}
+		got, err := r.ListByScopes(ctx, []model.ID{resource})
+		require.NoError(t, err)
+		require.Equal(t, []*Grant{grant}, got)
+	})
+
 	t.Run("Has", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
@@ -0,0 +1,511 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+var (
+	ErrReleaseAttach = errors.New("failed to attach release") // the release could not be attached
+	ErrReleaseCreate = errors.New("failed to create release") // the release could not be created
+	ErrReleaseDelete = errors.New("failed to delete release") // the release could not be deleted
+	ErrReleaseDetach = errors.New("failed to detach release") // the release could not be detached
+	ErrReleaseRead   = errors.New("failed to read release")   // the release could not be retrieved
+	ErrReleaseUpdate = errors.New("failed to update release") // the release could not be updated
+)
+
+// Release represents a project release persisted by the repository.
+type Release struct {
+	ID          model.ID            `json:"id"`
+	Project     model.ID            `json:"project"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Status      model.ReleaseStatus `json:"status"`
+	TargetDate  *time.Time          `json:"target_date"`
+	ReleasedAt  *time.Time          `json:"released_at"`
+	CreatedAt   *time.Time          `json:"created_at"`
+	UpdatedAt   *time.Time          `json:"updated_at"`
+}
+
+// CreateReleaseOpts holds the data required to create a release.
+type CreateReleaseOpts struct {
+	Project     model.ID
+	Name        string
+	Description string
+	TargetDate  *time.Time
+}
+
+// UpdateReleaseOpts holds the fields that can be updated on a release.
+// Undefined fields (Defined == false) are left unchanged, and a defined but
+// nil TargetDate removes the target date of the release.
+type UpdateReleaseOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Status      optional.Optional[model.ReleaseStatus]
+	TargetDate  optional.Optional[time.Time]
+}
+
+// patch builds a Neo4j property map from defined optional fields.
+func (o UpdateReleaseOpts) patch() map[string]any {
+	p := make(map[string]any)
+
+	if o.Name.Defined {
+		p["name"] = *o.Name.Value
+	}
+	if o.Description.Defined {
+		p["description"] = *o.Description.Value
+	}
+	if o.Status.Defined {
+		p["status"] = o.Status.Value.String()
+	}
+
+	return p
+}
+
+//go:generate go tool mockgen -source=release.go -destination=release_mock_gen.go -package=repository -mock_names "ReleaseRepository=MockReleaseRepository"
+type ReleaseRepository interface {
+	Create(ctx context.Context, opts CreateReleaseOpts) (*Release, error)
+	Get(ctx context.Context, id model.ID, proj ReleaseProjection) (*Release, error)
+	ListForProject(ctx context.Context, project model.ID, page CursorPage, proj ReleaseProjection) (Page[*Release], error)
+	ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error)
+	Update(ctx context.Context, id model.ID, opts UpdateReleaseOpts) (*Release, error)
+	AttachTo(ctx context.Context, releaseID, issueID model.ID) error
+	DetachFrom(ctx context.Context, releaseID, issueID model.ID) error
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// Neo4jReleaseRepository is a repository for managing project releases.
+type Neo4jReleaseRepository struct {
+	*neo4jBaseRepository
+}
+
+func (r *Neo4jReleaseRepository) scan(rp, pp string) func(rec *neo4j.Record) (*Release, error) {
+	return func(rec *neo4j.Record) (*Release, error) {
+		release := new(Release)
+
+		node, err := Neo4jRecordNode(rec, rp)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := Neo4jScanIntoStruct(&node, &release, []string{"id", "project"}); err != nil {
+			return nil, err
+		}
+
+		if release.ID, err = Neo4jDecodeID(node, model.ResourceTypeRelease); err != nil {
+			return nil, err
+		}
+
+		projectID, err := Neo4jParseValueFromRecord[string](rec, pp)
+		if err != nil {
+			return nil, err
+		}
+		if release.Project, err = model.NewIDFromString(projectID, model.ResourceTypeProject.String()); err != nil {
+			return nil, errors.Join(ErrMalformedResult, err)
+		}
+
+		return release, nil
+	}
+}
+
+func (r *Neo4jReleaseRepository) Create(ctx context.Context, opts CreateReleaseOpts) (*Release, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/Create")
+	defer span.End()
+
+	if err := opts.Project.Validate(); err != nil || opts.Project.Type != model.ResourceTypeProject {
+		return nil, errors.Join(ErrReleaseCreate, model.ErrInvalidID)
+	}
+
+	createdAt := time.Now().UTC()
+	id := model.MustNewID(model.ResourceTypeRelease)
+
+	params := map[string]any{
+		"id":          id.String(),
+		"project_id":  opts.Project.String(),
+		"rel_id":      model.NewRawID(),
+		"name":        opts.Name,
+		"description": opts.Description,
+		"status":      model.ReleaseStatusPlanned.String(),
+		"target_date": nil,
+		"created_at":  createdAt.Format(time.RFC3339Nano),
+	}
+
+	if opts.TargetDate != nil {
+		params["target_date"] = opts.TargetDate.Format(time.RFC3339Nano)
+	}
+
+	cypher := `
+	MATCH (p:` + opts.Project.Label() + ` {id: $project_id})
+	CREATE
+		(r:` + id.Label() + ` {
+			id: $id, name: $name, description: $description, status: $status,
+			target_date: datetime($target_date), created_at: datetime($created_at)
+		}),
+		(r)-[:` + EdgeKindBelongsTo.String() + ` {id: $rel_id, created_at: datetime($created_at)}]->(p)
+	RETURN r.id AS id`
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrReleaseCreate, err)
+	}
+
+	return r.Get(ctx, id, ReleaseDetailProjection())
+}
+
+func (r *Neo4jReleaseRepository) Get(ctx context.Context, id model.ID, proj ReleaseProjection) (*Release, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/Get")
+	defer span.End()
+
+	plan, err := CompileQuery(ReleaseGetQuery{
+		ID:         id,
+		Projection: proj,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrReleaseRead, err)
+	}
+
+	var release *Release
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		release, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("r", "project_id"))
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrReleaseRead, err)
+	}
+
+	return release, nil
+}
+
+func (r *Neo4jReleaseRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj ReleaseProjection) (Page[*Release], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/ListForProject")
+	defer span.End()
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Release]{}, errors.Join(ErrReleaseRead, err)
+	}
+	plan, err := CompileQuery(ReleaseListForProjectQuery{
+		ProjectID:  project,
+		Page:       normalized,
+		Order:      SortDirectionDesc,
+		Projection: proj,
+	})
+	if err != nil {
+		return Page[*Release]{}, errors.Join(ErrReleaseRead, err)
+	}
+
+	items := make([]*Release, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("r", "project_id"))
+		return runErr
+	})
+	if err != nil {
+		return Page[*Release]{}, errors.Join(ErrReleaseRead, err)
+	}
+
+	return PaginateSlice(items, normalized.Size, func(release *Release) model.ID {
+		return release.ID
+	})
+}
+
+// ListIssues returns the first MaxPageSize issues that have the release as
+// fix version, ordered by their numeric ID.
+func (r *Neo4jReleaseRepository) ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/ListIssues")
+	defer span.End()
+
+	plan, err := CompileQuery(ReleaseIssuesQuery{
+		ID:    id,
+		Limit: MaxPageSize,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrReleaseRead, err)
+	}
+
+	issues := make([]*PartialIssue, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		issues, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, func(rec *neo4j.Record) (*PartialIssue, error) {
+			node, err := Neo4jRecordNode(rec, "i")
+			if err != nil {
+				return nil, err
+			}
+			projectKey, err := Neo4jParseValueFromRecord[string](rec, "project_key")
+			if err != nil {
+				return nil, err
+			}
+			return decodePartialIssueNode(node, projectKey)
+		})
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrReleaseRead, err)
+	}
+
+	return issues, nil
+}
+
+func (r *Neo4jReleaseRepository) Update(ctx context.Context, id model.ID, opts UpdateReleaseOpts) (*Release, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/Update")
+	defer span.End()
+
+	params := map[string]any{
+		"id":    id.String(),
+		"patch": opts.patch(),
+	}
+
+	set := ""
+	if opts.TargetDate.Defined {
+		set += `, r.target_date = datetime($target_date)`
+		params["target_date"] = nil
+		if opts.TargetDate.Value != nil {
+			params["target_date"] = opts.TargetDate.Value.Format(time.RFC3339Nano)
+		}
+	}
+
+	// The release date is kept when a released release is archived, and
+	// cleared when it is planned again.
+	if opts.Status.Defined {
+		switch *opts.Status.Value {
+		case model.ReleaseStatusReleased:
+			set += `, r.released_at = coalesce(r.released_at, datetime())`
+		case model.ReleaseStatusPlanned:
+			set += `, r.released_at = null`
+		}
+	}
+
+	cypher := `
+	MATCH (r:` + id.Label() + ` {id: $id})
+	SET r += $patch, r.updated_at = datetime()` + set + `
+	RETURN r.id AS id`
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrReleaseUpdate, err)
+	}
+
+	return r.Get(ctx, id, ReleaseDetailProjection())
+}
+
+func (r *Neo4jReleaseRepository) AttachTo(ctx context.Context, releaseID, issueID model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/AttachTo")
+	defer span.End()
+
+	cypher := `
+	MATCH (r:` + releaseID.Label() + ` {id: $release_id})
+	MATCH (i:` + issueID.Label() + ` {id: $issue_id})
+	MERGE (i)-[f:` + EdgeKindHasFixVersion.String() + `]->(r)
+	ON CREATE SET f.id = $rel_id, f.created_at = datetime()`
+
+	params := map[string]any{
+		"release_id": releaseID.String(),
+		"issue_id":   issueID.String(),
+		"rel_id":     model.NewRawID(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrReleaseAttach, err)
+	}
+
+	return nil
+}
+
+func (r *Neo4jReleaseRepository) DetachFrom(ctx context.Context, releaseID, issueID model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/DetachFrom")
+	defer span.End()
+
+	cypher := `
+	MATCH (i:` + issueID.Label() + ` {id: $issue_id})-[f:` + EdgeKindHasFixVersion.String() + `]->(r:` + releaseID.Label() + ` {id: $release_id})
+	DELETE f`
+
+	params := map[string]any{
+		"release_id": releaseID.String(),
+		"issue_id":   issueID.String(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrReleaseDetach, err)
+	}
+
+	return nil
+}
+
+func (r *Neo4jReleaseRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ReleaseRepository/Delete")
+	defer span.End()
+
+	cypher := `MATCH (r:` + id.Label() + ` {id: $id}) DETACH DELETE r`
+	params := map[string]any{
+		"id": id.String(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrReleaseDelete, err)
+	}
+
+	return nil
+}
+
+// NewNeo4jReleaseRepository creates a new release neo4jBaseRepository.
+func NewNeo4jReleaseRepository(opts ...Neo4jRepositoryOption) (*Neo4jReleaseRepository, error) {
+	baseRepo, err := newNeo4jRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Neo4jReleaseRepository{
+		neo4jBaseRepository: baseRepo,
+	}, nil
+}
+
+func clearReleasesPattern(ctx context.Context, r *redisBaseRepository, pattern ...string) error {
+	return r.DeletePattern(ctx, composeCacheKey(model.ResourceTypeRelease.String(), pattern))
+}
+
+func clearReleasesKey(ctx context.Context, r *redisBaseRepository, id model.ID) error {
+	return clearReleasesPattern(ctx, r, "Get", id.String(), "*")
+}
+
+func clearReleaseAllLists(ctx context.Context, r *redisBaseRepository) error {
+	return clearReleasesPattern(ctx, r, "List", "*", "*", "*", "*")
+}
+
+// RedisCachedReleaseRepository implements caching on the ReleaseRepository.
+// The issues of a release change with every issue update, so they are not
+// cached.
+type RedisCachedReleaseRepository struct {
+	cacheRepo   *redisBaseRepository
+	releaseRepo ReleaseRepository
+}
+
+func (r *RedisCachedReleaseRepository) Create(ctx context.Context, opts CreateReleaseOpts) (*Release, error) {
+	if err := clearReleaseAllLists(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	return r.releaseRepo.Create(ctx, opts)
+}
+
+func (r *RedisCachedReleaseRepository) Get(ctx context.Context, id model.ID, proj ReleaseProjection) (*Release, error) {
+	var release *Release
+	var err error
+
+	key := composeCacheKey(model.ResourceTypeRelease.String(), "Get", id.String(), projectionCacheValue(proj))
+	if err = r.cacheRepo.Get(ctx, key, &release); err != nil {
+		return nil, err
+	}
+
+	if release != nil {
+		return release, nil
+	}
+
+	if release, err = r.releaseRepo.Get(ctx, id, proj); err != nil {
+		return nil, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (r *RedisCachedReleaseRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj ReleaseProjection) (Page[*Release], error) {
+	var releases Page[*Release]
+	var err error
+
+	normalized, err := normalizedPage(page)
+	if err != nil {
+		return Page[*Release]{}, err
+	}
+
+	key := composeCacheKey(
+		model.ResourceTypeRelease.String(),
+		"List",
+		project.String(),
+		projectionCacheValue(proj),
+		pageTokenValue(normalized.Token),
+		normalized.Size,
+	)
+	if err = r.cacheRepo.Get(ctx, key, &releases); err != nil {
+		return Page[*Release]{}, err
+	}
+
+	if releases.Items != nil {
+		return releases, nil
+	}
+
+	if releases, err = r.releaseRepo.ListForProject(ctx, project, normalized, proj); err != nil {
+		return Page[*Release]{}, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, releases); err != nil {
+		return Page[*Release]{}, err
+	}
+
+	return releases, nil
+}
+
+func (r *RedisCachedReleaseRepository) ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error) {
+	return r.releaseRepo.ListIssues(ctx, id)
+}
+
+func (r *RedisCachedReleaseRepository) Update(ctx context.Context, id model.ID, opts UpdateReleaseOpts) (*Release, error) {
+	release, err := r.releaseRepo.Update(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := composeCacheKey(model.ResourceTypeRelease.String(), "Get", id.String(), projectionCacheValue(ReleaseDetailProjection()))
+	if err := r.cacheRepo.Set(ctx, key, release); err != nil {
+		return nil, err
+	}
+
+	if err := clearReleaseAllLists(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func (r *RedisCachedReleaseRepository) AttachTo(ctx context.Context, releaseID, issueID model.ID) error {
+	return r.releaseRepo.AttachTo(ctx, releaseID, issueID)
+}
+
+func (r *RedisCachedReleaseRepository) DetachFrom(ctx context.Context, releaseID, issueID model.ID) error {
+	return r.releaseRepo.DetachFrom(ctx, releaseID, issueID)
+}
+
+func (r *RedisCachedReleaseRepository) Delete(ctx context.Context, id model.ID) error {
+	if err := clearReleasesKey(ctx, r.cacheRepo, id); err != nil {
+		return err
+	}
+	if err := clearReleaseAllLists(ctx, r.cacheRepo); err != nil {
+		return err
+	}
+
+	return r.releaseRepo.Delete(ctx, id)
+}
+
+// NewCachedReleaseRepository returns a new CachedReleaseRepository.
+func NewCachedReleaseRepository(repo ReleaseRepository, opts ...RedisRepositoryOption) (*RedisCachedReleaseRepository, error) {
+	r, err := newRedisBaseRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCachedReleaseRepository{
+		cacheRepo:   r,
+		releaseRepo: repo,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReleaseRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.Neo4jContainerIntegrationTestSuite
+
+	testUser      *repository.User
+	testOrg       *repository.Organization
+	testNamespace *repository.Namespace
+	testProject   *repository.Project
+	createOpts    repository.CreateReleaseOpts
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	s.SetupNeo4j(&s.ContainerIntegrationTestSuite, reflect.TypeOf(s).Elem().String())
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) SetupTest() {
+	var err error
+	s.testUser, err = s.UserRepo.Create(context.Background(), testModel.NewCreateUserOpts())
+	s.Require().NoError(err)
+	s.testOrg, err = s.OrganizationRepo.Create(context.Background(), testModel.NewCreateOrganizationOpts(s.testUser.ID))
+	s.Require().NoError(err)
+	s.testNamespace, err = s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	s.testProject, err = s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(s.testNamespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	s.createOpts = testModel.NewCreateReleaseOpts(s.testProject.ID)
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupNeo4j(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TestCreate() {
+	release, err := s.ReleaseRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	s.Assert().NotEqual(model.MustNewNilID(model.ResourceTypeRelease), release.ID)
+	s.Assert().Equal(s.testProject.ID, release.Project)
+	s.Assert().Equal(s.createOpts.Name, release.Name)
+	s.Assert().Equal(model.ReleaseStatusPlanned, release.Status)
+	s.Assert().WithinDuration(*s.createOpts.TargetDate, *release.TargetDate, 0)
+	s.Assert().Nil(release.ReleasedAt)
+	s.Assert().NotNil(release.CreatedAt)
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TestListForProject() {
+	for range 3 {
+		_, err := s.ReleaseRepo.Create(context.Background(), testModel.NewCreateReleaseOpts(s.testProject.ID))
+		s.Require().NoError(err)
+	}
+
+	releases, err := s.ReleaseRepo.ListForProject(context.Background(), s.testProject.ID, repository.CursorPage{Size: 2}, repository.ReleaseListProjection())
+	s.Require().NoError(err)
+	s.Assert().Len(releases.Items, 2)
+	s.Assert().True(releases.PageInfo.HasMore)
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TestUpdate() {
+	release, err := s.ReleaseRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	updated, err := s.ReleaseRepo.Update(context.Background(), release.ID, repository.UpdateReleaseOpts{
+		Status:     optional.Some(model.ReleaseStatusReleased),
+		TargetDate: optional.Null[time.Time](),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(model.ReleaseStatusReleased, updated.Status)
+	s.Assert().Nil(updated.TargetDate)
+	s.Require().NotNil(updated.ReleasedAt)
+
+	archived, err := s.ReleaseRepo.Update(context.Background(), release.ID, repository.UpdateReleaseOpts{
+		Status: optional.Some(model.ReleaseStatusArchived),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(updated.ReleasedAt, archived.ReleasedAt)
+
+	planned, err := s.ReleaseRepo.Update(context.Background(), release.ID, repository.UpdateReleaseOpts{
+		Status: optional.Some(model.ReleaseStatusPlanned),
+	})
+	s.Require().NoError(err)
+	s.Assert().Nil(planned.ReleasedAt)
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TestAttachAndListIssues() {
+	release, err := s.ReleaseRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	first, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	second, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ReleaseRepo.AttachTo(context.Background(), release.ID, second.ID))
+	s.Require().NoError(s.ReleaseRepo.AttachTo(context.Background(), release.ID, first.ID))
+	s.Require().NoError(s.ReleaseRepo.AttachTo(context.Background(), release.ID, first.ID))
+
+	issues, err := s.ReleaseRepo.ListIssues(context.Background(), release.ID)
+	s.Require().NoError(err)
+	s.Require().Len(issues, 2)
+	s.Assert().Equal(first.ID, issues[0].ID)
+	s.Assert().Equal(first.Key, issues[0].Key)
+	s.Assert().Equal(second.ID, issues[1].ID)
+
+	s.Require().NoError(s.ReleaseRepo.DetachFrom(context.Background(), release.ID, first.ID))
+
+	issues, err = s.ReleaseRepo.ListIssues(context.Background(), release.ID)
+	s.Require().NoError(err)
+	s.Require().Len(issues, 1)
+	s.Assert().Equal(second.ID, issues[0].ID)
+}
+
+func (s *ReleaseRepositoryIntegrationTestSuite) TestDelete() {
+	release, err := s.ReleaseRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ReleaseRepo.Delete(context.Background(), release.ID))
+
+	_, err = s.ReleaseRepo.Get(context.Background(), release.ID, repository.ReleaseDetailProjection())
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func TestReleaseRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ReleaseRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+// ReleaseProjection selects bounded fields for release reads.
+type ReleaseProjection struct{}
+
+func ReleaseListProjection() ReleaseProjection {
+	return ReleaseProjection{}
+}
+
+func ReleaseDetailProjection() ReleaseProjection {
+	return ReleaseProjection{}
+}
+
+type ReleaseGetQuery struct {
+	ID         model.ID
+	Projection ReleaseProjection
+}
+
+// ReleaseListForProjectQuery lists the releases of a project.
+type ReleaseListForProjectQuery struct {
+	ProjectID  model.ID
+	Page       CursorPage
+	Order      SortDirection
+	Projection ReleaseProjection
+}
+
+// ReleaseIssuesQuery lists the issues that have the release as fix version,
+// ordered by their numeric ID.
+type ReleaseIssuesQuery struct {
+	ID    model.ID
+	Limit int
+}
+
+func (q ReleaseGetQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "release.get",
+			Cypher: `
+				MATCH (r:` + q.ID.Label() + ` {id: $id})-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				RETURN r, p.id AS project_id`,
+			Params: map[string]any{"id": q.ID.String()},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q ReleaseListForProjectQuery) Compile() (QueryPlan, error) {
+	if err := q.ProjectID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+	params := map[string]any{
+		"project_id": q.ProjectID.String(),
+	}
+	bounds, err := compileCursorBounds("r", q.Page, q.Order, params)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "release.list_for_project",
+			Cypher: strings.TrimSpace(`
+				MATCH (r:` + model.ResourceTypeRelease.String() + `)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + q.ProjectID.Label() + ` {id: $project_id})
+				` + cursorWherePrefix(bounds.Where, "WHERE ") + `
+				RETURN r, p.id AS project_id
+				ORDER BY r.id ` + bounds.Order.Cypher() + `
+				LIMIT $limit`,
+			),
+			Params: params,
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q ReleaseIssuesQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+	if q.Limit < MinPageSize || q.Limit > MaxPageSize {
+		return QueryPlan{}, ErrInvalidPageSize
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "release.issues",
+			Cypher: `
+				MATCH (i:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindHasFixVersion.String() + `]->(r:` + q.ID.Label() + ` {id: $id})
+				MATCH (i)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				RETURN i, p.key AS project_key
+				ORDER BY i.numeric_id ASC
+				LIMIT $limit`,
+			Params: map[string]any{
+				"id":    q.ID.String(),
+				"limit": q.Limit,
+			},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
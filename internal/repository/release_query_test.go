@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseGetQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	releaseID := model.MustNewID(model.ResourceTypeRelease)
+
+	t.Run("root query matches release with project", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ReleaseGetQuery{
+			ID:         releaseID,
+			Projection: ReleaseDetailProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "release.get", plan.Root.Name)
+		assert.Empty(t, plan.Loaders)
+		assert.Contains(t, plan.Root.Cypher, EdgeKindBelongsTo.String())
+		assert.Contains(t, plan.Root.Cypher, "RETURN r, p.id AS project_id")
+		assert.Equal(t, releaseID.String(), plan.Root.Params["id"])
+	})
+
+	t.Run("invalid release id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ReleaseGetQuery{ID: model.ID{}})
+		require.Error(t, err)
+	})
+}
+
+func TestReleaseListForProjectQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("root query lists releases of the project", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ReleaseListForProjectQuery{
+			ProjectID:  projectID,
+			Page:       CursorPage{Size: 10},
+			Order:      SortDirectionDesc,
+			Projection: ReleaseListProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "release.list_for_project", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY r.id DESC")
+		assert.Equal(t, projectID.String(), plan.Root.Params["project_id"])
+		assert.Equal(t, 11, plan.Root.Params["limit"])
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ReleaseListForProjectQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: MaxPageSize + 1},
+		})
+		require.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
+
+func TestReleaseIssuesQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	releaseID := model.MustNewID(model.ResourceTypeRelease)
+
+	t.Run("root query lists fix version issues", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ReleaseIssuesQuery{ID: releaseID, Limit: MaxPageSize})
+		require.NoError(t, err)
+		assert.Equal(t, "release.issues", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, EdgeKindHasFixVersion.String())
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY i.numeric_id ASC")
+		assert.Equal(t, MaxPageSize, plan.Root.Params["limit"])
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ReleaseIssuesQuery{ID: releaseID})
+		require.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
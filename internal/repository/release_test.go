@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedReleaseRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	opts := CreateReleaseOpts{
+		Project: model.MustNewID(model.ResourceTypeProject),
+		Name:    "v1.0.0",
+	}
+
+	listKey := composeCacheKey(model.ResourceTypeRelease.String(), "List", "*", "*", "*", "*")
+
+	releaseRepo := NewMockReleaseRepository(ctrl)
+	releaseRepo.EXPECT().Create(ctx, opts).Return(&Release{Name: opts.Name}, nil)
+
+	r := &RedisCachedReleaseRepository{
+		cacheRepo:   newDeletePatternCacheRepo(t, ctrl, ctx, listKey),
+		releaseRepo: releaseRepo,
+	}
+
+	got, err := r.Create(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, opts.Name, got.Name)
+}
+
+func TestCachedReleaseRepository_ListIssues(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeRelease)
+	issues := []*PartialIssue{{ID: model.MustNewID(model.ResourceTypeIssue)}}
+
+	releaseRepo := NewMockReleaseRepository(ctrl)
+	releaseRepo.EXPECT().ListIssues(ctx, id).Return(issues, nil)
+
+	r := &RedisCachedReleaseRepository{
+		releaseRepo: releaseRepo,
+	}
+
+	got, err := r.ListIssues(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, issues, got)
+}
+
+func TestCachedReleaseRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeRelease)
+
+	getKey := composeCacheKey(model.ResourceTypeRelease.String(), "Get", id.String(), "*")
+	listKey := composeCacheKey(model.ResourceTypeRelease.String(), "List", "*", "*", "*", "*")
+
+	releaseRepo := NewMockReleaseRepository(ctrl)
+	releaseRepo.EXPECT().Delete(ctx, id).Return(nil)
+
+	r := &RedisCachedReleaseRepository{
+		cacheRepo:   newDeletePatternCacheRepo(t, ctrl, ctx, getKey, listKey),
+		releaseRepo: releaseRepo,
+	}
+
+	require.NoError(t, r.Delete(ctx, id))
+}
@@ -5,6 +5,8 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -51,6 +53,12 @@ const (
 	EdgeKindInScopeOf                         // IN_SCOPE_OF
 	EdgeKindGranted                           // GRANTED
 	EdgeKindDefinesRole                       // DEFINES_ROLE
+	EdgeKindUnwatched                         // UNWATCHED
+	EdgeKindLeads                             // LEADS
+	EdgeKindInComponent                       // IN_COMPONENT
+	EdgeKindHasFixVersion                     // HAS_FIX_VERSION
+	EdgeKindInSprint                          // IN_SPRINT
+	EdgeKindMovedFrom                         // MOVED_FROM
 )
 
 var (
@@ -900,6 +908,50 @@ func (r *redisBaseRepository) DeletePattern(ctx context.Context, pattern string)
 	return nil
 }
 
+// DeletePatterns deletes the keys matching any of the patterns in one pass.
+// The keys are listed once for the patterns sharing the same first segment,
+// matched against the patterns locally, then deleted together.
+func (r *redisBaseRepository) DeletePatterns(ctx context.Context, patterns ...string) error {
+	ctx, span := r.tracer.Start(ctx, "repository.redisBaseRepository/DeletePatterns")
+	defer span.End()
+
+	prefixes := make([]string, 0)
+	byPrefix := make(map[string][]string)
+	for _, pattern := range patterns {
+		prefix, _, _ := strings.Cut(pattern, ":")
+		if _, ok := byPrefix[prefix]; !ok {
+			prefixes = append(prefixes, prefix)
+		}
+		byPrefix[prefix] = append(byPrefix[prefix], pattern)
+	}
+
+	keys := make([]string, 0)
+	for _, prefix := range prefixes {
+		listed, err := r.db.Client().Keys(ctx, prefix+"*").Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		for _, key := range listed {
+			if slices.ContainsFunc(byPrefix[prefix], func(pattern string) bool {
+				matched, _ := path.Match(pattern, key)
+				return matched
+			}) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := r.db.Client().Del(ctx, keys...).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return errors.Join(ErrCacheDelete, err)
+	}
+
+	return nil
+}
+
 // newRedisBaseRepository creates a new redisBaseRepository for a Neo4j redisBaseRepository.
 func newRedisBaseRepository(opts ...RedisRepositoryOption) (*redisBaseRepository, error) {
 	r := &redisBaseRepository{
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _EdgeKindName = "ASSIGNED_TOBELONGS_TOCOMMENTEDCREATEDHAS_ATTACHMENTHAS_COMMENTHAS_LABELHAS_NAMESPACEHAS_PERMISSIONHAS_PROJECTHAS_TEAMINVITEDINVITED_TOKIND_OFMEMBER_OFRELATED_TOSPEAKSWATCHESSCOPED_TOLOCATED_ININ_SCOPE_OFGRANTEDDEFINES_ROLE"
+const _EdgeKindName = "ASSIGNED_TOBELONGS_TOCOMMENTEDCREATEDHAS_ATTACHMENTHAS_COMMENTHAS_LABELHAS_NAMESPACEHAS_PERMISSIONHAS_PROJECTHAS_TEAMINVITEDINVITED_TOKIND_OFMEMBER_OFRELATED_TOSPEAKSWATCHESSCOPED_TOLOCATED_ININ_SCOPE_OFGRANTEDDEFINES_ROLEUNWATCHEDLEADSIN_COMPONENTHAS_FIX_VERSIONIN_SPRINTMOVED_FROM"
 
-var _EdgeKindIndex = [...]uint8{0, 11, 21, 30, 37, 51, 62, 71, 84, 98, 109, 117, 124, 134, 141, 150, 160, 166, 173, 182, 192, 203, 210, 222}
+var _EdgeKindIndex = [...]uint16{0, 11, 21, 30, 37, 51, 62, 71, 84, 98, 109, 117, 124, 134, 141, 150, 160, 166, 173, 182, 192, 203, 210, 222, 231, 236, 248, 263, 272, 282}
 
-const _EdgeKindLowerName = "assigned_tobelongs_tocommentedcreatedhas_attachmenthas_commenthas_labelhas_namespacehas_permissionhas_projecthas_teaminvitedinvited_tokind_ofmember_ofrelated_tospeakswatchesscoped_tolocated_inin_scope_ofgranteddefines_role"
+const _EdgeKindLowerName = "assigned_tobelongs_tocommentedcreatedhas_attachmenthas_commenthas_labelhas_namespacehas_permissionhas_projecthas_teaminvitedinvited_tokind_ofmember_ofrelated_tospeakswatchesscoped_tolocated_inin_scope_ofgranteddefines_roleunwatchedleadsin_componenthas_fix_versionin_sprintmoved_from"
 
 func (i EdgeKind) String() string {
 	i -= 1
@@ -48,9 +48,15 @@ func _EdgeKindNoOp() {
 	_ = x[EdgeKindInScopeOf-(21)]
 	_ = x[EdgeKindGranted-(22)]
 	_ = x[EdgeKindDefinesRole-(23)]
+	_ = x[EdgeKindUnwatched-(24)]
+	_ = x[EdgeKindLeads-(25)]
+	_ = x[EdgeKindInComponent-(26)]
+	_ = x[EdgeKindHasFixVersion-(27)]
+	_ = x[EdgeKindInSprint-(28)]
+	_ = x[EdgeKindMovedFrom-(29)]
 }
 
-var _EdgeKindValues = []EdgeKind{EdgeKindAssignedTo, EdgeKindBelongsTo, EdgeKindCommented, EdgeKindCreated, EdgeKindHasAttachment, EdgeKindHasComment, EdgeKindHasLabel, EdgeKindHasNamespace, EdgeKindHasPermission, EdgeKindHasProject, EdgeKindHasTeam, EdgeKindInvited, EdgeKindInvitedTo, EdgeKindKindOf, EdgeKindMemberOf, EdgeKindRelatedTo, EdgeKindSpeaks, EdgeKindWatches, EdgeKindScopedTo, EdgeKindLocatedIn, EdgeKindInScopeOf, EdgeKindGranted, EdgeKindDefinesRole}
+var _EdgeKindValues = []EdgeKind{EdgeKindAssignedTo, EdgeKindBelongsTo, EdgeKindCommented, EdgeKindCreated, EdgeKindHasAttachment, EdgeKindHasComment, EdgeKindHasLabel, EdgeKindHasNamespace, EdgeKindHasPermission, EdgeKindHasProject, EdgeKindHasTeam, EdgeKindInvited, EdgeKindInvitedTo, EdgeKindKindOf, EdgeKindMemberOf, EdgeKindRelatedTo, EdgeKindSpeaks, EdgeKindWatches, EdgeKindScopedTo, EdgeKindLocatedIn, EdgeKindInScopeOf, EdgeKindGranted, EdgeKindDefinesRole, EdgeKindUnwatched, EdgeKindLeads, EdgeKindInComponent, EdgeKindHasFixVersion, EdgeKindInSprint, EdgeKindMovedFrom}
 
 var _EdgeKindNameToValueMap = map[string]EdgeKind{
 	_EdgeKindName[0:11]:         EdgeKindAssignedTo,
@@ -99,6 +105,18 @@ var _EdgeKindNameToValueMap = map[string]EdgeKind{
 	_EdgeKindLowerName[203:210]: EdgeKindGranted,
 	_EdgeKindName[210:222]:      EdgeKindDefinesRole,
 	_EdgeKindLowerName[210:222]: EdgeKindDefinesRole,
+	_EdgeKindName[222:231]:      EdgeKindUnwatched,
+	_EdgeKindLowerName[222:231]: EdgeKindUnwatched,
+	_EdgeKindName[231:236]:      EdgeKindLeads,
+	_EdgeKindLowerName[231:236]: EdgeKindLeads,
+	_EdgeKindName[236:248]:      EdgeKindInComponent,
+	_EdgeKindLowerName[236:248]: EdgeKindInComponent,
+	_EdgeKindName[248:263]:      EdgeKindHasFixVersion,
+	_EdgeKindLowerName[248:263]: EdgeKindHasFixVersion,
+	_EdgeKindName[263:272]:      EdgeKindInSprint,
+	_EdgeKindLowerName[263:272]: EdgeKindInSprint,
+	_EdgeKindName[272:282]:      EdgeKindMovedFrom,
+	_EdgeKindLowerName[272:282]: EdgeKindMovedFrom,
 }
 
 var _EdgeKindNames = []string{
@@ -125,6 +143,12 @@ var _EdgeKindNames = []string{
 	_EdgeKindName[192:203],
 	_EdgeKindName[203:210],
 	_EdgeKindName[210:222],
+	_EdgeKindName[222:231],
+	_EdgeKindName[231:236],
+	_EdgeKindName[236:248],
+	_EdgeKindName[248:263],
+	_EdgeKindName[263:272],
+	_EdgeKindName[272:282],
 }
 
 // EdgeKindString retrieves an enum value from the enum constants string name.
@@ -387,6 +387,10 @@ func TestEdgeKind_String(t *testing.T) {
 		{"IN_SCOPE_OF", EdgeKindInScopeOf, "IN_SCOPE_OF"},
 		{"GRANTED", EdgeKindGranted, "GRANTED"},
 		{"DEFINES_ROLE", EdgeKindDefinesRole, "DEFINES_ROLE"},
+		{"LEADS", EdgeKindLeads, "LEADS"},
+		{"IN_COMPONENT", EdgeKindInComponent, "IN_COMPONENT"},
+		{"HAS_FIX_VERSION", EdgeKindHasFixVersion, "HAS_FIX_VERSION"},
+		{"IN_SPRINT", EdgeKindInSprint, "IN_SPRINT"},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -137,6 +137,135 @@ func (s *RoleRepositoryIntegrationTestSuite) TestDelete() {
 	s.Assert().ErrorIs(err, repository.ErrNotFound)
 }
 
+func (s *RoleRepositoryIntegrationTestSuite) TestBootstrapAddsLabelActions() {
+	maintainerOpts := s.createOpts
+	maintainerOpts.Key = model.RoleKeyIssueMaintainer
+	maintainerOpts.Actions = []string{model.ActionIssueRead.String()}
+	maintainer, err := s.RoleRepo.Create(context.Background(), maintainerOpts)
+	s.Require().NoError(err)
+
+	customOpts := testModel.NewCreateRoleOpts(s.testUser.ID, s.testOrg.ID)
+	customOpts.Actions = []string{model.ActionProjectRead.String()}
+	custom, err := s.RoleRepo.Create(context.Background(), customOpts)
+	s.Require().NoError(err)
+
+	namespace, err := s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	creatorGrant, err := s.PermissionRepo.Create(context.Background(), repository.CreateGrantOpts{
+		Principal: s.testUser.ID,
+		Scope:     namespace.ID,
+		Actions:   []model.Action{model.ActionNamespaceUpdate, model.ActionNamespaceDelete},
+	})
+	s.Require().NoError(err)
+
+	s.BootstrapNeo4jDatabase(&s.ContainerIntegrationTestSuite)
+
+	role, err := s.RoleRepo.Get(context.Background(), maintainer.ID, s.testOrg.ID, repository.RoleDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{
+		model.ActionIssueRead.String(),
+		model.ActionLabelAttach.String(),
+	}, role.Actions)
+
+	role, err = s.RoleRepo.Get(context.Background(), custom.ID, s.testOrg.ID, repository.RoleDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(customOpts.Actions, role.Actions)
+
+	grant, err := s.PermissionRepo.Get(context.Background(), creatorGrant.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal([]model.Action{
+		model.ActionNamespaceUpdate,
+		model.ActionNamespaceDelete,
+		model.ActionLabelManage,
+		model.ActionLabelAttach,
+		model.ActionWebhookManage,
+	}, grant.Actions)
+
+	// The upgrade runs once, so the label actions removed later stay removed.
+	_, err = s.RoleRepo.Update(context.Background(), maintainer.ID, s.testOrg.ID, repository.UpdateRoleOpts{
+		Actions: optional.Some(maintainerOpts.Actions),
+	})
+	s.Require().NoError(err)
+
+	s.BootstrapNeo4jDatabase(&s.ContainerIntegrationTestSuite)
+
+	role, err = s.RoleRepo.Get(context.Background(), maintainer.ID, s.testOrg.ID, repository.RoleDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(maintainerOpts.Actions, role.Actions)
+}
+
+func (s *RoleRepositoryIntegrationTestSuite) TestBootstrapAddsWebhookActions() {
+	adminOpts := s.createOpts
+	adminOpts.Key = model.RoleKeyOrgAdmin
+	adminOpts.Actions = []string{model.ActionOrganizationRead.String(), model.ActionWebhookManage.String()}
+	admin, err := s.RoleRepo.Create(context.Background(), adminOpts)
+	s.Require().NoError(err)
+
+	viewerOpts := testModel.NewCreateRoleOpts(s.testUser.ID, s.testOrg.ID)
+	viewerOpts.Key = model.RoleKeyProjectViewer
+	viewerOpts.Actions = []string{model.ActionProjectRead.String()}
+	viewer, err := s.RoleRepo.Create(context.Background(), viewerOpts)
+	s.Require().NoError(err)
+
+	namespace, err := s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	project, err := s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(namespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	creatorGrant, err := s.PermissionRepo.Create(context.Background(), repository.CreateGrantOpts{
+		Principal: s.testUser.ID,
+		Scope:     project.ID,
+		Actions:   []model.Action{model.ActionProjectUpdate, model.ActionProjectMembersManage},
+	})
+	s.Require().NoError(err)
+	readerGrant, err := s.PermissionRepo.Create(context.Background(), repository.CreateGrantOpts{
+		Principal: s.testUser.ID,
+		Scope:     project.ID,
+		Actions:   []model.Action{model.ActionProjectRead},
+	})
+	s.Require().NoError(err)
+
+	s.BootstrapNeo4jDatabase(&s.ContainerIntegrationTestSuite)
+
+	role, err := s.RoleRepo.Get(context.Background(), admin.ID, s.testOrg.ID, repository.RoleDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{
+		model.ActionOrganizationRead.String(),
+		model.ActionWebhookManage.String(),
+		model.ActionLabelManage.String(),
+		model.ActionLabelAttach.String(),
+	}, role.Actions)
+
+	role, err = s.RoleRepo.Get(context.Background(), viewer.ID, s.testOrg.ID, repository.RoleDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal(viewerOpts.Actions, role.Actions)
+
+	grant, err := s.PermissionRepo.Get(context.Background(), creatorGrant.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal([]model.Action{
+		model.ActionProjectUpdate,
+		model.ActionProjectMembersManage,
+		model.ActionLabelManage,
+		model.ActionLabelAttach,
+		model.ActionWebhookManage,
+	}, grant.Actions)
+
+	grant, err = s.PermissionRepo.Get(context.Background(), readerGrant.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal([]model.Action{model.ActionProjectRead}, grant.Actions)
+
+	// The upgrade runs once, so the webhook action removed later stays removed.
+	_, err = s.RoleRepo.Update(context.Background(), admin.ID, s.testOrg.ID, repository.UpdateRoleOpts{
+		Actions: optional.Some([]string{model.ActionOrganizationRead.String()}),
+	})
+	s.Require().NoError(err)
+
+	s.BootstrapNeo4jDatabase(&s.ContainerIntegrationTestSuite)
+
+	role, err = s.RoleRepo.Get(context.Background(), admin.ID, s.testOrg.ID, repository.RoleDetailProjection())
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{model.ActionOrganizationRead.String()}, role.Actions)
+}
+
 func TestRoleRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(RoleRepositoryIntegrationTestSuite))
 }
@@ -0,0 +1,575 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+var (
+	ErrSprintAddIssue    = errors.New("failed to add issue to sprint")      // the issue could not be added to the sprint
+	ErrSprintCreate      = errors.New("failed to create sprint")            // the sprint could not be created
+	ErrSprintDelete      = errors.New("failed to delete sprint")            // the sprint could not be deleted
+	ErrSprintRead        = errors.New("failed to read sprint")              // the sprint could not be retrieved
+	ErrSprintRemoveIssue = errors.New("failed to remove issue from sprint") // the issue could not be removed from the sprint
+	ErrSprintUpdate      = errors.New("failed to update sprint")            // the sprint could not be updated
+)
+
+// Sprint represents a project sprint persisted by the repository.
+type Sprint struct {
+	ID        model.ID          `json:"id"`
+	Project   model.ID          `json:"project"`
+	Name      string            `json:"name"`
+	Goal      string            `json:"goal"`
+	State     model.SprintState `json:"state"`
+	StartDate time.Time         `json:"start_date"`
+	EndDate   time.Time         `json:"end_date"`
+	ClosedAt  *time.Time        `json:"closed_at"`
+	CreatedAt *time.Time        `json:"created_at"`
+	UpdatedAt *time.Time        `json:"updated_at"`
+}
+
+// SprintIssueMove describes the effect of adding an issue to a sprint.
+type SprintIssueMove struct {
+	// Added is false if the issue was already in the sprint.
+	Added bool
+	// MovedFrom lists the sprints that are not closed and the issue was
+	// removed from.
+	MovedFrom []model.ID
+}
+
+// CreateSprintOpts holds the data required to create a sprint.
+type CreateSprintOpts struct {
+	Project   model.ID
+	Name      string
+	Goal      string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// UpdateSprintOpts holds the fields that can be updated on a sprint.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateSprintOpts struct {
+	Name      optional.Optional[string]
+	Goal      optional.Optional[string]
+	State     optional.Optional[model.SprintState]
+	StartDate optional.Optional[time.Time]
+	EndDate   optional.Optional[time.Time]
+}
+
+// patch builds a Neo4j property map from defined optional fields.
+func (o UpdateSprintOpts) patch() map[string]any {
+	p := make(map[string]any)
+
+	if o.Name.Defined {
+		p["name"] = *o.Name.Value
+	}
+	if o.Goal.Defined {
+		p["goal"] = *o.Goal.Value
+	}
+	if o.State.Defined {
+		p["state"] = o.State.Value.String()
+	}
+
+	return p
+}
+
+//go:generate go tool mockgen -source=sprint.go -destination=sprint_mock_gen.go -package=repository -mock_names "SprintRepository=MockSprintRepository"
+type SprintRepository interface {
+	Create(ctx context.Context, opts CreateSprintOpts) (*Sprint, error)
+	Get(ctx context.Context, id model.ID, proj SprintProjection) (*Sprint, error)
+	GetNext(ctx context.Context, id model.ID) (*Sprint, error)
+	ListForProject(ctx context.Context, project model.ID, page CursorPage, proj SprintProjection) (Page[*Sprint], error)
+	ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error)
+	Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error)
+	AddIssue(ctx context.Context, sprintID, issueID model.ID) (*SprintIssueMove, error)
+	RemoveIssue(ctx context.Context, sprintID, issueID model.ID) (bool, error)
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// Neo4jSprintRepository is a repository for managing project sprints.
+type Neo4jSprintRepository struct {
+	*neo4jBaseRepository
+}
+
+func (r *Neo4jSprintRepository) scan(sp, pp string) func(rec *neo4j.Record) (*Sprint, error) {
+	return func(rec *neo4j.Record) (*Sprint, error) {
+		sprint := new(Sprint)
+
+		node, err := Neo4jRecordNode(rec, sp)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := Neo4jScanIntoStruct(&node, &sprint, []string{"id", "project"}); err != nil {
+			return nil, err
+		}
+
+		if sprint.ID, err = Neo4jDecodeID(node, model.ResourceTypeSprint); err != nil {
+			return nil, err
+		}
+
+		projectID, err := Neo4jParseValueFromRecord[string](rec, pp)
+		if err != nil {
+			return nil, err
+		}
+		if sprint.Project, err = model.NewIDFromString(projectID, model.ResourceTypeProject.String()); err != nil {
+			return nil, errors.Join(ErrMalformedResult, err)
+		}
+
+		return sprint, nil
+	}
+}
+
+func (r *Neo4jSprintRepository) Create(ctx context.Context, opts CreateSprintOpts) (*Sprint, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/Create")
+	defer span.End()
+
+	if err := opts.Project.Validate(); err != nil || opts.Project.Type != model.ResourceTypeProject {
+		return nil, errors.Join(ErrSprintCreate, model.ErrInvalidID)
+	}
+
+	createdAt := time.Now().UTC()
+	id := model.MustNewID(model.ResourceTypeSprint)
+
+	params := map[string]any{
+		"id":         id.String(),
+		"project_id": opts.Project.String(),
+		"rel_id":     model.NewRawID(),
+		"name":       opts.Name,
+		"goal":       opts.Goal,
+		"state":      model.SprintStatePlanned.String(),
+		"start_date": opts.StartDate.Format(time.RFC3339Nano),
+		"end_date":   opts.EndDate.Format(time.RFC3339Nano),
+		"created_at": createdAt.Format(time.RFC3339Nano),
+	}
+
+	cypher := `
+	MATCH (p:` + opts.Project.Label() + ` {id: $project_id})
+	CREATE
+		(s:` + id.Label() + ` {
+			id: $id, name: $name, goal: $goal, state: $state,
+			start_date: datetime($start_date), end_date: datetime($end_date),
+			created_at: datetime($created_at)
+		}),
+		(s)-[:` + EdgeKindBelongsTo.String() + ` {id: $rel_id, created_at: datetime($created_at)}]->(p)
+	RETURN s.id AS id`
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrSprintCreate, err)
+	}
+
+	return r.Get(ctx, id, SprintDetailProjection())
+}
+
+func (r *Neo4jSprintRepository) Get(ctx context.Context, id model.ID, proj SprintProjection) (*Sprint, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/Get")
+	defer span.End()
+
+	plan, err := CompileQuery(SprintGetQuery{
+		ID:         id,
+		Projection: proj,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintRead, err)
+	}
+
+	var sprint *Sprint
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		sprint, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("s", "project_id"))
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintRead, err)
+	}
+
+	return sprint, nil
+}
+
+// GetNext returns the planned sprint of the same project that starts first,
+// or ErrNotFound if the project has no other planned sprint.
+func (r *Neo4jSprintRepository) GetNext(ctx context.Context, id model.ID) (*Sprint, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/GetNext")
+	defer span.End()
+
+	plan, err := CompileQuery(SprintNextQuery{ID: id})
+	if err != nil {
+		return nil, errors.Join(ErrSprintRead, err)
+	}
+
+	var sprint *Sprint
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		sprint, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("s", "project_id"))
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintRead, err)
+	}
+
+	return sprint, nil
+}
+
+func (r *Neo4jSprintRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj SprintProjection) (Page[*Sprint], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/ListForProject")
+	defer span.End()
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Sprint]{}, errors.Join(ErrSprintRead, err)
+	}
+	plan, err := CompileQuery(SprintListForProjectQuery{
+		ProjectID:  project,
+		Page:       normalized,
+		Order:      SortDirectionDesc,
+		Projection: proj,
+	})
+	if err != nil {
+		return Page[*Sprint]{}, errors.Join(ErrSprintRead, err)
+	}
+
+	items := make([]*Sprint, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("s", "project_id"))
+		return runErr
+	})
+	if err != nil {
+		return Page[*Sprint]{}, errors.Join(ErrSprintRead, err)
+	}
+
+	return PaginateSlice(items, normalized.Size, func(sprint *Sprint) model.ID {
+		return sprint.ID
+	})
+}
+
+// ListIssues returns the first MaxPageSize issues planned in the sprint,
+// ordered by their numeric ID.
+func (r *Neo4jSprintRepository) ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/ListIssues")
+	defer span.End()
+
+	plan, err := CompileQuery(SprintIssuesQuery{
+		ID:    id,
+		Limit: MaxPageSize,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintRead, err)
+	}
+
+	issues := make([]*PartialIssue, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		issues, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, func(rec *neo4j.Record) (*PartialIssue, error) {
+			node, err := Neo4jRecordNode(rec, "i")
+			if err != nil {
+				return nil, err
+			}
+			projectKey, err := Neo4jParseValueFromRecord[string](rec, "project_key")
+			if err != nil {
+				return nil, err
+			}
+			return decodePartialIssueNode(node, projectKey)
+		})
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintRead, err)
+	}
+
+	return issues, nil
+}
+
+func (r *Neo4jSprintRepository) Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/Update")
+	defer span.End()
+
+	params := map[string]any{
+		"id":    id.String(),
+		"patch": opts.patch(),
+	}
+
+	set := ""
+	if opts.StartDate.Defined && opts.StartDate.Value != nil {
+		set += `, s.start_date = datetime($start_date)`
+		params["start_date"] = opts.StartDate.Value.Format(time.RFC3339Nano)
+	}
+	if opts.EndDate.Defined && opts.EndDate.Value != nil {
+		set += `, s.end_date = datetime($end_date)`
+		params["end_date"] = opts.EndDate.Value.Format(time.RFC3339Nano)
+	}
+	if opts.State.Defined && *opts.State.Value == model.SprintStateClosed {
+		set += `, s.closed_at = coalesce(s.closed_at, datetime())`
+	}
+
+	cypher := `
+	MATCH (s:` + id.Label() + ` {id: $id})
+	SET s += $patch, s.updated_at = datetime()` + set + `
+	RETURN s.id AS id`
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrSprintUpdate, err)
+	}
+
+	return r.Get(ctx, id, SprintDetailProjection())
+}
+
+// AddIssue plans the issue in the sprint. An issue is in at most one sprint
+// that is not closed, so the issue is removed from the other open sprints of
+// it; closed sprints keep their issues as history.
+func (r *Neo4jSprintRepository) AddIssue(ctx context.Context, sprintID, issueID model.ID) (*SprintIssueMove, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/AddIssue")
+	defer span.End()
+
+	cypher := `
+	MATCH (s:` + sprintID.Label() + ` {id: $sprint_id})
+	MATCH (i:` + issueID.Label() + ` {id: $issue_id})
+	OPTIONAL MATCH (i)-[o:` + EdgeKindInSprint.String() + `]->(other:` + model.ResourceTypeSprint.String() + `)
+	WHERE other.id <> s.id AND other.state <> $closed
+	WITH s, i, collect(o) AS edges, collect(other.id) AS moved_from
+	FOREACH (edge IN edges | DELETE edge)
+	WITH s, i, moved_from
+	OPTIONAL MATCH (i)-[existing:` + EdgeKindInSprint.String() + `]->(s)
+	WITH s, i, moved_from, existing IS NULL AS added
+	MERGE (i)-[e:` + EdgeKindInSprint.String() + `]->(s)
+	ON CREATE SET e.id = $rel_id, e.created_at = datetime()
+	RETURN added, moved_from`
+
+	params := map[string]any{
+		"sprint_id": sprintID.String(),
+		"issue_id":  issueID.String(),
+		"rel_id":    model.NewRawID(),
+		"closed":    model.SprintStateClosed.String(),
+	}
+
+	move, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(rec *neo4j.Record) (*SprintIssueMove, error) {
+		added, err := Neo4jParseValueFromRecord[bool](rec, "added")
+		if err != nil {
+			return nil, err
+		}
+		movedFrom, err := Neo4jParseIDsFromRecord(rec, "moved_from", model.ResourceTypeSprint.String())
+		if err != nil {
+			return nil, err
+		}
+		return &SprintIssueMove{Added: added, MovedFrom: movedFrom}, nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintAddIssue, err)
+	}
+
+	return move, nil
+}
+
+// RemoveIssue removes the issue from the sprint and reports whether the issue
+// was in the sprint.
+func (r *Neo4jSprintRepository) RemoveIssue(ctx context.Context, sprintID, issueID model.ID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/RemoveIssue")
+	defer span.End()
+
+	cypher := `
+	OPTIONAL MATCH (i:` + issueID.Label() + ` {id: $issue_id})-[e:` + EdgeKindInSprint.String() + `]->(s:` + sprintID.Label() + ` {id: $sprint_id})
+	DELETE e
+	RETURN count(e) > 0 AS removed`
+
+	params := map[string]any{
+		"sprint_id": sprintID.String(),
+		"issue_id":  issueID.String(),
+	}
+
+	removed, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(rec *neo4j.Record) (*bool, error) {
+		removed, err := Neo4jParseValueFromRecord[bool](rec, "removed")
+		if err != nil {
+			return nil, err
+		}
+		return &removed, nil
+	})
+	if err != nil {
+		return false, errors.Join(ErrSprintRemoveIssue, err)
+	}
+
+	return *removed, nil
+}
+
+func (r *Neo4jSprintRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.SprintRepository/Delete")
+	defer span.End()
+
+	cypher := `MATCH (s:` + id.Label() + ` {id: $id}) DETACH DELETE s`
+	params := map[string]any{
+		"id": id.String(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrSprintDelete, err)
+	}
+
+	return nil
+}
+
+// NewNeo4jSprintRepository creates a new sprint neo4jBaseRepository.
+func NewNeo4jSprintRepository(opts ...Neo4jRepositoryOption) (*Neo4jSprintRepository, error) {
+	baseRepo, err := newNeo4jRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Neo4jSprintRepository{
+		neo4jBaseRepository: baseRepo,
+	}, nil
+}
+
+func clearSprintsPattern(ctx context.Context, r *redisBaseRepository, pattern ...string) error {
+	return r.DeletePattern(ctx, composeCacheKey(model.ResourceTypeSprint.String(), pattern))
+}
+
+func clearSprintsKey(ctx context.Context, r *redisBaseRepository, id model.ID) error {
+	return clearSprintsPattern(ctx, r, "Get", id.String(), "*")
+}
+
+func clearSprintAllLists(ctx context.Context, r *redisBaseRepository) error {
+	return clearSprintsPattern(ctx, r, "List", "*", "*", "*", "*")
+}
+
+// RedisCachedSprintRepository implements caching on the SprintRepository.
+// The issues of a sprint and the next sprint depend on other resources, so
+// they are not cached.
+type RedisCachedSprintRepository struct {
+	cacheRepo  *redisBaseRepository
+	sprintRepo SprintRepository
+}
+
+func (r *RedisCachedSprintRepository) Create(ctx context.Context, opts CreateSprintOpts) (*Sprint, error) {
+	if err := clearSprintAllLists(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	return r.sprintRepo.Create(ctx, opts)
+}
+
+func (r *RedisCachedSprintRepository) Get(ctx context.Context, id model.ID, proj SprintProjection) (*Sprint, error) {
+	var sprint *Sprint
+	var err error
+
+	key := composeCacheKey(model.ResourceTypeSprint.String(), "Get", id.String(), projectionCacheValue(proj))
+	if err = r.cacheRepo.Get(ctx, key, &sprint); err != nil {
+		return nil, err
+	}
+
+	if sprint != nil {
+		return sprint, nil
+	}
+
+	if sprint, err = r.sprintRepo.Get(ctx, id, proj); err != nil {
+		return nil, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, sprint); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (r *RedisCachedSprintRepository) GetNext(ctx context.Context, id model.ID) (*Sprint, error) {
+	return r.sprintRepo.GetNext(ctx, id)
+}
+
+func (r *RedisCachedSprintRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj SprintProjection) (Page[*Sprint], error) {
+	var sprints Page[*Sprint]
+	var err error
+
+	normalized, err := normalizedPage(page)
+	if err != nil {
+		return Page[*Sprint]{}, err
+	}
+
+	key := composeCacheKey(
+		model.ResourceTypeSprint.String(),
+		"List",
+		project.String(),
+		projectionCacheValue(proj),
+		pageTokenValue(normalized.Token),
+		normalized.Size,
+	)
+	if err = r.cacheRepo.Get(ctx, key, &sprints); err != nil {
+		return Page[*Sprint]{}, err
+	}
+
+	if sprints.Items != nil {
+		return sprints, nil
+	}
+
+	if sprints, err = r.sprintRepo.ListForProject(ctx, project, normalized, proj); err != nil {
+		return Page[*Sprint]{}, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, sprints); err != nil {
+		return Page[*Sprint]{}, err
+	}
+
+	return sprints, nil
+}
+
+func (r *RedisCachedSprintRepository) ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error) {
+	return r.sprintRepo.ListIssues(ctx, id)
+}
+
+func (r *RedisCachedSprintRepository) Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error) {
+	sprint, err := r.sprintRepo.Update(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := composeCacheKey(model.ResourceTypeSprint.String(), "Get", id.String(), projectionCacheValue(SprintDetailProjection()))
+	if err := r.cacheRepo.Set(ctx, key, sprint); err != nil {
+		return nil, err
+	}
+
+	if err := clearSprintAllLists(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (r *RedisCachedSprintRepository) AddIssue(ctx context.Context, sprintID, issueID model.ID) (*SprintIssueMove, error) {
+	return r.sprintRepo.AddIssue(ctx, sprintID, issueID)
+}
+
+func (r *RedisCachedSprintRepository) RemoveIssue(ctx context.Context, sprintID, issueID model.ID) (bool, error) {
+	return r.sprintRepo.RemoveIssue(ctx, sprintID, issueID)
+}
+
+func (r *RedisCachedSprintRepository) Delete(ctx context.Context, id model.ID) error {
+	if err := clearSprintsKey(ctx, r.cacheRepo, id); err != nil {
+		return err
+	}
+	if err := clearSprintAllLists(ctx, r.cacheRepo); err != nil {
+		return err
+	}
+
+	return r.sprintRepo.Delete(ctx, id)
+}
+
+// NewCachedSprintRepository returns a new CachedSprintRepository.
+func NewCachedSprintRepository(repo SprintRepository, opts ...RedisRepositoryOption) (*RedisCachedSprintRepository, error) {
+	r, err := newRedisBaseRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCachedSprintRepository{
+		cacheRepo:  r,
+		sprintRepo: repo,
+	}, nil
+}
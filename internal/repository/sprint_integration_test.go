@@ -0,0 +1,208 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type SprintRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.Neo4jContainerIntegrationTestSuite
+
+	testUser      *repository.User
+	testOrg       *repository.Organization
+	testNamespace *repository.Namespace
+	testProject   *repository.Project
+	createOpts    repository.CreateSprintOpts
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	s.SetupNeo4j(&s.ContainerIntegrationTestSuite, reflect.TypeOf(s).Elem().String())
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) SetupTest() {
+	var err error
+	s.testUser, err = s.UserRepo.Create(context.Background(), testModel.NewCreateUserOpts())
+	s.Require().NoError(err)
+	s.testOrg, err = s.OrganizationRepo.Create(context.Background(), testModel.NewCreateOrganizationOpts(s.testUser.ID))
+	s.Require().NoError(err)
+	s.testNamespace, err = s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	s.testProject, err = s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(s.testNamespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	s.createOpts = testModel.NewCreateSprintOpts(s.testProject.ID)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupNeo4j(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestCreate() {
+	sprint, err := s.SprintRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	s.Assert().NotEqual(model.MustNewNilID(model.ResourceTypeSprint), sprint.ID)
+	s.Assert().Equal(s.testProject.ID, sprint.Project)
+	s.Assert().Equal(s.createOpts.Name, sprint.Name)
+	s.Assert().Equal(s.createOpts.Goal, sprint.Goal)
+	s.Assert().Equal(model.SprintStatePlanned, sprint.State)
+	s.Assert().WithinDuration(s.createOpts.StartDate, sprint.StartDate, 0)
+	s.Assert().WithinDuration(s.createOpts.EndDate, sprint.EndDate, 0)
+	s.Assert().Nil(sprint.ClosedAt)
+	s.Assert().NotNil(sprint.CreatedAt)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestListForProject() {
+	for range 3 {
+		_, err := s.SprintRepo.Create(context.Background(), testModel.NewCreateSprintOpts(s.testProject.ID))
+		s.Require().NoError(err)
+	}
+
+	sprints, err := s.SprintRepo.ListForProject(context.Background(), s.testProject.ID, repository.CursorPage{Size: 2}, repository.SprintListProjection())
+	s.Require().NoError(err)
+	s.Assert().Len(sprints.Items, 2)
+	s.Assert().True(sprints.PageInfo.HasMore)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestGetNext() {
+	current, err := s.SprintRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	_, err = s.SprintRepo.GetNext(context.Background(), current.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+
+	later := testModel.NewCreateSprintOpts(s.testProject.ID)
+	later.StartDate = s.createOpts.EndDate.Add(14 * 24 * time.Hour)
+	later.EndDate = later.StartDate.Add(14 * 24 * time.Hour)
+	_, err = s.SprintRepo.Create(context.Background(), later)
+	s.Require().NoError(err)
+
+	next := testModel.NewCreateSprintOpts(s.testProject.ID)
+	next.StartDate = s.createOpts.EndDate
+	next.EndDate = next.StartDate.Add(14 * 24 * time.Hour)
+	expected, err := s.SprintRepo.Create(context.Background(), next)
+	s.Require().NoError(err)
+
+	got, err := s.SprintRepo.GetNext(context.Background(), current.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(expected.ID, got.ID)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestUpdate() {
+	sprint, err := s.SprintRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	endDate := s.createOpts.EndDate.Add(7 * 24 * time.Hour)
+	updated, err := s.SprintRepo.Update(context.Background(), sprint.ID, repository.UpdateSprintOpts{
+		Goal:    optional.Some(""),
+		State:   optional.Some(model.SprintStateActive),
+		EndDate: optional.Some(endDate),
+	})
+	s.Require().NoError(err)
+	s.Assert().Empty(updated.Goal)
+	s.Assert().Equal(model.SprintStateActive, updated.State)
+	s.Assert().WithinDuration(endDate, updated.EndDate, 0)
+	s.Assert().Nil(updated.ClosedAt)
+
+	closed, err := s.SprintRepo.Update(context.Background(), sprint.ID, repository.UpdateSprintOpts{
+		State: optional.Some(model.SprintStateClosed),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(model.SprintStateClosed, closed.State)
+	s.Assert().NotNil(closed.ClosedAt)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestAddAndRemoveIssue() {
+	first, err := s.SprintRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	second, err := s.SprintRepo.Create(context.Background(), testModel.NewCreateSprintOpts(s.testProject.ID))
+	s.Require().NoError(err)
+
+	issue, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+	s.Require().NoError(err)
+
+	move, err := s.SprintRepo.AddIssue(context.Background(), first.ID, issue.ID)
+	s.Require().NoError(err)
+	s.Assert().True(move.Added)
+	s.Assert().Empty(move.MovedFrom)
+
+	move, err = s.SprintRepo.AddIssue(context.Background(), first.ID, issue.ID)
+	s.Require().NoError(err)
+	s.Assert().False(move.Added)
+
+	move, err = s.SprintRepo.AddIssue(context.Background(), second.ID, issue.ID)
+	s.Require().NoError(err)
+	s.Assert().True(move.Added)
+	s.Assert().Equal([]model.ID{first.ID}, move.MovedFrom)
+
+	issues, err := s.SprintRepo.ListIssues(context.Background(), first.ID)
+	s.Require().NoError(err)
+	s.Assert().Empty(issues)
+
+	issues, err = s.SprintRepo.ListIssues(context.Background(), second.ID)
+	s.Require().NoError(err)
+	s.Require().Len(issues, 1)
+	s.Assert().Equal(issue.ID, issues[0].ID)
+
+	removed, err := s.SprintRepo.RemoveIssue(context.Background(), second.ID, issue.ID)
+	s.Require().NoError(err)
+	s.Assert().True(removed)
+
+	removed, err = s.SprintRepo.RemoveIssue(context.Background(), second.ID, issue.ID)
+	s.Require().NoError(err)
+	s.Assert().False(removed)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestAddIssueKeepsClosedSprints() {
+	closed, err := s.SprintRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	next, err := s.SprintRepo.Create(context.Background(), testModel.NewCreateSprintOpts(s.testProject.ID))
+	s.Require().NoError(err)
+
+	issue, err := s.IssueRepo.Create(context.Background(), testModel.NewCreateIssueOpts(s.testProject.ID, s.testUser.ID))
+	s.Require().NoError(err)
+
+	_, err = s.SprintRepo.AddIssue(context.Background(), closed.ID, issue.ID)
+	s.Require().NoError(err)
+	_, err = s.SprintRepo.Update(context.Background(), closed.ID, repository.UpdateSprintOpts{
+		State: optional.Some(model.SprintStateClosed),
+	})
+	s.Require().NoError(err)
+
+	move, err := s.SprintRepo.AddIssue(context.Background(), next.ID, issue.ID)
+	s.Require().NoError(err)
+	s.Assert().Empty(move.MovedFrom)
+
+	issues, err := s.SprintRepo.ListIssues(context.Background(), closed.ID)
+	s.Require().NoError(err)
+	s.Assert().Len(issues, 1)
+}
+
+func (s *SprintRepositoryIntegrationTestSuite) TestDelete() {
+	sprint, err := s.SprintRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.SprintRepo.Delete(context.Background(), sprint.ID))
+
+	_, err = s.SprintRepo.Get(context.Background(), sprint.ID, repository.SprintDetailProjection())
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func TestSprintRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(SprintRepositoryIntegrationTestSuite))
+}
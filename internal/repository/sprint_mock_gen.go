@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sprint.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sprint.go -destination=sprint_mock_gen.go -package=repository -mock_names SprintRepository=MockSprintRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSprintRepository is a mock of SprintRepository interface.
+type MockSprintRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSprintRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSprintRepositoryMockRecorder is the mock recorder for MockSprintRepository.
+type MockSprintRepositoryMockRecorder struct {
+	mock *MockSprintRepository
+}
+
+// NewMockSprintRepository creates a new mock instance.
+func NewMockSprintRepository(ctrl *gomock.Controller) *MockSprintRepository {
+	mock := &MockSprintRepository{ctrl: ctrl}
+	mock.recorder = &MockSprintRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSprintRepository) EXPECT() *MockSprintRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddIssue mocks base method.
+func (m *MockSprintRepository) AddIssue(ctx context.Context, sprintID, issueID model.ID) (*SprintIssueMove, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIssue", ctx, sprintID, issueID)
+	ret0, _ := ret[0].(*SprintIssueMove)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddIssue indicates an expected call of AddIssue.
+func (mr *MockSprintRepositoryMockRecorder) AddIssue(ctx, sprintID, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIssue", reflect.TypeOf((*MockSprintRepository)(nil).AddIssue), ctx, sprintID, issueID)
+}
+
+// Create mocks base method.
+func (m *MockSprintRepository) Create(ctx context.Context, opts CreateSprintOpts) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSprintRepositoryMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSprintRepository)(nil).Create), ctx, opts)
+}
+
+// Delete mocks base method.
+func (m *MockSprintRepository) Delete(ctx context.Context, id model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSprintRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSprintRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockSprintRepository) Get(ctx context.Context, id model.ID, proj SprintProjection) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, proj)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSprintRepositoryMockRecorder) Get(ctx, id, proj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSprintRepository)(nil).Get), ctx, id, proj)
+}
+
+// GetNext mocks base method.
+func (m *MockSprintRepository) GetNext(ctx context.Context, id model.ID) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNext", ctx, id)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNext indicates an expected call of GetNext.
+func (mr *MockSprintRepositoryMockRecorder) GetNext(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNext", reflect.TypeOf((*MockSprintRepository)(nil).GetNext), ctx, id)
+}
+
+// ListForProject mocks base method.
+func (m *MockSprintRepository) ListForProject(ctx context.Context, project model.ID, page CursorPage, proj SprintProjection) (Page[*Sprint], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForProject", ctx, project, page, proj)
+	ret0, _ := ret[0].(Page[*Sprint])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForProject indicates an expected call of ListForProject.
+func (mr *MockSprintRepositoryMockRecorder) ListForProject(ctx, project, page, proj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForProject", reflect.TypeOf((*MockSprintRepository)(nil).ListForProject), ctx, project, page, proj)
+}
+
+// ListIssues mocks base method.
+func (m *MockSprintRepository) ListIssues(ctx context.Context, id model.ID) ([]*PartialIssue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssues", ctx, id)
+	ret0, _ := ret[0].([]*PartialIssue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssues indicates an expected call of ListIssues.
+func (mr *MockSprintRepositoryMockRecorder) ListIssues(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssues", reflect.TypeOf((*MockSprintRepository)(nil).ListIssues), ctx, id)
+}
+
+// RemoveIssue mocks base method.
+func (m *MockSprintRepository) RemoveIssue(ctx context.Context, sprintID, issueID model.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIssue", ctx, sprintID, issueID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveIssue indicates an expected call of RemoveIssue.
+func (mr *MockSprintRepositoryMockRecorder) RemoveIssue(ctx, sprintID, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIssue", reflect.TypeOf((*MockSprintRepository)(nil).RemoveIssue), ctx, sprintID, issueID)
+}
+
+// Update mocks base method.
+func (m *MockSprintRepository) Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, opts)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSprintRepositoryMockRecorder) Update(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSprintRepository)(nil).Update), ctx, id, opts)
+}
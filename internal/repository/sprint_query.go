@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+// SprintProjection selects bounded fields for sprint reads.
+type SprintProjection struct{}
+
+func SprintListProjection() SprintProjection {
+	return SprintProjection{}
+}
+
+func SprintDetailProjection() SprintProjection {
+	return SprintProjection{}
+}
+
+type SprintGetQuery struct {
+	ID         model.ID
+	Projection SprintProjection
+}
+
+// SprintListForProjectQuery lists the sprints of a project.
+type SprintListForProjectQuery struct {
+	ProjectID  model.ID
+	Page       CursorPage
+	Order      SortDirection
+	Projection SprintProjection
+}
+
+// SprintNextQuery returns the planned sprint of the same project that starts
+// first, excluding the sprint itself.
+type SprintNextQuery struct {
+	ID model.ID
+}
+
+// SprintIssuesQuery lists the issues planned in the sprint, ordered by their
+// numeric ID.
+type SprintIssuesQuery struct {
+	ID    model.ID
+	Limit int
+}
+
+func (q SprintGetQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "sprint.get",
+			Cypher: `
+				MATCH (s:` + q.ID.Label() + ` {id: $id})-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				RETURN s, p.id AS project_id`,
+			Params: map[string]any{"id": q.ID.String()},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q SprintListForProjectQuery) Compile() (QueryPlan, error) {
+	if err := q.ProjectID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+	params := map[string]any{
+		"project_id": q.ProjectID.String(),
+	}
+	bounds, err := compileCursorBounds("s", q.Page, q.Order, params)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "sprint.list_for_project",
+			Cypher: strings.TrimSpace(`
+				MATCH (s:` + model.ResourceTypeSprint.String() + `)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + q.ProjectID.Label() + ` {id: $project_id})
+				` + cursorWherePrefix(bounds.Where, "WHERE ") + `
+				RETURN s, p.id AS project_id
+				ORDER BY s.id ` + bounds.Order.Cypher() + `
+				LIMIT $limit`,
+			),
+			Params: params,
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q SprintNextQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "sprint.next",
+			Cypher: `
+				MATCH (c:` + q.ID.Label() + ` {id: $id})-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				MATCH (s:` + model.ResourceTypeSprint.String() + ` {state: $state})-[:` + EdgeKindBelongsTo.String() + `]->(p)
+				WHERE s.id <> c.id
+				RETURN s, p.id AS project_id
+				ORDER BY s.start_date ASC, s.id ASC
+				LIMIT 1`,
+			Params: map[string]any{
+				"id":    q.ID.String(),
+				"state": model.SprintStatePlanned.String(),
+			},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q SprintIssuesQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+	if q.Limit < MinPageSize || q.Limit > MaxPageSize {
+		return QueryPlan{}, ErrInvalidPageSize
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "sprint.issues",
+			Cypher: `
+				MATCH (i:` + model.ResourceTypeIssue.String() + `)-[:` + EdgeKindInSprint.String() + `]->(s:` + q.ID.Label() + ` {id: $id})
+				MATCH (i)-[:` + EdgeKindBelongsTo.String() + `]->(p:` + model.ResourceTypeProject.String() + `)
+				RETURN i, p.key AS project_key
+				ORDER BY i.numeric_id ASC
+				LIMIT $limit`,
+			Params: map[string]any{
+				"id":    q.ID.String(),
+				"limit": q.Limit,
+			},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSprintGetQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+
+	t.Run("root query matches sprint with project", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(SprintGetQuery{
+			ID:         sprintID,
+			Projection: SprintDetailProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "sprint.get", plan.Root.Name)
+		assert.Empty(t, plan.Loaders)
+		assert.Contains(t, plan.Root.Cypher, EdgeKindBelongsTo.String())
+		assert.Contains(t, plan.Root.Cypher, "RETURN s, p.id AS project_id")
+		assert.Equal(t, sprintID.String(), plan.Root.Params["id"])
+	})
+
+	t.Run("invalid sprint id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(SprintGetQuery{ID: model.ID{}})
+		require.Error(t, err)
+	})
+}
+
+func TestSprintListForProjectQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("root query lists sprints of the project", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(SprintListForProjectQuery{
+			ProjectID:  projectID,
+			Page:       CursorPage{Size: 10},
+			Order:      SortDirectionDesc,
+			Projection: SprintListProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "sprint.list_for_project", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY s.id DESC")
+		assert.Equal(t, projectID.String(), plan.Root.Params["project_id"])
+		assert.Equal(t, 11, plan.Root.Params["limit"])
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(SprintListForProjectQuery{
+			ProjectID: projectID,
+			Page:      CursorPage{Size: MaxPageSize + 1},
+		})
+		require.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
+
+func TestSprintNextQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+
+	plan, err := CompileQuery(SprintNextQuery{ID: sprintID})
+	require.NoError(t, err)
+	assert.Equal(t, "sprint.next", plan.Root.Name)
+	assert.Contains(t, plan.Root.Cypher, "ORDER BY s.start_date ASC, s.id ASC")
+	assert.Equal(t, sprintID.String(), plan.Root.Params["id"])
+	assert.Equal(t, model.SprintStatePlanned.String(), plan.Root.Params["state"])
+}
+
+func TestSprintIssuesQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+
+	t.Run("root query lists sprint issues", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(SprintIssuesQuery{ID: sprintID, Limit: MaxPageSize})
+		require.NoError(t, err)
+		assert.Equal(t, "sprint.issues", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, EdgeKindInSprint.String())
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY i.numeric_id ASC")
+		assert.Equal(t, MaxPageSize, plan.Root.Params["limit"])
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(SprintIssuesQuery{ID: sprintID})
+		require.ErrorIs(t, err, ErrInvalidPageSize)
+	})
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+const (
+	SprintScopeChangeKindAdded   = "added"   // the issue was added to the sprint
+	SprintScopeChangeKindRemoved = "removed" // the issue was removed from the sprint
+)
+
+var (
+	ErrSprintScopeChangeCreate = errors.New("failed to create sprint scope change") // the sprint scope change could not be created
+	ErrSprintScopeChangeRead   = errors.New("failed to read sprint scope changes")  // the sprint scope changes could not be retrieved
+)
+
+// SprintScopeChange records an issue added to or removed from a sprint.
+type SprintScopeChange struct {
+	ID        model.ID   `json:"id"`
+	Sprint    model.ID   `json:"sprint"`
+	Issue     model.ID   `json:"issue"`
+	Kind      string     `json:"kind"`
+	Actor     *model.ID  `json:"actor"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// CreateSprintScopeChangeOpts holds the data required to record a scope
+// change.
+type CreateSprintScopeChangeOpts struct {
+	Sprint model.ID
+	Issue  model.ID
+	Kind   string
+	Actor  *model.ID
+}
+
+//go:generate go tool mockgen -source=sprint_scope_change.go -destination=sprint_scope_change_mock_gen.go -package=repository -mock_names "SprintScopeChangeRepository=MockSprintScopeChangeRepository"
+type SprintScopeChangeRepository interface {
+	// Create records the scope changes in a single batch.
+	Create(ctx context.Context, opts []CreateSprintScopeChangeOpts) ([]*SprintScopeChange, error)
+	// ListBySprint returns the scope changes of the sprint, oldest first.
+	ListBySprint(ctx context.Context, sprint model.ID) ([]*SprintScopeChange, error)
+}
+
+// PGSprintScopeChangeRepository is a repository for managing the scope
+// change history of sprints.
+type PGSprintScopeChangeRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGSprintScopeChangeRepository) Create(ctx context.Context, opts []CreateSprintScopeChangeOpts) ([]*SprintScopeChange, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.SprintScopeChangeRepository/Create")
+	defer span.End()
+
+	createdAt := convert.ToPointer(time.Now().UTC().Round(time.Microsecond))
+
+	batch := &pgx.Batch{}
+	changes := make([]*SprintScopeChange, 0, len(opts))
+	for _, o := range opts {
+		change := &SprintScopeChange{
+			ID:        model.MustNewID(model.ResourceTypeSprintScopeChange),
+			Sprint:    o.Sprint,
+			Issue:     o.Issue,
+			Kind:      o.Kind,
+			Actor:     o.Actor,
+			CreatedAt: createdAt,
+		}
+
+		batch.Queue(
+			"INSERT INTO sprint_scope_changes (id, sprint_id, issue_id, kind, actor, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+			change.ID, change.Sprint, change.Issue, change.Kind, change.Actor, *change.CreatedAt,
+		)
+		changes = append(changes, change)
+	}
+
+	if batch.Len() == 0 {
+		return changes, nil
+	}
+
+	if err := r.db.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, errors.Join(ErrSprintScopeChangeCreate, err)
+	}
+
+	return changes, nil
+}
+
+func (r *PGSprintScopeChangeRepository) ListBySprint(ctx context.Context, sprint model.ID) ([]*SprintScopeChange, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.SprintScopeChangeRepository/ListBySprint")
+	defer span.End()
+
+	rows, err := r.db.pool.Query(ctx,
+		"SELECT * FROM sprint_scope_changes WHERE sprint_id = $1 ORDER BY created_at ASC, id ASC",
+		sprint,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrSprintScopeChangeRead, err)
+	}
+	defer rows.Close()
+
+	changes := make([]*SprintScopeChange, 0)
+	for rows.Next() {
+		change, err := scanSprintScopeChange(rows)
+		if err != nil {
+			return nil, errors.Join(ErrSprintScopeChangeRead, err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrSprintScopeChangeRead, err)
+	}
+
+	return changes, nil
+}
+
+func scanSprintScopeChange(row pgx.Row) (*SprintScopeChange, error) {
+	var c SprintScopeChange
+	if err := row.Scan(&c.ID, &c.Sprint, &c.Issue, &c.Kind, &c.Actor, &c.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// NewSprintScopeChangeRepository creates a new SprintScopeChangeRepository.
+func NewSprintScopeChangeRepository(opts ...PGRepositoryOption) (*PGSprintScopeChangeRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGSprintScopeChangeRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
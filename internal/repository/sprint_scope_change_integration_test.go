@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type SprintScopeChangeRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	sprint model.ID
+	actor  model.ID
+}
+
+func (s *SprintScopeChangeRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *SprintScopeChangeRepositoryIntegrationTestSuite) SetupTest() {
+	s.sprint = model.MustNewID(model.ResourceTypeSprint)
+	s.actor = model.MustNewID(model.ResourceTypeUser)
+}
+
+func (s *SprintScopeChangeRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *SprintScopeChangeRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *SprintScopeChangeRepositoryIntegrationTestSuite) TestCreateAndListBySprint() {
+	issue := model.MustNewID(model.ResourceTypeIssue)
+
+	created, err := s.SprintScopeChangeRepo.Create(context.Background(), []repository.CreateSprintScopeChangeOpts{
+		{Sprint: s.sprint, Issue: issue, Kind: repository.SprintScopeChangeKindAdded, Actor: &s.actor},
+		{Sprint: s.sprint, Issue: issue, Kind: repository.SprintScopeChangeKindRemoved},
+		{Sprint: model.MustNewID(model.ResourceTypeSprint), Issue: issue, Kind: repository.SprintScopeChangeKindAdded},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(created, 3)
+
+	changes, err := s.SprintScopeChangeRepo.ListBySprint(context.Background(), s.sprint)
+	s.Require().NoError(err)
+	s.Require().Len(changes, 2)
+	s.Assert().Equal(created[0].ID, changes[0].ID)
+	s.Assert().Equal(repository.SprintScopeChangeKindAdded, changes[0].Kind)
+	s.Assert().Equal(&s.actor, changes[0].Actor)
+	s.Assert().Equal(repository.SprintScopeChangeKindRemoved, changes[1].Kind)
+	s.Assert().Nil(changes[1].Actor)
+
+	created, err = s.SprintScopeChangeRepo.Create(context.Background(), nil)
+	s.Require().NoError(err)
+	s.Assert().Empty(created)
+}
+
+func TestSprintScopeChangeRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(SprintScopeChangeRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sprint_scope_change.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sprint_scope_change.go -destination=sprint_scope_change_mock_gen.go -package=repository -mock_names SprintScopeChangeRepository=MockSprintScopeChangeRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSprintScopeChangeRepository is a mock of SprintScopeChangeRepository interface.
+type MockSprintScopeChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSprintScopeChangeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSprintScopeChangeRepositoryMockRecorder is the mock recorder for MockSprintScopeChangeRepository.
+type MockSprintScopeChangeRepositoryMockRecorder struct {
+	mock *MockSprintScopeChangeRepository
+}
+
+// NewMockSprintScopeChangeRepository creates a new mock instance.
+func NewMockSprintScopeChangeRepository(ctrl *gomock.Controller) *MockSprintScopeChangeRepository {
+	mock := &MockSprintScopeChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockSprintScopeChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSprintScopeChangeRepository) EXPECT() *MockSprintScopeChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSprintScopeChangeRepository) Create(ctx context.Context, opts []CreateSprintScopeChangeOpts) ([]*SprintScopeChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].([]*SprintScopeChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSprintScopeChangeRepositoryMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSprintScopeChangeRepository)(nil).Create), ctx, opts)
+}
+
+// ListBySprint mocks base method.
+func (m *MockSprintScopeChangeRepository) ListBySprint(ctx context.Context, sprint model.ID) ([]*SprintScopeChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySprint", ctx, sprint)
+	ret0, _ := ret[0].([]*SprintScopeChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySprint indicates an expected call of ListBySprint.
+func (mr *MockSprintScopeChangeRepositoryMockRecorder) ListBySprint(ctx, sprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySprint", reflect.TypeOf((*MockSprintScopeChangeRepository)(nil).ListBySprint), ctx, sprint)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedSprintRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	opts := CreateSprintOpts{
+		Project: model.MustNewID(model.ResourceTypeProject),
+		Name:    "Sprint 1",
+	}
+
+	listKey := composeCacheKey(model.ResourceTypeSprint.String(), "List", "*", "*", "*", "*")
+
+	sprintRepo := NewMockSprintRepository(ctrl)
+	sprintRepo.EXPECT().Create(ctx, opts).Return(&Sprint{Name: opts.Name}, nil)
+
+	r := &RedisCachedSprintRepository{
+		cacheRepo:  newDeletePatternCacheRepo(t, ctrl, ctx, listKey),
+		sprintRepo: sprintRepo,
+	}
+
+	got, err := r.Create(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, opts.Name, got.Name)
+}
+
+func TestCachedSprintRepository_AddIssue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	move := &SprintIssueMove{Added: true, MovedFrom: []model.ID{model.MustNewID(model.ResourceTypeSprint)}}
+
+	sprintRepo := NewMockSprintRepository(ctrl)
+	sprintRepo.EXPECT().AddIssue(ctx, sprintID, issueID).Return(move, nil)
+
+	r := &RedisCachedSprintRepository{
+		sprintRepo: sprintRepo,
+	}
+
+	got, err := r.AddIssue(ctx, sprintID, issueID)
+	require.NoError(t, err)
+	assert.Equal(t, move, got)
+}
+
+func TestCachedSprintRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeSprint)
+
+	getKey := composeCacheKey(model.ResourceTypeSprint.String(), "Get", id.String(), "*")
+	listKey := composeCacheKey(model.ResourceTypeSprint.String(), "List", "*", "*", "*", "*")
+
+	sprintRepo := NewMockSprintRepository(ctrl)
+	sprintRepo.EXPECT().Delete(ctx, id).Return(nil)
+
+	r := &RedisCachedSprintRepository{
+		cacheRepo:  newDeletePatternCacheRepo(t, ctrl, ctx, getKey, listKey),
+		sprintRepo: sprintRepo,
+	}
+
+	require.NoError(t, r.Delete(ctx, id))
+}
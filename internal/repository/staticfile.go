@@ -27,6 +27,10 @@ type StaticFileRepository interface {
 	// Get retrieves an object and writes its data to the designated location.
 	// It returns an error if the operation failed.
 	Get(ctx context.Context, path string) ([]byte, error)
+	// Stream opens an object for reading without buffering its data. The
+	// caller must close the returned reader. It also returns the size of the
+	// object in bytes.
+	Stream(ctx context.Context, path string) (io.ReadCloser, int64, error)
 	// Update replaces the file at the given path with the new data. It returns
 	// an error if the operation failed.
 	Update(ctx context.Context, path string, data []byte) error
@@ -108,6 +112,36 @@ func (r *S3StaticFileRepository) Get(ctx context.Context, path string) ([]byte,
 	return body, nil
 }
 
+func (r *S3StaticFileRepository) Stream(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.StaticFileRepository/Stream")
+	defer span.End()
+
+	res, err := r.storage.client.GetObject(ctx, &awsS3.GetObjectInput{
+		Bucket: &r.storage.bucket,
+		Key:    &path,
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, 0, errors.Join(ErrFileGet, ErrNotFound)
+		}
+		r.logger.Error(
+			ctx,
+			ErrFileGet.Error(),
+			log.WithPath(path),
+			log.WithAction(log.ActionFileGet),
+			log.WithError(err),
+		)
+		return nil, 0, errors.Join(ErrFileGet, err)
+	}
+
+	var size int64
+	if res.ContentLength != nil {
+		size = *res.ContentLength
+	}
+
+	return res.Body, size, nil
+}
+
 func (r *S3StaticFileRepository) Update(ctx context.Context, path string, data []byte) error {
 	ctx, span := r.tracer.Start(ctx, "repository.pg.StaticFileRepository/Update")
 	defer span.End()
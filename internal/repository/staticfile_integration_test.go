@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"testing"
 
@@ -56,6 +57,25 @@ func (s *StaticFileRepositoryIntegrationTestSuite) TestGet() {
 	s.Assert().ElementsMatch(s.staticFile, data)
 }
 
+func (s *StaticFileRepositoryIntegrationTestSuite) TestStream() {
+	s.Require().NoError(s.StaticFileRepository.Create(context.Background(), s.staticFilePath, s.staticFile))
+
+	body, size, err := s.StaticFileRepository.Stream(context.Background(), s.staticFilePath)
+	s.Require().NoError(err)
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(int64(len(s.staticFile)), size)
+	s.Assert().ElementsMatch(s.staticFile, data)
+}
+
+func (s *StaticFileRepositoryIntegrationTestSuite) TestStreamNonExistentFile() {
+	_, _, err := s.StaticFileRepository.Stream(context.Background(), "non-existent-file.txt")
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
 func (s *StaticFileRepositoryIntegrationTestSuite) TestUpdate() {
 	// First create a file
 	s.Require().NoError(s.StaticFileRepository.Create(context.Background(), s.staticFilePath, s.staticFile))
@@ -168,6 +168,7 @@ type UserRepository interface {
 	Create(ctx context.Context, opts CreateUserOpts) (*User, error)
 	Get(ctx context.Context, id model.ID, proj UserProjection) (*User, error)
 	GetByEmail(ctx context.Context, email string, proj UserProjection) (*User, error)
+	GetByUsername(ctx context.Context, username string, proj UserProjection) (*User, error)
 	List(ctx context.Context, page CursorPage, proj UserProjection) (Page[*User], error)
 	Update(ctx context.Context, id model.ID, opts UpdateUserOpts) (*User, error)
 	Delete(ctx context.Context, id model.ID) error
@@ -385,6 +386,38 @@ func (r *Neo4jUserRepository) GetByEmail(ctx context.Context, email string, proj
 	return user, nil
 }
 
+// GetByUsername returns a user by its username.
+func (r *Neo4jUserRepository) GetByUsername(ctx context.Context, username string, proj UserProjection) (*User, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.UserRepository/GetByUsername")
+	defer span.End()
+
+	plan, err := CompileQuery(UserGetByUsernameQuery{
+		Username:   username,
+		Projection: proj,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrUserRead, err)
+	}
+
+	var user *User
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var readErr error
+		user, _, readErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("u", proj))
+		if readErr != nil {
+			return readErr
+		}
+		return r.applyUserLoaders(ctx, tx, plan, []*User{user})
+	})
+	if err != nil {
+		if errors.As(err, &ErrNoMoreRecords) {
+			return nil, errors.Join(ErrUserRead, ErrNotFound)
+		}
+		return nil, errors.Join(ErrUserRead, err)
+	}
+
+	return user, nil
+}
+
 // List returns users with cursor pagination.
 func (r *Neo4jUserRepository) List(ctx context.Context, page CursorPage, proj UserProjection) (Page[*User], error) {
 	ctx, span := r.tracer.Start(ctx, "repository.neo4j.UserRepository/List")
@@ -497,6 +530,10 @@ func clearUsersAllByEmail(ctx context.Context, r *redisBaseRepository) error {
 	return clearUsersPattern(ctx, r, "GetByEmail", "*")
 }
 
+func clearUsersAllByUsername(ctx context.Context, r *redisBaseRepository) error {
+	return clearUsersPattern(ctx, r, "GetByUsername", "*")
+}
+
 func clearUserAll(ctx context.Context, r *redisBaseRepository) error {
 	return clearUsersPattern(ctx, r, "List", "*", "*", "*")
 }
@@ -581,6 +618,30 @@ func (r *RedisCachedUserRepository) GetByEmail(ctx context.Context, email string
 	return user, nil
 }
 
+func (r *RedisCachedUserRepository) GetByUsername(ctx context.Context, username string, proj UserProjection) (*User, error) {
+	var user *User
+	var err error
+
+	key := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", username, projectionCacheValue(proj))
+	if err = r.cacheRepo.Get(ctx, key, &user); err != nil {
+		return nil, err
+	}
+
+	if user != nil {
+		return user, nil
+	}
+
+	if user, err = r.userRepo.GetByUsername(ctx, username, proj); err != nil {
+		return nil, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (r *RedisCachedUserRepository) List(ctx context.Context, page CursorPage, proj UserProjection) (Page[*User], error) {
 	var users Page[*User]
 	var err error
@@ -625,6 +686,10 @@ func (r *RedisCachedUserRepository) Update(ctx context.Context, id model.ID, opt
 		return nil, err
 	}
 
+	if err = clearUsersAllByUsername(ctx, r.cacheRepo); err != nil {
+		return nil, err
+	}
+
 	if err = clearUserAll(ctx, r.cacheRepo); err != nil {
 		return nil, err
 	}
@@ -647,6 +712,10 @@ func (r *RedisCachedUserRepository) Delete(ctx context.Context, id model.ID) err
 		return err
 	}
 
+	if err := clearUsersAllByUsername(ctx, r.cacheRepo); err != nil {
+		return err
+	}
+
 	if err := clearUserAll(ctx, r.cacheRepo); err != nil {
 		return err
 	}
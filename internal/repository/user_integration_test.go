@@ -83,6 +83,17 @@ func (s *UserRepositoryIntegrationTestSuite) TestGetByEmail() {
 	s.Assert().Equal(s.createOpts.Email, user.Email)
 }
 
+func (s *UserRepositoryIntegrationTestSuite) TestGetByUsername() {
+	created, err := s.UserRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	user, err := s.UserRepo.GetByUsername(context.Background(), s.createOpts.Username, repository.UserDetailProjection())
+	s.Require().NoError(err)
+
+	s.Assert().Equal(created.ID, user.ID)
+	s.Assert().Equal(s.createOpts.Username, user.Username)
+}
+
 func (s *UserRepositoryIntegrationTestSuite) TestGetAll() {
 	_, err := s.UserRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
@@ -230,6 +241,26 @@ func (s *CachedUserRepositoryIntegrationTestSuite) TestGetByEmail() {
 	s.Assert().Len(cacheKeysWithoutIssueListGeneration(s.Keys(&s.ContainerIntegrationTestSuite, "*")), 1)
 }
 
+func (s *CachedUserRepositoryIntegrationTestSuite) TestGetByUsername() {
+	created, err := s.userRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	original, err := s.UserRepo.GetByUsername(context.Background(), created.Username, repository.UserDetailProjection())
+	s.Require().NoError(err)
+
+	usingCache, err := s.userRepo.GetByUsername(context.Background(), created.Username, repository.UserDetailProjection())
+	s.Require().NoError(err)
+
+	s.Assert().Equal(original, usingCache)
+	s.Assert().Len(cacheKeysWithoutIssueListGeneration(s.Keys(&s.ContainerIntegrationTestSuite, "*")), 1)
+
+	cached, err := s.userRepo.GetByUsername(context.Background(), created.Username, repository.UserDetailProjection())
+	s.Require().NoError(err)
+
+	s.Assert().Equal(usingCache.ID, cached.ID)
+	s.Assert().Len(cacheKeysWithoutIssueListGeneration(s.Keys(&s.ContainerIntegrationTestSuite, "*")), 1)
+}
+
 func (s *CachedUserRepositoryIntegrationTestSuite) TestGetAll() {
 	_, err := s.userRepo.Create(context.Background(), s.createOpts)
 	s.Require().NoError(err)
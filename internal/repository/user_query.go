@@ -33,6 +33,11 @@ type UserGetByEmailQuery struct {
 	Projection UserProjection
 }
 
+type UserGetByUsernameQuery struct {
+	Username   string
+	Projection UserProjection
+}
+
 type UserListQuery struct {
 	Page       CursorPage
 	Order      SortDirection
@@ -61,6 +66,15 @@ func (q UserGetByEmailQuery) Compile() (QueryPlan, error) {
 	})
 }
 
+func (q UserGetByUsernameQuery) Compile() (QueryPlan, error) {
+	return compileUserRootQuery(userRootQueryInput{
+		Name:       "user.get_by_username",
+		Match:      "MATCH (u:" + model.ResourceTypeUser.String() + " {username: $username})",
+		Params:     map[string]any{"username": q.Username},
+		Projection: q.Projection,
+	})
+}
+
 func (q UserListQuery) Compile() (QueryPlan, error) {
 	params := map[string]any{}
 	bounds, err := compileCursorBounds("u", q.Page, q.Order, params)
@@ -936,6 +936,288 @@ func TestCachedUserRepository_GetByEmail(t *testing.T) {
 	}
 }
 
+func TestCachedUserRepository_GetByUsername(t *testing.T) {
+	type fields struct {
+		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) *redisBaseRepository
+		userRepo  func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) UserRepository
+	}
+	type args struct {
+		ctx      context.Context
+		username string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    func(username string) *User
+		wantErr error
+	}{
+		{
+			name: "get uncached user",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", username, projectionCacheValue(UserDetailProjection()))
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(2)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Return(cache.ErrCacheMiss)
+					cacheRepo.EXPECT().Set(&cache.Item{
+						Ctx:   ctx,
+						Key:   key,
+						Value: user,
+					}).Return(nil)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				userRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) UserRepository {
+					repo := NewMockUserRepository(ctrl)
+					repo.EXPECT().GetByUsername(ctx, username, UserDetailProjection()).Return(user, nil)
+					return repo
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				username: "test-user",
+			},
+			want: func(username string) *User {
+				return &User{
+					ID:          model.MustNewID(model.ResourceTypeUser),
+					Email:       "test@example.com",
+					Username:    username,
+					Password:    password.UnusablePassword,
+					Status:      model.UserStatusActive,
+					FirstName:   "Test",
+					LastName:    "User",
+					Picture:     "https://example.com/picture.jpg",
+					Title:       "Software Engineer",
+					Bio:         "I'm a software engineer",
+					Phone:       "+1234567890",
+					Address:     "Remote",
+					Links:       make([]string, 0),
+					Languages:   make([]model.Language, 0),
+					Permissions: make([]model.ID, 0),
+				}
+			},
+		},
+		{
+			name: "get cached user",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", username, projectionCacheValue(UserDetailProjection()))
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(1)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Do(func(_ context.Context, _ string, dst any) {
+						if ptr, ok := dst.(**User); ok {
+							*ptr = user
+						}
+					}).Return(nil)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				userRepo: func(ctrl *gomock.Controller, _ context.Context, _ string, _ *User) UserRepository {
+					return NewMockUserRepository(ctrl)
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				username: "test-user",
+			},
+			want: func(username string) *User {
+				return &User{
+					ID:          model.MustNewID(model.ResourceTypeUser),
+					Email:       "test@example.com",
+					Username:    username,
+					Password:    password.UnusablePassword,
+					Status:      model.UserStatusActive,
+					FirstName:   "Test",
+					LastName:    "User",
+					Picture:     "https://example.com/picture.jpg",
+					Title:       "Software Engineer",
+					Bio:         "I'm a software engineer",
+					Phone:       "+1234567890",
+					Address:     "Remote",
+					Links:       make([]string, 0),
+					Languages:   make([]model.Language, 0),
+					Permissions: make([]model.ID, 0),
+				}
+			},
+		},
+		{
+			name: "get uncached user error",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, _ *User) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", username, projectionCacheValue(UserDetailProjection()))
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(1)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Return(cache.ErrCacheMiss)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				userRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, _ *User) UserRepository {
+					repo := NewMockUserRepository(ctrl)
+					repo.EXPECT().GetByUsername(ctx, username, UserDetailProjection()).Return(nil, ErrNotFound)
+					return repo
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				username: "test-user",
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "get cached user error",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, _ *User) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", username, projectionCacheValue(UserDetailProjection()))
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(1)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Return(assert.AnError)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				userRepo: func(ctrl *gomock.Controller, _ context.Context, _ string, _ *User) UserRepository {
+					return NewMockUserRepository(ctrl)
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				username: "test-user",
+			},
+			wantErr: ErrCacheRead,
+		},
+		{
+			name: "get uncached user cache set error",
+			fields: fields{
+				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) *redisBaseRepository {
+					key := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", username, projectionCacheValue(UserDetailProjection()))
+
+					db, err := NewRedisDatabase(
+						WithRedisClient(mock.NewUniversalClient(ctrl)),
+					)
+					require.NoError(t, err)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0)).Times(2)
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span)
+
+					cacheRepo := mock.NewCacheBackend(ctrl)
+					cacheRepo.EXPECT().Get(ctx, key, gomock.Any()).Return(cache.ErrCacheMiss)
+					cacheRepo.EXPECT().Set(&cache.Item{
+						Ctx:   ctx,
+						Key:   key,
+						Value: user,
+					}).Return(assert.AnError)
+
+					return &redisBaseRepository{
+						db:     db,
+						cache:  cacheRepo,
+						tracer: tracer,
+						logger: mock.NewMockLogger(ctrl),
+					}
+				},
+				userRepo: func(ctrl *gomock.Controller, ctx context.Context, username string, user *User) UserRepository {
+					repo := NewMockUserRepository(ctrl)
+					repo.EXPECT().GetByUsername(ctx, username, UserDetailProjection()).Return(user, nil)
+					return repo
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				username: "test-user",
+			},
+			wantErr: ErrCacheWrite,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var want *User
+			if tt.want != nil {
+				want = tt.want(tt.args.username)
+			}
+
+			r := &RedisCachedUserRepository{
+				cacheRepo: tt.fields.cacheRepo(ctrl, tt.args.ctx, tt.args.username, want),
+				userRepo:  tt.fields.userRepo(ctrl, tt.args.ctx, tt.args.username, want),
+			}
+			got, err := r.GetByUsername(tt.args.ctx, tt.args.username, UserDetailProjection())
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
 func TestCachedUserRepository_GetAll(t *testing.T) {
 	type fields struct {
 		cacheRepo func(ctrl *gomock.Controller, ctx context.Context, _, limit int, users []*User) *redisBaseRepository
@@ -1276,6 +1558,7 @@ func TestCachedUserRepository_Update(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, user *User) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), projectionCacheValue(UserDetailProjection()))
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", user.Email, "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 
 					getAllKeyCmd := new(redis.StringSliceCmd)
@@ -1284,8 +1567,12 @@ func TestCachedUserRepository_Update(t *testing.T) {
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 
 					db, err := NewRedisDatabase(
@@ -1294,15 +1581,16 @@ func TestCachedUserRepository_Update(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(7)
+					span.EXPECT().End(gomock.Len(0)).Times(8)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(2)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(3)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(3)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(nil)
 					cacheRepo.EXPECT().Get(ctx, issueListUserGenKey(id), gomock.Any()).Return(cache.ErrCacheMiss)
 					cacheRepo.EXPECT().Set(&cache.Item{Ctx: ctx, Key: issueListUserGenKey(id), Value: int64(1)}).Return(nil)
@@ -1511,6 +1799,7 @@ func TestCachedUserRepository_Update(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, user *User) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), projectionCacheValue(UserDetailProjection()))
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", user.Email, "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 
 					getAllKeyCmd := new(redis.StringSliceCmd)
@@ -1519,8 +1808,12 @@ func TestCachedUserRepository_Update(t *testing.T) {
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 
 					db, err := NewRedisDatabase(
@@ -1529,14 +1822,15 @@ func TestCachedUserRepository_Update(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(3)
+					span.EXPECT().End(gomock.Len(0)).Times(4)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(2)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(3)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(assert.AnError)
 					cacheRepo.EXPECT().Set(&cache.Item{
 						Ctx:   ctx,
@@ -1626,6 +1920,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), "*")
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 					organizationsKey := composeCacheKey(model.ResourceTypeOrganization.String(), "*")
 					rolesKey := composeCacheKey(model.ResourceTypeRole.String(), "*")
@@ -1633,6 +1928,9 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					getAllKeyCmd := new(redis.StringSliceCmd)
 					getAllKeyCmd.SetVal([]string{getAllKey})
 
@@ -1648,6 +1946,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, key).Return(keyCmd)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 					dbClient.EXPECT().Keys(ctx, organizationsKey).Return(organizationsKeyCmd)
 					dbClient.EXPECT().Keys(ctx, rolesKey).Return(rolesKeyCmd)
@@ -1658,16 +1957,17 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(9)
+					span.EXPECT().End(gomock.Len(0)).Times(10)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(5)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(6)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(2)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, key).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, organizationsKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, rolesKey).Return(nil)
@@ -1700,6 +2000,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), "*")
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 					organizationsKey := composeCacheKey(model.ResourceTypeOrganization.String(), "*")
 					rolesKey := composeCacheKey(model.ResourceTypeRole.String(), "*")
@@ -1707,6 +2008,9 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					getAllKeyCmd := new(redis.StringSliceCmd)
 					getAllKeyCmd.SetVal([]string{getAllKey})
 
@@ -1722,6 +2026,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, key).Return(keyCmd)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 					dbClient.EXPECT().Keys(ctx, organizationsKey).Return(organizationsKeyCmd)
 					dbClient.EXPECT().Keys(ctx, rolesKey).Return(rolesKeyCmd)
@@ -1732,16 +2037,17 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(9)
+					span.EXPECT().End(gomock.Len(0)).Times(10)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(5)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(6)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Get", gomock.Len(0)).Return(ctx, span).Times(2)
 					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/Set", gomock.Len(0)).Return(ctx, span).Times(2)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, key).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, organizationsKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, rolesKey).Return(nil)
@@ -1868,11 +2174,15 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), "*")
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					getAllKeyCmd := new(redis.StringSliceCmd)
 					getAllKeyCmd.SetVal([]string{getAllKey})
 
@@ -1882,6 +2192,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, key).Return(keyCmd)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 
 					db, err := NewRedisDatabase(
@@ -1890,14 +2201,15 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(3)
+					span.EXPECT().End(gomock.Len(0)).Times(4)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(3)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(4)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, key).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(ErrCacheDelete)
 
 					return &redisBaseRepository{
@@ -1923,12 +2235,16 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), "*")
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 					organizationsKey := composeCacheKey(model.ResourceTypeOrganization.String(), "*")
 
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					getAllKeyCmd := new(redis.StringSliceCmd)
 					getAllKeyCmd.SetVal([]string{getAllKey})
 
@@ -1941,6 +2257,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, key).Return(keyCmd)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 					dbClient.EXPECT().Keys(ctx, organizationsKey).Return(organizationsKeyCmd)
 
@@ -1950,14 +2267,15 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(4)
+					span.EXPECT().End(gomock.Len(0)).Times(5)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(4)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(5)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, key).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, organizationsKey).Return(ErrCacheDelete)
 
@@ -1984,6 +2302,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 				cacheRepo: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *redisBaseRepository {
 					key := composeCacheKey(model.ResourceTypeUser.String(), "Get", id.String(), "*")
 					byEmailKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByEmail", "*")
+					byUsernameKey := composeCacheKey(model.ResourceTypeUser.String(), "GetByUsername", "*")
 					getAllKey := composeCacheKey(model.ResourceTypeUser.String(), "List", "*", "*", "*")
 					organizationsKey := composeCacheKey(model.ResourceTypeOrganization.String(), "*")
 					rolesKey := composeCacheKey(model.ResourceTypeRole.String(), "*")
@@ -1991,6 +2310,9 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					byEmailKeyCmd := new(redis.StringSliceCmd)
 					byEmailKeyCmd.SetVal([]string{byEmailKey})
 
+					byUsernameKeyCmd := new(redis.StringSliceCmd)
+					byUsernameKeyCmd.SetVal([]string{byUsernameKey})
+
 					getAllKeyCmd := new(redis.StringSliceCmd)
 					getAllKeyCmd.SetVal([]string{getAllKey})
 
@@ -2006,6 +2328,7 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					dbClient := mock.NewUniversalClient(ctrl)
 					dbClient.EXPECT().Keys(ctx, key).Return(keyCmd)
 					dbClient.EXPECT().Keys(ctx, byEmailKey).Return(byEmailKeyCmd)
+					dbClient.EXPECT().Keys(ctx, byUsernameKey).Return(byUsernameKeyCmd)
 					dbClient.EXPECT().Keys(ctx, getAllKey).Return(getAllKeyCmd)
 					dbClient.EXPECT().Keys(ctx, organizationsKey).Return(organizationsKeyCmd)
 					dbClient.EXPECT().Keys(ctx, rolesKey).Return(rolesKeyCmd)
@@ -2016,14 +2339,15 @@ func TestCachedUserRepository_Delete(t *testing.T) {
 					require.NoError(t, err)
 
 					span := mock.NewMockSpan(ctrl)
-					span.EXPECT().End(gomock.Len(0)).Times(5)
+					span.EXPECT().End(gomock.Len(0)).Times(6)
 
 					tracer := mock.NewMockTracer(ctrl)
-					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(5)
+					tracer.EXPECT().Start(ctx, "repository.redisBaseRepository/DeletePattern", gomock.Len(0)).Return(ctx, span).Times(6)
 
 					cacheRepo := mock.NewCacheBackend(ctrl)
 					cacheRepo.EXPECT().Delete(ctx, key).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, byEmailKey).Return(nil)
+					cacheRepo.EXPECT().Delete(ctx, byUsernameKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, getAllKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, organizationsKey).Return(nil)
 					cacheRepo.EXPECT().Delete(ctx, rolesKey).Return(ErrCacheDelete)
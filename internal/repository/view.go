@@ -0,0 +1,530 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+var (
+	ErrViewCreate = errors.New("failed to create view") // the view could not be created
+	ErrViewDelete = errors.New("failed to delete view") // the view could not be deleted
+	ErrViewRead   = errors.New("failed to read view")   // the view could not be retrieved
+	ErrViewUpdate = errors.New("failed to update view") // the view could not be updated
+)
+
+// ViewFilter is the filter of the issues listed by a view. The values of the
+// custom field filters are kept as given and parsed by the type of their
+// field when the view is run, and the query is parsed for the user running
+// the view.
+type ViewFilter struct {
+	Text         string
+	Query        string
+	Statuses     []model.IssueStatus
+	Priorities   []model.IssuePriority
+	CustomFields []IssueListCustomFieldFilter
+	Components   []model.ID
+}
+
+// properties returns the Neo4j properties of the filter.
+func (f ViewFilter) properties() map[string]any {
+	statuses := make([]string, len(f.Statuses))
+	for i, status := range f.Statuses {
+		statuses[i] = status.String()
+	}
+
+	priorities := make([]string, len(f.Priorities))
+	for i, priority := range f.Priorities {
+		priorities[i] = priority.String()
+	}
+
+	customFields := make([]string, 0, len(f.CustomFields))
+	for _, filter := range f.CustomFields {
+		for _, value := range filter.Values {
+			if text, ok := value.(string); ok {
+				customFields = append(customFields, filter.Key+":"+text)
+			}
+		}
+	}
+
+	components := make([]string, len(f.Components))
+	for i, component := range f.Components {
+		components[i] = component.String()
+	}
+
+	return map[string]any{
+		"filter_text":          f.Text,
+		"filter_query":         f.Query,
+		"filter_statuses":      statuses,
+		"filter_priorities":    priorities,
+		"filter_custom_fields": customFields,
+		"filter_components":    components,
+	}
+}
+
+func sortProperties(sort IssueListSort) map[string]any {
+	return map[string]any{
+		"sort_field":     string(sort.Field),
+		"sort_direction": sort.Direction.String(),
+	}
+}
+
+func viewColumnStrings(columns []model.ViewColumn) []string {
+	out := make([]string, len(columns))
+	for i, column := range columns {
+		out[i] = string(column)
+	}
+	return out
+}
+
+// View represents a saved issue view persisted by the repository.
+type View struct {
+	ID          model.ID           `json:"id"`
+	Owner       model.ID           `json:"owner"`
+	Scope       model.ID           `json:"scope"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Filter      ViewFilter         `json:"filter"`
+	Sort        IssueListSort      `json:"sort"`
+	Columns     []model.ViewColumn `json:"columns"`
+	Grouping    model.ViewGrouping `json:"grouping"`
+	CreatedAt   *time.Time         `json:"created_at"`
+	UpdatedAt   *time.Time         `json:"updated_at"`
+}
+
+// viewNode holds the properties of a view node.
+type viewNode struct {
+	Name               string                `json:"name"`
+	Description        string                `json:"description"`
+	FilterText         string                `json:"filter_text"`
+	FilterQuery        string                `json:"filter_query"`
+	FilterStatuses     []model.IssueStatus   `json:"filter_statuses"`
+	FilterPriorities   []model.IssuePriority `json:"filter_priorities"`
+	FilterCustomFields []string              `json:"filter_custom_fields"`
+	FilterComponents   []string              `json:"filter_components"`
+	SortField          IssueListSortField    `json:"sort_field"`
+	SortDirection      string                `json:"sort_direction"`
+	Columns            []model.ViewColumn    `json:"columns"`
+	Grouping           model.ViewGrouping    `json:"grouping"`
+	CreatedAt          *time.Time            `json:"created_at"`
+	UpdatedAt          *time.Time            `json:"updated_at"`
+}
+
+func (n viewNode) view() (*View, error) {
+	v := &View{
+		Name:        n.Name,
+		Description: n.Description,
+		Filter: ViewFilter{
+			Text:       n.FilterText,
+			Query:      n.FilterQuery,
+			Statuses:   n.FilterStatuses,
+			Priorities: n.FilterPriorities,
+		},
+		Sort:      IssueListSort{Field: n.SortField},
+		Columns:   n.Columns,
+		Grouping:  n.Grouping,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+
+	for _, raw := range n.FilterCustomFields {
+		key, value, found := strings.Cut(raw, ":")
+		if !found {
+			return nil, ErrMalformedResult
+		}
+		v.Filter.CustomFields = appendViewCustomFieldFilter(v.Filter.CustomFields, key, value)
+	}
+
+	for _, raw := range n.FilterComponents {
+		component, err := model.NewIDFromString(raw, model.ResourceTypeComponent.String())
+		if err != nil {
+			return nil, errors.Join(ErrMalformedResult, err)
+		}
+		v.Filter.Components = append(v.Filter.Components, component)
+	}
+
+	direction, err := SortDirectionString(n.SortDirection)
+	if err != nil {
+		return nil, errors.Join(ErrMalformedResult, err)
+	}
+	v.Sort.Direction = direction
+
+	if v.Columns == nil {
+		v.Columns = make([]model.ViewColumn, 0)
+	}
+
+	return v, nil
+}
+
+// appendViewCustomFieldFilter adds the value to the filter of the custom
+// field, matching the values of the same field with any.
+func appendViewCustomFieldFilter(filters []IssueListCustomFieldFilter, key, value string) []IssueListCustomFieldFilter {
+	for i := range filters {
+		if filters[i].Key == key {
+			filters[i].Values = append(filters[i].Values, value)
+			return filters
+		}
+	}
+
+	return append(filters, IssueListCustomFieldFilter{Key: key, Values: []any{value}})
+}
+
+// CreateViewOpts holds the data required to create a view.
+type CreateViewOpts struct {
+	Owner       model.ID
+	Scope       model.ID
+	Name        string
+	Description string
+	Filter      ViewFilter
+	Sort        IssueListSort
+	Columns     []model.ViewColumn
+	Grouping    model.ViewGrouping
+}
+
+// UpdateViewOpts holds the fields that can be updated on a view. Undefined
+// fields (Defined == false) are left unchanged, and the filter is replaced as
+// a whole.
+type UpdateViewOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Filter      optional.Optional[ViewFilter]
+	Sort        optional.Optional[IssueListSort]
+	Columns     optional.Optional[[]model.ViewColumn]
+	Grouping    optional.Optional[model.ViewGrouping]
+}
+
+// patch builds a Neo4j property map from defined optional fields.
+func (o UpdateViewOpts) patch() map[string]any {
+	p := make(map[string]any)
+
+	if o.Name.Defined {
+		p["name"] = *o.Name.Value
+	}
+	if o.Description.Defined {
+		p["description"] = *o.Description.Value
+	}
+	if o.Filter.Defined {
+		for key, value := range o.Filter.Value.properties() {
+			p[key] = value
+		}
+	}
+	if o.Sort.Defined {
+		for key, value := range sortProperties(*o.Sort.Value) {
+			p[key] = value
+		}
+	}
+	if o.Columns.Defined {
+		p["columns"] = viewColumnStrings(*o.Columns.Value)
+	}
+	if o.Grouping.Defined {
+		p["grouping"] = string(*o.Grouping.Value)
+	}
+
+	return p
+}
+
+//go:generate go tool mockgen -source=view.go -destination=view_mock_gen.go -package=repository -mock_names "ViewRepository=MockViewRepository"
+type ViewRepository interface {
+	Create(ctx context.Context, opts CreateViewOpts) (*View, error)
+	Get(ctx context.Context, id model.ID, proj ViewProjection) (*View, error)
+	List(ctx context.Context, ids []model.ID, scope *model.ID, page CursorPage, proj ViewProjection) (Page[*View], error)
+	Update(ctx context.Context, id model.ID, opts UpdateViewOpts) (*View, error)
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// Neo4jViewRepository is a repository for managing saved issue views.
+type Neo4jViewRepository struct {
+	*neo4jBaseRepository
+}
+
+func (r *Neo4jViewRepository) scan(vp, op, sp string) func(rec *neo4j.Record) (*View, error) {
+	return func(rec *neo4j.Record) (*View, error) {
+		node, err := Neo4jRecordNode(rec, vp)
+		if err != nil {
+			return nil, err
+		}
+
+		var props viewNode
+		if err := Neo4jScanIntoStruct(&node, &props, []string{"id"}); err != nil {
+			return nil, err
+		}
+
+		v, err := props.view()
+		if err != nil {
+			return nil, err
+		}
+
+		if v.ID, err = Neo4jDecodeID(node, model.ResourceTypeView); err != nil {
+			return nil, err
+		}
+
+		ownerID, err := Neo4jParseValueFromRecord[string](rec, op)
+		if err != nil {
+			return nil, err
+		}
+		if v.Owner, err = model.NewIDFromString(ownerID, model.ResourceTypeUser.String()); err != nil {
+			return nil, errors.Join(ErrMalformedResult, err)
+		}
+
+		scope, err := Neo4jRecordNode(rec, sp)
+		if err != nil {
+			return nil, err
+		}
+		if v.Scope, err = Neo4jDecodeIDFromLabel(scope); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+}
+
+func (r *Neo4jViewRepository) Create(ctx context.Context, opts CreateViewOpts) (*View, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ViewRepository/Create")
+	defer span.End()
+
+	if err := opts.Owner.Validate(); err != nil || opts.Owner.Type != model.ResourceTypeUser {
+		return nil, errors.Join(ErrViewCreate, model.ErrInvalidID)
+	}
+	if err := model.ValidateViewScope(opts.Scope); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	createdAt := time.Now().UTC()
+	id := model.MustNewID(model.ResourceTypeView)
+
+	props := map[string]any{
+		"id":          id.String(),
+		"name":        opts.Name,
+		"description": opts.Description,
+		"columns":     viewColumnStrings(opts.Columns),
+		"grouping":    string(opts.Grouping),
+	}
+	for key, value := range opts.Filter.properties() {
+		props[key] = value
+	}
+	for key, value := range sortProperties(opts.Sort) {
+		props[key] = value
+	}
+
+	cypher := `
+	MATCH (o:` + opts.Owner.Label() + ` {id: $owner_id})
+	MATCH (s:` + opts.Scope.Label() + ` {id: $scope_id})
+	CREATE
+		(v:` + id.Label() + ` $props),
+		(v)-[:` + EdgeKindScopedTo.String() + ` {id: $scoped_rel_id, created_at: datetime($created_at)}]->(s),
+		(o)-[:` + EdgeKindCreated.String() + ` {id: $created_rel_id, created_at: datetime($created_at)}]->(v)
+	SET v.created_at = datetime($created_at)
+	RETURN v.id AS id`
+
+	params := map[string]any{
+		"owner_id":       opts.Owner.String(),
+		"scope_id":       opts.Scope.String(),
+		"scoped_rel_id":  model.NewRawID(),
+		"created_rel_id": model.NewRawID(),
+		"created_at":     createdAt.Format(time.RFC3339Nano),
+		"props":          props,
+	}
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	return r.Get(ctx, id, ViewDetailProjection())
+}
+
+func (r *Neo4jViewRepository) Get(ctx context.Context, id model.ID, proj ViewProjection) (*View, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ViewRepository/Get")
+	defer span.End()
+
+	plan, err := CompileQuery(ViewGetQuery{
+		ID:         id,
+		Projection: proj,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrViewRead, err)
+	}
+
+	var view *View
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		view, _, runErr = Neo4jRunQuerySingle(ctx, tx, plan.Root, r.scan("v", "owner_id", "s"))
+		return runErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrViewRead, err)
+	}
+
+	return view, nil
+}
+
+func (r *Neo4jViewRepository) List(ctx context.Context, ids []model.ID, scope *model.ID, page CursorPage, proj ViewProjection) (Page[*View], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ViewRepository/List")
+	defer span.End()
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewRead, err)
+	}
+	plan, err := CompileQuery(ViewListQuery{
+		IDs:        ids,
+		Scope:      scope,
+		Page:       normalized,
+		Order:      SortDirectionDesc,
+		Projection: proj,
+	})
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewRead, err)
+	}
+
+	items := make([]*View, 0)
+	err = Neo4jExecuteReadPlan(ctx, r.db, plan, func(tx neo4j.ManagedTransaction) error {
+		var runErr error
+		items, _, runErr = Neo4jRunQuery(ctx, tx, plan.Root, r.scan("v", "owner_id", "s"))
+		return runErr
+	})
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewRead, err)
+	}
+
+	return PaginateSlice(items, normalized.Size, func(view *View) model.ID {
+		return view.ID
+	})
+}
+
+func (r *Neo4jViewRepository) Update(ctx context.Context, id model.ID, opts UpdateViewOpts) (*View, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ViewRepository/Update")
+	defer span.End()
+
+	cypher := `
+	MATCH (v:` + id.Label() + ` {id: $id})
+	SET v += $patch, v.updated_at = datetime()
+	RETURN v.id AS id`
+
+	params := map[string]any{
+		"id":    id.String(),
+		"patch": opts.patch(),
+	}
+
+	if _, err := Neo4jExecuteWriteAndReadSingle(ctx, r.db, cypher, params, func(_ *neo4j.Record) (*struct{}, error) {
+		return &struct{}{}, nil
+	}); err != nil {
+		return nil, errors.Join(ErrViewUpdate, err)
+	}
+
+	return r.Get(ctx, id, ViewDetailProjection())
+}
+
+func (r *Neo4jViewRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.neo4j.ViewRepository/Delete")
+	defer span.End()
+
+	cypher := `MATCH (v:` + id.Label() + ` {id: $id}) DETACH DELETE v`
+	params := map[string]any{
+		"id": id.String(),
+	}
+
+	if err := Neo4jExecuteWriteAndConsume(ctx, r.db, cypher, params); err != nil {
+		return errors.Join(ErrViewDelete, err)
+	}
+
+	return nil
+}
+
+// NewNeo4jViewRepository creates a new view neo4jBaseRepository.
+func NewNeo4jViewRepository(opts ...Neo4jRepositoryOption) (*Neo4jViewRepository, error) {
+	baseRepo, err := newNeo4jRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Neo4jViewRepository{
+		neo4jBaseRepository: baseRepo,
+	}, nil
+}
+
+func clearViewsKey(ctx context.Context, r *redisBaseRepository, id model.ID) error {
+	return r.DeletePattern(ctx, composeCacheKey(model.ResourceTypeView.String(), "Get", id.String(), "*"))
+}
+
+// RedisCachedViewRepository implements caching on the ViewRepository. The
+// views are listed by the IDs the user can read, so only single views are
+// cached.
+type RedisCachedViewRepository struct {
+	cacheRepo *redisBaseRepository
+	viewRepo  ViewRepository
+}
+
+func (r *RedisCachedViewRepository) Create(ctx context.Context, opts CreateViewOpts) (*View, error) {
+	return r.viewRepo.Create(ctx, opts)
+}
+
+func (r *RedisCachedViewRepository) Get(ctx context.Context, id model.ID, proj ViewProjection) (*View, error) {
+	var view *View
+	var err error
+
+	key := composeCacheKey(model.ResourceTypeView.String(), "Get", id.String(), projectionCacheValue(proj))
+	if err = r.cacheRepo.Get(ctx, key, &view); err != nil {
+		return nil, err
+	}
+
+	if view != nil {
+		return view, nil
+	}
+
+	if view, err = r.viewRepo.Get(ctx, id, proj); err != nil {
+		return nil, err
+	}
+
+	if err = r.cacheRepo.Set(ctx, key, view); err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
+func (r *RedisCachedViewRepository) List(ctx context.Context, ids []model.ID, scope *model.ID, page CursorPage, proj ViewProjection) (Page[*View], error) {
+	return r.viewRepo.List(ctx, ids, scope, page, proj)
+}
+
+func (r *RedisCachedViewRepository) Update(ctx context.Context, id model.ID, opts UpdateViewOpts) (*View, error) {
+	view, err := r.viewRepo.Update(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := composeCacheKey(model.ResourceTypeView.String(), "Get", id.String(), projectionCacheValue(ViewDetailProjection()))
+	if err := r.cacheRepo.Set(ctx, key, view); err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
+func (r *RedisCachedViewRepository) Delete(ctx context.Context, id model.ID) error {
+	if err := clearViewsKey(ctx, r.cacheRepo, id); err != nil {
+		return err
+	}
+
+	return r.viewRepo.Delete(ctx, id)
+}
+
+// NewCachedViewRepository returns a new CachedViewRepository.
+func NewCachedViewRepository(repo ViewRepository, opts ...RedisRepositoryOption) (*RedisCachedViewRepository, error) {
+	r, err := newRedisBaseRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCachedViewRepository{
+		cacheRepo: r,
+		viewRepo:  repo,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type ViewRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.Neo4jContainerIntegrationTestSuite
+
+	testUser      *repository.User
+	testOrg       *repository.Organization
+	testNamespace *repository.Namespace
+	testProject   *repository.Project
+	createOpts    repository.CreateViewOpts
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	s.SetupNeo4j(&s.ContainerIntegrationTestSuite, reflect.TypeOf(s).Elem().String())
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) SetupTest() {
+	var err error
+	s.testUser, err = s.UserRepo.Create(context.Background(), testModel.NewCreateUserOpts())
+	s.Require().NoError(err)
+	s.testOrg, err = s.OrganizationRepo.Create(context.Background(), testModel.NewCreateOrganizationOpts(s.testUser.ID))
+	s.Require().NoError(err)
+	s.testNamespace, err = s.NamespaceRepo.Create(context.Background(), testModel.NewCreateNamespaceOpts(s.testUser.ID, s.testOrg.ID))
+	s.Require().NoError(err)
+	s.testProject, err = s.ProjectRepo.Create(context.Background(), testModel.NewCreateProjectOpts(s.testNamespace.ID, s.testUser.ID))
+	s.Require().NoError(err)
+	s.createOpts = testModel.NewCreateViewOpts(s.testUser.ID, s.testProject.ID)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupNeo4j(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TestCreate() {
+	view, err := s.ViewRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	s.Assert().NotEqual(model.MustNewNilID(model.ResourceTypeView), view.ID)
+	s.Assert().Equal(s.testUser.ID, view.Owner)
+	s.Assert().Equal(s.testProject.ID, view.Scope)
+	s.Assert().Equal(s.createOpts.Name, view.Name)
+	s.Assert().Equal(s.createOpts.Filter.Query, view.Filter.Query)
+	s.Assert().Equal(s.createOpts.Filter.Statuses, view.Filter.Statuses)
+	s.Assert().Equal(s.createOpts.Sort, view.Sort)
+	s.Assert().Equal(s.createOpts.Columns, view.Columns)
+	s.Assert().Equal(s.createOpts.Grouping, view.Grouping)
+	s.Assert().NotNil(view.CreatedAt)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TestCreateNamespaceScope() {
+	view, err := s.ViewRepo.Create(context.Background(), testModel.NewCreateViewOpts(s.testUser.ID, s.testNamespace.ID))
+	s.Require().NoError(err)
+	s.Assert().Equal(s.testNamespace.ID, view.Scope)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TestCreateMissingScope() {
+	_, err := s.ViewRepo.Create(context.Background(), testModel.NewCreateViewOpts(s.testUser.ID, model.MustNewID(model.ResourceTypeProject)))
+	s.Assert().ErrorIs(err, repository.ErrViewCreate)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TestList() {
+	ids := make([]model.ID, 0, 3)
+	for range 3 {
+		view, err := s.ViewRepo.Create(context.Background(), s.createOpts)
+		s.Require().NoError(err)
+		ids = append(ids, view.ID)
+	}
+	other, err := s.ViewRepo.Create(context.Background(), testModel.NewCreateViewOpts(s.testUser.ID, s.testNamespace.ID))
+	s.Require().NoError(err)
+
+	views, err := s.ViewRepo.List(context.Background(), ids[:2], nil, repository.CursorPage{Size: 10}, repository.ViewListProjection())
+	s.Require().NoError(err)
+	s.Assert().Len(views.Items, 2)
+	s.Assert().False(views.PageInfo.HasMore)
+
+	views, err = s.ViewRepo.List(context.Background(), append(ids, other.ID), &s.testNamespace.ID, repository.CursorPage{Size: 10}, repository.ViewListProjection())
+	s.Require().NoError(err)
+	s.Require().Len(views.Items, 1)
+	s.Assert().Equal(other.ID, views.Items[0].ID)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TestUpdate() {
+	view, err := s.ViewRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	filter := repository.ViewFilter{Text: "login"}
+	updated, err := s.ViewRepo.Update(context.Background(), view.ID, repository.UpdateViewOpts{
+		Name:     optional.Some("Triage"),
+		Filter:   optional.Some(filter),
+		Grouping: optional.Some(model.ViewGroupingNone),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("Triage", updated.Name)
+	s.Assert().Equal(view.Description, updated.Description)
+	s.Assert().Equal(filter.Text, updated.Filter.Text)
+	s.Assert().Empty(updated.Filter.Query)
+	s.Assert().Empty(updated.Filter.Statuses)
+	s.Assert().Equal(view.Sort, updated.Sort)
+	s.Assert().Equal(model.ViewGroupingNone, updated.Grouping)
+	s.Assert().NotNil(updated.UpdatedAt)
+}
+
+func (s *ViewRepositoryIntegrationTestSuite) TestDelete() {
+	view, err := s.ViewRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ViewRepo.Delete(context.Background(), view.ID))
+
+	_, err = s.ViewRepo.Get(context.Background(), view.ID, repository.ViewDetailProjection())
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func TestViewRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ViewRepositoryIntegrationTestSuite))
+}
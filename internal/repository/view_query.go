@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+// ViewProjection selects bounded fields for view reads.
+type ViewProjection struct{}
+
+func ViewListProjection() ViewProjection {
+	return ViewProjection{}
+}
+
+func ViewDetailProjection() ViewProjection {
+	return ViewProjection{}
+}
+
+type ViewGetQuery struct {
+	ID         model.ID
+	Projection ViewProjection
+}
+
+// ViewListQuery lists the views with the given IDs, optionally limited to
+// the views of a scope.
+type ViewListQuery struct {
+	IDs        []model.ID
+	Scope      *model.ID
+	Page       CursorPage
+	Order      SortDirection
+	Projection ViewProjection
+}
+
+func (q ViewGetQuery) Compile() (QueryPlan, error) {
+	if err := q.ID.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "view.get",
+			Cypher: `
+				MATCH (v:` + q.ID.Label() + ` {id: $id})-[:` + EdgeKindScopedTo.String() + `]->(s)
+				MATCH (o:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindCreated.String() + `]->(v)
+				RETURN v, o.id AS owner_id, s`,
+			Params: map[string]any{"id": q.ID.String()},
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (q ViewListQuery) Compile() (QueryPlan, error) {
+	ids := make([]string, len(q.IDs))
+	for i, id := range q.IDs {
+		if err := id.Validate(); err != nil || id.Type != model.ResourceTypeView {
+			return QueryPlan{}, model.ErrInvalidID
+		}
+		ids[i] = id.String()
+	}
+	params := map[string]any{
+		"ids": ids,
+	}
+
+	scopeWhere := ""
+	if q.Scope != nil {
+		if err := q.Scope.Validate(); err != nil {
+			return QueryPlan{}, err
+		}
+		scopeWhere = "s.id = $scope_id"
+		params["scope_id"] = q.Scope.String()
+	}
+
+	bounds, err := compileCursorBounds("v", q.Page, q.Order, params)
+	if err != nil {
+		return QueryPlan{}, err
+	}
+
+	plan := QueryPlan{
+		Root: CompiledQuery{
+			Name: "view.list",
+			Cypher: strings.TrimSpace(`
+				MATCH (v:` + model.ResourceTypeView.String() + `)-[:` + EdgeKindScopedTo.String() + `]->(s)
+				` + whereClause("WHERE ", "v.id IN $ids", scopeWhere, bounds.Where) + `
+				WITH v, s
+				ORDER BY v.id ` + bounds.Order.Cypher() + `
+				LIMIT $limit
+				MATCH (o:` + model.ResourceTypeUser.String() + `)-[:` + EdgeKindCreated.String() + `]->(v)
+				RETURN v, o.id AS owner_id, s`,
+			),
+			Params: params,
+		},
+	}
+
+	if err := plan.Validate(); err != nil {
+		return QueryPlan{}, err
+	}
+
+	return plan, nil
+}
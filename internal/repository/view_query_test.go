@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewGetQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	viewID := model.MustNewID(model.ResourceTypeView)
+
+	t.Run("root query matches view with owner and scope", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ViewGetQuery{
+			ID:         viewID,
+			Projection: ViewDetailProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "view.get", plan.Root.Name)
+		assert.Empty(t, plan.Loaders)
+		assert.Contains(t, plan.Root.Cypher, EdgeKindScopedTo.String())
+		assert.Contains(t, plan.Root.Cypher, EdgeKindCreated.String())
+		assert.Contains(t, plan.Root.Cypher, "RETURN v, o.id AS owner_id, s")
+		assert.Equal(t, viewID.String(), plan.Root.Params["id"])
+	})
+
+	t.Run("invalid view id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ViewGetQuery{ID: model.ID{}})
+		require.Error(t, err)
+	})
+}
+
+func TestViewListQuery_Compile(t *testing.T) {
+	t.Parallel()
+
+	viewID := model.MustNewID(model.ResourceTypeView)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("root query lists views by id", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ViewListQuery{
+			IDs:        []model.ID{viewID},
+			Page:       CursorPage{Size: 10},
+			Order:      SortDirectionDesc,
+			Projection: ViewListProjection(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "view.list", plan.Root.Name)
+		assert.Contains(t, plan.Root.Cypher, "WHERE v.id IN $ids")
+		assert.NotContains(t, plan.Root.Cypher, "$scope_id")
+		assert.Contains(t, plan.Root.Cypher, "ORDER BY v.id DESC")
+		assert.Equal(t, []string{viewID.String()}, plan.Root.Params["ids"])
+		assert.Equal(t, 11, plan.Root.Params["limit"])
+	})
+
+	t.Run("root query lists views of the scope", func(t *testing.T) {
+		t.Parallel()
+
+		plan, err := CompileQuery(ViewListQuery{
+			IDs:        []model.ID{viewID},
+			Scope:      &projectID,
+			Page:       CursorPage{Size: 10},
+			Order:      SortDirectionDesc,
+			Projection: ViewListProjection(),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, plan.Root.Cypher, "v.id IN $ids AND s.id = $scope_id")
+		assert.Equal(t, projectID.String(), plan.Root.Params["scope_id"])
+	})
+
+	t.Run("invalid view id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CompileQuery(ViewListQuery{
+			IDs:  []model.ID{projectID},
+			Page: CursorPage{Size: 10},
+		})
+		require.ErrorIs(t, err, model.ErrInvalidID)
+	})
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestViewNode_view(t *testing.T) {
+	t.Parallel()
+
+	component := model.MustNewID(model.ResourceTypeComponent)
+	filter := ViewFilter{
+		Text:       "login",
+		Query:      "assignee:me",
+		Statuses:   []model.IssueStatus{model.IssueStatusOpen, model.IssueStatusInProgress},
+		Priorities: []model.IssuePriority{model.IssuePriorityHigh},
+		CustomFields: []IssueListCustomFieldFilter{
+			{Key: "team", Values: []any{"web", "api"}},
+			{Key: "severity", Values: []any{"s1"}},
+		},
+		Components: []model.ID{component},
+	}
+	sort := IssueListSort{Field: IssueListSortFieldDueDate, Direction: SortDirectionAsc}
+
+	props := filter.properties()
+	for key, value := range sortProperties(sort) {
+		props[key] = value
+	}
+	props["name"] = "Triage"
+	props["columns"] = viewColumnStrings([]model.ViewColumn{model.ViewColumnKey, model.ViewColumnTitle})
+	props["grouping"] = string(model.ViewGroupingAssignee)
+
+	var node viewNode
+	require.NoError(t, convert.AnyToAny(props, &node))
+
+	got, err := node.view()
+	require.NoError(t, err)
+	assert.Equal(t, &View{
+		Name:     "Triage",
+		Filter:   filter,
+		Sort:     sort,
+		Columns:  []model.ViewColumn{model.ViewColumnKey, model.ViewColumnTitle},
+		Grouping: model.ViewGroupingAssignee,
+	}, got)
+}
+
+func TestCachedViewRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	id := model.MustNewID(model.ResourceTypeView)
+
+	getKey := composeCacheKey(model.ResourceTypeView.String(), "Get", id.String(), "*")
+
+	viewRepo := NewMockViewRepository(ctrl)
+	viewRepo.EXPECT().Delete(ctx, id).Return(nil)
+
+	r := &RedisCachedViewRepository{
+		cacheRepo: newDeletePatternCacheRepo(t, ctrl, ctx, getKey),
+		viewRepo:  viewRepo,
+	}
+
+	require.NoError(t, r.Delete(ctx, id))
+}
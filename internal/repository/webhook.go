@@ -0,0 +1,413 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+const (
+	WebhookDeliveryStatusPending   = "pending"   // the delivery is waiting for a successful attempt
+	WebhookDeliveryStatusSucceeded = "succeeded" // the receiver accepted the delivery
+	WebhookDeliveryStatusFailed    = "failed"    // every attempt of the delivery failed
+)
+
+var (
+	ErrWebhookCreate         = errors.New("failed to create webhook")          // the webhook could not be created
+	ErrWebhookDelete         = errors.New("failed to delete webhook")          // the webhook could not be deleted
+	ErrWebhookRead           = errors.New("failed to read webhook")            // the webhook could not be retrieved
+	ErrWebhookUpdate         = errors.New("failed to update webhook")          // the webhook could not be updated
+	ErrWebhookDeliveryCreate = errors.New("failed to create webhook delivery") // the webhook delivery could not be created
+	ErrWebhookDeliveryRead   = errors.New("failed to read webhook delivery")   // the webhook delivery could not be retrieved
+	ErrWebhookDeliveryUpdate = errors.New("failed to update webhook delivery") // the webhook delivery could not be updated
+)
+
+// Webhook represents an outbound webhook subscription of an organization or
+// project. The secret is used to sign the payload of every delivery.
+type Webhook struct {
+	ID        model.ID   `json:"id"`
+	Scope     model.ID   `json:"scope"`
+	URL       string     `json:"url"`
+	Secret    string     `json:"secret"`
+	Events    []string   `json:"events"`
+	Enabled   bool       `json:"enabled"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// WebhookDelivery represents a payload sent, or to be sent, to a webhook and
+// the outcome of its last attempt.
+type WebhookDelivery struct {
+	ID             model.ID   `json:"id"`
+	Webhook        model.ID   `json:"webhook"`
+	Event          string     `json:"event"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	ResponseStatus *int       `json:"response_status"`
+	Error          *string    `json:"error"`
+	CreatedAt      *time.Time `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+// CreateWebhookOpts holds the data required to create a webhook.
+type CreateWebhookOpts struct {
+	Scope   model.ID
+	URL     string
+	Secret  string
+	Events  []string
+	Enabled bool
+}
+
+// UpdateWebhookOpts holds the fields that can be updated on a webhook.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateWebhookOpts struct {
+	URL     optional.Optional[string]
+	Secret  optional.Optional[string]
+	Events  optional.Optional[[]string]
+	Enabled optional.Optional[bool]
+}
+
+// CreateWebhookDeliveryOpts holds the data required to create a delivery.
+type CreateWebhookDeliveryOpts struct {
+	Webhook model.ID
+	Event   string
+	Payload string
+}
+
+// WebhookDeliveryAttempt describes the outcome of a delivery attempt.
+type WebhookDeliveryAttempt struct {
+	Status         string
+	ResponseStatus *int
+	Error          *string
+}
+
+//go:generate go tool mockgen -source=webhook.go -destination=webhook_mock_gen.go -package=repository -mock_names "WebhookRepository=MockWebhookRepository"
+type WebhookRepository interface {
+	Create(ctx context.Context, opts CreateWebhookOpts) (*Webhook, error)
+	Get(ctx context.Context, id model.ID) (*Webhook, error)
+	ListByScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Webhook], error)
+	// ListSubscribed returns the enabled webhooks of the given scopes that
+	// subscribed to the event.
+	ListSubscribed(ctx context.Context, scopes []model.ID, event string) ([]*Webhook, error)
+	Update(ctx context.Context, id model.ID, opts UpdateWebhookOpts) (*Webhook, error)
+	Delete(ctx context.Context, id model.ID) error
+	CreateDelivery(ctx context.Context, opts CreateWebhookDeliveryOpts) (*WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id model.ID) (*WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, webhook model.ID, page CursorPage) (Page[*WebhookDelivery], error)
+	// RecordDeliveryAttempt stores the outcome of an attempt and increments
+	// the number of attempts of the delivery.
+	RecordDeliveryAttempt(ctx context.Context, id model.ID, attempt WebhookDeliveryAttempt) (*WebhookDelivery, error)
+}
+
+// PGWebhookRepository is a repository for managing webhooks and their
+// deliveries.
+type PGWebhookRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGWebhookRepository) Create(ctx context.Context, opts CreateWebhookOpts) (*Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/Create")
+	defer span.End()
+
+	events := opts.Events
+	if events == nil {
+		events = make([]string, 0)
+	}
+
+	webhook := &Webhook{
+		ID:        model.MustNewID(model.ResourceTypeWebhook),
+		Scope:     opts.Scope,
+		URL:       opts.URL,
+		Secret:    opts.Secret,
+		Events:    events,
+		Enabled:   opts.Enabled,
+		CreatedAt: convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	}
+
+	_, err := r.db.pool.Exec(ctx,
+		"INSERT INTO webhooks (id, scope, url, secret, events, enabled, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		webhook.ID, webhook.Scope, webhook.URL, webhook.Secret, webhook.Events, webhook.Enabled, *webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookCreate, err)
+	}
+
+	return webhook, nil
+}
+
+func (r *PGWebhookRepository) Get(ctx context.Context, id model.ID) (*Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/Get")
+	defer span.End()
+
+	webhook, err := scanWebhook(r.db.pool.QueryRow(ctx, "SELECT * FROM webhooks WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWebhookRead, err)
+	}
+
+	return webhook, nil
+}
+
+func (r *PGWebhookRepository) ListByScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Webhook], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/ListByScope")
+	defer span.End()
+
+	webhooks, normalized, err := listPG(ctx, r.db, "webhooks", "scope", scope, page, scanWebhook)
+	if err != nil {
+		return Page[*Webhook]{}, errors.Join(ErrWebhookRead, err)
+	}
+
+	return PaginateSlice(webhooks, normalized.Size, func(webhook *Webhook) model.ID {
+		return webhook.ID
+	})
+}
+
+func (r *PGWebhookRepository) ListSubscribed(ctx context.Context, scopes []model.ID, event string) ([]*Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/ListSubscribed")
+	defer span.End()
+
+	ids := make([]string, len(scopes))
+	for i, scope := range scopes {
+		ids[i] = scope.Composite()
+	}
+
+	rows, err := r.db.pool.Query(ctx,
+		"SELECT * FROM webhooks WHERE enabled AND scope = ANY($1) AND $2 = ANY(events) ORDER BY id",
+		ids, event,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookRead, err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]*Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, errors.Join(ErrWebhookRead, err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrWebhookRead, err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *PGWebhookRepository) Update(ctx context.Context, id model.ID, opts UpdateWebhookOpts) (*Webhook, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/Update")
+	defer span.End()
+
+	sets := []string{"updated_at = timezone('utc', now())"}
+	args := []any{id}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if opts.URL.Defined && opts.URL.Value != nil {
+		set("url", *opts.URL.Value)
+	}
+	if opts.Secret.Defined && opts.Secret.Value != nil {
+		set("secret", *opts.Secret.Value)
+	}
+	if opts.Events.Defined && opts.Events.Value != nil {
+		set("events", *opts.Events.Value)
+	}
+	if opts.Enabled.Defined && opts.Enabled.Value != nil {
+		set("enabled", *opts.Enabled.Value)
+	}
+
+	row := r.db.pool.QueryRow(ctx,
+		fmt.Sprintf("UPDATE webhooks SET %s WHERE id = $1 RETURNING *", strings.Join(sets, ", ")),
+		args...,
+	)
+	webhook, err := scanWebhook(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWebhookUpdate, err)
+	}
+
+	return webhook, nil
+}
+
+func (r *PGWebhookRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/Delete")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx, "DELETE FROM webhooks WHERE id = $1", id)
+	if err != nil {
+		return errors.Join(ErrWebhookDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PGWebhookRepository) CreateDelivery(ctx context.Context, opts CreateWebhookDeliveryOpts) (*WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/CreateDelivery")
+	defer span.End()
+
+	delivery := &WebhookDelivery{
+		ID:        model.MustNewID(model.ResourceTypeWebhookDelivery),
+		Webhook:   opts.Webhook,
+		Event:     opts.Event,
+		Payload:   opts.Payload,
+		Status:    WebhookDeliveryStatusPending,
+		CreatedAt: convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	}
+
+	_, err := r.db.pool.Exec(ctx,
+		"INSERT INTO webhook_deliveries (id, webhook_id, event, payload, status, attempts, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		delivery.ID, delivery.Webhook, delivery.Event, delivery.Payload, delivery.Status, delivery.Attempts, *delivery.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookDeliveryCreate, err)
+	}
+
+	return delivery, nil
+}
+
+func (r *PGWebhookRepository) GetDelivery(ctx context.Context, id model.ID) (*WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/GetDelivery")
+	defer span.End()
+
+	delivery, err := scanWebhookDelivery(r.db.pool.QueryRow(ctx, "SELECT * FROM webhook_deliveries WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWebhookDeliveryRead, err)
+	}
+
+	return delivery, nil
+}
+
+func (r *PGWebhookRepository) ListDeliveries(ctx context.Context, webhook model.ID, page CursorPage) (Page[*WebhookDelivery], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/ListDeliveries")
+	defer span.End()
+
+	deliveries, normalized, err := listPG(ctx, r.db, "webhook_deliveries", "webhook_id", webhook, page, scanWebhookDelivery)
+	if err != nil {
+		return Page[*WebhookDelivery]{}, errors.Join(ErrWebhookDeliveryRead, err)
+	}
+
+	return PaginateSlice(deliveries, normalized.Size, func(delivery *WebhookDelivery) model.ID {
+		return delivery.ID
+	})
+}
+
+func (r *PGWebhookRepository) RecordDeliveryAttempt(ctx context.Context, id model.ID, attempt WebhookDeliveryAttempt) (*WebhookDelivery, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WebhookRepository/RecordDeliveryAttempt")
+	defer span.End()
+
+	row := r.db.pool.QueryRow(ctx,
+		`UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, response_status = $3, error = $4,
+			delivered_at = CASE WHEN $2 = 'succeeded' THEN timezone('utc', now()) ELSE delivered_at END
+		WHERE id = $1 RETURNING *`,
+		id, attempt.Status, attempt.ResponseStatus, attempt.Error,
+	)
+	delivery, err := scanWebhookDelivery(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWebhookDeliveryUpdate, err)
+	}
+
+	return delivery, nil
+}
+
+// listPG returns the rows of the table whose column equals the value, newest
+// first, fetching one item more than the page size for the pagination.
+func listPG[T any](ctx context.Context, db *PGDatabase, table, column string, value model.ID, page CursorPage, scan func(pgx.Row) (T, error)) ([]T, CursorPage, error) {
+	normalized, err := page.Normalize()
+	if err != nil {
+		return nil, CursorPage{}, err
+	}
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s = $1", table, column)
+	args := []any{value}
+	if normalized.Token != nil && *normalized.Token != "" {
+		id, err := DecodeCursor(*normalized.Token)
+		if err != nil {
+			return nil, CursorPage{}, err
+		}
+		query += " AND id < $2"
+		args = append(args, id)
+	}
+	query += fmt.Sprintf(" ORDER BY id %s LIMIT $%d", SortDirectionDesc.Cypher(), len(args)+1)
+	args = append(args, normalized.FetchLimit())
+
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, CursorPage{}, err
+	}
+	defer rows.Close()
+
+	items := make([]T, 0, normalized.FetchLimit())
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, CursorPage{}, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, CursorPage{}, err
+	}
+
+	return items, normalized, nil
+}
+
+func scanWebhook(row pgx.Row) (*Webhook, error) {
+	var w Webhook
+	if err := row.Scan(
+		&w.ID, &w.Scope, &w.URL, &w.Secret, &w.Events, &w.Enabled, &w.CreatedAt, &w.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+func scanWebhookDelivery(row pgx.Row) (*WebhookDelivery, error) {
+	var d WebhookDelivery
+	if err := row.Scan(
+		&d.ID, &d.Webhook, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.ResponseStatus, &d.Error,
+		&d.CreatedAt, &d.DeliveredAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// NewWebhookRepository creates a new WebhookRepository.
+func NewWebhookRepository(opts ...PGRepositoryOption) (*PGWebhookRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGWebhookRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
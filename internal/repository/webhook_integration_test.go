@@ -0,0 +1,214 @@
+package repository_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type WebhookRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	createOpts repository.CreateWebhookOpts
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) SetupTest() {
+	s.createOpts = repository.CreateWebhookOpts{
+		Scope:   model.MustNewID(model.ResourceTypeOrganization),
+		URL:     "https://example.com/hooks/elemo",
+		Secret:  "0123456789abcdef",
+		Events:  []string{"issue.updated"},
+		Enabled: true,
+	}
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestCreate() {
+	webhook, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	s.Assert().NotEqual(model.MustNewNilID(model.ResourceTypeWebhook), webhook.ID)
+	s.Assert().NotNil(webhook.CreatedAt)
+	s.Assert().Nil(webhook.UpdatedAt)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestGet() {
+	created, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	webhook, err := s.WebhookRepo.Get(context.Background(), created.ID)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(created.ID, webhook.ID)
+	s.Assert().Equal(s.createOpts.Scope, webhook.Scope)
+	s.Assert().Equal(s.createOpts.URL, webhook.URL)
+	s.Assert().Equal(s.createOpts.Secret, webhook.Secret)
+	s.Assert().Equal(s.createOpts.Events, webhook.Events)
+	s.Assert().True(webhook.Enabled)
+	s.Assert().WithinDuration(*created.CreatedAt, *webhook.CreatedAt, 100*time.Millisecond)
+
+	_, err = s.WebhookRepo.Get(context.Background(), model.MustNewID(model.ResourceTypeWebhook))
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestListByScope() {
+	_, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+	_, err = s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	webhooks, err := s.WebhookRepo.ListByScope(context.Background(), s.createOpts.Scope, repository.CursorPage{Size: 1})
+	s.Require().NoError(err)
+	s.Assert().Len(webhooks.Items, 1)
+	s.Assert().True(webhooks.PageInfo.HasMore)
+
+	webhooks, err = s.WebhookRepo.ListByScope(context.Background(), s.createOpts.Scope, repository.CursorPage{Size: 1, Token: webhooks.PageInfo.NextPageToken})
+	s.Require().NoError(err)
+	s.Assert().Len(webhooks.Items, 1)
+	s.Assert().False(webhooks.PageInfo.HasMore)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestListSubscribed() {
+	subscribed, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	disabled := s.createOpts
+	disabled.Enabled = false
+	_, err = s.WebhookRepo.Create(context.Background(), disabled)
+	s.Require().NoError(err)
+
+	otherEvent := s.createOpts
+	otherEvent.Events = []string{"document.updated"}
+	_, err = s.WebhookRepo.Create(context.Background(), otherEvent)
+	s.Require().NoError(err)
+
+	otherScope := s.createOpts
+	otherScope.Scope = model.MustNewID(model.ResourceTypeOrganization)
+	_, err = s.WebhookRepo.Create(context.Background(), otherScope)
+	s.Require().NoError(err)
+
+	project := model.MustNewID(model.ResourceTypeProject)
+	webhooks, err := s.WebhookRepo.ListSubscribed(context.Background(), []model.ID{project, s.createOpts.Scope}, "issue.updated")
+	s.Require().NoError(err)
+	s.Require().Len(webhooks, 1)
+	s.Assert().Equal(subscribed.ID, webhooks[0].ID)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestUpdate() {
+	created, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	webhook, err := s.WebhookRepo.Update(context.Background(), created.ID, repository.UpdateWebhookOpts{
+		URL:     optional.Some("https://example.com/hooks/other"),
+		Events:  optional.Some([]string{"issue.updated", "document.updated"}),
+		Enabled: optional.Some(false),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("https://example.com/hooks/other", webhook.URL)
+	s.Assert().Equal(s.createOpts.Secret, webhook.Secret)
+	s.Assert().Equal([]string{"issue.updated", "document.updated"}, webhook.Events)
+	s.Assert().False(webhook.Enabled)
+	s.Assert().NotNil(webhook.UpdatedAt)
+
+	_, err = s.WebhookRepo.Update(context.Background(), model.MustNewID(model.ResourceTypeWebhook), repository.UpdateWebhookOpts{})
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestDelete() {
+	created, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	delivery, err := s.WebhookRepo.CreateDelivery(context.Background(), repository.CreateWebhookDeliveryOpts{
+		Webhook: created.ID,
+		Event:   "issue.updated",
+		Payload: `{"event":"issue.updated"}`,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.WebhookRepo.Delete(context.Background(), created.ID))
+
+	_, err = s.WebhookRepo.Get(context.Background(), created.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+
+	_, err = s.WebhookRepo.GetDelivery(context.Background(), delivery.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+
+	s.Assert().ErrorIs(s.WebhookRepo.Delete(context.Background(), created.ID), repository.ErrNotFound)
+}
+
+func (s *WebhookRepositoryIntegrationTestSuite) TestDeliveries() {
+	webhook, err := s.WebhookRepo.Create(context.Background(), s.createOpts)
+	s.Require().NoError(err)
+
+	opts := repository.CreateWebhookDeliveryOpts{
+		Webhook: webhook.ID,
+		Event:   "issue.updated",
+		Payload: `{"event":"issue.updated"}`,
+	}
+
+	first, err := s.WebhookRepo.CreateDelivery(context.Background(), opts)
+	s.Require().NoError(err)
+	s.Assert().Equal(repository.WebhookDeliveryStatusPending, first.Status)
+
+	second, err := s.WebhookRepo.CreateDelivery(context.Background(), opts)
+	s.Require().NoError(err)
+
+	delivery, err := s.WebhookRepo.RecordDeliveryAttempt(context.Background(), first.ID, repository.WebhookDeliveryAttempt{
+		Status:         repository.WebhookDeliveryStatusPending,
+		ResponseStatus: convert.ToPointer(http.StatusBadGateway),
+		Error:          convert.ToPointer("unexpected response status 502"),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(1, delivery.Attempts)
+	s.Assert().Nil(delivery.DeliveredAt)
+
+	delivery, err = s.WebhookRepo.RecordDeliveryAttempt(context.Background(), first.ID, repository.WebhookDeliveryAttempt{
+		Status:         repository.WebhookDeliveryStatusSucceeded,
+		ResponseStatus: convert.ToPointer(http.StatusOK),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(2, delivery.Attempts)
+	s.Assert().Equal(repository.WebhookDeliveryStatusSucceeded, delivery.Status)
+	s.Assert().Equal(convert.ToPointer(http.StatusOK), delivery.ResponseStatus)
+	s.Assert().Nil(delivery.Error)
+	s.Assert().NotNil(delivery.DeliveredAt)
+
+	got, err := s.WebhookRepo.GetDelivery(context.Background(), first.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(opts.Payload, got.Payload)
+
+	deliveries, err := s.WebhookRepo.ListDeliveries(context.Background(), webhook.ID, repository.CursorPage{Size: 10})
+	s.Require().NoError(err)
+	s.Require().Len(deliveries.Items, 2)
+	s.Assert().Equal(second.ID, deliveries.Items[0].ID)
+	s.Assert().Equal(first.ID, deliveries.Items[1].ID)
+}
+
+func TestWebhookRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookRepositoryIntegrationTestSuite))
+}
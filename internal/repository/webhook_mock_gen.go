@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook.go -destination=webhook_mock_gen.go -package=repository -mock_names WebhookRepository=MockWebhookRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookRepository) Create(ctx context.Context, opts CreateWebhookOpts) (*Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookRepositoryMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), ctx, opts)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, opts CreateWebhookDeliveryOpts) (*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, opts)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) CreateDelivery(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDelivery), ctx, opts)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookRepository) Delete(ctx context.Context, id model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockWebhookRepository) Get(ctx context.Context, id model.ID) (*Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookRepository)(nil).Get), ctx, id)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id model.ID) (*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) GetDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetDelivery), ctx, id)
+}
+
+// ListByScope mocks base method.
+func (m *MockWebhookRepository) ListByScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Webhook], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByScope", ctx, scope, page)
+	ret0, _ := ret[0].(Page[*Webhook])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByScope indicates an expected call of ListByScope.
+func (mr *MockWebhookRepositoryMockRecorder) ListByScope(ctx, scope, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByScope", reflect.TypeOf((*MockWebhookRepository)(nil).ListByScope), ctx, scope, page)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, webhook model.ID, page CursorPage) (Page[*WebhookDelivery], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, webhook, page)
+	ret0, _ := ret[0].(Page[*WebhookDelivery])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ListDeliveries(ctx, webhook, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListDeliveries), ctx, webhook, page)
+}
+
+// ListSubscribed mocks base method.
+func (m *MockWebhookRepository) ListSubscribed(ctx context.Context, scopes []model.ID, event string) ([]*Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscribed", ctx, scopes, event)
+	ret0, _ := ret[0].([]*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscribed indicates an expected call of ListSubscribed.
+func (mr *MockWebhookRepositoryMockRecorder) ListSubscribed(ctx, scopes, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscribed", reflect.TypeOf((*MockWebhookRepository)(nil).ListSubscribed), ctx, scopes, event)
+}
+
+// RecordDeliveryAttempt mocks base method.
+func (m *MockWebhookRepository) RecordDeliveryAttempt(ctx context.Context, id model.ID, attempt WebhookDeliveryAttempt) (*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDeliveryAttempt", ctx, id, attempt)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordDeliveryAttempt indicates an expected call of RecordDeliveryAttempt.
+func (mr *MockWebhookRepositoryMockRecorder) RecordDeliveryAttempt(ctx, id, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDeliveryAttempt", reflect.TypeOf((*MockWebhookRepository)(nil).RecordDeliveryAttempt), ctx, id, attempt)
+}
+
+// Update mocks base method.
+func (m *MockWebhookRepository) Update(ctx context.Context, id model.ID, opts UpdateWebhookOpts) (*Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, opts)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookRepositoryMockRecorder) Update(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), ctx, id, opts)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+var (
+	ErrWIPLimitRead = errors.New("failed to read WIP limits") // the WIP limits could not be retrieved
+	ErrWIPLimitSave = errors.New("failed to save WIP limits") // the WIP limits could not be saved
+)
+
+//go:generate go tool mockgen -source=wip_limit.go -destination=wip_limit_mock_gen.go -package=repository -mock_names "WIPLimitRepository=MockWIPLimitRepository"
+type WIPLimitRepository interface {
+	// List returns the WIP limits of the board columns of the project. If the
+	// project has no WIP limits, an empty list is returned.
+	List(ctx context.Context, project model.ID) (model.WIPLimits, error)
+	// Save replaces the WIP limits of the project.
+	Save(ctx context.Context, project model.ID, limits model.WIPLimits) (model.WIPLimits, error)
+}
+
+// PGWIPLimitRepository is a repository for managing the WIP limits of project
+// boards.
+type PGWIPLimitRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGWIPLimitRepository) List(ctx context.Context, project model.ID) (model.WIPLimits, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WIPLimitRepository/List")
+	defer span.End()
+
+	limits := make(model.WIPLimits, 0)
+	if err := r.db.pool.QueryRow(ctx, "SELECT limits FROM project_wip_limits WHERE project_id = $1", project).Scan(&limits); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return make(model.WIPLimits, 0), nil
+		}
+		return nil, errors.Join(ErrWIPLimitRead, err)
+	}
+
+	return limits, nil
+}
+
+func (r *PGWIPLimitRepository) Save(ctx context.Context, project model.ID, limits model.WIPLimits) (model.WIPLimits, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WIPLimitRepository/Save")
+	defer span.End()
+
+	if limits == nil {
+		limits = make(model.WIPLimits, 0)
+	}
+
+	saved := make(model.WIPLimits, 0)
+	if err := r.db.pool.QueryRow(ctx,
+		`INSERT INTO project_wip_limits (project_id, limits, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE SET limits = EXCLUDED.limits, updated_at = timezone('utc', now())
+		RETURNING limits`,
+		project, limits, convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	).Scan(&saved); err != nil {
+		return nil, errors.Join(ErrWIPLimitSave, err)
+	}
+
+	return saved, nil
+}
+
+// NewWIPLimitRepository creates a new WIPLimitRepository.
+func NewWIPLimitRepository(opts ...PGRepositoryOption) (*PGWIPLimitRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGWIPLimitRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type WIPLimitRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	project model.ID
+	limits  model.WIPLimits
+}
+
+func (s *WIPLimitRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *WIPLimitRepositoryIntegrationTestSuite) SetupTest() {
+	s.project = model.MustNewID(model.ResourceTypeProject)
+	s.limits = model.WIPLimits{
+		{GroupBy: model.BoardGroupByStatus, Column: "in progress", Limit: 5},
+		{GroupBy: model.BoardGroupByWorkflowStatus, Column: "code_review", Limit: 3},
+	}
+}
+
+func (s *WIPLimitRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *WIPLimitRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *WIPLimitRepositoryIntegrationTestSuite) TestSave() {
+	limits, err := s.WIPLimitRepo.Save(context.Background(), s.project, s.limits)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.limits, limits)
+
+	limits, err = s.WIPLimitRepo.Save(context.Background(), s.project, s.limits[:1])
+	s.Require().NoError(err)
+	s.Assert().Equal(s.limits[:1], limits)
+}
+
+func (s *WIPLimitRepositoryIntegrationTestSuite) TestList() {
+	limits, err := s.WIPLimitRepo.List(context.Background(), s.project)
+	s.Require().NoError(err)
+	s.Assert().Empty(limits)
+
+	_, err = s.WIPLimitRepo.Save(context.Background(), s.project, s.limits)
+	s.Require().NoError(err)
+
+	limits, err = s.WIPLimitRepo.List(context.Background(), s.project)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.limits, limits)
+}
+
+func TestWIPLimitRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(WIPLimitRepositoryIntegrationTestSuite))
+}
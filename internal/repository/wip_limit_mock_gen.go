@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: wip_limit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=wip_limit.go -destination=wip_limit_mock_gen.go -package=repository -mock_names WIPLimitRepository=MockWIPLimitRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWIPLimitRepository is a mock of WIPLimitRepository interface.
+type MockWIPLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWIPLimitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWIPLimitRepositoryMockRecorder is the mock recorder for MockWIPLimitRepository.
+type MockWIPLimitRepositoryMockRecorder struct {
+	mock *MockWIPLimitRepository
+}
+
+// NewMockWIPLimitRepository creates a new mock instance.
+func NewMockWIPLimitRepository(ctrl *gomock.Controller) *MockWIPLimitRepository {
+	mock := &MockWIPLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockWIPLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWIPLimitRepository) EXPECT() *MockWIPLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockWIPLimitRepository) List(ctx context.Context, project model.ID) (model.WIPLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, project)
+	ret0, _ := ret[0].(model.WIPLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWIPLimitRepositoryMockRecorder) List(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWIPLimitRepository)(nil).List), ctx, project)
+}
+
+// Save mocks base method.
+func (m *MockWIPLimitRepository) Save(ctx context.Context, project model.ID, limits model.WIPLimits) (model.WIPLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, project, limits)
+	ret0, _ := ret[0].(model.WIPLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockWIPLimitRepositoryMockRecorder) Save(ctx, project, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWIPLimitRepository)(nil).Save), ctx, project, limits)
+}
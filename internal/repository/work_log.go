@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+)
+
+var (
+	ErrWorkLogCreate = errors.New("failed to create work log") // the work log could not be created
+	ErrWorkLogDelete = errors.New("failed to delete work log") // the work log could not be deleted
+	ErrWorkLogRead   = errors.New("failed to read work log")   // the work log could not be retrieved
+	ErrWorkLogUpdate = errors.New("failed to update work log") // the work log could not be updated
+)
+
+// WorkLog is the time a user spent on an issue on a given day. The duration
+// is in minutes.
+type WorkLog struct {
+	ID        model.ID   `json:"id"`
+	Issue     model.ID   `json:"issue"`
+	User      model.ID   `json:"user"`
+	Duration  uint       `json:"duration"`
+	Date      time.Time  `json:"date"`
+	Note      string     `json:"note"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// CreateWorkLogOpts holds the data required to create a work log.
+type CreateWorkLogOpts struct {
+	Issue    model.ID
+	User     model.ID
+	Duration uint
+	Date     time.Time
+	Note     string
+}
+
+// UpdateWorkLogOpts holds the fields that can be updated on a work log.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateWorkLogOpts struct {
+	Duration optional.Optional[uint]
+	Date     optional.Optional[time.Time]
+	Note     optional.Optional[string]
+}
+
+//go:generate go tool mockgen -source=work_log.go -destination=work_log_mock_gen.go -package=repository -mock_names "WorkLogRepository=MockWorkLogRepository"
+type WorkLogRepository interface {
+	Create(ctx context.Context, opts CreateWorkLogOpts) (*WorkLog, error)
+	Get(ctx context.Context, id model.ID) (*WorkLog, error)
+	ListByIssue(ctx context.Context, issue model.ID, page CursorPage) (Page[*WorkLog], error)
+	// ListByUser returns the work logs of the user dated between from and to,
+	// both inclusive, oldest first.
+	ListByUser(ctx context.Context, user model.ID, from, to time.Time) ([]*WorkLog, error)
+	// SumByIssues returns the total minutes logged on each of the issues.
+	// Issues without work logs are omitted.
+	SumByIssues(ctx context.Context, issues []model.ID) (map[model.ID]uint, error)
+	Update(ctx context.Context, id model.ID, opts UpdateWorkLogOpts) (*WorkLog, error)
+	Delete(ctx context.Context, id model.ID) error
+	// DeleteByIssues deletes the work logs of the issues.
+	DeleteByIssues(ctx context.Context, issues []model.ID) error
+}
+
+// PGWorkLogRepository is a repository for managing the work logs of issues.
+type PGWorkLogRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGWorkLogRepository) Create(ctx context.Context, opts CreateWorkLogOpts) (*WorkLog, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/Create")
+	defer span.End()
+
+	workLog := &WorkLog{
+		ID:        model.MustNewID(model.ResourceTypeWorkLog),
+		Issue:     opts.Issue,
+		User:      opts.User,
+		Duration:  opts.Duration,
+		Date:      opts.Date,
+		Note:      opts.Note,
+		CreatedAt: convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	}
+
+	_, err := r.db.pool.Exec(ctx,
+		"INSERT INTO work_logs (id, issue_id, user_id, duration, date, note, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		workLog.ID, workLog.Issue, workLog.User, workLog.Duration, workLog.Date, workLog.Note, *workLog.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrWorkLogCreate, err)
+	}
+
+	return workLog, nil
+}
+
+func (r *PGWorkLogRepository) Get(ctx context.Context, id model.ID) (*WorkLog, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/Get")
+	defer span.End()
+
+	workLog, err := scanWorkLog(r.db.pool.QueryRow(ctx, "SELECT * FROM work_logs WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWorkLogRead, err)
+	}
+
+	return workLog, nil
+}
+
+func (r *PGWorkLogRepository) ListByIssue(ctx context.Context, issue model.ID, page CursorPage) (Page[*WorkLog], error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/ListByIssue")
+	defer span.End()
+
+	workLogs, normalized, err := listPG(ctx, r.db, "work_logs", "issue_id", issue, page, scanWorkLog)
+	if err != nil {
+		return Page[*WorkLog]{}, errors.Join(ErrWorkLogRead, err)
+	}
+
+	return PaginateSlice(workLogs, normalized.Size, func(workLog *WorkLog) model.ID {
+		return workLog.ID
+	})
+}
+
+func (r *PGWorkLogRepository) ListByUser(ctx context.Context, user model.ID, from, to time.Time) ([]*WorkLog, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/ListByUser")
+	defer span.End()
+
+	rows, err := r.db.pool.Query(ctx,
+		"SELECT * FROM work_logs WHERE user_id = $1 AND date BETWEEN $2 AND $3 ORDER BY date ASC, created_at ASC, id ASC",
+		user, from, to,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrWorkLogRead, err)
+	}
+	defer rows.Close()
+
+	workLogs := make([]*WorkLog, 0)
+	for rows.Next() {
+		workLog, err := scanWorkLog(rows)
+		if err != nil {
+			return nil, errors.Join(ErrWorkLogRead, err)
+		}
+		workLogs = append(workLogs, workLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrWorkLogRead, err)
+	}
+
+	return workLogs, nil
+}
+
+func (r *PGWorkLogRepository) SumByIssues(ctx context.Context, issues []model.ID) (map[model.ID]uint, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/SumByIssues")
+	defer span.End()
+
+	totals := make(map[model.ID]uint, len(issues))
+	if len(issues) == 0 {
+		return totals, nil
+	}
+
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.Composite()
+	}
+
+	rows, err := r.db.pool.Query(ctx,
+		"SELECT issue_id, SUM(duration) FROM work_logs WHERE issue_id = ANY($1) GROUP BY issue_id",
+		ids,
+	)
+	if err != nil {
+		return nil, errors.Join(ErrWorkLogRead, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issue model.ID
+		var total int64
+		if err := rows.Scan(&issue, &total); err != nil {
+			return nil, errors.Join(ErrWorkLogRead, err)
+		}
+		totals[issue] = uint(total)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Join(ErrWorkLogRead, err)
+	}
+
+	return totals, nil
+}
+
+func (r *PGWorkLogRepository) Update(ctx context.Context, id model.ID, opts UpdateWorkLogOpts) (*WorkLog, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/Update")
+	defer span.End()
+
+	sets := []string{"updated_at = timezone('utc', now())"}
+	args := []any{id}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if opts.Duration.Defined && opts.Duration.Value != nil {
+		set("duration", *opts.Duration.Value)
+	}
+	if opts.Date.Defined && opts.Date.Value != nil {
+		set("date", *opts.Date.Value)
+	}
+	if opts.Note.Defined {
+		var note string
+		if opts.Note.Value != nil {
+			note = *opts.Note.Value
+		}
+		set("note", note)
+	}
+
+	row := r.db.pool.QueryRow(ctx,
+		fmt.Sprintf("UPDATE work_logs SET %s WHERE id = $1 RETURNING *", strings.Join(sets, ", ")),
+		args...,
+	)
+	workLog, err := scanWorkLog(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWorkLogUpdate, err)
+	}
+
+	return workLog, nil
+}
+
+func (r *PGWorkLogRepository) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/Delete")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx, "DELETE FROM work_logs WHERE id = $1", id)
+	if err != nil {
+		return errors.Join(ErrWorkLogDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PGWorkLogRepository) DeleteByIssues(ctx context.Context, issues []model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkLogRepository/DeleteByIssues")
+	defer span.End()
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.Composite()
+	}
+
+	if _, err := r.db.pool.Exec(ctx, "DELETE FROM work_logs WHERE issue_id = ANY($1)", ids); err != nil {
+		return errors.Join(ErrWorkLogDelete, err)
+	}
+
+	return nil
+}
+
+func scanWorkLog(row pgx.Row) (*WorkLog, error) {
+	var w WorkLog
+	if err := row.Scan(
+		&w.ID, &w.Issue, &w.User, &w.Duration, &w.Date, &w.Note, &w.CreatedAt, &w.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+// NewWorkLogRepository creates a new WorkLogRepository.
+func NewWorkLogRepository(opts ...PGRepositoryOption) (*PGWorkLogRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGWorkLogRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type WorkLogRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	issue model.ID
+	user  model.ID
+	date  time.Time
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) SetupTest() {
+	s.issue = model.MustNewID(model.ResourceTypeIssue)
+	s.user = model.MustNewID(model.ResourceTypeUser)
+	s.date = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) createWorkLog(issue model.ID, duration uint, date time.Time) *repository.WorkLog {
+	workLog, err := s.WorkLogRepo.Create(context.Background(), repository.CreateWorkLogOpts{
+		Issue:    issue,
+		User:     s.user,
+		Duration: duration,
+		Date:     date,
+		Note:     "Implementation",
+	})
+	s.Require().NoError(err)
+	return workLog
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestCreateAndGet() {
+	created := s.createWorkLog(s.issue, 90, s.date)
+
+	workLog, err := s.WorkLogRepo.Get(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(created.ID, workLog.ID)
+	s.Assert().Equal(s.issue, workLog.Issue)
+	s.Assert().Equal(s.user, workLog.User)
+	s.Assert().Equal(uint(90), workLog.Duration)
+	s.Assert().True(s.date.Equal(workLog.Date))
+	s.Assert().Equal("Implementation", workLog.Note)
+	s.Assert().Nil(workLog.UpdatedAt)
+
+	_, err = s.WorkLogRepo.Get(context.Background(), model.MustNewID(model.ResourceTypeWorkLog))
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestListByIssue() {
+	s.createWorkLog(s.issue, 30, s.date)
+	s.createWorkLog(s.issue, 45, s.date)
+	s.createWorkLog(model.MustNewID(model.ResourceTypeIssue), 60, s.date)
+
+	page, err := s.WorkLogRepo.ListByIssue(context.Background(), s.issue, repository.CursorPage{Size: 1})
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Assert().True(page.PageInfo.HasMore)
+
+	page, err = s.WorkLogRepo.ListByIssue(context.Background(), s.issue, repository.CursorPage{Size: 1, Token: page.PageInfo.NextPageToken})
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Assert().False(page.PageInfo.HasMore)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestListByUser() {
+	first := s.createWorkLog(s.issue, 30, s.date)
+	second := s.createWorkLog(s.issue, 45, s.date.AddDate(0, 0, 1))
+	s.createWorkLog(s.issue, 60, s.date.AddDate(0, 0, 7))
+
+	workLogs, err := s.WorkLogRepo.ListByUser(context.Background(), s.user, s.date, s.date.AddDate(0, 0, 6))
+	s.Require().NoError(err)
+	s.Require().Len(workLogs, 2)
+	s.Assert().Equal(first.ID, workLogs[0].ID)
+	s.Assert().Equal(second.ID, workLogs[1].ID)
+
+	workLogs, err = s.WorkLogRepo.ListByUser(context.Background(), model.MustNewID(model.ResourceTypeUser), s.date, s.date.AddDate(0, 0, 6))
+	s.Require().NoError(err)
+	s.Assert().Empty(workLogs)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestSumByIssues() {
+	other := model.MustNewID(model.ResourceTypeIssue)
+	s.createWorkLog(s.issue, 30, s.date)
+	s.createWorkLog(s.issue, 45, s.date)
+	s.createWorkLog(other, 60, s.date)
+
+	totals, err := s.WorkLogRepo.SumByIssues(context.Background(), []model.ID{s.issue, other, model.MustNewID(model.ResourceTypeIssue)})
+	s.Require().NoError(err)
+	s.Assert().Equal(map[model.ID]uint{s.issue: 75, other: 60}, totals)
+
+	totals, err = s.WorkLogRepo.SumByIssues(context.Background(), nil)
+	s.Require().NoError(err)
+	s.Assert().Empty(totals)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestUpdate() {
+	created := s.createWorkLog(s.issue, 30, s.date)
+
+	updated, err := s.WorkLogRepo.Update(context.Background(), created.ID, repository.UpdateWorkLogOpts{
+		Duration: optional.Some(uint(120)),
+		Note:     optional.Null[string](),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(uint(120), updated.Duration)
+	s.Assert().Empty(updated.Note)
+	s.Assert().True(s.date.Equal(updated.Date))
+	s.Assert().NotNil(updated.UpdatedAt)
+
+	_, err = s.WorkLogRepo.Update(context.Background(), model.MustNewID(model.ResourceTypeWorkLog), repository.UpdateWorkLogOpts{})
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestDelete() {
+	created := s.createWorkLog(s.issue, 30, s.date)
+
+	s.Require().NoError(s.WorkLogRepo.Delete(context.Background(), created.ID))
+
+	_, err := s.WorkLogRepo.Get(context.Background(), created.ID)
+	s.Assert().ErrorIs(err, repository.ErrNotFound)
+	s.Assert().ErrorIs(s.WorkLogRepo.Delete(context.Background(), created.ID), repository.ErrNotFound)
+}
+
+func (s *WorkLogRepositoryIntegrationTestSuite) TestDeleteByIssues() {
+	other := model.MustNewID(model.ResourceTypeIssue)
+	s.createWorkLog(s.issue, 30, s.date)
+	kept := s.createWorkLog(other, 60, s.date)
+
+	s.Require().NoError(s.WorkLogRepo.DeleteByIssues(context.Background(), []model.ID{s.issue}))
+	s.Require().NoError(s.WorkLogRepo.DeleteByIssues(context.Background(), nil))
+
+	workLogs, err := s.WorkLogRepo.ListByUser(context.Background(), s.user, s.date, s.date)
+	s.Require().NoError(err)
+	s.Require().Len(workLogs, 1)
+	s.Assert().Equal(kept.ID, workLogs[0].ID)
+}
+
+func TestWorkLogRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkLogRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: work_log.go
+//
+// Generated by this command:
+//
+//	mockgen -source=work_log.go -destination=work_log_mock_gen.go -package=repository -mock_names WorkLogRepository=MockWorkLogRepository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkLogRepository is a mock of WorkLogRepository interface.
+type MockWorkLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkLogRepositoryMockRecorder is the mock recorder for MockWorkLogRepository.
+type MockWorkLogRepositoryMockRecorder struct {
+	mock *MockWorkLogRepository
+}
+
+// NewMockWorkLogRepository creates a new mock instance.
+func NewMockWorkLogRepository(ctrl *gomock.Controller) *MockWorkLogRepository {
+	mock := &MockWorkLogRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkLogRepository) EXPECT() *MockWorkLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWorkLogRepository) Create(ctx context.Context, opts CreateWorkLogOpts) (*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].(*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkLogRepositoryMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkLogRepository)(nil).Create), ctx, opts)
+}
+
+// Delete mocks base method.
+func (m *MockWorkLogRepository) Delete(ctx context.Context, id model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorkLogRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkLogRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteByIssues mocks base method.
+func (m *MockWorkLogRepository) DeleteByIssues(ctx context.Context, issues []model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIssues", ctx, issues)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByIssues indicates an expected call of DeleteByIssues.
+func (mr *MockWorkLogRepositoryMockRecorder) DeleteByIssues(ctx, issues any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIssues", reflect.TypeOf((*MockWorkLogRepository)(nil).DeleteByIssues), ctx, issues)
+}
+
+// Get mocks base method.
+func (m *MockWorkLogRepository) Get(ctx context.Context, id model.ID) (*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWorkLogRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkLogRepository)(nil).Get), ctx, id)
+}
+
+// ListByIssue mocks base method.
+func (m *MockWorkLogRepository) ListByIssue(ctx context.Context, issue model.ID, page CursorPage) (Page[*WorkLog], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIssue", ctx, issue, page)
+	ret0, _ := ret[0].(Page[*WorkLog])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIssue indicates an expected call of ListByIssue.
+func (mr *MockWorkLogRepositoryMockRecorder) ListByIssue(ctx, issue, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIssue", reflect.TypeOf((*MockWorkLogRepository)(nil).ListByIssue), ctx, issue, page)
+}
+
+// ListByUser mocks base method.
+func (m *MockWorkLogRepository) ListByUser(ctx context.Context, user model.ID, from, to time.Time) ([]*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, user, from, to)
+	ret0, _ := ret[0].([]*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockWorkLogRepositoryMockRecorder) ListByUser(ctx, user, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockWorkLogRepository)(nil).ListByUser), ctx, user, from, to)
+}
+
+// SumByIssues mocks base method.
+func (m *MockWorkLogRepository) SumByIssues(ctx context.Context, issues []model.ID) (map[model.ID]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByIssues", ctx, issues)
+	ret0, _ := ret[0].(map[model.ID]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByIssues indicates an expected call of SumByIssues.
+func (mr *MockWorkLogRepositoryMockRecorder) SumByIssues(ctx, issues any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByIssues", reflect.TypeOf((*MockWorkLogRepository)(nil).SumByIssues), ctx, issues)
+}
+
+// Update mocks base method.
+func (m *MockWorkLogRepository) Update(ctx context.Context, id model.ID, opts UpdateWorkLogOpts) (*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, opts)
+	ret0, _ := ret[0].(*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkLogRepositoryMockRecorder) Update(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkLogRepository)(nil).Update), ctx, id, opts)
+}
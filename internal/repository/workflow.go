@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+)
+
+var (
+	ErrWorkflowDelete = errors.New("failed to delete workflow") // the workflow could not be deleted
+	ErrWorkflowRead   = errors.New("failed to read workflow")   // the workflow could not be retrieved
+	ErrWorkflowSave   = errors.New("failed to save workflow")   // the workflow could not be saved
+)
+
+// Workflow represents the custom statuses of the issues in a project and the
+// allowed transitions between them.
+type Workflow struct {
+	Project     model.ID                   `json:"project"`
+	Statuses    []model.WorkflowStatus     `json:"statuses"`
+	Transitions []model.WorkflowTransition `json:"transitions"`
+	CreatedAt   *time.Time                 `json:"created_at"`
+	UpdatedAt   *time.Time                 `json:"updated_at"`
+}
+
+// SaveWorkflowOpts holds the data required to save the workflow of a
+// project.
+type SaveWorkflowOpts struct {
+	Project     model.ID
+	Statuses    []model.WorkflowStatus
+	Transitions []model.WorkflowTransition
+}
+
+//go:generate go tool mockgen -source=workflow.go -destination=workflow_mock_gen.go -package=repository -mock_names "WorkflowRepository=MockWorkflowRepository"
+type WorkflowRepository interface {
+	// Get returns the workflow of the project.
+	Get(ctx context.Context, project model.ID) (*Workflow, error)
+	// Save creates the workflow of the project or replaces the existing one.
+	Save(ctx context.Context, opts SaveWorkflowOpts) (*Workflow, error)
+	// Delete removes the workflow of the project.
+	Delete(ctx context.Context, project model.ID) error
+}
+
+// PGWorkflowRepository is a repository for managing project workflows.
+type PGWorkflowRepository struct {
+	*pgBaseRepository
+}
+
+func (r *PGWorkflowRepository) Get(ctx context.Context, project model.ID) (*Workflow, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkflowRepository/Get")
+	defer span.End()
+
+	workflow, err := scanWorkflow(r.db.pool.QueryRow(ctx, "SELECT * FROM project_workflows WHERE project_id = $1", project))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Join(ErrWorkflowRead, err)
+	}
+
+	return workflow, nil
+}
+
+func (r *PGWorkflowRepository) Save(ctx context.Context, opts SaveWorkflowOpts) (*Workflow, error) {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkflowRepository/Save")
+	defer span.End()
+
+	statuses := opts.Statuses
+	if statuses == nil {
+		statuses = make([]model.WorkflowStatus, 0)
+	}
+
+	transitions := opts.Transitions
+	if transitions == nil {
+		transitions = make([]model.WorkflowTransition, 0)
+	}
+
+	row := r.db.pool.QueryRow(ctx,
+		`INSERT INTO project_workflows (project_id, statuses, transitions, created_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE SET statuses = EXCLUDED.statuses, transitions = EXCLUDED.transitions, updated_at = timezone('utc', now())
+		RETURNING *`,
+		opts.Project, statuses, transitions, convert.ToPointer(time.Now().UTC().Round(time.Microsecond)),
+	)
+
+	workflow, err := scanWorkflow(row)
+	if err != nil {
+		return nil, errors.Join(ErrWorkflowSave, err)
+	}
+
+	return workflow, nil
+}
+
+func (r *PGWorkflowRepository) Delete(ctx context.Context, project model.ID) error {
+	ctx, span := r.tracer.Start(ctx, "repository.pg.WorkflowRepository/Delete")
+	defer span.End()
+
+	tag, err := r.db.pool.Exec(ctx, "DELETE FROM project_workflows WHERE project_id = $1", project)
+	if err != nil {
+		return errors.Join(ErrWorkflowDelete, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanWorkflow(row pgx.Row) (*Workflow, error) {
+	var w Workflow
+	if err := row.Scan(&w.Project, &w.Statuses, &w.Transitions, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+// NewWorkflowRepository creates a new WorkflowRepository.
+func NewWorkflowRepository(opts ...PGRepositoryOption) (*PGWorkflowRepository, error) {
+	baseRepo, err := newPGRepository(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PGWorkflowRepository{
+		pgBaseRepository: baseRepo,
+	}, nil
+}
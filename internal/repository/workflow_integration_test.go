@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil"
+)
+
+type WorkflowRepositoryIntegrationTestSuite struct {
+	testutil.ContainerIntegrationTestSuite
+	testutil.PgContainerIntegrationTestSuite
+
+	project model.ID
+	opts    repository.SaveWorkflowOpts
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test")
+	}
+	container := reflect.TypeOf(s).Elem().String()
+	s.SetupPg(&s.ContainerIntegrationTestSuite, container)
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) SetupTest() {
+	s.project = model.MustNewID(model.ResourceTypeProject)
+	s.opts = repository.SaveWorkflowOpts{
+		Project: s.project,
+		Statuses: []model.WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: model.WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: model.WorkflowStatusCategoryInProgress},
+			{Key: "shipped", Name: "Shipped", Category: model.WorkflowStatusCategoryDone},
+		},
+		Transitions: []model.WorkflowTransition{
+			{From: "backlog", To: "in_review"},
+			{From: "in_review", To: "shipped"},
+		},
+	}
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) TearDownTest() {
+	defer s.CleanupPg(&s.ContainerIntegrationTestSuite)
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) TearDownSuite() {
+	defer s.CleanupContainers()
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) TestSave() {
+	workflow, err := s.WorkflowRepo.Save(context.Background(), s.opts)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.project, workflow.Project)
+	s.Assert().Equal(s.opts.Statuses, workflow.Statuses)
+	s.Assert().Equal(s.opts.Transitions, workflow.Transitions)
+	s.Assert().NotNil(workflow.CreatedAt)
+	s.Assert().Nil(workflow.UpdatedAt)
+
+	s.opts.Statuses = s.opts.Statuses[:1]
+	s.opts.Transitions = nil
+
+	workflow, err = s.WorkflowRepo.Save(context.Background(), s.opts)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.opts.Statuses, workflow.Statuses)
+	s.Assert().Empty(workflow.Transitions)
+	s.Assert().NotNil(workflow.UpdatedAt)
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) TestGet() {
+	_, err := s.WorkflowRepo.Get(context.Background(), s.project)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+
+	_, err = s.WorkflowRepo.Save(context.Background(), s.opts)
+	s.Require().NoError(err)
+
+	workflow, err := s.WorkflowRepo.Get(context.Background(), s.project)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.opts.Statuses, workflow.Statuses)
+	s.Assert().Equal(s.opts.Transitions, workflow.Transitions)
+}
+
+func (s *WorkflowRepositoryIntegrationTestSuite) TestDelete() {
+	_, err := s.WorkflowRepo.Save(context.Background(), s.opts)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.WorkflowRepo.Delete(context.Background(), s.project))
+	s.Require().ErrorIs(s.WorkflowRepo.Delete(context.Background(), s.project), repository.ErrNotFound)
+
+	_, err = s.WorkflowRepo.Get(context.Background(), s.project)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func TestWorkflowRepositoryIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowRepositoryIntegrationTestSuite))
+}
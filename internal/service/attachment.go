@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	// AttachmentMaxSize is the maximum size of an attachment in bytes.
+	AttachmentMaxSize = 25 << 20
+
+	attachmentFilePrefix = "organizations/"
+)
+
+// Attachment represents a file attached to an issue or document.
+type Attachment struct {
+	ID        model.ID
+	BelongsTo model.ID
+	Name      string
+	CreatedBy model.ID
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+// AttachmentContent is the content of an attachment opened for reading. The
+// caller must close the Body.
+type AttachmentContent struct {
+	Name string
+	Size int64
+	Body io.ReadCloser
+}
+
+// CreateAttachmentOpts holds the data required to create an attachment.
+type CreateAttachmentOpts struct {
+	Name    string `json:"name" validate:"required,min=3,max=120"`
+	Content []byte `json:"content" validate:"required"`
+}
+
+// Validate validates the create options.
+func (o *CreateAttachmentOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidAttachmentDetails, err)
+	}
+	if len(o.Content) > AttachmentMaxSize {
+		return errors.Join(model.ErrInvalidAttachmentDetails, ErrAttachmentTooLarge)
+	}
+	return nil
+}
+
+// UpdateAttachmentOpts holds the fields that can be updated on an attachment.
+type UpdateAttachmentOpts struct {
+	Name string `json:"name" validate:"required,min=3,max=120"`
+}
+
+// Validate validates the update options.
+func (o *UpdateAttachmentOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidAttachmentDetails, err)
+	}
+	return nil
+}
+
+// AttachmentService serves the business logic of interacting with files
+// attached to issues and documents.
+//
+//go:generate go tool mockgen -destination=attachment_mock_gen.go -package=service -mock_names AttachmentService=MockAttachmentService . AttachmentService
+type AttachmentService interface {
+	// Create stores the content of the attachment under the path of the
+	// organization owning belongsTo and attaches it to the resource.
+	Create(ctx context.Context, belongsTo model.ID, opts CreateAttachmentOpts) (*Attachment, error)
+	// Get returns an attachment of the issue or document identified by
+	// belongsTo.
+	Get(ctx context.Context, belongsTo, id model.ID) (*Attachment, error)
+	// Open returns the content of an attachment for streaming.
+	Open(ctx context.Context, belongsTo, id model.ID) (*AttachmentContent, error)
+	// ListBelongsTo returns a cursor-paginated page of attachments of an issue
+	// or document.
+	ListBelongsTo(ctx context.Context, belongsTo model.ID, page CursorPage) (Page[*Attachment], error)
+	// Update renames an attachment. Only the uploader or a user who can update
+	// the resource may rename an attachment.
+	Update(ctx context.Context, belongsTo, id model.ID, opts UpdateAttachmentOpts) (*Attachment, error)
+	// Delete deletes an attachment and its stored content. Only the uploader
+	// or a user who can update the resource may delete an attachment.
+	Delete(ctx context.Context, belongsTo, id model.ID) error
+}
+
+// attachmentService is the concrete implementation of AttachmentService.
+type attachmentService struct {
+	*baseService
+}
+
+func attachmentFromRepository(a *repository.Attachment) *Attachment {
+	if a == nil {
+		return nil
+	}
+	return &Attachment{
+		ID:        a.ID,
+		BelongsTo: a.BelongsTo,
+		Name:      a.Name,
+		CreatedBy: a.CreatedBy,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+// attachmentFilePath returns the static storage path of a new attachment
+// owned by the given organization.
+func attachmentFilePath(orgID model.ID) string {
+	return path.Join(attachmentFilePrefix+orgID.String(), "attachments", model.NewRawID())
+}
+
+// attachmentParentActions returns the read and update actions of a resource
+// that can hold attachments. The last result is false for other resources.
+func attachmentParentActions(belongsTo model.ID) (model.Action, model.Action, bool) {
+	switch belongsTo.Type {
+	case model.ResourceTypeIssue:
+		return model.ActionIssueRead, model.ActionIssueUpdate, true
+	case model.ResourceTypeDocument:
+		return model.ActionDocumentRead, model.ActionDocumentUpdate, true
+	default:
+		return "", "", false
+	}
+}
+
+// can reports whether the context user can read or, if write is set, update
+// the resource holding the attachments.
+func (s *attachmentService) can(ctx context.Context, belongsTo model.ID, write bool) error {
+	if err := belongsTo.Validate(); err != nil {
+		return err
+	}
+
+	read, update, ok := attachmentParentActions(belongsTo)
+	if !ok {
+		return model.ErrInvalidID
+	}
+
+	action := read
+	if write {
+		action = update
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, belongsTo, action) {
+		return ErrNoPermission
+	}
+
+	return nil
+}
+
+// get returns the attachment if it belongs to belongsTo and the context user
+// can read the resource holding it.
+func (s *attachmentService) get(ctx context.Context, belongsTo, id model.ID) (*repository.Attachment, error) {
+	if err := s.can(ctx, belongsTo, false); err != nil {
+		return nil, err
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.attachmentRepo.Get(ctx, id, repository.AttachmentDetailProjection())
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.BelongsTo != belongsTo {
+		return nil, repository.ErrNotFound
+	}
+
+	return attachment, nil
+}
+
+// getOwned returns the attachment if the context user is either its uploader
+// or can update the resource holding it.
+func (s *attachmentService) getOwned(ctx context.Context, belongsTo, id model.ID) (*repository.Attachment, error) {
+	attachment, err := s.get(ctx, belongsTo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	if attachment.CreatedBy == userID {
+		return attachment, nil
+	}
+
+	if err := s.can(ctx, belongsTo, true); err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (s *attachmentService) Create(ctx context.Context, belongsTo model.ID, opts CreateAttachmentOpts) (*Attachment, error) {
+	ctx, span := s.tracer.Start(ctx, "service.attachmentService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrAttachmentCreate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrAttachmentCreate, err)
+	}
+
+	if err := s.can(ctx, belongsTo, true); err != nil {
+		return nil, errors.Join(ErrAttachmentCreate, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrAttachmentCreate, ErrNoUser)
+	}
+
+	orgID, err := s.attachmentRepo.ResolveOrganization(ctx, belongsTo)
+	if err != nil {
+		return nil, errors.Join(ErrAttachmentCreate, err)
+	}
+
+	fileID := attachmentFilePath(orgID)
+	if err := s.staticFileService.Create(ctx, fileID, opts.Content); err != nil {
+		return nil, errors.Join(ErrAttachmentCreate, err)
+	}
+
+	attachment, err := s.attachmentRepo.Create(ctx, repository.CreateAttachmentOpts{
+		BelongsTo: belongsTo,
+		Name:      opts.Name,
+		FileID:    fileID,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		_ = s.staticFileService.Delete(ctx, fileID)
+		return nil, errors.Join(ErrAttachmentCreate, err)
+	}
+
+	return attachmentFromRepository(attachment), nil
+}
+
+func (s *attachmentService) Get(ctx context.Context, belongsTo, id model.ID) (*Attachment, error) {
+	ctx, span := s.tracer.Start(ctx, "service.attachmentService/Get")
+	defer span.End()
+
+	attachment, err := s.get(ctx, belongsTo, id)
+	if err != nil {
+		return nil, errors.Join(ErrAttachmentGet, err)
+	}
+
+	return attachmentFromRepository(attachment), nil
+}
+
+func (s *attachmentService) Open(ctx context.Context, belongsTo, id model.ID) (*AttachmentContent, error) {
+	ctx, span := s.tracer.Start(ctx, "service.attachmentService/Open")
+	defer span.End()
+
+	attachment, err := s.get(ctx, belongsTo, id)
+	if err != nil {
+		return nil, errors.Join(ErrAttachmentGet, err)
+	}
+
+	body, size, err := s.staticFileService.Stream(ctx, attachment.FileID)
+	if err != nil {
+		return nil, errors.Join(ErrAttachmentGet, err)
+	}
+
+	return &AttachmentContent{
+		Name: attachment.Name,
+		Size: size,
+		Body: body,
+	}, nil
+}
+
+func (s *attachmentService) ListBelongsTo(ctx context.Context, belongsTo model.ID, page CursorPage) (Page[*Attachment], error) {
+	ctx, span := s.tracer.Start(ctx, "service.attachmentService/ListBelongsTo")
+	defer span.End()
+
+	if err := s.can(ctx, belongsTo, false); err != nil {
+		return Page[*Attachment]{}, errors.Join(ErrAttachmentGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Attachment]{}, errors.Join(ErrAttachmentGetAll, err)
+	}
+
+	attachments, err := s.attachmentRepo.ListBelongsTo(ctx, belongsTo, normalized, repository.AttachmentListProjection())
+	if err != nil {
+		return Page[*Attachment]{}, errors.Join(ErrAttachmentGetAll, err)
+	}
+
+	return mapPage(attachments, attachmentFromRepository), nil
+}
+
+func (s *attachmentService) Update(ctx context.Context, belongsTo, id model.ID, opts UpdateAttachmentOpts) (*Attachment, error) {
+	ctx, span := s.tracer.Start(ctx, "service.attachmentService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrAttachmentUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrAttachmentUpdate, err)
+	}
+
+	if _, err := s.getOwned(ctx, belongsTo, id); err != nil {
+		return nil, errors.Join(ErrAttachmentUpdate, err)
+	}
+
+	attachment, err := s.attachmentRepo.Update(ctx, id, repository.UpdateAttachmentOpts{
+		Name: opts.Name,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAttachmentUpdate, err)
+	}
+
+	return attachmentFromRepository(attachment), nil
+}
+
+func (s *attachmentService) Delete(ctx context.Context, belongsTo, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.attachmentService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrAttachmentDelete, license.ErrLicenseExpired)
+	}
+
+	attachment, err := s.getOwned(ctx, belongsTo, id)
+	if err != nil {
+		return errors.Join(ErrAttachmentDelete, err)
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrAttachmentDelete, err)
+	}
+
+	// The record is gone at this point, so a missing blob is not an error.
+	if err := s.staticFileService.Delete(ctx, attachment.FileID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return errors.Join(ErrAttachmentDelete, err)
+	}
+
+	return nil
+}
+
+// NewAttachmentService returns a new instance of the AttachmentService
+// interface.
+func NewAttachmentService(opts ...Option) (AttachmentService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &attachmentService{
+		baseService: s,
+	}
+
+	if svc.attachmentRepo == nil {
+		return nil, ErrNoAttachmentRepository
+	}
+
+	if svc.staticFileService == nil {
+		return nil, ErrNoStaticFileService
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,796 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttachmentService(t *testing.T) {
+	type args struct {
+		opts func(ctrl *gomock.Controller) []Option
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    func(ctrl *gomock.Controller) AttachmentService
+		wantErr error
+	}{
+		{
+			name: "new attachment service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithAttachmentRepository(repository.NewMockAttachmentRepository(nil)),
+						WithStaticFileService(NewMockStaticFileService(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			want: func(ctrl *gomock.Controller) AttachmentService {
+				return &attachmentService{
+					baseService: &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            mock.NewMockTracer(ctrl),
+						attachmentRepo:    repository.NewMockAttachmentRepository(nil),
+						staticFileService: NewMockStaticFileService(nil),
+						permissionService: NewMockPermissionService(nil),
+						licenseService:    mock.NewMockLicenseService(nil),
+					},
+				}
+			},
+		},
+		{
+			name: "new attachment service with invalid options",
+			args: args{
+				opts: func(_ *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(nil),
+					}
+				},
+			},
+			wantErr: log.ErrNoLogger,
+		},
+		{
+			name: "new attachment service with no attachment repository",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithStaticFileService(NewMockStaticFileService(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoAttachmentRepository,
+		},
+		{
+			name: "new attachment service with no static file service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithAttachmentRepository(repository.NewMockAttachmentRepository(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoStaticFileService,
+		},
+		{
+			name: "new attachment service with no license service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithAttachmentRepository(repository.NewMockAttachmentRepository(nil)),
+						WithStaticFileService(NewMockStaticFileService(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new attachment service with no permission service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithAttachmentRepository(repository.NewMockAttachmentRepository(nil)),
+						WithStaticFileService(NewMockStaticFileService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			got, err := NewAttachmentService(tt.args.opts(ctrl)...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want(ctrl), got)
+		})
+	}
+}
+
+func TestAttachmentService_Create(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoAttachment := testModel.NewRepositoryAttachment(issueID, userID)
+	opts := CreateAttachmentOpts{Name: repoAttachment.Name, Content: []byte("file-content")}
+	orgPath := gomock.Cond(func(path string) bool {
+		return strings.HasPrefix(path, attachmentFilePrefix+orgID.String()+"/attachments/")
+	})
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx       context.Context
+		belongsTo model.ID
+		opts      CreateAttachmentOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Attachment
+		wantErr error
+	}{
+		{
+			name: "create attachment on issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+					var fileID string
+					staticFileSvc := NewMockStaticFileService(ctrl)
+					staticFileSvc.EXPECT().Create(ctx, orgPath, opts.Content).DoAndReturn(func(_ context.Context, path string, _ []byte) error {
+						fileID = path
+						return nil
+					})
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().ResolveOrganization(ctx, issueID).Return(orgID, nil)
+					attachmentRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, got repository.CreateAttachmentOpts) (*repository.Attachment, error) {
+						assert.Equal(t, repository.CreateAttachmentOpts{
+							BelongsTo: issueID,
+							Name:      opts.Name,
+							FileID:    fileID,
+							CreatedBy: userID,
+						}, got)
+						return repoAttachment, nil
+					})
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Create"),
+						attachmentRepo:    attachmentRepo,
+						staticFileService: staticFileSvc,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			want: attachmentFromRepository(repoAttachment),
+		},
+		{
+			name: "create attachment with expired license",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.attachmentService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			wantErr: license.ErrLicenseExpired,
+		},
+		{
+			name: "create attachment exceeding the size limit",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.attachmentService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      CreateAttachmentOpts{Name: opts.Name, Content: make([]byte, AttachmentMaxSize+1)},
+			},
+			wantErr: ErrAttachmentTooLarge,
+		},
+		{
+			name: "create attachment with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Create"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "create attachment removes stored file on repository error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+					staticFileSvc := NewMockStaticFileService(ctrl)
+					staticFileSvc.EXPECT().Create(ctx, orgPath, opts.Content).Return(nil)
+					staticFileSvc.EXPECT().Delete(ctx, orgPath).Return(nil)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().ResolveOrganization(ctx, issueID).Return(orgID, nil)
+					attachmentRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, repository.ErrAttachmentCreate)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Create"),
+						attachmentRepo:    attachmentRepo,
+						staticFileService: staticFileSvc,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			wantErr: repository.ErrAttachmentCreate,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &attachmentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.Create(tt.args.ctx, tt.args.belongsTo, tt.args.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrAttachmentCreate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttachmentService_Open(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	repoAttachment := testModel.NewRepositoryAttachment(documentID, userID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx       context.Context
+		belongsTo model.ID
+		id        model.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "open attachment of document",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+
+					staticFileSvc := NewMockStaticFileService(ctrl)
+					staticFileSvc.EXPECT().Stream(ctx, repoAttachment.FileID).Return(io.NopCloser(bytes.NewReader([]byte("file-content"))), int64(12), nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Open"),
+						attachmentRepo:    attachmentRepo,
+						staticFileService: staticFileSvc,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: documentID,
+				id:        repoAttachment.ID,
+			},
+			want: []byte("file-content"),
+		},
+		{
+			name: "open attachment of another resource",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					otherDocumentID := model.MustNewID(model.ResourceTypeDocument)
+					other := *repoAttachment
+					other.BelongsTo = otherDocumentID
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(&other, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Open"),
+						attachmentRepo:    attachmentRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: documentID,
+				id:        repoAttachment.ID,
+			},
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name: "open attachment with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Open"),
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: documentID,
+				id:        repoAttachment.ID,
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &attachmentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.Open(tt.args.ctx, tt.args.belongsTo, tt.args.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrAttachmentGet)
+				return
+			}
+			require.NoError(t, err)
+			defer got.Body.Close()
+
+			data, err := io.ReadAll(got.Body)
+			require.NoError(t, err)
+			assert.Equal(t, repoAttachment.Name, got.Name)
+			assert.Equal(t, int64(len(tt.want)), got.Size)
+			assert.Equal(t, tt.want, data)
+		})
+	}
+}
+
+func TestAttachmentService_ListBelongsTo(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoAttachment := testModel.NewRepositoryAttachment(issueID, userID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx       context.Context
+		belongsTo model.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    Page[*Attachment]
+		wantErr error
+	}{
+		{
+			name: "list attachments of issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().ListBelongsTo(ctx, issueID, CursorPage{Size: repository.DefaultPageSize}, repository.AttachmentListProjection()).Return(
+						repository.Page[*repository.Attachment]{Items: []*repository.Attachment{repoAttachment}},
+						nil,
+					)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/ListBelongsTo"),
+						attachmentRepo:    attachmentRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+			},
+			want: Page[*Attachment]{Items: []*Attachment{attachmentFromRepository(repoAttachment)}},
+		},
+		{
+			name: "list attachments with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/ListBelongsTo"),
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &attachmentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.ListBelongsTo(tt.args.ctx, tt.args.belongsTo, CursorPage{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrAttachmentGetAll)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttachmentService_Update(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	otherUserID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoAttachment := testModel.NewRepositoryAttachment(issueID, userID)
+	opts := UpdateAttachmentOpts{Name: "renamed.png"}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx  context.Context
+		opts UpdateAttachmentOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "rename own attachment",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+					attachmentRepo.EXPECT().Update(ctx, repoAttachment.ID, repository.UpdateAttachmentOpts{Name: opts.Name}).Return(repoAttachment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Update"),
+						attachmentRepo:    attachmentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:  context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				opts: opts,
+			},
+		},
+		{
+			name: "rename attachment of another user as maintainer",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+					attachmentRepo.EXPECT().Update(ctx, repoAttachment.ID, repository.UpdateAttachmentOpts{Name: opts.Name}).Return(repoAttachment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Update"),
+						attachmentRepo:    attachmentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:  context.WithValue(context.Background(), pkg.CtxKeyUserID, otherUserID),
+				opts: opts,
+			},
+		},
+		{
+			name: "rename attachment of another user without permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(false)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Update"),
+						attachmentRepo:    attachmentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:  context.WithValue(context.Background(), pkg.CtxKeyUserID, otherUserID),
+				opts: opts,
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "rename attachment with invalid name",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.attachmentService/Update"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:  context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				opts: UpdateAttachmentOpts{Name: "a"},
+			},
+			wantErr: model.ErrInvalidAttachmentDetails,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &attachmentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.Update(tt.args.ctx, issueID, repoAttachment.ID, tt.args.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrAttachmentUpdate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, attachmentFromRepository(repoAttachment), got)
+		})
+	}
+}
+
+func TestAttachmentService_Delete(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	repoAttachment := testModel.NewRepositoryAttachment(documentID, userID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "delete attachment and its file",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+					attachmentRepo.EXPECT().Delete(ctx, repoAttachment.ID).Return(nil)
+
+					staticFileSvc := NewMockStaticFileService(ctrl)
+					staticFileSvc.EXPECT().Delete(ctx, repoAttachment.FileID).Return(nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Delete"),
+						attachmentRepo:    attachmentRepo,
+						staticFileService: staticFileSvc,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+		},
+		{
+			name: "delete attachment with missing file",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+					attachmentRepo.EXPECT().Delete(ctx, repoAttachment.ID).Return(nil)
+
+					staticFileSvc := NewMockStaticFileService(ctrl)
+					staticFileSvc.EXPECT().Delete(ctx, repoAttachment.FileID).Return(repository.ErrNotFound)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Delete"),
+						attachmentRepo:    attachmentRepo,
+						staticFileService: staticFileSvc,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+		},
+		{
+			name: "delete attachment with repository error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					attachmentRepo := repository.NewMockAttachmentRepository(ctrl)
+					attachmentRepo.EXPECT().Get(ctx, repoAttachment.ID, repository.AttachmentDetailProjection()).Return(repoAttachment, nil)
+					attachmentRepo.EXPECT().Delete(ctx, repoAttachment.ID).Return(repository.ErrAttachmentDelete)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.attachmentService/Delete"),
+						attachmentRepo:    attachmentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: repository.ErrAttachmentDelete,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+			s := &attachmentService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			err := s.Delete(ctx, documentID, repoAttachment.ID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrAttachmentDelete)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
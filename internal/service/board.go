@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// BoardColumn is a column of a board. The issues of the column are counted
+// and capped by a WIP limit on project boards only.
+type BoardColumn struct {
+	Value      string
+	IssueCount *int64
+	WIPLimit   *int
+}
+
+// WIPExceeded reports whether the column holds more issues than its WIP
+// limit allows.
+func (c *BoardColumn) WIPExceeded() bool {
+	return c.IssueCount != nil && c.WIPLimit != nil && *c.IssueCount > int64(*c.WIPLimit)
+}
+
+// ForBoardColumn returns the options listing the issues of a board column,
+// which are the issues matching the options with the value of the column. The
+// issues are listed in rank order, the order the cards are moved into.
+func (o IssueListOptions) ForBoardColumn(groupBy model.BoardGroupBy, column string) IssueListOptions {
+	out := o
+	// The board groupings are named after the issue fields they group by.
+	out.Filter.Conditions = append(slices.Clone(o.Filter.Conditions), repository.IssueListCondition{
+		Field:    repository.IssueListConditionField(groupBy),
+		Operator: repository.IssueListOperatorIn,
+		Values:   []string{column},
+	})
+	out.Sort = repository.IssueListSort{
+		Field:     repository.IssueListSortFieldRank,
+		Direction: repository.SortDirectionAsc,
+	}
+	return out
+}
+
+// BoardService serves the business logic of the boards listing the issues of
+// projects and namespaces in columns.
+//
+//go:generate go tool mockgen -destination=board_mock_gen.go -package=service -mock_names BoardService=MockBoardService . BoardService
+type BoardService interface {
+	// GetColumns returns the columns of the board of a project or namespace
+	// in display order. The columns of a project board come with the number
+	// of issues in them and their WIP limits. The workflow statuses are the
+	// columns of project boards only, following the workflow of the project.
+	GetColumns(ctx context.Context, scope model.ID, groupBy model.BoardGroupBy) ([]*BoardColumn, error)
+	// GetWIPLimits returns the WIP limits of the board of a project.
+	GetWIPLimits(ctx context.Context, projectID model.ID) (model.WIPLimits, error)
+	// SetWIPLimits replaces the WIP limits of the board of a project.
+	SetWIPLimits(ctx context.Context, projectID model.ID, limits []model.WIPLimit) (model.WIPLimits, error)
+}
+
+// boardService is the concrete implementation of BoardService.
+type boardService struct {
+	*baseService
+}
+
+func (s *boardService) GetColumns(ctx context.Context, scope model.ID, groupBy model.BoardGroupBy) ([]*BoardColumn, error) {
+	ctx, span := s.tracer.Start(ctx, "service.boardService/GetColumns")
+	defer span.End()
+
+	if err := scope.Validate(); err != nil {
+		return nil, errors.Join(ErrBoardGet, err)
+	}
+	if scope.Type != model.ResourceTypeProject && scope.Type != model.ResourceTypeNamespace {
+		return nil, errors.Join(ErrBoardGet, model.ErrInvalidID)
+	}
+	if !groupBy.Valid() {
+		return nil, errors.Join(ErrBoardGet, ErrBoardGroupBy)
+	}
+
+	action, _ := model.ReadActionFor(scope.Type)
+	if !s.permissionService.CtxUserHas(ctx, scope, action) {
+		return nil, errors.Join(ErrBoardGet, ErrNoPermission)
+	}
+
+	if scope.Type == model.ResourceTypeProject {
+		columns, err := s.boardColumns(ctx, scope, groupBy)
+		if err != nil {
+			return nil, errors.Join(ErrBoardGet, err)
+		}
+		return columns, nil
+	}
+
+	if groupBy == model.BoardGroupByWorkflowStatus {
+		return nil, errors.Join(ErrBoardGet, ErrBoardGroupBy)
+	}
+
+	values := groupBy.Columns()
+	columns := make([]*BoardColumn, len(values))
+	for i, value := range values {
+		columns[i] = &BoardColumn{Value: value}
+	}
+
+	return columns, nil
+}
+
+func (s *boardService) GetWIPLimits(ctx context.Context, projectID model.ID) (model.WIPLimits, error) {
+	ctx, span := s.tracer.Start(ctx, "service.boardService/GetWIPLimits")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrWIPLimitGet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return nil, errors.Join(ErrWIPLimitGet, ErrNoPermission)
+	}
+
+	limits, err := s.wipLimitRepo.List(ctx, projectID)
+	if err != nil {
+		return nil, errors.Join(ErrWIPLimitGet, err)
+	}
+
+	return limits, nil
+}
+
+func (s *boardService) SetWIPLimits(ctx context.Context, projectID model.ID, limits []model.WIPLimit) (model.WIPLimits, error) {
+	ctx, span := s.tracer.Start(ctx, "service.boardService/SetWIPLimits")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrWIPLimitSet, license.ErrLicenseExpired)
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrWIPLimitSet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return nil, errors.Join(ErrWIPLimitSet, ErrNoPermission)
+	}
+
+	set, err := model.NewWIPLimits(limits)
+	if err != nil {
+		return nil, errors.Join(ErrWIPLimitSet, err)
+	}
+
+	saved, err := s.wipLimitRepo.Save(ctx, projectID, set)
+	if err != nil {
+		return nil, errors.Join(ErrWIPLimitSet, err)
+	}
+
+	return saved, nil
+}
+
+// boardColumnValues returns the values of the columns of the project board
+// grouped by the field. The workflow statuses are the columns of projects
+// having a workflow only.
+func (s *baseService) boardColumnValues(ctx context.Context, projectID model.ID, groupBy model.BoardGroupBy) ([]string, error) {
+	if groupBy != model.BoardGroupByWorkflowStatus {
+		return groupBy.Columns(), nil
+	}
+
+	workflow, err := s.projectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, ErrBoardGroupBy
+	}
+
+	values := make([]string, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		values[i] = status.Key
+	}
+
+	return values, nil
+}
+
+// boardColumns returns the columns of the project board grouped by the field
+// with the number of issues in them and their WIP limits. The WIP limits are
+// left out without a WIP limit repository.
+func (s *baseService) boardColumns(ctx context.Context, projectID model.ID, groupBy model.BoardGroupBy) ([]*BoardColumn, error) {
+	values, err := s.boardColumnValues(ctx, projectID, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.issueRepo.CountByField(ctx, projectID, repository.IssueListConditionField(groupBy))
+	if err != nil {
+		return nil, err
+	}
+
+	var limits model.WIPLimits
+	if s.wipLimitRepo != nil {
+		if limits, err = s.wipLimitRepo.List(ctx, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	columns := make([]*BoardColumn, len(values))
+	for i, value := range values {
+		count := counts[value]
+		columns[i] = &BoardColumn{Value: value, IssueCount: &count}
+		if limit, ok := limits.Limit(groupBy, value); ok {
+			columns[i].WIPLimit = &limit
+		}
+	}
+
+	return columns, nil
+}
+
+// NewBoardService returns a new instance of the BoardService interface.
+func NewBoardService(opts ...Option) (BoardService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &boardService{
+		baseService: s,
+	}
+
+	if svc.issueRepo == nil {
+		return nil, ErrNoIssueRepository
+	}
+
+	if svc.wipLimitRepo == nil {
+		return nil, ErrNoWIPLimitRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/opcotech/elemo/internal/service (interfaces: BoardService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=board_mock_gen.go -package=service -mock_names BoardService=MockBoardService . BoardService
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBoardService is a mock of BoardService interface.
+type MockBoardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardServiceMockRecorder is the mock recorder for MockBoardService.
+type MockBoardServiceMockRecorder struct {
+	mock *MockBoardService
+}
+
+// NewMockBoardService creates a new mock instance.
+func NewMockBoardService(ctrl *gomock.Controller) *MockBoardService {
+	mock := &MockBoardService{ctrl: ctrl}
+	mock.recorder = &MockBoardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardService) EXPECT() *MockBoardServiceMockRecorder {
+	return m.recorder
+}
+
+// GetColumns mocks base method.
+func (m *MockBoardService) GetColumns(ctx context.Context, scope model.ID, groupBy model.BoardGroupBy) ([]*BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumns", ctx, scope, groupBy)
+	ret0, _ := ret[0].([]*BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumns indicates an expected call of GetColumns.
+func (mr *MockBoardServiceMockRecorder) GetColumns(ctx, scope, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumns", reflect.TypeOf((*MockBoardService)(nil).GetColumns), ctx, scope, groupBy)
+}
+
+// GetWIPLimits mocks base method.
+func (m *MockBoardService) GetWIPLimits(ctx context.Context, projectID model.ID) (model.WIPLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWIPLimits", ctx, projectID)
+	ret0, _ := ret[0].(model.WIPLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWIPLimits indicates an expected call of GetWIPLimits.
+func (mr *MockBoardServiceMockRecorder) GetWIPLimits(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWIPLimits", reflect.TypeOf((*MockBoardService)(nil).GetWIPLimits), ctx, projectID)
+}
+
+// SetWIPLimits mocks base method.
+func (m *MockBoardService) SetWIPLimits(ctx context.Context, projectID model.ID, limits []model.WIPLimit) (model.WIPLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWIPLimits", ctx, projectID, limits)
+	ret0, _ := ret[0].(model.WIPLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWIPLimits indicates an expected call of SetWIPLimits.
+func (mr *MockBoardServiceMockRecorder) SetWIPLimits(ctx, projectID, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWIPLimits", reflect.TypeOf((*MockBoardService)(nil).SetWIPLimits), ctx, projectID, limits)
+}
@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func newTestWIPLimits() model.WIPLimits {
+	return model.WIPLimits{
+		{GroupBy: model.BoardGroupByStatus, Column: "in progress", Limit: 2},
+		{GroupBy: model.BoardGroupByWorkflowStatus, Column: "in_review", Limit: 3},
+	}
+}
+
+func TestNewBoardService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new board service",
+			opts: []Option{
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithWIPLimitRepository(repository.NewMockWIPLimitRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new board service with invalid options",
+			opts:    []Option{WithWIPLimitRepository(nil)},
+			wantErr: ErrNoWIPLimitRepository,
+		},
+		{
+			name: "new board service with no issue repository",
+			opts: []Option{
+				WithWIPLimitRepository(repository.NewMockWIPLimitRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoIssueRepository,
+		},
+		{
+			name: "new board service with no WIP limit repository",
+			opts: []Option{
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoWIPLimitRepository,
+		},
+		{
+			name: "new board service with no license service",
+			opts: []Option{
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithWIPLimitRepository(repository.NewMockWIPLimitRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new board service with no permission service",
+			opts: []Option{
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithWIPLimitRepository(repository.NewMockWIPLimitRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewBoardService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestBoardService_GetColumns(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	namespaceID := model.MustNewID(model.ResourceTypeNamespace)
+
+	t.Run("get project columns by status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().CountByField(ctx, projectID, repository.IssueListConditionFieldStatus).Return(map[string]int64{
+			"open":        4,
+			"in progress": 3,
+		}, nil)
+
+		wipLimitRepo := repository.NewMockWIPLimitRepository(ctrl)
+		wipLimitRepo.EXPECT().List(ctx, projectID).Return(newTestWIPLimits(), nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+			issueRepo:         issueRepo,
+			wipLimitRepo:      wipLimitRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetColumns(ctx, projectID, model.BoardGroupByStatus)
+		require.NoError(t, err)
+		require.Len(t, got, len(model.IssueStatusStrings()))
+
+		assert.Equal(t, &BoardColumn{Value: "open", IssueCount: convert.ToPointer(int64(4))}, got[0])
+		assert.Equal(t, &BoardColumn{Value: "in progress", IssueCount: convert.ToPointer(int64(3)), WIPLimit: convert.ToPointer(2)}, got[1])
+		assert.True(t, got[1].WIPExceeded())
+		assert.Equal(t, int64(0), *got[2].IssueCount)
+		assert.False(t, got[2].WIPExceeded())
+	})
+
+	t.Run("get project columns by workflow status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().CountByField(ctx, projectID, repository.IssueListConditionFieldWorkflowStatus).Return(map[string]int64{
+			"in_review": 1,
+		}, nil)
+
+		workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+		workflowRepo.EXPECT().Get(ctx, projectID).Return(newTestRepositoryWorkflow(projectID), nil)
+
+		wipLimitRepo := repository.NewMockWIPLimitRepository(ctrl)
+		wipLimitRepo.EXPECT().List(ctx, projectID).Return(newTestWIPLimits(), nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+			issueRepo:         issueRepo,
+			workflowRepo:      workflowRepo,
+			wipLimitRepo:      wipLimitRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+
+		got, err := s.GetColumns(ctx, projectID, model.BoardGroupByWorkflowStatus)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+
+		assert.Equal(t, []string{"backlog", "in_review", "shipped"}, []string{got[0].Value, got[1].Value, got[2].Value})
+		assert.Equal(t, int64(1), *got[1].IssueCount)
+		assert.Equal(t, 3, *got[1].WIPLimit)
+		assert.False(t, got[1].WIPExceeded())
+	})
+
+	t.Run("get project columns by workflow status without workflow", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetColumns(ctx, projectID, model.BoardGroupByWorkflowStatus)
+		assert.ErrorIs(t, err, ErrBoardGet)
+		assert.ErrorIs(t, err, ErrBoardGroupBy)
+	})
+
+	t.Run("get namespace columns", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, namespaceID, model.ActionNamespaceRead).Return(true)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetColumns(ctx, namespaceID, model.BoardGroupByPriority)
+		require.NoError(t, err)
+		require.Len(t, got, len(model.IssuePriorityStrings()))
+		assert.Equal(t, &BoardColumn{Value: "lowest"}, got[0])
+	})
+
+	t.Run("get namespace columns by workflow status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, namespaceID, model.ActionNamespaceRead).Return(true)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetColumns(ctx, namespaceID, model.BoardGroupByWorkflowStatus)
+		assert.ErrorIs(t, err, ErrBoardGroupBy)
+	})
+
+	t.Run("get columns with invalid grouping", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &boardService{baseService: &baseService{
+			tracer: newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+		}}
+
+		_, err := s.GetColumns(ctx, projectID, "assignee")
+		assert.ErrorIs(t, err, ErrBoardGroupBy)
+	})
+
+	t.Run("get columns with invalid scope", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &boardService{baseService: &baseService{
+			tracer: newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+		}}
+
+		_, err := s.GetColumns(ctx, model.MustNewID(model.ResourceTypeIssue), model.BoardGroupByStatus)
+		assert.ErrorIs(t, err, model.ErrInvalidID)
+	})
+
+	t.Run("get columns without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetColumns"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetColumns(ctx, projectID, model.BoardGroupByStatus)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestBoardService_GetWIPLimits(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("get WIP limits", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		wipLimitRepo := repository.NewMockWIPLimitRepository(ctrl)
+		wipLimitRepo.EXPECT().List(ctx, projectID).Return(newTestWIPLimits(), nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetWIPLimits"),
+			wipLimitRepo:      wipLimitRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetWIPLimits(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, newTestWIPLimits(), got)
+	})
+
+	t.Run("get WIP limits without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+		s := &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/GetWIPLimits"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetWIPLimits(ctx, projectID)
+		assert.ErrorIs(t, err, ErrWIPLimitGet)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestBoardService_SetWIPLimits(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context) (*boardService, *repository.MockWIPLimitRepository) {
+		wipLimitRepo := repository.NewMockWIPLimitRepository(ctrl)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		return &boardService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.boardService/SetWIPLimits"),
+			wipLimitRepo:      wipLimitRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}, wipLimitRepo
+	}
+
+	t.Run("set WIP limits", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, wipLimitRepo := newService(ctrl, ctx)
+		wipLimitRepo.EXPECT().Save(ctx, projectID, newTestWIPLimits()).Return(newTestWIPLimits(), nil)
+
+		got, err := s.SetWIPLimits(ctx, projectID, newTestWIPLimits())
+		require.NoError(t, err)
+		assert.Equal(t, newTestWIPLimits(), got)
+	})
+
+	t.Run("set WIP limits with invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, _ := newService(ctrl, ctx)
+		_, err := s.SetWIPLimits(ctx, projectID, []model.WIPLimit{
+			{GroupBy: model.BoardGroupByKind, Column: "chore", Limit: 2},
+		})
+		assert.ErrorIs(t, err, ErrWIPLimitSet)
+		assert.ErrorIs(t, err, model.ErrInvalidWIPLimitDetails)
+	})
+
+	t.Run("set WIP limits with expired license", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &boardService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.boardService/SetWIPLimits"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.SetWIPLimits(ctx, projectID, newTestWIPLimits())
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
+
+func TestIssueListOptions_ForBoardColumn(t *testing.T) {
+	t.Parallel()
+
+	opts := IssueListOptions{
+		Filter: repository.IssueListFilter{
+			Conditions: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldKind, Operator: repository.IssueListOperatorIn, Values: []string{"bug"}},
+			},
+		},
+	}
+
+	got := opts.ForBoardColumn(model.BoardGroupByStatus, "in progress")
+	assert.Len(t, opts.Filter.Conditions, 1)
+	assert.Equal(t, []repository.IssueListCondition{
+		{Field: repository.IssueListConditionFieldKind, Operator: repository.IssueListOperatorIn, Values: []string{"bug"}},
+		{Field: repository.IssueListConditionFieldStatus, Operator: repository.IssueListOperatorIn, Values: []string{"in progress"}},
+	}, got.Filter.Conditions)
+	assert.Equal(t, repository.IssueListSort{
+		Field:     repository.IssueListSortFieldRank,
+		Direction: repository.SortDirectionAsc,
+	}, got.Sort)
+}
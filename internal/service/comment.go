@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// Comment represents a comment on an issue or document.
+type Comment struct {
+	ID        model.ID
+	BelongsTo model.ID
+	Content   string
+	CreatedBy model.ID
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+// CreateCommentOpts holds the data required to create a comment.
+type CreateCommentOpts struct {
+	Content string `json:"content" validate:"required,min=5,max=2000"`
+}
+
+// Validate validates the create options.
+func (o *CreateCommentOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidCommentDetails, err)
+	}
+	return nil
+}
+
+// UpdateCommentOpts holds the fields that can be updated on a comment.
+type UpdateCommentOpts struct {
+	Content string `json:"content" validate:"required,min=5,max=2000"`
+}
+
+// Validate validates the update options.
+func (o *UpdateCommentOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidCommentDetails, err)
+	}
+	return nil
+}
+
+// CommentService serves the business logic of interacting with comments on
+// issues and documents.
+//
+//go:generate go tool mockgen -destination=comment_mock_gen.go -package=service -mock_names CommentService=MockCommentService . CommentService
+type CommentService interface {
+	// Create adds a comment to the issue or document identified by belongsTo.
+	Create(ctx context.Context, belongsTo model.ID, opts CreateCommentOpts) (*Comment, error)
+	// Get returns a comment of the issue or document identified by belongsTo.
+	Get(ctx context.Context, belongsTo, id model.ID) (*Comment, error)
+	// ListBelongsTo returns a cursor-paginated page of comments of an issue
+	// or document.
+	ListBelongsTo(ctx context.Context, belongsTo model.ID, page CursorPage) (Page[*Comment], error)
+	// Update updates the content of a comment. Only the author or a user who
+	// can update the commented resource may edit a comment.
+	Update(ctx context.Context, belongsTo, id model.ID, opts UpdateCommentOpts) (*Comment, error)
+	// Delete deletes a comment. Only the author or a user who can update the
+	// commented resource may delete a comment.
+	Delete(ctx context.Context, belongsTo, id model.ID) error
+}
+
+// commentService is the concrete implementation of CommentService.
+type commentService struct {
+	*baseService
+}
+
+func commentFromRepository(c *repository.Comment) *Comment {
+	if c == nil {
+		return nil
+	}
+	return &Comment{
+		ID:        c.ID,
+		BelongsTo: c.BelongsTo,
+		Content:   c.Content,
+		CreatedBy: c.CreatedBy,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// commentParentActions returns the read and maintain actions of a resource
+// that can hold comments. The last result is false for other resources.
+func commentParentActions(belongsTo model.ID) (model.Action, model.Action, bool) {
+	switch belongsTo.Type {
+	case model.ResourceTypeIssue:
+		return model.ActionIssueRead, model.ActionIssueUpdate, true
+	case model.ResourceTypeDocument:
+		return model.ActionDocumentRead, model.ActionDocumentUpdate, true
+	default:
+		return "", "", false
+	}
+}
+
+// canRead reports whether the context user can read the commented resource.
+func (s *commentService) canRead(ctx context.Context, belongsTo model.ID) error {
+	if err := belongsTo.Validate(); err != nil {
+		return err
+	}
+
+	read, _, ok := commentParentActions(belongsTo)
+	if !ok {
+		return model.ErrInvalidID
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, belongsTo, read) {
+		return ErrNoPermission
+	}
+
+	return nil
+}
+
+// getOwned returns the comment if it belongs to belongsTo and the context
+// user is either its author or a maintainer of the commented resource.
+func (s *commentService) getOwned(ctx context.Context, belongsTo, id model.ID) (*repository.Comment, error) {
+	if err := s.canRead(ctx, belongsTo); err != nil {
+		return nil, err
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.BelongsTo != belongsTo {
+		return nil, repository.ErrNotFound
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	if comment.CreatedBy == userID {
+		return comment, nil
+	}
+
+	_, maintain, _ := commentParentActions(belongsTo)
+	if !s.permissionService.CtxUserHas(ctx, belongsTo, maintain) {
+		return nil, ErrNoPermission
+	}
+
+	return comment, nil
+}
+
+func (s *commentService) Create(ctx context.Context, belongsTo model.ID, opts CreateCommentOpts) (*Comment, error) {
+	ctx, span := s.tracer.Start(ctx, "service.commentService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrCommentCreate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrCommentCreate, err)
+	}
+
+	if err := s.canRead(ctx, belongsTo); err != nil {
+		return nil, errors.Join(ErrCommentCreate, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrCommentCreate, ErrNoUser)
+	}
+
+	comment, err := s.commentRepo.Create(ctx, repository.CreateCommentOpts{
+		BelongsTo: belongsTo,
+		Content:   opts.Content,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrCommentCreate, err)
+	}
+
+	if belongsTo.Type == model.ResourceTypeIssue {
+		s.recordIssueActivity(ctx, commentActivity(IssueActivityKindCommentAdded, belongsTo, comment.ID))
+		s.autoWatchIssue(ctx, belongsTo, []model.ID{userID})
+		s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueComment, belongsTo, []string{issueFieldComments})
+	}
+	s.notifyMentions(ctx, comment.ID, belongsTo, commentMentionSubject, "", comment.Content)
+
+	return commentFromRepository(comment), nil
+}
+
+func (s *commentService) Get(ctx context.Context, belongsTo, id model.ID) (*Comment, error) {
+	ctx, span := s.tracer.Start(ctx, "service.commentService/Get")
+	defer span.End()
+
+	if err := s.canRead(ctx, belongsTo); err != nil {
+		return nil, errors.Join(ErrCommentGet, err)
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrCommentGet, err)
+	}
+
+	comment, err := s.commentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrCommentGet, err)
+	}
+
+	if comment.BelongsTo != belongsTo {
+		return nil, errors.Join(ErrCommentGet, repository.ErrNotFound)
+	}
+
+	return commentFromRepository(comment), nil
+}
+
+func (s *commentService) ListBelongsTo(ctx context.Context, belongsTo model.ID, page CursorPage) (Page[*Comment], error) {
+	ctx, span := s.tracer.Start(ctx, "service.commentService/ListBelongsTo")
+	defer span.End()
+
+	if err := s.canRead(ctx, belongsTo); err != nil {
+		return Page[*Comment]{}, errors.Join(ErrCommentGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Comment]{}, errors.Join(ErrCommentGetAll, err)
+	}
+
+	comments, err := s.commentRepo.ListBelongsTo(ctx, belongsTo, normalized)
+	if err != nil {
+		return Page[*Comment]{}, errors.Join(ErrCommentGetAll, err)
+	}
+
+	return mapPage(comments, commentFromRepository), nil
+}
+
+func (s *commentService) Update(ctx context.Context, belongsTo, id model.ID, opts UpdateCommentOpts) (*Comment, error) {
+	ctx, span := s.tracer.Start(ctx, "service.commentService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrCommentUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrCommentUpdate, err)
+	}
+
+	_, err := s.getOwned(ctx, belongsTo, id)
+	if err != nil {
+		return nil, errors.Join(ErrCommentUpdate, err)
+	}
+
+	comment, err := s.commentRepo.Update(ctx, id, repository.UpdateCommentOpts{
+		Content: opts.Content,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrCommentUpdate, err)
+	}
+
+	if belongsTo.Type == model.ResourceTypeIssue {
+		s.recordIssueActivity(ctx, commentActivity(IssueActivityKindCommentUpdated, belongsTo, id))
+	}
+	s.notifyMentions(ctx, comment.ID, belongsTo, commentMentionSubject, "", comment.Content)
+
+	return commentFromRepository(comment), nil
+}
+
+func (s *commentService) Delete(ctx context.Context, belongsTo, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.commentService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrCommentDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getOwned(ctx, belongsTo, id); err != nil {
+		return errors.Join(ErrCommentDelete, err)
+	}
+
+	if err := s.commentRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrCommentDelete, err)
+	}
+
+	if belongsTo.Type == model.ResourceTypeIssue {
+		s.recordIssueActivity(ctx, commentActivity(IssueActivityKindCommentDeleted, belongsTo, id))
+	}
+
+	return nil
+}
+
+// NewCommentService returns a new instance of the CommentService interface.
+func NewCommentService(opts ...Option) (CommentService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &commentService{
+		baseService: s,
+	}
+
+	if svc.commentRepo == nil {
+		return nil, ErrNoCommentRepository
+	}
+
+	if svc.issueRepo == nil {
+		return nil, ErrNoIssueRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,834 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCommentTestTracer(ctrl *gomock.Controller, ctx context.Context, name string) *mock.MockTracer {
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0))
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, name, gomock.Len(0)).Return(ctx, span)
+
+	return tracer
+}
+
+func newCommentTestLicenseService(ctrl *gomock.Controller, ctx context.Context, expired bool) *mock.MockLicenseService {
+	licenseSvc := mock.NewMockLicenseService(ctrl)
+	licenseSvc.EXPECT().Expired(ctx).Return(expired, nil)
+	return licenseSvc
+}
+
+func TestNewCommentService(t *testing.T) {
+	type args struct {
+		opts func(ctrl *gomock.Controller) []Option
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    func(ctrl *gomock.Controller) CommentService
+		wantErr error
+	}{
+		{
+			name: "new comment service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithCommentRepository(repository.NewMockCommentRepository(nil)),
+						WithIssueRepository(repository.NewMockIssueRepository(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			want: func(ctrl *gomock.Controller) CommentService {
+				return &commentService{
+					baseService: &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            mock.NewMockTracer(ctrl),
+						commentRepo:       repository.NewMockCommentRepository(nil),
+						issueRepo:         repository.NewMockIssueRepository(nil),
+						permissionService: NewMockPermissionService(nil),
+						licenseService:    mock.NewMockLicenseService(nil),
+					},
+				}
+			},
+		},
+		{
+			name: "new comment service with invalid options",
+			args: args{
+				opts: func(_ *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(nil),
+						WithCommentRepository(repository.NewMockCommentRepository(nil)),
+					}
+				},
+			},
+			wantErr: log.ErrNoLogger,
+		},
+		{
+			name: "new comment service with no comment repository",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoCommentRepository,
+		},
+		{
+			name: "new comment service with no issue repository",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithCommentRepository(repository.NewMockCommentRepository(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoIssueRepository,
+		},
+		{
+			name: "new comment service with no license service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithCommentRepository(repository.NewMockCommentRepository(nil)),
+						WithIssueRepository(repository.NewMockIssueRepository(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new comment service with no permission service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithCommentRepository(repository.NewMockCommentRepository(nil)),
+						WithIssueRepository(repository.NewMockIssueRepository(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			got, err := NewCommentService(tt.args.opts(ctrl)...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want(ctrl), got)
+		})
+	}
+}
+
+func TestCommentService_Create(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoComment := testModel.NewRepositoryComment(issueID, userID)
+	opts := CreateCommentOpts{Content: repoComment.Content}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx       context.Context
+		belongsTo model.ID
+		opts      CreateCommentOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Comment
+		wantErr error
+	}{
+		{
+			name: "create comment on issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Create(ctx, repository.CreateCommentOpts{
+						BelongsTo: issueID,
+						Content:   opts.Content,
+						CreatedBy: userID,
+					}).Return(repoComment, nil)
+
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().AutoWatch(ctx, issueID, []model.ID{userID}).Return(nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Create"),
+						commentRepo:       commentRepo,
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			want: commentFromRepository(repoComment),
+		},
+		{
+			name: "create comment with expired license",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.commentService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			wantErr: license.ErrLicenseExpired,
+		},
+		{
+			name: "create comment with invalid content",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.commentService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      CreateCommentOpts{Content: "hi"},
+			},
+			wantErr: model.ErrInvalidCommentDetails,
+		},
+		{
+			name: "create comment on unsupported resource",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.commentService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: model.MustNewID(model.ResourceTypeProject),
+				opts:      opts,
+			},
+			wantErr: model.ErrInvalidID,
+		},
+		{
+			name: "create comment with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Create"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "create comment with repository error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, repository.ErrCommentCreate)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Create"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx:       context.WithValue(context.Background(), pkg.CtxKeyUserID, userID),
+				belongsTo: issueID,
+				opts:      opts,
+			},
+			wantErr: repository.ErrCommentCreate,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &commentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.Create(tt.args.ctx, tt.args.belongsTo, tt.args.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrCommentCreate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommentService_Get(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	otherDocumentID := model.MustNewID(model.ResourceTypeDocument)
+	repoComment := testModel.NewRepositoryComment(documentID, userID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx       context.Context
+		belongsTo model.ID
+		id        model.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Comment
+		wantErr error
+	}{
+		{
+			name: "get comment of document",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Get"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				belongsTo: documentID,
+				id:        repoComment.ID,
+			},
+			want: commentFromRepository(repoComment),
+		},
+		{
+			name: "get comment of another resource",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, otherDocumentID, model.ActionDocumentRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Get"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				belongsTo: otherDocumentID,
+				id:        repoComment.ID,
+			},
+			wantErr: repository.ErrNotFound,
+		},
+		{
+			name: "get comment with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Get"),
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				belongsTo: documentID,
+				id:        repoComment.ID,
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &commentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.Get(tt.args.ctx, tt.args.belongsTo, tt.args.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommentService_ListBelongsTo(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoComment := testModel.NewRepositoryComment(issueID, userID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx       context.Context
+		belongsTo model.ID
+		page      CursorPage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    Page[*Comment]
+		wantErr error
+	}{
+		{
+			name: "list comments of issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().ListBelongsTo(ctx, issueID, CursorPage{Size: repository.DefaultPageSize}).Return(Page[*repository.Comment]{
+						Items: []*repository.Comment{repoComment},
+					}, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/ListBelongsTo"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				belongsTo: issueID,
+			},
+			want: Page[*Comment]{
+				Items: []*Comment{commentFromRepository(repoComment)},
+			},
+		},
+		{
+			name: "list comments with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/ListBelongsTo"),
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				belongsTo: issueID,
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "list comments with repository error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().ListBelongsTo(ctx, issueID, gomock.Any()).Return(Page[*repository.Comment]{}, repository.ErrCommentRead)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/ListBelongsTo"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:       context.Background(),
+				belongsTo: issueID,
+			},
+			wantErr: repository.ErrCommentRead,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &commentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.ListBelongsTo(tt.args.ctx, tt.args.belongsTo, tt.args.page)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommentService_Update(t *testing.T) {
+	authorID := model.MustNewID(model.ResourceTypeUser)
+	otherUserID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoComment := testModel.NewRepositoryComment(issueID, authorID)
+	updatedComment := *repoComment
+	updatedComment.Content = "updated comment"
+	opts := UpdateCommentOpts{Content: updatedComment.Content}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx context.Context
+		id  model.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Comment
+		wantErr error
+	}{
+		{
+			name: "update own comment",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+					commentRepo.EXPECT().Update(ctx, repoComment.ID, repository.UpdateCommentOpts{Content: opts.Content}).Return(&updatedComment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Update"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, authorID),
+				id:  repoComment.ID,
+			},
+			want: commentFromRepository(&updatedComment),
+		},
+		{
+			name: "update comment as maintainer",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+					commentRepo.EXPECT().Update(ctx, repoComment.ID, repository.UpdateCommentOpts{Content: opts.Content}).Return(&updatedComment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Update"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, otherUserID),
+				id:  repoComment.ID,
+			},
+			want: commentFromRepository(&updatedComment),
+		},
+		{
+			name: "update comment of another user",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(false)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Update"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, otherUserID),
+				id:  repoComment.ID,
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "update comment with expired license",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.commentService/Update"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, authorID),
+				id:  repoComment.ID,
+			},
+			wantErr: license.ErrLicenseExpired,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &commentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			got, err := s.Update(tt.args.ctx, issueID, tt.args.id, opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrCommentUpdate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommentService_Delete(t *testing.T) {
+	authorID := model.MustNewID(model.ResourceTypeUser)
+	otherUserID := model.MustNewID(model.ResourceTypeUser)
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	repoComment := testModel.NewRepositoryComment(documentID, authorID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		ctx context.Context
+		id  model.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "delete own comment",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+					commentRepo.EXPECT().Delete(ctx, repoComment.ID).Return(nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Delete"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, authorID),
+				id:  repoComment.ID,
+			},
+		},
+		{
+			name: "delete comment of another user",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentUpdate).Return(false)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Delete"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, otherUserID),
+				id:  repoComment.ID,
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "delete comment with repository error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionDocumentRead).Return(true)
+
+					commentRepo := repository.NewMockCommentRepository(ctrl)
+					commentRepo.EXPECT().Get(ctx, repoComment.ID).Return(repoComment, nil)
+					commentRepo.EXPECT().Delete(ctx, repoComment.ID).Return(repository.ErrCommentDelete)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.commentService/Delete"),
+						commentRepo:       commentRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				ctx: context.WithValue(context.Background(), pkg.CtxKeyUserID, authorID),
+				id:  repoComment.ID,
+			},
+			wantErr: repository.ErrCommentDelete,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &commentService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx),
+			}
+
+			err := s.Delete(tt.args.ctx, documentID, tt.args.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrCommentDelete)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// PartialComponent is a lean component used on issue reads.
+type PartialComponent struct {
+	ID   model.ID
+	Name string
+}
+
+// Component represents a project component returned by the service.
+type Component struct {
+	ID          model.ID
+	Project     model.ID
+	Name        string
+	Description string
+	Lead        *model.ID
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// CreateComponentOpts holds the data required to create a component.
+type CreateComponentOpts struct {
+	Name        string    `json:"name" validate:"required,min=1,max=120"`
+	Description string    `json:"description" validate:"omitempty,max=500"`
+	Lead        *model.ID `json:"lead" validate:"omitempty"`
+}
+
+// Validate validates the create options.
+func (o *CreateComponentOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidComponentDetails, err)
+	}
+	if o.Lead != nil {
+		if err := validateComponentLead(*o.Lead); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateComponentOpts holds the fields that can be updated on a component.
+// Undefined fields (Defined == false) are left unchanged, and a null Lead
+// removes the lead of the component.
+type UpdateComponentOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Lead        optional.Optional[model.ID]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateComponentOpts) Validate() error {
+	fields := []struct {
+		value optional.Optional[string]
+		tag   string
+	}{
+		{o.Name, "required,min=1,max=120"},
+		{o.Description, "omitempty,max=500"},
+	}
+
+	for _, f := range fields {
+		if !f.value.Defined {
+			continue
+		}
+		var value string
+		if f.value.Value != nil {
+			value = *f.value.Value
+		}
+		if err := validate.Var(value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidComponentDetails, err)
+		}
+	}
+
+	if o.Lead.Defined && o.Lead.Value != nil {
+		return validateComponentLead(*o.Lead.Value)
+	}
+
+	return nil
+}
+
+func validateComponentLead(lead model.ID) error {
+	if err := lead.Validate(); err != nil || lead.Type != model.ResourceTypeUser {
+		return errors.Join(model.ErrInvalidComponentDetails, model.ErrInvalidID)
+	}
+	return nil
+}
+
+// ComponentService serves the business logic of interacting with project
+// components.
+//
+//go:generate go tool mockgen -destination=component_mock_gen.go -package=service -mock_names ComponentService=MockComponentService . ComponentService
+type ComponentService interface {
+	// Create creates a new component in a project.
+	Create(ctx context.Context, projectID model.ID, opts CreateComponentOpts) (*Component, error)
+	// Get returns a component by its ID.
+	Get(ctx context.Context, id model.ID) (*Component, error)
+	// List returns a cursor-paginated page of the components of a project.
+	List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Component], error)
+	// Update updates a component.
+	Update(ctx context.Context, id model.ID, opts UpdateComponentOpts) (*Component, error)
+	// Delete deletes a component and removes it from its issues.
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// componentService is the concrete implementation of ComponentService.
+type componentService struct {
+	*baseService
+}
+
+func componentFromRepository(c *repository.Component) *Component {
+	if c == nil {
+		return nil
+	}
+	return &Component{
+		ID:          c.ID,
+		Project:     c.Project,
+		Name:        c.Name,
+		Description: c.Description,
+		Lead:        c.Lead,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// canLead reports whether the user can lead a component of the project. The
+// lead is assigned to the issues of the component, so it must be able to
+// read the project.
+func (s *componentService) canLead(ctx context.Context, lead, projectID model.ID) error {
+	ok, err := s.permissionService.Has(ctx, lead, projectID, model.ActionProjectRead)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return model.ErrInvalidComponentDetails
+	}
+	return nil
+}
+
+// getForAction returns the component if the context user can perform the
+// action on its project.
+func (s *componentService) getForAction(ctx context.Context, id model.ID, action model.Action) (*repository.Component, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	if id.Type != model.ResourceTypeComponent {
+		return nil, model.ErrInvalidID
+	}
+
+	component, err := s.componentRepo.Get(ctx, id, repository.ComponentDetailProjection())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, component.Project, action) {
+		return nil, ErrNoPermission
+	}
+
+	return component, nil
+}
+
+func (s *componentService) Create(ctx context.Context, projectID model.ID, opts CreateComponentOpts) (*Component, error) {
+	ctx, span := s.tracer.Start(ctx, "service.componentService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrComponentCreate, license.ErrLicenseExpired)
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrComponentCreate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrComponentCreate, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return nil, errors.Join(ErrComponentCreate, ErrNoPermission)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureComponents); !ok || err != nil {
+		return nil, errors.Join(ErrComponentCreate, ErrQuotaExceeded)
+	}
+
+	if opts.Lead != nil {
+		if err := s.canLead(ctx, *opts.Lead, projectID); err != nil {
+			return nil, errors.Join(ErrComponentCreate, err)
+		}
+	}
+
+	component, err := s.componentRepo.Create(ctx, repository.CreateComponentOpts{
+		Project:     projectID,
+		Name:        opts.Name,
+		Description: opts.Description,
+		Lead:        opts.Lead,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrComponentCreate, err)
+	}
+
+	return componentFromRepository(component), nil
+}
+
+func (s *componentService) Get(ctx context.Context, id model.ID) (*Component, error) {
+	ctx, span := s.tracer.Start(ctx, "service.componentService/Get")
+	defer span.End()
+
+	component, err := s.getForAction(ctx, id, model.ActionProjectRead)
+	if err != nil {
+		return nil, errors.Join(ErrComponentGet, err)
+	}
+
+	return componentFromRepository(component), nil
+}
+
+func (s *componentService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Component], error) {
+	ctx, span := s.tracer.Start(ctx, "service.componentService/List")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return Page[*Component]{}, errors.Join(ErrComponentGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Component]{}, errors.Join(ErrComponentGetAll, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return Page[*Component]{}, errors.Join(ErrComponentGetAll, ErrNoPermission)
+	}
+
+	components, err := s.componentRepo.ListForProject(ctx, projectID, normalized, repository.ComponentListProjection())
+	if err != nil {
+		return Page[*Component]{}, errors.Join(ErrComponentGetAll, err)
+	}
+
+	return mapPage(components, componentFromRepository), nil
+}
+
+func (s *componentService) Update(ctx context.Context, id model.ID, opts UpdateComponentOpts) (*Component, error) {
+	ctx, span := s.tracer.Start(ctx, "service.componentService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrComponentUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrComponentUpdate, err)
+	}
+
+	current, err := s.getForAction(ctx, id, model.ActionProjectUpdate)
+	if err != nil {
+		return nil, errors.Join(ErrComponentUpdate, err)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureComponents); !ok || err != nil {
+		return nil, errors.Join(ErrComponentUpdate, ErrQuotaExceeded)
+	}
+
+	if opts.Lead.Defined && opts.Lead.Value != nil {
+		if err := s.canLead(ctx, *opts.Lead.Value, current.Project); err != nil {
+			return nil, errors.Join(ErrComponentUpdate, err)
+		}
+	}
+
+	component, err := s.componentRepo.Update(ctx, id, repository.UpdateComponentOpts{
+		Name:        opts.Name,
+		Description: clearedToEmpty(opts.Description),
+		Lead:        opts.Lead,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrComponentUpdate, err)
+	}
+
+	return componentFromRepository(component), nil
+}
+
+func (s *componentService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.componentService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrComponentDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getForAction(ctx, id, model.ActionProjectUpdate); err != nil {
+		return errors.Join(ErrComponentDelete, err)
+	}
+
+	if err := s.componentRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrComponentDelete, err)
+	}
+
+	return nil
+}
+
+// issueComponents returns the components an issue of the project is set to.
+// Every component must belong to the project of the issue.
+func (s *baseService) issueComponents(ctx context.Context, projectID model.ID, ids []model.ID) ([]*repository.Component, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if s.componentRepo == nil {
+		return nil, ErrNoComponentRepository
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureComponents); !ok || err != nil {
+		return nil, ErrQuotaExceeded
+	}
+
+	components := make([]*repository.Component, 0, len(ids))
+	seen := make(map[model.ID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if err := id.Validate(); err != nil || id.Type != model.ResourceTypeComponent {
+			return nil, model.ErrInvalidID
+		}
+
+		component, err := s.componentRepo.Get(ctx, id, repository.ComponentDetailProjection())
+		if err != nil {
+			return nil, err
+		}
+		if component.Project != projectID {
+			return nil, ErrIssueComponent
+		}
+
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// NewComponentService returns a new instance of the ComponentService
+// interface.
+func NewComponentService(opts ...Option) (ComponentService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &componentService{
+		baseService: s,
+	}
+
+	if svc.componentRepo == nil {
+		return nil, ErrNoComponentRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
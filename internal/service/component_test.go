@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestNewComponentService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new component service",
+			opts: []Option{
+				WithComponentRepository(repository.NewMockComponentRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new component service with invalid options",
+			opts:    []Option{WithComponentRepository(nil)},
+			wantErr: ErrNoComponentRepository,
+		},
+		{
+			name: "new component service with no component repository",
+			opts: []Option{
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoComponentRepository,
+		},
+		{
+			name: "new component service with no license service",
+			opts: []Option{
+				WithComponentRepository(repository.NewMockComponentRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new component service with no permission service",
+			opts: []Option{
+				WithComponentRepository(repository.NewMockComponentRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewComponentService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestComponentService_Create(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	leadID := model.MustNewID(model.ResourceTypeUser)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, licensed bool) (*componentService, *repository.MockComponentRepository, *MockPermissionService) {
+		componentRepo := repository.NewMockComponentRepository(ctrl)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureComponents).Return(licensed, nil)
+
+		return &componentService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.componentService/Create"),
+			componentRepo:     componentRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}, componentRepo, permSvc
+	}
+
+	t.Run("create component with lead", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, componentRepo, permSvc := newService(ctrl, ctx, true)
+		permSvc.EXPECT().Has(ctx, leadID, projectID, model.ActionProjectRead).Return(true, nil)
+
+		component := testModel.NewRepositoryComponent(projectID, &leadID)
+		componentRepo.EXPECT().Create(ctx, repository.CreateComponentOpts{
+			Project: projectID,
+			Name:    "API",
+			Lead:    &leadID,
+		}).Return(component, nil)
+
+		got, err := s.Create(ctx, projectID, CreateComponentOpts{Name: "API", Lead: &leadID})
+		require.NoError(t, err)
+		assert.Equal(t, componentFromRepository(component), got)
+	})
+
+	t.Run("create component with lead not in project", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, _, permSvc := newService(ctrl, ctx, true)
+		permSvc.EXPECT().Has(ctx, leadID, projectID, model.ActionProjectRead).Return(false, nil)
+
+		_, err := s.Create(ctx, projectID, CreateComponentOpts{Name: "API", Lead: &leadID})
+		assert.ErrorIs(t, err, ErrComponentCreate)
+		assert.ErrorIs(t, err, model.ErrInvalidComponentDetails)
+	})
+
+	t.Run("create component without licensed feature", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, _, _ := newService(ctrl, ctx, false)
+		_, err := s.Create(ctx, projectID, CreateComponentOpts{Name: "API"})
+		assert.ErrorIs(t, err, ErrComponentCreate)
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+	})
+
+	t.Run("create component with invalid lead", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &componentService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.componentService/Create"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		lead := model.MustNewID(model.ResourceTypeTeam)
+		_, err := s.Create(ctx, projectID, CreateComponentOpts{Name: "API", Lead: &lead})
+		assert.ErrorIs(t, err, model.ErrInvalidComponentDetails)
+	})
+}
+
+func TestComponentService_Get(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	tests := []struct {
+		name    string
+		allowed bool
+		wantErr error
+	}{
+		{
+			name:    "get component",
+			allowed: true,
+		},
+		{
+			name:    "get component without permission",
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			component := testModel.NewRepositoryComponent(projectID, nil)
+
+			componentRepo := repository.NewMockComponentRepository(ctrl)
+			componentRepo.EXPECT().Get(ctx, component.ID, repository.ComponentDetailProjection()).Return(component, nil)
+
+			permSvc := NewMockPermissionService(ctrl)
+			permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(tt.allowed)
+
+			s := &componentService{baseService: &baseService{
+				tracer:            newCommentTestTracer(ctrl, ctx, "service.componentService/Get"),
+				componentRepo:     componentRepo,
+				permissionService: permSvc,
+			}}
+
+			got, err := s.Get(ctx, component.ID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, componentFromRepository(component), got)
+			}
+		})
+	}
+}
+
+func TestComponentService_List(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	component := testModel.NewRepositoryComponent(projectID, nil)
+
+	componentRepo := repository.NewMockComponentRepository(ctrl)
+	componentRepo.EXPECT().ListForProject(ctx, projectID, CursorPage{Size: repository.DefaultPageSize}, repository.ComponentListProjection()).
+		Return(repository.Page[*repository.Component]{Items: []*repository.Component{component}}, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+	s := &componentService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.componentService/List"),
+		componentRepo:     componentRepo,
+		permissionService: permSvc,
+	}}
+
+	got, err := s.List(ctx, projectID, CursorPage{})
+	require.NoError(t, err)
+	assert.Equal(t, []*Component{componentFromRepository(component)}, got.Items)
+}
+
+func TestComponentService_Update(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	component := testModel.NewRepositoryComponent(projectID, nil)
+
+	componentRepo := repository.NewMockComponentRepository(ctrl)
+	componentRepo.EXPECT().Get(ctx, component.ID, repository.ComponentDetailProjection()).Return(component, nil)
+	componentRepo.EXPECT().Update(ctx, component.ID, repository.UpdateComponentOpts{
+		Description: optional.Some(""),
+		Lead:        optional.Null[model.ID](),
+	}).Return(component, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+	licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+	licenseSvc.EXPECT().HasFeature(ctx, license.FeatureComponents).Return(true, nil)
+
+	s := &componentService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.componentService/Update"),
+		componentRepo:     componentRepo,
+		permissionService: permSvc,
+		licenseService:    licenseSvc,
+	}}
+
+	_, err := s.Update(ctx, component.ID, UpdateComponentOpts{
+		Description: optional.Null[string](),
+		Lead:        optional.Null[model.ID](),
+	})
+	require.NoError(t, err)
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	component := testModel.NewRepositoryComponent(projectID, nil)
+
+	componentRepo := repository.NewMockComponentRepository(ctrl)
+	componentRepo.EXPECT().Get(ctx, component.ID, repository.ComponentDetailProjection()).Return(component, nil)
+	componentRepo.EXPECT().Delete(ctx, component.ID).Return(nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+	s := &componentService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.componentService/Delete"),
+		componentRepo:     componentRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	require.NoError(t, s.Delete(ctx, component.ID))
+}
+
+func TestIssueService_CreateWithComponents(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	leadID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, componentRepo repository.ComponentRepository) (*issueService, *repository.MockIssueRepository) {
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(true)
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureComponents).Return(true, nil)
+
+		return &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/Create"),
+			issueRepo:         issueRepo,
+			componentRepo:     componentRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}, issueRepo
+	}
+
+	t.Run("assign the component lead", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		withoutLead := testModel.NewRepositoryComponent(projectID, nil)
+		withLead := testModel.NewRepositoryComponent(projectID, &leadID)
+		issue := testModel.NewRepositoryIssue(userID)
+
+		componentRepo := repository.NewMockComponentRepository(ctrl)
+		componentRepo.EXPECT().Get(ctx, withoutLead.ID, repository.ComponentDetailProjection()).Return(withoutLead, nil)
+		componentRepo.EXPECT().Get(ctx, withLead.ID, repository.ComponentDetailProjection()).Return(withLead, nil)
+		componentRepo.EXPECT().AttachTo(ctx, withoutLead.ID, issue.ID).Return(nil)
+		componentRepo.EXPECT().AttachTo(ctx, withLead.ID, issue.ID).Return(nil)
+
+		assignmentRepo := repository.NewMockAssignmentRepository(ctrl)
+		assignmentRepo.EXPECT().Create(ctx, repository.CreateAssignmentOpts{
+			Kind:     model.AssignmentKindAssignee,
+			User:     leadID,
+			Resource: issue.ID,
+		}).Return(&repository.Assignment{}, nil)
+
+		s, issueRepo := newService(ctrl, ctx, componentRepo)
+		s.assignmentRepo = assignmentRepo
+		s.searchService = mockSearchIndex(ctrl)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).Return(issue, nil)
+		issueRepo.EXPECT().AutoWatch(ctx, issue.ID, []model.ID{leadID}).Return(nil)
+		issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+
+		_, err := s.Create(ctx, projectID, CreateIssueOpts{
+			Kind:       model.IssueKindStory,
+			Title:      "component issue",
+			Components: []model.ID{withoutLead.ID, withLead.ID},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("component of another project", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		component := testModel.NewRepositoryComponent(model.MustNewID(model.ResourceTypeProject), &leadID)
+
+		componentRepo := repository.NewMockComponentRepository(ctrl)
+		componentRepo.EXPECT().Get(ctx, component.ID, repository.ComponentDetailProjection()).Return(component, nil)
+
+		s, _ := newService(ctrl, ctx, componentRepo)
+		_, err := s.Create(ctx, projectID, CreateIssueOpts{
+			Kind:       model.IssueKindStory,
+			Title:      "component issue",
+			Components: []model.ID{component.ID},
+		})
+		assert.ErrorIs(t, err, ErrIssueCreate)
+		assert.ErrorIs(t, err, ErrIssueComponent)
+	})
+}
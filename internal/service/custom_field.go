@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// CustomFieldService serves the business logic of interacting with the
+// custom fields of project issues.
+//
+//go:generate go tool mockgen -destination=custom_field_mock_gen.go -package=service -mock_names CustomFieldService=MockCustomFieldService . CustomFieldService
+type CustomFieldService interface {
+	// List returns the custom fields defined for the issues of a project.
+	List(ctx context.Context, projectID model.ID) (model.CustomFields, error)
+	// Set replaces the custom fields of a project. The values of removed
+	// fields are kept on the issues, but no longer returned.
+	Set(ctx context.Context, projectID model.ID, fields []model.CustomField) (model.CustomFields, error)
+}
+
+// customFieldService is the concrete implementation of CustomFieldService.
+type customFieldService struct {
+	*baseService
+}
+
+func (s *customFieldService) List(ctx context.Context, projectID model.ID) (model.CustomFields, error) {
+	ctx, span := s.tracer.Start(ctx, "service.customFieldService/List")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrCustomFieldGet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return nil, errors.Join(ErrCustomFieldGet, ErrNoPermission)
+	}
+
+	fields, err := s.customFieldRepo.List(ctx, projectID)
+	if err != nil {
+		return nil, errors.Join(ErrCustomFieldGet, err)
+	}
+
+	return fields, nil
+}
+
+func (s *customFieldService) Set(ctx context.Context, projectID model.ID, fields []model.CustomField) (model.CustomFields, error) {
+	ctx, span := s.tracer.Start(ctx, "service.customFieldService/Set")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrCustomFieldSet, license.ErrLicenseExpired)
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrCustomFieldSet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return nil, errors.Join(ErrCustomFieldSet, ErrNoPermission)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureCustomFields); !ok || err != nil {
+		return nil, errors.Join(ErrCustomFieldSet, ErrQuotaExceeded)
+	}
+
+	set, err := model.NewCustomFields(fields)
+	if err != nil {
+		return nil, errors.Join(ErrCustomFieldSet, err)
+	}
+
+	saved, err := s.customFieldRepo.Save(ctx, projectID, set)
+	if err != nil {
+		return nil, errors.Join(ErrCustomFieldSet, err)
+	}
+
+	return saved, nil
+}
+
+// projectCustomFields returns the custom fields of the issues in the project.
+// The custom fields are ignored without a custom field repository and when
+// the license does not include custom fields, in which case nil is returned.
+func (s *baseService) projectCustomFields(ctx context.Context, projectID model.ID) (model.CustomFields, error) {
+	if s.customFieldRepo == nil {
+		return nil, nil
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureCustomFields); !ok || err != nil {
+		return nil, err
+	}
+
+	return s.customFieldRepo.List(ctx, projectID)
+}
+
+// issueCustomFieldValues validates the custom field values set on an issue of
+// the project and returns them in the stored form. On create, every required
+// field must have a value. The users of user fields must be able to read the
+// project.
+func (s *baseService) issueCustomFieldValues(ctx context.Context, projectID model.ID, values map[string]any, create bool) (map[string]any, error) {
+	if len(values) == 0 && !create {
+		return nil, nil
+	}
+
+	fields, err := s.projectCustomFields(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if fields == nil && len(values) > 0 {
+		return nil, ErrQuotaExceeded
+	}
+
+	stored, err := fields.Values(values, create)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range stored {
+		user, ok := value.(string)
+		if field, _ := fields.Field(key); !ok || field.Type != model.CustomFieldTypeUser {
+			continue
+		}
+		if err := s.canReadProject(ctx, user, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	return stored, nil
+}
+
+// canReadProject reports whether the user set on a user field can read the
+// project of the issue. Users who do not exist cannot read it either.
+func (s *baseService) canReadProject(ctx context.Context, user string, projectID model.ID) error {
+	userID, err := model.NewIDFromString(user, model.ResourceTypeUser.String())
+	if err != nil {
+		return errors.Join(model.ErrInvalidCustomFieldValue, err)
+	}
+
+	ok, err := s.permissionService.Has(ctx, userID, projectID, model.ActionProjectRead)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return model.ErrInvalidCustomFieldValue
+	}
+	return nil
+}
+
+// issueListCustomFields resolves the custom fields the issues of a project
+// are filtered and sorted by, parsing the filter values by the type of their
+// field.
+func (s *baseService) issueListCustomFields(ctx context.Context, projectID model.ID, opts *IssueListOptions) error {
+	key, sorted := opts.Sort.Field.CustomFieldKey()
+	if len(opts.Filter.CustomFields) == 0 && !sorted {
+		return nil
+	}
+
+	fields, err := s.projectCustomFields(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if fields == nil {
+		return ErrQuotaExceeded
+	}
+
+	if sorted {
+		field, ok := fields.Field(key)
+		if !ok || !field.Sortable() {
+			return repository.ErrUnsupportedOrder
+		}
+	}
+
+	filters := make([]repository.IssueListCustomFieldFilter, len(opts.Filter.CustomFields))
+	for i, filter := range opts.Filter.CustomFields {
+		field, ok := fields.Field(filter.Key)
+		if !ok {
+			return model.ErrInvalidCustomFieldValue
+		}
+
+		values := make([]any, len(filter.Values))
+		for j, raw := range filter.Values {
+			text, ok := raw.(string)
+			if !ok {
+				return model.ErrInvalidCustomFieldValue
+			}
+			if values[j], err = field.ParseValue(text); err != nil {
+				return err
+			}
+		}
+
+		filters[i] = repository.IssueListCustomFieldFilter{
+			Key:    field.Key,
+			Type:   field.Type,
+			Values: values,
+		}
+	}
+	opts.Filter.CustomFields = filters
+
+	return nil
+}
+
+// NewCustomFieldService returns a new instance of the CustomFieldService
+// interface.
+func NewCustomFieldService(opts ...Option) (CustomFieldService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &customFieldService{
+		baseService: s,
+	}
+
+	if svc.customFieldRepo == nil {
+		return nil, ErrNoCustomFieldRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/opcotech/elemo/internal/service (interfaces: CustomFieldService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=custom_field_mock_gen.go -package=service -mock_names CustomFieldService=MockCustomFieldService . CustomFieldService
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldService is a mock of CustomFieldService interface.
+type MockCustomFieldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldServiceMockRecorder is the mock recorder for MockCustomFieldService.
+type MockCustomFieldServiceMockRecorder struct {
+	mock *MockCustomFieldService
+}
+
+// NewMockCustomFieldService creates a new mock instance.
+func NewMockCustomFieldService(ctrl *gomock.Controller) *MockCustomFieldService {
+	mock := &MockCustomFieldService{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldService) EXPECT() *MockCustomFieldServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockCustomFieldService) List(ctx context.Context, projectID model.ID) (model.CustomFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID)
+	ret0, _ := ret[0].(model.CustomFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomFieldServiceMockRecorder) List(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomFieldService)(nil).List), ctx, projectID)
+}
+
+// Set mocks base method.
+func (m *MockCustomFieldService) Set(ctx context.Context, projectID model.ID, fields []model.CustomField) (model.CustomFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, projectID, fields)
+	ret0, _ := ret[0].(model.CustomFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockCustomFieldServiceMockRecorder) Set(ctx, projectID, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCustomFieldService)(nil).Set), ctx, projectID, fields)
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func newTestCustomFields() model.CustomFields {
+	return model.CustomFields{
+		{Key: "customer", Name: "Customer", Type: model.CustomFieldTypeText, Required: true},
+		{Key: "points", Name: "Points", Type: model.CustomFieldTypeNumber},
+		{Key: "platforms", Name: "Platforms", Type: model.CustomFieldTypeMultiSelect, Options: []string{"ios", "web"}},
+	}
+}
+
+func TestNewCustomFieldService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new custom field service",
+			opts: []Option{
+				WithCustomFieldRepository(repository.NewMockCustomFieldRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new custom field service with invalid options",
+			opts:    []Option{WithCustomFieldRepository(nil)},
+			wantErr: ErrNoCustomFieldRepository,
+		},
+		{
+			name: "new custom field service with no custom field repository",
+			opts: []Option{
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoCustomFieldRepository,
+		},
+		{
+			name: "new custom field service with no license service",
+			opts: []Option{
+				WithCustomFieldRepository(repository.NewMockCustomFieldRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new custom field service with no permission service",
+			opts: []Option{
+				WithCustomFieldRepository(repository.NewMockCustomFieldRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewCustomFieldService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestCustomFieldService_List(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("list custom fields", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		customFieldRepo := repository.NewMockCustomFieldRepository(ctrl)
+		customFieldRepo.EXPECT().List(ctx, projectID).Return(newTestCustomFields(), nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		s := &customFieldService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.customFieldService/List"),
+			customFieldRepo:   customFieldRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.List(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, newTestCustomFields(), got)
+	})
+
+	t.Run("list custom fields without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+		s := &customFieldService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.customFieldService/List"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.List(ctx, projectID)
+		assert.ErrorIs(t, err, ErrCustomFieldGet)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestCustomFieldService_Set(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, licensed bool) (*customFieldService, *repository.MockCustomFieldRepository) {
+		customFieldRepo := repository.NewMockCustomFieldRepository(ctrl)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomFields).Return(licensed, nil)
+
+		return &customFieldService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.customFieldService/Set"),
+			customFieldRepo:   customFieldRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}, customFieldRepo
+	}
+
+	t.Run("set custom fields", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, customFieldRepo := newService(ctrl, ctx, true)
+		customFieldRepo.EXPECT().Save(ctx, projectID, newTestCustomFields()).Return(newTestCustomFields(), nil)
+
+		got, err := s.Set(ctx, projectID, newTestCustomFields())
+		require.NoError(t, err)
+		assert.Equal(t, newTestCustomFields(), got)
+	})
+
+	t.Run("set custom fields without licensed feature", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, _ := newService(ctrl, ctx, false)
+		_, err := s.Set(ctx, projectID, newTestCustomFields())
+		assert.ErrorIs(t, err, ErrCustomFieldSet)
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+	})
+
+	t.Run("set custom fields with invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, _ := newService(ctrl, ctx, true)
+		_, err := s.Set(ctx, projectID, []model.CustomField{
+			{Key: "severity", Name: "Severity", Type: model.CustomFieldTypeSingleSelect},
+		})
+		assert.ErrorIs(t, err, ErrCustomFieldSet)
+		assert.ErrorIs(t, err, model.ErrInvalidCustomFieldDetails)
+	})
+
+	t.Run("set custom fields with expired license", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &customFieldService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.customFieldService/Set"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.Set(ctx, projectID, newTestCustomFields())
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
+
+func TestIssueService_UpdateCustomFields(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newIssue := func() *repository.Issue {
+		issue := testModel.NewRepositoryIssue(userID)
+		issue.Project = &repository.PartialProject{ID: projectID}
+		issue.CustomFields = map[string]any{"customer": "acme"}
+		return issue
+	}
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, issueRepo repository.IssueRepository, lists int) *issueService {
+		customFieldRepo := repository.NewMockCustomFieldRepository(ctrl)
+		customFieldRepo.EXPECT().List(ctx, projectID).Return(newTestCustomFields(), nil).Times(lists)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), model.ActionIssueUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomFields).Return(true, nil).Times(lists)
+
+		return &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/Update"),
+			issueRepo:         issueRepo,
+			customFieldRepo:   customFieldRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+	}
+
+	t.Run("set custom fields", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+		after := *before
+		after.CustomFields = map[string]any{"customer": "acme", "points": float64(5), "removed": "value"}
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+		issueRepo.EXPECT().Update(ctx, before.ID, repository.UpdateIssueOpts{
+			CustomFields: map[string]any{"points": float64(5), "platforms": nil},
+		}, repository.IssueDetailProjection()).Return(&after, nil)
+
+		s := newService(ctrl, ctx, issueRepo, 2)
+		s.searchService = mockSearchIndex(ctrl)
+		got, err := s.Update(ctx, before.ID, UpdateIssueOpts{
+			CustomFields: map[string]any{"points": 5, "platforms": nil},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"customer": "acme", "points": float64(5)}, got.CustomFields)
+	})
+
+	t.Run("clear required custom field", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+
+		s := newService(ctrl, ctx, issueRepo, 1)
+		_, err := s.Update(ctx, before.ID, UpdateIssueOpts{
+			CustomFields: map[string]any{"customer": nil},
+		})
+		assert.ErrorIs(t, err, ErrIssueUpdate)
+		assert.ErrorIs(t, err, model.ErrInvalidCustomFieldValue)
+	})
+
+	t.Run("unknown custom field", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+
+		s := newService(ctrl, ctx, issueRepo, 1)
+		_, err := s.Update(ctx, before.ID, UpdateIssueOpts{
+			CustomFields: map[string]any{"team": "core"},
+		})
+		assert.ErrorIs(t, err, model.ErrInvalidCustomFieldValue)
+	})
+}
+
+func TestIssueService_issueCustomFieldValues(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	reviewerID := model.MustNewID(model.ResourceTypeUser)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, allowed bool, err error) *issueService {
+		customFieldRepo := repository.NewMockCustomFieldRepository(ctrl)
+		customFieldRepo.EXPECT().List(ctx, projectID).Return(model.CustomFields{
+			{Key: "reviewer", Name: "Reviewer", Type: model.CustomFieldTypeUser},
+			{Key: "points", Name: "Points", Type: model.CustomFieldTypeNumber},
+		}, nil)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomFields).Return(true, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, reviewerID, projectID, model.ActionProjectRead).Return(allowed, err)
+
+		return &issueService{baseService: &baseService{
+			customFieldRepo:   customFieldRepo,
+			licenseService:    licenseSvc,
+			permissionService: permSvc,
+		}}
+	}
+
+	t.Run("set user who can read the project", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := newService(ctrl, ctx, true, nil)
+		got, err := s.issueCustomFieldValues(ctx, projectID, map[string]any{
+			"reviewer": reviewerID.String(),
+			"points":   3,
+		}, false)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"reviewer": reviewerID.String(), "points": float64(3)}, got)
+	})
+
+	t.Run("set user who cannot read the project", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := newService(ctrl, ctx, false, nil)
+		_, err := s.issueCustomFieldValues(ctx, projectID, map[string]any{"reviewer": reviewerID.String()}, false)
+		assert.ErrorIs(t, err, model.ErrInvalidCustomFieldValue)
+	})
+
+	t.Run("set user with permission error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := newService(ctrl, ctx, false, assert.AnError)
+		_, err := s.issueCustomFieldValues(ctx, projectID, map[string]any{"reviewer": reviewerID.String()}, false)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestIssueService_issueListCustomFields(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context) *issueService {
+		customFieldRepo := repository.NewMockCustomFieldRepository(ctrl)
+		customFieldRepo.EXPECT().List(ctx, projectID).Return(newTestCustomFields(), nil)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomFields).Return(true, nil)
+
+		return &issueService{baseService: &baseService{
+			customFieldRepo: customFieldRepo,
+			licenseService:  licenseSvc,
+		}}
+	}
+
+	t.Run("parse filter values", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		opts := IssueListOptions{
+			Filter: repository.IssueListFilter{
+				CustomFields: []repository.IssueListCustomFieldFilter{{Key: "points", Values: []any{"3", "5"}}},
+			},
+			Sort: repository.IssueListSort{Field: repository.IssueListSortFieldCustom("customer")},
+		}
+		require.NoError(t, newService(ctrl, ctx).issueListCustomFields(ctx, projectID, &opts))
+		assert.Equal(t, []repository.IssueListCustomFieldFilter{
+			{Key: "points", Type: model.CustomFieldTypeNumber, Values: []any{float64(3), float64(5)}},
+		}, opts.Filter.CustomFields)
+	})
+
+	t.Run("sort by multi-select field", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		opts := IssueListOptions{
+			Sort: repository.IssueListSort{Field: repository.IssueListSortFieldCustom("platforms")},
+		}
+		err := newService(ctrl, ctx).issueListCustomFields(ctx, projectID, &opts)
+		assert.ErrorIs(t, err, repository.ErrUnsupportedOrder)
+	})
+
+	t.Run("invalid filter value", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		opts := IssueListOptions{
+			Filter: repository.IssueListFilter{
+				CustomFields: []repository.IssueListCustomFieldFilter{{Key: "platforms", Values: []any{"linux"}}},
+			},
+		}
+		err := newService(ctrl, ctx).issueListCustomFields(ctx, projectID, &opts)
+		assert.ErrorIs(t, err, model.ErrInvalidCustomFieldValue)
+	})
+}
@@ -75,6 +75,28 @@ type UpdateDocumentOpts struct {
 	FolderID  optional.Optional[model.ID]
 }
 
+// changedFields returns the JSON names of the fields defined in the options.
+func (o UpdateDocumentOpts) changedFields() []string {
+	fields := []struct {
+		name    string
+		defined bool
+	}{
+		{"title", o.Title.Defined},
+		{"excerpt", o.Excerpt.Defined},
+		{"content", o.Content.Defined},
+		{"library_id", o.LibraryID.Defined},
+		{"folder_id", o.FolderID.Defined},
+	}
+
+	changed := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field.defined {
+			changed = append(changed, field.name)
+		}
+	}
+	return changed
+}
+
 // LibraryListFilter selects which documents to return from a library.
 type LibraryListFilter struct {
 	FolderID *model.ID
@@ -177,6 +199,20 @@ func (s *documentService) hasDocumentPermission(ctx context.Context, docID model
 	return s.permissionService.CtxUserHas(ctx, docID, action)
 }
 
+// publishDocumentUpdated publishes the update of the document if any of its
+// fields changed.
+func (s *documentService) publishDocumentUpdated(ctx context.Context, id model.ID, opts UpdateDocumentOpts) {
+	changed := opts.changedFields()
+	if len(changed) == 0 {
+		return
+	}
+	s.publishEvent(ctx, &repository.Event{
+		Type:          EventTypeDocumentUpdated,
+		Resource:      id,
+		ChangedFields: changed,
+	})
+}
+
 func relatedResourceReadAction(id model.ID) (model.Action, bool) {
 	return model.ReadActionFor(id.Type)
 }
@@ -261,6 +297,7 @@ func (s *documentService) Create(ctx context.Context, contextID model.ID, opts C
 
 	out := documentFromRepository(doc, opts.Content)
 	s.enqueueSearchIndex(ctx, out.ID)
+	s.notifyMentions(ctx, out.ID, out.ID, documentMentionSubject, out.Title, string(opts.Content))
 	return out, nil
 }
 
@@ -388,6 +425,7 @@ func (s *documentService) Update(ctx context.Context, id model.ID, opts UpdateDo
 		if err := s.staticFileService.Update(ctx, current.FileID, *opts.Content.Value); err != nil {
 			return nil, errors.Join(ErrDocumentUpdate, err)
 		}
+		s.notifyMentions(ctx, current.ID, current.ID, documentMentionSubject, current.Title, string(*opts.Content.Value))
 	}
 
 	if opts.Title.Defined || opts.Excerpt.Defined {
@@ -406,6 +444,7 @@ func (s *documentService) Update(ctx context.Context, id model.ID, opts UpdateDo
 			return nil, err
 		}
 		if !opts.FolderID.Defined {
+			s.publishDocumentUpdated(ctx, moved.ID, opts)
 			return moved, nil
 		}
 	}
@@ -416,6 +455,7 @@ func (s *documentService) Update(ctx context.Context, id model.ID, opts UpdateDo
 			return nil, err
 		}
 		s.enqueueSearchIndex(ctx, moved.ID)
+		s.publishDocumentUpdated(ctx, moved.ID, opts)
 		return moved, nil
 	}
 
@@ -426,6 +466,7 @@ func (s *documentService) Update(ctx context.Context, id model.ID, opts UpdateDo
 
 	out := documentFromRepository(current, content)
 	s.enqueueSearchIndex(ctx, out.ID)
+	s.publishDocumentUpdated(ctx, out.ID, opts)
 	return out, nil
 }
 
@@ -3,6 +3,73 @@ package service
 import "errors"
 
 var (
+	ErrAttachmentCreate   = errors.New("failed to create attachment")   // failed to create attachment
+	ErrAttachmentDelete   = errors.New("failed to delete attachment")   // failed to delete attachment
+	ErrAttachmentGet      = errors.New("failed to get attachment")      // failed to get attachment
+	ErrAttachmentGetAll   = errors.New("failed to get attachments")     // failed to get attachments
+	ErrAttachmentTooLarge = errors.New("attachment exceeds size limit") // attachment exceeds size limit
+	ErrAttachmentUpdate   = errors.New("failed to update attachment")   // failed to update attachment
+
+	ErrCommentCreate = errors.New("failed to create comment") // failed to create comment
+	ErrCommentDelete = errors.New("failed to delete comment") // failed to delete comment
+	ErrCommentGet    = errors.New("failed to get comment")    // failed to get comment
+	ErrCommentGetAll = errors.New("failed to get comments")   // failed to get comments
+	ErrCommentUpdate = errors.New("failed to update comment") // failed to update comment
+
+	ErrComponentCreate = errors.New("failed to create component") // failed to create component
+	ErrComponentDelete = errors.New("failed to delete component") // failed to delete component
+	ErrComponentGet    = errors.New("failed to get component")    // failed to get component
+	ErrComponentGetAll = errors.New("failed to get components")   // failed to get components
+	ErrComponentUpdate = errors.New("failed to update component") // failed to update component
+
+	ErrReleaseAttach = errors.New("failed to attach release")         // failed to attach release
+	ErrReleaseCreate = errors.New("failed to create release")         // failed to create release
+	ErrReleaseDelete = errors.New("failed to delete release")         // failed to delete release
+	ErrReleaseDetach = errors.New("failed to detach release")         // failed to detach release
+	ErrReleaseGet    = errors.New("failed to get release")            // failed to get release
+	ErrReleaseGetAll = errors.New("failed to get releases")           // failed to get releases
+	ErrReleaseNotes  = errors.New("failed to generate release notes") // failed to generate release notes
+	ErrReleaseUpdate = errors.New("failed to update release")         // failed to update release
+
+	ErrSprintAddIssue    = errors.New("failed to add issue to sprint")      // failed to add issue to sprint
+	ErrSprintBurndown    = errors.New("failed to get sprint burndown")      // failed to get sprint burndown
+	ErrSprintClosed      = errors.New("sprint is closed")                   // sprint is closed
+	ErrSprintCreate      = errors.New("failed to create sprint")            // failed to create sprint
+	ErrSprintDelete      = errors.New("failed to delete sprint")            // failed to delete sprint
+	ErrSprintGet         = errors.New("failed to get sprint")               // failed to get sprint
+	ErrSprintGetAll      = errors.New("failed to get sprints")              // failed to get sprints
+	ErrSprintRemoveIssue = errors.New("failed to remove issue from sprint") // failed to remove issue from sprint
+	ErrSprintUpdate      = errors.New("failed to update sprint")            // failed to update sprint
+
+	ErrTimeTrackingGet = errors.New("failed to get time tracking") // failed to get time tracking
+	ErrTimesheetGet    = errors.New("failed to get timesheet")     // failed to get timesheet
+	ErrTimesheetRange  = errors.New("invalid timesheet range")     // invalid timesheet range
+	ErrWorkLogCreate   = errors.New("failed to create work log")   // failed to create work log
+	ErrWorkLogDelete   = errors.New("failed to delete work log")   // failed to delete work log
+	ErrWorkLogGet      = errors.New("failed to get work log")      // failed to get work log
+	ErrWorkLogGetAll   = errors.New("failed to get work logs")     // failed to get work logs
+	ErrWorkLogUpdate   = errors.New("failed to update work log")   // failed to update work log
+
+	ErrIssueTemplateCreate = errors.New("failed to create issue template") // failed to create issue template
+	ErrIssueTemplateDelete = errors.New("failed to delete issue template") // failed to delete issue template
+	ErrIssueTemplateGet    = errors.New("failed to get issue template")    // failed to get issue template
+	ErrIssueTemplateGetAll = errors.New("failed to get issue templates")   // failed to get issue templates
+	ErrIssueTemplateUpdate = errors.New("failed to update issue template") // failed to update issue template
+
+	ErrIssueRecurrenceDelete      = errors.New("failed to delete issue recurrence")       // failed to delete issue recurrence
+	ErrIssueRecurrenceGet         = errors.New("failed to get issue recurrence")          // failed to get issue recurrence
+	ErrIssueRecurrenceMaterialize = errors.New("failed to materialize issue recurrences") // failed to materialize issue recurrences
+	ErrIssueRecurrenceSet         = errors.New("failed to set issue recurrence")          // failed to set issue recurrence
+
+	ErrCustomFieldGet = errors.New("failed to get custom fields") // failed to get custom fields
+	ErrCustomFieldSet = errors.New("failed to set custom fields") // failed to set custom fields
+
+	ErrBoardColumn  = errors.New("invalid board column")     // invalid board column
+	ErrBoardGet     = errors.New("failed to get board")      // failed to get board
+	ErrBoardGroupBy = errors.New("invalid board grouping")   // invalid board grouping
+	ErrWIPLimitGet  = errors.New("failed to get WIP limits") // failed to get WIP limits
+	ErrWIPLimitSet  = errors.New("failed to set WIP limits") // failed to set WIP limits
+
 	ErrDocumentCreate   = errors.New("failed to create document")   // failed to create document
 	ErrDocumentDelete   = errors.New("failed to delete document")   // failed to delete document
 	ErrDocumentGet      = errors.New("failed to get document")      // failed to get document
@@ -18,23 +85,67 @@ var (
 	ErrFolderGetAll = errors.New("failed to get folders")   // failed to get folders
 	ErrFolderUpdate = errors.New("failed to update folder") // failed to update folder
 
+	ErrViewCreate     = errors.New("failed to create view")                                // failed to create view
+	ErrViewDelete     = errors.New("failed to delete view")                                // failed to delete view
+	ErrViewGet        = errors.New("failed to get view")                                   // failed to get view
+	ErrViewGetAll     = errors.New("failed to get views")                                  // failed to get views
+	ErrViewShare      = errors.New("view can only be shared with teams and organizations") // view can only be shared with teams and organizations
+	ErrViewShareScope = errors.New("view can only be shared within its organization")      // view can only be shared within its organization
+	ErrViewUpdate     = errors.New("failed to update view")                                // failed to update view
+
 	ErrEmailSend                       = errors.New("failed to send email")                         // failed to send email
+	ErrEventSubscribe                  = errors.New("failed to subscribe to events")                // failed to subscribe to events
 	ErrExpiredToken                    = errors.New("expired token")                                // expired token
 	ErrInvalidEmail                    = errors.New("invalid email address")                        // invalid email address
+	ErrInvalidEventID                  = errors.New("invalid event id")                             // invalid event id
 	ErrInvalidPaginationParams         = errors.New("invalid pagination parameters")                // invalid pagination parameters
 	ErrInvalidToken                    = errors.New("invalid token")                                // invalid token
 	ErrIssueAddRelation                = errors.New("failed to add issue relation")                 // failed to add issue relation
+	ErrIssueBulk                       = errors.New("failed to apply bulk issue operation")         // failed to apply bulk issue operation
+	ErrIssueBulkOperation              = errors.New("invalid bulk issue operation")                 // invalid bulk issue operation
+	ErrIssueBulkSize                   = errors.New("invalid number of issues in bulk operation")   // invalid number of issues in bulk operation
+	ErrIssueClone                      = errors.New("failed to clone issue")                        // failed to clone issue
+	ErrIssueComponent                  = errors.New("component is not part of the issue project")   // component is not part of the issue project
 	ErrIssueCreate                     = errors.New("failed to create issue")                       // failed to create issue
+	ErrIssueCreateFromTemplate         = errors.New("failed to create issue from template")         // failed to create issue from template
+	ErrIssueCustomFieldList            = errors.New("custom fields need a project issue list")      // custom fields need a project issue list
 	ErrIssueDelete                     = errors.New("failed to delete issue")                       // failed to delete issue
 	ErrIssueGet                        = errors.New("failed to get issue")                          // failed to get issue
+	ErrIssueDependencyDepth            = errors.New("invalid dependency graph depth")               // invalid dependency graph depth
+	ErrIssueGetActivity                = errors.New("failed to get issue activity")                 // failed to get issue activity
 	ErrIssueGetAll                     = errors.New("failed to get issues")                         // failed to get issues
+	ErrIssueGetCriticalPath            = errors.New("failed to get critical path")                  // failed to get critical path
+	ErrIssueGetDependencyGraph         = errors.New("failed to get issue dependency graph")         // failed to get issue dependency graph
 	ErrIssueGetRelations               = errors.New("failed to get issue relations")                // failed to get issue relations
+	ErrIssueGetRollups                 = errors.New("failed to get issue rollups")                  // failed to get issue rollups
+	ErrIssueGetWatchers                = errors.New("failed to get issue watchers")                 // failed to get issue watchers
+	ErrIssueMove                       = errors.New("failed to move issue")                         // failed to move issue
+	ErrIssueMoveCard                   = errors.New("failed to move issue card")                    // failed to move issue card
+	ErrIssueMoveProject                = errors.New("issue is already in the project")              // issue is already in the project
 	ErrIssueRemoveRelation             = errors.New("failed to remove issue relation")              // failed to remove issue relation
+	ErrIssueQuery                      = errors.New("invalid issue query")                          // invalid issue query
+	ErrIssueRank                       = errors.New("failed to rank issue")                         // failed to rank issue
+	ErrIssueRankAnchor                 = errors.New("issue must be ranked next to another issue")   // issue must be ranked next to another issue
+	ErrIssueRelationCycle              = errors.New("relation would create a dependency cycle")     // relation would create a dependency cycle
+	ErrIssueRelease                    = errors.New("release is not part of the issue project")     // release is not part of the issue project
 	ErrIssueReservedRelationKind       = errors.New("relation kind is reserved")                    // relation kind is reserved
+	ErrIssueRollupSize                 = errors.New("invalid number of issues to get rollups for")  // invalid number of issues to get rollups for
+	ErrIssueSprint                     = errors.New("sprint is not part of the issue project")      // sprint is not part of the issue project
 	ErrIssueSelfRelation               = errors.New("issue cannot be related to itself")            // issue cannot be related to itself
+	ErrIssueUnwatch                    = errors.New("failed to unwatch issue")                      // failed to unwatch issue
 	ErrIssueUpdate                     = errors.New("failed to update issue")                       // failed to update issue
 	ErrIssueUpdateRelation             = errors.New("failed to update issue relation")              // failed to update issue relation
+	ErrIssueWatch                      = errors.New("failed to watch issue")                        // failed to watch issue
+	ErrIssueWorkflowStatus             = errors.New("status is not part of the project workflow")   // status is not part of the project workflow
+	ErrIssueWorkflowTransition         = errors.New("status transition is not allowed")             // status transition is not allowed
+	ErrLabelAttach                     = errors.New("failed to attach label")                       // failed to attach label
+	ErrLabelCreate                     = errors.New("failed to create label")                       // failed to create label
+	ErrLabelDelete                     = errors.New("failed to delete label")                       // failed to delete label
+	ErrLabelDetach                     = errors.New("failed to detach label")                       // failed to detach label
+	ErrLabelGet                        = errors.New("failed to get label")                          // failed to get label
 	ErrLabelGetAll                     = errors.New("failed to get labels")                         // failed to get labels
+	ErrLabelOutOfScope                 = errors.New("label is not defined in the resource scope")   // label is not defined in the resource scope
+	ErrLabelUpdate                     = errors.New("failed to update label")                       // failed to update label
 	ErrLicenseGet                      = errors.New("failed to get license")                        // failed to get license
 	ErrLicensePing                     = errors.New("failed to ping license")                       // failed to ping license
 	ErrNamespaceCreate                 = errors.New("failed to create namespace")                   // failed to create namespace
@@ -43,13 +154,22 @@ var (
 	ErrNamespaceGetAll                 = errors.New("failed to get namespaces")                     // failed to get namespaces
 	ErrNamespaceUpdate                 = errors.New("failed to update namespace")                   // failed to update namespace
 	ErrNoAssignmentRepository          = errors.New("no assignment repository provided")            // no assignment repository provided
+	ErrNoAttachmentRepository          = errors.New("no attachment repository provided")            // no attachment repository provided
+	ErrNoCommentRepository             = errors.New("no comment repository provided")               // no comment repository provided
+	ErrNoComponentRepository           = errors.New("no component repository provided")             // no component repository provided
+	ErrNoCustomFieldRepository         = errors.New("no custom field repository provided")          // no custom field repository provided
 	ErrNoDocumentRepository            = errors.New("no document repository provided")              // no document repository provided
 	ErrNoFolderRepository              = errors.New("no folder repository provided")                // no folder repository provided
 	ErrNoEmailService                  = errors.New("no email service provided")                    // no email service provided
+	ErrNoEventRepository               = errors.New("no event repository provided")                 // no event repository provided
+	ErrNoIssueActivityRepository       = errors.New("no issue activity repository provided")        // no issue activity repository provided
+	ErrNoIssueRecurrenceRepository     = errors.New("no issue recurrence repository provided")      // no issue recurrence repository provided
 	ErrNoIssueRepository               = errors.New("no issue repository provided")                 // no issue repository provided
+	ErrNoIssueTemplateRepository       = errors.New("no issue template repository provided")        // no issue template repository provided
 	ErrNoLabelRepository               = errors.New("no label repository provided")                 // no label repository provided
 	ErrNoLabelService                  = errors.New("no label service provided")                    // no label service provided
 	ErrNoLicenseService                = errors.New("no license service provided")                  // no license service provided
+	ErrNoMentionRepository             = errors.New("no mention repository provided")               // no mention repository provided
 	ErrNoNamespaceRepository           = errors.New("no namespace repository provided")             // no namespace repository provided
 	ErrNoNotificationRepository        = errors.New("no notification repository provided")          // no notification repository provided
 	ErrNoNotificationService           = errors.New("no notification service provided")             // no notification service provided
@@ -58,8 +178,11 @@ var (
 	ErrNoPermissionRepository          = errors.New("no permission repository provided")            // no permission repository provided
 	ErrNoPermissionService             = errors.New("no permission service provided")               // no permission service provided
 	ErrNoProjectRepository             = errors.New("no project repository provided")               // no project repository provided
+	ErrNoReleaseRepository             = errors.New("no release repository provided")               // no release repository provided
 	ErrNoResources                     = errors.New("no resources provided")                        // no resources provided
 	ErrNoRoleRepository                = errors.New("no role repository provided")                  // no role repository provided
+	ErrNoSprintRepository              = errors.New("no sprint repository provided")                // no sprint repository provided
+	ErrNoSprintScopeChangeRepository   = errors.New("no sprint scope change repository provided")   // no sprint scope change repository provided
 	ErrNoTeamRepository                = errors.New("no team repository provided")                  // no team repository provided
 	ErrNoStaticFileRepository          = errors.New("no static file repository provided")           // no static file repository provided
 	ErrNoStaticFileService             = errors.New("no static file service provided")              // no static file service provided
@@ -67,10 +190,17 @@ var (
 	ErrNoUser                          = errors.New("no user provided")                             // no user provided
 	ErrNoUserRepository                = errors.New("no user repository provided")                  // no user repository provided
 	ErrNoUserTokenRepository           = errors.New("no user token repository provided")            // no user token repository provided
+	ErrNoWorkLogRepository             = errors.New("no work log repository provided")              // no work log repository provided
 	ErrNoSearchRepository              = errors.New("no search repository provided")                // no search repository provided
 	ErrNoSearchService                 = errors.New("no search service provided")                   // no search service provided
 	ErrNoSearchTaskEnqueuer            = errors.New("no search task enqueuer provided")             // no search task enqueuer provided
+	ErrNoNotificationTaskEnqueuer      = errors.New("no notification task enqueuer provided")       // no notification task enqueuer provided
+	ErrNoWebhookRepository             = errors.New("no webhook repository provided")               // no webhook repository provided
+	ErrNoWebhookTaskEnqueuer           = errors.New("no webhook task enqueuer provided")            // no webhook task enqueuer provided
+	ErrNoWorkflowRepository            = errors.New("no workflow repository provided")              // no workflow repository provided
 	ErrNoVersionInfo                   = errors.New("no version info provided")                     // no version info provided
+	ErrNoViewRepository                = errors.New("no view repository provided")                  // no view repository provided
+	ErrNoWIPLimitRepository            = errors.New("no WIP limit repository provided")             // no WIP limit repository provided
 	ErrNotificationCreate              = errors.New("failed to create notification")                // failed to create notification
 	ErrNotificationDelete              = errors.New("failed to delete notification")                // failed to delete notification
 	ErrNotificationGet                 = errors.New("failed to get notification")                   // failed to get notification
@@ -143,4 +273,15 @@ var (
 	ErrUserGetAll                      = errors.New("failed to get users")                          // failed to get users
 	ErrUserUpdate                      = errors.New("failed to update user")                        // failed to update user
 	ErrUserVerifyToken                 = errors.New("failed to verify user token")                  // failed to verify user token
+	ErrWebhookCreate                   = errors.New("failed to create webhook")                     // failed to create webhook
+	ErrWebhookDelete                   = errors.New("failed to delete webhook")                     // failed to delete webhook
+	ErrWebhookDeliveryPending          = errors.New("webhook delivery is still pending")            // webhook delivery is still pending
+	ErrWebhookDeliveryGetAll           = errors.New("failed to get webhook deliveries")             // failed to get webhook deliveries
+	ErrWebhookGet                      = errors.New("failed to get webhook")                        // failed to get webhook
+	ErrWebhookGetAll                   = errors.New("failed to get webhooks")                       // failed to get webhooks
+	ErrWebhookRedeliver                = errors.New("failed to redeliver webhook delivery")         // failed to redeliver webhook delivery
+	ErrWebhookUpdate                   = errors.New("failed to update webhook")                     // failed to update webhook
+	ErrWorkflowDelete                  = errors.New("failed to delete workflow")                    // failed to delete workflow
+	ErrWorkflowGet                     = errors.New("failed to get workflow")                       // failed to get workflow
+	ErrWorkflowSet                     = errors.New("failed to set workflow")                       // failed to set workflow
 )
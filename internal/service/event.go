@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	EventTypeNotificationCreated = "notification.created" // a notification was sent to the user
+	EventTypeIssueUpdated        = "issue.updated"        // an issue was updated
+	EventTypeDocumentUpdated     = "document.updated"     // a document was updated
+
+	eventStreamBufferSize = 16
+)
+
+// Event represents a change streamed to the connected user.
+type Event struct {
+	ID            int64
+	Type          string
+	Resource      model.ID
+	Actor         *model.ID
+	ChangedFields []string
+	CreatedAt     time.Time
+}
+
+func eventFromRepository(e *repository.Event) *Event {
+	if e == nil {
+		return nil
+	}
+	return &Event{
+		ID:            e.ID,
+		Type:          e.Type,
+		Resource:      e.Resource,
+		Actor:         e.Actor,
+		ChangedFields: e.ChangedFields,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+// EventService streams the changes of resources to the connected users.
+//
+//go:generate go tool mockgen -destination=event_mock_gen.go -package=service -mock_names EventService=MockEventService . EventService
+type EventService interface {
+	// Subscribe returns the stream of events the user in the context is
+	// allowed to see. If lastEventID is not zero, the buffered events
+	// published after it are replayed first. The channel is closed when the
+	// context is done.
+	Subscribe(ctx context.Context, lastEventID int64) (<-chan *Event, error)
+}
+
+// eventService is the concrete implementation of EventService.
+type eventService struct {
+	*baseService
+}
+
+// canSee reports whether the user is allowed to receive the event. Events
+// with a recipient are delivered to the recipient only, others to the users
+// who can read the changed resource.
+func (s *eventService) canSee(ctx context.Context, userID model.ID, event *repository.Event) bool {
+	if event.Recipient != nil {
+		return *event.Recipient == userID
+	}
+
+	action, ok := model.ReadActionFor(event.Resource.Type)
+	if !ok {
+		return false
+	}
+
+	allowed, err := s.permissionService.Has(ctx, userID, event.Resource, action)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to check event permission",
+			log.WithError(err),
+			log.WithValue(event.Resource.Composite()),
+		)
+		return false
+	}
+
+	return allowed
+}
+
+func (s *eventService) Subscribe(ctx context.Context, lastEventID int64) (<-chan *Event, error) {
+	ctx, span := s.tracer.Start(ctx, "service.eventService/Subscribe")
+	defer span.End()
+
+	if lastEventID < 0 {
+		return nil, errors.Join(ErrEventSubscribe, ErrInvalidEventID)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrEventSubscribe, ErrNoUser)
+	}
+
+	// Subscribe before reading the replay buffer, so no event published in
+	// between is lost. Events present in both are delivered once.
+	live, err := s.eventRepo.Subscribe(ctx)
+	if err != nil {
+		return nil, errors.Join(ErrEventSubscribe, err)
+	}
+
+	replay := make([]*repository.Event, 0)
+	if lastEventID > 0 {
+		if replay, err = s.eventRepo.Since(ctx, lastEventID); err != nil {
+			return nil, errors.Join(ErrEventSubscribe, err)
+		}
+	}
+
+	events := make(chan *Event, eventStreamBufferSize)
+	go func() {
+		defer close(events)
+
+		replayed := make(map[int64]struct{}, len(replay))
+		send := func(event *repository.Event) bool {
+			if !s.canSee(ctx, userID, event) {
+				return true
+			}
+			select {
+			case events <- eventFromRepository(event):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, event := range replay {
+			replayed[event.ID] = struct{}{}
+			if !send(event) {
+				return
+			}
+		}
+
+		for {
+			var event *repository.Event
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-live:
+				if !ok {
+					return
+				}
+				event = e
+			}
+
+			if _, ok := replayed[event.ID]; ok || event.ID <= lastEventID {
+				continue
+			}
+			if !send(event) {
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// publishEvent publishes the change of a resource to the connected users and
+// to the subscribed webhooks. If the event has no actor, the user in the
+// context is used. Publishing is a side effect of the calling operation,
+// therefore failures are logged only. Without an event repository no events
+// are streamed.
+func (s *baseService) publishEvent(ctx context.Context, event *repository.Event) {
+	if event.Actor == nil {
+		if actor, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID); ok {
+			event.Actor = &actor
+		}
+	}
+
+	if event.ChangedFields == nil {
+		event.ChangedFields = make([]string, 0)
+	}
+
+	s.enqueueWebhookDeliveries(ctx, event)
+
+	if s.eventRepo == nil {
+		return
+	}
+
+	if err := s.eventRepo.Publish(ctx, event); err != nil {
+		s.logger.Warn(ctx, "failed to publish event",
+			log.WithError(err),
+			log.WithValue(event.Resource.Composite()),
+		)
+	}
+}
+
+// NewEventService returns a new instance of the EventService interface.
+func NewEventService(opts ...Option) (EventService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &eventService{
+		baseService: s,
+	}
+
+	if svc.eventRepo == nil {
+		return nil, ErrNoEventRepository
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func TestNewEventService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new event service",
+			opts: []Option{
+				WithEventRepository(repository.NewMockEventRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLogger(mock.NewMockLogger(nil)),
+				WithTracer(mock.NewMockTracer(nil)),
+			},
+		},
+		{
+			name: "new event service with invalid options",
+			opts: []Option{
+				WithEventRepository(repository.NewMockEventRepository(nil)),
+				WithLogger(nil),
+			},
+			wantErr: log.ErrNoLogger,
+		},
+		{
+			name: "new event service with no event repository",
+			opts: []Option{
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoEventRepository,
+		},
+		{
+			name: "new event service with no permission service",
+			opts: []Option{
+				WithEventRepository(repository.NewMockEventRepository(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewEventService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestEventService_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	otherID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	hiddenIssueID := model.MustNewID(model.ResourceTypeIssue)
+	notificationID := model.MustNewID(model.ResourceTypeNotification)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, eventRepo repository.EventRepository, permSvc PermissionService) *eventService {
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0)).AnyTimes()
+
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), "service.eventService/Subscribe", gomock.Len(0)).Return(ctx, span).AnyTimes()
+
+		return &eventService{
+			baseService: &baseService{
+				logger:            mock.NewMockLogger(ctrl),
+				tracer:            tracer,
+				eventRepo:         eventRepo,
+				permissionService: permSvc,
+			},
+		}
+	}
+
+	t.Run("filters and replays events", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), pkg.CtxKeyUserID, userID))
+		defer cancel()
+
+		live := make(chan *repository.Event, 4)
+		live <- &repository.Event{ID: 3, Type: EventTypeIssueUpdated, Resource: issueID}
+		live <- &repository.Event{ID: 4, Type: EventTypeNotificationCreated, Resource: notificationID, Recipient: &otherID}
+		live <- &repository.Event{ID: 5, Type: EventTypeIssueUpdated, Resource: hiddenIssueID}
+		live <- &repository.Event{ID: 6, Type: EventTypeNotificationCreated, Resource: notificationID, Recipient: &userID}
+		close(live)
+
+		eventRepo := repository.NewMockEventRepository(ctrl)
+		eventRepo.EXPECT().Subscribe(ctx).Return((<-chan *repository.Event)(live), nil)
+		eventRepo.EXPECT().Since(ctx, int64(1)).Return([]*repository.Event{
+			{ID: 2, Type: EventTypeIssueUpdated, Resource: issueID},
+			{ID: 3, Type: EventTypeIssueUpdated, Resource: issueID},
+		}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, userID, issueID, model.ActionIssueRead).Return(true, nil).Times(2)
+		permSvc.EXPECT().Has(ctx, userID, hiddenIssueID, model.ActionIssueRead).Return(false, nil)
+
+		events, err := newService(ctrl, ctx, eventRepo, permSvc).Subscribe(ctx, 1)
+		require.NoError(t, err)
+
+		ids := make([]int64, 0)
+		for event := range events {
+			ids = append(ids, event.ID)
+		}
+		assert.Equal(t, []int64{2, 3, 6}, ids)
+	})
+
+	t.Run("closes stream when context is done", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), pkg.CtxKeyUserID, userID))
+
+		eventRepo := repository.NewMockEventRepository(ctrl)
+		eventRepo.EXPECT().Subscribe(ctx).Return((<-chan *repository.Event)(make(chan *repository.Event)), nil)
+
+		events, err := newService(ctrl, ctx, eventRepo, NewMockPermissionService(ctrl)).Subscribe(ctx, 0)
+		require.NoError(t, err)
+
+		cancel()
+		select {
+		case _, ok := <-events:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("event stream was not closed")
+		}
+	})
+
+	t.Run("subscribe with invalid event id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		_, err := newService(ctrl, ctx, repository.NewMockEventRepository(ctrl), NewMockPermissionService(ctrl)).Subscribe(ctx, -1)
+		assert.ErrorIs(t, err, ErrEventSubscribe)
+		assert.ErrorIs(t, err, ErrInvalidEventID)
+	})
+
+	t.Run("subscribe without user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		_, err := newService(ctrl, ctx, repository.NewMockEventRepository(ctrl), NewMockPermissionService(ctrl)).Subscribe(ctx, 0)
+		assert.ErrorIs(t, err, ErrEventSubscribe)
+		assert.ErrorIs(t, err, ErrNoUser)
+	})
+
+	t.Run("subscribe with repository error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		eventRepo := repository.NewMockEventRepository(ctrl)
+		eventRepo.EXPECT().Subscribe(ctx).Return(nil, repository.ErrEventSubscribe)
+
+		_, err := newService(ctrl, ctx, eventRepo, NewMockPermissionService(ctrl)).Subscribe(ctx, 0)
+		assert.ErrorIs(t, err, ErrEventSubscribe)
+		assert.ErrorIs(t, err, repository.ErrEventSubscribe)
+	})
+}
+
+func TestBaseService_publishEvent(t *testing.T) {
+	t.Parallel()
+
+	actorID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, actorID)
+
+	t.Run("skips without event repository", func(t *testing.T) {
+		t.Parallel()
+		s := &baseService{logger: mock.NewMockLogger(gomock.NewController(t))}
+		s.publishEvent(ctx, &repository.Event{Type: EventTypeIssueUpdated, Resource: issueID})
+	})
+
+	t.Run("publishes event with actor from context", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		eventRepo := repository.NewMockEventRepository(ctrl)
+		eventRepo.EXPECT().Publish(ctx, &repository.Event{
+			Type:          EventTypeIssueUpdated,
+			Resource:      issueID,
+			Actor:         &actorID,
+			ChangedFields: []string{},
+		}).Return(nil)
+
+		s := &baseService{logger: mock.NewMockLogger(ctrl), eventRepo: eventRepo}
+		s.publishEvent(ctx, &repository.Event{Type: EventTypeIssueUpdated, Resource: issueID})
+	})
+
+	t.Run("logs publish errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		eventRepo := repository.NewMockEventRepository(ctrl)
+		eventRepo.EXPECT().Publish(ctx, gomock.Any()).Return(repository.ErrEventPublish)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to publish event", gomock.Any(), gomock.Any())
+
+		s := &baseService{logger: logger, eventRepo: eventRepo}
+		s.publishEvent(ctx, &repository.Event{Type: EventTypeIssueUpdated, Resource: issueID, ChangedFields: []string{"title"}})
+	})
+}
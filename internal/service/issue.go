@@ -11,16 +11,42 @@ import (
 	"github.com/opcotech/elemo/internal/pkg/log"
 	"github.com/opcotech/elemo/internal/pkg/optional"
 	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/queue"
 	"github.com/opcotech/elemo/internal/repository"
 )
 
-const assignmentSyncPageSize = 1000
+const (
+	assignmentSyncPageSize = 1000
+	issueClonePageSize     = 1000
+
+	issueFieldRelations = "relations" // changed field reported on relation events
+	issueFieldComments  = "comments"  // changed field reported on comment events
+)
 
 type issueListOptionsContextKey struct{}
 
 type IssueListOptions struct {
 	Filter repository.IssueListFilter
 	Sort   repository.IssueListSort
+	Query  string // issue query adding conditions to the filter
+}
+
+// hasCustomFields reports whether the issues are filtered or sorted by custom
+// fields, which are defined per project.
+func (o IssueListOptions) hasCustomFields() bool {
+	_, sorted := o.Sort.Field.CustomFieldKey()
+	return sorted || len(o.Filter.CustomFields) > 0
+}
+
+// applyQuery parses the issue query of the options into the conditions of the
+// filter. The assignee "me" in the query refers to the user.
+func (o *IssueListOptions) applyQuery(userID model.ID) error {
+	conditions, err := parseIssueQuery(o.Query, userID)
+	if err != nil {
+		return err
+	}
+	o.Filter.Conditions = append(o.Filter.Conditions, conditions...)
+	return nil
 }
 
 func WithIssueListOptions(ctx context.Context, opts IssueListOptions) context.Context {
@@ -50,53 +76,62 @@ type PartialAssignee struct {
 
 // PartialIssue represents a simplified issue within a project.
 type PartialIssue struct {
-	ID          model.ID
-	Key         string
-	NumericID   uint
-	Parent      *PartialIssue
-	Kind        model.IssueKind
-	Title       string
-	Description string
-	Status      model.IssueStatus
-	Priority    model.IssuePriority
-	Assignments []PartialAssignee
-	Labels      []PartialLabel
-	Project     *PartialProject
-	Namespace   *PartialNamespace
-	ReportedBy  *PartialUser
-	DueDate     *time.Time
-	StartDate   *time.Time
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	ID             model.ID
+	Key            string
+	NumericID      uint
+	Parent         *PartialIssue
+	Kind           model.IssueKind
+	Title          string
+	Description    string
+	Status         model.IssueStatus
+	WorkflowStatus *string
+	Priority       model.IssuePriority
+	Assignments    []PartialAssignee
+	Labels         []PartialLabel
+	Components     []PartialComponent
+	Project        *PartialProject
+	Namespace      *PartialNamespace
+	ReportedBy     *PartialUser
+	DueDate        *time.Time
+	StartDate      *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
 }
 
 // Issue represents an issue returned by the service.
 type Issue struct {
-	ID              model.ID
-	Key             string
-	NumericID       uint
-	Parent          *PartialIssue
-	Kind            model.IssueKind
-	Title           string
-	Description     string
-	Status          model.IssueStatus
-	Priority        model.IssuePriority
-	Resolution      model.IssueResolution
-	ReportedBy      *PartialUser
-	Assignments     []PartialAssignee
-	Labels          []PartialLabel
-	Project         *PartialProject
-	Namespace       *PartialNamespace
-	CommentCount    *int64
-	DocumentCount   *int64
-	AttachmentCount *int64
-	WatcherCount    *int64
-	RelationCount   *int64
-	Links           []model.IssueLink
-	DueDate         *time.Time
-	StartDate       *time.Time
-	CreatedAt       *time.Time
-	UpdatedAt       *time.Time
+	ID                model.ID
+	Key               string
+	NumericID         uint
+	Parent            *PartialIssue
+	Kind              model.IssueKind
+	Title             string
+	Description       string
+	Status            model.IssueStatus
+	WorkflowStatus    *string
+	Priority          model.IssuePriority
+	Resolution        model.IssueResolution
+	ReportedBy        *PartialUser
+	Assignments       []PartialAssignee
+	Labels            []PartialLabel
+	Components        []PartialComponent
+	Project           *PartialProject
+	Namespace         *PartialNamespace
+	CommentCount      *int64
+	DocumentCount     *int64
+	AttachmentCount   *int64
+	WatcherCount      *int64
+	RelationCount     *int64
+	Links             []model.IssueLink
+	CustomFields      map[string]any
+	StoryPoints       *float64
+	OriginalEstimate  *uint
+	RemainingEstimate *uint
+	DueDate           *time.Time
+	StartDate         *time.Time
+	Rollup            *model.IssueRollup
+	CreatedAt         *time.Time
+	UpdatedAt         *time.Time
 }
 
 const (
@@ -121,16 +156,20 @@ type IssueRelation struct {
 
 // CreateIssueOpts holds the data required to create an issue.
 type CreateIssueOpts struct {
-	Parent      *model.ID             `json:"parent" validate:"omitempty"`
-	Kind        model.IssueKind       `json:"kind" validate:"required,min=1,max=4"`
-	Title       string                `json:"title" validate:"required,min=3,max=120"`
-	Description string                `json:"description" validate:"omitempty,min=3"`
-	Status      model.IssueStatus     `json:"status" validate:"omitempty,min=1,max=6"`
-	Priority    model.IssuePriority   `json:"priority" validate:"omitempty,min=1,max=5"`
-	Resolution  model.IssueResolution `json:"resolution" validate:"omitempty,min=1,max=7"`
-	Links       []model.IssueLink     `json:"links" validate:"omitempty,dive"`
-	DueDate     *time.Time            `json:"due_date" validate:"omitempty"`
-	StartDate   *time.Time            `json:"start_date" validate:"omitempty"`
+	Parent           *model.ID             `json:"parent" validate:"omitempty"`
+	Kind             model.IssueKind       `json:"kind" validate:"required,min=1,max=4"`
+	Title            string                `json:"title" validate:"required,min=3,max=120"`
+	Description      string                `json:"description" validate:"omitempty,min=3"`
+	Status           model.IssueStatus     `json:"status" validate:"omitempty,min=1,max=6"`
+	Priority         model.IssuePriority   `json:"priority" validate:"omitempty,min=1,max=5"`
+	Resolution       model.IssueResolution `json:"resolution" validate:"omitempty,min=1,max=7"`
+	Links            []model.IssueLink     `json:"links" validate:"omitempty,dive"`
+	CustomFields     map[string]any        `json:"custom_fields" validate:"omitempty"`
+	Components       []model.ID            `json:"components" validate:"omitempty"`
+	StoryPoints      *float64              `json:"story_points" validate:"omitempty,gte=0,lte=1000"`
+	OriginalEstimate *uint                 `json:"original_estimate" validate:"omitempty"`
+	DueDate          *time.Time            `json:"due_date" validate:"omitempty"`
+	StartDate        *time.Time            `json:"start_date" validate:"omitempty"`
 }
 
 // Validate validates the create options.
@@ -148,20 +187,59 @@ func (o *CreateIssueOpts) Validate() error {
 
 // UpdateIssueOpts holds the fields that can be updated on an issue.
 // Undefined fields (Defined == false) are left unchanged.
+//
+// If the project of the issue has a workflow, the status is changed by
+// setting the WorkflowStatus, and the Status is derived from its category.
 type UpdateIssueOpts struct {
-	Kind        optional.Optional[model.IssueKind]
-	Title       optional.Optional[string]
-	Description optional.Optional[string]
-	Status      optional.Optional[model.IssueStatus]
-	Priority    optional.Optional[model.IssuePriority]
-	Resolution  optional.Optional[model.IssueResolution]
-	Links       optional.Optional[[]model.IssueLink]
-	DueDate     optional.Optional[time.Time]
-	StartDate   optional.Optional[time.Time]
-	Assignees   optional.Optional[[]model.ID]
-	Reviewers   optional.Optional[[]model.ID]
-	Labels      optional.Optional[[]model.ID]
-	Parent      optional.Optional[model.ID]
+	Kind              optional.Optional[model.IssueKind]
+	Title             optional.Optional[string]
+	Description       optional.Optional[string]
+	Status            optional.Optional[model.IssueStatus]
+	WorkflowStatus    optional.Optional[string]
+	Priority          optional.Optional[model.IssuePriority]
+	Resolution        optional.Optional[model.IssueResolution]
+	Links             optional.Optional[[]model.IssueLink]
+	StoryPoints       optional.Optional[float64]
+	OriginalEstimate  optional.Optional[uint]
+	RemainingEstimate optional.Optional[uint]
+	DueDate           optional.Optional[time.Time]
+	StartDate         optional.Optional[time.Time]
+	Assignees         optional.Optional[[]model.ID]
+	Reviewers         optional.Optional[[]model.ID]
+	Labels            optional.Optional[[]model.ID]
+	Components        optional.Optional[[]model.ID]
+	Parent            optional.Optional[model.ID]
+	CustomFields      map[string]any // values by field key, a nil value clears the field
+}
+
+// RankIssueOpts positions an issue in the backlog of its project, right after
+// the After issue and right before the Before issue. At least one of them must
+// be set.
+type RankIssueOpts struct {
+	Before *model.ID
+	After  *model.ID
+}
+
+// Validate validates the anchors the issue is ranked next to.
+func (o RankIssueOpts) Validate(id model.ID) error {
+	if o.Before == nil && o.After == nil {
+		return ErrIssueRankAnchor
+	}
+	for _, anchor := range []*model.ID{o.Before, o.After} {
+		if anchor == nil {
+			continue
+		}
+		if err := anchor.Validate(); err != nil {
+			return err
+		}
+		if anchor.Type != model.ResourceTypeIssue || *anchor == id {
+			return ErrIssueRankAnchor
+		}
+	}
+	if o.Before != nil && o.After != nil && *o.Before == *o.After {
+		return ErrIssueRankAnchor
+	}
+	return nil
 }
 
 // IssueService serves the business logic of interacting with issues.
@@ -181,22 +259,75 @@ type IssueService interface {
 	// ListByNamespace returns a cursor-paginated page of issues across
 	// projects in a namespace.
 	ListByNamespace(ctx context.Context, namespaceID model.ID, page CursorPage) (Page[*PartialIssue], error)
+	// ListByView returns a cursor-paginated page of the issues of a saved
+	// view, listed with its options for the context user.
+	ListByView(ctx context.Context, viewID model.ID, page CursorPage) (Page[*PartialIssue], error)
 	// ListByUser returns a cursor-paginated page of issues assigned to a user.
 	ListByUser(ctx context.Context, userID model.ID, page CursorPage) (Page[*PartialIssue], error)
 	// Update updates an issue. If the issue does not exist, an error is
 	// returned.
 	Update(ctx context.Context, id model.ID, opts UpdateIssueOpts) (*Issue, error)
+	// Rank moves an issue in the backlog of its project before or after other
+	// issues of the same project.
+	Rank(ctx context.Context, id model.ID, opts RankIssueOpts) (*Issue, error)
+	// Move moves an issue and its sub-issues to another project. The issues
+	// get new keys in the target project, while their previous keys keep
+	// resolving to them.
+	Move(ctx context.Context, id, projectID model.ID) (*Issue, error)
+	// MoveCard moves the card of an issue to a column of a board, changing
+	// the grouped field of the issue and ranking it next to other cards in
+	// one call. The target column reports whether its WIP limit is exceeded.
+	MoveCard(ctx context.Context, id model.ID, opts MoveIssueCardOpts) (*IssueCardMove, error)
+	// CreateFromTemplate creates an issue and its child issues in the project
+	// of an issue template, prefilled from the template.
+	CreateFromTemplate(ctx context.Context, templateID model.ID, opts CreateIssueFromTemplateOpts) (*Issue, error)
+	// MaterializeRecurrences creates the issues of the due occurrences of the
+	// recurring issue templates, at most once per occurrence. The issues
+	// created are returned, the recurrences failing are logged and skipped.
+	MaterializeRecurrences(ctx context.Context, now time.Time) ([]*Issue, error)
+	// Clone creates a copy of an issue in its project, copying its subtasks,
+	// relations, labels and related documents as the options select. If the
+	// copy is created but copying the rest fails, the copy is returned along
+	// with the error.
+	Clone(ctx context.Context, id model.ID, opts CloneIssueOpts) (*Issue, error)
 	// Delete deletes an issue. If the issue does not exist, an error is
 	// returned.
 	Delete(ctx context.Context, id model.ID) error
+	// Bulk applies an update or a delete to many issues at once, checking
+	// the permissions of the context user per issue. The result of every
+	// issue is returned in the order of the IDs.
+	Bulk(ctx context.Context, opts BulkIssueOpts) ([]*BulkIssueResult, error)
 	// ListRelations returns a cursor-paginated page of relations for an issue.
 	ListRelations(ctx context.Context, issueID model.ID, page CursorPage) (Page[*IssueRelation], error)
+	// ListActivity returns a cursor-paginated page of the activity timeline
+	// of an issue, newest first. The timeline merges the field changes with
+	// the relation changes and comment events of the issue.
+	ListActivity(ctx context.Context, issueID model.ID, page CursorPage) (Page[*IssueActivity], error)
 	// AddRelation creates an outgoing relation from issueID to relatedID.
 	AddRelation(ctx context.Context, issueID, relatedID model.ID, kind model.IssueRelationKind) (*IssueRelation, error)
 	// UpdateRelation replaces a relation with a new outgoing edge of the given kind.
 	UpdateRelation(ctx context.Context, issueID, relationID model.ID, kind model.IssueRelationKind) (*IssueRelation, error)
 	// RemoveRelation deletes a relation of an issue by relation ID.
 	RemoveRelation(ctx context.Context, issueID, relationID model.ID) error
+	// GetDependencyGraph returns the issues connected to an issue through
+	// blocking and subtask relations, following at most depth relations, with
+	// the relations between them. The default depth is used if depth is 0.
+	GetDependencyGraph(ctx context.Context, id model.ID, depth int) (*IssueDependencyGraph, error)
+	// GetCriticalPath returns the longest chain of blocking issues of a
+	// project, where every issue takes the time between its start and due
+	// date.
+	GetCriticalPath(ctx context.Context, projectID model.ID) (*CriticalPath, error)
+	// GetRollups returns the progress of the issues aggregated from their
+	// subtasks at any depth, in the order of the IDs. Issues the user cannot
+	// read or that do not exist are left out.
+	GetRollups(ctx context.Context, ids []model.ID) ([]*IssueRollup, error)
+	// GetWatchers returns the users watching an issue.
+	GetWatchers(ctx context.Context, issueID model.ID) ([]*PartialUser, error)
+	// Watch subscribes the current user to an issue.
+	Watch(ctx context.Context, issueID model.ID) error
+	// Unwatch unsubscribes the current user from an issue. The user is not
+	// subscribed again automatically until watching the issue explicitly.
+	Unwatch(ctx context.Context, issueID model.ID) error
 }
 
 // issueService is the concrete implementation of IssueService.
@@ -226,6 +357,22 @@ func partialLabelsFromRepository(labels []repository.PartialLabel) []PartialLabe
 	return out
 }
 
+func partialComponentsFromRepository(components []repository.PartialComponent) []PartialComponent {
+	out := make([]PartialComponent, len(components))
+	for i, component := range components {
+		out[i] = PartialComponent{ID: component.ID, Name: component.Name}
+	}
+	return out
+}
+
+func componentIDsFromPartial(components []repository.PartialComponent) []model.ID {
+	ids := make([]model.ID, len(components))
+	for i, component := range components {
+		ids[i] = component.ID
+	}
+	return ids
+}
+
 func labelIDsFromPartial(labels []repository.PartialLabel) []model.ID {
 	ids := make([]model.ID, len(labels))
 	for i, label := range labels {
@@ -240,24 +387,26 @@ func partialIssueFromRepository(i *repository.PartialIssue) *PartialIssue {
 	}
 
 	return &PartialIssue{
-		ID:          i.ID,
-		Key:         i.Key,
-		NumericID:   i.NumericID,
-		Parent:      partialIssueFromRepository(i.Parent),
-		Kind:        i.Kind,
-		Title:       i.Title,
-		Description: i.Description,
-		Status:      i.Status,
-		Priority:    i.Priority,
-		Assignments: partialAssigneesFromRepository(i.Assignments),
-		Labels:      partialLabelsFromRepository(i.Labels),
-		Project:     partialProjectFromRepository(i.Project),
-		Namespace:   partialNamespaceFromRepository(i.Namespace),
-		ReportedBy:  partialUserFromRepository(i.ReportedBy),
-		DueDate:     i.DueDate,
-		StartDate:   i.StartDate,
-		CreatedAt:   i.CreatedAt,
-		UpdatedAt:   i.UpdatedAt,
+		ID:             i.ID,
+		Key:            i.Key,
+		NumericID:      i.NumericID,
+		Parent:         partialIssueFromRepository(i.Parent),
+		Kind:           i.Kind,
+		Title:          i.Title,
+		Description:    i.Description,
+		Status:         i.Status,
+		WorkflowStatus: i.WorkflowStatus,
+		Priority:       i.Priority,
+		Assignments:    partialAssigneesFromRepository(i.Assignments),
+		Labels:         partialLabelsFromRepository(i.Labels),
+		Components:     partialComponentsFromRepository(i.Components),
+		Project:        partialProjectFromRepository(i.Project),
+		Namespace:      partialNamespaceFromRepository(i.Namespace),
+		ReportedBy:     partialUserFromRepository(i.ReportedBy),
+		DueDate:        i.DueDate,
+		StartDate:      i.StartDate,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
 	}
 }
 
@@ -267,22 +416,24 @@ func partialIssueFromRepoIssue(i *repository.Issue) *PartialIssue {
 	}
 
 	return partialIssueFromRepository(&repository.PartialIssue{
-		ID:          i.ID,
-		Key:         i.Key,
-		NumericID:   i.NumericID,
-		Parent:      i.Parent,
-		Kind:        i.Kind,
-		Title:       i.Title,
-		Description: i.Description,
-		Status:      i.Status,
-		Priority:    i.Priority,
-		Assignments: i.Assignments,
-		Labels:      i.Labels,
-		Project:     i.Project,
-		Namespace:   i.Namespace,
-		ReportedBy:  i.ReportedBy,
-		DueDate:     i.DueDate,
-		StartDate:   i.StartDate,
+		ID:             i.ID,
+		Key:            i.Key,
+		NumericID:      i.NumericID,
+		Parent:         i.Parent,
+		Kind:           i.Kind,
+		Title:          i.Title,
+		Description:    i.Description,
+		Status:         i.Status,
+		WorkflowStatus: i.WorkflowStatus,
+		Priority:       i.Priority,
+		Assignments:    i.Assignments,
+		Labels:         i.Labels,
+		Components:     i.Components,
+		Project:        i.Project,
+		Namespace:      i.Namespace,
+		ReportedBy:     i.ReportedBy,
+		DueDate:        i.DueDate,
+		StartDate:      i.StartDate,
 	})
 }
 
@@ -331,31 +482,38 @@ func issueFromRepository(i *repository.Issue) *Issue {
 	}
 
 	return &Issue{
-		ID:              i.ID,
-		Key:             i.Key,
-		NumericID:       i.NumericID,
-		Parent:          partialIssueFromRepository(i.Parent),
-		Kind:            i.Kind,
-		Title:           i.Title,
-		Description:     i.Description,
-		Status:          i.Status,
-		Priority:        i.Priority,
-		Resolution:      i.Resolution,
-		ReportedBy:      partialUserFromRepository(i.ReportedBy),
-		Assignments:     partialAssigneesFromRepository(i.Assignments),
-		Labels:          partialLabelsFromRepository(i.Labels),
-		Project:         partialProjectFromRepository(i.Project),
-		Namespace:       partialNamespaceFromRepository(i.Namespace),
-		CommentCount:    i.CommentCount,
-		DocumentCount:   i.DocumentCount,
-		AttachmentCount: i.AttachmentCount,
-		WatcherCount:    i.WatcherCount,
-		RelationCount:   i.RelationCount,
-		Links:           i.Links,
-		DueDate:         i.DueDate,
-		StartDate:       i.StartDate,
-		CreatedAt:       i.CreatedAt,
-		UpdatedAt:       i.UpdatedAt,
+		ID:                i.ID,
+		Key:               i.Key,
+		NumericID:         i.NumericID,
+		Parent:            partialIssueFromRepository(i.Parent),
+		Kind:              i.Kind,
+		Title:             i.Title,
+		Description:       i.Description,
+		Status:            i.Status,
+		WorkflowStatus:    i.WorkflowStatus,
+		Priority:          i.Priority,
+		Resolution:        i.Resolution,
+		ReportedBy:        partialUserFromRepository(i.ReportedBy),
+		Assignments:       partialAssigneesFromRepository(i.Assignments),
+		Labels:            partialLabelsFromRepository(i.Labels),
+		Components:        partialComponentsFromRepository(i.Components),
+		Project:           partialProjectFromRepository(i.Project),
+		Namespace:         partialNamespaceFromRepository(i.Namespace),
+		CommentCount:      i.CommentCount,
+		DocumentCount:     i.DocumentCount,
+		AttachmentCount:   i.AttachmentCount,
+		WatcherCount:      i.WatcherCount,
+		RelationCount:     i.RelationCount,
+		Links:             i.Links,
+		CustomFields:      i.CustomFields,
+		StoryPoints:       i.StoryPoints,
+		OriginalEstimate:  i.OriginalEstimate,
+		RemainingEstimate: i.RemainingEstimate,
+		DueDate:           i.DueDate,
+		StartDate:         i.StartDate,
+		Rollup:            i.Rollup,
+		CreatedAt:         i.CreatedAt,
+		UpdatedAt:         i.UpdatedAt,
 	}
 }
 
@@ -385,7 +543,9 @@ func optionalIDs(opt optional.Optional[[]model.ID]) ([]model.ID, error) {
 	return ids, nil
 }
 
-func (s *issueService) syncAssignments(ctx context.Context, issueID model.ID, kind model.AssignmentKind, userIDs []model.ID) error {
+// syncAssignments reconciles the assignments of the given kind with userIDs and
+// returns the users who were newly assigned.
+func (s *issueService) syncAssignments(ctx context.Context, issueID model.ID, kind model.AssignmentKind, userIDs []model.ID) ([]model.ID, error) {
 	page := repository.CursorPage{Size: assignmentSyncPageSize}
 	existing := make([]*repository.Assignment, 0)
 	for {
@@ -396,7 +556,7 @@ func (s *issueService) syncAssignments(ctx context.Context, issueID model.ID, ki
 			repository.AssignmentListProjection(),
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		existing = append(existing, assignments.Items...)
@@ -425,10 +585,11 @@ func (s *issueService) syncAssignments(ctx context.Context, issueID model.ID, ki
 			continue
 		}
 		if err := s.assignmentRepo.Delete(ctx, assignment.ID); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	added := make([]model.ID, 0, len(desired))
 	for userID, id := range desired {
 		if _, ok := current[userID]; ok {
 			continue
@@ -438,11 +599,36 @@ func (s *issueService) syncAssignments(ctx context.Context, issueID model.ID, ki
 			User:     id,
 			Resource: issueID,
 		}); err != nil {
-			return err
+			return nil, err
 		}
+		added = append(added, id)
 	}
 
-	return nil
+	return added, nil
+}
+
+// issueMentionSubject returns how the issue is referred to in the title of
+// mention notifications.
+func issueMentionSubject(issue *Issue) string {
+	if issue.Key != "" {
+		return issue.Key
+	}
+	return "an issue"
+}
+
+// autoWatchIssue subscribes the users to the issue unless they opted out of
+// watching it. Watching is a side effect of the calling operation, therefore
+// failures are logged only.
+func (s *baseService) autoWatchIssue(ctx context.Context, issueID model.ID, users []model.ID) {
+	if len(users) == 0 {
+		return
+	}
+	if err := s.issueRepo.AutoWatch(ctx, issueID, users); err != nil {
+		s.logger.Warn(ctx, "failed to auto-watch issue",
+			log.WithError(err),
+			log.WithValue(issueID.Composite()),
+		)
+	}
 }
 
 func (s *issueService) syncLabels(ctx context.Context, issueID model.ID, current, desired []model.ID) error {
@@ -477,6 +663,46 @@ func (s *issueService) syncLabels(ctx context.Context, issueID model.ID, current
 	return nil
 }
 
+func (s *issueService) syncComponents(ctx context.Context, issueID model.ID, current []model.ID, desired []*repository.Component) error {
+	desiredSet := make(map[model.ID]struct{}, len(desired))
+	for _, component := range desired {
+		desiredSet[component.ID] = struct{}{}
+	}
+
+	currentSet := make(map[model.ID]struct{}, len(current))
+	for _, id := range current {
+		currentSet[id] = struct{}{}
+		if _, ok := desiredSet[id]; ok {
+			continue
+		}
+		if err := s.componentRepo.DetachFrom(ctx, id, issueID); err != nil {
+			return err
+		}
+	}
+
+	for _, component := range desired {
+		if _, ok := currentSet[component.ID]; ok {
+			continue
+		}
+		if err := s.componentRepo.AttachTo(ctx, component.ID, issueID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// componentLead returns the lead of the first component having one. New
+// issues of the components are assigned to the lead.
+func componentLead(components []*repository.Component) *model.ID {
+	for _, component := range components {
+		if component.Lead != nil {
+			return component.Lead
+		}
+	}
+	return nil
+}
+
 func (s *issueService) validateParentUpdate(ctx context.Context, issueID model.ID, parent optional.Optional[model.ID]) error {
 	if !parent.Defined || parent.Value == nil {
 		return nil
@@ -492,6 +718,13 @@ func (s *issueService) validateParentUpdate(ctx context.Context, issueID model.I
 	if !s.permissionService.CtxUserHas(ctx, parentID, model.ActionIssueRead) {
 		return ErrNoPermission
 	}
+	if !issueID.IsNil() {
+		return s.validateRelationCycle(ctx, repository.CreateIssueRelationOpts{
+			Source: issueID,
+			Target: parentID,
+			Kind:   model.IssueRelationKindSubtaskOf,
+		}, nil)
+	}
 
 	return nil
 }
@@ -530,6 +763,75 @@ func (s *issueService) syncParent(ctx context.Context, issueID model.ID, current
 	return nil
 }
 
+// resolveWorkflowStatus validates the status change against the workflow of
+// the project of the issue, and derives the built-in status from the category
+// of the new workflow status. Without a workflow, only the built-in status can
+// be changed, which clears the workflow status left by a removed workflow.
+func (s *issueService) resolveWorkflowStatus(ctx context.Context, previous *repository.Issue, opts *UpdateIssueOpts) error {
+	if !opts.Status.Defined && !opts.WorkflowStatus.Defined {
+		return nil
+	}
+
+	var workflow *model.Workflow
+	if s.workflowRepo != nil && previous != nil && previous.Project != nil {
+		var err error
+		if workflow, err = s.projectWorkflow(ctx, previous.Project.ID); err != nil {
+			return err
+		}
+	}
+
+	if workflow == nil {
+		if opts.WorkflowStatus.Defined && opts.WorkflowStatus.Value != nil {
+			return ErrIssueWorkflowStatus
+		}
+		if previous != nil && previous.WorkflowStatus != nil {
+			opts.WorkflowStatus = optional.Null[string]()
+		}
+		return nil
+	}
+
+	if !opts.WorkflowStatus.Defined || opts.WorkflowStatus.Value == nil {
+		return ErrIssueWorkflowStatus
+	}
+
+	target, ok := workflow.Status(*opts.WorkflowStatus.Value)
+	if !ok {
+		return ErrIssueWorkflowStatus
+	}
+
+	var current string
+	if previous.WorkflowStatus != nil {
+		current = *previous.WorkflowStatus
+	}
+	if !workflow.CanTransition(current, target.Key) {
+		return ErrIssueWorkflowTransition
+	}
+
+	status := target.Category.IssueStatus()
+	if opts.Status.Defined && (opts.Status.Value == nil || *opts.Status.Value != status) {
+		return ErrIssueWorkflowStatus
+	}
+	opts.Status = optional.Some(status)
+
+	return nil
+}
+
+// applyIssueCustomFields keeps the values of the custom fields defined for
+// the project of the issue only.
+func (s *issueService) applyIssueCustomFields(ctx context.Context, issue *Issue) error {
+	if len(issue.CustomFields) == 0 || issue.Project == nil {
+		return nil
+	}
+
+	fields, err := s.projectCustomFields(ctx, issue.Project.ID)
+	if err != nil {
+		return err
+	}
+
+	issue.CustomFields = fields.Known(issue.CustomFields)
+	return nil
+}
+
 func (s *issueService) Create(ctx context.Context, projectID model.ID, opts CreateIssueOpts) (*Issue, error) {
 	ctx, span := s.tracer.Start(ctx, "service.issueService/Create")
 	defer span.End()
@@ -568,6 +870,19 @@ func (s *issueService) Create(ctx context.Context, projectID model.ID, opts Crea
 		status = model.IssueStatusOpen
 	}
 
+	// New issues of a project having a workflow start in its initial status.
+	workflow, err := s.projectWorkflow(ctx, projectID)
+	if err != nil {
+		return nil, errors.Join(ErrIssueCreate, err)
+	}
+
+	var workflowStatus *string
+	if workflow != nil {
+		initial := workflow.InitialStatus()
+		workflowStatus = &initial.Key
+		status = initial.Category.IssueStatus()
+	}
+
 	priority := opts.Priority
 	if priority == 0 {
 		priority = model.IssuePriorityNormal
@@ -583,19 +898,33 @@ func (s *issueService) Create(ctx context.Context, projectID model.ID, opts Crea
 		links = make([]model.IssueLink, 0)
 	}
 
+	customFields, err := s.issueCustomFieldValues(ctx, projectID, opts.CustomFields, true)
+	if err != nil {
+		return nil, errors.Join(ErrIssueCreate, err)
+	}
+
+	components, err := s.issueComponents(ctx, projectID, opts.Components)
+	if err != nil {
+		return nil, errors.Join(ErrIssueCreate, err)
+	}
+
 	issue, err := s.issueRepo.Create(ctx, repository.CreateIssueOpts{
-		ProjectID:   projectID,
-		Parent:      opts.Parent,
-		Kind:        opts.Kind,
-		Title:       opts.Title,
-		Description: opts.Description,
-		Status:      status,
-		Priority:    priority,
-		Resolution:  resolution,
-		ReportedBy:  userID,
-		Links:       links,
-		DueDate:     opts.DueDate,
-		StartDate:   opts.StartDate,
+		ProjectID:        projectID,
+		Parent:           opts.Parent,
+		Kind:             opts.Kind,
+		Title:            opts.Title,
+		Description:      opts.Description,
+		Status:           status,
+		WorkflowStatus:   workflowStatus,
+		Priority:         priority,
+		Resolution:       resolution,
+		ReportedBy:       userID,
+		Links:            links,
+		CustomFields:     customFields,
+		StoryPoints:      opts.StoryPoints,
+		OriginalEstimate: opts.OriginalEstimate,
+		DueDate:          opts.DueDate,
+		StartDate:        opts.StartDate,
 	})
 	if err != nil {
 		return nil, errors.Join(ErrIssueCreate, err)
@@ -609,8 +938,35 @@ func (s *issueService) Create(ctx context.Context, projectID model.ID, opts Crea
 		return nil, errors.Join(ErrIssueCreate, err)
 	}
 
+	if len(components) > 0 {
+		if err := s.syncComponents(ctx, issue.ID, nil, components); err != nil {
+			return nil, errors.Join(ErrIssueCreate, err)
+		}
+
+		// New issues have no assignees, so the lead of the component is
+		// assigned by default.
+		if lead := componentLead(components); lead != nil {
+			if _, err := s.assignmentRepo.Create(ctx, repository.CreateAssignmentOpts{
+				Kind:     model.AssignmentKindAssignee,
+				User:     *lead,
+				Resource: issue.ID,
+			}); err != nil {
+				return nil, errors.Join(ErrIssueCreate, err)
+			}
+			s.autoWatchIssue(ctx, issue.ID, []model.ID{*lead})
+		}
+
+		if issue, err = s.issueRepo.Get(ctx, issue.ID, repository.IssueDetailProjection()); err != nil {
+			return nil, errors.Join(ErrIssueCreate, err)
+		}
+	}
+
 	out := issueFromRepository(issue)
+	if err := s.applyIssueCustomFields(ctx, out); err != nil {
+		return nil, errors.Join(ErrIssueCreate, err)
+	}
 	s.enqueueSearchIndex(ctx, out.ID)
+	s.notifyMentions(ctx, out.ID, out.ID, issueMentionSubject(out), out.Title, out.Description)
 	return out, nil
 }
 
@@ -631,7 +987,12 @@ func (s *issueService) Get(ctx context.Context, id model.ID) (*Issue, error) {
 		return nil, errors.Join(ErrIssueGet, err)
 	}
 
-	return issueFromRepository(issue), nil
+	out := issueFromRepository(issue)
+	if err := s.applyIssueCustomFields(ctx, out); err != nil {
+		return nil, errors.Join(ErrIssueGet, err)
+	}
+
+	return out, nil
 }
 
 func (s *issueService) GetByKey(ctx context.Context, namespaceID model.ID, key string) (*Issue, error) {
@@ -655,7 +1016,12 @@ func (s *issueService) GetByKey(ctx context.Context, namespaceID model.ID, key s
 		return nil, errors.Join(ErrIssueGet, ErrNoPermission)
 	}
 
-	return issueFromRepository(issue), nil
+	out := issueFromRepository(issue)
+	if err := s.applyIssueCustomFields(ctx, out); err != nil {
+		return nil, errors.Join(ErrIssueGet, err)
+	}
+
+	return out, nil
 }
 
 func (s *issueService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*PartialIssue], error) {
@@ -683,17 +1049,37 @@ func (s *issueService) List(ctx context.Context, projectID model.ID, page Cursor
 		return repository.EmptyPage[*PartialIssue](), nil
 	}
 	listOpts := issueListOptionsFromContext(ctx)
+	if err := listOpts.applyQuery(userID); err != nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+	}
+	if err := s.issueListCustomFields(ctx, projectID, &listOpts); err != nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+	}
+
+	// The issues of a project having a workflow are sorted by the order of
+	// the workflow statuses.
+	var statusOrder []string
+	if listOpts.Sort.Field == repository.IssueListSortFieldStatus {
+		workflow, err := s.projectWorkflow(ctx, projectID)
+		if err != nil {
+			return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+		}
+		if workflow != nil {
+			statusOrder = workflow.StatusKeys()
+		}
+	}
 
 	issues, err := s.issueRepo.ListForProject(ctx, repository.IssueListQuery{
-		ProjectID:  projectID,
-		ActorID:    userID,
-		Action:     model.ActionIssueRead,
-		ScopeIDs:   scopeIDs,
-		SortField:  listOpts.Sort.Field,
-		Page:       normalized,
-		Order:      listOpts.Sort.Direction,
-		Filter:     listOpts.Filter,
-		Projection: repository.IssueListForProjectProjection(),
+		ProjectID:   projectID,
+		ActorID:     userID,
+		Action:      model.ActionIssueRead,
+		ScopeIDs:    scopeIDs,
+		SortField:   listOpts.Sort.Field,
+		Page:        normalized,
+		Order:       listOpts.Sort.Direction,
+		Filter:      listOpts.Filter,
+		Projection:  repository.IssueListForProjectProjection(),
+		StatusOrder: statusOrder,
 	})
 	if err != nil {
 		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
@@ -727,6 +1113,12 @@ func (s *issueService) ListByNamespace(ctx context.Context, namespaceID model.ID
 		return repository.EmptyPage[*PartialIssue](), nil
 	}
 	listOpts := issueListOptionsFromContext(ctx)
+	if listOpts.hasCustomFields() {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, ErrIssueCustomFieldList)
+	}
+	if err := listOpts.applyQuery(userID); err != nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+	}
 
 	issues, err := s.issueRepo.ListForNamespace(ctx, repository.IssueListForNamespaceQuery{
 		NamespaceID: namespaceID,
@@ -746,6 +1138,39 @@ func (s *issueService) ListByNamespace(ctx context.Context, namespaceID model.ID
 	return mapPage(issues, partialIssueFromRepository), nil
 }
 
+func (s *issueService) ListByView(ctx context.Context, viewID model.ID, page CursorPage) (Page[*PartialIssue], error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/ListByView")
+	defer span.End()
+
+	if s.viewRepo == nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, ErrNoViewRepository)
+	}
+
+	if err := viewID.Validate(); err != nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+	}
+	if viewID.Type != model.ResourceTypeView {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, model.ErrInvalidID)
+	}
+
+	view, err := s.viewRepo.Get(ctx, viewID, repository.ViewDetailProjection())
+	if err != nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, view.ID, model.ActionViewRead) {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, ErrNoPermission)
+	}
+
+	// The issues are listed for the user running the view, who must be able
+	// to read them, regardless of who owns the view.
+	ctx = WithIssueListOptions(ctx, viewFromRepository(view, nil).Options)
+	if view.Scope.Type == model.ResourceTypeNamespace {
+		return s.ListByNamespace(ctx, view.Scope, page)
+	}
+	return s.List(ctx, view.Scope, page)
+}
+
 func (s *issueService) ListByUser(ctx context.Context, userID model.ID, page CursorPage) (Page[*PartialIssue], error) {
 	ctx, span := s.tracer.Start(ctx, "service.issueService/ListByUser")
 	defer span.End()
@@ -775,6 +1200,12 @@ func (s *issueService) ListByUser(ctx context.Context, userID model.ID, page Cur
 		return repository.EmptyPage[*PartialIssue](), nil
 	}
 	listOpts := issueListOptionsFromContext(ctx)
+	if listOpts.hasCustomFields() {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, ErrIssueCustomFieldList)
+	}
+	if err := listOpts.applyQuery(ctxUserID); err != nil {
+		return Page[*PartialIssue]{}, errors.Join(ErrIssueGetAll, err)
+	}
 
 	issues, err := s.issueRepo.ListForUser(ctx, repository.IssueListForUserQuery{
 		UserID:     userID,
@@ -802,90 +1233,249 @@ func (s *issueService) Update(ctx context.Context, id model.ID, opts UpdateIssue
 		return nil, errors.Join(ErrIssueUpdate, license.ErrLicenseExpired)
 	}
 
-	if err := id.Validate(); err != nil {
+	update, err := s.prepareUpdate(ctx, id, opts)
+	if err != nil {
 		return nil, errors.Join(ErrIssueUpdate, err)
 	}
 
-	if !s.permissionService.CtxUserHas(ctx, id, model.ActionIssueUpdate) {
-		return nil, errors.Join(ErrIssueUpdate, ErrNoPermission)
+	issue, err := s.issueRepo.Update(ctx, id, update.patch, repository.IssueDetailProjection())
+	if err != nil {
+		return nil, errors.Join(ErrIssueUpdate, err)
 	}
 
-	assignees, err := optionalIDs(opts.Assignees)
+	out, err := s.completeUpdate(ctx, update, issue)
 	if err != nil {
 		return nil, errors.Join(ErrIssueUpdate, err)
 	}
-	if opts.Assignees.Defined && len(assignees) > 1 {
+
+	s.enqueueSearchIndex(ctx, out.ID)
+	return out, nil
+}
+
+// issueUpdate is a validated issue update, holding everything resolved before
+// the issue is written and needed after it is.
+type issueUpdate struct {
+	id         model.ID
+	opts       UpdateIssueOpts
+	patch      repository.UpdateIssueOpts
+	previous   *repository.Issue
+	assignees  []model.ID
+	reviewers  []model.ID
+	labels     []model.ID
+	components []*repository.Component
+}
+
+// prepareUpdate validates the update of an issue, checks the permissions of
+// the context user and resolves the issue properties to write.
+func (s *issueService) prepareUpdate(ctx context.Context, id model.ID, opts UpdateIssueOpts) (*issueUpdate, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.StoryPoints.Defined && opts.StoryPoints.Value != nil {
+		if err := validate.Var(*opts.StoryPoints.Value, "gte=0,lte=1000"); err != nil {
+			return nil, errors.Join(model.ErrInvalidIssueDetails, err)
+		}
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, id, model.ActionIssueUpdate) {
+		return nil, ErrNoPermission
+	}
+
+	update := &issueUpdate{id: id}
+
+	var err error
+	if update.assignees, err = optionalIDs(opts.Assignees); err != nil {
+		return nil, err
+	}
+	if opts.Assignees.Defined && len(update.assignees) > 1 {
 		ok, err := s.licenseService.HasFeature(ctx, license.FeatureMultipleAssignees)
 		if err != nil {
-			return nil, errors.Join(ErrIssueUpdate, err)
+			return nil, err
 		}
 		if !ok {
-			return nil, errors.Join(ErrIssueUpdate, ErrQuotaExceeded)
+			return nil, ErrQuotaExceeded
 		}
 	}
 
-	reviewers, err := optionalIDs(opts.Reviewers)
-	if err != nil {
-		return nil, errors.Join(ErrIssueUpdate, err)
+	if update.reviewers, err = optionalIDs(opts.Reviewers); err != nil {
+		return nil, err
 	}
 
-	labels, err := optionalIDs(opts.Labels)
-	if err != nil {
-		return nil, errors.Join(ErrIssueUpdate, err)
+	if update.labels, err = optionalIDs(opts.Labels); err != nil {
+		return nil, err
 	}
 
 	if err := s.validateParentUpdate(ctx, id, opts.Parent); err != nil {
-		return nil, errors.Join(ErrIssueUpdate, err)
+		return nil, err
 	}
 
-	issue, err := s.issueRepo.Update(ctx, id, repository.UpdateIssueOpts{
-		Kind:        opts.Kind,
-		Title:       opts.Title,
-		Description: opts.Description,
-		Status:      opts.Status,
-		Priority:    opts.Priority,
-		Resolution:  opts.Resolution,
-		Links:       opts.Links,
-		DueDate:     opts.DueDate,
-		StartDate:   opts.StartDate,
-	}, repository.IssueDetailProjection())
-	if err != nil {
-		return nil, errors.Join(ErrIssueUpdate, err)
+	// The issue before the update is needed to tell the changed fields and
+	// to enforce the workflow transitions.
+	if update.previous, err = s.issueRepo.Get(ctx, id, repository.IssueDetailProjection()); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveWorkflowStatus(ctx, update.previous, &opts); err != nil {
+		return nil, err
+	}
+
+	var customFields map[string]any
+	if len(opts.CustomFields) > 0 {
+		if update.previous.Project == nil {
+			return nil, model.ErrInvalidCustomFieldValue
+		}
+		if customFields, err = s.issueCustomFieldValues(ctx, update.previous.Project.ID, opts.CustomFields, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Components.Defined {
+		if s.componentRepo == nil {
+			return nil, ErrNoComponentRepository
+		}
+		if update.previous.Project == nil {
+			return nil, ErrIssueComponent
+		}
+		if opts.Components.Value != nil {
+			if update.components, err = s.issueComponents(ctx, update.previous.Project.ID, *opts.Components.Value); err != nil {
+				return nil, err
+			}
+		}
 	}
 
+	update.opts = opts
+	update.patch = repository.UpdateIssueOpts{
+		Kind:              opts.Kind,
+		Title:             opts.Title,
+		Description:       opts.Description,
+		Status:            opts.Status,
+		WorkflowStatus:    opts.WorkflowStatus,
+		Priority:          opts.Priority,
+		Resolution:        opts.Resolution,
+		Links:             opts.Links,
+		StoryPoints:       opts.StoryPoints,
+		OriginalEstimate:  opts.OriginalEstimate,
+		RemainingEstimate: opts.RemainingEstimate,
+		DueDate:           opts.DueDate,
+		StartDate:         opts.StartDate,
+		CustomFields:      customFields,
+	}
+
+	return update, nil
+}
+
+// completeUpdate syncs the relations of an updated issue and records the
+// update. Search indexing is left to the caller, so batches are indexed once.
+func (s *issueService) completeUpdate(ctx context.Context, update *issueUpdate, issue *repository.Issue) (*Issue, error) {
+	id, opts := update.id, update.opts
+
 	if opts.Assignees.Defined {
-		if err := s.syncAssignments(ctx, id, model.AssignmentKindAssignee, assignees); err != nil {
-			return nil, errors.Join(ErrIssueUpdate, err)
+		added, err := s.syncAssignments(ctx, id, model.AssignmentKindAssignee, update.assignees)
+		if err != nil {
+			return nil, err
 		}
+		s.autoWatchIssue(ctx, id, added)
 	}
 
 	if opts.Reviewers.Defined {
-		if err := s.syncAssignments(ctx, id, model.AssignmentKindReviewer, reviewers); err != nil {
-			return nil, errors.Join(ErrIssueUpdate, err)
+		added, err := s.syncAssignments(ctx, id, model.AssignmentKindReviewer, update.reviewers)
+		if err != nil {
+			return nil, err
 		}
+		s.autoWatchIssue(ctx, id, added)
 	}
 
 	if opts.Labels.Defined {
-		if err := s.syncLabels(ctx, id, labelIDsFromPartial(issue.Labels), labels); err != nil {
-			return nil, errors.Join(ErrIssueUpdate, err)
+		if err := s.syncLabels(ctx, id, labelIDsFromPartial(issue.Labels), update.labels); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Components.Defined {
+		if err := s.syncComponents(ctx, id, componentIDsFromPartial(issue.Components), update.components); err != nil {
+			return nil, err
 		}
 	}
 
 	if opts.Parent.Defined {
 		if err := s.syncParent(ctx, id, issue.Parent, opts.Parent); err != nil {
-			return nil, errors.Join(ErrIssueUpdate, err)
+			return nil, err
 		}
 	}
 
-	if opts.Assignees.Defined || opts.Reviewers.Defined || opts.Labels.Defined || opts.Parent.Defined {
-		issue, err = s.issueRepo.Get(ctx, id, repository.IssueDetailProjection())
-		if err != nil {
-			return nil, errors.Join(ErrIssueUpdate, err)
+	if opts.Assignees.Defined || opts.Reviewers.Defined || opts.Labels.Defined || opts.Components.Defined || opts.Parent.Defined {
+		var err error
+		if issue, err = s.issueRepo.Get(ctx, id, repository.IssueDetailProjection()); err != nil {
+			return nil, err
 		}
 	}
 
+	changes := issueFieldChanges(update.previous, issue, opts)
+	s.recordIssueActivity(ctx, changes...)
+
 	out := issueFromRepository(issue)
-	s.enqueueSearchIndex(ctx, out.ID)
+	if err := s.applyIssueCustomFields(ctx, out); err != nil {
+		return nil, err
+	}
+
+	// Only the fields whose values changed are notified, so resending the
+	// current values of an issue does not notify anyone.
+	if changed := changedIssueFields(changes); len(changed) > 0 {
+		s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueUpdated, out.ID, changed)
+		s.publishEvent(ctx, &repository.Event{
+			Type:          EventTypeIssueUpdated,
+			Resource:      out.ID,
+			ChangedFields: changed,
+		})
+	}
+	if opts.Description.Defined {
+		s.notifyMentions(ctx, out.ID, out.ID, issueMentionSubject(out), out.Title, out.Description)
+	}
+	return out, nil
+}
+
+func (s *issueService) Rank(ctx context.Context, id model.ID, opts RankIssueOpts) (*Issue, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/Rank")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueRank, license.ErrLicenseExpired)
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueRank, err)
+	}
+	if err := opts.Validate(id); err != nil {
+		return nil, errors.Join(ErrIssueRank, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, id, model.ActionIssueUpdate) {
+		return nil, errors.Join(ErrIssueRank, ErrNoPermission)
+	}
+	for _, anchor := range []*model.ID{opts.Before, opts.After} {
+		if anchor != nil && !s.permissionService.CtxUserHas(ctx, *anchor, model.ActionIssueRead) {
+			return nil, errors.Join(ErrIssueRank, ErrNoPermission)
+		}
+	}
+
+	if err := s.issueRepo.Rank(ctx, id, repository.RankIssueOpts{
+		Before: opts.Before,
+		After:  opts.After,
+	}); err != nil {
+		return nil, errors.Join(ErrIssueRank, err)
+	}
+
+	issue, err := s.issueRepo.Get(ctx, id, repository.IssueDetailProjection())
+	if err != nil {
+		return nil, errors.Join(ErrIssueRank, err)
+	}
+
+	out := issueFromRepository(issue)
+	if err := s.applyIssueCustomFields(ctx, out); err != nil {
+		return nil, errors.Join(ErrIssueRank, err)
+	}
+
 	return out, nil
 }
 
@@ -905,10 +1495,15 @@ func (s *issueService) Delete(ctx context.Context, id model.ID) error {
 		return errors.Join(ErrIssueDelete, ErrNoPermission)
 	}
 
+	scopeChanges := s.sprintRemovals(ctx, id)
+
 	if err := s.issueRepo.Delete(ctx, id); err != nil {
 		return errors.Join(ErrIssueDelete, err)
 	}
 
+	s.recordScopeChanges(ctx, scopeChanges...)
+	s.deleteIssueRecords(ctx, id)
+
 	if err := s.searchService.Delete(ctx, id); err != nil {
 		s.logger.Warn(ctx, "failed to delete search document",
 			log.WithError(err),
@@ -918,6 +1513,40 @@ func (s *issueService) Delete(ctx context.Context, id model.ID) error {
 	return nil
 }
 
+// deleteIssueRecords deletes the work logs, activities and mentions of the
+// deleted issues, so they do not count towards timesheets anymore. Failing
+// to delete them does not fail the request. The sprint scope changes are
+// kept as the history of the sprints, and the recurrence occurrences are
+// kept so they are never claimed again.
+func (s *baseService) deleteIssueRecords(ctx context.Context, ids ...model.ID) {
+	if s.workLogRepo != nil {
+		if err := s.workLogRepo.DeleteByIssues(ctx, ids); err != nil {
+			s.logger.Warn(ctx, "failed to delete work logs of issues",
+				log.WithError(err),
+				log.WithLimit(len(ids)),
+			)
+		}
+	}
+
+	if s.issueActivityRepo != nil {
+		if err := s.issueActivityRepo.DeleteByIssues(ctx, ids); err != nil {
+			s.logger.Warn(ctx, "failed to delete activities of issues",
+				log.WithError(err),
+				log.WithLimit(len(ids)),
+			)
+		}
+	}
+
+	if s.mentionRepo != nil {
+		if err := s.mentionRepo.DeleteBySources(ctx, ids); err != nil {
+			s.logger.Warn(ctx, "failed to delete mentions of issues",
+				log.WithError(err),
+				log.WithLimit(len(ids)),
+			)
+		}
+	}
+}
+
 func (s *issueService) ListRelations(ctx context.Context, issueID model.ID, page CursorPage) (Page[*IssueRelation], error) {
 	ctx, span := s.tracer.Start(ctx, "service.issueService/ListRelations")
 	defer span.End()
@@ -976,11 +1605,16 @@ func (s *issueService) AddRelation(ctx context.Context, issueID, relatedID model
 		return nil, errors.Join(ErrIssueAddRelation, ErrNoPermission)
 	}
 
-	created, err := s.issueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{
+	opts := repository.CreateIssueRelationOpts{
 		Source: issueID,
 		Target: relatedID,
 		Kind:   kind,
-	})
+	}
+	if err := s.validateRelationCycle(ctx, opts, nil); err != nil {
+		return nil, errors.Join(ErrIssueAddRelation, err)
+	}
+
+	created, err := s.issueRepo.AddRelation(ctx, opts)
 	if err != nil {
 		return nil, errors.Join(ErrIssueAddRelation, err)
 	}
@@ -990,6 +1624,9 @@ func (s *issueService) AddRelation(ctx context.Context, issueID, relatedID model
 		return nil, errors.Join(ErrIssueAddRelation, err)
 	}
 
+	s.recordIssueActivity(ctx, relationActivities(IssueActivityKindRelationAdded, issueID, relatedID, nil, []string{created.Kind.String()})...)
+	s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueRelation, issueID, []string{issueFieldRelations})
+
 	return &IssueRelation{
 		ID:        created.ID,
 		Kind:      created.Kind,
@@ -1035,15 +1672,20 @@ func (s *issueService) UpdateRelation(ctx context.Context, issueID, relationID m
 		return nil, errors.Join(ErrIssueUpdateRelation, ErrNoPermission)
 	}
 
+	opts := repository.CreateIssueRelationOpts{
+		Source: issueID,
+		Target: relatedID,
+		Kind:   kind,
+	}
+	if err := s.validateRelationCycle(ctx, opts, &relationID); err != nil {
+		return nil, errors.Join(ErrIssueUpdateRelation, err)
+	}
+
 	if err := s.issueRepo.RemoveRelationByID(ctx, relationID); err != nil {
 		return nil, errors.Join(ErrIssueUpdateRelation, err)
 	}
 
-	created, err := s.issueRepo.AddRelation(ctx, repository.CreateIssueRelationOpts{
-		Source: issueID,
-		Target: relatedID,
-		Kind:   kind,
-	})
+	created, err := s.issueRepo.AddRelation(ctx, opts)
 	if err != nil {
 		return nil, errors.Join(ErrIssueUpdateRelation, err)
 	}
@@ -1053,6 +1695,11 @@ func (s *issueService) UpdateRelation(ctx context.Context, issueID, relationID m
 		return nil, errors.Join(ErrIssueUpdateRelation, err)
 	}
 
+	if existing.Kind != created.Kind {
+		s.recordIssueActivity(ctx, relationActivities(IssueActivityKindRelationUpdated, issueID, relatedID, []string{existing.Kind.String()}, []string{created.Kind.String()})...)
+	}
+	s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueRelation, issueID, []string{issueFieldRelations})
+
 	return &IssueRelation{
 		ID:        created.ID,
 		Kind:      created.Kind,
@@ -1099,6 +1746,94 @@ func (s *issueService) RemoveRelation(ctx context.Context, issueID, relationID m
 		return errors.Join(ErrIssueRemoveRelation, err)
 	}
 
+	s.recordIssueActivity(ctx, relationActivities(IssueActivityKindRelationRemoved, issueID, relatedID, []string{existing.Kind.String()}, nil)...)
+	s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueRelation, issueID, []string{issueFieldRelations})
+	return nil
+}
+
+func (s *issueService) GetWatchers(ctx context.Context, issueID model.ID) ([]*PartialUser, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/GetWatchers")
+	defer span.End()
+
+	if err := issueID.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueGetWatchers, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return nil, errors.Join(ErrIssueGetWatchers, ErrNoPermission)
+	}
+
+	users, err := s.issueRepo.GetWatchers(ctx, issueID)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetWatchers, err)
+	}
+
+	watchers := make([]*PartialUser, 0, len(users))
+	for _, u := range users {
+		watchers = append(watchers, &PartialUser{
+			ID:        u.ID,
+			FirstName: u.FirstName,
+			LastName:  u.LastName,
+			Picture:   u.Picture,
+		})
+	}
+
+	return watchers, nil
+}
+
+func (s *issueService) Watch(ctx context.Context, issueID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/Watch")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrIssueWatch, license.ErrLicenseExpired)
+	}
+
+	if err := issueID.Validate(); err != nil {
+		return errors.Join(ErrIssueWatch, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return errors.Join(ErrIssueWatch, ErrNoUser)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return errors.Join(ErrIssueWatch, ErrNoPermission)
+	}
+
+	if err := s.issueRepo.AddWatcher(ctx, issueID, userID); err != nil {
+		return errors.Join(ErrIssueWatch, err)
+	}
+
+	return nil
+}
+
+func (s *issueService) Unwatch(ctx context.Context, issueID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/Unwatch")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrIssueUnwatch, license.ErrLicenseExpired)
+	}
+
+	if err := issueID.Validate(); err != nil {
+		return errors.Join(ErrIssueUnwatch, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return errors.Join(ErrIssueUnwatch, ErrNoUser)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return errors.Join(ErrIssueUnwatch, ErrNoPermission)
+	}
+
+	if err := s.issueRepo.RemoveWatcher(ctx, issueID, userID); err != nil {
+		return errors.Join(ErrIssueUnwatch, err)
+	}
+
 	return nil
 }
 
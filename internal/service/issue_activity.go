@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	IssueActivityKindFieldChanged    = repository.IssueActivityKindFieldChanged    // a field of the issue was changed
+	IssueActivityKindRelationAdded   = repository.IssueActivityKindRelationAdded   // a relation to another issue was added
+	IssueActivityKindRelationUpdated = repository.IssueActivityKindRelationUpdated // the kind of a relation was changed
+	IssueActivityKindRelationRemoved = repository.IssueActivityKindRelationRemoved // a relation to another issue was removed
+	IssueActivityKindCommentAdded    = repository.IssueActivityKindCommentAdded    // a comment was added to the issue
+	IssueActivityKindCommentUpdated  = repository.IssueActivityKindCommentUpdated  // a comment of the issue was edited
+	IssueActivityKindCommentDeleted  = repository.IssueActivityKindCommentDeleted  // a comment of the issue was deleted
+)
+
+// IssueActivity represents an entry of the activity timeline of an issue.
+type IssueActivity struct {
+	ID        model.ID
+	Kind      string
+	Field     *string
+	OldValue  []string
+	NewValue  []string
+	Subject   *model.ID
+	Actor     *model.ID
+	CreatedAt *time.Time
+}
+
+func issueActivityFromRepository(a *repository.IssueActivity) *IssueActivity {
+	if a == nil {
+		return nil
+	}
+	return &IssueActivity{
+		ID:        a.ID,
+		Kind:      a.Kind,
+		Field:     a.Field,
+		OldValue:  a.OldValue,
+		NewValue:  a.NewValue,
+		Subject:   a.Subject,
+		Actor:     a.Actor,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func (s *issueService) ListActivity(ctx context.Context, issueID model.ID, page CursorPage) (Page[*IssueActivity], error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/ListActivity")
+	defer span.End()
+
+	if s.issueActivityRepo == nil {
+		return Page[*IssueActivity]{}, errors.Join(ErrIssueGetActivity, ErrNoIssueActivityRepository)
+	}
+
+	if err := issueID.Validate(); err != nil {
+		return Page[*IssueActivity]{}, errors.Join(ErrIssueGetActivity, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*IssueActivity]{}, errors.Join(ErrIssueGetActivity, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return Page[*IssueActivity]{}, errors.Join(ErrIssueGetActivity, ErrNoPermission)
+	}
+
+	activities, err := s.issueActivityRepo.ListByIssue(ctx, issueID, normalized)
+	if err != nil {
+		return Page[*IssueActivity]{}, errors.Join(ErrIssueGetActivity, err)
+	}
+
+	return mapPage(activities, issueActivityFromRepository), nil
+}
+
+// recordIssueActivity appends the activities to the timeline of their issues.
+// Activities without an actor are attributed to the user in the context.
+// Recording is a side effect of the calling operation, therefore failures are
+// logged only. Without an issue activity repository nothing is recorded.
+func (s *baseService) recordIssueActivity(ctx context.Context, activities ...repository.CreateIssueActivityOpts) {
+	if s.issueActivityRepo == nil || len(activities) == 0 {
+		return
+	}
+
+	if actor, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID); ok {
+		for i := range activities {
+			if activities[i].Actor == nil {
+				activities[i].Actor = &actor
+			}
+		}
+	}
+
+	if _, err := s.issueActivityRepo.Create(ctx, activities); err != nil {
+		s.logger.Warn(ctx, "failed to record issue activity",
+			log.WithError(err),
+			log.WithValue(activities[0].Issue.Composite()),
+		)
+	}
+}
+
+// issueFieldChanges returns the field changes between the issue before and
+// after the update, limited to the fields defined in the update options. The
+// description is recorded without its values to keep the timeline lean.
+func issueFieldChanges(before, after *repository.Issue, opts UpdateIssueOpts) []repository.CreateIssueActivityOpts {
+	fields := []struct {
+		name     string
+		defined  bool
+		old, new []string
+	}{
+		{"kind", opts.Kind.Defined, enumActivityValue(before.Kind), enumActivityValue(after.Kind)},
+		{"title", opts.Title.Defined, []string{before.Title}, []string{after.Title}},
+		{"status", opts.Status.Defined, enumActivityValue(before.Status), enumActivityValue(after.Status)},
+		{"workflow_status", opts.WorkflowStatus.Defined, stringActivityValue(before.WorkflowStatus), stringActivityValue(after.WorkflowStatus)},
+		{"priority", opts.Priority.Defined, enumActivityValue(before.Priority), enumActivityValue(after.Priority)},
+		{"resolution", opts.Resolution.Defined, enumActivityValue(before.Resolution), enumActivityValue(after.Resolution)},
+		{"links", opts.Links.Defined, linkActivityValue(before.Links), linkActivityValue(after.Links)},
+		{"story_points", opts.StoryPoints.Defined, numberActivityValue(before.StoryPoints), numberActivityValue(after.StoryPoints)},
+		{"original_estimate", opts.OriginalEstimate.Defined, numberActivityValue(before.OriginalEstimate), numberActivityValue(after.OriginalEstimate)},
+		{"remaining_estimate", opts.RemainingEstimate.Defined, numberActivityValue(before.RemainingEstimate), numberActivityValue(after.RemainingEstimate)},
+		{"due_date", opts.DueDate.Defined, timeActivityValue(before.DueDate), timeActivityValue(after.DueDate)},
+		{"start_date", opts.StartDate.Defined, timeActivityValue(before.StartDate), timeActivityValue(after.StartDate)},
+		{"assignees", opts.Assignees.Defined, assigneeActivityValue(before.Assignments, model.AssignmentKindAssignee), assigneeActivityValue(after.Assignments, model.AssignmentKindAssignee)},
+		{"reviewers", opts.Reviewers.Defined, assigneeActivityValue(before.Assignments, model.AssignmentKindReviewer), assigneeActivityValue(after.Assignments, model.AssignmentKindReviewer)},
+		{"labels", opts.Labels.Defined, labelActivityValue(before.Labels), labelActivityValue(after.Labels)},
+		{"components", opts.Components.Defined, componentActivityValue(before.Components), componentActivityValue(after.Components)},
+		{"parent", opts.Parent.Defined, parentActivityValue(before.Parent), parentActivityValue(after.Parent)},
+	}
+
+	changes := make([]repository.CreateIssueActivityOpts, 0, len(fields)+1)
+	if opts.Description.Defined && before.Description != after.Description {
+		changes = append(changes, fieldChangeActivity(after.ID, "description", nil, nil))
+	}
+
+	for _, field := range fields {
+		if field.defined && !slices.Equal(field.old, field.new) {
+			changes = append(changes, fieldChangeActivity(after.ID, field.name, field.old, field.new))
+		}
+	}
+
+	keys := slices.Sorted(maps.Keys(opts.CustomFields))
+	for _, key := range keys {
+		oldValue := model.FormatCustomFieldValue(before.CustomFields[key])
+		newValue := model.FormatCustomFieldValue(after.CustomFields[key])
+		if !slices.Equal(oldValue, newValue) {
+			changes = append(changes, fieldChangeActivity(after.ID, "custom_fields."+key, oldValue, newValue))
+		}
+	}
+
+	return changes
+}
+
+// changedIssueFields returns the names of the fields changed by the field
+// change activities in the order they are recorded, listing the changed
+// custom fields once.
+func changedIssueFields(changes []repository.CreateIssueActivityOpts) []string {
+	changed := make([]string, 0, len(changes))
+	for _, change := range changes {
+		if change.Field == nil {
+			continue
+		}
+		field := *change.Field
+		if strings.HasPrefix(field, "custom_fields.") {
+			field = "custom_fields"
+		}
+		if !slices.Contains(changed, field) {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+func fieldChangeActivity(issueID model.ID, field string, oldValue, newValue []string) repository.CreateIssueActivityOpts {
+	return repository.CreateIssueActivityOpts{
+		Issue:    issueID,
+		Kind:     repository.IssueActivityKindFieldChanged,
+		Field:    &field,
+		OldValue: oldValue,
+		NewValue: newValue,
+	}
+}
+
+// relationActivities returns the relation change recorded on both issues of
+// the relation, each referring to the other issue.
+func relationActivities(kind string, issueID, relatedID model.ID, oldKind, newKind []string) []repository.CreateIssueActivityOpts {
+	return []repository.CreateIssueActivityOpts{
+		{Issue: issueID, Kind: kind, OldValue: oldKind, NewValue: newKind, Subject: &relatedID},
+		{Issue: relatedID, Kind: kind, OldValue: oldKind, NewValue: newKind, Subject: &issueID},
+	}
+}
+
+// commentActivity returns the comment event recorded on the issue.
+func commentActivity(kind string, issueID, commentID model.ID) repository.CreateIssueActivityOpts {
+	return repository.CreateIssueActivityOpts{
+		Issue:   issueID,
+		Kind:    kind,
+		Subject: &commentID,
+	}
+}
+
+func enumActivityValue[T interface {
+	~uint8
+	String() string
+}](value T) []string {
+	if value == 0 {
+		return nil
+	}
+	return []string{value.String()}
+}
+
+func stringActivityValue(value *string) []string {
+	if value == nil {
+		return nil
+	}
+	return []string{*value}
+}
+
+func numberActivityValue[T float64 | uint](value *T) []string {
+	if value == nil {
+		return nil
+	}
+	return []string{fmt.Sprint(*value)}
+}
+
+func timeActivityValue(value *time.Time) []string {
+	if value == nil {
+		return nil
+	}
+	return []string{value.UTC().Format(time.RFC3339)}
+}
+
+func linkActivityValue(links []model.IssueLink) []string {
+	values := make([]string, 0, len(links))
+	for _, link := range links {
+		values = append(values, link.URL)
+	}
+	return values
+}
+
+func assigneeActivityValue(assignments []repository.PartialAssignee, kind model.AssignmentKind) []string {
+	values := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.Kind == kind {
+			values = append(values, assignment.ID.String())
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+func labelActivityValue(labels []repository.PartialLabel) []string {
+	values := make([]string, 0, len(labels))
+	for _, label := range labels {
+		values = append(values, label.ID.String())
+	}
+	slices.Sort(values)
+	return values
+}
+
+func componentActivityValue(components []repository.PartialComponent) []string {
+	values := make([]string, 0, len(components))
+	for _, component := range components {
+		values = append(values, component.ID.String())
+	}
+	slices.Sort(values)
+	return values
+}
+
+func parentActivityValue(parent *repository.PartialIssue) []string {
+	if parent == nil {
+		return nil
+	}
+	return []string{parent.ID.String()}
+}
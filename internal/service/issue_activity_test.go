@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestIssueService_ListActivity(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	activity := &repository.IssueActivity{
+		ID:        model.MustNewID(model.ResourceTypeIssueActivity),
+		Issue:     issueID,
+		Kind:      repository.IssueActivityKindFieldChanged,
+		Field:     convert.ToPointer("status"),
+		OldValue:  []string{"open"},
+		NewValue:  []string{"done"},
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+
+	newTracer := func(ctrl *gomock.Controller) *mock.MockTracer {
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/ListActivity", gomock.Len(0)).Return(context.Background(), span)
+		return tracer
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		activityRepo := repository.NewMockIssueActivityRepository(ctrl)
+		activityRepo.EXPECT().ListByIssue(gomock.Any(), issueID, repository.CursorPage{Size: 10}).Return(
+			repository.Page[*repository.IssueActivity]{Items: []*repository.IssueActivity{activity}},
+			nil,
+		)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, model.ActionIssueRead).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            newTracer(ctrl),
+			issueActivityRepo: activityRepo,
+			permissionService: permSvc,
+		}}
+		got, err := s.ListActivity(context.Background(), issueID, CursorPage{Size: 10})
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, issueActivityFromRepository(activity), got.Items[0])
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            newTracer(ctrl),
+			issueActivityRepo: repository.NewMockIssueActivityRepository(ctrl),
+			permissionService: permSvc,
+		}}
+		_, err := s.ListActivity(context.Background(), issueID, CursorPage{Size: 10})
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("no repository", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            newTracer(ctrl),
+			permissionService: NewMockPermissionService(ctrl),
+		}}
+		_, err := s.ListActivity(context.Background(), issueID, CursorPage{Size: 10})
+		assert.ErrorIs(t, err, ErrNoIssueActivityRepository)
+	})
+}
+
+func TestIssueService_UpdateRecordsActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+	before := testModel.NewRepositoryIssue(userID)
+	after := *before
+	after.Status = model.IssueStatusInProgress
+
+	opts := UpdateIssueOpts{
+		Title:  optional.Some(before.Title),
+		Status: optional.Some(model.IssueStatusInProgress),
+	}
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0))
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "service.issueService/Update", gomock.Len(0)).Return(ctx, span)
+
+	issueRepo := repository.NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+	issueRepo.EXPECT().Update(ctx, before.ID, gomock.Any(), repository.IssueDetailProjection()).Return(&after, nil)
+
+	activityRepo := repository.NewMockIssueActivityRepository(ctrl)
+	activityRepo.EXPECT().Create(ctx, []repository.CreateIssueActivityOpts{
+		{
+			Issue:    before.ID,
+			Kind:     repository.IssueActivityKindFieldChanged,
+			Field:    convert.ToPointer("status"),
+			OldValue: []string{"open"},
+			NewValue: []string{"in progress"},
+			Actor:    &userID,
+		},
+	}).Return(nil, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, before.ID, model.ActionIssueUpdate).Return(true)
+
+	licenseSvc := mock.NewMockLicenseService(ctrl)
+	licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+	s := &issueService{baseService: &baseService{
+		searchService:     mockSearchIndex(ctrl),
+		logger:            mock.NewMockLogger(ctrl),
+		tracer:            tracer,
+		issueRepo:         issueRepo,
+		issueActivityRepo: activityRepo,
+		permissionService: permSvc,
+		licenseService:    licenseSvc,
+	}}
+
+	got, err := s.Update(ctx, before.ID, opts)
+	require.NoError(t, err)
+	assert.Equal(t, model.IssueStatusInProgress, got.Status)
+}
+
+func TestIssueService_UpdateUnchangedSkipsNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+	before := testModel.NewRepositoryIssue(userID)
+	after := *before
+
+	opts := UpdateIssueOpts{
+		Title:    optional.Some(before.Title),
+		Status:   optional.Some(before.Status),
+		Priority: optional.Some(before.Priority),
+	}
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0))
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, "service.issueService/Update", gomock.Len(0)).Return(ctx, span)
+
+	issueRepo := repository.NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+	issueRepo.EXPECT().Update(ctx, before.ID, gomock.Any(), repository.IssueDetailProjection()).Return(&after, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, before.ID, model.ActionIssueUpdate).Return(true)
+
+	licenseSvc := mock.NewMockLicenseService(ctrl)
+	licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+	enqueuer := &stubSearchEnqueuer{}
+	s := &issueService{baseService: &baseService{
+		searchService:            mockSearchIndex(ctrl),
+		logger:                   mock.NewMockLogger(ctrl),
+		tracer:                   tracer,
+		issueRepo:                issueRepo,
+		issueActivityRepo:        repository.NewMockIssueActivityRepository(ctrl),
+		eventRepo:                repository.NewMockEventRepository(ctrl),
+		permissionService:        permSvc,
+		licenseService:           licenseSvc,
+		notificationTaskEnqueuer: enqueuer,
+	}}
+
+	_, err := s.Update(ctx, before.ID, opts)
+	require.NoError(t, err)
+	assert.Nil(t, enqueuer.task)
+}
+
+func TestIssueService_RemoveRelationRecordsActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	relatedID := model.MustNewID(model.ResourceTypeIssue)
+	relationID := model.MustNewID(model.ResourceTypeIssueRelation)
+
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0))
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(gomock.Any(), "service.issueService/RemoveRelation", gomock.Len(0)).Return(context.Background(), span)
+
+	issueRepo := repository.NewMockIssueRepository(ctrl)
+	issueRepo.EXPECT().GetRelation(gomock.Any(), relationID).Return(&repository.IssueRelation{
+		ID:     relationID,
+		Source: issueID,
+		Target: relatedID,
+		Kind:   model.IssueRelationKindBlocks,
+	}, nil)
+	issueRepo.EXPECT().RemoveRelationByID(gomock.Any(), relationID).Return(nil)
+
+	activityRepo := repository.NewMockIssueActivityRepository(ctrl)
+	activityRepo.EXPECT().Create(gomock.Any(), []repository.CreateIssueActivityOpts{
+		{Issue: issueID, Kind: repository.IssueActivityKindRelationRemoved, OldValue: []string{"blocks"}, Subject: &relatedID},
+		{Issue: relatedID, Kind: repository.IssueActivityKindRelationRemoved, OldValue: []string{"blocks"}, Subject: &issueID},
+	}).Return(nil, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, gomock.Any()).Return(true)
+	permSvc.EXPECT().CtxUserHas(gomock.Any(), relatedID, gomock.Any()).Return(true)
+
+	licenseSvc := mock.NewMockLicenseService(ctrl)
+	licenseSvc.EXPECT().Expired(gomock.Any()).Return(false, nil)
+
+	s := &issueService{baseService: &baseService{
+		logger:            mock.NewMockLogger(ctrl),
+		tracer:            tracer,
+		issueRepo:         issueRepo,
+		issueActivityRepo: activityRepo,
+		permissionService: permSvc,
+		licenseService:    licenseSvc,
+	}}
+	require.NoError(t, s.RemoveRelation(context.Background(), issueID, relationID))
+}
+
+func TestIssueFieldChanges(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	first := model.MustNewID(model.ResourceTypeUser)
+	second := model.MustNewID(model.ResourceTypeUser)
+	dueDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	before := testModel.NewRepositoryIssue(userID)
+	before.DueDate = nil
+	before.Assignments = []repository.PartialAssignee{
+		{ID: first, Kind: model.AssignmentKindAssignee},
+		{ID: second, Kind: model.AssignmentKindReviewer},
+	}
+
+	after := *before
+	after.Description = "changed description"
+	after.Priority = model.IssuePriorityHigh
+	after.DueDate = &dueDate
+	after.Assignments = []repository.PartialAssignee{
+		{ID: second, Kind: model.AssignmentKindAssignee},
+		{ID: first, Kind: model.AssignmentKindAssignee},
+		{ID: second, Kind: model.AssignmentKindReviewer},
+	}
+
+	changes := issueFieldChanges(before, &after, UpdateIssueOpts{
+		Title:       optional.Some(before.Title),
+		Description: optional.Some(after.Description),
+		Priority:    optional.Some(model.IssuePriorityHigh),
+		DueDate:     optional.Some(dueDate),
+		Assignees:   optional.Some([]model.ID{first, second}),
+		Reviewers:   optional.Some([]model.ID{second}),
+	})
+
+	assignees := []string{first.String(), second.String()}
+	if assignees[0] > assignees[1] {
+		assignees[0], assignees[1] = assignees[1], assignees[0]
+	}
+
+	assert.Equal(t, []repository.CreateIssueActivityOpts{
+		fieldChangeActivity(before.ID, "description", nil, nil),
+		fieldChangeActivity(before.ID, "priority", []string{"normal"}, []string{"high"}),
+		fieldChangeActivity(before.ID, "due_date", nil, []string{"2024-01-01T00:00:00Z"}),
+		fieldChangeActivity(before.ID, "assignees", []string{first.String()}, assignees),
+	}, changes)
+}
+
+func TestChangedIssueFields(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	assert.Empty(t, changedIssueFields(nil))
+	assert.Equal(t, []string{"status", "custom_fields", "assignees"}, changedIssueFields([]repository.CreateIssueActivityOpts{
+		fieldChangeActivity(issueID, "status", []string{"open"}, []string{"done"}),
+		fieldChangeActivity(issueID, "custom_fields.customer", nil, []string{"ACME"}),
+		fieldChangeActivity(issueID, "custom_fields.points", nil, []string{"3"}),
+		fieldChangeActivity(issueID, "assignees", nil, []string{issueID.String()}),
+	}))
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// MoveIssueCardOpts moves the card of an issue to the Column of a board
+// grouped by GroupBy. The card is ranked right after the After issue and
+// right before the Before issue if any of them is set.
+type MoveIssueCardOpts struct {
+	GroupBy model.BoardGroupBy
+	Column  string
+	Before  *model.ID
+	After   *model.ID
+}
+
+// Validate validates the target column and the anchors of the card.
+func (o MoveIssueCardOpts) Validate(id model.ID) error {
+	if !o.GroupBy.Valid() {
+		return ErrBoardGroupBy
+	}
+	if !o.GroupBy.HasColumn(o.Column) {
+		return ErrBoardColumn
+	}
+	if o.Before != nil || o.After != nil {
+		return RankIssueOpts{Before: o.Before, After: o.After}.Validate(id)
+	}
+	return nil
+}
+
+// updateOpts returns the update setting the grouped field of the issue to the
+// value of the column.
+func (o MoveIssueCardOpts) updateOpts() (UpdateIssueOpts, error) {
+	var opts UpdateIssueOpts
+
+	switch o.GroupBy {
+	case model.BoardGroupByStatus:
+		status, err := model.IssueStatusString(o.Column)
+		if err != nil {
+			return opts, ErrBoardColumn
+		}
+		opts.Status = optional.Some(status)
+	case model.BoardGroupByWorkflowStatus:
+		opts.WorkflowStatus = optional.Some(o.Column)
+	case model.BoardGroupByPriority:
+		priority, err := model.IssuePriorityString(o.Column)
+		if err != nil {
+			return opts, ErrBoardColumn
+		}
+		opts.Priority = optional.Some(priority)
+	case model.BoardGroupByKind:
+		kind, err := model.IssueKindString(o.Column)
+		if err != nil {
+			return opts, ErrBoardColumn
+		}
+		opts.Kind = optional.Some(kind)
+	default:
+		return opts, ErrBoardGroupBy
+	}
+
+	return opts, nil
+}
+
+// resolveStatusColumn maps the status column a card is moved to on a project
+// with a workflow to a status of the workflow, as the issues of these
+// projects change status through their workflow status. The card keeps its
+// workflow status if it is in the category of the column already, otherwise
+// it moves to the first status of the category it can transition to.
+func (s *issueService) resolveStatusColumn(ctx context.Context, id model.ID, opts *UpdateIssueOpts) error {
+	if s.workflowRepo == nil || !opts.Status.Defined || opts.Status.Value == nil || opts.WorkflowStatus.Defined {
+		return nil
+	}
+
+	issue, err := s.issueRepo.Get(ctx, id, repository.IssueDetailProjection())
+	if err != nil {
+		return err
+	}
+	if issue.Project == nil {
+		return nil
+	}
+
+	workflow, err := s.projectWorkflow(ctx, issue.Project.ID)
+	if err != nil || workflow == nil {
+		return err
+	}
+
+	var current string
+	if issue.WorkflowStatus != nil {
+		current = *issue.WorkflowStatus
+		if status, ok := workflow.Status(current); ok && status.Category.IssueStatus() == *opts.Status.Value {
+			opts.WorkflowStatus = optional.Some(current)
+			return nil
+		}
+	}
+
+	var target *model.WorkflowStatus
+	for _, status := range workflow.Statuses {
+		if status.Category.IssueStatus() != *opts.Status.Value {
+			continue
+		}
+		if workflow.CanTransition(current, status.Key) {
+			target = &status
+			break
+		}
+		if target == nil {
+			target = &status
+		}
+	}
+	if target == nil {
+		return ErrBoardColumn
+	}
+
+	opts.WorkflowStatus = optional.Some(target.Key)
+	return nil
+}
+
+// IssueCardMove is the result of moving the card of an issue: the updated
+// issue and the column it was moved to.
+type IssueCardMove struct {
+	Issue  *Issue
+	Column *BoardColumn
+}
+
+func (s *issueService) MoveCard(ctx context.Context, id model.ID, opts MoveIssueCardOpts) (*IssueCardMove, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/MoveCard")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, license.ErrLicenseExpired)
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+	if err := opts.Validate(id); err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+
+	updateOpts, err := opts.updateOpts()
+	if err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+	if err := s.resolveStatusColumn(ctx, id, &updateOpts); err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+
+	update, err := s.prepareUpdate(ctx, id, updateOpts)
+	if err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+
+	for _, anchor := range []*model.ID{opts.Before, opts.After} {
+		if anchor != nil && !s.permissionService.CtxUserHas(ctx, *anchor, model.ActionIssueRead) {
+			return nil, errors.Join(ErrIssueMoveCard, ErrNoPermission)
+		}
+	}
+
+	// The card is ranked along with the update, so a rejected anchor leaves
+	// the issue in its column, and a rejected update leaves its rank.
+	if opts.Before != nil || opts.After != nil {
+		update.patch.Rank = &repository.RankIssueOpts{
+			Before: opts.Before,
+			After:  opts.After,
+		}
+	}
+
+	issue, err := s.issueRepo.Update(ctx, id, update.patch, repository.IssueDetailProjection())
+	if err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+
+	out, err := s.completeUpdate(ctx, update, issue)
+	if err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+
+	s.enqueueSearchIndex(ctx, out.ID)
+
+	move := &IssueCardMove{Issue: out, Column: &BoardColumn{Value: opts.Column}}
+	if out.Project == nil {
+		return move, nil
+	}
+
+	columns, err := s.boardColumns(ctx, out.Project.ID, opts.GroupBy)
+	if err != nil {
+		return nil, errors.Join(ErrIssueMoveCard, err)
+	}
+	for _, column := range columns {
+		if column.Value == opts.Column {
+			move.Column = column
+			break
+		}
+	}
+
+	return move, nil
+}
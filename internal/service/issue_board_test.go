@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestIssueService_MoveCard(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	afterID := model.MustNewID(model.ResourceTypeIssue)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	repoIssue := testModel.NewRepositoryIssue(model.MustNewID(model.ResourceTypeUser))
+	repoIssue.ID = issueID
+	repoIssue.Status = model.IssueStatusInProgress
+	repoIssue.Project = &repository.PartialProject{ID: projectID}
+
+	t.Run("move card", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{
+			Status: optional.Some(model.IssueStatusInProgress),
+			Rank:   &repository.RankIssueOpts{After: &afterID},
+		}, repository.IssueDetailProjection()).Return(repoIssue, nil)
+		issueRepo.EXPECT().CountByField(ctx, projectID, repository.IssueListConditionFieldStatus).Return(map[string]int64{
+			"in progress": 3,
+		}, nil)
+
+		wipLimitRepo := repository.NewMockWIPLimitRepository(ctrl)
+		wipLimitRepo.EXPECT().List(ctx, projectID).Return(newTestWIPLimits(), nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, afterID, model.ActionIssueRead).Return(true)
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(ctx, issueID).Return(nil)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			issueRepo:         issueRepo,
+			wipLimitRepo:      wipLimitRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "in progress",
+			After:   &afterID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, issueFromRepository(repoIssue), got.Issue)
+		assert.Equal(t, "in progress", got.Column.Value)
+		assert.Equal(t, int64(3), *got.Column.IssueCount)
+		assert.Equal(t, 2, *got.Column.WIPLimit)
+		assert.True(t, got.Column.WIPExceeded())
+	})
+
+	t.Run("move card with rejected update", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{
+			Status: optional.Some(model.IssueStatusInProgress),
+			Rank:   &repository.RankIssueOpts{After: &afterID},
+		}, repository.IssueDetailProjection()).Return(nil, repository.ErrIssueUpdate)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, afterID, model.ActionIssueRead).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "in progress",
+			After:   &afterID,
+		})
+		assert.ErrorIs(t, err, ErrIssueMoveCard)
+		assert.ErrorIs(t, err, repository.ErrIssueUpdate)
+	})
+
+	t.Run("move card without anchors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{
+			Priority: optional.Some(model.IssuePriorityHigh),
+		}, repository.IssueDetailProjection()).Return(repoIssue, nil)
+		issueRepo.EXPECT().CountByField(ctx, projectID, repository.IssueListConditionFieldPriority).Return(map[string]int64{}, nil)
+
+		wipLimitRepo := repository.NewMockWIPLimitRepository(ctrl)
+		wipLimitRepo.EXPECT().List(ctx, projectID).Return(newTestWIPLimits(), nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(ctx, issueID).Return(nil)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			issueRepo:         issueRepo,
+			wipLimitRepo:      wipLimitRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByPriority,
+			Column:  "high",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, got.Column.WIPLimit)
+		assert.False(t, got.Column.WIPExceeded())
+	})
+
+	t.Run("move card to unknown column", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByKind,
+			Column:  "chore",
+		})
+		assert.ErrorIs(t, err, ErrIssueMoveCard)
+		assert.ErrorIs(t, err, ErrBoardColumn)
+	})
+
+	t.Run("move card next to itself", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "done",
+			Before:  &issueID,
+		})
+		assert.ErrorIs(t, err, ErrIssueRankAnchor)
+	})
+
+	t.Run("move card without anchor permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, afterID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "done",
+			After:   &afterID,
+		})
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("move card on status board of workflow project", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		workflowIssue := *repoIssue
+		workflowIssue.Status = model.IssueStatusOpen
+		workflowIssue.WorkflowStatus = convert.ToPointer("backlog")
+
+		movedIssue := workflowIssue
+		movedIssue.Status = model.IssueStatusInProgress
+		movedIssue.WorkflowStatus = convert.ToPointer("in_review")
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(&workflowIssue, nil).Times(2)
+		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{
+			Status:         optional.Some(model.IssueStatusInProgress),
+			WorkflowStatus: optional.Some("in_review"),
+		}, repository.IssueDetailProjection()).Return(&movedIssue, nil)
+		issueRepo.EXPECT().CountByField(ctx, projectID, repository.IssueListConditionFieldStatus).Return(map[string]int64{}, nil)
+
+		workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+		workflowRepo.EXPECT().Get(ctx, projectID).Return(newTestRepositoryWorkflow(projectID), nil).AnyTimes()
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil).AnyTimes()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(ctx, issueID).Return(nil)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			issueRepo:         issueRepo,
+			workflowRepo:      workflowRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "in progress",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "in progress", got.Column.Value)
+		assert.Equal(t, convert.ToPointer("in_review"), got.Issue.WorkflowStatus)
+	})
+
+	t.Run("move card to status column without workflow status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+
+		workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+		workflowRepo.EXPECT().Get(ctx, projectID).Return(newTestRepositoryWorkflow(projectID), nil)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			issueRepo:      issueRepo,
+			workflowRepo:   workflowRepo,
+			licenseService: licenseSvc,
+		}}
+
+		_, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "blocked",
+		})
+		assert.ErrorIs(t, err, ErrIssueMoveCard)
+		assert.ErrorIs(t, err, ErrBoardColumn)
+	})
+
+	t.Run("move card with expired license", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueService/MoveCard"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.MoveCard(ctx, issueID, MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "done",
+		})
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
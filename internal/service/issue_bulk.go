@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// MaxBulkIssues is the maximum number of issues a bulk operation applies to.
+const MaxBulkIssues = 100
+
+const (
+	BulkIssueOperationUnknown BulkIssueOperation = iota
+	BulkIssueOperationUpdate                     // update
+	BulkIssueOperationDelete                     // delete
+)
+
+// BulkIssueOperation is the operation a bulk request applies to every issue.
+//
+//go:generate go tool enumer -type=BulkIssueOperation -text -transform=noop -linecomment -output=issue_bulk_operation_gen.go
+type BulkIssueOperation uint8
+
+// BulkIssueOpts holds the data required to apply an operation to many issues.
+// Update is only used by the update operation.
+type BulkIssueOpts struct {
+	IDs       []model.ID
+	Operation BulkIssueOperation
+	Update    UpdateIssueOpts
+}
+
+// BulkIssueResult is the outcome of a bulk operation for one issue. Issue is
+// set for the updated issues and Err for the issues the operation failed for.
+type BulkIssueResult struct {
+	ID    model.ID
+	Issue *Issue
+	Err   error
+}
+
+func (s *issueService) Bulk(ctx context.Context, opts BulkIssueOpts) ([]*BulkIssueResult, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/Bulk")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueBulk, license.ErrLicenseExpired)
+	}
+
+	ids := make([]model.ID, 0, len(opts.IDs))
+	seen := make(map[model.ID]struct{}, len(opts.IDs))
+	for _, id := range opts.IDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if err := id.Validate(); err != nil || id.Type != model.ResourceTypeIssue {
+			return nil, errors.Join(ErrIssueBulk, model.ErrInvalidID)
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 || len(ids) > MaxBulkIssues {
+		return nil, errors.Join(ErrIssueBulk, ErrIssueBulkSize)
+	}
+
+	switch opts.Operation {
+	case BulkIssueOperationUpdate:
+		return s.bulkUpdate(ctx, ids, opts.Update), nil
+	case BulkIssueOperationDelete:
+		return s.bulkDelete(ctx, ids), nil
+	default:
+		return nil, errors.Join(ErrIssueBulk, ErrIssueBulkOperation)
+	}
+}
+
+// bulkUpdate updates the issues the context user is allowed to update in a
+// single write, then indexes the updated issues in one batch.
+func (s *issueService) bulkUpdate(ctx context.Context, ids []model.ID, opts UpdateIssueOpts) []*BulkIssueResult {
+	results := make([]*BulkIssueResult, len(ids))
+	prepared := make(map[model.ID]*issueUpdate, len(ids))
+	updates := make([]repository.IssueUpdate, 0, len(ids))
+	for i, id := range ids {
+		results[i] = &BulkIssueResult{ID: id}
+
+		update, err := s.prepareUpdate(ctx, id, opts)
+		if err != nil {
+			results[i].Err = errors.Join(ErrIssueUpdate, err)
+			continue
+		}
+		prepared[id] = update
+		updates = append(updates, repository.IssueUpdate{ID: id, Opts: update.patch})
+	}
+
+	if len(updates) == 0 {
+		return results
+	}
+
+	issues, err := s.issueRepo.UpdateMany(ctx, updates, repository.IssueDetailProjection())
+	if err != nil {
+		for _, result := range results {
+			if _, ok := prepared[result.ID]; ok {
+				result.Err = errors.Join(ErrIssueUpdate, err)
+			}
+		}
+		return results
+	}
+
+	updated := make(map[model.ID]*repository.Issue, len(issues))
+	for _, issue := range issues {
+		updated[issue.ID] = issue
+	}
+
+	indexed := make([]model.ID, 0, len(issues))
+	for _, result := range results {
+		update, ok := prepared[result.ID]
+		if !ok {
+			continue
+		}
+
+		issue, ok := updated[result.ID]
+		if !ok {
+			result.Err = errors.Join(ErrIssueUpdate, repository.ErrNotFound)
+			continue
+		}
+		indexed = append(indexed, result.ID)
+
+		if result.Issue, err = s.completeUpdate(ctx, update, issue); err != nil {
+			result.Err = errors.Join(ErrIssueUpdate, err)
+		}
+	}
+
+	if len(indexed) > 0 {
+		s.enqueueSearchIndexIDs(ctx, indexed)
+	}
+
+	return results
+}
+
+// bulkDelete deletes the issues the context user is allowed to delete in a
+// single write, records their removal from open sprints, deletes their work
+// logs, activities and mentions, then removes them from the search index in
+// one batch.
+func (s *issueService) bulkDelete(ctx context.Context, ids []model.ID) []*BulkIssueResult {
+	results := make([]*BulkIssueResult, len(ids))
+	deleted := make([]model.ID, 0, len(ids))
+	for i, id := range ids {
+		results[i] = &BulkIssueResult{ID: id}
+
+		if !s.permissionService.CtxUserHas(ctx, id, model.ActionIssueDelete) {
+			results[i].Err = errors.Join(ErrIssueDelete, ErrNoPermission)
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+
+	if len(deleted) == 0 {
+		return results
+	}
+
+	scopeChanges := s.sprintRemovals(ctx, deleted...)
+
+	if err := s.issueRepo.DeleteMany(ctx, deleted); err != nil {
+		for _, result := range results {
+			if result.Err == nil {
+				result.Err = errors.Join(ErrIssueDelete, err)
+			}
+		}
+		return results
+	}
+
+	s.recordScopeChanges(ctx, scopeChanges...)
+	s.deleteIssueRecords(ctx, deleted...)
+
+	if err := s.searchService.DeleteIDs(ctx, deleted...); err != nil {
+		s.logger.Warn(ctx, "failed to delete search documents",
+			log.WithError(err),
+			log.WithLimit(len(deleted)),
+		)
+	}
+
+	return results
+}
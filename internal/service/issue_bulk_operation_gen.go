@@ -0,0 +1,94 @@
+// Code generated by "enumer -type=BulkIssueOperation -text -transform=noop -linecomment -output=issue_bulk_operation_gen.go"; DO NOT EDIT.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _BulkIssueOperationName = "BulkIssueOperationUnknownupdatedelete"
+
+var _BulkIssueOperationIndex = [...]uint8{0, 25, 31, 37}
+
+const _BulkIssueOperationLowerName = "bulkissueoperationunknownupdatedelete"
+
+func (i BulkIssueOperation) String() string {
+	if i >= BulkIssueOperation(len(_BulkIssueOperationIndex)-1) {
+		return fmt.Sprintf("BulkIssueOperation(%d)", i)
+	}
+	return _BulkIssueOperationName[_BulkIssueOperationIndex[i]:_BulkIssueOperationIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _BulkIssueOperationNoOp() {
+	var x [1]struct{}
+	_ = x[BulkIssueOperationUnknown-(0)]
+	_ = x[BulkIssueOperationUpdate-(1)]
+	_ = x[BulkIssueOperationDelete-(2)]
+}
+
+var _BulkIssueOperationValues = []BulkIssueOperation{BulkIssueOperationUnknown, BulkIssueOperationUpdate, BulkIssueOperationDelete}
+
+var _BulkIssueOperationNameToValueMap = map[string]BulkIssueOperation{
+	_BulkIssueOperationName[0:25]:       BulkIssueOperationUnknown,
+	_BulkIssueOperationLowerName[0:25]:  BulkIssueOperationUnknown,
+	_BulkIssueOperationName[25:31]:      BulkIssueOperationUpdate,
+	_BulkIssueOperationLowerName[25:31]: BulkIssueOperationUpdate,
+	_BulkIssueOperationName[31:37]:      BulkIssueOperationDelete,
+	_BulkIssueOperationLowerName[31:37]: BulkIssueOperationDelete,
+}
+
+var _BulkIssueOperationNames = []string{
+	_BulkIssueOperationName[0:25],
+	_BulkIssueOperationName[25:31],
+	_BulkIssueOperationName[31:37],
+}
+
+// BulkIssueOperationString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func BulkIssueOperationString(s string) (BulkIssueOperation, error) {
+	if val, ok := _BulkIssueOperationNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _BulkIssueOperationNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to BulkIssueOperation values", s)
+}
+
+// BulkIssueOperationValues returns all values of the enum
+func BulkIssueOperationValues() []BulkIssueOperation {
+	return _BulkIssueOperationValues
+}
+
+// BulkIssueOperationStrings returns a slice of all String values of the enum
+func BulkIssueOperationStrings() []string {
+	strs := make([]string, len(_BulkIssueOperationNames))
+	copy(strs, _BulkIssueOperationNames)
+	return strs
+}
+
+// IsABulkIssueOperation returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i BulkIssueOperation) IsABulkIssueOperation() bool {
+	for _, v := range _BulkIssueOperationValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for BulkIssueOperation
+func (i BulkIssueOperation) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for BulkIssueOperation
+func (i *BulkIssueOperation) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = BulkIssueOperationString(string(text))
+	return err
+}
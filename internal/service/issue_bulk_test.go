@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestIssueService_Bulk(t *testing.T) {
+	allowedID := model.MustNewID(model.ResourceTypeIssue)
+	deniedID := model.MustNewID(model.ResourceTypeIssue)
+	missingID := model.MustNewID(model.ResourceTypeIssue)
+
+	repoIssue := testModel.NewRepositoryIssue(model.MustNewID(model.ResourceTypeUser))
+	repoIssue.ID = allowedID
+	repoIssue.Title = "updated title"
+
+	update := UpdateIssueOpts{Title: optional.Some("updated title")}
+
+	tooMany := make([]model.ID, MaxBulkIssues+1)
+	for i := range tooMany {
+		tooMany[i] = model.MustNewID(model.ResourceTypeIssue)
+	}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		opts     BulkIssueOpts
+		wantErrs []error
+		wantErr  error
+	}{
+		{
+			name: "update issues",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, allowedID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+					issueRepo.EXPECT().Get(ctx, missingID, repository.IssueDetailProjection()).Return(nil, repository.ErrNotFound)
+					issueRepo.EXPECT().UpdateMany(ctx, []repository.IssueUpdate{
+						{ID: allowedID, Opts: repository.UpdateIssueOpts{Title: update.Title}},
+					}, repository.IssueDetailProjection()).Return([]*repository.Issue{repoIssue}, nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, allowedID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, deniedID, model.ActionIssueUpdate).Return(false)
+					permSvc.EXPECT().CtxUserHas(ctx, missingID, model.ActionIssueUpdate).Return(true)
+
+					searchSvc := NewMockSearchService(ctrl)
+					searchSvc.EXPECT().EnqueueIndexIDs(ctx, allowedID).Return(nil)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+						searchService:     searchSvc,
+					}
+				},
+			},
+			opts: BulkIssueOpts{
+				IDs:       []model.ID{allowedID, deniedID, allowedID, missingID},
+				Operation: BulkIssueOperationUpdate,
+				Update:    update,
+			},
+			wantErrs: []error{nil, ErrNoPermission, repository.ErrNotFound},
+		},
+		{
+			name: "update issues with failing write",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, allowedID, repository.IssueDetailProjection()).Return(repoIssue, nil)
+					issueRepo.EXPECT().UpdateMany(ctx, gomock.Len(1), repository.IssueDetailProjection()).Return(nil, repository.ErrIssueUpdate)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, allowedID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, deniedID, model.ActionIssueUpdate).Return(false)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+						searchService:     NewMockSearchService(ctrl),
+					}
+				},
+			},
+			opts: BulkIssueOpts{
+				IDs:       []model.ID{allowedID, deniedID},
+				Operation: BulkIssueOperationUpdate,
+				Update:    update,
+			},
+			wantErrs: []error{repository.ErrIssueUpdate, ErrNoPermission},
+		},
+		{
+			name: "delete issues",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().DeleteMany(ctx, []model.ID{allowedID}).Return(nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, allowedID, model.ActionIssueDelete).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, deniedID, model.ActionIssueDelete).Return(false)
+
+					searchSvc := NewMockSearchService(ctrl)
+					searchSvc.EXPECT().DeleteIDs(ctx, allowedID).Return(nil)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+						searchService:     searchSvc,
+					}
+				},
+			},
+			opts: BulkIssueOpts{
+				IDs:       []model.ID{allowedID, deniedID},
+				Operation: BulkIssueOperationDelete,
+			},
+			wantErrs: []error{nil, ErrNoPermission},
+		},
+		{
+			name: "delete issues planned in open sprints with records",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					sprintID := model.MustNewID(model.ResourceTypeSprint)
+
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().GetBatchScopes(ctx, []model.ID{allowedID, missingID}).Return([]*repository.IssueBatchScope{
+						{ID: allowedID, Sprints: []model.ID{sprintID}},
+					}, nil)
+					issueRepo.EXPECT().DeleteMany(ctx, []model.ID{allowedID, missingID}).Return(nil)
+
+					scopeChangeRepo := repository.NewMockSprintScopeChangeRepository(ctrl)
+					scopeChangeRepo.EXPECT().Create(ctx, []repository.CreateSprintScopeChangeOpts{
+						{Sprint: sprintID, Issue: allowedID, Kind: repository.SprintScopeChangeKindRemoved},
+					}).Return(nil, nil)
+
+					workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+					workLogRepo.EXPECT().DeleteByIssues(ctx, []model.ID{allowedID, missingID}).Return(repository.ErrWorkLogDelete)
+
+					issueActivityRepo := repository.NewMockIssueActivityRepository(ctrl)
+					issueActivityRepo.EXPECT().DeleteByIssues(ctx, []model.ID{allowedID, missingID}).Return(nil)
+
+					mentionRepo := repository.NewMockMentionRepository(ctrl)
+					mentionRepo.EXPECT().DeleteBySources(ctx, []model.ID{allowedID, missingID}).Return(nil)
+
+					logger := mock.NewMockLogger(ctrl)
+					logger.EXPECT().Warn(ctx, "failed to delete work logs of issues", gomock.Any(), gomock.Any())
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, allowedID, model.ActionIssueDelete).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, missingID, model.ActionIssueDelete).Return(true)
+
+					searchSvc := NewMockSearchService(ctrl)
+					searchSvc.EXPECT().DeleteIDs(ctx, allowedID, missingID).Return(nil)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						logger:            logger,
+						scopeChangeRepo:   scopeChangeRepo,
+						workLogRepo:       workLogRepo,
+						issueActivityRepo: issueActivityRepo,
+						mentionRepo:       mentionRepo,
+						permissionService: permSvc,
+						searchService:     searchSvc,
+					}
+				},
+			},
+			opts: BulkIssueOpts{
+				IDs:       []model.ID{allowedID, missingID},
+				Operation: BulkIssueOperationDelete,
+			},
+			wantErrs: []error{nil, nil},
+		},
+		{
+			name: "bulk issues with license expired",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(true, nil)
+					return &baseService{licenseService: licenseSvc}
+				},
+			},
+			opts: BulkIssueOpts{
+				IDs:       []model.ID{allowedID},
+				Operation: BulkIssueOperationDelete,
+			},
+			wantErr: license.ErrLicenseExpired,
+		},
+		{
+			name: "bulk issues without issues",
+			opts: BulkIssueOpts{
+				Operation: BulkIssueOperationDelete,
+			},
+			wantErr: ErrIssueBulkSize,
+		},
+		{
+			name: "bulk issues with too many issues",
+			opts: BulkIssueOpts{
+				IDs:       tooMany,
+				Operation: BulkIssueOperationDelete,
+			},
+			wantErr: ErrIssueBulkSize,
+		},
+		{
+			name: "bulk issues with invalid id",
+			opts: BulkIssueOpts{
+				IDs:       []model.ID{model.MustNewID(model.ResourceTypeUser)},
+				Operation: BulkIssueOperationDelete,
+			},
+			wantErr: model.ErrInvalidID,
+		},
+		{
+			name: "bulk issues with unknown operation",
+			opts: BulkIssueOpts{
+				IDs: []model.ID{allowedID},
+			},
+			wantErr: ErrIssueBulkOperation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			span := mock.NewMockSpan(ctrl)
+			span.EXPECT().End(gomock.Len(0))
+
+			tracer := mock.NewMockTracer(ctrl)
+			tracer.EXPECT().Start(ctx, "service.issueService/Bulk", gomock.Len(0)).Return(ctx, span)
+
+			base := &baseService{}
+			if tt.fields.baseService != nil {
+				base = tt.fields.baseService(ctrl, ctx)
+			}
+			if base.licenseService == nil {
+				licenseSvc := mock.NewMockLicenseService(ctrl)
+				licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+				base.licenseService = licenseSvc
+			}
+			if base.logger == nil {
+				base.logger = mock.NewMockLogger(ctrl)
+			}
+			base.tracer = tracer
+
+			s := &issueService{baseService: base}
+			results, err := s.Bulk(ctx, tt.opts)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, results)
+				return
+			}
+
+			require.Len(t, results, len(tt.wantErrs))
+			for i, result := range results {
+				if tt.wantErrs[i] == nil {
+					assert.NoError(t, result.Err)
+					if tt.opts.Operation == BulkIssueOperationUpdate {
+						assert.Equal(t, issueFromRepository(repoIssue), result.Issue)
+					}
+					continue
+				}
+				assert.ErrorIs(t, result.Err, tt.wantErrs[i])
+				assert.Nil(t, result.Issue)
+			}
+		})
+	}
+}
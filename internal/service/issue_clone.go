@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// CloneIssueOpts selects what is copied to the clone of an issue besides its
+// fields. Only the relations and documents the context user could relate to
+// the clone on their own are copied.
+type CloneIssueOpts struct {
+	Subtasks  bool // clone the subtasks of the issue, at any depth
+	Relations bool // relate the clone to the issues related to the issue
+	Labels    bool // attach the labels of the issue to the clone
+	Documents bool // relate the documents of the issue to the clone
+}
+
+func (s *issueService) Clone(ctx context.Context, id model.ID, opts CloneIssueOpts) (*Issue, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/Clone")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueClone, license.ErrLicenseExpired)
+	}
+
+	if opts.Documents && s.documentRepo == nil {
+		return nil, errors.Join(ErrIssueClone, ErrNoDocumentRepository)
+	}
+
+	source, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrIssueClone, err)
+	}
+
+	var parent *model.ID
+	if source.Parent != nil {
+		parent = &source.Parent.ID
+	}
+
+	created, err := s.cloneIssue(ctx, source, parent, opts, make(map[model.ID]struct{}))
+	if err != nil {
+		return created, errors.Join(ErrIssueClone, err)
+	}
+
+	clone, err := s.Get(ctx, created.ID)
+	if err != nil {
+		return created, errors.Join(ErrIssueClone, err)
+	}
+
+	return clone, nil
+}
+
+// cloneIssue creates a copy of the issue under the parent and copies what the
+// options select to it. The subtasks are cloned under the copy, each issue
+// being cloned once even if the subtasks form a cycle.
+//
+// If the copy is created but copying the rest fails, the copy is returned
+// along with the error, so the caller knows the copy exists.
+func (s *issueService) cloneIssue(ctx context.Context, source *Issue, parent *model.ID, opts CloneIssueOpts, visited map[model.ID]struct{}) (*Issue, error) {
+	if source.Project == nil {
+		return nil, model.ErrInvalidIssueDetails
+	}
+	visited[source.ID] = struct{}{}
+
+	components := make([]model.ID, len(source.Components))
+	for i, component := range source.Components {
+		components[i] = component.ID
+	}
+
+	clone, err := s.Create(ctx, source.Project.ID, CreateIssueOpts{
+		Parent:           parent,
+		Kind:             source.Kind,
+		Title:            source.Title,
+		Description:      source.Description,
+		Priority:         source.Priority,
+		Links:            source.Links,
+		CustomFields:     source.CustomFields,
+		Components:       components,
+		StoryPoints:      source.StoryPoints,
+		OriginalEstimate: source.OriginalEstimate,
+		DueDate:          source.DueDate,
+		StartDate:        source.StartDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Labels && len(source.Labels) > 0 {
+		labels := make([]model.ID, len(source.Labels))
+		for i, label := range source.Labels {
+			labels[i] = label.ID
+		}
+		if err := s.syncLabels(ctx, clone.ID, nil, labels); err != nil {
+			return clone, err
+		}
+	}
+
+	if opts.Documents {
+		if err := s.cloneDocuments(ctx, source.ID, clone.ID); err != nil {
+			return clone, err
+		}
+	}
+
+	if !opts.Subtasks && !opts.Relations {
+		return clone, nil
+	}
+
+	relations, err := s.listAllRelations(ctx, source.ID)
+	if err != nil {
+		return clone, err
+	}
+
+	var subtasks []model.ID
+	for _, relation := range relations {
+		if relation.Kind == model.IssueRelationKindSubtaskOf {
+			if relation.Target == source.ID {
+				subtasks = append(subtasks, relation.Source)
+			}
+			continue
+		}
+
+		if !opts.Relations || validateEditableRelationKind(relation.Kind) != nil {
+			continue
+		}
+
+		if relation.Source == source.ID {
+			if _, err := s.AddRelation(ctx, clone.ID, relation.Target, relation.Kind); err != nil {
+				return clone, err
+			}
+			continue
+		}
+
+		// Incoming relations are added to the related issue, so they are
+		// copied only if the user can update it.
+		if s.permissionService.CtxUserHas(ctx, relation.Source, model.ActionIssueUpdate) {
+			if _, err := s.AddRelation(ctx, relation.Source, clone.ID, relation.Kind); err != nil {
+				return clone, err
+			}
+		}
+	}
+
+	if !opts.Subtasks {
+		return clone, nil
+	}
+
+	for _, subtaskID := range subtasks {
+		if _, ok := visited[subtaskID]; ok {
+			continue
+		}
+
+		subtask, err := s.Get(ctx, subtaskID)
+		if errors.Is(err, ErrNoPermission) {
+			continue
+		}
+		if err != nil {
+			return clone, err
+		}
+
+		if _, err := s.cloneIssue(ctx, subtask, &clone.ID, opts, visited); err != nil {
+			return clone, err
+		}
+	}
+
+	return clone, nil
+}
+
+// listAllRelations returns every relation of the issue, in both directions.
+func (s *issueService) listAllRelations(ctx context.Context, issueID model.ID) ([]*repository.IssueRelationItem, error) {
+	page := repository.CursorPage{Size: issueClonePageSize}
+	relations := make([]*repository.IssueRelationItem, 0)
+	for {
+		items, err := s.issueRepo.ListRelations(ctx, repository.IssueRelationListQuery{
+			IssueID: issueID,
+			Page:    page,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		relations = append(relations, items.Items...)
+		if !items.PageInfo.HasMore || items.PageInfo.NextPageToken == nil {
+			break
+		}
+
+		page.Token = items.PageInfo.NextPageToken
+	}
+
+	return relations, nil
+}
+
+// cloneDocuments relates the documents of the issue the context user can read
+// and update to the clone.
+func (s *issueService) cloneDocuments(ctx context.Context, issueID, cloneID model.ID) error {
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return ErrNoUser
+	}
+
+	page := repository.CursorPage{Size: issueClonePageSize}
+	for {
+		documents, err := s.documentRepo.ListRelated(ctx, issueID, userID, page, repository.DocumentSummaryProjection())
+		if err != nil {
+			return err
+		}
+
+		for _, document := range documents.Items {
+			if !s.permissionService.CtxUserHas(ctx, document.ID, model.ActionDocumentUpdate) {
+				continue
+			}
+			if err := s.documentRepo.Relate(ctx, document.ID, cloneID); err != nil {
+				return err
+			}
+		}
+
+		if !documents.PageInfo.HasMore || documents.PageInfo.NextPageToken == nil {
+			break
+		}
+
+		page.Token = documents.PageInfo.NextPageToken
+	}
+
+	return nil
+}
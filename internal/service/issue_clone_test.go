@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestIssueService_Clone(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+
+	newIssue := func() *repository.Issue {
+		issue := testModel.NewRepositoryIssue(userID)
+		issue.Project = &repository.PartialProject{ID: projectID, Key: "TEST"}
+		return issue
+	}
+
+	newTracer := func(ctrl *gomock.Controller, ctx context.Context) *mock.MockTracer {
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0)).AnyTimes()
+
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, gomock.Any(), gomock.Len(0)).Return(ctx, span).AnyTimes()
+		return tracer
+	}
+
+	t.Run("clone issue with subtasks, relations and labels", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		source := newIssue()
+		source.Labels = []repository.PartialLabel{{ID: labelID}}
+		subtask := newIssue()
+		blocked := newIssue()
+		related := newIssue()
+		clone := newIssue()
+		subtaskClone := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, source.ID, repository.IssueDetailProjection()).Return(source, nil)
+		issueRepo.EXPECT().Get(ctx, subtask.ID, repository.IssueDetailProjection()).Return(subtask, nil)
+		issueRepo.EXPECT().Get(ctx, clone.ID, repository.IssueDetailProjection()).Return(clone, nil)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts repository.CreateIssueOpts) (*repository.Issue, error) {
+			assert.Nil(t, opts.Parent)
+			assert.Equal(t, source.Title, opts.Title)
+			assert.Equal(t, source.Links, opts.Links)
+			return clone, nil
+		})
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts repository.CreateIssueOpts) (*repository.Issue, error) {
+			assert.Equal(t, &clone.ID, opts.Parent)
+			assert.Equal(t, subtask.Title, opts.Title)
+			return subtaskClone, nil
+		})
+		issueRepo.EXPECT().ListRelations(ctx, repository.IssueRelationListQuery{
+			IssueID: source.ID,
+			Page:    repository.CursorPage{Size: issueClonePageSize},
+		}).Return(repository.Page[*repository.IssueRelationItem]{Items: []*repository.IssueRelationItem{
+			{Kind: model.IssueRelationKindSubtaskOf, Source: subtask.ID, Target: source.ID},
+			{Kind: model.IssueRelationKindBlocks, Source: source.ID, Target: blocked.ID},
+			{Kind: model.IssueRelationKindRelatedTo, Source: related.ID, Target: source.ID},
+			{Kind: model.IssueRelationKindDependsOn, Source: source.ID, Target: blocked.ID},
+		}}, nil)
+		issueRepo.EXPECT().ListRelations(ctx, repository.IssueRelationListQuery{
+			IssueID: subtask.ID,
+			Page:    repository.CursorPage{Size: issueClonePageSize},
+		}).Return(repository.Page[*repository.IssueRelationItem]{Items: []*repository.IssueRelationItem{
+			{Kind: model.IssueRelationKindSubtaskOf, Source: subtask.ID, Target: source.ID},
+		}}, nil)
+		issueRepo.EXPECT().HasRelationCycle(ctx, repository.CreateIssueRelationOpts{
+			Source: clone.ID,
+			Target: blocked.ID,
+			Kind:   model.IssueRelationKindBlocks,
+		}, nil).Return(false, nil)
+		issueRepo.EXPECT().AddRelation(ctx, repository.CreateIssueRelationOpts{
+			Source: clone.ID,
+			Target: blocked.ID,
+			Kind:   model.IssueRelationKindBlocks,
+		}).Return(&repository.IssueRelation{Kind: model.IssueRelationKindBlocks}, nil)
+		issueRepo.EXPECT().Get(ctx, blocked.ID, repository.IssueProjection{}).Return(blocked, nil)
+		issueRepo.EXPECT().AddRelation(ctx, repository.CreateIssueRelationOpts{
+			Source: related.ID,
+			Target: clone.ID,
+			Kind:   model.IssueRelationKindRelatedTo,
+		}).Return(&repository.IssueRelation{Kind: model.IssueRelationKindRelatedTo}, nil)
+		issueRepo.EXPECT().Get(ctx, clone.ID, repository.IssueProjection{}).Return(clone, nil)
+
+		labelRepo := repository.NewMockLabelRepository(ctrl)
+		labelRepo.EXPECT().AttachTo(ctx, labelID, clone.ID).Return(nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil).AnyTimes()
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(ctx, gomock.Any()).Return(nil).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			labelRepo:         labelRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.Clone(ctx, source.ID, CloneIssueOpts{Subtasks: true, Relations: true, Labels: true})
+		require.NoError(t, err)
+		assert.Equal(t, issueFromRepository(clone), got)
+	})
+
+	t.Run("clone issue with documents", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		source := newIssue()
+		clone := newIssue()
+		readable := testModel.NewRepositoryDocument(userID)
+		readOnly := testModel.NewRepositoryDocument(userID)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, source.ID, repository.IssueDetailProjection()).Return(source, nil)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).Return(clone, nil)
+		issueRepo.EXPECT().Get(ctx, clone.ID, repository.IssueDetailProjection()).Return(clone, nil)
+
+		documentRepo := repository.NewMockDocumentRepository(ctrl)
+		documentRepo.EXPECT().ListRelated(ctx, source.ID, userID, repository.CursorPage{Size: issueClonePageSize}, repository.DocumentSummaryProjection()).
+			Return(repository.Page[*repository.Document]{Items: []*repository.Document{readable, readOnly}}, nil)
+		documentRepo.EXPECT().Relate(ctx, readable.ID, clone.ID).Return(nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, source.ID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, clone.ID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, readable.ID, model.ActionDocumentUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, readOnly.ID, model.ActionDocumentUpdate).Return(false)
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, clone.ID, gomock.Any()).Return(nil)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			documentRepo:      documentRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     mockSearchIndex(ctrl),
+		}}
+
+		got, err := s.Clone(ctx, source.ID, CloneIssueOpts{Documents: true})
+		require.NoError(t, err)
+		assert.Equal(t, issueFromRepository(clone), got)
+	})
+
+	t.Run("clone issue with cyclic subtasks", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		source := newIssue()
+		subtask := newIssue()
+		clone := newIssue()
+		subtaskClone := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, source.ID, repository.IssueDetailProjection()).Return(source, nil)
+		issueRepo.EXPECT().Get(ctx, subtask.ID, repository.IssueDetailProjection()).Return(subtask, nil)
+		issueRepo.EXPECT().Get(ctx, clone.ID, repository.IssueDetailProjection()).Return(clone, nil)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).Return(clone, nil)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).Return(subtaskClone, nil)
+		issueRepo.EXPECT().ListRelations(ctx, repository.IssueRelationListQuery{
+			IssueID: source.ID,
+			Page:    repository.CursorPage{Size: issueClonePageSize},
+		}).Return(repository.Page[*repository.IssueRelationItem]{Items: []*repository.IssueRelationItem{
+			{Kind: model.IssueRelationKindSubtaskOf, Source: subtask.ID, Target: source.ID},
+		}}, nil)
+		issueRepo.EXPECT().ListRelations(ctx, repository.IssueRelationListQuery{
+			IssueID: subtask.ID,
+			Page:    repository.CursorPage{Size: issueClonePageSize},
+		}).Return(repository.Page[*repository.IssueRelationItem]{Items: []*repository.IssueRelationItem{
+			{Kind: model.IssueRelationKindSubtaskOf, Source: subtask.ID, Target: source.ID},
+			{Kind: model.IssueRelationKindSubtaskOf, Source: source.ID, Target: subtask.ID},
+		}}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil).AnyTimes()
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(ctx, gomock.Any()).Return(nil).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.Clone(ctx, source.ID, CloneIssueOpts{Subtasks: true})
+		require.NoError(t, err)
+		assert.Equal(t, issueFromRepository(clone), got)
+	})
+
+	t.Run("clone issue with failing copy returns the clone", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		source := newIssue()
+		clone := newIssue()
+		document := testModel.NewRepositoryDocument(userID)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, source.ID, repository.IssueDetailProjection()).Return(source, nil)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).Return(clone, nil)
+
+		documentRepo := repository.NewMockDocumentRepository(ctrl)
+		documentRepo.EXPECT().ListRelated(ctx, source.ID, userID, repository.CursorPage{Size: issueClonePageSize}, repository.DocumentSummaryProjection()).
+			Return(repository.Page[*repository.Document]{Items: []*repository.Document{document}}, nil)
+		documentRepo.EXPECT().Relate(ctx, document.ID, clone.ID).Return(repository.ErrDocumentUpdate)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, clone.ID, gomock.Any()).Return(nil)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			documentRepo:      documentRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     mockSearchIndex(ctrl),
+		}}
+
+		got, err := s.Clone(ctx, source.ID, CloneIssueOpts{Documents: true})
+		assert.ErrorIs(t, err, ErrIssueClone)
+		assert.ErrorIs(t, err, repository.ErrDocumentUpdate)
+		require.NotNil(t, got)
+		assert.Equal(t, clone.ID, got.ID)
+	})
+
+	t.Run("clone issue without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		issueID := model.MustNewID(model.ResourceTypeIssue)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx),
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Clone(ctx, issueID, CloneIssueOpts{})
+		assert.ErrorIs(t, err, ErrIssueClone)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("clone issue with documents and no document repository", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newTracer(ctrl, ctx),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Clone(ctx, model.MustNewID(model.ResourceTypeIssue), CloneIssueOpts{Documents: true})
+		assert.ErrorIs(t, err, ErrNoDocumentRepository)
+	})
+
+	t.Run("clone issue with license expired", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newTracer(ctrl, ctx),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.Clone(ctx, model.MustNewID(model.ResourceTypeIssue), CloneIssueOpts{})
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	issueDependencyGraphDefaultDepth = 3
+)
+
+// IssueDependencyGraph is an issue with the issues around it connected
+// through blocking and subtask relations, and the relations between them.
+type IssueDependencyGraph struct {
+	Issues    []*PartialIssue
+	Relations []*model.IssueRelation
+}
+
+// CriticalPathStep is an issue on the critical path of a project. The
+// earliest start and finish are relative to the start of the path.
+type CriticalPathStep struct {
+	Issue          *PartialIssue
+	Duration       time.Duration
+	EarliestStart  time.Duration
+	EarliestFinish time.Duration
+}
+
+// CriticalPath is the longest chain of blocking issues of a project, which
+// determines the shortest time the issues of the project can be done in.
+type CriticalPath struct {
+	Steps    []*CriticalPathStep
+	Duration time.Duration
+}
+
+// validateRelationCycle returns an error if the relation would close a cycle
+// of blocking or subtask relations. The relation with the ignore ID is left
+// out, as it is about to be replaced.
+func (s *issueService) validateRelationCycle(ctx context.Context, opts repository.CreateIssueRelationOpts, ignore *model.ID) error {
+	if !opts.Kind.IsDependency() {
+		return nil
+	}
+
+	cycle, err := s.issueRepo.HasRelationCycle(ctx, opts, ignore)
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return ErrIssueRelationCycle
+	}
+
+	return nil
+}
+
+func (s *issueService) GetDependencyGraph(ctx context.Context, id model.ID, depth int) (*IssueDependencyGraph, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/GetDependencyGraph")
+	defer span.End()
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueGetDependencyGraph, err)
+	}
+
+	if depth == 0 {
+		depth = issueDependencyGraphDefaultDepth
+	}
+	if depth < 1 || depth > repository.IssueDependencyGraphMaxDepth {
+		return nil, errors.Join(ErrIssueGetDependencyGraph, ErrIssueDependencyDepth)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, id, model.ActionIssueRead) {
+		return nil, errors.Join(ErrIssueGetDependencyGraph, ErrNoPermission)
+	}
+
+	graph, err := s.issueRepo.GetDependencyGraph(ctx, id, depth)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetDependencyGraph, err)
+	}
+
+	// The issues around the requested one are left out if the user cannot
+	// read them, together with their relations.
+	readable := make(map[model.ID]bool, len(graph.Issues))
+	result := &IssueDependencyGraph{
+		Issues:    make([]*PartialIssue, 0, len(graph.Issues)),
+		Relations: make([]*model.IssueRelation, 0, len(graph.Relations)),
+	}
+	for _, issue := range graph.Issues {
+		if issue.ID != id && !s.permissionService.CtxUserHas(ctx, issue.ID, model.ActionIssueRead) {
+			continue
+		}
+		readable[issue.ID] = true
+		result.Issues = append(result.Issues, partialIssueFromRepository(issue))
+	}
+	for _, relation := range graph.Relations {
+		if !readable[relation.Source] || !readable[relation.Target] {
+			continue
+		}
+		result.Relations = append(result.Relations, &model.IssueRelation{
+			ID:        relation.ID,
+			Source:    relation.Source,
+			Target:    relation.Target,
+			Kind:      relation.Kind,
+			CreatedAt: relation.CreatedAt,
+			UpdatedAt: relation.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *issueService) GetCriticalPath(ctx context.Context, projectID model.ID) (*CriticalPath, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/GetCriticalPath")
+	defer span.End()
+
+	if err := projectID.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueGetCriticalPath, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionIssueRead) {
+		return nil, errors.Join(ErrIssueGetCriticalPath, ErrNoPermission)
+	}
+
+	graph, err := s.issueRepo.GetProjectDependencies(ctx, projectID)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetCriticalPath, err)
+	}
+
+	issues := make(map[model.ID]*repository.PartialIssue, len(graph.Issues))
+	scheduled := make([]model.ScheduledIssue, len(graph.Issues))
+	for i, issue := range graph.Issues {
+		issues[issue.ID] = issue
+		scheduled[i] = model.ScheduledIssue{
+			ID:        issue.ID,
+			StartDate: issue.StartDate,
+			DueDate:   issue.DueDate,
+		}
+	}
+
+	dependencies := make([]model.IssueDependency, 0, len(graph.Relations))
+	for _, relation := range graph.Relations {
+		if dependency, ok := model.NewIssueDependency(relation.Source, relation.Target, relation.Kind); ok {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	path, err := model.NewCriticalPath(scheduled, dependencies)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetCriticalPath, err)
+	}
+
+	result := &CriticalPath{
+		Steps:    make([]*CriticalPathStep, len(path.Steps)),
+		Duration: path.Duration,
+	}
+	for i, step := range path.Steps {
+		result.Steps[i] = &CriticalPathStep{
+			Issue:          partialIssueFromRepository(issues[step.ID]),
+			Duration:       step.Duration,
+			EarliestStart:  step.EarliestStart,
+			EarliestFinish: step.EarliestFinish,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func newIssueDependencyTestTracer(ctrl *gomock.Controller, ctx context.Context) *mock.MockTracer {
+	span := mock.NewMockSpan(ctrl)
+	span.EXPECT().End(gomock.Len(0)).AnyTimes()
+
+	tracer := mock.NewMockTracer(ctrl)
+	tracer.EXPECT().Start(ctx, gomock.Any(), gomock.Len(0)).Return(ctx, span).AnyTimes()
+	return tracer
+}
+
+func TestIssueService_RelationCycle(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	relatedID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("add relation closing a cycle", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().HasRelationCycle(ctx, repository.CreateIssueRelationOpts{
+			Source: issueID,
+			Target: relatedID,
+			Kind:   model.IssueRelationKindBlockedBy,
+		}, nil).Return(true, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), gomock.Any()).Return(true).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.AddRelation(ctx, issueID, relatedID, model.IssueRelationKindBlockedBy)
+		assert.ErrorIs(t, err, ErrIssueAddRelation)
+		assert.ErrorIs(t, err, ErrIssueRelationCycle)
+	})
+
+	t.Run("update relation ignores the replaced relation", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		relationID := model.MustNewID(model.ResourceTypeIssueRelation)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetRelation(ctx, relationID).Return(&repository.IssueRelation{
+			ID:     relationID,
+			Source: issueID,
+			Target: relatedID,
+			Kind:   model.IssueRelationKindBlocks,
+		}, nil)
+		issueRepo.EXPECT().HasRelationCycle(ctx, repository.CreateIssueRelationOpts{
+			Source: issueID,
+			Target: relatedID,
+			Kind:   model.IssueRelationKindBlockedBy,
+		}, &relationID).Return(true, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), gomock.Any()).Return(true).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.UpdateRelation(ctx, issueID, relationID, model.IssueRelationKindBlockedBy)
+		assert.ErrorIs(t, err, ErrIssueUpdateRelation)
+		assert.ErrorIs(t, err, ErrIssueRelationCycle)
+	})
+
+	t.Run("set parent closing a cycle", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().HasRelationCycle(ctx, repository.CreateIssueRelationOpts{
+			Source: issueID,
+			Target: relatedID,
+			Kind:   model.IssueRelationKindSubtaskOf,
+		}, nil).Return(true, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), gomock.Any()).Return(true).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Update(ctx, issueID, UpdateIssueOpts{Parent: optional.Some(relatedID)})
+		assert.ErrorIs(t, err, ErrIssueUpdate)
+		assert.ErrorIs(t, err, ErrIssueRelationCycle)
+	})
+}
+
+func TestIssueService_GetDependencyGraph(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	readableID := model.MustNewID(model.ResourceTypeIssue)
+	hiddenID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("get dependency graph", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		visible := &repository.IssueRelation{
+			ID:     model.MustNewID(model.ResourceTypeIssueRelation),
+			Source: issueID,
+			Target: readableID,
+			Kind:   model.IssueRelationKindBlocks,
+		}
+		hidden := &repository.IssueRelation{
+			ID:     model.MustNewID(model.ResourceTypeIssueRelation),
+			Source: hiddenID,
+			Target: issueID,
+			Kind:   model.IssueRelationKindSubtaskOf,
+		}
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetDependencyGraph(ctx, issueID, issueDependencyGraphDefaultDepth).Return(&repository.IssueDependencyGraph{
+			Issues: []*repository.PartialIssue{
+				{ID: issueID, Key: "TEST-1"},
+				{ID: readableID, Key: "TEST-2"},
+				{ID: hiddenID, Key: "TEST-3"},
+			},
+			Relations: []*repository.IssueRelation{visible, hidden},
+		}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, readableID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, hiddenID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetDependencyGraph(ctx, issueID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &IssueDependencyGraph{
+			Issues: []*PartialIssue{
+				partialIssueFromRepository(&repository.PartialIssue{ID: issueID, Key: "TEST-1"}),
+				partialIssueFromRepository(&repository.PartialIssue{ID: readableID, Key: "TEST-2"}),
+			},
+			Relations: []*model.IssueRelation{{
+				ID:     visible.ID,
+				Source: issueID,
+				Target: readableID,
+				Kind:   model.IssueRelationKindBlocks,
+			}},
+		}, got)
+	})
+
+	t.Run("get dependency graph with invalid depth", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueService{baseService: &baseService{
+			tracer: newIssueDependencyTestTracer(ctrl, ctx),
+		}}
+
+		_, err := s.GetDependencyGraph(ctx, issueID, repository.IssueDependencyGraphMaxDepth+1)
+		assert.ErrorIs(t, err, ErrIssueDependencyDepth)
+
+		_, err = s.GetDependencyGraph(ctx, issueID, -1)
+		assert.ErrorIs(t, err, ErrIssueDependencyDepth)
+	})
+
+	t.Run("get dependency graph without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetDependencyGraph(ctx, issueID, 2)
+		assert.ErrorIs(t, err, ErrIssueGetDependencyGraph)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestIssueService_GetCriticalPath(t *testing.T) {
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	scheduled := func(key string, days int) *repository.PartialIssue {
+		due := start.Add(time.Duration(days) * day)
+		return &repository.PartialIssue{
+			ID:        model.MustNewID(model.ResourceTypeIssue),
+			Key:       key,
+			StartDate: &start,
+			DueDate:   &due,
+		}
+	}
+
+	t.Run("get critical path", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		design, build, docs := scheduled("TEST-1", 2), scheduled("TEST-2", 5), scheduled("TEST-3", 1)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetProjectDependencies(ctx, projectID).Return(&repository.IssueDependencyGraph{
+			Issues: []*repository.PartialIssue{design, build, docs},
+			Relations: []*repository.IssueRelation{
+				{Source: build.ID, Target: design.ID, Kind: model.IssueRelationKindBlockedBy},
+				{Source: design.ID, Target: docs.ID, Kind: model.IssueRelationKindBlocks},
+			},
+		}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueRead).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetCriticalPath(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, &CriticalPath{
+			Steps: []*CriticalPathStep{
+				{Issue: partialIssueFromRepository(design), Duration: 2 * day, EarliestFinish: 2 * day},
+				{Issue: partialIssueFromRepository(build), Duration: 5 * day, EarliestStart: 2 * day, EarliestFinish: 7 * day},
+			},
+			Duration: 7 * day,
+		}, got)
+	})
+
+	t.Run("get critical path with dependency cycle", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		a, b := scheduled("TEST-1", 1), scheduled("TEST-2", 1)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetProjectDependencies(ctx, projectID).Return(&repository.IssueDependencyGraph{
+			Issues: []*repository.PartialIssue{a, b},
+			Relations: []*repository.IssueRelation{
+				{Source: a.ID, Target: b.ID, Kind: model.IssueRelationKindBlocks},
+				{Source: a.ID, Target: b.ID, Kind: model.IssueRelationKindDependsOn},
+			},
+		}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueRead).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetCriticalPath(ctx, projectID)
+		assert.ErrorIs(t, err, ErrIssueGetCriticalPath)
+		assert.ErrorIs(t, err, model.ErrIssueDependencyCycle)
+	})
+
+	t.Run("get critical path without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetCriticalPath(ctx, projectID)
+		assert.ErrorIs(t, err, ErrIssueGetCriticalPath)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/opcotech/elemo/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -56,6 +57,36 @@ func (mr *MockIssueServiceMockRecorder) AddRelation(ctx, issueID, relatedID, kin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelation", reflect.TypeOf((*MockIssueService)(nil).AddRelation), ctx, issueID, relatedID, kind)
 }
 
+// Bulk mocks base method.
+func (m *MockIssueService) Bulk(ctx context.Context, opts BulkIssueOpts) ([]*BulkIssueResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", ctx, opts)
+	ret0, _ := ret[0].([]*BulkIssueResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockIssueServiceMockRecorder) Bulk(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockIssueService)(nil).Bulk), ctx, opts)
+}
+
+// Clone mocks base method.
+func (m *MockIssueService) Clone(ctx context.Context, id model.ID, opts CloneIssueOpts) (*Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, id, opts)
+	ret0, _ := ret[0].(*Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockIssueServiceMockRecorder) Clone(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockIssueService)(nil).Clone), ctx, id, opts)
+}
+
 // Create mocks base method.
 func (m *MockIssueService) Create(ctx context.Context, projectID model.ID, opts CreateIssueOpts) (*Issue, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +102,21 @@ func (mr *MockIssueServiceMockRecorder) Create(ctx, projectID, opts any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIssueService)(nil).Create), ctx, projectID, opts)
 }
 
+// CreateFromTemplate mocks base method.
+func (m *MockIssueService) CreateFromTemplate(ctx context.Context, templateID model.ID, opts CreateIssueFromTemplateOpts) (*Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromTemplate", ctx, templateID, opts)
+	ret0, _ := ret[0].(*Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromTemplate indicates an expected call of CreateFromTemplate.
+func (mr *MockIssueServiceMockRecorder) CreateFromTemplate(ctx, templateID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromTemplate", reflect.TypeOf((*MockIssueService)(nil).CreateFromTemplate), ctx, templateID, opts)
+}
+
 // Delete mocks base method.
 func (m *MockIssueService) Delete(ctx context.Context, id model.ID) error {
 	m.ctrl.T.Helper()
@@ -115,6 +161,66 @@ func (mr *MockIssueServiceMockRecorder) GetByKey(ctx, namespaceID, key any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockIssueService)(nil).GetByKey), ctx, namespaceID, key)
 }
 
+// GetCriticalPath mocks base method.
+func (m *MockIssueService) GetCriticalPath(ctx context.Context, projectID model.ID) (*CriticalPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCriticalPath", ctx, projectID)
+	ret0, _ := ret[0].(*CriticalPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCriticalPath indicates an expected call of GetCriticalPath.
+func (mr *MockIssueServiceMockRecorder) GetCriticalPath(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCriticalPath", reflect.TypeOf((*MockIssueService)(nil).GetCriticalPath), ctx, projectID)
+}
+
+// GetDependencyGraph mocks base method.
+func (m *MockIssueService) GetDependencyGraph(ctx context.Context, id model.ID, depth int) (*IssueDependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", ctx, id, depth)
+	ret0, _ := ret[0].(*IssueDependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockIssueServiceMockRecorder) GetDependencyGraph(ctx, id, depth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockIssueService)(nil).GetDependencyGraph), ctx, id, depth)
+}
+
+// GetRollups mocks base method.
+func (m *MockIssueService) GetRollups(ctx context.Context, ids []model.ID) ([]*IssueRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRollups", ctx, ids)
+	ret0, _ := ret[0].([]*IssueRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRollups indicates an expected call of GetRollups.
+func (mr *MockIssueServiceMockRecorder) GetRollups(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRollups", reflect.TypeOf((*MockIssueService)(nil).GetRollups), ctx, ids)
+}
+
+// GetWatchers mocks base method.
+func (m *MockIssueService) GetWatchers(ctx context.Context, issueID model.ID) ([]*PartialUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchers", ctx, issueID)
+	ret0, _ := ret[0].([]*PartialUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchers indicates an expected call of GetWatchers.
+func (mr *MockIssueServiceMockRecorder) GetWatchers(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchers", reflect.TypeOf((*MockIssueService)(nil).GetWatchers), ctx, issueID)
+}
+
 // List mocks base method.
 func (m *MockIssueService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*PartialIssue], error) {
 	m.ctrl.T.Helper()
@@ -130,6 +236,21 @@ func (mr *MockIssueServiceMockRecorder) List(ctx, projectID, page any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIssueService)(nil).List), ctx, projectID, page)
 }
 
+// ListActivity mocks base method.
+func (m *MockIssueService) ListActivity(ctx context.Context, issueID model.ID, page CursorPage) (Page[*IssueActivity], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, issueID, page)
+	ret0, _ := ret[0].(Page[*IssueActivity])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockIssueServiceMockRecorder) ListActivity(ctx, issueID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockIssueService)(nil).ListActivity), ctx, issueID, page)
+}
+
 // ListByNamespace mocks base method.
 func (m *MockIssueService) ListByNamespace(ctx context.Context, namespaceID model.ID, page CursorPage) (Page[*PartialIssue], error) {
 	m.ctrl.T.Helper()
@@ -160,6 +281,21 @@ func (mr *MockIssueServiceMockRecorder) ListByUser(ctx, userID, page any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockIssueService)(nil).ListByUser), ctx, userID, page)
 }
 
+// ListByView mocks base method.
+func (m *MockIssueService) ListByView(ctx context.Context, viewID model.ID, page CursorPage) (Page[*PartialIssue], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByView", ctx, viewID, page)
+	ret0, _ := ret[0].(Page[*PartialIssue])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByView indicates an expected call of ListByView.
+func (mr *MockIssueServiceMockRecorder) ListByView(ctx, viewID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByView", reflect.TypeOf((*MockIssueService)(nil).ListByView), ctx, viewID, page)
+}
+
 // ListRelations mocks base method.
 func (m *MockIssueService) ListRelations(ctx context.Context, issueID model.ID, page CursorPage) (Page[*IssueRelation], error) {
 	m.ctrl.T.Helper()
@@ -175,6 +311,66 @@ func (mr *MockIssueServiceMockRecorder) ListRelations(ctx, issueID, page any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelations", reflect.TypeOf((*MockIssueService)(nil).ListRelations), ctx, issueID, page)
 }
 
+// MaterializeRecurrences mocks base method.
+func (m *MockIssueService) MaterializeRecurrences(ctx context.Context, now time.Time) ([]*Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeRecurrences", ctx, now)
+	ret0, _ := ret[0].([]*Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeRecurrences indicates an expected call of MaterializeRecurrences.
+func (mr *MockIssueServiceMockRecorder) MaterializeRecurrences(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeRecurrences", reflect.TypeOf((*MockIssueService)(nil).MaterializeRecurrences), ctx, now)
+}
+
+// Move mocks base method.
+func (m *MockIssueService) Move(ctx context.Context, id, projectID model.ID) (*Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, id, projectID)
+	ret0, _ := ret[0].(*Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockIssueServiceMockRecorder) Move(ctx, id, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockIssueService)(nil).Move), ctx, id, projectID)
+}
+
+// MoveCard mocks base method.
+func (m *MockIssueService) MoveCard(ctx context.Context, id model.ID, opts MoveIssueCardOpts) (*IssueCardMove, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCard", ctx, id, opts)
+	ret0, _ := ret[0].(*IssueCardMove)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCard indicates an expected call of MoveCard.
+func (mr *MockIssueServiceMockRecorder) MoveCard(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCard", reflect.TypeOf((*MockIssueService)(nil).MoveCard), ctx, id, opts)
+}
+
+// Rank mocks base method.
+func (m *MockIssueService) Rank(ctx context.Context, id model.ID, opts RankIssueOpts) (*Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rank", ctx, id, opts)
+	ret0, _ := ret[0].(*Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rank indicates an expected call of Rank.
+func (mr *MockIssueServiceMockRecorder) Rank(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rank", reflect.TypeOf((*MockIssueService)(nil).Rank), ctx, id, opts)
+}
+
 // RemoveRelation mocks base method.
 func (m *MockIssueService) RemoveRelation(ctx context.Context, issueID, relationID model.ID) error {
 	m.ctrl.T.Helper()
@@ -189,6 +385,20 @@ func (mr *MockIssueServiceMockRecorder) RemoveRelation(ctx, issueID, relationID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRelation", reflect.TypeOf((*MockIssueService)(nil).RemoveRelation), ctx, issueID, relationID)
 }
 
+// Unwatch mocks base method.
+func (m *MockIssueService) Unwatch(ctx context.Context, issueID model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unwatch", ctx, issueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unwatch indicates an expected call of Unwatch.
+func (mr *MockIssueServiceMockRecorder) Unwatch(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unwatch", reflect.TypeOf((*MockIssueService)(nil).Unwatch), ctx, issueID)
+}
+
 // Update mocks base method.
 func (m *MockIssueService) Update(ctx context.Context, id model.ID, opts UpdateIssueOpts) (*Issue, error) {
 	m.ctrl.T.Helper()
@@ -218,3 +428,17 @@ func (mr *MockIssueServiceMockRecorder) UpdateRelation(ctx, issueID, relationID,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelation", reflect.TypeOf((*MockIssueService)(nil).UpdateRelation), ctx, issueID, relationID, kind)
 }
+
+// Watch mocks base method.
+func (m *MockIssueService) Watch(ctx context.Context, issueID model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, issueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockIssueServiceMockRecorder) Watch(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockIssueService)(nil).Watch), ctx, issueID)
+}
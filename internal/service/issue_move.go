@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+func (s *issueService) Move(ctx context.Context, id, projectID model.ID) (*Issue, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/Move")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueMove, license.ErrLicenseExpired)
+	}
+
+	if err := id.Validate(); err != nil || id.Type != model.ResourceTypeIssue {
+		return nil, errors.Join(ErrIssueMove, model.ErrInvalidID)
+	}
+	if err := projectID.Validate(); err != nil || projectID.Type != model.ResourceTypeProject {
+		return nil, errors.Join(ErrIssueMove, model.ErrInvalidID)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, id, model.ActionIssueUpdate) {
+		return nil, errors.Join(ErrIssueMove, ErrNoPermission)
+	}
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionIssueCreate) {
+		return nil, errors.Join(ErrIssueMove, ErrNoPermission)
+	}
+
+	issue, err := s.issueRepo.Get(ctx, id, repository.IssueProjection{})
+	if err != nil {
+		return nil, errors.Join(ErrIssueMove, err)
+	}
+	if issue.Project != nil && issue.Project.ID == projectID {
+		return nil, errors.Join(ErrIssueMove, ErrIssueMoveProject)
+	}
+
+	opts, err := s.issueMoveOpts(ctx, projectID)
+	if err != nil {
+		return nil, errors.Join(ErrIssueMove, err)
+	}
+
+	moved, err := s.issueRepo.Move(ctx, id, projectID, opts)
+	if err != nil {
+		return nil, errors.Join(ErrIssueMove, err)
+	}
+
+	ids := make([]model.ID, 0, len(moved))
+	activities := make([]repository.CreateIssueActivityOpts, 0, len(moved))
+	var scopeChanges []repository.CreateSprintScopeChangeOpts
+	for _, m := range moved {
+		ids = append(ids, m.ID)
+		activities = append(activities, fieldChangeActivity(m.ID, "key", []string{m.OldKey}, []string{m.NewKey}))
+		if m.OldStatus != m.NewStatus {
+			activities = append(activities, fieldChangeActivity(m.ID, "status", enumActivityValue(m.OldStatus), enumActivityValue(m.NewStatus)))
+		}
+		if oldStatus, newStatus := stringActivityValue(m.OldWorkflowStatus), stringActivityValue(m.NewWorkflowStatus); !slices.Equal(oldStatus, newStatus) {
+			activities = append(activities, fieldChangeActivity(m.ID, "workflow_status", oldStatus, newStatus))
+		}
+		for _, sprintID := range m.Sprints {
+			scopeChanges = append(scopeChanges, repository.CreateSprintScopeChangeOpts{
+				Sprint: sprintID,
+				Issue:  m.ID,
+				Kind:   repository.SprintScopeChangeKindRemoved,
+			})
+		}
+	}
+	s.recordIssueActivity(ctx, activities...)
+	s.recordScopeChanges(ctx, scopeChanges...)
+
+	// The moved issues are searchable by their new key and project, even if
+	// reading the moved issue back fails.
+	s.enqueueSearchIndexIDs(ctx, ids)
+
+	if issue, err = s.issueRepo.Get(ctx, id, repository.IssueDetailProjection()); err != nil {
+		return nil, errors.Join(ErrIssueMove, err)
+	}
+
+	out := issueFromRepository(issue)
+	if err := s.applyIssueCustomFields(ctx, out); err != nil {
+		return nil, errors.Join(ErrIssueMove, err)
+	}
+
+	changed := []string{"project"}
+	s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueUpdated, out.ID, changed)
+	s.publishEvent(ctx, &repository.Event{
+		Type:          EventTypeIssueUpdated,
+		Resource:      out.ID,
+		ChangedFields: changed,
+	})
+
+	return out, nil
+}
+
+// issueMoveOpts returns the fields the moved issues take from the target
+// project. The issues start over in the initial status of its workflow, or
+// lose their workflow status if it has none, so they follow the transitions
+// of the target workflow. Only the values of the custom fields the target
+// project defines are kept, even if the license does not include custom
+// fields, so their values are not lost.
+func (s *issueService) issueMoveOpts(ctx context.Context, projectID model.ID) (repository.MoveIssueOpts, error) {
+	var opts repository.MoveIssueOpts
+
+	workflow, err := s.projectWorkflow(ctx, projectID)
+	if err != nil {
+		return opts, err
+	}
+	if workflow != nil {
+		initial := workflow.InitialStatus()
+		opts.Status = initial.Category.IssueStatus()
+		opts.WorkflowStatus = &initial.Key
+	}
+
+	if s.customFieldRepo != nil {
+		fields, err := s.customFieldRepo.List(ctx, projectID)
+		if err != nil {
+			return opts, err
+		}
+		for _, field := range fields {
+			opts.CustomFields = append(opts.CustomFields, field.Key)
+		}
+	}
+
+	return opts, nil
+}
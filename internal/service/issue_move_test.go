@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestIssueService_Move(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	subIssueID := model.MustNewID(model.ResourceTypeIssue)
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+	sourceID := model.MustNewID(model.ResourceTypeProject)
+	targetID := model.MustNewID(model.ResourceTypeProject)
+
+	previous := testModel.NewRepositoryIssue(model.MustNewID(model.ResourceTypeUser))
+	previous.ID = issueID
+	previous.Project = &repository.PartialProject{ID: sourceID, Key: "MOB"}
+
+	moved := testModel.NewRepositoryIssue(model.MustNewID(model.ResourceTypeUser))
+	moved.ID = issueID
+	moved.Project = &repository.PartialProject{ID: targetID, Key: "WEB"}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		id        model.ID
+		projectID model.ID
+		want      *Issue
+		wantErr   error
+	}{
+		{
+			name: "move issue with sub-issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, issueID, repository.IssueProjection{}).Return(previous, nil)
+					issueRepo.EXPECT().Move(ctx, issueID, targetID, repository.MoveIssueOpts{}).Return([]*repository.MovedIssue{
+						{ID: issueID, OldKey: "MOB-12", NewKey: "WEB-3", Sprints: []model.ID{sprintID}},
+						{ID: subIssueID, OldKey: "MOB-13", NewKey: "WEB-4", Sprints: []model.ID{}},
+					}, nil)
+					issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(moved, nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, targetID, model.ActionIssueCreate).Return(true)
+
+					key := "key"
+					activityRepo := repository.NewMockIssueActivityRepository(ctrl)
+					activityRepo.EXPECT().Create(ctx, []repository.CreateIssueActivityOpts{
+						{Issue: issueID, Kind: repository.IssueActivityKindFieldChanged, Field: &key, OldValue: []string{"MOB-12"}, NewValue: []string{"WEB-3"}},
+						{Issue: subIssueID, Kind: repository.IssueActivityKindFieldChanged, Field: &key, OldValue: []string{"MOB-13"}, NewValue: []string{"WEB-4"}},
+					}).Return(nil, nil)
+
+					scopeChangeRepo := repository.NewMockSprintScopeChangeRepository(ctrl)
+					scopeChangeRepo.EXPECT().Create(ctx, []repository.CreateSprintScopeChangeOpts{
+						{Sprint: sprintID, Issue: issueID, Kind: repository.SprintScopeChangeKindRemoved},
+					}).Return(nil, nil)
+
+					searchSvc := NewMockSearchService(ctrl)
+					searchSvc.EXPECT().EnqueueIndexIDs(ctx, issueID, subIssueID).Return(nil)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						issueActivityRepo: activityRepo,
+						scopeChangeRepo:   scopeChangeRepo,
+						permissionService: permSvc,
+						searchService:     searchSvc,
+					}
+				},
+			},
+			id:        issueID,
+			projectID: targetID,
+			want:      issueFromRepository(moved),
+		},
+		{
+			name: "move issue to a project with workflow and custom fields",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					initial := "backlog"
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, issueID, repository.IssueProjection{}).Return(previous, nil)
+					issueRepo.EXPECT().Move(ctx, issueID, targetID, repository.MoveIssueOpts{
+						Status:         model.IssueStatusOpen,
+						WorkflowStatus: &initial,
+						CustomFields:   []string{"customer", "points", "platforms"},
+					}).Return([]*repository.MovedIssue{
+						{
+							ID:                issueID,
+							OldKey:            "MOB-12",
+							NewKey:            "WEB-3",
+							OldStatus:         model.IssueStatusDone,
+							NewStatus:         model.IssueStatusOpen,
+							NewWorkflowStatus: &initial,
+						},
+					}, nil)
+					issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(moved, nil)
+
+					workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+					workflowRepo.EXPECT().Get(ctx, targetID).Return(newTestRepositoryWorkflow(targetID), nil)
+
+					customFieldRepo := repository.NewMockCustomFieldRepository(ctrl)
+					customFieldRepo.EXPECT().List(ctx, targetID).Return(newTestCustomFields(), nil)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+					licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, targetID, model.ActionIssueCreate).Return(true)
+
+					key, status, workflowStatus := "key", "status", "workflow_status"
+					activityRepo := repository.NewMockIssueActivityRepository(ctrl)
+					activityRepo.EXPECT().Create(ctx, []repository.CreateIssueActivityOpts{
+						{Issue: issueID, Kind: repository.IssueActivityKindFieldChanged, Field: &key, OldValue: []string{"MOB-12"}, NewValue: []string{"WEB-3"}},
+						{Issue: issueID, Kind: repository.IssueActivityKindFieldChanged, Field: &status, OldValue: []string{"done"}, NewValue: []string{"open"}},
+						{Issue: issueID, Kind: repository.IssueActivityKindFieldChanged, Field: &workflowStatus, NewValue: []string{"backlog"}},
+					}).Return(nil, nil)
+
+					searchSvc := NewMockSearchService(ctrl)
+					searchSvc.EXPECT().EnqueueIndexIDs(ctx, issueID).Return(nil)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						issueActivityRepo: activityRepo,
+						workflowRepo:      workflowRepo,
+						customFieldRepo:   customFieldRepo,
+						permissionService: permSvc,
+						licenseService:    licenseSvc,
+						searchService:     searchSvc,
+					}
+				},
+			},
+			id:        issueID,
+			projectID: targetID,
+			want:      issueFromRepository(moved),
+		},
+		{
+			name: "move issue to its own project",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, issueID, repository.IssueProjection{}).Return(previous, nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, sourceID, model.ActionIssueCreate).Return(true)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			id:        issueID,
+			projectID: sourceID,
+			wantErr:   ErrIssueMoveProject,
+		},
+		{
+			name: "move issue without permission on the target project",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, targetID, model.ActionIssueCreate).Return(false)
+
+					return &baseService{permissionService: permSvc}
+				},
+			},
+			id:        issueID,
+			projectID: targetID,
+			wantErr:   ErrNoPermission,
+		},
+		{
+			name: "move issue without permission on the issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(false)
+
+					return &baseService{permissionService: permSvc}
+				},
+			},
+			id:        issueID,
+			projectID: targetID,
+			wantErr:   ErrNoPermission,
+		},
+		{
+			name: "move issue with failing write",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, issueID, repository.IssueProjection{}).Return(previous, nil)
+					issueRepo.EXPECT().Move(ctx, issueID, targetID, repository.MoveIssueOpts{}).Return(nil, repository.ErrIssueMove)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, targetID, model.ActionIssueCreate).Return(true)
+
+					return &baseService{
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			id:        issueID,
+			projectID: targetID,
+			wantErr:   repository.ErrIssueMove,
+		},
+		{
+			name: "move issue with license expired",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(true, nil)
+					return &baseService{licenseService: licenseSvc}
+				},
+			},
+			id:        issueID,
+			projectID: targetID,
+			wantErr:   license.ErrLicenseExpired,
+		},
+		{
+			name:      "move issue with invalid project id",
+			id:        issueID,
+			projectID: model.MustNewID(model.ResourceTypeNamespace),
+			wantErr:   model.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			span := mock.NewMockSpan(ctrl)
+			span.EXPECT().End(gomock.Len(0))
+
+			tracer := mock.NewMockTracer(ctrl)
+			tracer.EXPECT().Start(ctx, "service.issueService/Move", gomock.Len(0)).Return(ctx, span)
+
+			base := &baseService{}
+			if tt.fields.baseService != nil {
+				base = tt.fields.baseService(ctrl, ctx)
+			}
+			if base.licenseService == nil {
+				licenseSvc := mock.NewMockLicenseService(ctrl)
+				licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+				base.licenseService = licenseSvc
+			}
+			base.logger = mock.NewMockLogger(ctrl)
+			base.tracer = tracer
+
+			s := &issueService{baseService: base}
+			got, err := s.Move(ctx, tt.id, tt.projectID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, ErrIssueMove)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
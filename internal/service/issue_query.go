@@ -0,0 +1,350 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const issueQueryDateLayout = "2006-01-02"
+
+// IssueQuerySyntaxError is an error at a position of an issue query.
+type IssueQuerySyntaxError struct {
+	Position int    // byte offset of the invalid part of the query
+	Length   int    // length of the invalid part in bytes
+	Message  string // description of the error
+}
+
+func (e IssueQuerySyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+// IssueQueryError lists the syntax errors of an issue query. It wraps
+// ErrIssueQuery.
+type IssueQueryError struct {
+	Errors []IssueQuerySyntaxError
+}
+
+func (e *IssueQueryError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return ErrIssueQuery.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *IssueQueryError) Unwrap() error {
+	return ErrIssueQuery
+}
+
+// issueQueryFields maps the fields of the issue query language to the fields
+// of the issue list conditions.
+var issueQueryFields = map[string]repository.IssueListConditionField{
+	"status":   repository.IssueListConditionFieldStatus,
+	"workflow": repository.IssueListConditionFieldWorkflowStatus,
+	"priority": repository.IssueListConditionFieldPriority,
+	"kind":     repository.IssueListConditionFieldKind,
+	"assignee": repository.IssueListConditionFieldAssignee,
+	"label":    repository.IssueListConditionFieldLabel,
+	"parent":   repository.IssueListConditionFieldParent,
+	"due":      repository.IssueListConditionFieldDueDate,
+	"start":    repository.IssueListConditionFieldStartDate,
+	"created":  repository.IssueListConditionFieldCreatedAt,
+	"updated":  repository.IssueListConditionFieldUpdatedAt,
+}
+
+// issueQueryOperators lists the operators of the issue query language, the
+// longer operators first.
+var issueQueryOperators = []struct {
+	token    string
+	operator repository.IssueListOperator
+	negated  bool
+}{
+	{"!=", repository.IssueListOperatorIn, true},
+	{"<=", repository.IssueListOperatorLte, false},
+	{">=", repository.IssueListOperatorGte, false},
+	{":", repository.IssueListOperatorIn, false},
+	{"=", repository.IssueListOperatorIn, false},
+	{"<", repository.IssueListOperatorLt, false},
+	{">", repository.IssueListOperatorGt, false},
+}
+
+type issueQueryToken struct {
+	pos  int
+	text string
+	raw  string
+}
+
+type issueQueryParser struct {
+	query      string
+	pos        int
+	userID     model.ID
+	conditions []repository.IssueListCondition
+	errors     []IssueQuerySyntaxError
+}
+
+// parseIssueQuery parses the issue query into issue list conditions. The
+// query is a whitespace separated list of terms, which all have to match. A
+// term is either a word the issues have to contain or a condition on a field
+// of the issues, like status:open,review, workflow:code_review or
+// due<2026-12-01. The values of a condition are separated by commas and match
+// if any of them does. Words and values containing whitespace are quoted, and
+// terms prefixed with a minus are negated. The assignee "me" refers to the
+// user with the given ID.
+func parseIssueQuery(query string, userID model.ID) ([]repository.IssueListCondition, error) {
+	p := &issueQueryParser{query: query, userID: userID}
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			break
+		}
+		p.parseTerm()
+	}
+
+	if len(p.errors) > 0 {
+		return nil, &IssueQueryError{Errors: p.errors}
+	}
+	return p.conditions, nil
+}
+
+func (p *issueQueryParser) parseTerm() {
+	start := p.pos
+	negated := false
+	if p.query[p.pos] == '-' {
+		negated = true
+		p.pos++
+		if p.eof() || p.space() {
+			p.fail(start, 1, "missing term after negation")
+			return
+		}
+	}
+
+	nameStart := p.pos
+	for !p.eof() && isIssueQueryFieldChar(p.query[p.pos]) {
+		p.pos++
+	}
+	name := p.query[nameStart:p.pos]
+
+	token, operator, opNegated, ok := p.scanOperator()
+	if name == "" || !ok {
+		p.pos = nameStart
+		p.parseWord(negated)
+		return
+	}
+
+	field, known := issueQueryFields[strings.ToLower(name)]
+	if !known {
+		p.fail(nameStart, len(name), fmt.Sprintf("unknown field %q", name))
+		p.skipTerm()
+		return
+	}
+
+	values := p.scanValues()
+	if !p.eof() && !p.space() {
+		p.fail(p.pos, 1, fmt.Sprintf("unexpected character %q", p.query[p.pos]))
+		p.skipTerm()
+		return
+	}
+	if len(values) == 0 {
+		return
+	}
+
+	if operator != repository.IssueListOperatorIn {
+		if !field.Ordered() {
+			p.fail(nameStart, len(name)+len(token), fmt.Sprintf("field %q cannot be compared with %q", name, token))
+			return
+		}
+		if len(values) > 1 {
+			p.fail(values[1].pos, values[len(values)-1].pos+len(values[len(values)-1].raw)-values[1].pos, fmt.Sprintf("operator %q takes a single value", token))
+			return
+		}
+	}
+
+	condition := repository.IssueListCondition{
+		Field:    field,
+		Operator: operator,
+		Values:   make([]string, 0, len(values)),
+		Negated:  negated != opNegated,
+	}
+	for _, value := range values {
+		parsed, err := p.parseValue(field, value.text)
+		if err != nil {
+			p.fail(value.pos, len(value.raw), err.Error())
+			continue
+		}
+		condition.Values = append(condition.Values, parsed)
+	}
+	p.conditions = append(p.conditions, condition)
+}
+
+func (p *issueQueryParser) parseWord(negated bool) {
+	var word issueQueryToken
+	if p.query[p.pos] == '"' {
+		var ok bool
+		if word, ok = p.scanQuoted(); !ok {
+			return
+		}
+	} else {
+		start := p.pos
+		for !p.eof() && !p.space() {
+			p.pos++
+		}
+		word = issueQueryToken{pos: start, text: p.query[start:p.pos], raw: p.query[start:p.pos]}
+	}
+
+	if word.text == "" {
+		return
+	}
+	p.conditions = append(p.conditions, repository.IssueListCondition{
+		Field:    repository.IssueListConditionFieldText,
+		Operator: repository.IssueListOperatorIn,
+		Values:   []string{word.text},
+		Negated:  negated,
+	})
+}
+
+func (p *issueQueryParser) scanOperator() (string, repository.IssueListOperator, bool, bool) {
+	for _, op := range issueQueryOperators {
+		if strings.HasPrefix(p.query[p.pos:], op.token) {
+			p.pos += len(op.token)
+			return op.token, op.operator, op.negated, true
+		}
+	}
+	return "", "", false, false
+}
+
+func (p *issueQueryParser) scanValues() []issueQueryToken {
+	values := make([]issueQueryToken, 0, 1)
+	for {
+		var value issueQueryToken
+		if !p.eof() && p.query[p.pos] == '"' {
+			var ok bool
+			if value, ok = p.scanQuoted(); !ok {
+				return nil
+			}
+		} else {
+			start := p.pos
+			for !p.eof() && !p.space() && p.query[p.pos] != ',' && p.query[p.pos] != '"' {
+				p.pos++
+			}
+			value = issueQueryToken{pos: start, text: p.query[start:p.pos], raw: p.query[start:p.pos]}
+		}
+
+		if value.text == "" {
+			p.fail(value.pos, len(value.raw), "missing value")
+			p.skipTerm()
+			return nil
+		}
+		values = append(values, value)
+
+		if p.eof() || p.query[p.pos] != ',' {
+			return values
+		}
+		p.pos++
+	}
+}
+
+func (p *issueQueryParser) scanQuoted() (issueQueryToken, bool) {
+	start := p.pos
+	end := strings.IndexByte(p.query[start+1:], '"')
+	if end < 0 {
+		p.fail(start, len(p.query)-start, "unterminated quoted string")
+		p.pos = len(p.query)
+		return issueQueryToken{}, false
+	}
+
+	p.pos = start + end + 2
+	return issueQueryToken{pos: start, text: p.query[start+1 : start+end+1], raw: p.query[start:p.pos]}, true
+}
+
+// parseValue validates the value of the field and returns it in the form the
+// issue list conditions expect.
+func (p *issueQueryParser) parseValue(field repository.IssueListConditionField, value string) (string, error) {
+	switch field {
+	case repository.IssueListConditionFieldStatus:
+		status, err := model.IssueStatusString(strings.ReplaceAll(value, "_", " "))
+		if err != nil {
+			return "", fmt.Errorf("unknown status %q", value)
+		}
+		return status.String(), nil
+	case repository.IssueListConditionFieldWorkflowStatus:
+		key := strings.ToLower(value)
+		if !model.IsWorkflowStatusKey(key) {
+			return "", fmt.Errorf("invalid workflow status %q", value)
+		}
+		return key, nil
+	case repository.IssueListConditionFieldPriority:
+		priority, err := model.IssuePriorityString(value)
+		if err != nil {
+			return "", fmt.Errorf("unknown priority %q", value)
+		}
+		return priority.String(), nil
+	case repository.IssueListConditionFieldKind:
+		kind, err := model.IssueKindString(value)
+		if err != nil {
+			return "", fmt.Errorf("unknown kind %q", value)
+		}
+		return kind.String(), nil
+	case repository.IssueListConditionFieldAssignee:
+		if strings.EqualFold(value, "me") {
+			return p.userID.String(), nil
+		}
+		id, err := model.NewIDFromString(value, model.ResourceTypeUser.String())
+		if err != nil {
+			return "", fmt.Errorf("invalid user %q", value)
+		}
+		return id.String(), nil
+	case repository.IssueListConditionFieldLabel:
+		return value, nil
+	case repository.IssueListConditionFieldParent:
+		if projectKey, numericID, err := model.ParseIssueKey(value); err == nil {
+			return model.FormatIssueKey(projectKey, numericID), nil
+		}
+		id, err := model.NewIDFromString(value, model.ResourceTypeIssue.String())
+		if err != nil {
+			return "", fmt.Errorf("invalid issue %q", value)
+		}
+		return id.String(), nil
+	default:
+		if _, err := time.Parse(issueQueryDateLayout, value); err != nil {
+			return "", fmt.Errorf("invalid date %q, expected YYYY-MM-DD", value)
+		}
+		return value, nil
+	}
+}
+
+func (p *issueQueryParser) fail(pos, length int, message string) {
+	p.errors = append(p.errors, IssueQuerySyntaxError{Position: pos, Length: length, Message: message})
+}
+
+func (p *issueQueryParser) skipTerm() {
+	for !p.eof() && !p.space() {
+		p.pos++
+	}
+}
+
+func (p *issueQueryParser) skipSpaces() {
+	for !p.eof() && p.space() {
+		p.pos++
+	}
+}
+
+func (p *issueQueryParser) eof() bool {
+	return p.pos >= len(p.query)
+}
+
+func (p *issueQueryParser) space() bool {
+	switch p.query[p.pos] {
+	case ' ', '\t', '\n', '\r':
+		return true
+	default:
+		return false
+	}
+}
+
+func isIssueQueryFieldChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+func TestParseIssueQuery(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []repository.IssueListCondition
+	}{
+		{
+			name:  "empty query",
+			query: "  ",
+			want:  nil,
+		},
+		{
+			name:  "conditions and words",
+			query: `status:open,in_progress priority>=high assignee:me label:backend kind:bug due<2026-12-01 parent:mob-4 -label:wontdo login`,
+			want: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldStatus, Operator: repository.IssueListOperatorIn, Values: []string{"open", "in progress"}},
+				{Field: repository.IssueListConditionFieldPriority, Operator: repository.IssueListOperatorGte, Values: []string{"high"}},
+				{Field: repository.IssueListConditionFieldAssignee, Operator: repository.IssueListOperatorIn, Values: []string{userID.String()}},
+				{Field: repository.IssueListConditionFieldLabel, Operator: repository.IssueListOperatorIn, Values: []string{"backend"}},
+				{Field: repository.IssueListConditionFieldKind, Operator: repository.IssueListOperatorIn, Values: []string{"bug"}},
+				{Field: repository.IssueListConditionFieldDueDate, Operator: repository.IssueListOperatorLt, Values: []string{"2026-12-01"}},
+				{Field: repository.IssueListConditionFieldParent, Operator: repository.IssueListOperatorIn, Values: []string{"MOB-4"}},
+				{Field: repository.IssueListConditionFieldLabel, Operator: repository.IssueListOperatorIn, Values: []string{"wontdo"}, Negated: true},
+				{Field: repository.IssueListConditionFieldText, Operator: repository.IssueListOperatorIn, Values: []string{"login"}},
+			},
+		},
+		{
+			name:  "quoted values and words",
+			query: `status:"in progress" label:"needs review" -"sign up"`,
+			want: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldStatus, Operator: repository.IssueListOperatorIn, Values: []string{"in progress"}},
+				{Field: repository.IssueListConditionFieldLabel, Operator: repository.IssueListOperatorIn, Values: []string{"needs review"}},
+				{Field: repository.IssueListConditionFieldText, Operator: repository.IssueListOperatorIn, Values: []string{"sign up"}, Negated: true},
+			},
+		},
+		{
+			name:  "not equal operator negates the condition",
+			query: "kind!=epic -status!=done parent:" + issueID.String(),
+			want: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldKind, Operator: repository.IssueListOperatorIn, Values: []string{"epic"}, Negated: true},
+				{Field: repository.IssueListConditionFieldStatus, Operator: repository.IssueListOperatorIn, Values: []string{"done"}},
+				{Field: repository.IssueListConditionFieldParent, Operator: repository.IssueListOperatorIn, Values: []string{issueID.String()}},
+			},
+		},
+		{
+			name:  "workflow statuses",
+			query: "workflow:code_review,QA -workflow:blocked",
+			want: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldWorkflowStatus, Operator: repository.IssueListOperatorIn, Values: []string{"code_review", "qa"}},
+				{Field: repository.IssueListConditionFieldWorkflowStatus, Operator: repository.IssueListOperatorIn, Values: []string{"blocked"}, Negated: true},
+			},
+		},
+		{
+			name:  "words that are not conditions",
+			query: "re-open 404",
+			want: []repository.IssueListCondition{
+				{Field: repository.IssueListConditionFieldText, Operator: repository.IssueListOperatorIn, Values: []string{"re-open"}},
+				{Field: repository.IssueListConditionFieldText, Operator: repository.IssueListOperatorIn, Values: []string{"404"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseIssueQuery(tt.query, userID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseIssueQuery_errors(t *testing.T) {
+	userID := model.MustNewID(model.ResourceTypeUser)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []IssueQuerySyntaxError
+	}{
+		{
+			name:  "unknown field",
+			query: "status:open color:red",
+			want:  []IssueQuerySyntaxError{{Position: 12, Length: 5, Message: `unknown field "color"`}},
+		},
+		{
+			name:  "invalid values",
+			query: "status:open,started priority:urgent",
+			want: []IssueQuerySyntaxError{
+				{Position: 12, Length: 7, Message: `unknown status "started"`},
+				{Position: 29, Length: 6, Message: `unknown priority "urgent"`},
+			},
+		},
+		{
+			name:  "missing value",
+			query: "label: kind:bug,",
+			want: []IssueQuerySyntaxError{
+				{Position: 6, Length: 0, Message: "missing value"},
+				{Position: 16, Length: 0, Message: "missing value"},
+			},
+		},
+		{
+			name:  "invalid workflow status",
+			query: `workflow:"code review",2nd_review`,
+			want: []IssueQuerySyntaxError{
+				{Position: 9, Length: 13, Message: `invalid workflow status "code review"`},
+				{Position: 23, Length: 10, Message: `invalid workflow status "2nd_review"`},
+			},
+		},
+		{
+			name:  "comparison of workflow status",
+			query: "workflow>code_review",
+			want:  []IssueQuerySyntaxError{{Position: 0, Length: 9, Message: `field "workflow" cannot be compared with ">"`}},
+		},
+		{
+			name:  "comparison of unordered field",
+			query: "kind>bug",
+			want:  []IssueQuerySyntaxError{{Position: 0, Length: 5, Message: `field "kind" cannot be compared with ">"`}},
+		},
+		{
+			name:  "comparison with many values",
+			query: "due<2026-01-01,2026-02-01",
+			want:  []IssueQuerySyntaxError{{Position: 15, Length: 10, Message: `operator "<" takes a single value`}},
+		},
+		{
+			name:  "invalid date and user",
+			query: "due<tomorrow assignee:someone",
+			want: []IssueQuerySyntaxError{
+				{Position: 4, Length: 8, Message: `invalid date "tomorrow", expected YYYY-MM-DD`},
+				{Position: 22, Length: 7, Message: `invalid user "someone"`},
+			},
+		},
+		{
+			name:  "unterminated quote",
+			query: `label:"needs review`,
+			want:  []IssueQuerySyntaxError{{Position: 6, Length: 13, Message: "unterminated quoted string"}},
+		},
+		{
+			name:  "unexpected character after value",
+			query: `label:"a"b`,
+			want:  []IssueQuerySyntaxError{{Position: 9, Length: 1, Message: `unexpected character 'b'`}},
+		},
+		{
+			name:  "missing negated term",
+			query: "login - bug",
+			want:  []IssueQuerySyntaxError{{Position: 6, Length: 1, Message: "missing term after negation"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parseIssueQuery(tt.query, userID)
+			require.ErrorIs(t, err, ErrIssueQuery)
+
+			var queryErr *IssueQueryError
+			require.ErrorAs(t, err, &queryErr)
+			assert.Equal(t, tt.want, queryErr.Errors)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	// issueRecurrenceBatchSize is the number of due recurrences materialized
+	// in one run. The rest is materialized by the following runs.
+	issueRecurrenceBatchSize = 100
+)
+
+// IssueRecurrence represents the recurrence of an issue template returned by
+// the service. The issues are created from the template on the occurrences
+// of the rule, on behalf of the creator of the recurrence.
+type IssueRecurrence struct {
+	Template         model.ID
+	Rule             string
+	StartsAt         time.Time
+	Creator          model.ID
+	NextOccurrenceAt *time.Time
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+}
+
+// SetIssueRecurrenceOpts holds the data required to set the recurrence of an
+// issue template. The rule is a cron expression or an RRULE, starting now if
+// no start is given.
+type SetIssueRecurrenceOpts struct {
+	Rule     string     `json:"rule" validate:"required,min=1,max=500"`
+	StartsAt *time.Time `json:"starts_at" validate:"omitempty"`
+}
+
+// Validate validates the options.
+func (o *SetIssueRecurrenceOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidIssueRecurrenceDetails, err)
+	}
+	return nil
+}
+
+func issueRecurrenceFromRepository(r *repository.IssueRecurrence) *IssueRecurrence {
+	if r == nil {
+		return nil
+	}
+	return &IssueRecurrence{
+		Template:         r.Template,
+		Rule:             r.Rule,
+		StartsAt:         r.StartsAt,
+		Creator:          r.Creator,
+		NextOccurrenceAt: r.NextOccurrenceAt,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
+
+func (s *issueTemplateService) GetRecurrence(ctx context.Context, templateID model.ID) (*IssueRecurrence, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/GetRecurrence")
+	defer span.End()
+
+	if s.recurrenceRepo == nil {
+		return nil, errors.Join(ErrIssueRecurrenceGet, ErrNoIssueRecurrenceRepository)
+	}
+
+	if _, err := s.getForAction(ctx, templateID, model.ActionProjectRead); err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceGet, err)
+	}
+
+	recurrence, err := s.recurrenceRepo.Get(ctx, templateID)
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceGet, err)
+	}
+
+	return issueRecurrenceFromRepository(recurrence), nil
+}
+
+func (s *issueTemplateService) SetRecurrence(ctx context.Context, templateID model.ID, opts SetIssueRecurrenceOpts) (*IssueRecurrence, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/SetRecurrence")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceSet, license.ErrLicenseExpired)
+	}
+
+	if s.recurrenceRepo == nil {
+		return nil, errors.Join(ErrIssueRecurrenceSet, ErrNoIssueRecurrenceRepository)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceSet, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrIssueRecurrenceSet, ErrNoUser)
+	}
+
+	template, err := s.getForAction(ctx, templateID, model.ActionProjectUpdate)
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceSet, err)
+	}
+
+	// The issues are created on behalf of the user setting the recurrence.
+	if !s.permissionService.CtxUserHas(ctx, template.Project, model.ActionIssueCreate) {
+		return nil, errors.Join(ErrIssueRecurrenceSet, ErrNoPermission)
+	}
+
+	now := time.Now().UTC()
+	start := now.Truncate(time.Minute)
+	if opts.StartsAt != nil {
+		start = *opts.StartsAt
+	}
+
+	rule, err := model.ParseRecurrenceRule(opts.Rule, start)
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceSet, err)
+	}
+
+	// Only the occurrences from now on are materialized.
+	next := rule.Next(now)
+	if next.IsZero() {
+		return nil, errors.Join(ErrIssueRecurrenceSet, model.ErrInvalidIssueRecurrenceDetails)
+	}
+
+	recurrence, err := s.recurrenceRepo.Save(ctx, repository.SaveIssueRecurrenceOpts{
+		Template:         templateID,
+		Rule:             rule.String(),
+		StartsAt:         rule.Start(),
+		Creator:          userID,
+		NextOccurrenceAt: &next,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceSet, err)
+	}
+
+	return issueRecurrenceFromRepository(recurrence), nil
+}
+
+func (s *issueTemplateService) DeleteRecurrence(ctx context.Context, templateID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/DeleteRecurrence")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrIssueRecurrenceDelete, license.ErrLicenseExpired)
+	}
+
+	if s.recurrenceRepo == nil {
+		return errors.Join(ErrIssueRecurrenceDelete, ErrNoIssueRecurrenceRepository)
+	}
+
+	if _, err := s.getForAction(ctx, templateID, model.ActionProjectUpdate); err != nil {
+		return errors.Join(ErrIssueRecurrenceDelete, err)
+	}
+
+	if err := s.recurrenceRepo.Delete(ctx, templateID); err != nil {
+		return errors.Join(ErrIssueRecurrenceDelete, err)
+	}
+
+	return nil
+}
+
+func (s *issueService) MaterializeRecurrences(ctx context.Context, now time.Time) ([]*Issue, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/MaterializeRecurrences")
+	defer span.End()
+
+	if s.issueTemplateRepo == nil {
+		return nil, errors.Join(ErrIssueRecurrenceMaterialize, ErrNoIssueTemplateRepository)
+	}
+
+	if s.recurrenceRepo == nil {
+		return nil, errors.Join(ErrIssueRecurrenceMaterialize, ErrNoIssueRecurrenceRepository)
+	}
+
+	recurrences, err := s.recurrenceRepo.ListDue(ctx, now, issueRecurrenceBatchSize)
+	if err != nil {
+		return nil, errors.Join(ErrIssueRecurrenceMaterialize, err)
+	}
+
+	issues := make([]*Issue, 0, len(recurrences))
+	for _, recurrence := range recurrences {
+		issue, err := s.materializeRecurrence(ctx, recurrence, now)
+		if err != nil {
+			s.logger.Error(ctx, "failed to materialize issue recurrence",
+				log.WithError(err),
+				log.WithValue(recurrence.Template.Composite()),
+			)
+		}
+		if issue != nil {
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues, nil
+}
+
+// materializeRecurrence creates the issue of the latest due occurrence of the
+// recurrence, skipping the occurrences missed before, then advances the
+// recurrence to its next occurrence. The occurrence is claimed before the
+// issue is created, so it is created at most once, even if the recurrence
+// is materialized concurrently or again after a failure.
+//
+// The claim is released if the issue cannot be created, and the occurrence
+// is retried by the next run, unless the creator of the recurrence has no
+// permission to create the issue anymore. Once the issue is created, the
+// claim is kept even if its labels or children cannot be created, so the
+// issue is never created twice.
+func (s *issueService) materializeRecurrence(ctx context.Context, recurrence *repository.IssueRecurrence, now time.Time) (*Issue, error) {
+	from := *recurrence.NextOccurrenceAt
+
+	rule, err := model.ParseRecurrenceRule(recurrence.Rule, recurrence.StartsAt)
+	if err != nil {
+		return nil, errors.Join(err, s.recurrenceRepo.Advance(ctx, recurrence.Template, from, nil))
+	}
+
+	occursAt := rule.Latest(from, now)
+
+	claimed, err := s.recurrenceRepo.ClaimOccurrence(ctx, recurrence.Template, occursAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue *Issue
+	var createErr error
+	if claimed {
+		issue, createErr = s.createFromRecurrence(ctx, recurrence, occursAt)
+		if issue == nil {
+			if err := s.recurrenceRepo.ReleaseOccurrence(ctx, recurrence.Template, occursAt); err != nil {
+				return nil, errors.Join(createErr, err)
+			}
+			if !errors.Is(createErr, ErrNoPermission) {
+				return nil, createErr
+			}
+		} else if err := s.recurrenceRepo.SetOccurrenceIssue(ctx, recurrence.Template, occursAt, issue.ID); err != nil {
+			return issue, errors.Join(createErr, err)
+		}
+	}
+
+	var next *time.Time
+	if n := rule.Next(occursAt); !n.IsZero() {
+		next = &n
+	}
+
+	if err := s.recurrenceRepo.Advance(ctx, recurrence.Template, from, next); err != nil {
+		return issue, err
+	}
+
+	return issue, createErr
+}
+
+// createFromRecurrence creates the issue of the occurrence from the template
+// of the recurrence on behalf of its creator. The date of the occurrence
+// replaces the title placeholder of the template, or it follows the name of
+// the template if the template has no title pattern.
+func (s *issueService) createFromRecurrence(ctx context.Context, recurrence *repository.IssueRecurrence, occursAt time.Time) (*Issue, error) {
+	template, err := s.issueTemplateRepo.Get(ctx, recurrence.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.TitlePattern == "" {
+		template.TitlePattern = template.Name + " " + model.IssueTemplateTitlePlaceholder
+	}
+
+	ctx = context.WithValue(ctx, pkg.CtxKeyUserID, recurrence.Creator)
+	return s.createFromTemplate(ctx, template, occursAt.Format(time.DateOnly))
+}
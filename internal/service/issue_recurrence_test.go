@@ -0,0 +1,515 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func newTestIssueRecurrence(templateID, creatorID model.ID, next time.Time) *repository.IssueRecurrence {
+	return &repository.IssueRecurrence{
+		Template:         templateID,
+		Rule:             "FREQ=WEEKLY",
+		StartsAt:         time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC),
+		Creator:          creatorID,
+		NextOccurrenceAt: &next,
+		CreatedAt:        convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestIssueTemplateService_GetRecurrence(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	template := newTestIssueTemplate(projectID)
+	recurrence := newTestIssueRecurrence(template.ID, model.MustNewID(model.ResourceTypeUser), time.Now().UTC())
+
+	templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+	templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+	recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+	recurrenceRepo.EXPECT().Get(ctx, template.ID).Return(recurrence, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+	s := &issueTemplateService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/GetRecurrence"),
+		issueTemplateRepo: templateRepo,
+		recurrenceRepo:    recurrenceRepo,
+		permissionService: permSvc,
+	}}
+
+	got, err := s.GetRecurrence(ctx, template.ID)
+	require.NoError(t, err)
+	assert.Equal(t, issueRecurrenceFromRepository(recurrence), got)
+}
+
+func TestIssueTemplateService_SetRecurrence(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("set issue recurrence", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		template := newTestIssueTemplate(projectID)
+		start := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+		recurrenceRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts repository.SaveIssueRecurrenceOpts) (*repository.IssueRecurrence, error) {
+			assert.Equal(t, template.ID, opts.Template)
+			assert.Equal(t, "FREQ=WEEKLY;BYHOUR=9", opts.Rule)
+			assert.Equal(t, start, opts.StartsAt)
+			assert.Equal(t, userID, opts.Creator)
+			require.NotNil(t, opts.NextOccurrenceAt)
+			assert.True(t, opts.NextOccurrenceAt.After(time.Now()))
+			assert.Equal(t, time.Monday, opts.NextOccurrenceAt.Weekday())
+			return newTestIssueRecurrence(opts.Template, opts.Creator, *opts.NextOccurrenceAt), nil
+		})
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(true)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/SetRecurrence"),
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    recurrenceRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.SetRecurrence(ctx, template.ID, SetIssueRecurrenceOpts{Rule: "FREQ=WEEKLY;BYHOUR=9", StartsAt: &start})
+		require.NoError(t, err)
+		assert.Equal(t, template.ID, got.Template)
+		assert.Equal(t, userID, got.Creator)
+	})
+
+	t.Run("set issue recurrence without issue create permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		template := newTestIssueTemplate(projectID)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(false)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/SetRecurrence"),
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    repository.NewMockIssueRecurrenceRepository(ctrl),
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.SetRecurrence(ctx, template.ID, SetIssueRecurrenceOpts{Rule: "@weekly"})
+		assert.ErrorIs(t, err, ErrIssueRecurrenceSet)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("set issue recurrence with invalid rule", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		template := newTestIssueTemplate(projectID)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(true)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/SetRecurrence"),
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    repository.NewMockIssueRecurrenceRepository(ctrl),
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.SetRecurrence(ctx, template.ID, SetIssueRecurrenceOpts{Rule: "FREQ=HOURLY"})
+		assert.ErrorIs(t, err, ErrIssueRecurrenceSet)
+		assert.ErrorIs(t, err, model.ErrInvalidIssueRecurrenceDetails)
+	})
+
+	t.Run("set issue recurrence ended already", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		template := newTestIssueTemplate(projectID)
+		start := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(true)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/SetRecurrence"),
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    repository.NewMockIssueRecurrenceRepository(ctrl),
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.SetRecurrence(ctx, template.ID, SetIssueRecurrenceOpts{Rule: "FREQ=DAILY;UNTIL=20200131", StartsAt: &start})
+		assert.ErrorIs(t, err, model.ErrInvalidIssueRecurrenceDetails)
+	})
+
+	t.Run("set issue recurrence without user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/SetRecurrence"),
+			recurrenceRepo: repository.NewMockIssueRecurrenceRepository(ctrl),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.SetRecurrence(ctx, model.MustNewID(model.ResourceTypeIssueTemplate), SetIssueRecurrenceOpts{Rule: "@weekly"})
+		assert.ErrorIs(t, err, ErrNoUser)
+	})
+}
+
+func TestIssueTemplateService_DeleteRecurrence(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	template := newTestIssueTemplate(projectID)
+
+	templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+	templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+	recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+	recurrenceRepo.EXPECT().Delete(ctx, template.ID).Return(nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+	s := &issueTemplateService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/DeleteRecurrence"),
+		issueTemplateRepo: templateRepo,
+		recurrenceRepo:    recurrenceRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	require.NoError(t, s.DeleteRecurrence(ctx, template.ID))
+}
+
+func TestIssueService_MaterializeRecurrences(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	// 2026-10-05 is a Monday, the recurrence is weekly on Mondays at 9:00.
+	occurrence := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	next := occurrence.AddDate(0, 0, 7)
+
+	newTemplate := func() *repository.IssueTemplate {
+		template := newTestIssueTemplate(projectID)
+		template.TitlePattern = ""
+		template.Children = make([]model.IssueTemplateChild, 0)
+		return template
+	}
+
+	newTracer := func(ctrl *gomock.Controller, ctx context.Context, creates int) *mock.MockTracer {
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0)).Times(1 + creates)
+
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/MaterializeRecurrences", gomock.Len(0)).Return(ctx, span)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/Create", gomock.Len(0)).DoAndReturn(
+			func(ctx context.Context, _ string, _ ...any) (context.Context, *mock.MockSpan) {
+				return ctx, span
+			},
+		).Times(creates)
+		return tracer
+	}
+
+	t.Run("materialize recurrences", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTemplate()
+		recurrence := newTestIssueRecurrence(template.ID, userID, occurrence)
+		issue := testModel.NewRepositoryIssue(userID)
+		now := occurrence.Add(time.Hour)
+
+		recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+		recurrenceRepo.EXPECT().ListDue(ctx, now, issueRecurrenceBatchSize).Return([]*repository.IssueRecurrence{recurrence}, nil)
+		recurrenceRepo.EXPECT().ClaimOccurrence(ctx, template.ID, occurrence).Return(true, nil)
+		recurrenceRepo.EXPECT().SetOccurrenceIssue(ctx, template.ID, occurrence, issue.ID).Return(nil)
+		recurrenceRepo.EXPECT().Advance(ctx, template.ID, occurrence, &next).Return(nil)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, opts repository.CreateIssueOpts) (*repository.Issue, error) {
+			assert.Equal(t, userID, ctx.Value(pkg.CtxKeyUserID))
+			assert.Equal(t, userID, opts.ReportedBy)
+			assert.Equal(t, "Release 2026-10-05", opts.Title)
+			assert.Equal(t, model.IssueKindEpic, opts.Kind)
+			return issue, nil
+		})
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), projectID, model.ActionIssueCreate).Return(true)
+		permSvc.EXPECT().BootstrapCreator(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(gomock.Any()).Return(false, nil)
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(gomock.Any(), gomock.Any()).Return(nil)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx, 1),
+			issueRepo:         issueRepo,
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    recurrenceRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.MaterializeRecurrences(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, []*Issue{issueFromRepository(issue)}, got)
+	})
+
+	t.Run("materialize recurrences skips missed occurrences", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		recurrence := newTestIssueRecurrence(model.MustNewID(model.ResourceTypeIssueTemplate), userID, occurrence)
+		now := occurrence.AddDate(0, 0, 23)
+		latest := occurrence.AddDate(0, 0, 21)
+		following := occurrence.AddDate(0, 0, 28)
+
+		// The occurrence has been claimed before a restart of the worker, so
+		// the recurrence is advanced without creating the issue again.
+		recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+		recurrenceRepo.EXPECT().ListDue(ctx, now, issueRecurrenceBatchSize).Return([]*repository.IssueRecurrence{recurrence}, nil)
+		recurrenceRepo.EXPECT().ClaimOccurrence(ctx, recurrence.Template, latest).Return(false, nil)
+		recurrenceRepo.EXPECT().Advance(ctx, recurrence.Template, occurrence, &following).Return(nil)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newTracer(ctrl, ctx, 0),
+			issueTemplateRepo: repository.NewMockIssueTemplateRepository(ctrl),
+			recurrenceRepo:    recurrenceRepo,
+		}}
+
+		got, err := s.MaterializeRecurrences(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("materialize recurrences retries failed occurrences", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTemplate()
+		recurrence := newTestIssueRecurrence(template.ID, userID, occurrence)
+		now := occurrence.Add(time.Hour)
+
+		recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+		recurrenceRepo.EXPECT().ListDue(ctx, now, issueRecurrenceBatchSize).Return([]*repository.IssueRecurrence{recurrence}, nil)
+		recurrenceRepo.EXPECT().ClaimOccurrence(ctx, template.ID, occurrence).Return(true, nil)
+		recurrenceRepo.EXPECT().ReleaseOccurrence(ctx, template.ID, occurrence).Return(nil)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(nil, errors.New("unavailable"))
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Error(ctx, "failed to materialize issue recurrence", gomock.Any(), gomock.Any())
+
+		s := &issueService{baseService: &baseService{
+			logger:            logger,
+			tracer:            newTracer(ctrl, ctx, 0),
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    recurrenceRepo,
+		}}
+
+		got, err := s.MaterializeRecurrences(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("materialize recurrences skips occurrences without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTemplate()
+		recurrence := newTestIssueRecurrence(template.ID, userID, occurrence)
+		now := occurrence.Add(time.Hour)
+
+		recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+		recurrenceRepo.EXPECT().ListDue(ctx, now, issueRecurrenceBatchSize).Return([]*repository.IssueRecurrence{recurrence}, nil)
+		recurrenceRepo.EXPECT().ClaimOccurrence(ctx, template.ID, occurrence).Return(true, nil)
+		recurrenceRepo.EXPECT().ReleaseOccurrence(ctx, template.ID, occurrence).Return(nil)
+		recurrenceRepo.EXPECT().Advance(ctx, template.ID, occurrence, &next).Return(nil)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), projectID, model.ActionIssueCreate).Return(false)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(gomock.Any()).Return(false, nil)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Error(ctx, "failed to materialize issue recurrence", gomock.Any(), gomock.Any())
+
+		s := &issueService{baseService: &baseService{
+			logger:            logger,
+			tracer:            newTracer(ctrl, ctx, 1),
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    recurrenceRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+
+		got, err := s.MaterializeRecurrences(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("materialize recurrences keeps occurrences of created issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTestIssueTemplate(projectID)
+		template.TitlePattern = ""
+		recurrence := newTestIssueRecurrence(template.ID, userID, occurrence)
+		issue := testModel.NewRepositoryIssue(userID)
+		now := occurrence.Add(time.Hour)
+
+		// The recurrence is listed again by the next run, as if it was not
+		// advanced yet, but the claim of the occurrence is kept.
+		claims := make(map[time.Time]bool)
+		recurrenceRepo := repository.NewMockIssueRecurrenceRepository(ctrl)
+		recurrenceRepo.EXPECT().ListDue(ctx, now, issueRecurrenceBatchSize).Return([]*repository.IssueRecurrence{recurrence}, nil).Times(2)
+		recurrenceRepo.EXPECT().ClaimOccurrence(ctx, template.ID, occurrence).DoAndReturn(
+			func(_ context.Context, _ model.ID, occursAt time.Time) (bool, error) {
+				if claims[occursAt] {
+					return false, nil
+				}
+				claims[occursAt] = true
+				return true, nil
+			},
+		).Times(2)
+		recurrenceRepo.EXPECT().SetOccurrenceIssue(ctx, template.ID, occurrence, issue.ID).Return(nil)
+		recurrenceRepo.EXPECT().Advance(ctx, template.ID, occurrence, &next).Return(nil).Times(2)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(issue, nil)
+		issueRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), projectID, model.ActionIssueCreate).Return(true).Times(2)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issue.ID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().BootstrapCreator(gomock.Any(), userID, issue.ID, gomock.Any()).Return(nil)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(gomock.Any()).Return(false, nil).Times(2)
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(gomock.Any(), gomock.Any()).Return(nil)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Error(ctx, "failed to materialize issue recurrence", gomock.Any(), gomock.Any())
+
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0)).Times(4)
+
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/MaterializeRecurrences", gomock.Len(0)).Return(ctx, span).Times(2)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/Create", gomock.Len(0)).DoAndReturn(
+			func(ctx context.Context, _ string, _ ...any) (context.Context, *mock.MockSpan) {
+				return ctx, span
+			},
+		).Times(2)
+
+		s := &issueService{baseService: &baseService{
+			logger:            logger,
+			tracer:            tracer,
+			issueRepo:         issueRepo,
+			issueTemplateRepo: templateRepo,
+			recurrenceRepo:    recurrenceRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.MaterializeRecurrences(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, []*Issue{issueFromRepository(issue)}, got)
+
+		got, err = s.MaterializeRecurrences(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("materialize recurrences without recurrence repository", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/MaterializeRecurrences"),
+			issueTemplateRepo: repository.NewMockIssueTemplateRepository(ctrl),
+		}}
+
+		_, err := s.MaterializeRecurrences(ctx, time.Now())
+		assert.ErrorIs(t, err, ErrIssueRecurrenceMaterialize)
+		assert.ErrorIs(t, err, ErrNoIssueRecurrenceRepository)
+	})
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opcotech/elemo/internal/model"
+)
+
+// MaxIssueRollups is the maximum number of issues the rollups are returned
+// for at once.
+const MaxIssueRollups = 100
+
+// IssueRollup is the progress of an issue aggregated from its subtasks at any
+// depth.
+type IssueRollup struct {
+	ID model.ID
+	model.IssueRollup
+}
+
+func (s *issueService) GetRollups(ctx context.Context, ids []model.ID) ([]*IssueRollup, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/GetRollups")
+	defer span.End()
+
+	unique := make([]model.ID, 0, len(ids))
+	seen := make(map[model.ID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if err := id.Validate(); err != nil || id.Type != model.ResourceTypeIssue {
+			return nil, errors.Join(ErrIssueGetRollups, model.ErrInvalidID)
+		}
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 || len(unique) > MaxIssueRollups {
+		return nil, errors.Join(ErrIssueGetRollups, ErrIssueRollupSize)
+	}
+
+	// The issues the user cannot read are left out, as with the issues that
+	// do not exist.
+	readable := make([]model.ID, 0, len(unique))
+	for _, id := range unique {
+		if s.permissionService.CtxUserHas(ctx, id, model.ActionIssueRead) {
+			readable = append(readable, id)
+		}
+	}
+
+	result := make([]*IssueRollup, 0, len(readable))
+	if len(readable) == 0 {
+		return result, nil
+	}
+
+	rollups, err := s.issueRepo.GetRollups(ctx, readable)
+	if err != nil {
+		return nil, errors.Join(ErrIssueGetRollups, err)
+	}
+
+	for _, id := range readable {
+		if rollup, ok := rollups[id]; ok && rollup != nil {
+			result = append(result, &IssueRollup{ID: id, IssueRollup: *rollup})
+		}
+	}
+
+	return result, nil
+}
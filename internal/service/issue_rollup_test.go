@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+func TestIssueService_GetRollups(t *testing.T) {
+	epicID := model.MustNewID(model.ResourceTypeIssue)
+	parentID := model.MustNewID(model.ResourceTypeIssue)
+	hiddenID := model.MustNewID(model.ResourceTypeIssue)
+	missingID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("get readable rollups in order", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		epic := &model.IssueRollup{Todo: 1, InProgress: 1, Done: 2}
+		parent := &model.IssueRollup{Done: 1}
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetRollups(ctx, []model.ID{parentID, epicID, missingID}).Return(map[model.ID]*model.IssueRollup{
+			epicID:   epic,
+			parentID: parent,
+		}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), model.ActionIssueRead).DoAndReturn(func(_ context.Context, id model.ID, _ model.Action) bool {
+			return id != hiddenID
+		}).Times(4)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetRollups(ctx, []model.ID{parentID, epicID, hiddenID, parentID, missingID})
+		require.NoError(t, err)
+		assert.Equal(t, []*IssueRollup{
+			{ID: parentID, IssueRollup: *parent},
+			{ID: epicID, IssueRollup: *epic},
+		}, got)
+	})
+
+	t.Run("get rollups of unreadable issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, hiddenID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         repository.NewMockIssueRepository(ctrl),
+			permissionService: permSvc,
+		}}
+
+		got, err := s.GetRollups(ctx, []model.ID{hiddenID})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("get rollups with invalid issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         repository.NewMockIssueRepository(ctrl),
+			permissionService: NewMockPermissionService(ctrl),
+		}}
+
+		tooMany := make([]model.ID, MaxIssueRollups+1)
+		for i := range tooMany {
+			tooMany[i] = model.MustNewID(model.ResourceTypeIssue)
+		}
+
+		_, err := s.GetRollups(ctx, nil)
+		assert.ErrorIs(t, err, ErrIssueRollupSize)
+		_, err = s.GetRollups(ctx, tooMany)
+		assert.ErrorIs(t, err, ErrIssueRollupSize)
+		_, err = s.GetRollups(ctx, []model.ID{model.MustNewID(model.ResourceTypeProject)})
+		assert.ErrorIs(t, err, model.ErrInvalidID)
+	})
+
+	t.Run("get rollups with repository error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetRollups(ctx, []model.ID{epicID}).Return(nil, repository.ErrIssueGetRollups)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, epicID, model.ActionIssueRead).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            newIssueDependencyTestTracer(ctrl, ctx),
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+		}}
+
+		_, err := s.GetRollups(ctx, []model.ID{epicID})
+		assert.ErrorIs(t, err, ErrIssueGetRollups)
+		assert.ErrorIs(t, err, repository.ErrIssueGetRollups)
+	})
+}
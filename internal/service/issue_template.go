@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// IssueTemplate represents a project issue template returned by the service.
+type IssueTemplate struct {
+	ID           model.ID
+	Project      model.ID
+	Name         string
+	Kind         model.IssueKind
+	TitlePattern string
+	Description  string
+	Priority     model.IssuePriority
+	Labels       []model.ID
+	Children     []model.IssueTemplateChild
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// CreateIssueTemplateOpts holds the data required to create an issue
+// template.
+type CreateIssueTemplateOpts struct {
+	Name         string                     `json:"name" validate:"required,min=1,max=120"`
+	Kind         model.IssueKind            `json:"kind" validate:"required,min=1,max=4"`
+	TitlePattern string                     `json:"title_pattern" validate:"omitempty,max=120"`
+	Description  string                     `json:"description" validate:"omitempty,min=3"`
+	Priority     model.IssuePriority        `json:"priority" validate:"omitempty,min=1,max=5"`
+	Labels       []model.ID                 `json:"labels" validate:"max=20"`
+	Children     []model.IssueTemplateChild `json:"children" validate:"max=50"`
+}
+
+// Validate validates the create options.
+func (o *CreateIssueTemplateOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidIssueTemplateDetails, err)
+	}
+	if err := validateIssueTemplateLabels(o.Labels); err != nil {
+		return err
+	}
+	return validateIssueTemplateChildren(o.Children)
+}
+
+// UpdateIssueTemplateOpts holds the fields that can be updated on an issue
+// template. Undefined fields (Defined == false) are left unchanged, and null
+// values clear the optional fields.
+type UpdateIssueTemplateOpts struct {
+	Name         optional.Optional[string]
+	Kind         optional.Optional[model.IssueKind]
+	TitlePattern optional.Optional[string]
+	Description  optional.Optional[string]
+	Priority     optional.Optional[model.IssuePriority]
+	Labels       optional.Optional[[]model.ID]
+	Children     optional.Optional[[]model.IssueTemplateChild]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateIssueTemplateOpts) Validate() error {
+	fields := []struct {
+		value optional.Optional[string]
+		tag   string
+	}{
+		{o.Name, "required,min=1,max=120"},
+		{o.TitlePattern, "omitempty,max=120"},
+		{o.Description, "omitempty,min=3"},
+	}
+
+	for _, f := range fields {
+		if !f.value.Defined {
+			continue
+		}
+		var value string
+		if f.value.Value != nil {
+			value = *f.value.Value
+		}
+		if err := validate.Var(value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidIssueTemplateDetails, err)
+		}
+	}
+
+	if o.Kind.Defined {
+		if o.Kind.Value == nil {
+			return model.ErrInvalidIssueTemplateDetails
+		}
+		if err := validate.Var(*o.Kind.Value, "required,min=1,max=4"); err != nil {
+			return errors.Join(model.ErrInvalidIssueTemplateDetails, err)
+		}
+	}
+
+	if o.Priority.Defined && o.Priority.Value != nil {
+		if err := validate.Var(*o.Priority.Value, "required,min=1,max=5"); err != nil {
+			return errors.Join(model.ErrInvalidIssueTemplateDetails, err)
+		}
+	}
+
+	if o.Labels.Defined && o.Labels.Value != nil {
+		if len(*o.Labels.Value) > 20 {
+			return model.ErrInvalidIssueTemplateDetails
+		}
+		if err := validateIssueTemplateLabels(*o.Labels.Value); err != nil {
+			return err
+		}
+	}
+
+	if o.Children.Defined && o.Children.Value != nil {
+		if len(*o.Children.Value) > 50 {
+			return model.ErrInvalidIssueTemplateDetails
+		}
+		if err := validateIssueTemplateChildren(*o.Children.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateIssueTemplateLabels(labels []model.ID) error {
+	for _, label := range labels {
+		if err := label.Validate(); err != nil || label.Type != model.ResourceTypeLabel {
+			return errors.Join(model.ErrInvalidIssueTemplateDetails, model.ErrInvalidID)
+		}
+	}
+	return nil
+}
+
+func validateIssueTemplateChildren(children []model.IssueTemplateChild) error {
+	for _, child := range children {
+		if err := child.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateIssueFromTemplateOpts holds the data required to create an issue
+// from a template. The title replaces the placeholder in the title patterns
+// of the template.
+type CreateIssueFromTemplateOpts struct {
+	Title string `json:"title" validate:"omitempty,max=120"`
+}
+
+// Validate validates the options.
+func (o *CreateIssueFromTemplateOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidIssueDetails, err)
+	}
+	return nil
+}
+
+// IssueTemplateService serves the business logic of interacting with the
+// issue templates of projects.
+//
+//go:generate go tool mockgen -destination=issue_template_mock_gen.go -package=service -mock_names IssueTemplateService=MockIssueTemplateService . IssueTemplateService
+type IssueTemplateService interface {
+	// Create creates a new issue template in a project.
+	Create(ctx context.Context, projectID model.ID, opts CreateIssueTemplateOpts) (*IssueTemplate, error)
+	// Get returns an issue template by its ID.
+	Get(ctx context.Context, id model.ID) (*IssueTemplate, error)
+	// List returns a cursor-paginated page of the issue templates of a
+	// project.
+	List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*IssueTemplate], error)
+	// Update updates an issue template.
+	Update(ctx context.Context, id model.ID, opts UpdateIssueTemplateOpts) (*IssueTemplate, error)
+	// Delete deletes an issue template. The issues created from the
+	// template are left unchanged.
+	Delete(ctx context.Context, id model.ID) error
+	// GetRecurrence returns the recurrence of an issue template.
+	GetRecurrence(ctx context.Context, templateID model.ID) (*IssueRecurrence, error)
+	// SetRecurrence creates or replaces the recurrence of an issue template.
+	// The issues are created on the occurrences from now on, on behalf of
+	// the context user.
+	SetRecurrence(ctx context.Context, templateID model.ID, opts SetIssueRecurrenceOpts) (*IssueRecurrence, error)
+	// DeleteRecurrence deletes the recurrence of an issue template. The
+	// issues created already are left unchanged.
+	DeleteRecurrence(ctx context.Context, templateID model.ID) error
+}
+
+// issueTemplateService is the concrete implementation of
+// IssueTemplateService.
+type issueTemplateService struct {
+	*baseService
+}
+
+func issueTemplateFromRepository(t *repository.IssueTemplate) *IssueTemplate {
+	if t == nil {
+		return nil
+	}
+	return &IssueTemplate{
+		ID:           t.ID,
+		Project:      t.Project,
+		Name:         t.Name,
+		Kind:         t.Kind,
+		TitlePattern: t.TitlePattern,
+		Description:  t.Description,
+		Priority:     t.Priority,
+		Labels:       t.Labels,
+		Children:     t.Children,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+}
+
+// canUseLabels reports whether the labels can be attached to the issues of
+// the project. Labels scoped to another part of the organization cannot.
+func (s *issueTemplateService) canUseLabels(ctx context.Context, projectID model.ID, labels []model.ID) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	ancestry, err := s.permissionService.ListScopeAncestry(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range labels {
+		label, err := s.labelRepo.Get(ctx, id, repository.LabelDetailProjection())
+		if err != nil {
+			return err
+		}
+		if label.Scope != nil && !slices.Contains(ancestry, *label.Scope) {
+			return errors.Join(model.ErrInvalidIssueTemplateDetails, ErrLabelOutOfScope)
+		}
+	}
+
+	return nil
+}
+
+// getForAction returns the issue template if the context user can perform
+// the action on its project.
+func (s *issueTemplateService) getForAction(ctx context.Context, id model.ID, action model.Action) (*repository.IssueTemplate, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	if id.Type != model.ResourceTypeIssueTemplate {
+		return nil, model.ErrInvalidID
+	}
+
+	template, err := s.issueTemplateRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, template.Project, action) {
+		return nil, ErrNoPermission
+	}
+
+	return template, nil
+}
+
+func (s *issueTemplateService) Create(ctx context.Context, projectID model.ID, opts CreateIssueTemplateOpts) (*IssueTemplate, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueTemplateCreate, license.ErrLicenseExpired)
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrIssueTemplateCreate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueTemplateCreate, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return nil, errors.Join(ErrIssueTemplateCreate, ErrNoPermission)
+	}
+
+	if err := s.canUseLabels(ctx, projectID, opts.Labels); err != nil {
+		return nil, errors.Join(ErrIssueTemplateCreate, err)
+	}
+
+	template, err := s.issueTemplateRepo.Create(ctx, repository.CreateIssueTemplateOpts{
+		Project:      projectID,
+		Name:         opts.Name,
+		Kind:         opts.Kind,
+		TitlePattern: opts.TitlePattern,
+		Description:  opts.Description,
+		Priority:     opts.Priority,
+		Labels:       opts.Labels,
+		Children:     opts.Children,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueTemplateCreate, err)
+	}
+
+	return issueTemplateFromRepository(template), nil
+}
+
+func (s *issueTemplateService) Get(ctx context.Context, id model.ID) (*IssueTemplate, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/Get")
+	defer span.End()
+
+	template, err := s.getForAction(ctx, id, model.ActionProjectRead)
+	if err != nil {
+		return nil, errors.Join(ErrIssueTemplateGet, err)
+	}
+
+	return issueTemplateFromRepository(template), nil
+}
+
+func (s *issueTemplateService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*IssueTemplate], error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/List")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return Page[*IssueTemplate]{}, errors.Join(ErrIssueTemplateGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*IssueTemplate]{}, errors.Join(ErrIssueTemplateGetAll, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return Page[*IssueTemplate]{}, errors.Join(ErrIssueTemplateGetAll, ErrNoPermission)
+	}
+
+	templates, err := s.issueTemplateRepo.ListForProject(ctx, projectID, normalized)
+	if err != nil {
+		return Page[*IssueTemplate]{}, errors.Join(ErrIssueTemplateGetAll, err)
+	}
+
+	return mapPage(templates, issueTemplateFromRepository), nil
+}
+
+func (s *issueTemplateService) Update(ctx context.Context, id model.ID, opts UpdateIssueTemplateOpts) (*IssueTemplate, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueTemplateUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueTemplateUpdate, err)
+	}
+
+	current, err := s.getForAction(ctx, id, model.ActionProjectUpdate)
+	if err != nil {
+		return nil, errors.Join(ErrIssueTemplateUpdate, err)
+	}
+
+	if opts.Labels.Defined && opts.Labels.Value != nil {
+		if err := s.canUseLabels(ctx, current.Project, *opts.Labels.Value); err != nil {
+			return nil, errors.Join(ErrIssueTemplateUpdate, err)
+		}
+	}
+
+	template, err := s.issueTemplateRepo.Update(ctx, id, repository.UpdateIssueTemplateOpts{
+		Name:         opts.Name,
+		Kind:         opts.Kind,
+		TitlePattern: clearedToEmpty(opts.TitlePattern),
+		Description:  clearedToEmpty(opts.Description),
+		Priority:     opts.Priority,
+		Labels:       opts.Labels,
+		Children:     opts.Children,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrIssueTemplateUpdate, err)
+	}
+
+	return issueTemplateFromRepository(template), nil
+}
+
+func (s *issueTemplateService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.issueTemplateService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrIssueTemplateDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getForAction(ctx, id, model.ActionProjectUpdate); err != nil {
+		return errors.Join(ErrIssueTemplateDelete, err)
+	}
+
+	if err := s.issueTemplateRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrIssueTemplateDelete, err)
+	}
+
+	return nil
+}
+
+func (s *issueService) CreateFromTemplate(ctx context.Context, templateID model.ID, opts CreateIssueFromTemplateOpts) (*Issue, error) {
+	ctx, span := s.tracer.Start(ctx, "service.issueService/CreateFromTemplate")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrIssueCreateFromTemplate, license.ErrLicenseExpired)
+	}
+
+	if err := templateID.Validate(); err != nil || templateID.Type != model.ResourceTypeIssueTemplate {
+		return nil, errors.Join(ErrIssueCreateFromTemplate, model.ErrInvalidID)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrIssueCreateFromTemplate, err)
+	}
+
+	if s.issueTemplateRepo == nil {
+		return nil, errors.Join(ErrIssueCreateFromTemplate, ErrNoIssueTemplateRepository)
+	}
+
+	template, err := s.issueTemplateRepo.Get(ctx, templateID)
+	if err != nil {
+		return nil, errors.Join(ErrIssueCreateFromTemplate, err)
+	}
+
+	issue, err := s.createFromTemplate(ctx, template, opts.Title)
+	if err != nil {
+		return nil, errors.Join(ErrIssueCreateFromTemplate, err)
+	}
+
+	return issue, nil
+}
+
+// createFromTemplate creates the issue prefilled by the template and its
+// children, the title replacing the placeholder in the title patterns.
+//
+// If the issue is created but its labels or children are not, the created
+// issue is returned along with the error, so the caller knows the issue
+// exists and must not be created again.
+func (s *issueService) createFromTemplate(ctx context.Context, template *repository.IssueTemplate, title string) (*Issue, error) {
+	issue, err := s.Create(ctx, template.Project, CreateIssueOpts{
+		Kind:        template.Kind,
+		Title:       model.IssueTemplateTitle(template.TitlePattern, title),
+		Description: template.Description,
+		Priority:    template.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(template.Labels) > 0 {
+		if err := s.syncLabels(ctx, issue.ID, nil, template.Labels); err != nil {
+			return issue, err
+		}
+	}
+
+	for _, child := range template.Children {
+		if _, err := s.Create(ctx, template.Project, CreateIssueOpts{
+			Parent:      &issue.ID,
+			Kind:        child.Kind,
+			Title:       child.Title(title),
+			Description: child.Description,
+			Priority:    child.Priority,
+		}); err != nil {
+			return issue, err
+		}
+	}
+
+	if len(template.Labels) == 0 && len(template.Children) == 0 {
+		return issue, nil
+	}
+
+	created, err := s.Get(ctx, issue.ID)
+	if err != nil {
+		return issue, err
+	}
+
+	return created, nil
+}
+
+// NewIssueTemplateService returns a new instance of the IssueTemplateService
+// interface.
+func NewIssueTemplateService(opts ...Option) (IssueTemplateService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &issueTemplateService{
+		baseService: s,
+	}
+
+	if svc.issueTemplateRepo == nil {
+		return nil, ErrNoIssueTemplateRepository
+	}
+
+	if svc.labelRepo == nil {
+		return nil, ErrNoLabelRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,518 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func newTestIssueTemplate(projectID model.ID, labels ...model.ID) *repository.IssueTemplate {
+	return &repository.IssueTemplate{
+		ID:           model.MustNewID(model.ResourceTypeIssueTemplate),
+		Project:      projectID,
+		Name:         "Release",
+		Kind:         model.IssueKindEpic,
+		TitlePattern: "Release {title}",
+		Description:  "Ship the release",
+		Priority:     model.IssuePriorityHigh,
+		Labels:       append(make([]model.ID, 0), labels...),
+		Children: []model.IssueTemplateChild{
+			{Kind: model.IssueKindTask, TitlePattern: "Changelog for {title}"},
+		},
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewIssueTemplateService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new issue template service",
+			opts: []Option{
+				WithIssueTemplateRepository(repository.NewMockIssueTemplateRepository(nil)),
+				WithLabelRepository(repository.NewMockLabelRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new issue template service with invalid options",
+			opts:    []Option{WithIssueTemplateRepository(nil)},
+			wantErr: ErrNoIssueTemplateRepository,
+		},
+		{
+			name: "new issue template service with no label repository",
+			opts: []Option{
+				WithIssueTemplateRepository(repository.NewMockIssueTemplateRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoLabelRepository,
+		},
+		{
+			name: "new issue template service with no license service",
+			opts: []Option{
+				WithIssueTemplateRepository(repository.NewMockIssueTemplateRepository(nil)),
+				WithLabelRepository(repository.NewMockLabelRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new issue template service with no permission service",
+			opts: []Option{
+				WithIssueTemplateRepository(repository.NewMockIssueTemplateRepository(nil)),
+				WithLabelRepository(repository.NewMockLabelRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewIssueTemplateService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestIssueTemplateService_Create(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	organizationID := model.MustNewID(model.ResourceTypeOrganization)
+
+	opts := func(labels ...model.ID) CreateIssueTemplateOpts {
+		return CreateIssueTemplateOpts{
+			Name:         "Release",
+			Kind:         model.IssueKindEpic,
+			TitlePattern: "Release {title}",
+			Description:  "Ship the release",
+			Priority:     model.IssuePriorityHigh,
+			Labels:       labels,
+			Children: []model.IssueTemplateChild{
+				{Kind: model.IssueKindTask, TitlePattern: "Changelog for {title}"},
+			},
+		}
+	}
+
+	t.Run("create issue template", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		label := testModel.NewRepositoryLabel(organizationID)
+		template := newTestIssueTemplate(projectID, label.ID)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, organizationID}, nil)
+
+		labelRepo := repository.NewMockLabelRepository(ctrl)
+		labelRepo.EXPECT().Get(ctx, label.ID, repository.LabelDetailProjection()).Return(label, nil)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Create(ctx, repository.CreateIssueTemplateOpts{
+			Project:      projectID,
+			Name:         "Release",
+			Kind:         model.IssueKindEpic,
+			TitlePattern: "Release {title}",
+			Description:  "Ship the release",
+			Priority:     model.IssuePriorityHigh,
+			Labels:       []model.ID{label.ID},
+			Children:     template.Children,
+		}).Return(template, nil)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Create"),
+			issueTemplateRepo: templateRepo,
+			labelRepo:         labelRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.Create(ctx, projectID, opts(label.ID))
+		require.NoError(t, err)
+		assert.Equal(t, issueTemplateFromRepository(template), got)
+	})
+
+	t.Run("create issue template with label out of scope", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		label := testModel.NewRepositoryLabel(model.MustNewID(model.ResourceTypeProject))
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, organizationID}, nil)
+
+		labelRepo := repository.NewMockLabelRepository(ctrl)
+		labelRepo.EXPECT().Get(ctx, label.ID, repository.LabelDetailProjection()).Return(label, nil)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Create"),
+			labelRepo:         labelRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Create(ctx, projectID, opts(label.ID))
+		assert.ErrorIs(t, err, ErrIssueTemplateCreate)
+		assert.ErrorIs(t, err, ErrLabelOutOfScope)
+	})
+
+	t.Run("create issue template with invalid child", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Create"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		invalid := opts()
+		invalid.Children = []model.IssueTemplateChild{{Kind: model.IssueKindTask}}
+
+		_, err := s.Create(ctx, projectID, invalid)
+		assert.ErrorIs(t, err, model.ErrInvalidIssueTemplateDetails)
+	})
+
+	t.Run("create issue template without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(false)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Create"),
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Create(ctx, projectID, opts())
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("create issue template with license expired", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Create"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.Create(ctx, projectID, opts())
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
+
+func TestIssueTemplateService_Get(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("get issue template", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTestIssueTemplate(projectID)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Get"),
+			issueTemplateRepo: templateRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.Get(ctx, template.ID)
+		require.NoError(t, err)
+		assert.Equal(t, issueTemplateFromRepository(template), got)
+	})
+
+	t.Run("get issue template without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTestIssueTemplate(projectID)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Get"),
+			issueTemplateRepo: templateRepo,
+			permissionService: permSvc,
+		}}
+
+		_, err := s.Get(ctx, template.ID)
+		assert.ErrorIs(t, err, ErrIssueTemplateGet)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("get issue template with invalid id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer: newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Get"),
+		}}
+
+		_, err := s.Get(ctx, projectID)
+		assert.ErrorIs(t, err, model.ErrInvalidID)
+	})
+}
+
+func TestIssueTemplateService_List(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	template := newTestIssueTemplate(projectID)
+
+	templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+	templateRepo.EXPECT().ListForProject(ctx, projectID, repository.CursorPage{Size: 10}).Return(repository.Page[*repository.IssueTemplate]{
+		Items: []*repository.IssueTemplate{template},
+	}, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+	s := &issueTemplateService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/List"),
+		issueTemplateRepo: templateRepo,
+		permissionService: permSvc,
+	}}
+
+	got, err := s.List(ctx, projectID, CursorPage{Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []*IssueTemplate{issueTemplateFromRepository(template)}, got.Items)
+}
+
+func TestIssueTemplateService_Update(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("update issue template", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		template := newTestIssueTemplate(projectID)
+		updated := *template
+		updated.Name = "Hotfix"
+		updated.TitlePattern = ""
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+		templateRepo.EXPECT().Update(ctx, template.ID, repository.UpdateIssueTemplateOpts{
+			Name:         optional.Some("Hotfix"),
+			TitlePattern: optional.Some(""),
+		}).Return(&updated, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Update"),
+			issueTemplateRepo: templateRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.Update(ctx, template.ID, UpdateIssueTemplateOpts{
+			Name:         optional.Some("Hotfix"),
+			TitlePattern: optional.Null[string](),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, issueTemplateFromRepository(&updated), got)
+	})
+
+	t.Run("update issue template clearing the kind", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &issueTemplateService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Update"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Update(ctx, model.MustNewID(model.ResourceTypeIssueTemplate), UpdateIssueTemplateOpts{
+			Kind: optional.Null[model.IssueKind](),
+		})
+		assert.ErrorIs(t, err, ErrIssueTemplateUpdate)
+		assert.ErrorIs(t, err, model.ErrInvalidIssueTemplateDetails)
+	})
+}
+
+func TestIssueTemplateService_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	template := newTestIssueTemplate(projectID)
+
+	templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+	templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+	templateRepo.EXPECT().Delete(ctx, template.ID).Return(nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+	s := &issueTemplateService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.issueTemplateService/Delete"),
+		issueTemplateRepo: templateRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	require.NoError(t, s.Delete(ctx, template.ID))
+}
+
+func TestIssueService_CreateFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+
+	t.Run("create issue from template", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		template := newTestIssueTemplate(projectID, labelID)
+		issue := testModel.NewRepositoryIssue(userID)
+		child := testModel.NewRepositoryIssue(userID)
+
+		templateRepo := repository.NewMockIssueTemplateRepository(ctrl)
+		templateRepo.EXPECT().Get(ctx, template.ID).Return(template, nil)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts repository.CreateIssueOpts) (*repository.Issue, error) {
+			assert.Nil(t, opts.Parent)
+			assert.Equal(t, model.IssueKindEpic, opts.Kind)
+			assert.Equal(t, "Release 1.0", opts.Title)
+			assert.Equal(t, "Ship the release", opts.Description)
+			assert.Equal(t, model.IssuePriorityHigh, opts.Priority)
+			return issue, nil
+		})
+		issueRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts repository.CreateIssueOpts) (*repository.Issue, error) {
+			assert.Equal(t, &issue.ID, opts.Parent)
+			assert.Equal(t, model.IssueKindTask, opts.Kind)
+			assert.Equal(t, "Changelog for 1.0", opts.Title)
+			assert.Equal(t, model.IssuePriorityNormal, opts.Priority)
+			return child, nil
+		})
+		issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+
+		labelRepo := repository.NewMockLabelRepository(ctrl)
+		labelRepo.EXPECT().AttachTo(ctx, labelID, issue.ID).Return(nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionIssueCreate).Return(true).Times(2)
+		permSvc.EXPECT().CtxUserHas(ctx, issue.ID, model.ActionIssueRead).Return(true).Times(2)
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil).Times(3)
+
+		searchSvc := NewMockSearchService(ctrl)
+		searchSvc.EXPECT().EnqueueIndex(ctx, gomock.Any()).Return(nil).Times(2)
+
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0)).Times(4)
+
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/CreateFromTemplate", gomock.Len(0)).Return(ctx, span)
+		tracer.EXPECT().Start(ctx, "service.issueService/Create", gomock.Len(0)).Return(ctx, span).Times(2)
+		tracer.EXPECT().Start(ctx, "service.issueService/Get", gomock.Len(0)).Return(ctx, span)
+
+		s := &issueService{baseService: &baseService{
+			tracer:            tracer,
+			issueRepo:         issueRepo,
+			issueTemplateRepo: templateRepo,
+			labelRepo:         labelRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+			searchService:     searchSvc,
+		}}
+
+		got, err := s.CreateFromTemplate(ctx, template.ID, CreateIssueFromTemplateOpts{Title: "1.0"})
+		require.NoError(t, err)
+		assert.Equal(t, issueFromRepository(issue), got)
+	})
+
+	t.Run("create issue from template with invalid id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueService/CreateFromTemplate"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.CreateFromTemplate(ctx, projectID, CreateIssueFromTemplateOpts{Title: "1.0"})
+		assert.ErrorIs(t, err, ErrIssueCreateFromTemplate)
+		assert.ErrorIs(t, err, model.ErrInvalidID)
+	})
+
+	t.Run("create issue from template with license expired", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &issueService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.issueService/CreateFromTemplate"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.CreateFromTemplate(ctx, model.MustNewID(model.ResourceTypeIssueTemplate), CreateIssueFromTemplateOpts{})
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/opcotech/elemo/internal/pkg/log"
 	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/queue"
 	"github.com/opcotech/elemo/internal/repository"
 	"github.com/opcotech/elemo/internal/testutil/mock"
 	testModel "github.com/opcotech/elemo/internal/testutil/model"
@@ -1068,6 +1069,141 @@ func TestIssueService_List(t *testing.T) {
 			},
 			want: want,
 		},
+		{
+			name: "list issues with query",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, projectID model.ID) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/List", gomock.Len(0)).Return(ctx, span)
+
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().ListForProject(ctx, repository.IssueListQuery{
+						ProjectID: projectID,
+						ActorID:   userID,
+						Action:    model.ActionIssueRead,
+						ScopeIDs:  nil,
+						SortField: repository.IssueListSortFieldRank,
+						Page:      repository.CursorPage{Size: 10},
+						Order:     repository.SortDirectionAsc,
+						Filter: repository.IssueListFilter{
+							Conditions: []repository.IssueListCondition{
+								{Field: repository.IssueListConditionFieldAssignee, Operator: repository.IssueListOperatorIn, Values: []string{userID.String()}},
+							},
+						},
+						Projection: repository.IssueListForProjectProjection(),
+					}).Return(repository.Page[*repository.PartialIssue]{Items: repoIssues}, nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserListGrantScopes(ctx, model.ActionIssueRead).Return(scopeIDs, nil)
+					permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID}, nil)
+
+					return &baseService{
+						searchService:     NewMockSearchService(ctrl),
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx: WithIssueListOptions(context.WithValue(context.Background(), pkg.CtxKeyUserID, userID), IssueListOptions{
+					Sort:  repository.IssueListSort{Field: repository.IssueListSortFieldRank, Direction: repository.SortDirectionAsc},
+					Query: "assignee:me",
+				}),
+				projectID: projectID,
+				page:      CursorPage{Size: 10},
+			},
+			want: want,
+		},
+		{
+			name: "list issues sorted by workflow status",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, projectID model.ID) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/List", gomock.Len(0)).Return(ctx, span)
+
+					workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+					workflowRepo.EXPECT().Get(ctx, projectID).Return(newTestRepositoryWorkflow(projectID), nil)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().ListForProject(ctx, repository.IssueListQuery{
+						ProjectID:   projectID,
+						ActorID:     userID,
+						Action:      model.ActionIssueRead,
+						ScopeIDs:    nil,
+						SortField:   repository.IssueListSortFieldStatus,
+						Page:        repository.CursorPage{Size: 10},
+						Order:       repository.SortDirectionDesc,
+						Projection:  repository.IssueListForProjectProjection(),
+						StatusOrder: []string{"backlog", "in_review", "shipped"},
+					}).Return(repository.Page[*repository.PartialIssue]{Items: repoIssues}, nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserListGrantScopes(ctx, model.ActionIssueRead).Return(scopeIDs, nil)
+					permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID}, nil)
+
+					return &baseService{
+						searchService:     NewMockSearchService(ctrl),
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						issueRepo:         issueRepo,
+						workflowRepo:      workflowRepo,
+						permissionService: permSvc,
+						licenseService:    licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx: WithIssueListOptions(context.WithValue(context.Background(), pkg.CtxKeyUserID, userID), IssueListOptions{
+					Sort: repository.IssueListSort{Field: repository.IssueListSortFieldStatus, Direction: repository.SortDirectionDesc},
+				}),
+				projectID: projectID,
+				page:      CursorPage{Size: 10},
+			},
+			want: want,
+		},
+		{
+			name: "list issues with invalid query",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, projectID model.ID) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/List", gomock.Len(0)).Return(ctx, span)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserListGrantScopes(ctx, model.ActionIssueRead).Return(scopeIDs, nil)
+					permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID}, nil)
+
+					return &baseService{
+						searchService:     NewMockSearchService(ctrl),
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						issueRepo:         repository.NewMockIssueRepository(ctrl),
+						permissionService: permSvc,
+					}
+				},
+			},
+			args: args{
+				ctx: WithIssueListOptions(context.WithValue(context.Background(), pkg.CtxKeyUserID, userID), IssueListOptions{
+					Query: "status:unknown",
+				}),
+				projectID: projectID,
+				page:      CursorPage{Size: 10},
+			},
+			wantErr: ErrIssueQuery,
+		},
 		{
 			name: "list issues with no user",
 			fields: fields{
@@ -1373,6 +1509,124 @@ func TestIssueService_ListByNamespace(t *testing.T) {
 	}
 }
 
+func TestIssueService_ListByView(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	namespaceID := model.MustNewID(model.ResourceTypeNamespace)
+
+	newBase := func(ctrl *gomock.Controller, viewRepo repository.ViewRepository, permSvc PermissionService) *baseService {
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0)).AnyTimes()
+
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Len(0)).DoAndReturn(
+			func(ctx context.Context, _ string, _ ...any) (context.Context, *mock.MockSpan) {
+				return ctx, span
+			},
+		).AnyTimes()
+
+		return &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			viewRepo:          viewRepo,
+			permissionService: permSvc,
+		}
+	}
+
+	t.Run("list issues with the view options", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		view := testModel.NewRepositoryView(model.MustNewID(model.ResourceTypeUser), namespaceID)
+		repoIssue := testModel.NewRepositoryIssue(userID)
+		repoIssues := []*repository.PartialIssue{{ID: repoIssue.ID, Title: repoIssue.Title}}
+
+		viewRepo := repository.NewMockViewRepository(ctrl)
+		viewRepo.EXPECT().Get(ctx, view.ID, repository.ViewDetailProjection()).Return(view, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, view.ID, model.ActionViewRead).Return(true)
+		permSvc.EXPECT().CtxUserListGrantScopes(gomock.Any(), model.ActionIssueRead).Return([]model.ID{namespaceID}, nil)
+		permSvc.EXPECT().ListScopeAncestry(gomock.Any(), namespaceID).Return([]model.ID{namespaceID}, nil)
+
+		base := newBase(ctrl, viewRepo, permSvc)
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().ListForNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, query repository.IssueListForNamespaceQuery) (repository.Page[*repository.PartialIssue], error) {
+				assert.Equal(t, namespaceID, query.NamespaceID)
+				assert.Equal(t, userID, query.ActorID)
+				assert.Equal(t, view.Sort.Field, query.SortField)
+				assert.Equal(t, view.Sort.Direction, query.Order)
+				assert.Equal(t, view.Filter.Statuses, query.Filter.Statuses)
+				assert.Equal(t, view.Filter.Priorities, query.Filter.Priorities)
+				assert.NotEmpty(t, query.Filter.Conditions)
+				return repository.Page[*repository.PartialIssue]{Items: repoIssues}, nil
+			},
+		)
+		base.issueRepo = issueRepo
+
+		s := &issueService{baseService: base}
+		got, err := s.ListByView(ctx, view.ID, CursorPage{Size: 10})
+		require.NoError(t, err)
+		assert.Equal(t, partialIssuesFromRepository(repoIssues), got.Items)
+	})
+
+	t.Run("list issues without permission on the view", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		view := testModel.NewRepositoryView(model.MustNewID(model.ResourceTypeUser), namespaceID)
+
+		viewRepo := repository.NewMockViewRepository(ctrl)
+		viewRepo.EXPECT().Get(ctx, view.ID, repository.ViewDetailProjection()).Return(view, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, view.ID, model.ActionViewRead).Return(false)
+
+		s := &issueService{baseService: newBase(ctrl, viewRepo, permSvc)}
+		_, err := s.ListByView(ctx, view.ID, CursorPage{Size: 10})
+		require.ErrorIs(t, err, ErrIssueGetAll)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("list issues of missing view", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+		viewID := model.MustNewID(model.ResourceTypeView)
+
+		viewRepo := repository.NewMockViewRepository(ctrl)
+		viewRepo.EXPECT().Get(ctx, viewID, repository.ViewDetailProjection()).Return(nil, repository.ErrNotFound)
+
+		s := &issueService{baseService: newBase(ctrl, viewRepo, NewMockPermissionService(ctrl))}
+		_, err := s.ListByView(ctx, viewID, CursorPage{Size: 10})
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("list issues of invalid view ID", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &issueService{baseService: newBase(ctrl, repository.NewMockViewRepository(ctrl), NewMockPermissionService(ctrl))}
+		_, err := s.ListByView(ctx, namespaceID, CursorPage{Size: 10})
+		require.ErrorIs(t, err, model.ErrInvalidID)
+	})
+
+	t.Run("list issues without view repository", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &issueService{baseService: newBase(ctrl, nil, NewMockPermissionService(ctrl))}
+		_, err := s.ListByView(ctx, model.MustNewID(model.ResourceTypeView), CursorPage{Size: 10})
+		require.ErrorIs(t, err, ErrNoViewRepository)
+	})
+}
+
 func TestIssueService_ListByUser(t *testing.T) {
 	userID := model.MustNewID(model.ResourceTypeUser)
 	otherUserID := model.MustNewID(model.ResourceTypeUser)
@@ -1622,6 +1876,7 @@ func TestIssueService_Update(t *testing.T) {
 					tracer.EXPECT().Start(ctx, "service.issueService/Update", gomock.Len(0)).Return(ctx, span)
 
 					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, id, repository.IssueDetailProjection()).Return(repoIssue, nil)
 					issueRepo.EXPECT().Update(ctx, id, repository.UpdateIssueOpts{
 						Title: opts.Title,
 					}, repository.IssueDetailProjection()).Return(repoIssue, nil)
@@ -1750,6 +2005,7 @@ func TestIssueService_Update(t *testing.T) {
 					tracer.EXPECT().Start(ctx, "service.issueService/Update", gomock.Len(0)).Return(ctx, span)
 
 					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Get(ctx, id, repository.IssueDetailProjection()).Return(repoIssue, nil)
 					issueRepo.EXPECT().Update(ctx, id, repository.UpdateIssueOpts{
 						Title: opts.Title,
 					}, repository.IssueDetailProjection()).Return(nil, repository.ErrIssueUpdate)
@@ -1825,12 +2081,14 @@ func TestIssueService_UpdateAssignments(t *testing.T) {
 		staleAssigneeID := model.MustNewID(model.ResourceTypeUser)
 
 		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{}, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		updated := *repoIssue
 		updated.Assignments = []repository.PartialAssignee{
 			{ID: assigneeID, Kind: model.AssignmentKindAssignee},
 		}
 		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(&updated, nil)
+		issueRepo.EXPECT().AutoWatch(ctx, issueID, []model.ID{assigneeID}).Return(nil)
 
 		assignmentRepo := repository.NewMockAssignmentRepository(ctrl)
 		assignmentRepo.EXPECT().ListByResource(ctx, issueID, repository.CursorPage{Size: assignmentSyncPageSize}, repository.AssignmentListProjection()).Return(repository.Page[*repository.Assignment]{Items: []*repository.Assignment{
@@ -1895,12 +2153,14 @@ func TestIssueService_UpdateAssignments(t *testing.T) {
 		tracer.EXPECT().Start(ctx, "service.issueService/Update", gomock.Len(0)).Return(ctx, span)
 
 		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{}, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		updated := *repoIssue
 		updated.Assignments = []repository.PartialAssignee{
 			{ID: reviewerID, Kind: model.AssignmentKindReviewer},
 		}
 		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(&updated, nil)
+		issueRepo.EXPECT().AutoWatch(ctx, issueID, []model.ID{reviewerID}).Return(nil)
 
 		assignmentRepo := repository.NewMockAssignmentRepository(ctrl)
 		assignmentRepo.EXPECT().ListByResource(ctx, issueID, repository.CursorPage{Size: assignmentSyncPageSize}, repository.AssignmentListProjection()).Return(repository.Page[*repository.Assignment]{Items: []*repository.Assignment{}}, nil)
@@ -1993,6 +2253,7 @@ func TestIssueService_UpdateAssignments(t *testing.T) {
 		assigneeB := model.MustNewID(model.ResourceTypeUser)
 
 		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{}, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		updated := *repoIssue
 		updated.Assignments = []repository.PartialAssignee{
@@ -2000,6 +2261,7 @@ func TestIssueService_UpdateAssignments(t *testing.T) {
 			{ID: assigneeB, Kind: model.AssignmentKindAssignee},
 		}
 		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(&updated, nil)
+		issueRepo.EXPECT().AutoWatch(ctx, issueID, gomock.InAnyOrder([]model.ID{assigneeA, assigneeB})).Return(nil)
 
 		assignmentRepo := repository.NewMockAssignmentRepository(ctrl)
 		assignmentRepo.EXPECT().ListByResource(ctx, issueID, repository.CursorPage{Size: assignmentSyncPageSize}, repository.AssignmentListProjection()).Return(repository.Page[*repository.Assignment]{Items: []*repository.Assignment{}}, nil)
@@ -2075,7 +2337,13 @@ func TestIssueService_UpdateParent(t *testing.T) {
 		}
 
 		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(repoIssue, nil)
 		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{}, repository.IssueDetailProjection()).Return(repoIssue, nil)
+		issueRepo.EXPECT().HasRelationCycle(ctx, repository.CreateIssueRelationOpts{
+			Source: issueID,
+			Target: parentID,
+			Kind:   model.IssueRelationKindSubtaskOf,
+		}, nil).Return(false, nil)
 		issueRepo.EXPECT().AddRelation(ctx, repository.CreateIssueRelationOpts{
 			Source: issueID,
 			Target: parentID,
@@ -2136,6 +2404,7 @@ func TestIssueService_UpdateParent(t *testing.T) {
 		}
 
 		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issueID, repository.IssueDetailProjection()).Return(&current, nil)
 		issueRepo.EXPECT().Update(ctx, issueID, repository.UpdateIssueOpts{}, repository.IssueDetailProjection()).Return(&current, nil)
 		issueRepo.EXPECT().RemoveRelation(ctx, issueID, currentParentID, model.IssueRelationKindSubtaskOf).Return(nil)
 		cleared := current
@@ -2290,6 +2559,63 @@ func TestIssueService_Delete(t *testing.T) {
 				id:  issueID,
 			},
 		},
+		{
+			name: "delete issue planned in open sprint with records",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, id model.ID) *baseService {
+					sprintID := model.MustNewID(model.ResourceTypeSprint)
+
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Delete", gomock.Len(0)).Return(ctx, span)
+
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().GetBatchScopes(ctx, []model.ID{id}).Return([]*repository.IssueBatchScope{
+						{ID: id, Sprints: []model.ID{sprintID}},
+					}, nil)
+					issueRepo.EXPECT().Delete(ctx, id).Return(nil)
+
+					scopeChangeRepo := repository.NewMockSprintScopeChangeRepository(ctrl)
+					scopeChangeRepo.EXPECT().Create(ctx, []repository.CreateSprintScopeChangeOpts{
+						{Sprint: sprintID, Issue: id, Kind: repository.SprintScopeChangeKindRemoved},
+					}).Return(nil, nil)
+
+					workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+					workLogRepo.EXPECT().DeleteByIssues(ctx, []model.ID{id}).Return(nil)
+
+					issueActivityRepo := repository.NewMockIssueActivityRepository(ctrl)
+					issueActivityRepo.EXPECT().DeleteByIssues(ctx, []model.ID{id}).Return(nil)
+
+					mentionRepo := repository.NewMockMentionRepository(ctrl)
+					mentionRepo.EXPECT().DeleteBySources(ctx, []model.ID{id}).Return(nil)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, id, gomock.Any()).Return(true)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+					return &baseService{
+						searchService:     mockSearchDelete(ctrl),
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						issueRepo:         issueRepo,
+						scopeChangeRepo:   scopeChangeRepo,
+						workLogRepo:       workLogRepo,
+						issueActivityRepo: issueActivityRepo,
+						mentionRepo:       mentionRepo,
+						permissionService: permSvc,
+						licenseService:    licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  issueID,
+			},
+		},
 		{
 			name: "delete issue with license expired",
 			fields: fields{
@@ -2435,44 +2761,274 @@ func TestIssueService_Delete(t *testing.T) {
 	}
 }
 
-func TestIssueService_ListRelations(t *testing.T) {
+func TestIssueService_Rank(t *testing.T) {
 	issueID := model.MustNewID(model.ResourceTypeIssue)
-	relatedID := model.MustNewID(model.ResourceTypeIssue)
-	item := &repository.IssueRelationItem{
-		ID:        model.MustNewID(model.ResourceTypeIssueRelation),
-		Kind:      model.IssueRelationKindBlocks,
-		Source:    issueID,
-		Target:    relatedID,
-		Related:   &repository.PartialIssue{ID: relatedID, Key: "ENG-1", NumericID: 1, Kind: model.IssueKindTask, Title: "related", Status: model.IssueStatusOpen, Priority: model.IssuePriorityNormal, Assignments: []repository.PartialAssignee{}, Labels: []repository.PartialLabel{}},
-		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	beforeID := model.MustNewID(model.ResourceTypeIssue)
+	userID := model.MustNewID(model.ResourceTypeUser)
+	repoIssue := testModel.NewRepositoryIssue(userID)
+	repoIssue.ID = issueID
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts) *baseService
+	}
+	type args struct {
+		ctx  context.Context
+		id   model.ID
+		opts RankIssueOpts
 	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Issue
+		wantErr error
+	}{
+		{
+			name: "rank issue",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
 
-	t.Run("success", func(t *testing.T) {
-		t.Parallel()
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Rank", gomock.Len(0)).Return(ctx, span)
 
-		span := mock.NewMockSpan(ctrl)
-		span.EXPECT().End(gomock.Len(0))
-		tracer := mock.NewMockTracer(ctrl)
-		tracer.EXPECT().Start(gomock.Any(), "service.issueService/ListRelations", gomock.Len(0)).Return(context.Background(), span)
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Rank(ctx, id, repository.RankIssueOpts{Before: opts.Before}).Return(nil)
+					issueRepo.EXPECT().Get(ctx, id, repository.IssueDetailProjection()).Return(repoIssue, nil)
 
-		issueRepo := repository.NewMockIssueRepository(ctrl)
-		issueRepo.EXPECT().ListRelations(gomock.Any(), repository.IssueRelationListQuery{
-			IssueID: issueID,
-			Page:    repository.CursorPage{Size: 100},
-		}).Return(repository.Page[*repository.IssueRelationItem]{Items: []*repository.IssueRelationItem{item}}, nil)
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, id, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, *opts.Before, model.ActionIssueRead).Return(true)
 
-		permSvc := NewMockPermissionService(ctrl)
-		permSvc.EXPECT().BootstrapCreator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, gomock.Any()).Return(true)
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
 
-		s := &issueService{baseService: &baseService{
-			searchService:     NewMockSearchService(ctrl),
-			logger:            mock.NewMockLogger(ctrl),
-			tracer:            tracer,
-			issueRepo:         issueRepo,
-			permissionService: permSvc,
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+						licenseService:    licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   issueID,
+				opts: RankIssueOpts{Before: &beforeID},
+			},
+			want: issueFromRepository(repoIssue),
+		},
+		{
+			name: "rank issue with license expired",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, _ model.ID, _ RankIssueOpts) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Rank", gomock.Len(0)).Return(ctx, span)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(true, nil)
+
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         tracer,
+						licenseService: licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   issueID,
+				opts: RankIssueOpts{Before: &beforeID},
+			},
+			wantErr: license.ErrLicenseExpired,
+		},
+		{
+			name: "rank issue without anchor",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, _ model.ID, _ RankIssueOpts) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Rank", gomock.Len(0)).Return(ctx, span)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         tracer,
+						licenseService: licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  issueID,
+			},
+			wantErr: ErrIssueRankAnchor,
+		},
+		{
+			name: "rank issue next to itself",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, _ model.ID, _ RankIssueOpts) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Rank", gomock.Len(0)).Return(ctx, span)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         tracer,
+						licenseService: licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   issueID,
+				opts: RankIssueOpts{After: &issueID},
+			},
+			wantErr: ErrIssueRankAnchor,
+		},
+		{
+			name: "rank issue with no permission on the anchor",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Rank", gomock.Len(0)).Return(ctx, span)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, id, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, *opts.Before, model.ActionIssueRead).Return(false)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						permissionService: permSvc,
+						licenseService:    licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   issueID,
+				opts: RankIssueOpts{Before: &beforeID},
+			},
+			wantErr: ErrNoPermission,
+		},
+		{
+			name: "rank issue with repository error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context, id model.ID, opts RankIssueOpts) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.issueService/Rank", gomock.Len(0)).Return(ctx, span)
+
+					issueRepo := repository.NewMockIssueRepository(ctrl)
+					issueRepo.EXPECT().Rank(ctx, id, repository.RankIssueOpts{Before: opts.Before}).Return(repository.ErrIssueRankAnchor)
+
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, id, model.ActionIssueUpdate).Return(true)
+					permSvc.EXPECT().CtxUserHas(ctx, *opts.Before, model.ActionIssueRead).Return(true)
+
+					licenseSvc := mock.NewMockLicenseService(ctrl)
+					licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            tracer,
+						issueRepo:         issueRepo,
+						permissionService: permSvc,
+						licenseService:    licenseSvc,
+					}
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   issueID,
+				opts: RankIssueOpts{Before: &beforeID},
+			},
+			wantErr: repository.ErrIssueRankAnchor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := &issueService{
+				baseService: tt.fields.baseService(ctrl, tt.args.ctx, tt.args.id, tt.args.opts),
+			}
+
+			got, err := s.Rank(tt.args.ctx, tt.args.id, tt.args.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrIssueRank)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIssueService_ListRelations(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	relatedID := model.MustNewID(model.ResourceTypeIssue)
+	item := &repository.IssueRelationItem{
+		ID:        model.MustNewID(model.ResourceTypeIssueRelation),
+		Kind:      model.IssueRelationKindBlocks,
+		Source:    issueID,
+		Target:    relatedID,
+		Related:   &repository.PartialIssue{ID: relatedID, Key: "ENG-1", NumericID: 1, Kind: model.IssueKindTask, Title: "related", Status: model.IssueStatusOpen, Priority: model.IssuePriorityNormal, Assignments: []repository.PartialAssignee{}, Labels: []repository.PartialLabel{}},
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/ListRelations", gomock.Len(0)).Return(context.Background(), span)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().ListRelations(gomock.Any(), repository.IssueRelationListQuery{
+			IssueID: issueID,
+			Page:    repository.CursorPage{Size: 100},
+		}).Return(repository.Page[*repository.IssueRelationItem]{Items: []*repository.IssueRelationItem{item}}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().BootstrapCreator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, gomock.Any()).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			searchService:     NewMockSearchService(ctrl),
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
 		}}
 		got, err := s.ListRelations(context.Background(), issueID, CursorPage{Size: 100})
 		require.NoError(t, err)
@@ -2568,6 +3124,11 @@ func TestIssueService_AddRelation(t *testing.T) {
 		tracer.EXPECT().Start(gomock.Any(), "service.issueService/AddRelation", gomock.Len(0)).Return(context.Background(), span)
 
 		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().HasRelationCycle(gomock.Any(), repository.CreateIssueRelationOpts{
+			Source: issueID,
+			Target: relatedID,
+			Kind:   model.IssueRelationKindBlocks,
+		}, nil).Return(false, nil)
 		issueRepo.EXPECT().AddRelation(gomock.Any(), repository.CreateIssueRelationOpts{
 			Source: issueID,
 			Target: relatedID,
@@ -2598,6 +3159,47 @@ func TestIssueService_AddRelation(t *testing.T) {
 		assert.Equal(t, relatedID, got.Related.ID)
 	})
 
+	t.Run("success enqueues notification", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, model.MustNewID(model.ResourceTypeUser))
+
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/AddRelation", gomock.Len(0)).Return(ctx, span)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().HasRelationCycle(gomock.Any(), gomock.Any(), nil).Return(false, nil)
+		issueRepo.EXPECT().AddRelation(gomock.Any(), gomock.Any()).Return(created, nil)
+		issueRepo.EXPECT().Get(gomock.Any(), relatedID, repository.IssueProjection{}).Return(relatedIssue, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, gomock.Any()).Return(true)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), relatedID, gomock.Any()).Return(true)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(gomock.Any()).Return(false, nil)
+
+		enqueuer := &stubSearchEnqueuer{}
+		s := &issueService{baseService: &baseService{
+			searchService:            NewMockSearchService(ctrl),
+			logger:                   mock.NewMockLogger(ctrl),
+			tracer:                   tracer,
+			issueRepo:                issueRepo,
+			permissionService:        permSvc,
+			licenseService:           licenseSvc,
+			notificationTaskEnqueuer: enqueuer,
+		}}
+		_, err := s.AddRelation(ctx, issueID, relatedID, model.IssueRelationKindBlocks)
+		require.NoError(t, err)
+		require.NotNil(t, enqueuer.task)
+		assert.Equal(t, queue.TaskTypeNotificationIssueRelation.String(), enqueuer.task.Type())
+		assert.Contains(t, string(enqueuer.task.Payload()), issueID.Composite())
+	})
+
 	t.Run("self relation", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
@@ -2926,3 +3528,210 @@ func TestIssueService_RemoveRelation(t *testing.T) {
 		assert.ErrorIs(t, err, ErrNoPermission)
 	})
 }
+
+func TestIssueService_GetWatchers(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/GetWatchers", gomock.Len(0)).Return(context.Background(), span)
+
+		watcher := testModel.NewRepositoryUser()
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetWatchers(gomock.Any(), issueID).Return([]*repository.User{watcher}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, model.ActionIssueRead).Return(true)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+		}}
+		got, err := s.GetWatchers(context.Background(), issueID)
+		require.NoError(t, err)
+		assert.Equal(t, []*PartialUser{{
+			ID:        watcher.ID,
+			FirstName: watcher.FirstName,
+			LastName:  watcher.LastName,
+			Picture:   watcher.Picture,
+		}}, got)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(gomock.Any(), "service.issueService/GetWatchers", gomock.Len(0)).Return(context.Background(), span)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(gomock.Any(), issueID, model.ActionIssueRead).Return(false)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			issueRepo:         repository.NewMockIssueRepository(ctrl),
+			permissionService: permSvc,
+		}}
+		_, err := s.GetWatchers(context.Background(), issueID)
+		assert.ErrorIs(t, err, ErrIssueGetWatchers)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestIssueService_Watch(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	userID := model.MustNewID(model.ResourceTypeUser)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/Watch", gomock.Len(0)).Return(ctx, span)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().AddWatcher(ctx, issueID, userID).Return(nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+		require.NoError(t, s.Watch(ctx, issueID))
+	})
+
+	t.Run("expired license", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/Watch", gomock.Len(0)).Return(ctx, span)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(true, nil)
+
+		s := &issueService{baseService: &baseService{
+			logger:         mock.NewMockLogger(ctrl),
+			tracer:         tracer,
+			licenseService: licenseSvc,
+		}}
+		err := s.Watch(ctx, issueID)
+		assert.ErrorIs(t, err, ErrIssueWatch)
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+
+	t.Run("no user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/Watch", gomock.Len(0)).Return(ctx, span)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+		s := &issueService{baseService: &baseService{
+			logger:         mock.NewMockLogger(ctrl),
+			tracer:         tracer,
+			licenseService: licenseSvc,
+		}}
+		err := s.Watch(ctx, issueID)
+		assert.ErrorIs(t, err, ErrNoUser)
+	})
+}
+
+func TestIssueService_Unwatch(t *testing.T) {
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	userID := model.MustNewID(model.ResourceTypeUser)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/Unwatch", gomock.Len(0)).Return(ctx, span)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().RemoveWatcher(ctx, issueID, userID).Return(nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			issueRepo:         issueRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+		require.NoError(t, s.Unwatch(ctx, issueID))
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+		span := mock.NewMockSpan(ctrl)
+		span.EXPECT().End(gomock.Len(0))
+		tracer := mock.NewMockTracer(ctrl)
+		tracer.EXPECT().Start(ctx, "service.issueService/Unwatch", gomock.Len(0)).Return(ctx, span)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+		licenseSvc := mock.NewMockLicenseService(ctrl)
+		licenseSvc.EXPECT().Expired(ctx).Return(false, nil)
+
+		s := &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            tracer,
+			issueRepo:         repository.NewMockIssueRepository(ctrl),
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+		err := s.Unwatch(ctx, issueID)
+		assert.ErrorIs(t, err, ErrIssueUnwatch)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
@@ -3,9 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
+	"github.com/opcotech/elemo/internal/license"
 	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
 	"github.com/opcotech/elemo/internal/repository"
 )
 
@@ -15,21 +19,100 @@ type PartialLabel struct {
 	Name string
 }
 
-// Label represents a label returned by the service.
+// Label represents a label returned by the service. Scope is the organization
+// or project the label is defined in, and it is nil for unscoped labels.
 type Label struct {
 	ID          model.ID
 	Name        string
 	Description string
+	Color       string
+	Scope       *model.ID
 	CreatedAt   *time.Time
 	UpdatedAt   *time.Time
 }
 
+// CreateLabelOpts holds the data required to create a label.
+type CreateLabelOpts struct {
+	Name        string `json:"name" validate:"required,min=3,max=120"`
+	Description string `json:"description" validate:"omitempty,min=5,max=500"`
+	Color       string `json:"color" validate:"omitempty,hexcolor"`
+}
+
+// Validate validates the create options.
+func (o *CreateLabelOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidLabelDetails, err)
+	}
+	return nil
+}
+
+// UpdateLabelOpts holds the fields that can be updated on a label.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateLabelOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Color       optional.Optional[string]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateLabelOpts) Validate() error {
+	fields := []struct {
+		value optional.Optional[string]
+		tag   string
+	}{
+		{o.Name, "required,min=3,max=120"},
+		{o.Description, "omitempty,min=5,max=500"},
+		{o.Color, "omitempty,hexcolor"},
+	}
+
+	for _, f := range fields {
+		if !f.value.Defined {
+			continue
+		}
+		var value string
+		if f.value.Value != nil {
+			value = *f.value.Value
+		}
+		if err := validate.Var(value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidLabelDetails, err)
+		}
+	}
+
+	return nil
+}
+
+// clearedToEmpty turns an explicit null into an empty string, so the stored
+// property is cleared instead of being left unset.
+func clearedToEmpty(o optional.Optional[string]) optional.Optional[string] {
+	if o.Defined && o.Value == nil {
+		return optional.Some("")
+	}
+	return o
+}
+
 // LabelService serves the business logic of interacting with labels.
 //
 //go:generate go tool mockgen -destination=label_mock_gen.go -package=service -mock_names LabelService=MockLabelService . LabelService
 type LabelService interface {
+	// Create creates a new label in the organization or project scope.
+	Create(ctx context.Context, scope model.ID, opts CreateLabelOpts) (*Label, error)
+	// Get returns a label by its ID.
+	Get(ctx context.Context, id model.ID) (*Label, error)
 	// List returns a cursor-paginated page of labels.
 	List(ctx context.Context, page CursorPage) (Page[*Label], error)
+	// ListForScope returns a cursor-paginated page of labels usable in the
+	// organization or project scope. Projects include the labels of their
+	// organization.
+	ListForScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Label], error)
+	// Update updates a label.
+	Update(ctx context.Context, id model.ID, opts UpdateLabelOpts) (*Label, error)
+	// Delete deletes a label and detaches it from every resource.
+	Delete(ctx context.Context, id model.ID) error
+	// AttachTo attaches a label to an issue or document. The label must be
+	// defined in the scope of the resource.
+	AttachTo(ctx context.Context, labelID, attachTo model.ID) error
+	// DetachFrom detaches a label from an issue or document.
+	DetachFrom(ctx context.Context, labelID, detachFrom model.ID) error
 }
 
 // labelService is the concrete implementation of LabelService.
@@ -45,11 +128,144 @@ func labelFromRepository(l *repository.Label) *Label {
 		ID:          l.ID,
 		Name:        l.Name,
 		Description: l.Description,
+		Color:       l.Color,
+		Scope:       l.Scope,
 		CreatedAt:   l.CreatedAt,
 		UpdatedAt:   l.UpdatedAt,
 	}
 }
 
+// labelScopeReadAction returns the action required to read the labels of an
+// organization or project. The last result is false for other resources.
+func labelScopeReadAction(scope model.ID) (model.Action, bool) {
+	switch scope.Type {
+	case model.ResourceTypeOrganization:
+		return model.ActionOrganizationRead, true
+	case model.ResourceTypeProject:
+		return model.ActionProjectRead, true
+	default:
+		return "", false
+	}
+}
+
+// canReadScope reports whether the context user can read the labels of the
+// organization or project scope.
+func (s *labelService) canReadScope(ctx context.Context, scope model.ID) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	action, ok := labelScopeReadAction(scope)
+	if !ok {
+		return model.ErrInvalidID
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, scope, action) {
+		return ErrNoPermission
+	}
+
+	return nil
+}
+
+// canAttach reports whether the context user can change the labels of the
+// issue or document, and whether the label is usable on it.
+func (s *labelService) canAttach(ctx context.Context, labelID, target model.ID) error {
+	if err := labelID.Validate(); err != nil {
+		return err
+	}
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	if target.Type != model.ResourceTypeIssue && target.Type != model.ResourceTypeDocument {
+		return model.ErrInvalidID
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, target, model.ActionLabelAttach) {
+		return ErrNoPermission
+	}
+
+	label, err := s.labelRepo.Get(ctx, labelID, repository.LabelDetailProjection())
+	if err != nil {
+		return err
+	}
+
+	if label.Scope == nil {
+		return nil
+	}
+
+	ancestry, err := s.permissionService.ListScopeAncestry(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(ancestry, *label.Scope) {
+		return errors.Join(model.ErrInvalidLabelDetails, ErrLabelOutOfScope)
+	}
+
+	return nil
+}
+
+func (s *labelService) Create(ctx context.Context, scope model.ID, opts CreateLabelOpts) (*Label, error) {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrLabelCreate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrLabelCreate, err)
+	}
+
+	if err := scope.Validate(); err != nil {
+		return nil, errors.Join(ErrLabelCreate, err)
+	}
+
+	if _, ok := labelScopeReadAction(scope); !ok {
+		return nil, errors.Join(ErrLabelCreate, model.ErrInvalidID)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, scope, model.ActionLabelManage) {
+		return nil, errors.Join(ErrLabelCreate, ErrNoPermission)
+	}
+
+	label, err := s.labelRepo.Create(ctx, repository.CreateLabelOpts{
+		Scope:       scope,
+		Name:        opts.Name,
+		Description: opts.Description,
+		Color:       opts.Color,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrLabelCreate, err)
+	}
+
+	return labelFromRepository(label), nil
+}
+
+func (s *labelService) Get(ctx context.Context, id model.ID) (*Label, error) {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/Get")
+	defer span.End()
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrLabelGet, err)
+	}
+
+	label, err := s.labelRepo.Get(ctx, id, repository.LabelDetailProjection())
+	if err != nil {
+		return nil, errors.Join(ErrLabelGet, err)
+	}
+
+	if label.Scope != nil {
+		if err := s.canReadScope(ctx, *label.Scope); err != nil {
+			return nil, errors.Join(ErrLabelGet, err)
+		}
+	}
+
+	return labelFromRepository(label), nil
+}
+
 func (s *labelService) List(ctx context.Context, page CursorPage) (Page[*Label], error) {
 	ctx, span := s.tracer.Start(ctx, "service.labelService/List")
 	defer span.End()
@@ -67,6 +283,132 @@ func (s *labelService) List(ctx context.Context, page CursorPage) (Page[*Label],
 	return mapPage(labels, labelFromRepository), nil
 }
 
+func (s *labelService) ListForScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Label], error) {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/ListForScope")
+	defer span.End()
+
+	if err := s.canReadScope(ctx, scope); err != nil {
+		return Page[*Label]{}, errors.Join(ErrLabelGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Label]{}, errors.Join(ErrLabelGetAll, err)
+	}
+
+	labels, err := s.labelRepo.ListForScope(ctx, scope, normalized, repository.LabelListProjection())
+	if err != nil {
+		return Page[*Label]{}, errors.Join(ErrLabelGetAll, err)
+	}
+
+	return mapPage(labels, labelFromRepository), nil
+}
+
+func (s *labelService) Update(ctx context.Context, id model.ID, opts UpdateLabelOpts) (*Label, error) {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrLabelUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrLabelUpdate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrLabelUpdate, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, id, model.ActionLabelManage) {
+		return nil, errors.Join(ErrLabelUpdate, ErrNoPermission)
+	}
+
+	label, err := s.labelRepo.Update(ctx, id, repository.UpdateLabelOpts{
+		Name:        opts.Name,
+		Description: clearedToEmpty(opts.Description),
+		Color:       clearedToEmpty(opts.Color),
+	})
+	if err != nil {
+		return nil, errors.Join(ErrLabelUpdate, err)
+	}
+
+	return labelFromRepository(label), nil
+}
+
+func (s *labelService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrLabelDelete, license.ErrLicenseExpired)
+	}
+
+	if err := id.Validate(); err != nil {
+		return errors.Join(ErrLabelDelete, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, id, model.ActionLabelManage) {
+		return errors.Join(ErrLabelDelete, ErrNoPermission)
+	}
+
+	if err := s.labelRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrLabelDelete, err)
+	}
+
+	return nil
+}
+
+func (s *labelService) AttachTo(ctx context.Context, labelID, attachTo model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/AttachTo")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrLabelAttach, license.ErrLicenseExpired)
+	}
+
+	if err := s.canAttach(ctx, labelID, attachTo); err != nil {
+		return errors.Join(ErrLabelAttach, err)
+	}
+
+	if err := s.labelRepo.AttachTo(ctx, labelID, attachTo); err != nil {
+		return errors.Join(ErrLabelAttach, err)
+	}
+
+	return nil
+}
+
+func (s *labelService) DetachFrom(ctx context.Context, labelID, detachFrom model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.labelService/DetachFrom")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrLabelDetach, license.ErrLicenseExpired)
+	}
+
+	if err := labelID.Validate(); err != nil {
+		return errors.Join(ErrLabelDetach, err)
+	}
+
+	if err := detachFrom.Validate(); err != nil {
+		return errors.Join(ErrLabelDetach, err)
+	}
+
+	if detachFrom.Type != model.ResourceTypeIssue && detachFrom.Type != model.ResourceTypeDocument {
+		return errors.Join(ErrLabelDetach, model.ErrInvalidID)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, detachFrom, model.ActionLabelAttach) {
+		return errors.Join(ErrLabelDetach, ErrNoPermission)
+	}
+
+	if err := s.labelRepo.DetachFrom(ctx, labelID, detachFrom); err != nil {
+		return errors.Join(ErrLabelDetach, err)
+	}
+
+	return nil
+}
+
 // NewLabelService returns a new instance of the LabelService interface.
 func NewLabelService(opts ...Option) (LabelService, error) {
 	s, err := newService(opts...)
@@ -82,5 +424,13 @@ func NewLabelService(opts ...Option) (LabelService, error) {
 		return nil, ErrNoLabelRepository
 	}
 
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
 	return svc, nil
 }
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/opcotech/elemo/internal/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,78 @@ func (m *MockLabelService) EXPECT() *MockLabelServiceMockRecorder {
 	return m.recorder
 }
 
+// AttachTo mocks base method.
+func (m *MockLabelService) AttachTo(ctx context.Context, labelID, attachTo model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTo", ctx, labelID, attachTo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachTo indicates an expected call of AttachTo.
+func (mr *MockLabelServiceMockRecorder) AttachTo(ctx, labelID, attachTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTo", reflect.TypeOf((*MockLabelService)(nil).AttachTo), ctx, labelID, attachTo)
+}
+
+// Create mocks base method.
+func (m *MockLabelService) Create(ctx context.Context, scope model.ID, opts CreateLabelOpts) (*Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, scope, opts)
+	ret0, _ := ret[0].(*Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLabelServiceMockRecorder) Create(ctx, scope, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLabelService)(nil).Create), ctx, scope, opts)
+}
+
+// Delete mocks base method.
+func (m *MockLabelService) Delete(ctx context.Context, id model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLabelServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLabelService)(nil).Delete), ctx, id)
+}
+
+// DetachFrom mocks base method.
+func (m *MockLabelService) DetachFrom(ctx context.Context, labelID, detachFrom model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFrom", ctx, labelID, detachFrom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFrom indicates an expected call of DetachFrom.
+func (mr *MockLabelServiceMockRecorder) DetachFrom(ctx, labelID, detachFrom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFrom", reflect.TypeOf((*MockLabelService)(nil).DetachFrom), ctx, labelID, detachFrom)
+}
+
+// Get mocks base method.
+func (m *MockLabelService) Get(ctx context.Context, id model.ID) (*Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLabelServiceMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLabelService)(nil).Get), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockLabelService) List(ctx context.Context, page CursorPage) (Page[*Label], error) {
 	m.ctrl.T.Helper()
@@ -54,3 +127,33 @@ func (mr *MockLabelServiceMockRecorder) List(ctx, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLabelService)(nil).List), ctx, page)
 }
+
+// ListForScope mocks base method.
+func (m *MockLabelService) ListForScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Label], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForScope", ctx, scope, page)
+	ret0, _ := ret[0].(Page[*Label])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForScope indicates an expected call of ListForScope.
+func (mr *MockLabelServiceMockRecorder) ListForScope(ctx, scope, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForScope", reflect.TypeOf((*MockLabelService)(nil).ListForScope), ctx, scope, page)
+}
+
+// Update mocks base method.
+func (m *MockLabelService) Update(ctx context.Context, id model.ID, opts UpdateLabelOpts) (*Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, opts)
+	ret0, _ := ret[0].(*Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLabelServiceMockRecorder) Update(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLabelService)(nil).Update), ctx, id, opts)
+}
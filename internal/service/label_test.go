@@ -0,0 +1,814 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLabelService(t *testing.T) {
+	type args struct {
+		opts func(ctrl *gomock.Controller) []Option
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    func(ctrl *gomock.Controller) LabelService
+		wantErr error
+	}{
+		{
+			name: "new label service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithLabelRepository(repository.NewMockLabelRepository(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			want: func(ctrl *gomock.Controller) LabelService {
+				return &labelService{
+					baseService: &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            mock.NewMockTracer(ctrl),
+						labelRepo:         repository.NewMockLabelRepository(nil),
+						permissionService: NewMockPermissionService(nil),
+						licenseService:    mock.NewMockLicenseService(nil),
+					},
+				}
+			},
+		},
+		{
+			name: "new label service with invalid options",
+			args: args{
+				opts: func(_ *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(nil),
+					}
+				},
+			},
+			wantErr: log.ErrNoLogger,
+		},
+		{
+			name: "new label service with no label repository",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithPermissionService(NewMockPermissionService(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoLabelRepository,
+		},
+		{
+			name: "new label service with no license service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithLabelRepository(repository.NewMockLabelRepository(nil)),
+						WithPermissionService(NewMockPermissionService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new label service with no permission service",
+			args: args{
+				opts: func(ctrl *gomock.Controller) []Option {
+					return []Option{
+						WithLogger(mock.NewMockLogger(ctrl)),
+						WithTracer(mock.NewMockTracer(ctrl)),
+						WithLabelRepository(repository.NewMockLabelRepository(nil)),
+						WithLicenseService(mock.NewMockLicenseService(nil)),
+					}
+				},
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			got, err := NewLabelService(tt.args.opts(ctrl)...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want(ctrl), got)
+		})
+	}
+}
+
+func TestLabelService_Create(t *testing.T) {
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoLabel := testModel.NewRepositoryLabel(orgID)
+	opts := CreateLabelOpts{Name: repoLabel.Name, Description: repoLabel.Description, Color: repoLabel.Color}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	type args struct {
+		scope model.ID
+		opts  CreateLabelOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *Label
+		wantErr error
+	}{
+		{
+			name: "create label in organization",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionLabelManage).Return(true)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Create(ctx, repository.CreateLabelOpts{
+						Scope:       orgID,
+						Name:        opts.Name,
+						Description: opts.Description,
+						Color:       opts.Color,
+					}).Return(repoLabel, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Create"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				scope: orgID,
+				opts:  opts,
+			},
+			want: labelFromRepository(repoLabel),
+		},
+		{
+			name: "create label with expired license",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.labelService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+					}
+				},
+			},
+			args: args{
+				scope: orgID,
+				opts:  opts,
+			},
+			wantErr: license.ErrLicenseExpired,
+		},
+		{
+			name: "create label with invalid color",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.labelService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				scope: orgID,
+				opts:  CreateLabelOpts{Name: opts.Name, Color: "blue"},
+			},
+			wantErr: model.ErrInvalidLabelDetails,
+		},
+		{
+			name: "create label in unsupported scope",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.labelService/Create"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				scope: model.MustNewID(model.ResourceTypeNamespace),
+				opts:  opts,
+			},
+			wantErr: model.ErrInvalidID,
+		},
+		{
+			name: "create label with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionLabelManage).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Create"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			args: args{
+				scope: orgID,
+				opts:  opts,
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			got, err := s.Create(ctx, tt.args.scope, tt.args.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelCreate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLabelService_Get(t *testing.T) {
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	repoLabel := testModel.NewRepositoryLabel(projectID)
+	unscopedLabel := testModel.NewRepositoryLabel(projectID)
+	unscopedLabel.Scope = nil
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		label   *repository.Label
+		wantErr error
+	}{
+		{
+			name:  "get project label",
+			label: repoLabel,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Get(ctx, repoLabel.ID, repository.LabelDetailProjection()).Return(repoLabel, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Get"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+		},
+		{
+			name:  "get unscoped label",
+			label: unscopedLabel,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Get(ctx, unscopedLabel.ID, repository.LabelDetailProjection()).Return(unscopedLabel, nil)
+
+					return &baseService{
+						logger:    mock.NewMockLogger(ctrl),
+						tracer:    newCommentTestTracer(ctrl, ctx, "service.labelService/Get"),
+						labelRepo: labelRepo,
+					}
+				},
+			},
+		},
+		{
+			name:  "get label with no permission",
+			label: repoLabel,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Get(ctx, repoLabel.ID, repository.LabelDetailProjection()).Return(repoLabel, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Get"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			got, err := s.Get(ctx, tt.label.ID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelGet)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, labelFromRepository(tt.label), got)
+		})
+	}
+}
+
+func TestLabelService_ListForScope(t *testing.T) {
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	repoLabel := testModel.NewRepositoryLabel(projectID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		scope   model.ID
+		want    Page[*Label]
+		wantErr error
+	}{
+		{
+			name:  "list project labels",
+			scope: projectID,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().ListForScope(ctx, projectID, repository.CursorPage{Size: repository.DefaultPageSize}, repository.LabelListProjection()).
+						Return(repository.Page[*repository.Label]{Items: []*repository.Label{repoLabel}}, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/ListForScope"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+					}
+				},
+			},
+			want: Page[*Label]{Items: []*Label{labelFromRepository(repoLabel)}},
+		},
+		{
+			name:  "list labels of unsupported scope",
+			scope: model.MustNewID(model.ResourceTypeIssue),
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger: mock.NewMockLogger(ctrl),
+						tracer: newCommentTestTracer(ctrl, ctx, "service.labelService/ListForScope"),
+					}
+				},
+			},
+			wantErr: model.ErrInvalidID,
+		},
+		{
+			name:  "list labels with no permission",
+			scope: projectID,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/ListForScope"),
+						permissionService: permSvc,
+					}
+				},
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			got, err := s.ListForScope(ctx, tt.scope, CursorPage{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelGetAll)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLabelService_Update(t *testing.T) {
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoLabel := testModel.NewRepositoryLabel(orgID)
+	opts := UpdateLabelOpts{Color: optional.Some("#d73a4a")}
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		opts    UpdateLabelOpts
+		wantErr error
+	}{
+		{
+			name: "update label color",
+			opts: opts,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, repoLabel.ID, model.ActionLabelManage).Return(true)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Update(ctx, repoLabel.ID, repository.UpdateLabelOpts{Color: opts.Color}).Return(repoLabel, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Update"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+		},
+		{
+			name: "update label with empty name",
+			opts: UpdateLabelOpts{Name: optional.Optional[string]{Defined: true, Value: convert.ToPointer("")}},
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.labelService/Update"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: model.ErrInvalidLabelDetails,
+		},
+		{
+			name: "update label with no permission",
+			opts: opts,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, repoLabel.ID, model.ActionLabelManage).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Update"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			got, err := s.Update(ctx, repoLabel.ID, tt.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelUpdate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, labelFromRepository(repoLabel), got)
+		})
+	}
+}
+
+func TestLabelService_Delete(t *testing.T) {
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "delete label",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, labelID, model.ActionLabelManage).Return(true)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Delete(ctx, labelID).Return(nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Delete"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+		},
+		{
+			name: "delete label with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, labelID, model.ActionLabelManage).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/Delete"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			err := s.Delete(ctx, labelID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelDelete)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLabelService_AttachTo(t *testing.T) {
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	repoLabel := testModel.NewRepositoryLabel(orgID)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		attachTo model.ID
+		wantErr  error
+	}{
+		{
+			name:     "attach organization label to issue",
+			attachTo: issueID,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionLabelAttach).Return(true)
+					permSvc.EXPECT().ListScopeAncestry(ctx, issueID).Return([]model.ID{issueID, projectID, orgID}, nil)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Get(ctx, repoLabel.ID, repository.LabelDetailProjection()).Return(repoLabel, nil)
+					labelRepo.EXPECT().AttachTo(ctx, repoLabel.ID, issueID).Return(nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/AttachTo"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+		},
+		{
+			name:     "attach label from another scope",
+			attachTo: issueID,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionLabelAttach).Return(true)
+					permSvc.EXPECT().ListScopeAncestry(ctx, issueID).Return([]model.ID{issueID, projectID}, nil)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().Get(ctx, repoLabel.ID, repository.LabelDetailProjection()).Return(repoLabel, nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/AttachTo"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: ErrLabelOutOfScope,
+		},
+		{
+			name:     "attach label to unsupported resource",
+			attachTo: projectID,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         newCommentTestTracer(ctrl, ctx, "service.labelService/AttachTo"),
+						licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: model.ErrInvalidID,
+		},
+		{
+			name:     "attach label with no permission",
+			attachTo: issueID,
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionLabelAttach).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/AttachTo"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			err := s.AttachTo(ctx, repoLabel.ID, tt.attachTo)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelAttach)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLabelService_DetachFrom(t *testing.T) {
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+
+	type fields struct {
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "detach label from document",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionLabelAttach).Return(true)
+
+					labelRepo := repository.NewMockLabelRepository(ctrl)
+					labelRepo.EXPECT().DetachFrom(ctx, labelID, documentID).Return(nil)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/DetachFrom"),
+						labelRepo:         labelRepo,
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+		},
+		{
+			name: "detach label with no permission",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					permSvc := NewMockPermissionService(ctrl)
+					permSvc.EXPECT().CtxUserHas(ctx, documentID, model.ActionLabelAttach).Return(false)
+
+					return &baseService{
+						logger:            mock.NewMockLogger(ctrl),
+						tracer:            newCommentTestTracer(ctrl, ctx, "service.labelService/DetachFrom"),
+						permissionService: permSvc,
+						licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+					}
+				},
+			},
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			s := &labelService{
+				baseService: tt.fields.baseService(ctrl, ctx),
+			}
+
+			err := s.DetachFrom(ctx, labelID, documentID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrLabelDetach)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
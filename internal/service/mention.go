@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	// NotificationEventMention is the event of notifications sent to users
+	// mentioned in an issue, document or comment.
+	NotificationEventMention = "notification:mention"
+
+	mentionNotificationTitle = "You were mentioned in %s"
+	documentMentionSubject   = "a document"
+	commentMentionSubject    = "a comment"
+)
+
+// mentionPattern matches "@username" references. The "@" must start the text
+// or follow a character that cannot be part of an email address, therefore
+// "jane@example.com" is not a mention.
+var mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9._@-])@([A-Za-z0-9_-][A-Za-z0-9._-]{2,49})`)
+
+// extractMentions returns the lowercase usernames mentioned in the text in the
+// order of their first occurrence. Trailing dots are not part of a username,
+// so a mention can end a sentence.
+func extractMentions(text string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(text, -1)
+	usernames := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+
+	for _, match := range matches {
+		username := strings.ToLower(strings.TrimRight(match[1], "."))
+		if len(username) < 3 {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+	}
+
+	return usernames
+}
+
+// notifyMentions notifies the users mentioned by the user in the context in
+// the text of the source, which is an issue, document or comment linked to the
+// resource. Users are notified once per source: the users already notified are
+// persisted, so editing the text around a mention, or removing a mention and
+// adding it back, does not notify them again. Only users who can read the
+// resource are notified, and if the resource is an issue, they start watching
+// it. Mentioning is a side effect of the calling operation, therefore failures
+// are logged only. Without a user repository, mention repository or
+// notification service no mentions are resolved.
+func (s *baseService) notifyMentions(ctx context.Context, source, resource model.ID, subject, description, text string) {
+	if s.userRepo == nil || s.mentionRepo == nil || s.notificationService == nil {
+		return
+	}
+
+	actor, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return
+	}
+
+	usernames := extractMentions(text)
+	if len(usernames) == 0 {
+		return
+	}
+
+	readAction, ok := model.ReadActionFor(resource.Type)
+	if !ok {
+		return
+	}
+
+	if utf8.RuneCountInString(description) < 5 {
+		description = ""
+	}
+
+	notified, err := s.mentionRepo.ListRecipients(ctx, source)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to list mentioned users",
+			log.WithError(err),
+			log.WithValue(source.Composite()),
+		)
+		return
+	}
+
+	mentioned := make([]model.ID, 0, len(usernames))
+	for _, username := range usernames {
+		user, err := s.userRepo.GetByUsername(ctx, username, repository.UserProjection{})
+		if err != nil {
+			continue
+		}
+		if user.ID == actor || slices.Contains(notified, user.ID) {
+			continue
+		}
+
+		canRead, err := s.permissionService.Has(ctx, user.ID, resource, readAction)
+		if err != nil || !canRead {
+			continue
+		}
+
+		if _, err := s.notificationService.Create(ctx, CreateNotificationOpts{
+			Title:       fmt.Sprintf(mentionNotificationTitle, subject),
+			Description: description,
+			Recipient:   user.ID,
+			Event:       NotificationEventMention,
+			Actor:       &actor,
+			Resource:    &resource,
+		}); err != nil {
+			s.logger.Warn(ctx, "failed to create mention notification",
+				log.WithError(err),
+				log.WithValue(resource.Composite()),
+			)
+			continue
+		}
+
+		mentioned = append(mentioned, user.ID)
+	}
+
+	if len(mentioned) == 0 {
+		return
+	}
+
+	if err := s.mentionRepo.Create(ctx, source, mentioned); err != nil {
+		s.logger.Warn(ctx, "failed to record mentioned users",
+			log.WithError(err),
+			log.WithValue(source.Composite()),
+		)
+	}
+
+	if resource.Type == model.ResourceTypeIssue && s.issueRepo != nil {
+		s.autoWatchIssue(ctx, resource, mentioned)
+	}
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func TestExtractMentions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty text", text: "", want: []string{}},
+		{name: "no mentions", text: "nothing to see here", want: []string{}},
+		{name: "mention at start", text: "@jane please review", want: []string{"jane"}},
+		{name: "multiple mentions", text: "cc @jane, @john-doe and (@bob_1)", want: []string{"jane", "john-doe", "bob_1"}},
+		{name: "mention ends sentence", text: "Ask @jane.", want: []string{"jane"}},
+		{name: "dotted username", text: "Ask @jane.doe today", want: []string{"jane.doe"}},
+		{name: "duplicate mentions", text: "@jane @JANE @jane", want: []string{"jane"}},
+		{name: "email is not a mention", text: "mail jane@example.com", want: []string{}},
+		{name: "too short username", text: "hi @jo", want: []string{}},
+		{name: "markdown", text: "- [ ] **@jane** check\n@john", want: []string{"jane", "john"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, extractMentions(tt.text))
+		})
+	}
+}
+
+func TestBaseService_notifyMentions(t *testing.T) {
+	t.Parallel()
+
+	actorID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, actorID)
+
+	t.Run("skips without user repository", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			notificationService: NewMockNotificationService(ctrl),
+		}
+		s.notifyMentions(ctx, issueID, issueID, "EL-1", "", "@jane")
+	})
+
+	t.Run("skips without mention repository", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			userRepo:            repository.NewMockUserRepository(ctrl),
+			notificationService: NewMockNotificationService(ctrl),
+		}
+		s.notifyMentions(ctx, issueID, issueID, "EL-1", "", "@jane")
+	})
+
+	t.Run("skips without user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			userRepo:            repository.NewMockUserRepository(ctrl),
+			notificationService: NewMockNotificationService(ctrl),
+		}
+		s.notifyMentions(context.Background(), issueID, issueID, "EL-1", "", "@jane")
+	})
+
+	t.Run("skips users already notified", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		jane := &repository.User{ID: model.MustNewID(model.ResourceTypeUser), Username: "jane"}
+
+		userRepo := repository.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().GetByUsername(ctx, "jane", repository.UserProjection{}).Return(jane, nil)
+
+		mentionRepo := repository.NewMockMentionRepository(ctrl)
+		mentionRepo.EXPECT().ListRecipients(ctx, issueID).Return([]model.ID{jane.ID}, nil)
+
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			userRepo:            userRepo,
+			mentionRepo:         mentionRepo,
+			notificationService: NewMockNotificationService(ctrl),
+		}
+		s.notifyMentions(ctx, issueID, issueID, "EL-1", "", "hello again @jane")
+	})
+
+	t.Run("skips listing errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		mentionRepo := repository.NewMockMentionRepository(ctrl)
+		mentionRepo.EXPECT().ListRecipients(ctx, issueID).Return(nil, repository.ErrMentionRead)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to list mentioned users", gomock.Any(), gomock.Any())
+
+		s := &baseService{
+			logger:              logger,
+			userRepo:            repository.NewMockUserRepository(ctrl),
+			mentionRepo:         mentionRepo,
+			notificationService: NewMockNotificationService(ctrl),
+		}
+		s.notifyMentions(ctx, issueID, issueID, "EL-1", "", "@jane")
+	})
+
+	t.Run("notifies readers and watches issue", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		jane := &repository.User{ID: model.MustNewID(model.ResourceTypeUser), Username: "jane"}
+		john := &repository.User{ID: model.MustNewID(model.ResourceTypeUser), Username: "john"}
+		actor := &repository.User{ID: actorID, Username: "actor"}
+
+		userRepo := repository.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().GetByUsername(ctx, "jane", repository.UserProjection{}).Return(jane, nil)
+		userRepo.EXPECT().GetByUsername(ctx, "john", repository.UserProjection{}).Return(john, nil)
+		userRepo.EXPECT().GetByUsername(ctx, "actor", repository.UserProjection{}).Return(actor, nil)
+		userRepo.EXPECT().GetByUsername(ctx, "nobody", repository.UserProjection{}).Return(nil, repository.ErrNotFound)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, jane.ID, issueID, model.ActionIssueRead).Return(true, nil)
+		permSvc.EXPECT().Has(ctx, john.ID, issueID, model.ActionIssueRead).Return(false, nil)
+
+		notificationSvc := NewMockNotificationService(ctrl)
+		notificationSvc.EXPECT().Create(ctx, CreateNotificationOpts{
+			Title:       "You were mentioned in EL-1",
+			Description: "Fix the login form",
+			Recipient:   jane.ID,
+			Event:       NotificationEventMention,
+			Actor:       &actorID,
+			Resource:    &issueID,
+		}).Return(&Notification{}, nil)
+
+		mentionRepo := repository.NewMockMentionRepository(ctrl)
+		mentionRepo.EXPECT().ListRecipients(ctx, issueID).Return([]model.ID{}, nil)
+		mentionRepo.EXPECT().Create(ctx, issueID, []model.ID{jane.ID}).Return(nil)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().AutoWatch(ctx, issueID, []model.ID{jane.ID}).Return(nil)
+
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			userRepo:            userRepo,
+			mentionRepo:         mentionRepo,
+			issueRepo:           issueRepo,
+			permissionService:   permSvc,
+			notificationService: notificationSvc,
+		}
+		s.notifyMentions(ctx, issueID, issueID, "EL-1", "Fix the login form", "@jane @john @actor @nobody")
+	})
+
+	t.Run("notifies document readers without watching", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		jane := &repository.User{ID: model.MustNewID(model.ResourceTypeUser), Username: "jane"}
+
+		userRepo := repository.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().GetByUsername(ctx, "jane", repository.UserProjection{}).Return(jane, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, jane.ID, documentID, model.ActionDocumentRead).Return(true, nil)
+
+		notificationSvc := NewMockNotificationService(ctrl)
+		notificationSvc.EXPECT().Create(ctx, CreateNotificationOpts{
+			Title:     "You were mentioned in a document",
+			Recipient: jane.ID,
+			Event:     NotificationEventMention,
+			Actor:     &actorID,
+			Resource:  &documentID,
+		}).Return(&Notification{}, nil)
+
+		mentionRepo := repository.NewMockMentionRepository(ctrl)
+		mentionRepo.EXPECT().ListRecipients(ctx, documentID).Return([]model.ID{}, nil)
+		mentionRepo.EXPECT().Create(ctx, documentID, []model.ID{jane.ID}).Return(nil)
+
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			userRepo:            userRepo,
+			mentionRepo:         mentionRepo,
+			issueRepo:           repository.NewMockIssueRepository(ctrl),
+			permissionService:   permSvc,
+			notificationService: notificationSvc,
+		}
+		s.notifyMentions(ctx, documentID, documentID, documentMentionSubject, "Spec", "@jane")
+	})
+
+	t.Run("records comment mentions per comment", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		commentID := model.MustNewID(model.ResourceTypeComment)
+		jane := &repository.User{ID: model.MustNewID(model.ResourceTypeUser), Username: "jane"}
+
+		userRepo := repository.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().GetByUsername(ctx, "jane", repository.UserProjection{}).Return(jane, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, jane.ID, issueID, model.ActionIssueRead).Return(true, nil)
+
+		notificationSvc := NewMockNotificationService(ctrl)
+		notificationSvc.EXPECT().Create(ctx, CreateNotificationOpts{
+			Title:     "You were mentioned in a comment",
+			Recipient: jane.ID,
+			Event:     NotificationEventMention,
+			Actor:     &actorID,
+			Resource:  &issueID,
+		}).Return(&Notification{}, nil)
+
+		mentionRepo := repository.NewMockMentionRepository(ctrl)
+		mentionRepo.EXPECT().ListRecipients(ctx, commentID).Return([]model.ID{}, nil)
+		mentionRepo.EXPECT().Create(ctx, commentID, []model.ID{jane.ID}).Return(nil)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().AutoWatch(ctx, issueID, []model.ID{jane.ID}).Return(nil)
+
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			userRepo:            userRepo,
+			mentionRepo:         mentionRepo,
+			issueRepo:           issueRepo,
+			permissionService:   permSvc,
+			notificationService: notificationSvc,
+		}
+		s.notifyMentions(ctx, commentID, issueID, commentMentionSubject, "", "@jane")
+	})
+
+	t.Run("logs notification errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		jane := &repository.User{ID: model.MustNewID(model.ResourceTypeUser), Username: "jane"}
+
+		userRepo := repository.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().GetByUsername(ctx, "jane", repository.UserProjection{}).Return(jane, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, jane.ID, issueID, model.ActionIssueRead).Return(true, nil)
+
+		notificationSvc := NewMockNotificationService(ctrl)
+		notificationSvc.EXPECT().Create(ctx, gomock.Any()).Return(nil, assert.AnError)
+
+		mentionRepo := repository.NewMockMentionRepository(ctrl)
+		mentionRepo.EXPECT().ListRecipients(ctx, issueID).Return([]model.ID{}, nil)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to create mention notification", gomock.Any(), gomock.Any())
+
+		s := &baseService{
+			logger:              logger,
+			userRepo:            userRepo,
+			mentionRepo:         mentionRepo,
+			issueRepo:           repository.NewMockIssueRepository(ctrl),
+			permissionService:   permSvc,
+			notificationService: notificationSvc,
+		}
+		s.notifyMentions(ctx, issueID, issueID, "EL-1", "", "@jane")
+	})
+}
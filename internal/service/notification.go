@@ -5,28 +5,47 @@ import (
 	"errors"
 	"time"
 
+	"github.com/hibiken/asynq"
+
 	"github.com/opcotech/elemo/internal/model"
 	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
 	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/queue"
 	"github.com/opcotech/elemo/internal/repository"
 )
 
+// NotificationTaskEnqueuer schedules notification fan-out tasks.
+type NotificationTaskEnqueuer interface {
+	Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
+
 // Notification represents a notification returned by the service.
 type Notification struct {
-	ID          model.ID
-	Title       string
-	Description string
-	Recipient   model.ID
-	Read        bool
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	ID            model.ID
+	Title         string
+	Description   string
+	Recipient     model.ID
+	Read          bool
+	Event         string
+	Actor         *model.ID
+	Resource      *model.ID
+	ChangedFields []string
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
 }
 
 // CreateNotificationOpts holds the data required to create a notification.
+// The Event, Actor, Resource and ChangedFields fields describe the event that
+// triggered the notification and are optional.
 type CreateNotificationOpts struct {
-	Title       string   `json:"title" validate:"required,min=3,max=120"`
-	Description string   `json:"description" validate:"omitempty,min=5,max=500"`
-	Recipient   model.ID `json:"recipient" validate:"required"`
+	Title         string    `json:"title" validate:"required,min=3,max=120"`
+	Description   string    `json:"description" validate:"omitempty,min=5,max=500"`
+	Recipient     model.ID  `json:"recipient" validate:"required"`
+	Event         string    `json:"event" validate:"omitempty,max=64"`
+	Actor         *model.ID `json:"actor" validate:"omitempty"`
+	Resource      *model.ID `json:"resource" validate:"omitempty"`
+	ChangedFields []string  `json:"changed_fields" validate:"omitempty,dive,min=1,max=64"`
 }
 
 // Validate validates the create options.
@@ -40,6 +59,16 @@ func (o *CreateNotificationOpts) Validate() error {
 	if o.Recipient.Type != model.ResourceTypeUser {
 		return model.ErrInvalidNotificationRecipient
 	}
+	if o.Actor != nil {
+		if err := o.Actor.Validate(); err != nil {
+			return errors.Join(model.ErrInvalidNotificationDetails, err)
+		}
+	}
+	if o.Resource != nil {
+		if err := o.Resource.Validate(); err != nil {
+			return errors.Join(model.ErrInvalidNotificationDetails, err)
+		}
+	}
 	return nil
 }
 
@@ -50,6 +79,8 @@ type UpdateNotificationOpts struct {
 
 // NotificationService serves the business logic of interacting with
 // notifications.
+//
+//go:generate go tool mockgen -destination=notification_mock_gen.go -package=service -mock_names NotificationService=MockNotificationService . NotificationService
 type NotificationService interface {
 	// Create creates a new notification.
 	Create(ctx context.Context, opts CreateNotificationOpts) (*Notification, error)
@@ -78,13 +109,17 @@ func notificationFromRepository(n *repository.Notification) *Notification {
 		return nil
 	}
 	return &Notification{
-		ID:          n.ID,
-		Title:       n.Title,
-		Description: n.Description,
-		Recipient:   n.Recipient,
-		Read:        n.Read,
-		CreatedAt:   n.CreatedAt,
-		UpdatedAt:   n.UpdatedAt,
+		ID:            n.ID,
+		Title:         n.Title,
+		Description:   n.Description,
+		Recipient:     n.Recipient,
+		Read:          n.Read,
+		Event:         n.Event,
+		Actor:         n.Actor,
+		Resource:      n.Resource,
+		ChangedFields: n.ChangedFields,
+		CreatedAt:     n.CreatedAt,
+		UpdatedAt:     n.UpdatedAt,
 	}
 }
 
@@ -102,14 +137,25 @@ func (s *notificationService) Create(ctx context.Context, opts CreateNotificatio
 	}
 
 	notification, err := s.notificationRepo.Create(ctx, repository.CreateNotificationOpts{
-		Title:       opts.Title,
-		Description: opts.Description,
-		Recipient:   opts.Recipient,
+		Title:         opts.Title,
+		Description:   opts.Description,
+		Recipient:     opts.Recipient,
+		Event:         opts.Event,
+		Actor:         opts.Actor,
+		Resource:      opts.Resource,
+		ChangedFields: opts.ChangedFields,
 	})
 	if err != nil {
 		return nil, errors.Join(ErrNotificationCreate, err)
 	}
 
+	s.publishEvent(ctx, &repository.Event{
+		Type:      EventTypeNotificationCreated,
+		Resource:  notification.ID,
+		Actor:     notification.Actor,
+		Recipient: &notification.Recipient,
+	})
+
 	return notificationFromRepository(notification), nil
 }
 
@@ -221,6 +267,32 @@ func (s *notificationService) Delete(ctx context.Context, id, recipient model.ID
 	return nil
 }
 
+// enqueueIssueNotification schedules the notification fan-out of an issue
+// event triggered by the user in the context. Notifications are a side effect
+// of the calling operation, therefore failures are logged only. Without a
+// notification task enqueuer no task is scheduled.
+func (s *baseService) enqueueIssueNotification(ctx context.Context, taskType queue.TaskType, issueID model.ID, changedFields []string) {
+	if s.notificationTaskEnqueuer == nil {
+		return
+	}
+
+	actor, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return
+	}
+
+	task, err := queue.NewIssueEventTask(taskType, actor, issueID, changedFields)
+	if err == nil {
+		_, err = s.notificationTaskEnqueuer.Enqueue(ctx, task)
+	}
+	if err != nil {
+		s.logger.Warn(ctx, "failed to enqueue issue notification",
+			log.WithError(err),
+			log.WithValue(issueID.Composite()),
+		)
+	}
+}
+
 // NewNotificationService returns a new instance of the NotificationService
 // interface.
 func NewNotificationService(notificationRepo repository.NotificationRepository, opts ...Option) (NotificationService, error) {
@@ -10,7 +10,9 @@ import (
 
 	"github.com/opcotech/elemo/internal/model"
 	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/queue"
 	"github.com/opcotech/elemo/internal/repository"
 	"github.com/opcotech/elemo/internal/testutil/mock"
 	testModel "github.com/opcotech/elemo/internal/testutil/model"
@@ -74,6 +76,38 @@ func TestCreateNotificationOpts_Validate(t *testing.T) {
 			},
 			wantErr: model.ErrInvalidNotificationRecipient,
 		},
+		{
+			name: "valid notification with event payload",
+			opts: CreateNotificationOpts{
+				Title:         "Test Notification",
+				Description:   "Test description",
+				Recipient:     model.MustNewNilID(model.ResourceTypeUser),
+				Event:         queue.TaskTypeNotificationIssueUpdated.String(),
+				Actor:         convert.ToPointer(model.MustNewNilID(model.ResourceTypeUser)),
+				Resource:      convert.ToPointer(model.MustNewNilID(model.ResourceTypeIssue)),
+				ChangedFields: []string{"status"},
+			},
+		},
+		{
+			name: "invalid notification actor",
+			opts: CreateNotificationOpts{
+				Title:       "Test Notification",
+				Description: "Test description",
+				Recipient:   model.MustNewNilID(model.ResourceTypeUser),
+				Actor:       &model.ID{},
+			},
+			wantErr: model.ErrInvalidNotificationDetails,
+		},
+		{
+			name: "invalid notification changed field",
+			opts: CreateNotificationOpts{
+				Title:         "Test Notification",
+				Description:   "Test description",
+				Recipient:     model.MustNewNilID(model.ResourceTypeUser),
+				ChangedFields: []string{""},
+			},
+			wantErr: model.ErrInvalidNotificationDetails,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -1018,3 +1052,49 @@ func TestNotificationService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseService_enqueueIssueNotification(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+	t.Run("skips without enqueuer", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		s := &baseService{logger: mock.NewMockLogger(ctrl)}
+		s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueUpdated, issueID, []string{"status"})
+	})
+
+	t.Run("skips without user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		enqueuer := &stubSearchEnqueuer{}
+		s := &baseService{logger: mock.NewMockLogger(ctrl), notificationTaskEnqueuer: enqueuer}
+		s.enqueueIssueNotification(context.Background(), queue.TaskTypeNotificationIssueUpdated, issueID, []string{"status"})
+		assert.Nil(t, enqueuer.task)
+	})
+
+	t.Run("enqueues issue event task", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		enqueuer := &stubSearchEnqueuer{}
+		s := &baseService{logger: mock.NewMockLogger(ctrl), notificationTaskEnqueuer: enqueuer}
+		s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueUpdated, issueID, []string{"status"})
+		require.NotNil(t, enqueuer.task)
+		assert.Equal(t, queue.TaskTypeNotificationIssueUpdated.String(), enqueuer.task.Type())
+		assert.Contains(t, string(enqueuer.task.Payload()), userID.Composite())
+		assert.Contains(t, string(enqueuer.task.Payload()), issueID.Composite())
+		assert.Contains(t, string(enqueuer.task.Payload()), `"changed_fields":["status"]`)
+	})
+
+	t.Run("logs enqueue errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to enqueue issue notification", gomock.Any(), gomock.Any())
+		s := &baseService{logger: logger, notificationTaskEnqueuer: &stubSearchEnqueuer{err: assert.AnError}}
+		s.enqueueIssueNotification(ctx, queue.TaskTypeNotificationIssueComment, issueID, nil)
+	})
+}
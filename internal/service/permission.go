@@ -79,6 +79,8 @@ type PermissionService interface {
 	ListByPrincipal(ctx context.Context, principal model.ID) ([]*Grant, error)
 	// ListByScope returns grants whose scope is the given resource.
 	ListByScope(ctx context.Context, scope model.ID) ([]*Grant, error)
+	// ListByScopes returns grants whose scope is any of the given resources.
+	ListByScopes(ctx context.Context, scopes []model.ID) ([]*Grant, error)
 	// Delete removes a grant by ID.
 	Delete(ctx context.Context, id model.ID) error
 	// CtxUserDelete deletes a grant if the context user holds permission.manage
@@ -332,6 +334,17 @@ func (s *permissionService) ListByScope(ctx context.Context, scope model.ID) ([]
 	return grantsFromRepository(grants), nil
 }
 
+func (s *permissionService) ListByScopes(ctx context.Context, scopes []model.ID) ([]*Grant, error) {
+	ctx, span := s.tracer.Start(ctx, "service.permissionService/ListByScopes")
+	defer span.End()
+
+	grants, err := s.permissionRepo.ListByScopes(ctx, scopes)
+	if err != nil {
+		return nil, errors.Join(ErrPermissionGetByTarget, err)
+	}
+	return grantsFromRepository(grants), nil
+}
+
 func (s *permissionService) Delete(ctx context.Context, id model.ID) error {
 	ctx, span := s.tracer.Start(ctx, "service.permissionService/Delete")
 	defer span.End()
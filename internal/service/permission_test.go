@@ -675,6 +675,34 @@ func Test_permissionService_ListByScope(t *testing.T) {
 	})
 }
 
+func Test_permissionService_ListByScopes(t *testing.T) {
+	t.Parallel()
+	scopes := []model.ID{model.MustNewID(model.ResourceTypeView), model.MustNewID(model.ResourceTypeView)}
+	grant := testModel.NewRepositoryGrant(model.MustNewID(model.ResourceTypeTeam), scopes[1], model.ActionViewRead)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base, repo := newPermissionTestBase(ctrl, ctx)
+		repo.EXPECT().ListByScopes(gomock.Any(), scopes).Return([]*repository.Grant{grant}, nil)
+		s := &permissionService{baseService: base, permissionRepo: repo}
+		got, err := s.ListByScopes(ctx, scopes)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+	})
+
+	t.Run("wraps repository error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base, repo := newPermissionTestBase(ctrl, ctx)
+		repo.EXPECT().ListByScopes(gomock.Any(), scopes).Return(nil, assert.AnError)
+		s := &permissionService{baseService: base, permissionRepo: repo}
+		_, err := s.ListByScopes(ctx, scopes)
+		require.ErrorIs(t, err, ErrPermissionGetByTarget)
+	})
+}
+
 func Test_permissionService_Delete(t *testing.T) {
 	t.Parallel()
 	id := model.MustNewID(model.ResourceTypePermission)
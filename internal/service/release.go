@@ -0,0 +1,475 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// releaseNoteSections lists the issue kinds in the order they appear in the
+// release notes, with the heading of their section.
+var releaseNoteSections = []struct {
+	kind    model.IssueKind
+	heading string
+}{
+	{model.IssueKindEpic, "Epics"},
+	{model.IssueKindStory, "Stories"},
+	{model.IssueKindTask, "Tasks"},
+	{model.IssueKindBug, "Bugs"},
+}
+
+// Release represents a project release returned by the service.
+type Release struct {
+	ID          model.ID
+	Project     model.ID
+	Name        string
+	Description string
+	Status      model.ReleaseStatus
+	TargetDate  *time.Time
+	ReleasedAt  *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	// UnresolvedIssues lists the issues that were still not done or closed
+	// when the release was marked as released. It is set by Update only.
+	UnresolvedIssues []*PartialIssue
+}
+
+// ReleaseNotes holds the generated Markdown release notes of a release.
+type ReleaseNotes struct {
+	Release  *Release
+	Markdown string
+}
+
+// CreateReleaseOpts holds the data required to create a release.
+type CreateReleaseOpts struct {
+	Name        string     `json:"name" validate:"required,min=1,max=120"`
+	Description string     `json:"description" validate:"omitempty,max=2000"`
+	TargetDate  *time.Time `json:"target_date" validate:"omitempty"`
+}
+
+// Validate validates the create options.
+func (o *CreateReleaseOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidReleaseDetails, err)
+	}
+	return nil
+}
+
+// UpdateReleaseOpts holds the fields that can be updated on a release.
+// Undefined fields (Defined == false) are left unchanged, and a null
+// TargetDate removes the target date of the release.
+type UpdateReleaseOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Status      optional.Optional[model.ReleaseStatus]
+	TargetDate  optional.Optional[time.Time]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateReleaseOpts) Validate() error {
+	fields := []struct {
+		value optional.Optional[string]
+		tag   string
+	}{
+		{o.Name, "required,min=1,max=120"},
+		{o.Description, "omitempty,max=2000"},
+	}
+
+	for _, f := range fields {
+		if !f.value.Defined {
+			continue
+		}
+		var value string
+		if f.value.Value != nil {
+			value = *f.value.Value
+		}
+		if err := validate.Var(value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidReleaseDetails, err)
+		}
+	}
+
+	if o.Status.Defined && (o.Status.Value == nil || !o.Status.Value.IsAReleaseStatus()) {
+		return model.ErrInvalidReleaseDetails
+	}
+
+	return nil
+}
+
+// ReleaseService serves the business logic of interacting with project
+// releases and the fix versions of issues.
+//
+//go:generate go tool mockgen -destination=release_mock_gen.go -package=service -mock_names ReleaseService=MockReleaseService . ReleaseService
+type ReleaseService interface {
+	// Create creates a new planned release in a project.
+	Create(ctx context.Context, projectID model.ID, opts CreateReleaseOpts) (*Release, error)
+	// Get returns a release by its ID.
+	Get(ctx context.Context, id model.ID) (*Release, error)
+	// List returns a cursor-paginated page of the releases of a project.
+	List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Release], error)
+	// Update updates a release. When the release is marked as released, the
+	// returned release lists the issues that are not resolved yet.
+	Update(ctx context.Context, id model.ID, opts UpdateReleaseOpts) (*Release, error)
+	// Delete deletes a release and removes it from its issues.
+	Delete(ctx context.Context, id model.ID) error
+	// AttachTo sets the release as a fix version of the issue.
+	AttachTo(ctx context.Context, releaseID, issueID model.ID) error
+	// DetachFrom removes the release from the fix versions of the issue.
+	DetachFrom(ctx context.Context, releaseID, issueID model.ID) error
+	// Notes generates the Markdown release notes of the done issues of the
+	// release, grouped by issue kind.
+	Notes(ctx context.Context, id model.ID) (*ReleaseNotes, error)
+}
+
+// releaseService is the concrete implementation of ReleaseService.
+type releaseService struct {
+	*baseService
+}
+
+func releaseFromRepository(r *repository.Release) *Release {
+	if r == nil {
+		return nil
+	}
+	return &Release{
+		ID:          r.ID,
+		Project:     r.Project,
+		Name:        r.Name,
+		Description: r.Description,
+		Status:      r.Status,
+		TargetDate:  r.TargetDate,
+		ReleasedAt:  r.ReleasedAt,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// unresolvedIssues returns the issues that are neither done nor closed.
+func unresolvedIssues(issues []*repository.PartialIssue) []*PartialIssue {
+	unresolved := make([]*PartialIssue, 0)
+	for _, issue := range issues {
+		if issue.Status != model.IssueStatusDone && issue.Status != model.IssueStatusClosed {
+			unresolved = append(unresolved, partialIssueFromRepository(issue))
+		}
+	}
+	return unresolved
+}
+
+// releaseNotesMarkdown renders the release notes of the done issues of the
+// release. Issues are grouped by kind and kept in the given order.
+func releaseNotesMarkdown(release *repository.Release, issues []*repository.PartialIssue) string {
+	var b strings.Builder
+
+	b.WriteString("# " + release.Name + "\n")
+	if release.Description != "" {
+		b.WriteString("\n" + release.Description + "\n")
+	}
+
+	written := false
+	for _, section := range releaseNoteSections {
+		heading := false
+		for _, issue := range issues {
+			if issue.Kind != section.kind || issue.Status != model.IssueStatusDone {
+				continue
+			}
+			if !heading {
+				b.WriteString("\n## " + section.heading + "\n\n")
+				heading = true
+			}
+			b.WriteString("- " + issue.Key + ": " + issue.Title + "\n")
+		}
+		written = written || heading
+	}
+
+	if !written {
+		b.WriteString("\nNo issues were completed in this release.\n")
+	}
+
+	return b.String()
+}
+
+// getForAction returns the release if the context user can perform the
+// action on its project.
+func (s *releaseService) getForAction(ctx context.Context, id model.ID, action model.Action) (*repository.Release, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	if id.Type != model.ResourceTypeRelease {
+		return nil, model.ErrInvalidID
+	}
+
+	release, err := s.releaseRepo.Get(ctx, id, repository.ReleaseDetailProjection())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, release.Project, action) {
+		return nil, ErrNoPermission
+	}
+
+	return release, nil
+}
+
+// canChangeFixVersion reports whether the context user can change the fix
+// versions of the issue, and whether the release is usable on it.
+func (s *releaseService) canChangeFixVersion(ctx context.Context, releaseID, issueID model.ID) error {
+	if err := issueID.Validate(); err != nil {
+		return err
+	}
+	if issueID.Type != model.ResourceTypeIssue {
+		return model.ErrInvalidID
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueUpdate) {
+		return ErrNoPermission
+	}
+
+	release, err := s.getForAction(ctx, releaseID, model.ActionProjectRead)
+	if err != nil {
+		return err
+	}
+
+	issue, err := s.issueRepo.Get(ctx, issueID, repository.IssueDetailProjection())
+	if err != nil {
+		return err
+	}
+	if issue.Project == nil || issue.Project.ID != release.Project {
+		return ErrIssueRelease
+	}
+
+	return nil
+}
+
+func (s *releaseService) Create(ctx context.Context, projectID model.ID, opts CreateReleaseOpts) (*Release, error) {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrReleaseCreate, license.ErrLicenseExpired)
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrReleaseCreate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrReleaseCreate, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return nil, errors.Join(ErrReleaseCreate, ErrNoPermission)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureReleases); !ok || err != nil {
+		return nil, errors.Join(ErrReleaseCreate, ErrQuotaExceeded)
+	}
+
+	release, err := s.releaseRepo.Create(ctx, repository.CreateReleaseOpts{
+		Project:     projectID,
+		Name:        opts.Name,
+		Description: opts.Description,
+		TargetDate:  opts.TargetDate,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrReleaseCreate, err)
+	}
+
+	return releaseFromRepository(release), nil
+}
+
+func (s *releaseService) Get(ctx context.Context, id model.ID) (*Release, error) {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/Get")
+	defer span.End()
+
+	release, err := s.getForAction(ctx, id, model.ActionProjectRead)
+	if err != nil {
+		return nil, errors.Join(ErrReleaseGet, err)
+	}
+
+	return releaseFromRepository(release), nil
+}
+
+func (s *releaseService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Release], error) {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/List")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return Page[*Release]{}, errors.Join(ErrReleaseGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Release]{}, errors.Join(ErrReleaseGetAll, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return Page[*Release]{}, errors.Join(ErrReleaseGetAll, ErrNoPermission)
+	}
+
+	releases, err := s.releaseRepo.ListForProject(ctx, projectID, normalized, repository.ReleaseListProjection())
+	if err != nil {
+		return Page[*Release]{}, errors.Join(ErrReleaseGetAll, err)
+	}
+
+	return mapPage(releases, releaseFromRepository), nil
+}
+
+func (s *releaseService) Update(ctx context.Context, id model.ID, opts UpdateReleaseOpts) (*Release, error) {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrReleaseUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrReleaseUpdate, err)
+	}
+
+	current, err := s.getForAction(ctx, id, model.ActionProjectUpdate)
+	if err != nil {
+		return nil, errors.Join(ErrReleaseUpdate, err)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureReleases); !ok || err != nil {
+		return nil, errors.Join(ErrReleaseUpdate, ErrQuotaExceeded)
+	}
+
+	released, err := s.releaseRepo.Update(ctx, id, repository.UpdateReleaseOpts{
+		Name:        opts.Name,
+		Description: clearedToEmpty(opts.Description),
+		Status:      opts.Status,
+		TargetDate:  opts.TargetDate,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrReleaseUpdate, err)
+	}
+
+	release := releaseFromRepository(released)
+
+	if current.Status != model.ReleaseStatusReleased && released.Status == model.ReleaseStatusReleased {
+		issues, err := s.releaseRepo.ListIssues(ctx, id)
+		if err != nil {
+			return nil, errors.Join(ErrReleaseUpdate, err)
+		}
+		release.UnresolvedIssues = unresolvedIssues(issues)
+	}
+
+	return release, nil
+}
+
+func (s *releaseService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrReleaseDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getForAction(ctx, id, model.ActionProjectUpdate); err != nil {
+		return errors.Join(ErrReleaseDelete, err)
+	}
+
+	if err := s.releaseRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrReleaseDelete, err)
+	}
+
+	return nil
+}
+
+func (s *releaseService) AttachTo(ctx context.Context, releaseID, issueID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/AttachTo")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrReleaseAttach, license.ErrLicenseExpired)
+	}
+
+	if err := s.canChangeFixVersion(ctx, releaseID, issueID); err != nil {
+		return errors.Join(ErrReleaseAttach, err)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureReleases); !ok || err != nil {
+		return errors.Join(ErrReleaseAttach, ErrQuotaExceeded)
+	}
+
+	if err := s.releaseRepo.AttachTo(ctx, releaseID, issueID); err != nil {
+		return errors.Join(ErrReleaseAttach, err)
+	}
+
+	return nil
+}
+
+func (s *releaseService) DetachFrom(ctx context.Context, releaseID, issueID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/DetachFrom")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrReleaseDetach, license.ErrLicenseExpired)
+	}
+
+	if err := s.canChangeFixVersion(ctx, releaseID, issueID); err != nil {
+		return errors.Join(ErrReleaseDetach, err)
+	}
+
+	if err := s.releaseRepo.DetachFrom(ctx, releaseID, issueID); err != nil {
+		return errors.Join(ErrReleaseDetach, err)
+	}
+
+	return nil
+}
+
+func (s *releaseService) Notes(ctx context.Context, id model.ID) (*ReleaseNotes, error) {
+	ctx, span := s.tracer.Start(ctx, "service.releaseService/Notes")
+	defer span.End()
+
+	release, err := s.getForAction(ctx, id, model.ActionProjectRead)
+	if err != nil {
+		return nil, errors.Join(ErrReleaseNotes, err)
+	}
+
+	issues, err := s.releaseRepo.ListIssues(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrReleaseNotes, err)
+	}
+
+	return &ReleaseNotes{
+		Release:  releaseFromRepository(release),
+		Markdown: releaseNotesMarkdown(release, issues),
+	}, nil
+}
+
+// NewReleaseService returns a new instance of the ReleaseService interface.
+func NewReleaseService(opts ...Option) (ReleaseService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &releaseService{
+		baseService: s,
+	}
+
+	if svc.releaseRepo == nil {
+		return nil, ErrNoReleaseRepository
+	}
+
+	if svc.issueRepo == nil {
+		return nil, ErrNoIssueRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
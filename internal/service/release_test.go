@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestNewReleaseService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new release service",
+			opts: []Option{
+				WithReleaseRepository(repository.NewMockReleaseRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new release service with invalid options",
+			opts:    []Option{WithReleaseRepository(nil)},
+			wantErr: ErrNoReleaseRepository,
+		},
+		{
+			name: "new release service with no release repository",
+			opts: []Option{
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoReleaseRepository,
+		},
+		{
+			name: "new release service with no issue repository",
+			opts: []Option{
+				WithReleaseRepository(repository.NewMockReleaseRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoIssueRepository,
+		},
+		{
+			name: "new release service with no license service",
+			opts: []Option{
+				WithReleaseRepository(repository.NewMockReleaseRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new release service with no permission service",
+			opts: []Option{
+				WithReleaseRepository(repository.NewMockReleaseRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewReleaseService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func newReleaseTestIssue(kind model.IssueKind, status model.IssueStatus, key, title string) *repository.PartialIssue {
+	return &repository.PartialIssue{
+		ID:     model.MustNewID(model.ResourceTypeIssue),
+		Key:    key,
+		Kind:   kind,
+		Title:  title,
+		Status: status,
+	}
+}
+
+func TestReleaseService_Create(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, licensed bool) (*releaseService, *repository.MockReleaseRepository) {
+		releaseRepo := repository.NewMockReleaseRepository(ctrl)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureReleases).Return(licensed, nil)
+
+		return &releaseService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.releaseService/Create"),
+			releaseRepo:       releaseRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}, releaseRepo
+	}
+
+	t.Run("create release", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, releaseRepo := newService(ctrl, ctx, true)
+
+		release := testModel.NewRepositoryRelease(projectID)
+		releaseRepo.EXPECT().Create(ctx, repository.CreateReleaseOpts{
+			Project:    projectID,
+			Name:       release.Name,
+			TargetDate: release.TargetDate,
+		}).Return(release, nil)
+
+		got, err := s.Create(ctx, projectID, CreateReleaseOpts{Name: release.Name, TargetDate: release.TargetDate})
+		require.NoError(t, err)
+		assert.Equal(t, releaseFromRepository(release), got)
+	})
+
+	t.Run("create release without licensed feature", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s, _ := newService(ctrl, ctx, false)
+		_, err := s.Create(ctx, projectID, CreateReleaseOpts{Name: "v1.0.0"})
+		assert.ErrorIs(t, err, ErrReleaseCreate)
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+	})
+
+	t.Run("create release with invalid name", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &releaseService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.releaseService/Create"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Create(ctx, projectID, CreateReleaseOpts{})
+		assert.ErrorIs(t, err, model.ErrInvalidReleaseDetails)
+	})
+}
+
+func TestReleaseService_Update(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, current *repository.Release) (*releaseService, *repository.MockReleaseRepository) {
+		releaseRepo := repository.NewMockReleaseRepository(ctrl)
+		releaseRepo.EXPECT().Get(ctx, current.ID, repository.ReleaseDetailProjection()).Return(current, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureReleases).Return(true, nil)
+
+		return &releaseService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.releaseService/Update"),
+			releaseRepo:       releaseRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}, releaseRepo
+	}
+
+	t.Run("mark as released warns about unresolved issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		current := testModel.NewRepositoryRelease(projectID)
+		released := *current
+		released.Status = model.ReleaseStatusReleased
+
+		done := newReleaseTestIssue(model.IssueKindStory, model.IssueStatusDone, "ELM-1", "Done story")
+		closed := newReleaseTestIssue(model.IssueKindBug, model.IssueStatusClosed, "ELM-2", "Closed bug")
+		open := newReleaseTestIssue(model.IssueKindTask, model.IssueStatusInProgress, "ELM-3", "Open task")
+
+		s, releaseRepo := newService(ctrl, ctx, current)
+		releaseRepo.EXPECT().Update(ctx, current.ID, repository.UpdateReleaseOpts{
+			Status: optional.Some(model.ReleaseStatusReleased),
+		}).Return(&released, nil)
+		releaseRepo.EXPECT().ListIssues(ctx, current.ID).Return([]*repository.PartialIssue{done, closed, open}, nil)
+
+		got, err := s.Update(ctx, current.ID, UpdateReleaseOpts{Status: optional.Some(model.ReleaseStatusReleased)})
+		require.NoError(t, err)
+		assert.Equal(t, model.ReleaseStatusReleased, got.Status)
+		assert.Equal(t, []*PartialIssue{partialIssueFromRepository(open)}, got.UnresolvedIssues)
+	})
+
+	t.Run("update without status change", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		current := testModel.NewRepositoryRelease(projectID)
+
+		s, releaseRepo := newService(ctrl, ctx, current)
+		releaseRepo.EXPECT().Update(ctx, current.ID, repository.UpdateReleaseOpts{
+			Description: optional.Some(""),
+			TargetDate:  optional.Null[time.Time](),
+		}).Return(current, nil)
+
+		got, err := s.Update(ctx, current.ID, UpdateReleaseOpts{
+			Description: optional.Null[string](),
+			TargetDate:  optional.Null[time.Time](),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, got.UnresolvedIssues)
+	})
+
+	t.Run("update with invalid status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &releaseService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.releaseService/Update"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Update(ctx, model.MustNewID(model.ResourceTypeRelease), UpdateReleaseOpts{
+			Status: optional.Null[model.ReleaseStatus](),
+		})
+		assert.ErrorIs(t, err, ErrReleaseUpdate)
+		assert.ErrorIs(t, err, model.ErrInvalidReleaseDetails)
+	})
+}
+
+func TestReleaseService_AttachTo(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	tests := []struct {
+		name      string
+		project   model.ID
+		wantErr   error
+		wantCalls bool
+	}{
+		{
+			name:      "attach release to issue",
+			project:   projectID,
+			wantCalls: true,
+		},
+		{
+			name:    "attach release of another project",
+			project: model.MustNewID(model.ResourceTypeProject),
+			wantErr: ErrIssueRelease,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			release := testModel.NewRepositoryRelease(projectID)
+			issue := testModel.NewRepositoryIssue(userID)
+			issue.Project = &repository.PartialProject{ID: tt.project}
+
+			releaseRepo := repository.NewMockReleaseRepository(ctrl)
+			releaseRepo.EXPECT().Get(ctx, release.ID, repository.ReleaseDetailProjection()).Return(release, nil)
+
+			issueRepo := repository.NewMockIssueRepository(ctrl)
+			issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+
+			permSvc := NewMockPermissionService(ctrl)
+			permSvc.EXPECT().CtxUserHas(ctx, issue.ID, model.ActionIssueUpdate).Return(true)
+			permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+			licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+			if tt.wantCalls {
+				licenseSvc.EXPECT().HasFeature(ctx, license.FeatureReleases).Return(true, nil)
+				releaseRepo.EXPECT().AttachTo(ctx, release.ID, issue.ID).Return(nil)
+			}
+
+			s := &releaseService{baseService: &baseService{
+				tracer:            newCommentTestTracer(ctrl, ctx, "service.releaseService/AttachTo"),
+				releaseRepo:       releaseRepo,
+				issueRepo:         issueRepo,
+				permissionService: permSvc,
+				licenseService:    licenseSvc,
+			}}
+
+			err := s.AttachTo(ctx, release.ID, issue.ID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, ErrReleaseAttach)
+			}
+		})
+	}
+}
+
+func TestReleaseService_Notes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	release := testModel.NewRepositoryRelease(projectID)
+	release.Name = "v1.2.0"
+	release.Description = ""
+
+	releaseRepo := repository.NewMockReleaseRepository(ctrl)
+	releaseRepo.EXPECT().Get(ctx, release.ID, repository.ReleaseDetailProjection()).Return(release, nil)
+	releaseRepo.EXPECT().ListIssues(ctx, release.ID).Return([]*repository.PartialIssue{
+		newReleaseTestIssue(model.IssueKindBug, model.IssueStatusDone, "ELM-1", "Fix login"),
+		newReleaseTestIssue(model.IssueKindStory, model.IssueStatusDone, "ELM-2", "Add export"),
+		newReleaseTestIssue(model.IssueKindTask, model.IssueStatusOpen, "ELM-3", "Write docs"),
+		newReleaseTestIssue(model.IssueKindBug, model.IssueStatusDone, "ELM-4", "Fix crash"),
+	}, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+	s := &releaseService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.releaseService/Notes"),
+		releaseRepo:       releaseRepo,
+		permissionService: permSvc,
+	}}
+
+	got, err := s.Notes(ctx, release.ID)
+	require.NoError(t, err)
+	assert.Equal(t, releaseFromRepository(release), got.Release)
+	assert.Equal(t, "# v1.2.0\n\n## Stories\n\n- ELM-2: Add export\n\n## Bugs\n\n- ELM-1: Fix login\n- ELM-4: Fix crash\n", got.Markdown)
+}
+
+func TestReleaseNotesMarkdown(t *testing.T) {
+	t.Parallel()
+
+	release := &repository.Release{Name: "v2.0.0", Description: "Major release."}
+	got := releaseNotesMarkdown(release, []*repository.PartialIssue{
+		newReleaseTestIssue(model.IssueKindEpic, model.IssueStatusClosed, "ELM-1", "Won't do"),
+	})
+	assert.Equal(t, "# v2.0.0\n\nMajor release.\n\nNo issues were completed in this release.\n", got)
+}
@@ -92,10 +92,15 @@ type SearchService interface {
 	Index(ctx context.Context, input IndexInput) error
 	// EnqueueIndex schedules current-state indexing for a resource ID.
 	EnqueueIndex(ctx context.Context, id model.ID) error
+	// EnqueueIndexIDs schedules current-state indexing for the resource IDs
+	// in batches instead of one task per resource.
+	EnqueueIndexIDs(ctx context.Context, ids ...model.ID) error
 	// IndexIDs reads the latest graph projection for the IDs and upserts them.
 	IndexIDs(ctx context.Context, db *repository.Neo4jDatabase, ids ...model.ID) error
 	// Delete removes one document by resource ID.
 	Delete(ctx context.Context, id model.ID) error
+	// DeleteIDs removes the documents of the resource IDs at once.
+	DeleteIDs(ctx context.Context, ids ...model.ID) error
 	// DeleteByScope removes every document whose ancestry includes scope.
 	DeleteByScope(ctx context.Context, scope model.ID) error
 	// DeleteAll removes every document in the search index.
@@ -262,6 +267,27 @@ func (s *searchService) Delete(ctx context.Context, id model.ID) error {
 	return nil
 }
 
+func (s *searchService) DeleteIDs(ctx context.Context, ids ...model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.searchService/DeleteIDs")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		if err := id.Validate(); err != nil {
+			return errors.Join(ErrSearchDelete, err)
+		}
+		keys[i] = id.SearchKey()
+	}
+	if err := s.searchRepo.Delete(ctx, keys...); err != nil {
+		return errors.Join(ErrSearchDelete, err)
+	}
+	return nil
+}
+
 func (s *searchService) DeleteByScope(ctx context.Context, scope model.ID) error {
 	ctx, span := s.tracer.Start(ctx, "service.searchService/DeleteByScope")
 	defer span.End()
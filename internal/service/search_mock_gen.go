@@ -84,6 +84,25 @@ func (mr *MockSearchServiceMockRecorder) DeleteByScope(ctx, scope any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByScope", reflect.TypeOf((*MockSearchService)(nil).DeleteByScope), ctx, scope)
 }
 
+// DeleteIDs mocks base method.
+func (m *MockSearchService) DeleteIDs(ctx context.Context, ids ...model.ID) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIDs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIDs indicates an expected call of DeleteIDs.
+func (mr *MockSearchServiceMockRecorder) DeleteIDs(ctx any, ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIDs", reflect.TypeOf((*MockSearchService)(nil).DeleteIDs), varargs...)
+}
+
 // EnqueueIndex mocks base method.
 func (m *MockSearchService) EnqueueIndex(ctx context.Context, id model.ID) error {
 	m.ctrl.T.Helper()
@@ -98,6 +117,25 @@ func (mr *MockSearchServiceMockRecorder) EnqueueIndex(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueIndex", reflect.TypeOf((*MockSearchService)(nil).EnqueueIndex), ctx, id)
 }
 
+// EnqueueIndexIDs mocks base method.
+func (m *MockSearchService) EnqueueIndexIDs(ctx context.Context, ids ...model.ID) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnqueueIndexIDs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueIndexIDs indicates an expected call of EnqueueIndexIDs.
+func (mr *MockSearchServiceMockRecorder) EnqueueIndexIDs(ctx any, ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueIndexIDs", reflect.TypeOf((*MockSearchService)(nil).EnqueueIndexIDs), varargs...)
+}
+
 // Index mocks base method.
 func (m *MockSearchService) Index(ctx context.Context, input IndexInput) error {
 	m.ctrl.T.Helper()
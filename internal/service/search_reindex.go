@@ -53,6 +53,43 @@ func (s *searchService) EnqueueIndex(ctx context.Context, id model.ID) error {
 	return nil
 }
 
+func (s *searchService) EnqueueIndexIDs(ctx context.Context, ids ...model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.searchService/EnqueueIndexIDs")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+	if s.searchTaskEnqueuer == nil {
+		return errors.Join(ErrSearchIndex, ErrNoSearchTaskEnqueuer)
+	}
+
+	types := make([]model.ResourceType, 0)
+	byType := make(map[model.ResourceType][]model.ID)
+	for _, id := range ids {
+		if err := id.Validate(); err != nil {
+			return errors.Join(ErrSearchIndex, err)
+		}
+		if _, ok := byType[id.Type]; !ok {
+			types = append(types, id.Type)
+		}
+		byType[id.Type] = append(byType[id.Type], id)
+	}
+
+	for _, resourceType := range types {
+		for _, chunk := range ChunkSearchableIDs(byType[resourceType], DefaultSearchReindexBatchSize) {
+			task, err := queue.NewSearchIndexBatchTask(resourceType, chunk)
+			if err != nil {
+				return errors.Join(ErrSearchIndex, err)
+			}
+			if _, err := s.searchTaskEnqueuer.Enqueue(ctx, task); err != nil {
+				return errors.Join(ErrSearchIndex, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (s *searchService) DeleteAll(ctx context.Context) error {
 	ctx, span := s.tracer.Start(ctx, "service.searchService/DeleteAll")
 	defer span.End()
@@ -179,6 +216,15 @@ func (s *baseService) enqueueSearchIndex(ctx context.Context, id model.ID) {
 	}
 }
 
+func (s *baseService) enqueueSearchIndexIDs(ctx context.Context, ids []model.ID) {
+	if err := s.searchService.EnqueueIndexIDs(ctx, ids...); err != nil {
+		s.logger.Warn(ctx, "failed to enqueue search index",
+			log.WithError(err),
+			log.WithLimit(len(ids)),
+		)
+	}
+}
+
 func ChunkSearchableIDs(ids []model.ID, size int) [][]model.ID {
 	if size <= 0 {
 		size = DefaultSearchReindexBatchSize
@@ -64,6 +64,69 @@ func TestSearchService_EnqueueIndex(t *testing.T) {
 	})
 }
 
+func TestSearchService_EnqueueIndexIDs(t *testing.T) {
+	t.Parallel()
+
+	ids := []model.ID{model.MustNewID(model.ResourceTypeIssue), model.MustNewID(model.ResourceTypeIssue)}
+
+	t.Run("skips empty batches", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		svc := newSearchServiceForTest(NewMockPermissionService(ctrl), repository.NewMockSearchRepository(ctrl))
+		assert.NoError(t, svc.EnqueueIndexIDs(context.Background()))
+	})
+
+	t.Run("requires enqueuer", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		svc := newSearchServiceForTest(NewMockPermissionService(ctrl), repository.NewMockSearchRepository(ctrl))
+		err := svc.EnqueueIndexIDs(context.Background(), ids...)
+		assert.ErrorIs(t, err, ErrNoSearchTaskEnqueuer)
+	})
+
+	t.Run("enqueues one batch task", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		enqueuer := &stubSearchEnqueuer{}
+		svc := newSearchServiceForTest(NewMockPermissionService(ctrl), repository.NewMockSearchRepository(ctrl))
+		svc.searchTaskEnqueuer = enqueuer
+
+		err := svc.EnqueueIndexIDs(context.Background(), ids...)
+		require.NoError(t, err)
+		require.NotNil(t, enqueuer.task)
+		assert.Equal(t, queue.TaskTypeSearchReindexBatch.String(), enqueuer.task.Type())
+		for _, id := range ids {
+			assert.Contains(t, string(enqueuer.task.Payload()), id.Composite())
+		}
+	})
+
+	t.Run("preserves enqueue errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		enqueuer := &stubSearchEnqueuer{err: assert.AnError}
+		svc := newSearchServiceForTest(NewMockPermissionService(ctrl), repository.NewMockSearchRepository(ctrl))
+		svc.searchTaskEnqueuer = enqueuer
+
+		err := svc.EnqueueIndexIDs(context.Background(), ids...)
+		assert.ErrorIs(t, err, ErrSearchIndex)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestSearchService_DeleteIDs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ids := []model.ID{model.MustNewID(model.ResourceTypeIssue), model.MustNewID(model.ResourceTypeIssue)}
+
+	searchRepo := repository.NewMockSearchRepository(ctrl)
+	searchRepo.EXPECT().Delete(gomock.Any(), ids[0].SearchKey(), ids[1].SearchKey()).Return(nil)
+
+	svc := newSearchServiceForTest(NewMockPermissionService(ctrl), searchRepo)
+	require.NoError(t, svc.DeleteIDs(context.Background(), ids...))
+	require.NoError(t, svc.DeleteIDs(context.Background()))
+}
+
 func TestSearchService_IndexIDs(t *testing.T) {
 	t.Parallel()
 
@@ -96,6 +96,18 @@ func WithUserTokenRepository(userTokenRepo repository.UserTokenRepository) Optio
 	}
 }
 
+// WithViewRepository sets the view repository for the baseService.
+func WithViewRepository(viewRepo repository.ViewRepository) Option {
+	return func(s *baseService) error {
+		if viewRepo == nil {
+			return ErrNoViewRepository
+		}
+
+		s.viewRepo = viewRepo
+		return nil
+	}
+}
+
 // WithTodoRepository sets the todo repository for the baseService.
 func WithTodoRepository(todoRepo repository.TodoRepository) Option {
 	return func(s *baseService) error {
@@ -180,6 +192,179 @@ func WithDocumentRepository(documentRepo repository.DocumentRepository) Option {
 	}
 }
 
+// WithEventRepository sets the event repository for the baseService.
+func WithEventRepository(eventRepo repository.EventRepository) Option {
+	return func(s *baseService) error {
+		if eventRepo == nil {
+			return ErrNoEventRepository
+		}
+
+		s.eventRepo = eventRepo
+		return nil
+	}
+}
+
+// WithWebhookRepository sets the webhook repository for the baseService.
+func WithWebhookRepository(webhookRepo repository.WebhookRepository) Option {
+	return func(s *baseService) error {
+		if webhookRepo == nil {
+			return ErrNoWebhookRepository
+		}
+
+		s.webhookRepo = webhookRepo
+		return nil
+	}
+}
+
+// WithIssueActivityRepository sets the issue activity repository for the
+// baseService.
+func WithIssueActivityRepository(issueActivityRepo repository.IssueActivityRepository) Option {
+	return func(s *baseService) error {
+		if issueActivityRepo == nil {
+			return ErrNoIssueActivityRepository
+		}
+
+		s.issueActivityRepo = issueActivityRepo
+		return nil
+	}
+}
+
+// WithWorkflowRepository sets the workflow repository for the baseService.
+func WithWorkflowRepository(workflowRepo repository.WorkflowRepository) Option {
+	return func(s *baseService) error {
+		if workflowRepo == nil {
+			return ErrNoWorkflowRepository
+		}
+
+		s.workflowRepo = workflowRepo
+		return nil
+	}
+}
+
+// WithComponentRepository sets the component repository for the baseService.
+func WithComponentRepository(componentRepo repository.ComponentRepository) Option {
+	return func(s *baseService) error {
+		if componentRepo == nil {
+			return ErrNoComponentRepository
+		}
+
+		s.componentRepo = componentRepo
+		return nil
+	}
+}
+
+// WithReleaseRepository sets the release repository for the baseService.
+func WithReleaseRepository(releaseRepo repository.ReleaseRepository) Option {
+	return func(s *baseService) error {
+		if releaseRepo == nil {
+			return ErrNoReleaseRepository
+		}
+
+		s.releaseRepo = releaseRepo
+		return nil
+	}
+}
+
+// WithSprintRepository sets the sprint repository for the baseService.
+func WithSprintRepository(sprintRepo repository.SprintRepository) Option {
+	return func(s *baseService) error {
+		if sprintRepo == nil {
+			return ErrNoSprintRepository
+		}
+
+		s.sprintRepo = sprintRepo
+		return nil
+	}
+}
+
+// WithSprintScopeChangeRepository sets the sprint scope change repository for
+// the baseService.
+func WithSprintScopeChangeRepository(scopeChangeRepo repository.SprintScopeChangeRepository) Option {
+	return func(s *baseService) error {
+		if scopeChangeRepo == nil {
+			return ErrNoSprintScopeChangeRepository
+		}
+
+		s.scopeChangeRepo = scopeChangeRepo
+		return nil
+	}
+}
+
+// WithMentionRepository sets the mention repository for the baseService.
+func WithMentionRepository(mentionRepo repository.MentionRepository) Option {
+	return func(s *baseService) error {
+		if mentionRepo == nil {
+			return ErrNoMentionRepository
+		}
+
+		s.mentionRepo = mentionRepo
+		return nil
+	}
+}
+
+// WithWorkLogRepository sets the work log repository for the baseService.
+func WithWorkLogRepository(workLogRepo repository.WorkLogRepository) Option {
+	return func(s *baseService) error {
+		if workLogRepo == nil {
+			return ErrNoWorkLogRepository
+		}
+
+		s.workLogRepo = workLogRepo
+		return nil
+	}
+}
+
+// WithIssueTemplateRepository sets the issue template repository for the
+// baseService.
+func WithIssueTemplateRepository(issueTemplateRepo repository.IssueTemplateRepository) Option {
+	return func(s *baseService) error {
+		if issueTemplateRepo == nil {
+			return ErrNoIssueTemplateRepository
+		}
+
+		s.issueTemplateRepo = issueTemplateRepo
+		return nil
+	}
+}
+
+// WithIssueRecurrenceRepository sets the issue recurrence repository for the
+// baseService.
+func WithIssueRecurrenceRepository(issueRecurrenceRepo repository.IssueRecurrenceRepository) Option {
+	return func(s *baseService) error {
+		if issueRecurrenceRepo == nil {
+			return ErrNoIssueRecurrenceRepository
+		}
+
+		s.recurrenceRepo = issueRecurrenceRepo
+		return nil
+	}
+}
+
+// WithCustomFieldRepository sets the custom field repository for the
+// baseService.
+func WithCustomFieldRepository(customFieldRepo repository.CustomFieldRepository) Option {
+	return func(s *baseService) error {
+		if customFieldRepo == nil {
+			return ErrNoCustomFieldRepository
+		}
+
+		s.customFieldRepo = customFieldRepo
+		return nil
+	}
+}
+
+// WithWIPLimitRepository sets the WIP limit repository for the baseService.
+func WithWIPLimitRepository(wipLimitRepo repository.WIPLimitRepository) Option {
+	return func(s *baseService) error {
+		if wipLimitRepo == nil {
+			return ErrNoWIPLimitRepository
+		}
+
+		s.wipLimitRepo = wipLimitRepo
+		return nil
+	}
+}
+
 // WithFolderRepository sets the folder repository for the baseService.
 func WithFolderRepository(folderRepo repository.FolderRepository) Option {
 	return func(s *baseService) error {
@@ -192,6 +377,30 @@ func WithFolderRepository(folderRepo repository.FolderRepository) Option {
 	}
 }
 
+// WithAttachmentRepository sets the attachment repository for the baseService.
+func WithAttachmentRepository(attachmentRepo repository.AttachmentRepository) Option {
+	return func(s *baseService) error {
+		if attachmentRepo == nil {
+			return ErrNoAttachmentRepository
+		}
+
+		s.attachmentRepo = attachmentRepo
+		return nil
+	}
+}
+
+// WithCommentRepository sets the comment repository for the baseService.
+func WithCommentRepository(commentRepo repository.CommentRepository) Option {
+	return func(s *baseService) error {
+		if commentRepo == nil {
+			return ErrNoCommentRepository
+		}
+
+		s.commentRepo = commentRepo
+		return nil
+	}
+}
+
 // WithLicenseService sets the license service for the baseService.
 func WithLicenseService(licenseService LicenseService) Option {
 	return func(s *baseService) error {
@@ -252,6 +461,32 @@ func WithSearchTaskEnqueuer(enqueuer SearchTaskEnqueuer) Option {
 	}
 }
 
+// WithNotificationTaskEnqueuer sets the queue client used to schedule
+// notification fan-out tasks.
+func WithNotificationTaskEnqueuer(enqueuer NotificationTaskEnqueuer) Option {
+	return func(s *baseService) error {
+		if enqueuer == nil {
+			return ErrNoNotificationTaskEnqueuer
+		}
+
+		s.notificationTaskEnqueuer = enqueuer
+		return nil
+	}
+}
+
+// WithWebhookTaskEnqueuer sets the queue client used to schedule webhook
+// deliveries.
+func WithWebhookTaskEnqueuer(enqueuer WebhookTaskEnqueuer) Option {
+	return func(s *baseService) error {
+		if enqueuer == nil {
+			return ErrNoWebhookTaskEnqueuer
+		}
+
+		s.webhookTaskEnqueuer = enqueuer
+		return nil
+	}
+}
+
 // WithEmailService sets the email service for the baseService.
 func WithEmailService(emailService EmailService) Option {
 	return func(s *baseService) error {
@@ -282,27 +517,46 @@ type baseService struct {
 	logger log.Logger
 	tracer tracing.Tracer
 
-	organizationRepo repository.OrganizationRepository
-	namespaceRepo    repository.NamespaceRepository
-	projectRepo      repository.ProjectRepository
-	issueRepo        repository.IssueRepository
-	assignmentRepo   repository.AssignmentRepository
-	labelRepo        repository.LabelRepository
-	documentRepo     repository.DocumentRepository
-	folderRepo       repository.FolderRepository
-	roleRepo         repository.RoleRepository
-	teamRepo         repository.TeamRepository
-	todoRepo         repository.TodoRepository
-	userRepo         repository.UserRepository
-	userTokenRepo    repository.UserTokenRepository
-
-	licenseService      LicenseService
-	permissionService   PermissionService
-	notificationService NotificationService
-	searchService       SearchService
-	searchTaskEnqueuer  SearchTaskEnqueuer
-	emailService        EmailService
-	staticFileService   StaticFileService
+	organizationRepo  repository.OrganizationRepository
+	namespaceRepo     repository.NamespaceRepository
+	projectRepo       repository.ProjectRepository
+	issueRepo         repository.IssueRepository
+	assignmentRepo    repository.AssignmentRepository
+	labelRepo         repository.LabelRepository
+	documentRepo      repository.DocumentRepository
+	folderRepo        repository.FolderRepository
+	commentRepo       repository.CommentRepository
+	eventRepo         repository.EventRepository
+	webhookRepo       repository.WebhookRepository
+	issueActivityRepo repository.IssueActivityRepository
+	workflowRepo      repository.WorkflowRepository
+	customFieldRepo   repository.CustomFieldRepository
+	wipLimitRepo      repository.WIPLimitRepository
+	componentRepo     repository.ComponentRepository
+	releaseRepo       repository.ReleaseRepository
+	sprintRepo        repository.SprintRepository
+	scopeChangeRepo   repository.SprintScopeChangeRepository
+	workLogRepo       repository.WorkLogRepository
+	issueTemplateRepo repository.IssueTemplateRepository
+	recurrenceRepo    repository.IssueRecurrenceRepository
+	attachmentRepo    repository.AttachmentRepository
+	roleRepo          repository.RoleRepository
+	teamRepo          repository.TeamRepository
+	todoRepo          repository.TodoRepository
+	userRepo          repository.UserRepository
+	userTokenRepo     repository.UserTokenRepository
+	viewRepo          repository.ViewRepository
+	mentionRepo       repository.MentionRepository
+
+	licenseService           LicenseService
+	permissionService        PermissionService
+	notificationService      NotificationService
+	notificationTaskEnqueuer NotificationTaskEnqueuer
+	searchService            SearchService
+	searchTaskEnqueuer       SearchTaskEnqueuer
+	webhookTaskEnqueuer      WebhookTaskEnqueuer
+	emailService             EmailService
+	staticFileService        StaticFileService
 }
 
 // newService creates a new baseService and defines the default values. Those
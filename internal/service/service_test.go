@@ -437,6 +437,90 @@ func TestWithAssignmentRepository(t *testing.T) {
 	}
 }
 
+func TestWithAttachmentRepository(t *testing.T) {
+	type args struct {
+		attachmentRepo repository.AttachmentRepository
+	}
+	tests := []struct {
+		name    string
+		argsFn  func(ctrl *gomock.Controller) args
+		wantErr error
+	}{
+		{
+			name: "set the attachment repository for the baseService",
+			argsFn: func(ctrl *gomock.Controller) args {
+				return args{attachmentRepo: repository.NewMockAttachmentRepository(ctrl)}
+			},
+		},
+		{
+			name: "return an error if no attachment repository is provided",
+			argsFn: func(_ *gomock.Controller) args {
+				return args{attachmentRepo: nil}
+			},
+			wantErr: ErrNoAttachmentRepository,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var s baseService
+			args := tt.argsFn(ctrl)
+			err := WithAttachmentRepository(args.attachmentRepo)(&s)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, args.attachmentRepo, s.attachmentRepo)
+			}
+		})
+	}
+}
+
+func TestWithCommentRepository(t *testing.T) {
+	type args struct {
+		commentRepo repository.CommentRepository
+	}
+	tests := []struct {
+		name    string
+		argsFn  func(ctrl *gomock.Controller) args
+		wantErr error
+	}{
+		{
+			name: "set the comment repository for the baseService",
+			argsFn: func(ctrl *gomock.Controller) args {
+				return args{commentRepo: repository.NewMockCommentRepository(ctrl)}
+			},
+		},
+		{
+			name: "return an error if no comment repository is provided",
+			argsFn: func(_ *gomock.Controller) args {
+				return args{commentRepo: nil}
+			},
+			wantErr: ErrNoCommentRepository,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var s baseService
+			args := tt.argsFn(ctrl)
+			err := WithCommentRepository(args.commentRepo)(&s)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, args.commentRepo, s.commentRepo)
+			}
+		})
+	}
+}
+
 func TestWithRoleRepository(t *testing.T) {
 	type args struct {
 		roleRepo repository.RoleRepository
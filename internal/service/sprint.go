@@ -0,0 +1,693 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// Sprint represents a project sprint returned by the service.
+type Sprint struct {
+	ID        model.ID
+	Project   model.ID
+	Name      string
+	Goal      string
+	State     model.SprintState
+	StartDate time.Time
+	EndDate   time.Time
+	ClosedAt  *time.Time
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	// NextSprint and MovedIssues are set by Update only, when the sprint is
+	// closed and its unfinished issues are moved to the next planned sprint.
+	NextSprint  *model.ID
+	MovedIssues []*PartialIssue
+}
+
+// SprintBurndownPoint is the state of the sprint scope at the end of a day.
+// Added and Removed count the scope changes made during the day.
+type SprintBurndownPoint struct {
+	Date      time.Time
+	Scope     int
+	Completed int
+	Remaining int
+	Added     int
+	Removed   int
+}
+
+// SprintBurndown holds the daily burndown series of a sprint.
+type SprintBurndown struct {
+	Sprint *Sprint
+	Points []SprintBurndownPoint
+}
+
+// CreateSprintOpts holds the data required to create a sprint.
+type CreateSprintOpts struct {
+	Name      string    `json:"name" validate:"required,min=1,max=120"`
+	Goal      string    `json:"goal" validate:"omitempty,max=2000"`
+	StartDate time.Time `json:"start_date" validate:"required"`
+	EndDate   time.Time `json:"end_date" validate:"required,gtfield=StartDate"`
+}
+
+// Validate validates the create options.
+func (o *CreateSprintOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidSprintDetails, err)
+	}
+	return nil
+}
+
+// UpdateSprintOpts holds the fields that can be updated on a sprint.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateSprintOpts struct {
+	Name      optional.Optional[string]
+	Goal      optional.Optional[string]
+	State     optional.Optional[model.SprintState]
+	StartDate optional.Optional[time.Time]
+	EndDate   optional.Optional[time.Time]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateSprintOpts) Validate() error {
+	fields := []struct {
+		value optional.Optional[string]
+		tag   string
+	}{
+		{o.Name, "required,min=1,max=120"},
+		{o.Goal, "omitempty,max=2000"},
+	}
+
+	for _, f := range fields {
+		if !f.value.Defined {
+			continue
+		}
+		var value string
+		if f.value.Value != nil {
+			value = *f.value.Value
+		}
+		if err := validate.Var(value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidSprintDetails, err)
+		}
+	}
+
+	if o.State.Defined && (o.State.Value == nil || !o.State.Value.IsASprintState()) {
+		return model.ErrInvalidSprintDetails
+	}
+
+	for _, date := range []optional.Optional[time.Time]{o.StartDate, o.EndDate} {
+		if date.Defined && (date.Value == nil || date.Value.IsZero()) {
+			return model.ErrInvalidSprintDetails
+		}
+	}
+
+	return nil
+}
+
+// SprintService serves the business logic of interacting with project
+// sprints and the issues planned in them.
+//
+//go:generate go tool mockgen -destination=sprint_mock_gen.go -package=service -mock_names SprintService=MockSprintService . SprintService
+type SprintService interface {
+	// Create creates a new planned sprint in a project.
+	Create(ctx context.Context, projectID model.ID, opts CreateSprintOpts) (*Sprint, error)
+	// Get returns a sprint by its ID.
+	Get(ctx context.Context, id model.ID) (*Sprint, error)
+	// List returns a cursor-paginated page of the sprints of a project.
+	List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Sprint], error)
+	// Update updates a sprint. When the sprint is closed, its unfinished
+	// issues are moved to the next planned sprint of the project.
+	Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error)
+	// Delete deletes a sprint and removes it from its issues.
+	Delete(ctx context.Context, id model.ID) error
+	// AddIssue plans the issue in the sprint, removing it from any other
+	// sprint that is not closed.
+	AddIssue(ctx context.Context, sprintID, issueID model.ID) error
+	// RemoveIssue removes the issue from the sprint.
+	RemoveIssue(ctx context.Context, sprintID, issueID model.ID) error
+	// Burndown returns the daily burndown series of the sprint, computed
+	// from its scope changes and the status history of its issues.
+	Burndown(ctx context.Context, id model.ID) (*SprintBurndown, error)
+}
+
+// sprintService is the concrete implementation of SprintService.
+type sprintService struct {
+	*baseService
+}
+
+func sprintFromRepository(s *repository.Sprint) *Sprint {
+	if s == nil {
+		return nil
+	}
+	return &Sprint{
+		ID:        s.ID,
+		Project:   s.Project,
+		Name:      s.Name,
+		Goal:      s.Goal,
+		State:     s.State,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+		ClosedAt:  s.ClosedAt,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+// isCompletedStatus reports whether the issue status counts as completed in
+// the burndown.
+func isCompletedStatus(status string) bool {
+	return status == model.IssueStatusDone.String() || status == model.IssueStatusClosed.String()
+}
+
+// statusAt returns the status of the issue at the given time, based on its
+// status changes in chronological order. If the issue has no status change
+// before the time, the old value of its first change is used, and for issues
+// without any change the current status is used.
+func statusAt(history []*repository.IssueActivity, current string, at time.Time) string {
+	status := current
+	for i, change := range history {
+		if change.CreatedAt == nil || !change.CreatedAt.Before(at) {
+			if i == 0 && len(change.OldValue) > 0 {
+				status = change.OldValue[0]
+			}
+			break
+		}
+		status = ""
+		if len(change.NewValue) > 0 {
+			status = change.NewValue[0]
+		}
+	}
+	return status
+}
+
+// sprintBurndown computes one burndown point for every day of the sprint
+// until its end date, the day it was closed or today, whichever comes first.
+// Days are in UTC, and every point describes the end of its day.
+func sprintBurndown(sprint *repository.Sprint, changes []*repository.SprintScopeChange, history []*repository.IssueActivity, current map[model.ID]string, now time.Time) []SprintBurndownPoint {
+	stop := now.UTC()
+	if sprint.ClosedAt != nil && sprint.ClosedAt.Before(stop) {
+		stop = sprint.ClosedAt.UTC()
+	}
+
+	firstDay := sprint.StartDate.UTC().Truncate(24 * time.Hour)
+	lastDay := sprint.EndDate.UTC().Truncate(24 * time.Hour)
+	if stopDay := stop.Truncate(24 * time.Hour); stopDay.Before(lastDay) {
+		lastDay = stopDay
+	}
+
+	statusHistory := make(map[model.ID][]*repository.IssueActivity)
+	for _, change := range history {
+		statusHistory[change.Issue] = append(statusHistory[change.Issue], change)
+	}
+
+	points := make([]SprintBurndownPoint, 0)
+	for day := firstDay; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		cutoff := day.AddDate(0, 0, 1)
+		if stop.Before(cutoff) {
+			cutoff = stop
+		}
+
+		point := SprintBurndownPoint{Date: day}
+		inScope := make(map[model.ID]bool)
+		order := make([]model.ID, 0)
+		for _, change := range changes {
+			if change.CreatedAt == nil || !change.CreatedAt.Before(cutoff) {
+				break
+			}
+			if _, ok := inScope[change.Issue]; !ok {
+				order = append(order, change.Issue)
+			}
+			inScope[change.Issue] = change.Kind == repository.SprintScopeChangeKindAdded
+			if !change.CreatedAt.Before(day) {
+				switch change.Kind {
+				case repository.SprintScopeChangeKindAdded:
+					point.Added++
+				case repository.SprintScopeChangeKindRemoved:
+					point.Removed++
+				}
+			}
+		}
+
+		for _, issue := range order {
+			if !inScope[issue] {
+				continue
+			}
+			point.Scope++
+			if isCompletedStatus(statusAt(statusHistory[issue], current[issue], cutoff)) {
+				point.Completed++
+			}
+		}
+		point.Remaining = point.Scope - point.Completed
+
+		points = append(points, point)
+	}
+
+	return points
+}
+
+// recordScopeChanges appends the changes to the scope history of their
+// sprints. Failing to record the changes does not fail the request. Without a
+// sprint scope change repository nothing is recorded.
+func (s *baseService) recordScopeChanges(ctx context.Context, changes ...repository.CreateSprintScopeChangeOpts) {
+	if s.scopeChangeRepo == nil || len(changes) == 0 {
+		return
+	}
+
+	if actor, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID); ok {
+		for i := range changes {
+			changes[i].Actor = &actor
+		}
+	}
+
+	if _, err := s.scopeChangeRepo.Create(ctx, changes); err != nil {
+		s.logger.Warn(ctx, "failed to record sprint scope change",
+			log.WithError(err),
+			log.WithValue(changes[0].Sprint.Composite()),
+		)
+	}
+}
+
+// sprintRemovals returns the scope changes removing the issues from the open
+// sprints they are planned in, to be recorded once the issues are deleted.
+// Failing to read the sprints does not fail the request. Without a sprint
+// scope change repository nothing is read.
+func (s *baseService) sprintRemovals(ctx context.Context, ids ...model.ID) []repository.CreateSprintScopeChangeOpts {
+	if s.scopeChangeRepo == nil || len(ids) == 0 {
+		return nil
+	}
+
+	scopes, err := s.issueRepo.GetBatchScopes(ctx, ids)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to read sprints of issues",
+			log.WithError(err),
+			log.WithLimit(len(ids)),
+		)
+		return nil
+	}
+
+	var changes []repository.CreateSprintScopeChangeOpts
+	for _, scope := range scopes {
+		for _, sprintID := range scope.Sprints {
+			changes = append(changes, repository.CreateSprintScopeChangeOpts{
+				Sprint: sprintID,
+				Issue:  scope.ID,
+				Kind:   repository.SprintScopeChangeKindRemoved,
+			})
+		}
+	}
+
+	return changes
+}
+
+// addIssue plans the issue in the sprint and records the resulting scope
+// changes.
+func (s *sprintService) addIssue(ctx context.Context, sprintID, issueID model.ID) error {
+	move, err := s.sprintRepo.AddIssue(ctx, sprintID, issueID)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]repository.CreateSprintScopeChangeOpts, 0, len(move.MovedFrom)+1)
+	for _, from := range move.MovedFrom {
+		changes = append(changes, repository.CreateSprintScopeChangeOpts{
+			Sprint: from,
+			Issue:  issueID,
+			Kind:   repository.SprintScopeChangeKindRemoved,
+		})
+	}
+	if move.Added {
+		changes = append(changes, repository.CreateSprintScopeChangeOpts{
+			Sprint: sprintID,
+			Issue:  issueID,
+			Kind:   repository.SprintScopeChangeKindAdded,
+		})
+	}
+	s.recordScopeChanges(ctx, changes...)
+
+	return nil
+}
+
+// carryOver moves the unfinished issues of the closed sprint to the next
+// planned sprint of the project. The closed sprint keeps its issues as
+// history. Nothing is moved if the project has no planned sprint.
+func (s *sprintService) carryOver(ctx context.Context, sprint *Sprint) error {
+	next, err := s.sprintRepo.GetNext(ctx, sprint.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	issues, err := s.sprintRepo.ListIssues(ctx, sprint.ID)
+	if err != nil {
+		return err
+	}
+
+	moved := unresolvedIssues(issues)
+	for _, issue := range moved {
+		if err := s.addIssue(ctx, next.ID, issue.ID); err != nil {
+			return err
+		}
+	}
+
+	sprint.NextSprint = &next.ID
+	sprint.MovedIssues = moved
+
+	return nil
+}
+
+// getForAction returns the sprint if the context user can perform the
+// action on its project.
+func (s *sprintService) getForAction(ctx context.Context, id model.ID, action model.Action) (*repository.Sprint, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	if id.Type != model.ResourceTypeSprint {
+		return nil, model.ErrInvalidID
+	}
+
+	sprint, err := s.sprintRepo.Get(ctx, id, repository.SprintDetailProjection())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, sprint.Project, action) {
+		return nil, ErrNoPermission
+	}
+
+	return sprint, nil
+}
+
+// canChangeSprintIssues reports whether the context user can plan the issue
+// in the sprint, and whether the sprint is usable for the issue.
+func (s *sprintService) canChangeSprintIssues(ctx context.Context, sprintID, issueID model.ID) error {
+	if err := issueID.Validate(); err != nil {
+		return err
+	}
+	if issueID.Type != model.ResourceTypeIssue {
+		return model.ErrInvalidID
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueUpdate) {
+		return ErrNoPermission
+	}
+
+	sprint, err := s.getForAction(ctx, sprintID, model.ActionProjectRead)
+	if err != nil {
+		return err
+	}
+	if sprint.State == model.SprintStateClosed {
+		return ErrSprintClosed
+	}
+
+	issue, err := s.issueRepo.Get(ctx, issueID, repository.IssueDetailProjection())
+	if err != nil {
+		return err
+	}
+	if issue.Project == nil || issue.Project.ID != sprint.Project {
+		return ErrIssueSprint
+	}
+
+	return nil
+}
+
+func (s *sprintService) Create(ctx context.Context, projectID model.ID, opts CreateSprintOpts) (*Sprint, error) {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrSprintCreate, license.ErrLicenseExpired)
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrSprintCreate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrSprintCreate, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return nil, errors.Join(ErrSprintCreate, ErrNoPermission)
+	}
+
+	sprint, err := s.sprintRepo.Create(ctx, repository.CreateSprintOpts{
+		Project:   projectID,
+		Name:      opts.Name,
+		Goal:      opts.Goal,
+		StartDate: opts.StartDate,
+		EndDate:   opts.EndDate,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintCreate, err)
+	}
+
+	return sprintFromRepository(sprint), nil
+}
+
+func (s *sprintService) Get(ctx context.Context, id model.ID) (*Sprint, error) {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/Get")
+	defer span.End()
+
+	sprint, err := s.getForAction(ctx, id, model.ActionProjectRead)
+	if err != nil {
+		return nil, errors.Join(ErrSprintGet, err)
+	}
+
+	return sprintFromRepository(sprint), nil
+}
+
+func (s *sprintService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Sprint], error) {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/List")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return Page[*Sprint]{}, errors.Join(ErrSprintGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Sprint]{}, errors.Join(ErrSprintGetAll, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return Page[*Sprint]{}, errors.Join(ErrSprintGetAll, ErrNoPermission)
+	}
+
+	sprints, err := s.sprintRepo.ListForProject(ctx, projectID, normalized, repository.SprintListProjection())
+	if err != nil {
+		return Page[*Sprint]{}, errors.Join(ErrSprintGetAll, err)
+	}
+
+	return mapPage(sprints, sprintFromRepository), nil
+}
+
+func (s *sprintService) Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error) {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrSprintUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrSprintUpdate, err)
+	}
+
+	current, err := s.getForAction(ctx, id, model.ActionProjectUpdate)
+	if err != nil {
+		return nil, errors.Join(ErrSprintUpdate, err)
+	}
+
+	if opts.State.Defined && *opts.State.Value != current.State && !current.State.CanTransitionTo(*opts.State.Value) {
+		return nil, errors.Join(ErrSprintUpdate, model.ErrInvalidSprintDetails)
+	}
+
+	startDate, endDate := current.StartDate, current.EndDate
+	if opts.StartDate.Defined {
+		startDate = *opts.StartDate.Value
+	}
+	if opts.EndDate.Defined {
+		endDate = *opts.EndDate.Value
+	}
+	if !endDate.After(startDate) {
+		return nil, errors.Join(ErrSprintUpdate, model.ErrInvalidSprintDetails)
+	}
+
+	updated, err := s.sprintRepo.Update(ctx, id, repository.UpdateSprintOpts{
+		Name:      opts.Name,
+		Goal:      clearedToEmpty(opts.Goal),
+		State:     opts.State,
+		StartDate: opts.StartDate,
+		EndDate:   opts.EndDate,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrSprintUpdate, err)
+	}
+
+	sprint := sprintFromRepository(updated)
+
+	if current.State != model.SprintStateClosed && updated.State == model.SprintStateClosed {
+		if err := s.carryOver(ctx, sprint); err != nil {
+			return nil, errors.Join(ErrSprintUpdate, err)
+		}
+	}
+
+	return sprint, nil
+}
+
+func (s *sprintService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrSprintDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getForAction(ctx, id, model.ActionProjectUpdate); err != nil {
+		return errors.Join(ErrSprintDelete, err)
+	}
+
+	if err := s.sprintRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrSprintDelete, err)
+	}
+
+	return nil
+}
+
+func (s *sprintService) AddIssue(ctx context.Context, sprintID, issueID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/AddIssue")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrSprintAddIssue, license.ErrLicenseExpired)
+	}
+
+	if err := s.canChangeSprintIssues(ctx, sprintID, issueID); err != nil {
+		return errors.Join(ErrSprintAddIssue, err)
+	}
+
+	if err := s.addIssue(ctx, sprintID, issueID); err != nil {
+		return errors.Join(ErrSprintAddIssue, err)
+	}
+
+	return nil
+}
+
+func (s *sprintService) RemoveIssue(ctx context.Context, sprintID, issueID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/RemoveIssue")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrSprintRemoveIssue, license.ErrLicenseExpired)
+	}
+
+	if err := s.canChangeSprintIssues(ctx, sprintID, issueID); err != nil {
+		return errors.Join(ErrSprintRemoveIssue, err)
+	}
+
+	removed, err := s.sprintRepo.RemoveIssue(ctx, sprintID, issueID)
+	if err != nil {
+		return errors.Join(ErrSprintRemoveIssue, err)
+	}
+
+	if removed {
+		s.recordScopeChanges(ctx, repository.CreateSprintScopeChangeOpts{
+			Sprint: sprintID,
+			Issue:  issueID,
+			Kind:   repository.SprintScopeChangeKindRemoved,
+		})
+	}
+
+	return nil
+}
+
+func (s *sprintService) Burndown(ctx context.Context, id model.ID) (*SprintBurndown, error) {
+	ctx, span := s.tracer.Start(ctx, "service.sprintService/Burndown")
+	defer span.End()
+
+	sprint, err := s.getForAction(ctx, id, model.ActionProjectRead)
+	if err != nil {
+		return nil, errors.Join(ErrSprintBurndown, err)
+	}
+
+	changes, err := s.scopeChangeRepo.ListBySprint(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrSprintBurndown, err)
+	}
+
+	issues := make([]model.ID, 0)
+	seen := make(map[model.ID]bool)
+	for _, change := range changes {
+		if !seen[change.Issue] {
+			seen[change.Issue] = true
+			issues = append(issues, change.Issue)
+		}
+	}
+
+	history, err := s.issueActivityRepo.ListFieldChanges(ctx, issues, "status")
+	if err != nil {
+		return nil, errors.Join(ErrSprintBurndown, err)
+	}
+
+	planned, err := s.sprintRepo.ListIssues(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrSprintBurndown, err)
+	}
+
+	current := make(map[model.ID]string, len(planned))
+	for _, issue := range planned {
+		current[issue.ID] = issue.Status.String()
+	}
+
+	return &SprintBurndown{
+		Sprint: sprintFromRepository(sprint),
+		Points: sprintBurndown(sprint, changes, history, current, time.Now().UTC()),
+	}, nil
+}
+
+// NewSprintService returns a new instance of the SprintService interface.
+func NewSprintService(opts ...Option) (SprintService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &sprintService{
+		baseService: s,
+	}
+
+	if svc.sprintRepo == nil {
+		return nil, ErrNoSprintRepository
+	}
+
+	if svc.scopeChangeRepo == nil {
+		return nil, ErrNoSprintScopeChangeRepository
+	}
+
+	if svc.issueRepo == nil {
+		return nil, ErrNoIssueRepository
+	}
+
+	if svc.issueActivityRepo == nil {
+		return nil, ErrNoIssueActivityRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
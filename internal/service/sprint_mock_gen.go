@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/opcotech/elemo/internal/service (interfaces: SprintService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=sprint_mock_gen.go -package=service -mock_names SprintService=MockSprintService . SprintService
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSprintService is a mock of SprintService interface.
+type MockSprintService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSprintServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSprintServiceMockRecorder is the mock recorder for MockSprintService.
+type MockSprintServiceMockRecorder struct {
+	mock *MockSprintService
+}
+
+// NewMockSprintService creates a new mock instance.
+func NewMockSprintService(ctrl *gomock.Controller) *MockSprintService {
+	mock := &MockSprintService{ctrl: ctrl}
+	mock.recorder = &MockSprintServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSprintService) EXPECT() *MockSprintServiceMockRecorder {
+	return m.recorder
+}
+
+// AddIssue mocks base method.
+func (m *MockSprintService) AddIssue(ctx context.Context, sprintID, issueID model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIssue", ctx, sprintID, issueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIssue indicates an expected call of AddIssue.
+func (mr *MockSprintServiceMockRecorder) AddIssue(ctx, sprintID, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIssue", reflect.TypeOf((*MockSprintService)(nil).AddIssue), ctx, sprintID, issueID)
+}
+
+// Burndown mocks base method.
+func (m *MockSprintService) Burndown(ctx context.Context, id model.ID) (*SprintBurndown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Burndown", ctx, id)
+	ret0, _ := ret[0].(*SprintBurndown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Burndown indicates an expected call of Burndown.
+func (mr *MockSprintServiceMockRecorder) Burndown(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burndown", reflect.TypeOf((*MockSprintService)(nil).Burndown), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockSprintService) Create(ctx context.Context, projectID model.ID, opts CreateSprintOpts) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, opts)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSprintServiceMockRecorder) Create(ctx, projectID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSprintService)(nil).Create), ctx, projectID, opts)
+}
+
+// Delete mocks base method.
+func (m *MockSprintService) Delete(ctx context.Context, id model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSprintServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSprintService)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockSprintService) Get(ctx context.Context, id model.ID) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSprintServiceMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSprintService)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockSprintService) List(ctx context.Context, projectID model.ID, page CursorPage) (Page[*Sprint], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID, page)
+	ret0, _ := ret[0].(Page[*Sprint])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSprintServiceMockRecorder) List(ctx, projectID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSprintService)(nil).List), ctx, projectID, page)
+}
+
+// RemoveIssue mocks base method.
+func (m *MockSprintService) RemoveIssue(ctx context.Context, sprintID, issueID model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIssue", ctx, sprintID, issueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveIssue indicates an expected call of RemoveIssue.
+func (mr *MockSprintServiceMockRecorder) RemoveIssue(ctx, sprintID, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIssue", reflect.TypeOf((*MockSprintService)(nil).RemoveIssue), ctx, sprintID, issueID)
+}
+
+// Update mocks base method.
+func (m *MockSprintService) Update(ctx context.Context, id model.ID, opts UpdateSprintOpts) (*Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, opts)
+	ret0, _ := ret[0].(*Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSprintServiceMockRecorder) Update(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSprintService)(nil).Update), ctx, id, opts)
+}
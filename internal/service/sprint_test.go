@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestNewSprintService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new sprint service",
+			opts: []Option{
+				WithSprintRepository(repository.NewMockSprintRepository(nil)),
+				WithSprintScopeChangeRepository(repository.NewMockSprintScopeChangeRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithIssueActivityRepository(repository.NewMockIssueActivityRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new sprint service with invalid options",
+			opts:    []Option{WithSprintRepository(nil)},
+			wantErr: ErrNoSprintRepository,
+		},
+		{
+			name: "new sprint service with no sprint repository",
+			opts: []Option{
+				WithSprintScopeChangeRepository(repository.NewMockSprintScopeChangeRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithIssueActivityRepository(repository.NewMockIssueActivityRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoSprintRepository,
+		},
+		{
+			name: "new sprint service with no scope change repository",
+			opts: []Option{
+				WithSprintRepository(repository.NewMockSprintRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithIssueActivityRepository(repository.NewMockIssueActivityRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoSprintScopeChangeRepository,
+		},
+		{
+			name: "new sprint service with no issue activity repository",
+			opts: []Option{
+				WithSprintRepository(repository.NewMockSprintRepository(nil)),
+				WithSprintScopeChangeRepository(repository.NewMockSprintScopeChangeRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoIssueActivityRepository,
+		},
+		{
+			name: "new sprint service with no permission service",
+			opts: []Option{
+				WithSprintRepository(repository.NewMockSprintRepository(nil)),
+				WithSprintScopeChangeRepository(repository.NewMockSprintScopeChangeRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithIssueActivityRepository(repository.NewMockIssueActivityRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewSprintService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestSprintService_Create(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("create sprint", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		sprint := testModel.NewRepositorySprint(projectID)
+
+		sprintRepo := repository.NewMockSprintRepository(ctrl)
+		sprintRepo.EXPECT().Create(ctx, repository.CreateSprintOpts{
+			Project:   projectID,
+			Name:      sprint.Name,
+			StartDate: sprint.StartDate,
+			EndDate:   sprint.EndDate,
+		}).Return(sprint, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		s := &sprintService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.sprintService/Create"),
+			sprintRepo:        sprintRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.Create(ctx, projectID, CreateSprintOpts{
+			Name:      sprint.Name,
+			StartDate: sprint.StartDate,
+			EndDate:   sprint.EndDate,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, sprintFromRepository(sprint), got)
+	})
+
+	t.Run("create sprint ending before it starts", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &sprintService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.sprintService/Create"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		start := time.Now().UTC()
+		_, err := s.Create(ctx, projectID, CreateSprintOpts{
+			Name:      "Sprint 1",
+			StartDate: start,
+			EndDate:   start.Add(-time.Hour),
+		})
+		assert.ErrorIs(t, err, ErrSprintCreate)
+		assert.ErrorIs(t, err, model.ErrInvalidSprintDetails)
+	})
+}
+
+func TestSprintService_Update(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, current *repository.Sprint) (*sprintService, *repository.MockSprintRepository, *repository.MockSprintScopeChangeRepository) {
+		sprintRepo := repository.NewMockSprintRepository(ctrl)
+		sprintRepo.EXPECT().Get(ctx, current.ID, repository.SprintDetailProjection()).Return(current, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		scopeChangeRepo := repository.NewMockSprintScopeChangeRepository(ctrl)
+
+		return &sprintService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.sprintService/Update"),
+			sprintRepo:        sprintRepo,
+			scopeChangeRepo:   scopeChangeRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}, sprintRepo, scopeChangeRepo
+	}
+
+	t.Run("close sprint moves unfinished issues to the next sprint", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		userID := model.MustNewID(model.ResourceTypeUser)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		current := testModel.NewRepositorySprint(projectID)
+		current.State = model.SprintStateActive
+		closed := *current
+		closed.State = model.SprintStateClosed
+		next := testModel.NewRepositorySprint(projectID)
+
+		done := newReleaseTestIssue(model.IssueKindStory, model.IssueStatusDone, "ELM-1", "Done story")
+		open := newReleaseTestIssue(model.IssueKindTask, model.IssueStatusInProgress, "ELM-2", "Open task")
+
+		s, sprintRepo, scopeChangeRepo := newService(ctrl, ctx, current)
+		sprintRepo.EXPECT().Update(ctx, current.ID, repository.UpdateSprintOpts{
+			State: optional.Some(model.SprintStateClosed),
+		}).Return(&closed, nil)
+		sprintRepo.EXPECT().GetNext(ctx, current.ID).Return(next, nil)
+		sprintRepo.EXPECT().ListIssues(ctx, current.ID).Return([]*repository.PartialIssue{done, open}, nil)
+		sprintRepo.EXPECT().AddIssue(ctx, next.ID, open.ID).Return(&repository.SprintIssueMove{Added: true}, nil)
+		scopeChangeRepo.EXPECT().Create(ctx, []repository.CreateSprintScopeChangeOpts{
+			{Sprint: next.ID, Issue: open.ID, Kind: repository.SprintScopeChangeKindAdded, Actor: &userID},
+		}).Return(nil, nil)
+
+		got, err := s.Update(ctx, current.ID, UpdateSprintOpts{State: optional.Some(model.SprintStateClosed)})
+		require.NoError(t, err)
+		assert.Equal(t, model.SprintStateClosed, got.State)
+		assert.Equal(t, &next.ID, got.NextSprint)
+		assert.Equal(t, []*PartialIssue{partialIssueFromRepository(open)}, got.MovedIssues)
+	})
+
+	t.Run("close sprint without next sprint", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		current := testModel.NewRepositorySprint(projectID)
+		closed := *current
+		closed.State = model.SprintStateClosed
+
+		s, sprintRepo, _ := newService(ctrl, ctx, current)
+		sprintRepo.EXPECT().Update(ctx, current.ID, gomock.Any()).Return(&closed, nil)
+		sprintRepo.EXPECT().GetNext(ctx, current.ID).Return(nil, repository.ErrNotFound)
+
+		got, err := s.Update(ctx, current.ID, UpdateSprintOpts{State: optional.Some(model.SprintStateClosed)})
+		require.NoError(t, err)
+		assert.Nil(t, got.NextSprint)
+		assert.Nil(t, got.MovedIssues)
+	})
+
+	t.Run("reopen closed sprint", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		current := testModel.NewRepositorySprint(projectID)
+		current.State = model.SprintStateClosed
+
+		s, _, _ := newService(ctrl, ctx, current)
+
+		_, err := s.Update(ctx, current.ID, UpdateSprintOpts{State: optional.Some(model.SprintStateActive)})
+		assert.ErrorIs(t, err, ErrSprintUpdate)
+		assert.ErrorIs(t, err, model.ErrInvalidSprintDetails)
+	})
+
+	t.Run("move end date before start date", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		current := testModel.NewRepositorySprint(projectID)
+
+		s, _, _ := newService(ctrl, ctx, current)
+
+		_, err := s.Update(ctx, current.ID, UpdateSprintOpts{EndDate: optional.Some(current.StartDate.Add(-time.Hour))})
+		assert.ErrorIs(t, err, ErrSprintUpdate)
+		assert.ErrorIs(t, err, model.ErrInvalidSprintDetails)
+	})
+}
+
+func TestSprintService_AddIssue(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	tests := []struct {
+		name      string
+		project   model.ID
+		state     model.SprintState
+		wantErr   error
+		wantCalls bool
+	}{
+		{
+			name:      "add issue to sprint",
+			project:   projectID,
+			state:     model.SprintStateActive,
+			wantCalls: true,
+		},
+		{
+			name:    "add issue of another project",
+			project: model.MustNewID(model.ResourceTypeProject),
+			state:   model.SprintStatePlanned,
+			wantErr: ErrIssueSprint,
+		},
+		{
+			name:    "add issue to closed sprint",
+			project: projectID,
+			state:   model.SprintStateClosed,
+			wantErr: ErrSprintClosed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			sprint := testModel.NewRepositorySprint(projectID)
+			sprint.State = tt.state
+			previous := model.MustNewID(model.ResourceTypeSprint)
+			issue := testModel.NewRepositoryIssue(userID)
+			issue.Project = &repository.PartialProject{ID: tt.project}
+
+			sprintRepo := repository.NewMockSprintRepository(ctrl)
+			sprintRepo.EXPECT().Get(ctx, sprint.ID, repository.SprintDetailProjection()).Return(sprint, nil)
+
+			issueRepo := repository.NewMockIssueRepository(ctrl)
+			if tt.state != model.SprintStateClosed {
+				issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+			}
+
+			permSvc := NewMockPermissionService(ctrl)
+			permSvc.EXPECT().CtxUserHas(ctx, issue.ID, model.ActionIssueUpdate).Return(true)
+			permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+			scopeChangeRepo := repository.NewMockSprintScopeChangeRepository(ctrl)
+			if tt.wantCalls {
+				sprintRepo.EXPECT().AddIssue(ctx, sprint.ID, issue.ID).Return(&repository.SprintIssueMove{
+					Added:     true,
+					MovedFrom: []model.ID{previous},
+				}, nil)
+				scopeChangeRepo.EXPECT().Create(ctx, []repository.CreateSprintScopeChangeOpts{
+					{Sprint: previous, Issue: issue.ID, Kind: repository.SprintScopeChangeKindRemoved},
+					{Sprint: sprint.ID, Issue: issue.ID, Kind: repository.SprintScopeChangeKindAdded},
+				}).Return(nil, nil)
+			}
+
+			s := &sprintService{baseService: &baseService{
+				tracer:            newCommentTestTracer(ctrl, ctx, "service.sprintService/AddIssue"),
+				sprintRepo:        sprintRepo,
+				scopeChangeRepo:   scopeChangeRepo,
+				issueRepo:         issueRepo,
+				permissionService: permSvc,
+				licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+			}}
+
+			err := s.AddIssue(ctx, sprint.ID, issue.ID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, ErrSprintAddIssue)
+			}
+		})
+	}
+}
+
+func TestSprintService_RemoveIssue(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	tests := []struct {
+		name    string
+		removed bool
+	}{
+		{name: "remove issue from sprint", removed: true},
+		{name: "remove issue not in sprint", removed: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			sprint := testModel.NewRepositorySprint(projectID)
+			issue := testModel.NewRepositoryIssue(userID)
+			issue.Project = &repository.PartialProject{ID: projectID}
+
+			sprintRepo := repository.NewMockSprintRepository(ctrl)
+			sprintRepo.EXPECT().Get(ctx, sprint.ID, repository.SprintDetailProjection()).Return(sprint, nil)
+			sprintRepo.EXPECT().RemoveIssue(ctx, sprint.ID, issue.ID).Return(tt.removed, nil)
+
+			issueRepo := repository.NewMockIssueRepository(ctrl)
+			issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+
+			permSvc := NewMockPermissionService(ctrl)
+			permSvc.EXPECT().CtxUserHas(ctx, issue.ID, model.ActionIssueUpdate).Return(true)
+			permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+			scopeChangeRepo := repository.NewMockSprintScopeChangeRepository(ctrl)
+			if tt.removed {
+				scopeChangeRepo.EXPECT().Create(ctx, []repository.CreateSprintScopeChangeOpts{
+					{Sprint: sprint.ID, Issue: issue.ID, Kind: repository.SprintScopeChangeKindRemoved},
+				}).Return(nil, nil)
+			}
+
+			s := &sprintService{baseService: &baseService{
+				tracer:            newCommentTestTracer(ctrl, ctx, "service.sprintService/RemoveIssue"),
+				sprintRepo:        sprintRepo,
+				scopeChangeRepo:   scopeChangeRepo,
+				issueRepo:         issueRepo,
+				permissionService: permSvc,
+				licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+			}}
+
+			require.NoError(t, s.RemoveIssue(ctx, sprint.ID, issue.ID))
+		})
+	}
+}
+
+func TestSprintBurndown(t *testing.T) {
+	t.Parallel()
+
+	day := func(d, h int) *time.Time {
+		return convert.ToPointer(time.Date(2026, time.March, d, h, 0, 0, 0, time.UTC))
+	}
+
+	sprint := &repository.Sprint{
+		StartDate: *day(2, 9),
+		EndDate:   *day(6, 17),
+	}
+
+	first := model.MustNewID(model.ResourceTypeIssue)
+	second := model.MustNewID(model.ResourceTypeIssue)
+	third := model.MustNewID(model.ResourceTypeIssue)
+
+	changes := []*repository.SprintScopeChange{
+		{Issue: first, Kind: repository.SprintScopeChangeKindAdded, CreatedAt: day(1, 10)},
+		{Issue: second, Kind: repository.SprintScopeChangeKindAdded, CreatedAt: day(1, 11)},
+		{Issue: third, Kind: repository.SprintScopeChangeKindAdded, CreatedAt: day(3, 12)},
+		{Issue: second, Kind: repository.SprintScopeChangeKindRemoved, CreatedAt: day(4, 12)},
+	}
+
+	history := []*repository.IssueActivity{
+		{Issue: first, OldValue: []string{"open"}, NewValue: []string{"in_progress"}, CreatedAt: day(2, 12)},
+		{Issue: first, OldValue: []string{"in_progress"}, NewValue: []string{"done"}, CreatedAt: day(3, 15)},
+		{Issue: third, OldValue: []string{"done"}, NewValue: []string{"open"}, CreatedAt: day(5, 8)},
+	}
+
+	current := map[model.ID]string{
+		first: "done",
+		third: "open",
+	}
+
+	got := sprintBurndown(sprint, changes, history, current, *day(5, 18))
+	assert.Equal(t, []SprintBurndownPoint{
+		{Date: *day(2, 0), Scope: 2, Completed: 0, Remaining: 2},
+		{Date: *day(3, 0), Scope: 3, Completed: 2, Remaining: 1, Added: 1},
+		{Date: *day(4, 0), Scope: 2, Completed: 2, Remaining: 0, Removed: 1},
+		{Date: *day(5, 0), Scope: 2, Completed: 1, Remaining: 1},
+	}, got)
+
+	t.Run("future sprint has no points", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, sprintBurndown(sprint, changes, history, current, *day(1, 12)))
+	})
+
+	t.Run("closed sprint stops at its closing day", func(t *testing.T) {
+		t.Parallel()
+		closed := *sprint
+		closed.ClosedAt = day(3, 13)
+		points := sprintBurndown(&closed, changes, history, current, *day(20, 0))
+		require.Len(t, points, 2)
+		assert.Equal(t, SprintBurndownPoint{Date: *day(3, 0), Scope: 3, Completed: 1, Remaining: 2, Added: 1}, points[1])
+	})
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
 
 	"github.com/opcotech/elemo/internal/license"
 	"github.com/opcotech/elemo/internal/pkg/safepath"
@@ -23,6 +24,10 @@ type StaticFileService interface {
 	// Get retrieves an object and writes its data to the designated location.
 	// It returns an error if the operation failed.
 	Get(ctx context.Context, path string) ([]byte, error)
+	// Stream opens an object for reading without buffering its data. The
+	// caller must close the returned reader. It also returns the size of the
+	// object in bytes.
+	Stream(ctx context.Context, path string) (io.ReadCloser, int64, error)
 	// Update replaces the file at the given path with the new data. It returns
 	// an error if the operation failed.
 	Update(ctx context.Context, path string, data []byte) error
@@ -73,6 +78,23 @@ func (s *staticFileService) Get(ctx context.Context, path string) ([]byte, error
 	return data, nil
 }
 
+func (s *staticFileService) Stream(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	ctx, span := s.tracer.Start(ctx, "service.staticFileService/Stream")
+	defer span.End()
+
+	safePath, err := safepath.Normalize(staticFileRoot, path)
+	if err != nil {
+		return nil, 0, errors.Join(ErrStaticFileGet, ErrStaticFileInvalidPath)
+	}
+
+	body, size, err := s.staticFileRepo.Stream(ctx, safePath)
+	if err != nil {
+		return nil, 0, errors.Join(ErrStaticFileGet, err)
+	}
+
+	return body, size, nil
+}
+
 func (s *staticFileService) Update(ctx context.Context, path string, data []byte) error {
 	ctx, span := s.tracer.Start(ctx, "service.staticFileService/Update")
 	defer span.End()
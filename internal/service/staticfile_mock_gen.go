@@ -11,6 +11,7 @@ package service
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -83,6 +84,22 @@ func (mr *MockStaticFileServiceMockRecorder) Get(ctx, path any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStaticFileService)(nil).Get), ctx, path)
 }
 
+// Stream mocks base method.
+func (m *MockStaticFileService) Stream(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, path)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockStaticFileServiceMockRecorder) Stream(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockStaticFileService)(nil).Stream), ctx, path)
+}
+
 // Update mocks base method.
 func (m *MockStaticFileService) Update(ctx context.Context, path string, data []byte) error {
 	m.ctrl.T.Helper()
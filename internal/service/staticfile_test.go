@@ -1,7 +1,9 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -597,6 +599,132 @@ func TestStaticFileService_Get(t *testing.T) {
 	}
 }
 
+func TestStaticFileService_Stream(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		path string
+	}
+	type fields struct {
+		baseService    func(ctrl *gomock.Controller, ctx context.Context) *baseService
+		staticFileRepo func(ctrl *gomock.Controller, ctx context.Context) repository.StaticFileRepository
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		want     []byte
+		wantSize int64
+		wantErr  error
+	}{
+		{
+			name: "stream static file",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.staticFileService/Stream", gomock.Len(0)).Return(ctx, span)
+
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         tracer,
+						licenseService: mock.NewMockLicenseService(ctrl),
+					}
+				},
+				staticFileRepo: func(ctrl *gomock.Controller, ctx context.Context) repository.StaticFileRepository {
+					repo := repository.NewMockStaticFileRepository(ctrl)
+					repo.EXPECT().Stream(ctx, "/assets/logo.png").Return(io.NopCloser(bytes.NewReader([]byte("file-content"))), int64(12), nil)
+					return repo
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				path: "assets/logo.png",
+			},
+			want:     []byte("file-content"),
+			wantSize: 12,
+		},
+		{
+			name: "stream static file with empty path",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.staticFileService/Stream", gomock.Len(0)).Return(ctx, span)
+
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         tracer,
+						licenseService: mock.NewMockLicenseService(ctrl),
+					}
+				},
+				staticFileRepo: func(ctrl *gomock.Controller, _ context.Context) repository.StaticFileRepository {
+					return repository.NewMockStaticFileRepository(ctrl)
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				path: "",
+			},
+			wantErr: ErrStaticFileInvalidPath,
+		},
+		{
+			name: "stream static file with error",
+			fields: fields{
+				baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+					span := mock.NewMockSpan(ctrl)
+					span.EXPECT().End(gomock.Len(0))
+
+					tracer := mock.NewMockTracer(ctrl)
+					tracer.EXPECT().Start(ctx, "service.staticFileService/Stream", gomock.Len(0)).Return(ctx, span)
+
+					return &baseService{
+						logger:         mock.NewMockLogger(ctrl),
+						tracer:         tracer,
+						licenseService: mock.NewMockLicenseService(ctrl),
+					}
+				},
+				staticFileRepo: func(ctrl *gomock.Controller, ctx context.Context) repository.StaticFileRepository {
+					repo := repository.NewMockStaticFileRepository(ctrl)
+					repo.EXPECT().Stream(ctx, "/missing.txt").Return(nil, int64(0), repository.ErrNotFound)
+					return repo
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				path: "missing.txt",
+			},
+			wantErr: repository.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			s := &staticFileService{
+				baseService:    tt.fields.baseService(ctrl, tt.args.ctx),
+				staticFileRepo: tt.fields.staticFileRepo(ctrl, tt.args.ctx),
+			}
+			body, size, err := s.Stream(tt.args.ctx, tt.args.path)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			defer body.Close()
+
+			data, err := io.ReadAll(body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, data)
+			assert.Equal(t, tt.wantSize, size)
+		})
+	}
+}
+
 func TestStaticFileService_Update(t *testing.T) {
 	type args struct {
 		ctx  context.Context
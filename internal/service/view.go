@@ -0,0 +1,667 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	viewMaxColumns    = 20 // maximum number of columns of a view
+	viewMaxSharedWith = 50 // maximum number of principals a view is shared with
+)
+
+// viewOwnerActions are the actions the owner of a view is granted on it.
+var viewOwnerActions = []model.Action{
+	model.ActionViewRead,
+	model.ActionViewUpdate,
+	model.ActionViewDelete,
+}
+
+// View represents a saved issue view returned by the service.
+type View struct {
+	ID          model.ID
+	Owner       model.ID
+	Scope       model.ID
+	Name        string
+	Description string
+	Options     IssueListOptions
+	Columns     []model.ViewColumn
+	Grouping    model.ViewGrouping
+	SharedWith  []model.ID
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// CreateViewOpts holds the data required to create a view. The view lists
+// the issues with the filter, sort and query of the options, and is shared
+// with the teams and organizations of SharedWith.
+type CreateViewOpts struct {
+	Name        string             `json:"name" validate:"required,min=1,max=120"`
+	Description string             `json:"description" validate:"omitempty,max=500"`
+	Options     IssueListOptions   `json:"options" validate:"-"`
+	Columns     []model.ViewColumn `json:"columns" validate:"max=20"`
+	Grouping    model.ViewGrouping `json:"grouping" validate:"omitempty"`
+	SharedWith  []model.ID         `json:"shared_with" validate:"max=50"`
+}
+
+// Validate validates the create options.
+func (o *CreateViewOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidViewDetails, err)
+	}
+	if err := model.ValidateViewColumns(o.Columns); err != nil {
+		return err
+	}
+	if o.Grouping != "" && !o.Grouping.Valid() {
+		return model.ErrInvalidViewDetails
+	}
+	return validateViewSharedWith(o.SharedWith)
+}
+
+// UpdateViewOpts holds the fields that can be updated on a view. Undefined
+// fields (Defined == false) are left unchanged. The filter is replaced as a
+// whole, and SharedWith replaces the teams and organizations the view is
+// shared with. Null fields reset them to their defaults.
+type UpdateViewOpts struct {
+	Name        optional.Optional[string]
+	Description optional.Optional[string]
+	Filter      optional.Optional[repository.IssueListFilter]
+	Query       optional.Optional[string]
+	Sort        optional.Optional[repository.IssueListSort]
+	Columns     optional.Optional[[]model.ViewColumn]
+	Grouping    optional.Optional[model.ViewGrouping]
+	SharedWith  optional.Optional[[]model.ID]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateViewOpts) Validate() error {
+	fields := []struct {
+		value optional.Optional[string]
+		tag   string
+	}{
+		{o.Name, "required,min=1,max=120"},
+		{o.Description, "omitempty,max=500"},
+	}
+
+	for _, f := range fields {
+		if !f.value.Defined {
+			continue
+		}
+		var value string
+		if f.value.Value != nil {
+			value = *f.value.Value
+		}
+		if err := validate.Var(value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidViewDetails, err)
+		}
+	}
+
+	if o.Columns.Defined && o.Columns.Value != nil {
+		if len(*o.Columns.Value) > viewMaxColumns {
+			return model.ErrInvalidViewDetails
+		}
+		if err := model.ValidateViewColumns(*o.Columns.Value); err != nil {
+			return err
+		}
+	}
+
+	if o.Grouping.Defined && o.Grouping.Value != nil && !o.Grouping.Value.Valid() {
+		return model.ErrInvalidViewDetails
+	}
+
+	if o.SharedWith.Defined && o.SharedWith.Value != nil {
+		return validateViewSharedWith(*o.SharedWith.Value)
+	}
+
+	return nil
+}
+
+// validateViewSharedWith validates the principals a view is shared with,
+// which must be teams or organizations.
+func validateViewSharedWith(principals []model.ID) error {
+	if len(principals) > viewMaxSharedWith {
+		return model.ErrInvalidViewDetails
+	}
+	for _, principal := range principals {
+		if err := principal.Validate(); err != nil {
+			return errors.Join(model.ErrInvalidViewDetails, err)
+		}
+		if !isViewSharePrincipal(principal) {
+			return ErrViewShare
+		}
+	}
+	return nil
+}
+
+func isViewSharePrincipal(principal model.ID) bool {
+	return principal.Type == model.ResourceTypeTeam || principal.Type == model.ResourceTypeOrganization
+}
+
+// normalizeViewOptions returns the options a view is stored with. Issues are
+// ranked by default, and the conditions of the filter are dropped as they
+// are parsed from the query every time the view is run.
+func normalizeViewOptions(opts IssueListOptions) IssueListOptions {
+	opts.Filter.Conditions = nil
+	if opts.Sort.Field == "" {
+		opts.Sort = repository.IssueListSort{
+			Field:     repository.IssueListSortFieldRank,
+			Direction: repository.SortDirectionAsc,
+		}
+	}
+	return opts
+}
+
+// validateViewOptions validates the options of a view listing the issues of
+// the scope. The query is parsed for the user to report syntax errors early,
+// and custom fields are only available on project views.
+func validateViewOptions(scope model.ID, opts IssueListOptions, userID model.ID) error {
+	if scope.Type == model.ResourceTypeNamespace && opts.hasCustomFields() {
+		return ErrIssueCustomFieldList
+	}
+	if _, err := parseIssueQuery(opts.Query, userID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func viewFilterFromOptions(opts IssueListOptions) repository.ViewFilter {
+	return repository.ViewFilter{
+		Text:         opts.Filter.Text,
+		Query:        opts.Query,
+		Statuses:     opts.Filter.Statuses,
+		Priorities:   opts.Filter.Priorities,
+		CustomFields: opts.Filter.CustomFields,
+		Components:   opts.Filter.Components,
+	}
+}
+
+func viewFromRepository(v *repository.View, sharedWith []model.ID) *View {
+	if v == nil {
+		return nil
+	}
+	return &View{
+		ID:          v.ID,
+		Owner:       v.Owner,
+		Scope:       v.Scope,
+		Name:        v.Name,
+		Description: v.Description,
+		Options: IssueListOptions{
+			Filter: repository.IssueListFilter{
+				Text:         v.Filter.Text,
+				Statuses:     v.Filter.Statuses,
+				Priorities:   v.Filter.Priorities,
+				CustomFields: v.Filter.CustomFields,
+				Components:   v.Filter.Components,
+			},
+			Sort:  v.Sort,
+			Query: v.Filter.Query,
+		},
+		Columns:    v.Columns,
+		Grouping:   v.Grouping,
+		SharedWith: sharedWith,
+		CreatedAt:  v.CreatedAt,
+		UpdatedAt:  v.UpdatedAt,
+	}
+}
+
+// ViewService serves the business logic of interacting with saved issue
+// views.
+//
+//go:generate go tool mockgen -destination=view_mock_gen.go -package=service -mock_names ViewService=MockViewService . ViewService
+type ViewService interface {
+	// Create creates a new view of the context user listing the issues of a
+	// project or namespace.
+	Create(ctx context.Context, scope model.ID, opts CreateViewOpts) (*View, error)
+	// Get returns a view by its ID.
+	Get(ctx context.Context, id model.ID) (*View, error)
+	// List returns a cursor-paginated page of the views the context user
+	// owns or that are shared with the user, optionally limited to the views
+	// of a project or namespace.
+	List(ctx context.Context, scope *model.ID, page CursorPage) (Page[*View], error)
+	// Update updates a view.
+	Update(ctx context.Context, id model.ID, opts UpdateViewOpts) (*View, error)
+	// Delete deletes a view and the grants sharing it.
+	Delete(ctx context.Context, id model.ID) error
+}
+
+// viewService is the concrete implementation of ViewService.
+type viewService struct {
+	*baseService
+}
+
+// getForAction returns the view if the context user can perform the action
+// on it.
+func (s *viewService) getForAction(ctx context.Context, id model.ID, action model.Action) (*repository.View, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	if id.Type != model.ResourceTypeView {
+		return nil, model.ErrInvalidID
+	}
+
+	view, err := s.viewRepo.Get(ctx, id, repository.ViewDetailProjection())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, view.ID, action) {
+		return nil, ErrNoPermission
+	}
+
+	return view, nil
+}
+
+// sharedWith returns the teams and organizations that can read each of the
+// views, looking up the grants of all views at once.
+func (s *viewService) sharedWith(ctx context.Context, ids ...model.ID) (map[model.ID][]model.ID, error) {
+	grants, err := s.permissionService.ListByScopes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	principals := make(map[model.ID][]model.ID, len(ids))
+	for _, id := range ids {
+		principals[id] = make([]model.ID, 0)
+	}
+	for _, grant := range grants {
+		if isViewSharePrincipal(grant.Principal) && slices.Contains(grant.Actions, model.ActionViewRead) {
+			principals[grant.Scope] = append(principals[grant.Scope], grant.Principal)
+		}
+	}
+
+	return principals, nil
+}
+
+// scopeOrganization returns the organization the resource is in the scope
+// of, or the resource itself if it is an organization.
+func (s *viewService) scopeOrganization(ctx context.Context, id model.ID) (model.ID, error) {
+	ancestry, err := s.permissionService.ListScopeAncestry(ctx, id)
+	if err != nil {
+		return model.ID{}, err
+	}
+
+	for _, ancestor := range ancestry {
+		if ancestor.Type == model.ResourceTypeOrganization {
+			return ancestor, nil
+		}
+	}
+
+	return model.ID{}, ErrViewShareScope
+}
+
+// validateShare checks that the view of the scope can be shared with the
+// principals. The principals must be the organization of the scope or its
+// teams, and the context user must be able to read the organization.
+func (s *viewService) validateShare(ctx context.Context, scope model.ID, principals []model.ID) error {
+	if len(principals) == 0 {
+		return nil
+	}
+
+	orgID, err := s.scopeOrganization(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, orgID, model.ActionOrganizationRead) {
+		return ErrNoPermission
+	}
+
+	for i, principal := range principals {
+		if slices.Contains(principals[:i], principal) {
+			continue
+		}
+		principalOrgID, err := s.scopeOrganization(ctx, principal)
+		if err != nil {
+			return err
+		}
+		if principalOrgID != orgID {
+			return ErrViewShareScope
+		}
+	}
+
+	return nil
+}
+
+// share sets the teams and organizations the view is shared with, granting
+// read access to the new principals and revoking it from the removed ones.
+func (s *viewService) share(ctx context.Context, id model.ID, principals []model.ID) ([]model.ID, error) {
+	grants, err := s.permissionService.ListByScope(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	shared := make(map[model.ID]struct{}, len(grants))
+	for _, grant := range grants {
+		if !isViewSharePrincipal(grant.Principal) {
+			continue
+		}
+		if slices.Contains(principals, grant.Principal) {
+			shared[grant.Principal] = struct{}{}
+			continue
+		}
+		if err := s.permissionService.Delete(ctx, grant.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]model.ID, 0, len(principals))
+	for _, principal := range principals {
+		if slices.Contains(out, principal) {
+			continue
+		}
+		out = append(out, principal)
+
+		if _, ok := shared[principal]; ok {
+			continue
+		}
+		if _, err := s.permissionService.Create(ctx, CreateGrantOpts{
+			Principal: principal,
+			Scope:     id,
+			Actions:   []model.Action{model.ActionViewRead},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+func (s *viewService) Create(ctx context.Context, scope model.ID, opts CreateViewOpts) (*View, error) {
+	ctx, span := s.tracer.Start(ctx, "service.viewService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrViewCreate, license.ErrLicenseExpired)
+	}
+
+	if err := model.ValidateViewScope(scope); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrViewCreate, ErrNoUser)
+	}
+
+	action, _ := model.ReadActionFor(scope.Type)
+	if !s.permissionService.CtxUserHas(ctx, scope, action) {
+		return nil, errors.Join(ErrViewCreate, ErrNoPermission)
+	}
+
+	options := normalizeViewOptions(opts.Options)
+	if err := validateViewOptions(scope, options, userID); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	if err := s.validateShare(ctx, scope, opts.SharedWith); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	grouping := opts.Grouping
+	if grouping == "" {
+		grouping = model.ViewGroupingNone
+	}
+
+	columns := opts.Columns
+	if columns == nil {
+		columns = make([]model.ViewColumn, 0)
+	}
+
+	view, err := s.viewRepo.Create(ctx, repository.CreateViewOpts{
+		Owner:       userID,
+		Scope:       scope,
+		Name:        opts.Name,
+		Description: opts.Description,
+		Filter:      viewFilterFromOptions(options),
+		Sort:        options.Sort,
+		Columns:     columns,
+		Grouping:    grouping,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	if err := s.permissionService.BootstrapCreator(ctx, userID, view.ID, viewOwnerActions); err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	sharedWith, err := s.share(ctx, view.ID, opts.SharedWith)
+	if err != nil {
+		return nil, errors.Join(ErrViewCreate, err)
+	}
+
+	return viewFromRepository(view, sharedWith), nil
+}
+
+func (s *viewService) Get(ctx context.Context, id model.ID) (*View, error) {
+	ctx, span := s.tracer.Start(ctx, "service.viewService/Get")
+	defer span.End()
+
+	view, err := s.getForAction(ctx, id, model.ActionViewRead)
+	if err != nil {
+		return nil, errors.Join(ErrViewGet, err)
+	}
+
+	sharedWith, err := s.sharedWith(ctx, view.ID)
+	if err != nil {
+		return nil, errors.Join(ErrViewGet, err)
+	}
+
+	return viewFromRepository(view, sharedWith[view.ID]), nil
+}
+
+func (s *viewService) List(ctx context.Context, scope *model.ID, page CursorPage) (Page[*View], error) {
+	ctx, span := s.tracer.Start(ctx, "service.viewService/List")
+	defer span.End()
+
+	if scope != nil {
+		if err := model.ValidateViewScope(*scope); err != nil {
+			return Page[*View]{}, errors.Join(ErrViewGetAll, err)
+		}
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewGetAll, err)
+	}
+
+	scopeIDs, err := s.permissionService.CtxUserListGrantScopes(ctx, model.ActionViewRead)
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewGetAll, err)
+	}
+
+	ids := make([]model.ID, 0, len(scopeIDs))
+	for _, id := range scopeIDs {
+		if id.Type == model.ResourceTypeView {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return repository.EmptyPage[*View](), nil
+	}
+
+	views, err := s.viewRepo.List(ctx, ids, scope, normalized, repository.ViewListProjection())
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewGetAll, err)
+	}
+
+	viewIDs := make([]model.ID, len(views.Items))
+	for i, view := range views.Items {
+		viewIDs[i] = view.ID
+	}
+
+	sharedWith, err := s.sharedWith(ctx, viewIDs...)
+	if err != nil {
+		return Page[*View]{}, errors.Join(ErrViewGetAll, err)
+	}
+
+	items := make([]*View, len(views.Items))
+	for i, view := range views.Items {
+		items[i] = viewFromRepository(view, sharedWith[view.ID])
+	}
+
+	return Page[*View]{Items: items, PageInfo: views.PageInfo}, nil
+}
+
+func (s *viewService) Update(ctx context.Context, id model.ID, opts UpdateViewOpts) (*View, error) {
+	ctx, span := s.tracer.Start(ctx, "service.viewService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrViewUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrViewUpdate, err)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrViewUpdate, ErrNoUser)
+	}
+
+	current, err := s.getForAction(ctx, id, model.ActionViewUpdate)
+	if err != nil {
+		return nil, errors.Join(ErrViewUpdate, err)
+	}
+
+	patch := repository.UpdateViewOpts{
+		Name:        opts.Name,
+		Description: clearedToEmpty(opts.Description),
+	}
+
+	if opts.Filter.Defined || opts.Query.Defined || opts.Sort.Defined {
+		options := viewFromRepository(current, nil).Options
+		if opts.Filter.Defined {
+			options.Filter = repository.IssueListFilter{}
+			if opts.Filter.Value != nil {
+				options.Filter = *opts.Filter.Value
+			}
+		}
+		if opts.Query.Defined {
+			options.Query = ""
+			if opts.Query.Value != nil {
+				options.Query = *opts.Query.Value
+			}
+		}
+		if opts.Sort.Defined {
+			options.Sort = repository.IssueListSort{}
+			if opts.Sort.Value != nil {
+				options.Sort = *opts.Sort.Value
+			}
+		}
+
+		options = normalizeViewOptions(options)
+		if err := validateViewOptions(current.Scope, options, userID); err != nil {
+			return nil, errors.Join(ErrViewUpdate, err)
+		}
+
+		if opts.Filter.Defined || opts.Query.Defined {
+			patch.Filter = optional.Some(viewFilterFromOptions(options))
+		}
+		if opts.Sort.Defined {
+			patch.Sort = optional.Some(options.Sort)
+		}
+	}
+
+	if opts.Columns.Defined {
+		columns := make([]model.ViewColumn, 0)
+		if opts.Columns.Value != nil {
+			columns = *opts.Columns.Value
+		}
+		patch.Columns = optional.Some(columns)
+	}
+
+	if opts.Grouping.Defined {
+		grouping := model.ViewGroupingNone
+		if opts.Grouping.Value != nil {
+			grouping = *opts.Grouping.Value
+		}
+		patch.Grouping = optional.Some(grouping)
+	}
+
+	var principals []model.ID
+	if opts.SharedWith.Defined && opts.SharedWith.Value != nil {
+		principals = *opts.SharedWith.Value
+		if err := s.validateShare(ctx, current.Scope, principals); err != nil {
+			return nil, errors.Join(ErrViewUpdate, err)
+		}
+	}
+
+	view, err := s.viewRepo.Update(ctx, id, patch)
+	if err != nil {
+		return nil, errors.Join(ErrViewUpdate, err)
+	}
+
+	var sharedWith []model.ID
+	if opts.SharedWith.Defined {
+		sharedWith, err = s.share(ctx, view.ID, principals)
+	} else {
+		var shared map[model.ID][]model.ID
+		shared, err = s.sharedWith(ctx, view.ID)
+		sharedWith = shared[view.ID]
+	}
+	if err != nil {
+		return nil, errors.Join(ErrViewUpdate, err)
+	}
+
+	return viewFromRepository(view, sharedWith), nil
+}
+
+func (s *viewService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.viewService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrViewDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getForAction(ctx, id, model.ActionViewDelete); err != nil {
+		return errors.Join(ErrViewDelete, err)
+	}
+
+	if err := s.viewRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrViewDelete, err)
+	}
+
+	return nil
+}
+
+// NewViewService returns a new instance of the ViewService interface.
+func NewViewService(opts ...Option) (ViewService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &viewService{
+		baseService: s,
+	}
+
+	if svc.viewRepo == nil {
+		return nil, ErrNoViewRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
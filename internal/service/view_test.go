@@ -0,0 +1,485 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestNewViewService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new view service",
+			opts: []Option{
+				WithViewRepository(repository.NewMockViewRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new view service with invalid options",
+			opts:    []Option{WithViewRepository(nil)},
+			wantErr: ErrNoViewRepository,
+		},
+		{
+			name: "new view service with no view repository",
+			opts: []Option{
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoViewRepository,
+		},
+		{
+			name: "new view service with no license service",
+			opts: []Option{
+				WithViewRepository(repository.NewMockViewRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new view service with no permission service",
+			opts: []Option{
+				WithViewRepository(repository.NewMockViewRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewViewService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestViewService_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	namespaceID := model.MustNewID(model.ResourceTypeNamespace)
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	teamID := model.MustNewID(model.ResourceTypeTeam)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context) (*viewService, *repository.MockViewRepository, *MockPermissionService) {
+		viewRepo := repository.NewMockViewRepository(ctrl)
+		permSvc := NewMockPermissionService(ctrl)
+
+		return &viewService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.viewService/Create"),
+			viewRepo:          viewRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}, viewRepo, permSvc
+	}
+
+	t.Run("create view shared with a team", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, viewRepo, permSvc := newService(ctrl, ctx)
+		view := testModel.NewRepositoryView(userID, projectID)
+
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, namespaceID, orgID}, nil)
+		permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionOrganizationRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, teamID).Return([]model.ID{teamID, orgID}, nil)
+		viewRepo.EXPECT().Create(ctx, repository.CreateViewOpts{
+			Owner: userID,
+			Scope: projectID,
+			Name:  "Triage",
+			Filter: repository.ViewFilter{
+				Query:    "assignee:me",
+				Statuses: []model.IssueStatus{model.IssueStatusOpen},
+			},
+			Sort: repository.IssueListSort{
+				Field:     repository.IssueListSortFieldRank,
+				Direction: repository.SortDirectionAsc,
+			},
+			Columns:  []model.ViewColumn{},
+			Grouping: model.ViewGroupingNone,
+		}).Return(view, nil)
+		permSvc.EXPECT().BootstrapCreator(ctx, userID, view.ID, viewOwnerActions).Return(nil)
+		permSvc.EXPECT().ListByScope(ctx, view.ID).Return([]*Grant{
+			{Principal: userID, Scope: view.ID, Actions: viewOwnerActions},
+		}, nil)
+		permSvc.EXPECT().Create(ctx, CreateGrantOpts{
+			Principal: teamID,
+			Scope:     view.ID,
+			Actions:   []model.Action{model.ActionViewRead},
+		}).Return(&Grant{}, nil)
+
+		got, err := s.Create(ctx, projectID, CreateViewOpts{
+			Name: "Triage",
+			Options: IssueListOptions{
+				Filter: repository.IssueListFilter{Statuses: []model.IssueStatus{model.IssueStatusOpen}},
+				Query:  "assignee:me",
+			},
+			SharedWith: []model.ID{teamID, teamID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, viewFromRepository(view, []model.ID{teamID}), got)
+	})
+
+	t.Run("create view shared with a team of another organization", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, permSvc := newService(ctrl, ctx)
+		foreignOrgID := model.MustNewID(model.ResourceTypeOrganization)
+
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, namespaceID, orgID}, nil)
+		permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionOrganizationRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, teamID).Return([]model.ID{teamID, foreignOrgID}, nil)
+
+		_, err := s.Create(ctx, projectID, CreateViewOpts{
+			Name:       "Triage",
+			SharedWith: []model.ID{teamID},
+		})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrViewShareScope)
+	})
+
+	t.Run("create view shared with another organization", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, permSvc := newService(ctrl, ctx)
+		foreignOrgID := model.MustNewID(model.ResourceTypeOrganization)
+
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, namespaceID, orgID}, nil)
+		permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionOrganizationRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, foreignOrgID).Return([]model.ID{foreignOrgID}, nil)
+
+		_, err := s.Create(ctx, projectID, CreateViewOpts{
+			Name:       "Triage",
+			SharedWith: []model.ID{foreignOrgID},
+		})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrViewShareScope)
+	})
+
+	t.Run("create view shared without organization read", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, permSvc := newService(ctrl, ctx)
+
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+		permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, namespaceID, orgID}, nil)
+		permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionOrganizationRead).Return(false)
+
+		_, err := s.Create(ctx, projectID, CreateViewOpts{
+			Name:       "Triage",
+			SharedWith: []model.ID{teamID},
+		})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("create view without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, permSvc := newService(ctrl, ctx)
+		permSvc.EXPECT().CtxUserHas(ctx, namespaceID, model.ActionNamespaceRead).Return(false)
+
+		_, err := s.Create(ctx, namespaceID, CreateViewOpts{Name: "Triage"})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("create namespace view with custom fields", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, permSvc := newService(ctrl, ctx)
+		permSvc.EXPECT().CtxUserHas(ctx, namespaceID, model.ActionNamespaceRead).Return(true)
+
+		_, err := s.Create(ctx, namespaceID, CreateViewOpts{
+			Name: "Triage",
+			Options: IssueListOptions{
+				Sort: repository.IssueListSort{Field: repository.IssueListSortFieldCustom("effort")},
+			},
+		})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrIssueCustomFieldList)
+	})
+
+	t.Run("create view with invalid query", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, permSvc := newService(ctrl, ctx)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		_, err := s.Create(ctx, projectID, CreateViewOpts{
+			Name:    "Triage",
+			Options: IssueListOptions{Query: "color:red"},
+		})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrIssueQuery)
+	})
+
+	t.Run("create view shared with a user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, _ := newService(ctrl, ctx)
+
+		_, err := s.Create(ctx, projectID, CreateViewOpts{
+			Name:       "Triage",
+			SharedWith: []model.ID{model.MustNewID(model.ResourceTypeUser)},
+		})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, ErrViewShare)
+	})
+
+	t.Run("create view with invalid scope", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s, _, _ := newService(ctrl, ctx)
+
+		_, err := s.Create(ctx, model.MustNewID(model.ResourceTypeOrganization), CreateViewOpts{Name: "Triage"})
+		assert.ErrorIs(t, err, ErrViewCreate)
+		assert.ErrorIs(t, err, model.ErrInvalidViewDetails)
+	})
+}
+
+func TestViewService_Get(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	tests := []struct {
+		name    string
+		allowed bool
+		wantErr error
+	}{
+		{
+			name:    "get view",
+			allowed: true,
+		},
+		{
+			name:    "get view without permission",
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			view := testModel.NewRepositoryView(userID, projectID)
+
+			viewRepo := repository.NewMockViewRepository(ctrl)
+			viewRepo.EXPECT().Get(ctx, view.ID, repository.ViewDetailProjection()).Return(view, nil)
+
+			permSvc := NewMockPermissionService(ctrl)
+			permSvc.EXPECT().CtxUserHas(ctx, view.ID, model.ActionViewRead).Return(tt.allowed)
+			if tt.allowed {
+				permSvc.EXPECT().ListByScopes(ctx, []model.ID{view.ID}).Return([]*Grant{
+					{Principal: userID, Scope: view.ID, Actions: viewOwnerActions},
+					{Principal: orgID, Scope: view.ID, Actions: []model.Action{model.ActionViewRead}},
+				}, nil)
+			}
+
+			s := &viewService{baseService: &baseService{
+				tracer:            newCommentTestTracer(ctrl, ctx, "service.viewService/Get"),
+				viewRepo:          viewRepo,
+				permissionService: permSvc,
+			}}
+
+			got, err := s.Get(ctx, view.ID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, viewFromRepository(view, []model.ID{orgID}), got)
+			}
+		})
+	}
+}
+
+func TestViewService_List(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("list views the user can read", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		view := testModel.NewRepositoryView(userID, projectID)
+		sharedView := testModel.NewRepositoryView(model.MustNewID(model.ResourceTypeUser), projectID)
+		teamID := model.MustNewID(model.ResourceTypeTeam)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserListGrantScopes(ctx, model.ActionViewRead).Return([]model.ID{projectID, view.ID, sharedView.ID}, nil)
+		permSvc.EXPECT().ListByScopes(ctx, []model.ID{view.ID, sharedView.ID}).Return([]*Grant{
+			{Principal: userID, Scope: view.ID, Actions: viewOwnerActions},
+			{Principal: teamID, Scope: sharedView.ID, Actions: []model.Action{model.ActionViewRead}},
+		}, nil)
+
+		viewRepo := repository.NewMockViewRepository(ctrl)
+		viewRepo.EXPECT().List(ctx, []model.ID{view.ID, sharedView.ID}, &projectID, CursorPage{Size: repository.DefaultPageSize}, repository.ViewListProjection()).
+			Return(repository.Page[*repository.View]{Items: []*repository.View{view, sharedView}}, nil)
+
+		s := &viewService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.viewService/List"),
+			viewRepo:          viewRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.List(ctx, &projectID, CursorPage{})
+		require.NoError(t, err)
+		assert.Equal(t, []*View{
+			viewFromRepository(view, []model.ID{}),
+			viewFromRepository(sharedView, []model.ID{teamID}),
+		}, got.Items)
+	})
+
+	t.Run("list views without grants", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserListGrantScopes(ctx, model.ActionViewRead).Return([]model.ID{projectID}, nil)
+
+		s := &viewService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.viewService/List"),
+			viewRepo:          repository.NewMockViewRepository(ctrl),
+			permissionService: permSvc,
+		}}
+
+		got, err := s.List(ctx, nil, CursorPage{})
+		require.NoError(t, err)
+		assert.Empty(t, got.Items)
+	})
+}
+
+func TestViewService_Update(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	userID := model.MustNewID(model.ResourceTypeUser)
+	ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	oldTeamID := model.MustNewID(model.ResourceTypeTeam)
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	view := testModel.NewRepositoryView(userID, projectID)
+	oldGrantID := model.MustNewID(model.ResourceTypePermission)
+
+	viewRepo := repository.NewMockViewRepository(ctrl)
+	viewRepo.EXPECT().Get(ctx, view.ID, repository.ViewDetailProjection()).Return(view, nil)
+	viewRepo.EXPECT().Update(ctx, view.ID, repository.UpdateViewOpts{
+		Description: optional.Some(""),
+		Filter: optional.Some(repository.ViewFilter{
+			Priorities: []model.IssuePriority{model.IssuePriorityHighest},
+		}),
+		Sort: optional.Some(repository.IssueListSort{
+			Field:     repository.IssueListSortFieldDueDate,
+			Direction: repository.SortDirectionDesc,
+		}),
+		Grouping: optional.Some(model.ViewGroupingNone),
+	}).Return(view, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, view.ID, model.ActionViewUpdate).Return(true)
+	permSvc.EXPECT().ListScopeAncestry(ctx, projectID).Return([]model.ID{projectID, orgID}, nil)
+	permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionOrganizationRead).Return(true)
+	permSvc.EXPECT().ListScopeAncestry(ctx, orgID).Return([]model.ID{orgID}, nil)
+	permSvc.EXPECT().ListByScope(ctx, view.ID).Return([]*Grant{
+		{Principal: userID, Scope: view.ID, Actions: viewOwnerActions},
+		{ID: oldGrantID, Principal: oldTeamID, Scope: view.ID, Actions: []model.Action{model.ActionViewRead}},
+	}, nil)
+	permSvc.EXPECT().Delete(ctx, oldGrantID).Return(nil)
+	permSvc.EXPECT().Create(ctx, CreateGrantOpts{
+		Principal: orgID,
+		Scope:     view.ID,
+		Actions:   []model.Action{model.ActionViewRead},
+	}).Return(&Grant{}, nil)
+
+	s := &viewService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.viewService/Update"),
+		viewRepo:          viewRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	got, err := s.Update(ctx, view.ID, UpdateViewOpts{
+		Description: optional.Null[string](),
+		Filter:      optional.Some(repository.IssueListFilter{Priorities: []model.IssuePriority{model.IssuePriorityHighest}}),
+		Query:       optional.Null[string](),
+		Sort: optional.Some(repository.IssueListSort{
+			Field:     repository.IssueListSortFieldDueDate,
+			Direction: repository.SortDirectionDesc,
+		}),
+		Grouping:   optional.Null[model.ViewGrouping](),
+		SharedWith: optional.Some([]model.ID{orgID}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []model.ID{orgID}, got.SharedWith)
+}
+
+func TestViewService_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	view := testModel.NewRepositoryView(model.MustNewID(model.ResourceTypeUser), model.MustNewID(model.ResourceTypeProject))
+
+	viewRepo := repository.NewMockViewRepository(ctrl)
+	viewRepo.EXPECT().Get(ctx, view.ID, repository.ViewDetailProjection()).Return(view, nil)
+	viewRepo.EXPECT().Delete(ctx, view.ID).Return(nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, view.ID, model.ActionViewDelete).Return(true)
+
+	s := &viewService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.viewService/Delete"),
+		viewRepo:          viewRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	require.NoError(t, s.Delete(ctx, view.ID))
+}
@@ -0,0 +1,527 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/hibiken/asynq"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	WebhookDeliveryStatusPending   = repository.WebhookDeliveryStatusPending   // the delivery is waiting for a successful attempt
+	WebhookDeliveryStatusSucceeded = repository.WebhookDeliveryStatusSucceeded // the receiver accepted the delivery
+	WebhookDeliveryStatusFailed    = repository.WebhookDeliveryStatusFailed    // every attempt of the delivery failed
+
+	webhookURLTag    = "required,http_url,max=2048"
+	webhookSecretTag = "required,min=16,max=128"
+	webhookEventsTag = "required,min=1,unique,dive,oneof=issue.updated document.updated"
+)
+
+// WebhookTaskEnqueuer schedules webhook delivery tasks.
+type WebhookTaskEnqueuer interface {
+	Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
+
+// Webhook represents a webhook returned by the service. The secret of the
+// webhook is write-only, therefore it is never returned.
+type Webhook struct {
+	ID        model.ID
+	Scope     model.ID
+	URL       string
+	Events    []string
+	Enabled   bool
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+// WebhookDelivery represents a delivery of a webhook and the outcome of its
+// last attempt.
+type WebhookDelivery struct {
+	ID             model.ID
+	Webhook        model.ID
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int
+	ResponseStatus *int
+	Error          *string
+	CreatedAt      *time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookPayload is the body sent to the webhook receivers.
+type WebhookPayload struct {
+	Event         string    `json:"event"`
+	ResourceType  string    `json:"resource_type"`
+	ResourceID    string    `json:"resource_id"`
+	Actor         *string   `json:"actor"`
+	ChangedFields []string  `json:"changed_fields"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CreateWebhookOpts holds the data required to create a webhook.
+type CreateWebhookOpts struct {
+	URL     string   `json:"url"`
+	Secret  string   `json:"secret"`
+	Events  []string `json:"events"`
+	Enabled bool     `json:"enabled"`
+}
+
+// Validate validates the create options with the same rules as the update
+// options.
+func (o *CreateWebhookOpts) Validate() error {
+	fields := []struct {
+		value any
+		tag   string
+	}{
+		{o.URL, webhookURLTag},
+		{o.Secret, webhookSecretTag},
+		{o.Events, webhookEventsTag},
+	}
+
+	for _, f := range fields {
+		if err := validate.Var(f.value, f.tag); err != nil {
+			return errors.Join(model.ErrInvalidWebhookDetails, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateWebhookOpts holds the fields that can be updated on a webhook.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateWebhookOpts struct {
+	URL     optional.Optional[string]
+	Secret  optional.Optional[string]
+	Events  optional.Optional[[]string]
+	Enabled optional.Optional[bool]
+}
+
+// Validate validates the defined fields of the update options. None of the
+// fields can be cleared.
+func (o *UpdateWebhookOpts) Validate() error {
+	fields := []struct {
+		defined bool
+		cleared bool
+		value   func() any
+		tag     string
+	}{
+		{o.URL.Defined, o.URL.Value == nil, func() any { return *o.URL.Value }, webhookURLTag},
+		{o.Secret.Defined, o.Secret.Value == nil, func() any { return *o.Secret.Value }, webhookSecretTag},
+		{o.Events.Defined, o.Events.Value == nil, func() any { return *o.Events.Value }, webhookEventsTag},
+		{o.Enabled.Defined, o.Enabled.Value == nil, func() any { return *o.Enabled.Value }, ""},
+	}
+
+	for _, f := range fields {
+		if !f.defined {
+			continue
+		}
+		if f.cleared {
+			return model.ErrInvalidWebhookDetails
+		}
+		if f.tag == "" {
+			continue
+		}
+		if err := validate.Var(f.value(), f.tag); err != nil {
+			return errors.Join(model.ErrInvalidWebhookDetails, err)
+		}
+	}
+
+	return nil
+}
+
+// WebhookService serves the business logic of interacting with webhooks.
+//
+//go:generate go tool mockgen -destination=webhook_mock_gen.go -package=service -mock_names WebhookService=MockWebhookService . WebhookService
+type WebhookService interface {
+	// Create creates a new webhook in the organization or project scope.
+	Create(ctx context.Context, scope model.ID, opts CreateWebhookOpts) (*Webhook, error)
+	// Get returns a webhook by its ID.
+	Get(ctx context.Context, id model.ID) (*Webhook, error)
+	// ListForScope returns a cursor-paginated page of the webhooks of the
+	// organization or project.
+	ListForScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Webhook], error)
+	// Update updates a webhook.
+	Update(ctx context.Context, id model.ID, opts UpdateWebhookOpts) (*Webhook, error)
+	// Delete deletes a webhook and its delivery log.
+	Delete(ctx context.Context, id model.ID) error
+	// ListDeliveries returns a cursor-paginated page of the deliveries of the
+	// webhook, newest first.
+	ListDeliveries(ctx context.Context, id model.ID, page CursorPage) (Page[*WebhookDelivery], error)
+	// Redeliver sends the payload of a finished delivery again as a new
+	// delivery of the same webhook.
+	Redeliver(ctx context.Context, id, deliveryID model.ID) (*WebhookDelivery, error)
+}
+
+// webhookService is the concrete implementation of WebhookService.
+type webhookService struct {
+	*baseService
+}
+
+func webhookFromRepository(w *repository.Webhook) *Webhook {
+	if w == nil {
+		return nil
+	}
+	return &Webhook{
+		ID:        w.ID,
+		Scope:     w.Scope,
+		URL:       w.URL,
+		Events:    w.Events,
+		Enabled:   w.Enabled,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func webhookDeliveryFromRepository(d *repository.WebhookDelivery) *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+	return &WebhookDelivery{
+		ID:             d.ID,
+		Webhook:        d.Webhook,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+// canManageScope reports whether the context user can manage the webhooks of
+// the organization or project scope.
+func (s *webhookService) canManageScope(ctx context.Context, scope model.ID) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	if scope.Type != model.ResourceTypeOrganization && scope.Type != model.ResourceTypeProject {
+		return model.ErrInvalidID
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, scope, model.ActionWebhookManage) {
+		return ErrNoPermission
+	}
+
+	return nil
+}
+
+// getManaged returns the webhook if the context user can manage it.
+func (s *webhookService) getManaged(ctx context.Context, id model.ID) (*repository.Webhook, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.webhookRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.canManageScope(ctx, webhook.Scope); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (s *webhookService) Create(ctx context.Context, scope model.ID, opts CreateWebhookOpts) (*Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrWebhookCreate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrWebhookCreate, err)
+	}
+
+	if err := s.canManageScope(ctx, scope); err != nil {
+		return nil, errors.Join(ErrWebhookCreate, err)
+	}
+
+	webhook, err := s.webhookRepo.Create(ctx, repository.CreateWebhookOpts{
+		Scope:   scope,
+		URL:     opts.URL,
+		Secret:  opts.Secret,
+		Events:  opts.Events,
+		Enabled: opts.Enabled,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWebhookCreate, err)
+	}
+
+	return webhookFromRepository(webhook), nil
+}
+
+func (s *webhookService) Get(ctx context.Context, id model.ID) (*Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/Get")
+	defer span.End()
+
+	webhook, err := s.getManaged(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookGet, err)
+	}
+
+	return webhookFromRepository(webhook), nil
+}
+
+func (s *webhookService) ListForScope(ctx context.Context, scope model.ID, page CursorPage) (Page[*Webhook], error) {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/ListForScope")
+	defer span.End()
+
+	if err := s.canManageScope(ctx, scope); err != nil {
+		return Page[*Webhook]{}, errors.Join(ErrWebhookGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*Webhook]{}, errors.Join(ErrWebhookGetAll, err)
+	}
+
+	webhooks, err := s.webhookRepo.ListByScope(ctx, scope, normalized)
+	if err != nil {
+		return Page[*Webhook]{}, errors.Join(ErrWebhookGetAll, err)
+	}
+
+	return mapPage(webhooks, webhookFromRepository), nil
+}
+
+func (s *webhookService) Update(ctx context.Context, id model.ID, opts UpdateWebhookOpts) (*Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrWebhookUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrWebhookUpdate, err)
+	}
+
+	if _, err := s.getManaged(ctx, id); err != nil {
+		return nil, errors.Join(ErrWebhookUpdate, err)
+	}
+
+	webhook, err := s.webhookRepo.Update(ctx, id, repository.UpdateWebhookOpts{
+		URL:     opts.URL,
+		Secret:  opts.Secret,
+		Events:  opts.Events,
+		Enabled: opts.Enabled,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWebhookUpdate, err)
+	}
+
+	return webhookFromRepository(webhook), nil
+}
+
+func (s *webhookService) Delete(ctx context.Context, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrWebhookDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getManaged(ctx, id); err != nil {
+		return errors.Join(ErrWebhookDelete, err)
+	}
+
+	if err := s.webhookRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrWebhookDelete, err)
+	}
+
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, id model.ID, page CursorPage) (Page[*WebhookDelivery], error) {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/ListDeliveries")
+	defer span.End()
+
+	if _, err := s.getManaged(ctx, id); err != nil {
+		return Page[*WebhookDelivery]{}, errors.Join(ErrWebhookDeliveryGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*WebhookDelivery]{}, errors.Join(ErrWebhookDeliveryGetAll, err)
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, id, normalized)
+	if err != nil {
+		return Page[*WebhookDelivery]{}, errors.Join(ErrWebhookDeliveryGetAll, err)
+	}
+
+	return mapPage(deliveries, webhookDeliveryFromRepository), nil
+}
+
+func (s *webhookService) Redeliver(ctx context.Context, id, deliveryID model.ID) (*WebhookDelivery, error) {
+	ctx, span := s.tracer.Start(ctx, "service.webhookService/Redeliver")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, license.ErrLicenseExpired)
+	}
+
+	if err := deliveryID.Validate(); err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, err)
+	}
+
+	if _, err := s.getManaged(ctx, id); err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, err)
+	}
+
+	original, err := s.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, err)
+	}
+
+	if original.Webhook != id {
+		return nil, errors.Join(ErrWebhookRedeliver, repository.ErrNotFound)
+	}
+
+	if original.Status == WebhookDeliveryStatusPending {
+		return nil, errors.Join(ErrWebhookRedeliver, model.ErrInvalidWebhookDetails, ErrWebhookDeliveryPending)
+	}
+
+	delivery, err := s.webhookRepo.CreateDelivery(ctx, repository.CreateWebhookDeliveryOpts{
+		Webhook: id,
+		Event:   original.Event,
+		Payload: original.Payload,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, err)
+	}
+
+	task, err := queue.NewWebhookDeliveryTask(delivery.ID)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, err)
+	}
+
+	if _, err := s.webhookTaskEnqueuer.Enqueue(ctx, task); err != nil {
+		return nil, errors.Join(ErrWebhookRedeliver, err)
+	}
+
+	return webhookDeliveryFromRepository(delivery), nil
+}
+
+// enqueueWebhookDeliveries creates a delivery for every enabled webhook
+// subscribed to the event in the scope of the changed resource, and schedules
+// sending them. Events addressed to a single recipient are never delivered.
+// Deliveries are a side effect of the calling operation, therefore failures
+// are logged only. Without a webhook repository or task enqueuer no delivery
+// is created.
+func (s *baseService) enqueueWebhookDeliveries(ctx context.Context, event *repository.Event) {
+	if s.webhookRepo == nil || s.webhookTaskEnqueuer == nil || s.permissionService == nil {
+		return
+	}
+
+	if event.Recipient != nil {
+		return
+	}
+
+	logFailure := func(msg string, err error) {
+		s.logger.Warn(ctx, msg,
+			log.WithError(err),
+			log.WithValue(event.Resource.Composite()),
+		)
+	}
+
+	scopes, err := s.permissionService.ListScopeAncestry(ctx, event.Resource)
+	if err != nil {
+		logFailure("failed to resolve webhook scopes", err)
+		return
+	}
+
+	webhooks, err := s.webhookRepo.ListSubscribed(ctx, scopes, event.Type)
+	if err != nil {
+		logFailure("failed to list subscribed webhooks", err)
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload := WebhookPayload{
+		Event:         event.Type,
+		ResourceType:  event.Resource.Type.String(),
+		ResourceID:    event.Resource.String(),
+		ChangedFields: event.ChangedFields,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if event.Actor != nil {
+		actor := event.Actor.String()
+		payload.Actor = &actor
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logFailure("failed to marshal webhook payload", err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		delivery, err := s.webhookRepo.CreateDelivery(ctx, repository.CreateWebhookDeliveryOpts{
+			Webhook: webhook.ID,
+			Event:   event.Type,
+			Payload: string(body),
+		})
+		if err != nil {
+			logFailure("failed to create webhook delivery", err)
+			continue
+		}
+
+		task, err := queue.NewWebhookDeliveryTask(delivery.ID)
+		if err == nil {
+			_, err = s.webhookTaskEnqueuer.Enqueue(ctx, task)
+		}
+		if err != nil {
+			logFailure("failed to enqueue webhook delivery", err)
+		}
+	}
+}
+
+// NewWebhookService returns a new instance of the WebhookService interface.
+func NewWebhookService(opts ...Option) (WebhookService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &webhookService{
+		baseService: s,
+	}
+
+	if svc.webhookRepo == nil {
+		return nil, ErrNoWebhookRepository
+	}
+
+	if svc.webhookTaskEnqueuer == nil {
+		return nil, ErrNoWebhookTaskEnqueuer
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
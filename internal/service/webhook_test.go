@@ -0,0 +1,693 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func newTestRepositoryWebhook(scope model.ID) *repository.Webhook {
+	return &repository.Webhook{
+		ID:        model.MustNewID(model.ResourceTypeWebhook),
+		Scope:     scope,
+		URL:       "https://example.com/hooks/elemo",
+		Secret:    "0123456789abcdef",
+		Events:    []string{EventTypeIssueUpdated},
+		Enabled:   true,
+		CreatedAt: convert.ToPointer(time.Now()),
+	}
+}
+
+func newTestRepositoryWebhookDelivery(webhook model.ID, status string) *repository.WebhookDelivery {
+	return &repository.WebhookDelivery{
+		ID:        model.MustNewID(model.ResourceTypeWebhookDelivery),
+		Webhook:   webhook,
+		Event:     EventTypeIssueUpdated,
+		Payload:   `{"event":"issue.updated"}`,
+		Status:    status,
+		Attempts:  1,
+		CreatedAt: convert.ToPointer(time.Now()),
+	}
+}
+
+func TestNewWebhookService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new webhook service",
+			opts: []Option{
+				WithWebhookRepository(repository.NewMockWebhookRepository(nil)),
+				WithWebhookTaskEnqueuer(&stubSearchEnqueuer{}),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new webhook service with invalid options",
+			opts:    []Option{WithWebhookRepository(nil)},
+			wantErr: ErrNoWebhookRepository,
+		},
+		{
+			name: "new webhook service with no webhook repository",
+			opts: []Option{
+				WithWebhookTaskEnqueuer(&stubSearchEnqueuer{}),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoWebhookRepository,
+		},
+		{
+			name: "new webhook service with no task enqueuer",
+			opts: []Option{
+				WithWebhookRepository(repository.NewMockWebhookRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoWebhookTaskEnqueuer,
+		},
+		{
+			name: "new webhook service with no license service",
+			opts: []Option{
+				WithWebhookRepository(repository.NewMockWebhookRepository(nil)),
+				WithWebhookTaskEnqueuer(&stubSearchEnqueuer{}),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new webhook service with no permission service",
+			opts: []Option{
+				WithWebhookRepository(repository.NewMockWebhookRepository(nil)),
+				WithWebhookTaskEnqueuer(&stubSearchEnqueuer{}),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewWebhookService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestCreateWebhookOpts_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := CreateWebhookOpts{
+		URL:    "https://example.com/hooks/elemo",
+		Secret: "0123456789abcdef",
+		Events: []string{EventTypeIssueUpdated, EventTypeDocumentUpdated},
+	}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(o *CreateWebhookOpts)
+	}{
+		{"missing url", func(o *CreateWebhookOpts) { o.URL = "" }},
+		{"invalid url", func(o *CreateWebhookOpts) { o.URL = "ftp://example.com" }},
+		{"short secret", func(o *CreateWebhookOpts) { o.Secret = "secret" }},
+		{"no events", func(o *CreateWebhookOpts) { o.Events = []string{} }},
+		{"unsupported event", func(o *CreateWebhookOpts) { o.Events = []string{EventTypeNotificationCreated} }},
+		{"duplicated event", func(o *CreateWebhookOpts) { o.Events = []string{EventTypeIssueUpdated, EventTypeIssueUpdated} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			opts := valid
+			tt.modify(&opts)
+			assert.ErrorIs(t, opts.Validate(), model.ErrInvalidWebhookDetails)
+		})
+	}
+}
+
+func TestUpdateWebhookOpts_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&UpdateWebhookOpts{}).Validate())
+	assert.NoError(t, (&UpdateWebhookOpts{
+		URL:     optional.Some("http://example.com/hooks"),
+		Secret:  optional.Some("0123456789abcdef"),
+		Events:  optional.Some([]string{EventTypeDocumentUpdated}),
+		Enabled: optional.Some(false),
+	}).Validate())
+
+	invalid := []UpdateWebhookOpts{
+		{URL: optional.Some("not a url")},
+		{URL: optional.Null[string]()},
+		{Secret: optional.Some("short")},
+		{Events: optional.Some([]string{})},
+		{Events: optional.Some([]string{"issue.deleted"})},
+		{Enabled: optional.Null[bool]()},
+	}
+	for _, opts := range invalid {
+		assert.ErrorIs(t, opts.Validate(), model.ErrInvalidWebhookDetails)
+	}
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	t.Parallel()
+
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoWebhook := newTestRepositoryWebhook(orgID)
+	opts := CreateWebhookOpts{
+		URL:     repoWebhook.URL,
+		Secret:  repoWebhook.Secret,
+		Events:  repoWebhook.Events,
+		Enabled: true,
+	}
+
+	tests := []struct {
+		name        string
+		baseService func(ctrl *gomock.Controller, ctx context.Context) *baseService
+		scope       model.ID
+		opts        CreateWebhookOpts
+		want        *Webhook
+		wantErr     error
+	}{
+		{
+			name: "create webhook in organization",
+			baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+				permSvc := NewMockPermissionService(ctrl)
+				permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(true)
+
+				webhookRepo := repository.NewMockWebhookRepository(ctrl)
+				webhookRepo.EXPECT().Create(ctx, repository.CreateWebhookOpts{
+					Scope:   orgID,
+					URL:     opts.URL,
+					Secret:  opts.Secret,
+					Events:  opts.Events,
+					Enabled: true,
+				}).Return(repoWebhook, nil)
+
+				return &baseService{
+					tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/Create"),
+					webhookRepo:       webhookRepo,
+					permissionService: permSvc,
+					licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+				}
+			},
+			scope: orgID,
+			opts:  opts,
+			want:  webhookFromRepository(repoWebhook),
+		},
+		{
+			name: "create webhook with expired license",
+			baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+				return &baseService{
+					tracer:         newCommentTestTracer(ctrl, ctx, "service.webhookService/Create"),
+					licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+				}
+			},
+			scope:   orgID,
+			opts:    opts,
+			wantErr: license.ErrLicenseExpired,
+		},
+		{
+			name: "create webhook with invalid details",
+			baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+				return &baseService{
+					tracer:         newCommentTestTracer(ctrl, ctx, "service.webhookService/Create"),
+					licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+				}
+			},
+			scope:   orgID,
+			opts:    CreateWebhookOpts{URL: opts.URL, Secret: opts.Secret},
+			wantErr: model.ErrInvalidWebhookDetails,
+		},
+		{
+			name: "create webhook in unsupported scope",
+			baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+				return &baseService{
+					tracer:         newCommentTestTracer(ctrl, ctx, "service.webhookService/Create"),
+					licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+				}
+			},
+			scope:   model.MustNewID(model.ResourceTypeNamespace),
+			opts:    opts,
+			wantErr: model.ErrInvalidID,
+		},
+		{
+			name: "create webhook with no permission",
+			baseService: func(ctrl *gomock.Controller, ctx context.Context) *baseService {
+				permSvc := NewMockPermissionService(ctrl)
+				permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(false)
+
+				return &baseService{
+					tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/Create"),
+					permissionService: permSvc,
+					licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+				}
+			},
+			scope:   orgID,
+			opts:    opts,
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			s := &webhookService{baseService: tt.baseService(ctrl, ctx)}
+
+			got, err := s.Create(ctx, tt.scope, tt.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, ErrWebhookCreate)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWebhookService_Get(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	repoWebhook := newTestRepositoryWebhook(projectID)
+
+	t.Run("get webhook", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionWebhookManage).Return(true)
+
+		s := &webhookService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/Get"),
+			webhookRepo:       webhookRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.Get(ctx, repoWebhook.ID)
+		require.NoError(t, err)
+		assert.Equal(t, webhookFromRepository(repoWebhook), got)
+	})
+
+	t.Run("get webhook with no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionWebhookManage).Return(false)
+
+		s := &webhookService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/Get"),
+			webhookRepo:       webhookRepo,
+			permissionService: permSvc,
+		}}
+
+		_, err := s.Get(ctx, repoWebhook.ID)
+		assert.ErrorIs(t, err, ErrWebhookGet)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("get missing webhook", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(nil, repository.ErrNotFound)
+
+		s := &webhookService{baseService: &baseService{
+			tracer:      newCommentTestTracer(ctrl, ctx, "service.webhookService/Get"),
+			webhookRepo: webhookRepo,
+		}}
+
+		_, err := s.Get(ctx, repoWebhook.ID)
+		assert.ErrorIs(t, err, ErrWebhookGet)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestWebhookService_ListForScope(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoWebhook := newTestRepositoryWebhook(orgID)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(true)
+
+	webhookRepo := repository.NewMockWebhookRepository(ctrl)
+	webhookRepo.EXPECT().ListByScope(ctx, orgID, repository.CursorPage{Size: 10}).Return(repository.Page[*repository.Webhook]{
+		Items: []*repository.Webhook{repoWebhook},
+	}, nil)
+
+	s := &webhookService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/ListForScope"),
+		webhookRepo:       webhookRepo,
+		permissionService: permSvc,
+	}}
+
+	got, err := s.ListForScope(ctx, orgID, CursorPage{Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []*Webhook{webhookFromRepository(repoWebhook)}, got.Items)
+}
+
+func TestWebhookService_Update(t *testing.T) {
+	t.Parallel()
+
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoWebhook := newTestRepositoryWebhook(orgID)
+
+	t.Run("update webhook", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		opts := UpdateWebhookOpts{Enabled: optional.Some(false)}
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+		webhookRepo.EXPECT().Update(ctx, repoWebhook.ID, repository.UpdateWebhookOpts{Enabled: opts.Enabled}).Return(repoWebhook, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(true)
+
+		s := &webhookService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/Update"),
+			webhookRepo:       webhookRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.Update(ctx, repoWebhook.ID, opts)
+		require.NoError(t, err)
+		assert.Equal(t, webhookFromRepository(repoWebhook), got)
+	})
+
+	t.Run("update webhook with invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &webhookService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.webhookService/Update"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Update(ctx, repoWebhook.ID, UpdateWebhookOpts{URL: optional.Some("invalid")})
+		assert.ErrorIs(t, err, ErrWebhookUpdate)
+		assert.ErrorIs(t, err, model.ErrInvalidWebhookDetails)
+	})
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoWebhook := newTestRepositoryWebhook(orgID)
+
+	webhookRepo := repository.NewMockWebhookRepository(ctrl)
+	webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+	webhookRepo.EXPECT().Delete(ctx, repoWebhook.ID).Return(nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(true)
+
+	s := &webhookService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/Delete"),
+		webhookRepo:       webhookRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	require.NoError(t, s.Delete(ctx, repoWebhook.ID))
+}
+
+func TestWebhookService_ListDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoWebhook := newTestRepositoryWebhook(orgID)
+	repoDelivery := newTestRepositoryWebhookDelivery(repoWebhook.ID, WebhookDeliveryStatusFailed)
+
+	webhookRepo := repository.NewMockWebhookRepository(ctrl)
+	webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+	webhookRepo.EXPECT().ListDeliveries(ctx, repoWebhook.ID, repository.CursorPage{Size: 5}).Return(repository.Page[*repository.WebhookDelivery]{
+		Items: []*repository.WebhookDelivery{repoDelivery},
+	}, nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(true)
+
+	s := &webhookService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.webhookService/ListDeliveries"),
+		webhookRepo:       webhookRepo,
+		permissionService: permSvc,
+	}}
+
+	got, err := s.ListDeliveries(ctx, repoWebhook.ID, CursorPage{Size: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []*WebhookDelivery{webhookDeliveryFromRepository(repoDelivery)}, got.Items)
+}
+
+func TestWebhookService_Redeliver(t *testing.T) {
+	t.Parallel()
+
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	repoWebhook := newTestRepositoryWebhook(orgID)
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, webhookRepo repository.WebhookRepository, enqueuer WebhookTaskEnqueuer) *webhookService {
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, orgID, model.ActionWebhookManage).Return(true)
+
+		return &webhookService{baseService: &baseService{
+			tracer:              newCommentTestTracer(ctrl, ctx, "service.webhookService/Redeliver"),
+			webhookRepo:         webhookRepo,
+			webhookTaskEnqueuer: enqueuer,
+			permissionService:   permSvc,
+			licenseService:      newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+	}
+
+	t.Run("redeliver failed delivery", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		original := newTestRepositoryWebhookDelivery(repoWebhook.ID, WebhookDeliveryStatusFailed)
+		redelivery := newTestRepositoryWebhookDelivery(repoWebhook.ID, WebhookDeliveryStatusPending)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+		webhookRepo.EXPECT().GetDelivery(ctx, original.ID).Return(original, nil)
+		webhookRepo.EXPECT().CreateDelivery(ctx, repository.CreateWebhookDeliveryOpts{
+			Webhook: repoWebhook.ID,
+			Event:   original.Event,
+			Payload: original.Payload,
+		}).Return(redelivery, nil)
+
+		enqueuer := &stubSearchEnqueuer{}
+
+		got, err := newService(ctrl, ctx, webhookRepo, enqueuer).Redeliver(ctx, repoWebhook.ID, original.ID)
+		require.NoError(t, err)
+		assert.Equal(t, webhookDeliveryFromRepository(redelivery), got)
+		require.NotNil(t, enqueuer.task)
+		assert.Equal(t, queue.TaskTypeWebhookDelivery.String(), enqueuer.task.Type())
+		assert.Contains(t, string(enqueuer.task.Payload()), redelivery.ID.Composite())
+	})
+
+	t.Run("redeliver pending delivery", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		original := newTestRepositoryWebhookDelivery(repoWebhook.ID, WebhookDeliveryStatusPending)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+		webhookRepo.EXPECT().GetDelivery(ctx, original.ID).Return(original, nil)
+
+		_, err := newService(ctrl, ctx, webhookRepo, &stubSearchEnqueuer{}).Redeliver(ctx, repoWebhook.ID, original.ID)
+		assert.ErrorIs(t, err, ErrWebhookRedeliver)
+		assert.ErrorIs(t, err, ErrWebhookDeliveryPending)
+		assert.ErrorIs(t, err, model.ErrInvalidWebhookDetails)
+	})
+
+	t.Run("redeliver delivery of other webhook", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		original := newTestRepositoryWebhookDelivery(model.MustNewID(model.ResourceTypeWebhook), WebhookDeliveryStatusFailed)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().Get(ctx, repoWebhook.ID).Return(repoWebhook, nil)
+		webhookRepo.EXPECT().GetDelivery(ctx, original.ID).Return(original, nil)
+
+		_, err := newService(ctrl, ctx, webhookRepo, &stubSearchEnqueuer{}).Redeliver(ctx, repoWebhook.ID, original.ID)
+		assert.ErrorIs(t, err, ErrWebhookRedeliver)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestBaseService_enqueueWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	actorID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	scopes := []model.ID{issueID, projectID, orgID}
+	ctx := context.Background()
+
+	t.Run("skips without webhook repository", func(t *testing.T) {
+		t.Parallel()
+		s := &baseService{logger: mock.NewMockLogger(gomock.NewController(t))}
+		s.enqueueWebhookDeliveries(ctx, &repository.Event{Type: EventTypeIssueUpdated, Resource: issueID})
+	})
+
+	t.Run("skips events with recipient", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			webhookRepo:         repository.NewMockWebhookRepository(ctrl),
+			webhookTaskEnqueuer: &stubSearchEnqueuer{},
+			permissionService:   NewMockPermissionService(ctrl),
+		}
+		s.enqueueWebhookDeliveries(ctx, &repository.Event{
+			Type:      EventTypeNotificationCreated,
+			Resource:  model.MustNewID(model.ResourceTypeNotification),
+			Recipient: &actorID,
+		})
+	})
+
+	t.Run("creates and enqueues deliveries", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		webhook := newTestRepositoryWebhook(orgID)
+		delivery := newTestRepositoryWebhookDelivery(webhook.ID, WebhookDeliveryStatusPending)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().ListScopeAncestry(ctx, issueID).Return(scopes, nil)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().ListSubscribed(ctx, scopes, EventTypeIssueUpdated).Return([]*repository.Webhook{webhook}, nil)
+		webhookRepo.EXPECT().CreateDelivery(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, opts repository.CreateWebhookDeliveryOpts) (*repository.WebhookDelivery, error) {
+				assert.Equal(t, webhook.ID, opts.Webhook)
+				assert.Equal(t, EventTypeIssueUpdated, opts.Event)
+
+				var payload WebhookPayload
+				require.NoError(t, json.Unmarshal([]byte(opts.Payload), &payload))
+				assert.Equal(t, EventTypeIssueUpdated, payload.Event)
+				assert.Equal(t, model.ResourceTypeIssue.String(), payload.ResourceType)
+				assert.Equal(t, issueID.String(), payload.ResourceID)
+				assert.Equal(t, convert.ToPointer(actorID.String()), payload.Actor)
+				assert.Equal(t, []string{"title"}, payload.ChangedFields)
+
+				return delivery, nil
+			},
+		)
+
+		enqueuer := &stubSearchEnqueuer{}
+
+		s := &baseService{
+			logger:              mock.NewMockLogger(ctrl),
+			webhookRepo:         webhookRepo,
+			webhookTaskEnqueuer: enqueuer,
+			permissionService:   permSvc,
+		}
+		s.enqueueWebhookDeliveries(ctx, &repository.Event{
+			Type:          EventTypeIssueUpdated,
+			Resource:      issueID,
+			Actor:         &actorID,
+			ChangedFields: []string{"title"},
+		})
+
+		require.NotNil(t, enqueuer.task)
+		assert.Equal(t, queue.TaskTypeWebhookDelivery.String(), enqueuer.task.Type())
+		assert.Contains(t, string(enqueuer.task.Payload()), delivery.ID.Composite())
+	})
+
+	t.Run("logs delivery errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().ListScopeAncestry(ctx, issueID).Return(scopes, nil)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().ListSubscribed(ctx, scopes, EventTypeIssueUpdated).Return([]*repository.Webhook{
+			newTestRepositoryWebhook(orgID),
+		}, nil)
+		webhookRepo.EXPECT().CreateDelivery(ctx, gomock.Any()).Return(nil, repository.ErrWebhookDeliveryCreate)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to create webhook delivery", gomock.Any(), gomock.Any())
+
+		s := &baseService{
+			logger:              logger,
+			webhookRepo:         webhookRepo,
+			webhookTaskEnqueuer: &stubSearchEnqueuer{},
+			permissionService:   permSvc,
+		}
+		s.enqueueWebhookDeliveries(ctx, &repository.Event{Type: EventTypeIssueUpdated, Resource: issueID})
+	})
+
+	t.Run("logs scope errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().ListScopeAncestry(ctx, issueID).Return(nil, ErrPermissionListScopeAncestry)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to resolve webhook scopes", gomock.Any(), gomock.Any())
+
+		s := &baseService{
+			logger:              logger,
+			webhookRepo:         repository.NewMockWebhookRepository(ctrl),
+			webhookTaskEnqueuer: &stubSearchEnqueuer{},
+			permissionService:   permSvc,
+		}
+		s.enqueueWebhookDeliveries(ctx, &repository.Event{Type: EventTypeIssueUpdated, Resource: issueID})
+	})
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/pkg/validate"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// maxTimesheetDays is the longest date range a timesheet can cover.
+const maxTimesheetDays = 366
+
+// WorkLog represents the time a user spent on an issue, returned by the
+// service. The duration is in minutes.
+type WorkLog struct {
+	ID        model.ID
+	Issue     model.ID
+	User      model.ID
+	Duration  uint
+	Date      time.Time
+	Note      string
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+// TimeTracking holds the estimates of issues and the time logged on them.
+// The estimates and the time spent are in minutes.
+type TimeTracking struct {
+	StoryPoints       float64
+	OriginalEstimate  uint
+	RemainingEstimate uint
+	TimeSpent         uint
+}
+
+// IssueTimeTracking holds the time tracking of an issue on its own and rolled
+// up with all of its subtasks, at any depth.
+type IssueTimeTracking struct {
+	Issue    model.ID
+	Subtasks int
+	Own      TimeTracking
+	Total    TimeTracking
+}
+
+// TimesheetIssue is the total time a user logged on an issue in a timesheet.
+type TimesheetIssue struct {
+	Issue    model.ID
+	Duration uint
+}
+
+// Timesheet holds the work logs of a user over a date range, oldest first,
+// with the total time logged per issue in the order the issues were first
+// worked on.
+type Timesheet struct {
+	User     model.ID
+	From     time.Time
+	To       time.Time
+	Duration uint
+	Issues   []TimesheetIssue
+	Entries  []*WorkLog
+}
+
+// CreateWorkLogOpts holds the data required to log work on an issue.
+type CreateWorkLogOpts struct {
+	Duration uint      `json:"duration" validate:"required,min=1,max=1440"`
+	Date     time.Time `json:"date" validate:"required"`
+	Note     string    `json:"note" validate:"omitempty,max=2000"`
+}
+
+// Validate validates the create options.
+func (o *CreateWorkLogOpts) Validate() error {
+	if err := validate.Struct(o); err != nil {
+		return errors.Join(model.ErrInvalidWorkLogDetails, err)
+	}
+	return nil
+}
+
+// UpdateWorkLogOpts holds the fields that can be updated on a work log.
+// Undefined fields (Defined == false) are left unchanged.
+type UpdateWorkLogOpts struct {
+	Duration optional.Optional[uint]
+	Date     optional.Optional[time.Time]
+	Note     optional.Optional[string]
+}
+
+// Validate validates the defined fields of the update options.
+func (o *UpdateWorkLogOpts) Validate() error {
+	if o.Duration.Defined {
+		if o.Duration.Value == nil {
+			return model.ErrInvalidWorkLogDetails
+		}
+		if err := validate.Var(*o.Duration.Value, "required,min=1,max=1440"); err != nil {
+			return errors.Join(model.ErrInvalidWorkLogDetails, err)
+		}
+	}
+
+	if o.Date.Defined && (o.Date.Value == nil || o.Date.Value.IsZero()) {
+		return model.ErrInvalidWorkLogDetails
+	}
+
+	if o.Note.Defined && o.Note.Value != nil {
+		if err := validate.Var(*o.Note.Value, "omitempty,max=2000"); err != nil {
+			return errors.Join(model.ErrInvalidWorkLogDetails, err)
+		}
+	}
+
+	return nil
+}
+
+// WorkLogService serves the business logic of logging the time spent on
+// issues and reporting on it.
+//
+//go:generate go tool mockgen -destination=work_log_mock_gen.go -package=service -mock_names WorkLogService=MockWorkLogService . WorkLogService
+type WorkLogService interface {
+	// Create logs work of the current user on an issue.
+	Create(ctx context.Context, issueID model.ID, opts CreateWorkLogOpts) (*WorkLog, error)
+	// Get returns a work log of an issue.
+	Get(ctx context.Context, issueID, id model.ID) (*WorkLog, error)
+	// List returns a cursor-paginated page of the work logs of an issue,
+	// newest first.
+	List(ctx context.Context, issueID model.ID, page CursorPage) (Page[*WorkLog], error)
+	// Update updates a work log of an issue. Only the author of the work log
+	// or a user who can delete the issue can update it.
+	Update(ctx context.Context, issueID, id model.ID, opts UpdateWorkLogOpts) (*WorkLog, error)
+	// Delete deletes a work log of an issue. Only the author of the work log
+	// or a user who can delete the issue can delete it.
+	Delete(ctx context.Context, issueID, id model.ID) error
+	// TimeTracking returns the estimates of the issue and the time logged on
+	// it, rolled up with its subtasks at any depth.
+	TimeTracking(ctx context.Context, issueID model.ID) (*IssueTimeTracking, error)
+	// Timesheet returns the work logs of a user between two days, both
+	// inclusive. Users other than the current user only see the work logged
+	// on issues they can read.
+	Timesheet(ctx context.Context, userID model.ID, from, to time.Time) (*Timesheet, error)
+}
+
+// workLogService is the concrete implementation of WorkLogService.
+type workLogService struct {
+	*baseService
+}
+
+func workLogFromRepository(w *repository.WorkLog) *WorkLog {
+	if w == nil {
+		return nil
+	}
+	return &WorkLog{
+		ID:        w.ID,
+		Issue:     w.Issue,
+		User:      w.User,
+		Duration:  w.Duration,
+		Date:      w.Date,
+		Note:      w.Note,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// workLogDay returns the UTC day the time falls on.
+func workLogDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// issueTimeTracking sums the estimates and the time spent of the issue and
+// its subtasks. The first estimate belongs to the issue itself.
+func issueTimeTracking(estimates []*repository.IssueEstimate, spent map[model.ID]uint) *IssueTimeTracking {
+	tracking := &IssueTimeTracking{Subtasks: len(estimates) - 1}
+	for i, estimate := range estimates {
+		own := TimeTracking{TimeSpent: spent[estimate.ID]}
+		if estimate.StoryPoints != nil {
+			own.StoryPoints = *estimate.StoryPoints
+		}
+		if estimate.OriginalEstimate != nil {
+			own.OriginalEstimate = *estimate.OriginalEstimate
+		}
+		if estimate.RemainingEstimate != nil {
+			own.RemainingEstimate = *estimate.RemainingEstimate
+		}
+
+		if i == 0 {
+			tracking.Issue = estimate.ID
+			tracking.Own = own
+		}
+		tracking.Total.StoryPoints += own.StoryPoints
+		tracking.Total.OriginalEstimate += own.OriginalEstimate
+		tracking.Total.RemainingEstimate += own.RemainingEstimate
+		tracking.Total.TimeSpent += own.TimeSpent
+	}
+	return tracking
+}
+
+// getOwned returns the work log if it belongs to the issue and the context
+// user is either its author or can delete the issue.
+func (s *workLogService) getOwned(ctx context.Context, issueID, id model.ID) (*repository.WorkLog, error) {
+	if err := issueID.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return nil, ErrNoPermission
+	}
+
+	workLog, err := s.workLogRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if workLog.Issue != issueID {
+		return nil, repository.ErrNotFound
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, ErrNoUser
+	}
+
+	if workLog.User != userID && !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueDelete) {
+		return nil, ErrNoPermission
+	}
+
+	return workLog, nil
+}
+
+func (s *workLogService) Create(ctx context.Context, issueID model.ID, opts CreateWorkLogOpts) (*WorkLog, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/Create")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrWorkLogCreate, license.ErrLicenseExpired)
+	}
+
+	if err := issueID.Validate(); err != nil {
+		return nil, errors.Join(ErrWorkLogCreate, err)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrWorkLogCreate, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueUpdate) {
+		return nil, errors.Join(ErrWorkLogCreate, ErrNoPermission)
+	}
+
+	userID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrWorkLogCreate, ErrNoUser)
+	}
+
+	workLog, err := s.workLogRepo.Create(ctx, repository.CreateWorkLogOpts{
+		Issue:    issueID,
+		User:     userID,
+		Duration: opts.Duration,
+		Date:     workLogDay(opts.Date),
+		Note:     opts.Note,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWorkLogCreate, err)
+	}
+
+	return workLogFromRepository(workLog), nil
+}
+
+func (s *workLogService) Get(ctx context.Context, issueID, id model.ID) (*WorkLog, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/Get")
+	defer span.End()
+
+	if err := issueID.Validate(); err != nil {
+		return nil, errors.Join(ErrWorkLogGet, err)
+	}
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Join(ErrWorkLogGet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return nil, errors.Join(ErrWorkLogGet, ErrNoPermission)
+	}
+
+	workLog, err := s.workLogRepo.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Join(ErrWorkLogGet, err)
+	}
+
+	if workLog.Issue != issueID {
+		return nil, errors.Join(ErrWorkLogGet, repository.ErrNotFound)
+	}
+
+	return workLogFromRepository(workLog), nil
+}
+
+func (s *workLogService) List(ctx context.Context, issueID model.ID, page CursorPage) (Page[*WorkLog], error) {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/List")
+	defer span.End()
+
+	if err := issueID.Validate(); err != nil {
+		return Page[*WorkLog]{}, errors.Join(ErrWorkLogGetAll, err)
+	}
+
+	normalized, err := page.Normalize()
+	if err != nil {
+		return Page[*WorkLog]{}, errors.Join(ErrWorkLogGetAll, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return Page[*WorkLog]{}, errors.Join(ErrWorkLogGetAll, ErrNoPermission)
+	}
+
+	workLogs, err := s.workLogRepo.ListByIssue(ctx, issueID, normalized)
+	if err != nil {
+		return Page[*WorkLog]{}, errors.Join(ErrWorkLogGetAll, err)
+	}
+
+	return mapPage(workLogs, workLogFromRepository), nil
+}
+
+func (s *workLogService) Update(ctx context.Context, issueID, id model.ID, opts UpdateWorkLogOpts) (*WorkLog, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/Update")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return nil, errors.Join(ErrWorkLogUpdate, license.ErrLicenseExpired)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrWorkLogUpdate, err)
+	}
+
+	if _, err := s.getOwned(ctx, issueID, id); err != nil {
+		return nil, errors.Join(ErrWorkLogUpdate, err)
+	}
+
+	date := opts.Date
+	if date.Defined {
+		date = optional.Some(workLogDay(*date.Value))
+	}
+
+	workLog, err := s.workLogRepo.Update(ctx, id, repository.UpdateWorkLogOpts{
+		Duration: opts.Duration,
+		Date:     date,
+		Note:     opts.Note,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWorkLogUpdate, err)
+	}
+
+	return workLogFromRepository(workLog), nil
+}
+
+func (s *workLogService) Delete(ctx context.Context, issueID, id model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/Delete")
+	defer span.End()
+
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return errors.Join(ErrWorkLogDelete, license.ErrLicenseExpired)
+	}
+
+	if _, err := s.getOwned(ctx, issueID, id); err != nil {
+		return errors.Join(ErrWorkLogDelete, err)
+	}
+
+	if err := s.workLogRepo.Delete(ctx, id); err != nil {
+		return errors.Join(ErrWorkLogDelete, err)
+	}
+
+	return nil
+}
+
+func (s *workLogService) TimeTracking(ctx context.Context, issueID model.ID) (*IssueTimeTracking, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/TimeTracking")
+	defer span.End()
+
+	if err := issueID.Validate(); err != nil {
+		return nil, errors.Join(ErrTimeTrackingGet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, issueID, model.ActionIssueRead) {
+		return nil, errors.Join(ErrTimeTrackingGet, ErrNoPermission)
+	}
+
+	estimates, err := s.issueRepo.GetEstimates(ctx, issueID)
+	if err != nil {
+		return nil, errors.Join(ErrTimeTrackingGet, err)
+	}
+
+	issues := make([]model.ID, len(estimates))
+	for i, estimate := range estimates {
+		issues[i] = estimate.ID
+	}
+
+	spent, err := s.workLogRepo.SumByIssues(ctx, issues)
+	if err != nil {
+		return nil, errors.Join(ErrTimeTrackingGet, err)
+	}
+
+	return issueTimeTracking(estimates, spent), nil
+}
+
+func (s *workLogService) Timesheet(ctx context.Context, userID model.ID, from, to time.Time) (*Timesheet, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workLogService/Timesheet")
+	defer span.End()
+
+	if err := userID.Validate(); err != nil {
+		return nil, errors.Join(ErrTimesheetGet, err)
+	}
+
+	from, to = workLogDay(from), workLogDay(to)
+	if from.IsZero() || to.Before(from) || to.Sub(from) >= maxTimesheetDays*24*time.Hour {
+		return nil, errors.Join(ErrTimesheetGet, ErrTimesheetRange)
+	}
+
+	ctxUserID, ok := ctx.Value(pkg.CtxKeyUserID).(model.ID)
+	if !ok {
+		return nil, errors.Join(ErrTimesheetGet, ErrNoUser)
+	}
+
+	workLogs, err := s.workLogRepo.ListByUser(ctx, userID, from, to)
+	if err != nil {
+		return nil, errors.Join(ErrTimesheetGet, err)
+	}
+
+	timesheet := &Timesheet{
+		User:    userID,
+		From:    from,
+		To:      to,
+		Issues:  make([]TimesheetIssue, 0),
+		Entries: make([]*WorkLog, 0, len(workLogs)),
+	}
+
+	readable := make(map[model.ID]bool)
+	totals := make(map[model.ID]int)
+	for _, workLog := range workLogs {
+		if ctxUserID != userID {
+			canRead, checked := readable[workLog.Issue]
+			if !checked {
+				canRead = s.permissionService.CtxUserHas(ctx, workLog.Issue, model.ActionIssueRead)
+				readable[workLog.Issue] = canRead
+			}
+			if !canRead {
+				continue
+			}
+		}
+
+		i, ok := totals[workLog.Issue]
+		if !ok {
+			i = len(timesheet.Issues)
+			totals[workLog.Issue] = i
+			timesheet.Issues = append(timesheet.Issues, TimesheetIssue{Issue: workLog.Issue})
+		}
+		timesheet.Issues[i].Duration += workLog.Duration
+		timesheet.Duration += workLog.Duration
+		timesheet.Entries = append(timesheet.Entries, workLogFromRepository(workLog))
+	}
+
+	return timesheet, nil
+}
+
+// NewWorkLogService returns a new instance of the WorkLogService interface.
+func NewWorkLogService(opts ...Option) (WorkLogService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &workLogService{
+		baseService: s,
+	}
+
+	if svc.workLogRepo == nil {
+		return nil, ErrNoWorkLogRepository
+	}
+
+	if svc.issueRepo == nil {
+		return nil, ErrNoIssueRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/opcotech/elemo/internal/service (interfaces: WorkLogService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=work_log_mock_gen.go -package=service -mock_names WorkLogService=MockWorkLogService . WorkLogService
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkLogService is a mock of WorkLogService interface.
+type MockWorkLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkLogServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkLogServiceMockRecorder is the mock recorder for MockWorkLogService.
+type MockWorkLogServiceMockRecorder struct {
+	mock *MockWorkLogService
+}
+
+// NewMockWorkLogService creates a new mock instance.
+func NewMockWorkLogService(ctrl *gomock.Controller) *MockWorkLogService {
+	mock := &MockWorkLogService{ctrl: ctrl}
+	mock.recorder = &MockWorkLogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkLogService) EXPECT() *MockWorkLogServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWorkLogService) Create(ctx context.Context, issueID model.ID, opts CreateWorkLogOpts) (*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, issueID, opts)
+	ret0, _ := ret[0].(*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkLogServiceMockRecorder) Create(ctx, issueID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkLogService)(nil).Create), ctx, issueID, opts)
+}
+
+// Delete mocks base method.
+func (m *MockWorkLogService) Delete(ctx context.Context, issueID, id model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, issueID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorkLogServiceMockRecorder) Delete(ctx, issueID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkLogService)(nil).Delete), ctx, issueID, id)
+}
+
+// Get mocks base method.
+func (m *MockWorkLogService) Get(ctx context.Context, issueID, id model.ID) (*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, issueID, id)
+	ret0, _ := ret[0].(*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWorkLogServiceMockRecorder) Get(ctx, issueID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkLogService)(nil).Get), ctx, issueID, id)
+}
+
+// List mocks base method.
+func (m *MockWorkLogService) List(ctx context.Context, issueID model.ID, page CursorPage) (Page[*WorkLog], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, issueID, page)
+	ret0, _ := ret[0].(Page[*WorkLog])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWorkLogServiceMockRecorder) List(ctx, issueID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWorkLogService)(nil).List), ctx, issueID, page)
+}
+
+// TimeTracking mocks base method.
+func (m *MockWorkLogService) TimeTracking(ctx context.Context, issueID model.ID) (*IssueTimeTracking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeTracking", ctx, issueID)
+	ret0, _ := ret[0].(*IssueTimeTracking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TimeTracking indicates an expected call of TimeTracking.
+func (mr *MockWorkLogServiceMockRecorder) TimeTracking(ctx, issueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeTracking", reflect.TypeOf((*MockWorkLogService)(nil).TimeTracking), ctx, issueID)
+}
+
+// Timesheet mocks base method.
+func (m *MockWorkLogService) Timesheet(ctx context.Context, userID model.ID, from, to time.Time) (*Timesheet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timesheet", ctx, userID, from, to)
+	ret0, _ := ret[0].(*Timesheet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timesheet indicates an expected call of Timesheet.
+func (mr *MockWorkLogServiceMockRecorder) Timesheet(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timesheet", reflect.TypeOf((*MockWorkLogService)(nil).Timesheet), ctx, userID, from, to)
+}
+
+// Update mocks base method.
+func (m *MockWorkLogService) Update(ctx context.Context, issueID, id model.ID, opts UpdateWorkLogOpts) (*WorkLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, issueID, id, opts)
+	ret0, _ := ret[0].(*WorkLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkLogServiceMockRecorder) Update(ctx, issueID, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkLogService)(nil).Update), ctx, issueID, id, opts)
+}
@@ -0,0 +1,484 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func newTestWorkLog(issueID, userID model.ID, duration uint, date time.Time) *repository.WorkLog {
+	return &repository.WorkLog{
+		ID:        model.MustNewID(model.ResourceTypeWorkLog),
+		Issue:     issueID,
+		User:      userID,
+		Duration:  duration,
+		Date:      date,
+		Note:      "Implementation",
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewWorkLogService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new work log service",
+			opts: []Option{
+				WithWorkLogRepository(repository.NewMockWorkLogRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new work log service with invalid options",
+			opts:    []Option{WithWorkLogRepository(nil)},
+			wantErr: ErrNoWorkLogRepository,
+		},
+		{
+			name: "new work log service with no issue repository",
+			opts: []Option{
+				WithWorkLogRepository(repository.NewMockWorkLogRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoIssueRepository,
+		},
+		{
+			name: "new work log service with no license service",
+			opts: []Option{
+				WithWorkLogRepository(repository.NewMockWorkLogRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new work log service with no permission service",
+			opts: []Option{
+				WithWorkLogRepository(repository.NewMockWorkLogRepository(nil)),
+				WithIssueRepository(repository.NewMockIssueRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewWorkLogService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestWorkLogService_Create(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	userID := model.MustNewID(model.ResourceTypeUser)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("create work log on the day of the given time", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		workLog := newTestWorkLog(issueID, userID, 90, day)
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().Create(ctx, repository.CreateWorkLogOpts{
+			Issue:    issueID,
+			User:     userID,
+			Duration: 90,
+			Date:     day,
+			Note:     "Implementation",
+		}).Return(workLog, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(true)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Create"),
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.Create(ctx, issueID, CreateWorkLogOpts{
+			Duration: 90,
+			Date:     day.Add(15 * time.Hour),
+			Note:     "Implementation",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, workLogFromRepository(workLog), got)
+	})
+
+	t.Run("create work log with invalid duration", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.workLogService/Create"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Create(ctx, issueID, CreateWorkLogOpts{Duration: 1441, Date: day})
+		assert.ErrorIs(t, err, ErrWorkLogCreate)
+		assert.ErrorIs(t, err, model.ErrInvalidWorkLogDetails)
+	})
+
+	t.Run("create work log without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueUpdate).Return(false)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Create"),
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Create(ctx, issueID, CreateWorkLogOpts{Duration: 90, Date: day})
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestWorkLogService_Get(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	userID := model.MustNewID(model.ResourceTypeUser)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("get work log of another issue", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		workLog := newTestWorkLog(model.MustNewID(model.ResourceTypeIssue), userID, 30, day)
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().Get(ctx, workLog.ID).Return(workLog, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Get"),
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+		}}
+
+		_, err := s.Get(ctx, issueID, workLog.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestWorkLogService_Update(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	authorID := model.MustNewID(model.ResourceTypeUser)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("update own work log", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, authorID)
+
+		workLog := newTestWorkLog(issueID, authorID, 30, day)
+		updated := newTestWorkLog(issueID, authorID, 60, day.AddDate(0, 0, 1))
+		updated.ID = workLog.ID
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().Get(ctx, workLog.ID).Return(workLog, nil)
+		workLogRepo.EXPECT().Update(ctx, workLog.ID, repository.UpdateWorkLogOpts{
+			Duration: optional.Some(uint(60)),
+			Date:     optional.Some(day.AddDate(0, 0, 1)),
+		}).Return(updated, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Update"),
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		got, err := s.Update(ctx, issueID, workLog.ID, UpdateWorkLogOpts{
+			Duration: optional.Some(uint(60)),
+			Date:     optional.Some(day.AddDate(0, 0, 1).Add(8 * time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, workLogFromRepository(updated), got)
+	})
+
+	t.Run("update work log of another user without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, model.MustNewID(model.ResourceTypeUser))
+
+		workLog := newTestWorkLog(issueID, authorID, 30, day)
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().Get(ctx, workLog.ID).Return(workLog, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueDelete).Return(false)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Update"),
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Update(ctx, issueID, workLog.ID, UpdateWorkLogOpts{Note: optional.Some("Review")})
+		assert.ErrorIs(t, err, ErrWorkLogUpdate)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+
+	t.Run("update work log with null duration", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, authorID)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.workLogService/Update"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		_, err := s.Update(ctx, issueID, model.MustNewID(model.ResourceTypeWorkLog), UpdateWorkLogOpts{
+			Duration: optional.Null[uint](),
+		})
+		assert.ErrorIs(t, err, model.ErrInvalidWorkLogDetails)
+	})
+}
+
+func TestWorkLogService_Delete(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	authorID := model.MustNewID(model.ResourceTypeUser)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("delete work log of another user as maintainer", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, model.MustNewID(model.ResourceTypeUser))
+
+		workLog := newTestWorkLog(issueID, authorID, 30, day)
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().Get(ctx, workLog.ID).Return(workLog, nil)
+		workLogRepo.EXPECT().Delete(ctx, workLog.ID).Return(nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(true)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueDelete).Return(true)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Delete"),
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+			licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+		}}
+
+		require.NoError(t, s.Delete(ctx, issueID, workLog.ID))
+	})
+}
+
+func TestWorkLogService_TimeTracking(t *testing.T) {
+	t.Parallel()
+
+	t.Run("roll up subtasks", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		parentID := model.MustNewID(model.ResourceTypeIssue)
+		childID := model.MustNewID(model.ResourceTypeIssue)
+		grandchildID := model.MustNewID(model.ResourceTypeIssue)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().GetEstimates(ctx, parentID).Return([]*repository.IssueEstimate{
+			{ID: parentID, StoryPoints: convert.ToPointer(5.0), OriginalEstimate: convert.ToPointer(uint(480)), RemainingEstimate: convert.ToPointer(uint(240))},
+			{ID: childID, StoryPoints: convert.ToPointer(2.5), OriginalEstimate: convert.ToPointer(uint(120))},
+			{ID: grandchildID, RemainingEstimate: convert.ToPointer(uint(30))},
+		}, nil)
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().SumByIssues(ctx, []model.ID{parentID, childID, grandchildID}).Return(map[model.ID]uint{
+			parentID:     200,
+			grandchildID: 45,
+		}, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, parentID, model.ActionIssueRead).Return(true)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/TimeTracking"),
+			issueRepo:         issueRepo,
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.TimeTracking(ctx, parentID)
+		require.NoError(t, err)
+		assert.Equal(t, &IssueTimeTracking{
+			Issue:    parentID,
+			Subtasks: 2,
+			Own: TimeTracking{
+				StoryPoints:       5,
+				OriginalEstimate:  480,
+				RemainingEstimate: 240,
+				TimeSpent:         200,
+			},
+			Total: TimeTracking{
+				StoryPoints:       7.5,
+				OriginalEstimate:  600,
+				RemainingEstimate: 270,
+				TimeSpent:         245,
+			},
+		}, got)
+	})
+
+	t.Run("time tracking without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		issueID := model.MustNewID(model.ResourceTypeIssue)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, issueID, model.ActionIssueRead).Return(false)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/TimeTracking"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.TimeTracking(ctx, issueID)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestWorkLogService_Timesheet(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 6)
+
+	t.Run("timesheet of another user hides unreadable issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, model.MustNewID(model.ResourceTypeUser))
+
+		readable := model.MustNewID(model.ResourceTypeIssue)
+		hidden := model.MustNewID(model.ResourceTypeIssue)
+		workLogs := []*repository.WorkLog{
+			newTestWorkLog(readable, userID, 30, from),
+			newTestWorkLog(hidden, userID, 60, from),
+			newTestWorkLog(readable, userID, 45, from.AddDate(0, 0, 1)),
+		}
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().ListByUser(ctx, userID, from, to).Return(workLogs, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, readable, model.ActionIssueRead).Return(true).Times(1)
+		permSvc.EXPECT().CtxUserHas(ctx, hidden, model.ActionIssueRead).Return(false).Times(1)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workLogService/Timesheet"),
+			workLogRepo:       workLogRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.Timesheet(ctx, userID, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, &Timesheet{
+			User:     userID,
+			From:     from,
+			To:       to,
+			Duration: 75,
+			Issues:   []TimesheetIssue{{Issue: readable, Duration: 75}},
+			Entries:  []*WorkLog{workLogFromRepository(workLogs[0]), workLogFromRepository(workLogs[2])},
+		}, got)
+	})
+
+	t.Run("own timesheet", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		first := model.MustNewID(model.ResourceTypeIssue)
+		second := model.MustNewID(model.ResourceTypeIssue)
+		workLogs := []*repository.WorkLog{
+			newTestWorkLog(first, userID, 30, from),
+			newTestWorkLog(second, userID, 60, from),
+		}
+
+		workLogRepo := repository.NewMockWorkLogRepository(ctrl)
+		workLogRepo.EXPECT().ListByUser(ctx, userID, from, to).Return(workLogs, nil)
+
+		s := &workLogService{baseService: &baseService{
+			tracer:      newCommentTestTracer(ctrl, ctx, "service.workLogService/Timesheet"),
+			workLogRepo: workLogRepo,
+		}}
+
+		got, err := s.Timesheet(ctx, userID, from.Add(9*time.Hour), to)
+		require.NoError(t, err)
+		assert.Equal(t, uint(90), got.Duration)
+		assert.Equal(t, []TimesheetIssue{{Issue: first, Duration: 30}, {Issue: second, Duration: 60}}, got.Issues)
+		assert.Len(t, got.Entries, 2)
+	})
+
+	t.Run("timesheet with invalid range", func(t *testing.T) {
+		t.Parallel()
+
+		for _, r := range [][2]time.Time{
+			{to, from},
+			{from, from.AddDate(1, 1, 0)},
+			{{}, to},
+		} {
+			ctrl := gomock.NewController(t)
+			ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+			s := &workLogService{baseService: &baseService{
+				tracer: newCommentTestTracer(ctrl, ctx, "service.workLogService/Timesheet"),
+			}}
+
+			_, err := s.Timesheet(ctx, userID, r[0], r[1])
+			assert.ErrorIs(t, err, ErrTimesheetRange)
+		}
+	})
+}
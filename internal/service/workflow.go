@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// Workflow represents the custom statuses of the issues in a project and the
+// allowed transitions between them.
+type Workflow struct {
+	Project     model.ID
+	Statuses    []model.WorkflowStatus
+	Transitions []model.WorkflowTransition
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// SetWorkflowOpts holds the data required to set the workflow of a project.
+// The first status is the initial status of new issues.
+type SetWorkflowOpts struct {
+	Statuses    []model.WorkflowStatus
+	Transitions []model.WorkflowTransition
+}
+
+// Validate validates the workflow options.
+func (o *SetWorkflowOpts) Validate() error {
+	_, err := model.NewWorkflow(o.Statuses, o.Transitions)
+	return err
+}
+
+// WorkflowService serves the business logic of interacting with project
+// workflows.
+//
+//go:generate go tool mockgen -destination=workflow_mock_gen.go -package=service -mock_names WorkflowService=MockWorkflowService . WorkflowService
+type WorkflowService interface {
+	// Get returns the workflow of a project. If the project has no workflow,
+	// an error is returned.
+	Get(ctx context.Context, projectID model.ID) (*Workflow, error)
+	// Set creates or replaces the workflow of a project. Issues in a status
+	// removed from the workflow keep it until they are moved to a new one.
+	Set(ctx context.Context, projectID model.ID, opts SetWorkflowOpts) (*Workflow, error)
+	// Delete removes the workflow of a project, reverting its issues to the
+	// built-in statuses.
+	Delete(ctx context.Context, projectID model.ID) error
+}
+
+// workflowService is the concrete implementation of WorkflowService.
+type workflowService struct {
+	*baseService
+}
+
+func workflowFromRepository(w *repository.Workflow) *Workflow {
+	if w == nil {
+		return nil
+	}
+	return &Workflow{
+		Project:     w.Project,
+		Statuses:    w.Statuses,
+		Transitions: w.Transitions,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+// validateProjectID validates the ID of a project owning issue settings.
+func validateProjectID(projectID model.ID) error {
+	if err := projectID.Validate(); err != nil {
+		return err
+	}
+	if projectID.Type != model.ResourceTypeProject {
+		return model.ErrInvalidID
+	}
+	return nil
+}
+
+// canManageWorkflow reports whether the workflow of the project can be
+// changed by the context user under the current license.
+func (s *workflowService) canManageWorkflow(ctx context.Context, projectID model.ID) error {
+	if expired, err := s.licenseService.Expired(ctx); expired || err != nil {
+		return license.ErrLicenseExpired
+	}
+
+	if err := validateProjectID(projectID); err != nil {
+		return err
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectUpdate) {
+		return ErrNoPermission
+	}
+
+	return nil
+}
+
+func (s *workflowService) Get(ctx context.Context, projectID model.ID) (*Workflow, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workflowService/Get")
+	defer span.End()
+
+	if err := validateProjectID(projectID); err != nil {
+		return nil, errors.Join(ErrWorkflowGet, err)
+	}
+
+	if !s.permissionService.CtxUserHas(ctx, projectID, model.ActionProjectRead) {
+		return nil, errors.Join(ErrWorkflowGet, ErrNoPermission)
+	}
+
+	workflow, err := s.workflowRepo.Get(ctx, projectID)
+	if err != nil {
+		return nil, errors.Join(ErrWorkflowGet, err)
+	}
+
+	return workflowFromRepository(workflow), nil
+}
+
+func (s *workflowService) Set(ctx context.Context, projectID model.ID, opts SetWorkflowOpts) (*Workflow, error) {
+	ctx, span := s.tracer.Start(ctx, "service.workflowService/Set")
+	defer span.End()
+
+	if err := s.canManageWorkflow(ctx, projectID); err != nil {
+		return nil, errors.Join(ErrWorkflowSet, err)
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureCustomStatuses); !ok || err != nil {
+		return nil, errors.Join(ErrWorkflowSet, ErrQuotaExceeded)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Join(ErrWorkflowSet, err)
+	}
+
+	workflow, err := s.workflowRepo.Save(ctx, repository.SaveWorkflowOpts{
+		Project:     projectID,
+		Statuses:    opts.Statuses,
+		Transitions: opts.Transitions,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrWorkflowSet, err)
+	}
+
+	return workflowFromRepository(workflow), nil
+}
+
+func (s *workflowService) Delete(ctx context.Context, projectID model.ID) error {
+	ctx, span := s.tracer.Start(ctx, "service.workflowService/Delete")
+	defer span.End()
+
+	if err := s.canManageWorkflow(ctx, projectID); err != nil {
+		return errors.Join(ErrWorkflowDelete, err)
+	}
+
+	if err := s.workflowRepo.Delete(ctx, projectID); err != nil {
+		return errors.Join(ErrWorkflowDelete, err)
+	}
+
+	return nil
+}
+
+// projectWorkflow returns the workflow the issues of the project follow. The
+// workflow is ignored without a workflow repository and when the license
+// does not include custom statuses, in which case nil is returned.
+func (s *baseService) projectWorkflow(ctx context.Context, projectID model.ID) (*model.Workflow, error) {
+	if s.workflowRepo == nil {
+		return nil, nil
+	}
+
+	if ok, err := s.licenseService.HasFeature(ctx, license.FeatureCustomStatuses); !ok || err != nil {
+		return nil, err
+	}
+
+	workflow, err := s.workflowRepo.Get(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &model.Workflow{
+		Statuses:    workflow.Statuses,
+		Transitions: workflow.Transitions,
+	}, nil
+}
+
+// NewWorkflowService returns a new instance of the WorkflowService interface.
+func NewWorkflowService(opts ...Option) (WorkflowService, error) {
+	s, err := newService(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &workflowService{
+		baseService: s,
+	}
+
+	if svc.workflowRepo == nil {
+		return nil, ErrNoWorkflowRepository
+	}
+
+	if svc.licenseService == nil {
+		return nil, ErrNoLicenseService
+	}
+
+	if svc.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+
+	return svc, nil
+}
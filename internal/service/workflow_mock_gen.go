@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/opcotech/elemo/internal/service (interfaces: WorkflowService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=workflow_mock_gen.go -package=service -mock_names WorkflowService=MockWorkflowService . WorkflowService
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/opcotech/elemo/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkflowService is a mock of WorkflowService interface.
+type MockWorkflowService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkflowServiceMockRecorder is the mock recorder for MockWorkflowService.
+type MockWorkflowServiceMockRecorder struct {
+	mock *MockWorkflowService
+}
+
+// NewMockWorkflowService creates a new mock instance.
+func NewMockWorkflowService(ctrl *gomock.Controller) *MockWorkflowService {
+	mock := &MockWorkflowService{ctrl: ctrl}
+	mock.recorder = &MockWorkflowServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowService) EXPECT() *MockWorkflowServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockWorkflowService) Delete(ctx context.Context, projectID model.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorkflowServiceMockRecorder) Delete(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkflowService)(nil).Delete), ctx, projectID)
+}
+
+// Get mocks base method.
+func (m *MockWorkflowService) Get(ctx context.Context, projectID model.ID) (*Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, projectID)
+	ret0, _ := ret[0].(*Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWorkflowServiceMockRecorder) Get(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkflowService)(nil).Get), ctx, projectID)
+}
+
+// Set mocks base method.
+func (m *MockWorkflowService) Set(ctx context.Context, projectID model.ID, opts SetWorkflowOpts) (*Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, projectID, opts)
+	ret0, _ := ret[0].(*Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockWorkflowServiceMockRecorder) Set(ctx, projectID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockWorkflowService)(nil).Set), ctx, projectID, opts)
+}
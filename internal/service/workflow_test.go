@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/license"
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func newTestRepositoryWorkflow(project model.ID) *repository.Workflow {
+	return &repository.Workflow{
+		Project: project,
+		Statuses: []model.WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: model.WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: model.WorkflowStatusCategoryInProgress},
+			{Key: "shipped", Name: "Shipped", Category: model.WorkflowStatusCategoryDone},
+		},
+		Transitions: []model.WorkflowTransition{
+			{From: "backlog", To: "in_review"},
+			{From: "in_review", To: "shipped"},
+		},
+		CreatedAt: convert.ToPointer(time.Now()),
+	}
+}
+
+func TestNewWorkflowService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}{
+		{
+			name: "new workflow service",
+			opts: []Option{
+				WithWorkflowRepository(repository.NewMockWorkflowRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+		},
+		{
+			name:    "new workflow service with invalid options",
+			opts:    []Option{WithWorkflowRepository(nil)},
+			wantErr: ErrNoWorkflowRepository,
+		},
+		{
+			name: "new workflow service with no workflow repository",
+			opts: []Option{
+				WithPermissionService(NewMockPermissionService(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoWorkflowRepository,
+		},
+		{
+			name: "new workflow service with no license service",
+			opts: []Option{
+				WithWorkflowRepository(repository.NewMockWorkflowRepository(nil)),
+				WithPermissionService(NewMockPermissionService(nil)),
+			},
+			wantErr: ErrNoLicenseService,
+		},
+		{
+			name: "new workflow service with no permission service",
+			opts: []Option{
+				WithWorkflowRepository(repository.NewMockWorkflowRepository(nil)),
+				WithLicenseService(mock.NewMockLicenseService(nil)),
+			},
+			wantErr: ErrNoPermissionService,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewWorkflowService(tt.opts...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Get(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	repoWorkflow := newTestRepositoryWorkflow(projectID)
+
+	t.Run("get workflow", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+		workflowRepo.EXPECT().Get(ctx, projectID).Return(repoWorkflow, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(true)
+
+		s := &workflowService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workflowService/Get"),
+			workflowRepo:      workflowRepo,
+			permissionService: permSvc,
+		}}
+
+		got, err := s.Get(ctx, projectID)
+		require.NoError(t, err)
+		assert.Equal(t, workflowFromRepository(repoWorkflow), got)
+	})
+
+	t.Run("get workflow of another resource", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &workflowService{baseService: &baseService{
+			tracer: newCommentTestTracer(ctrl, ctx, "service.workflowService/Get"),
+		}}
+
+		_, err := s.Get(ctx, model.MustNewID(model.ResourceTypeOrganization))
+		assert.ErrorIs(t, err, ErrWorkflowGet)
+		assert.ErrorIs(t, err, model.ErrInvalidID)
+	})
+
+	t.Run("get workflow without permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectRead).Return(false)
+
+		s := &workflowService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workflowService/Get"),
+			permissionService: permSvc,
+		}}
+
+		_, err := s.Get(ctx, projectID)
+		assert.ErrorIs(t, err, ErrNoPermission)
+	})
+}
+
+func TestWorkflowService_Set(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	repoWorkflow := newTestRepositoryWorkflow(projectID)
+	opts := SetWorkflowOpts{
+		Statuses:    repoWorkflow.Statuses,
+		Transitions: repoWorkflow.Transitions,
+	}
+
+	t.Run("set workflow", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+		workflowRepo.EXPECT().Save(ctx, repository.SaveWorkflowOpts{
+			Project:     projectID,
+			Statuses:    opts.Statuses,
+			Transitions: opts.Transitions,
+		}).Return(repoWorkflow, nil)
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+		s := &workflowService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workflowService/Set"),
+			workflowRepo:      workflowRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+
+		got, err := s.Set(ctx, projectID, opts)
+		require.NoError(t, err)
+		assert.Equal(t, workflowFromRepository(repoWorkflow), got)
+	})
+
+	t.Run("set workflow without licensed feature", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(false, nil)
+
+		s := &workflowService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workflowService/Set"),
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+
+		_, err := s.Set(ctx, projectID, opts)
+		assert.ErrorIs(t, err, ErrWorkflowSet)
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+	})
+
+	t.Run("set workflow with invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+		s := &workflowService{baseService: &baseService{
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.workflowService/Set"),
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+
+		_, err := s.Set(ctx, projectID, SetWorkflowOpts{
+			Statuses:    opts.Statuses,
+			Transitions: []model.WorkflowTransition{{From: "backlog", To: "unknown"}},
+		})
+		assert.ErrorIs(t, err, ErrWorkflowSet)
+		assert.ErrorIs(t, err, model.ErrInvalidWorkflowDetails)
+	})
+
+	t.Run("set workflow with expired license", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+
+		s := &workflowService{baseService: &baseService{
+			tracer:         newCommentTestTracer(ctrl, ctx, "service.workflowService/Set"),
+			licenseService: newCommentTestLicenseService(ctrl, ctx, true),
+		}}
+
+		_, err := s.Set(ctx, projectID, opts)
+		assert.ErrorIs(t, err, license.ErrLicenseExpired)
+	})
+}
+
+func TestWorkflowService_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+	workflowRepo.EXPECT().Delete(ctx, projectID).Return(nil)
+
+	permSvc := NewMockPermissionService(ctrl)
+	permSvc.EXPECT().CtxUserHas(ctx, projectID, model.ActionProjectUpdate).Return(true)
+
+	s := &workflowService{baseService: &baseService{
+		tracer:            newCommentTestTracer(ctrl, ctx, "service.workflowService/Delete"),
+		workflowRepo:      workflowRepo,
+		permissionService: permSvc,
+		licenseService:    newCommentTestLicenseService(ctrl, ctx, false),
+	}}
+
+	require.NoError(t, s.Delete(ctx, projectID))
+}
+
+func TestIssueService_UpdateWorkflowStatus(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	newIssue := func() *repository.Issue {
+		issue := testModel.NewRepositoryIssue(userID)
+		issue.Project = &repository.PartialProject{ID: projectID}
+		issue.WorkflowStatus = convert.ToPointer("backlog")
+		return issue
+	}
+
+	newService := func(ctrl *gomock.Controller, ctx context.Context, issueRepo repository.IssueRepository, workflow *repository.Workflow) *issueService {
+		workflowRepo := repository.NewMockWorkflowRepository(ctrl)
+		if workflow != nil {
+			workflowRepo.EXPECT().Get(ctx, projectID).Return(workflow, nil)
+		} else {
+			workflowRepo.EXPECT().Get(ctx, projectID).Return(nil, repository.ErrNotFound)
+		}
+
+		permSvc := NewMockPermissionService(ctrl)
+		permSvc.EXPECT().CtxUserHas(ctx, gomock.Any(), model.ActionIssueUpdate).Return(true)
+
+		licenseSvc := newCommentTestLicenseService(ctrl, ctx, false)
+		licenseSvc.EXPECT().HasFeature(ctx, license.FeatureCustomStatuses).Return(true, nil)
+
+		return &issueService{baseService: &baseService{
+			logger:            mock.NewMockLogger(ctrl),
+			tracer:            newCommentTestTracer(ctrl, ctx, "service.issueService/Update"),
+			issueRepo:         issueRepo,
+			workflowRepo:      workflowRepo,
+			permissionService: permSvc,
+			licenseService:    licenseSvc,
+		}}
+	}
+
+	t.Run("allowed transition", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+		after := *before
+		after.Status = model.IssueStatusInProgress
+		after.WorkflowStatus = convert.ToPointer("in_review")
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+		issueRepo.EXPECT().Update(ctx, before.ID, repository.UpdateIssueOpts{
+			Status:         optional.Some(model.IssueStatusInProgress),
+			WorkflowStatus: optional.Some("in_review"),
+		}, repository.IssueDetailProjection()).Return(&after, nil)
+
+		s := newService(ctrl, ctx, issueRepo, newTestRepositoryWorkflow(projectID))
+		s.searchService = mockSearchIndex(ctrl)
+		got, err := s.Update(ctx, before.ID, UpdateIssueOpts{WorkflowStatus: optional.Some("in_review")})
+		require.NoError(t, err)
+		assert.Equal(t, model.IssueStatusInProgress, got.Status)
+		assert.Equal(t, convert.ToPointer("in_review"), got.WorkflowStatus)
+	})
+
+	t.Run("disallowed transition", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+
+		s := newService(ctrl, ctx, issueRepo, newTestRepositoryWorkflow(projectID))
+		_, err := s.Update(ctx, before.ID, UpdateIssueOpts{WorkflowStatus: optional.Some("shipped")})
+		assert.ErrorIs(t, err, ErrIssueUpdate)
+		assert.ErrorIs(t, err, ErrIssueWorkflowTransition)
+	})
+
+	t.Run("built-in status with workflow", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+
+		s := newService(ctrl, ctx, issueRepo, newTestRepositoryWorkflow(projectID))
+		_, err := s.Update(ctx, before.ID, UpdateIssueOpts{Status: optional.Some(model.IssueStatusDone)})
+		assert.ErrorIs(t, err, ErrIssueWorkflowStatus)
+	})
+
+	t.Run("workflow status without workflow", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+
+		s := newService(ctrl, ctx, issueRepo, nil)
+		_, err := s.Update(ctx, before.ID, UpdateIssueOpts{WorkflowStatus: optional.Some("in_review")})
+		assert.ErrorIs(t, err, ErrIssueWorkflowStatus)
+	})
+
+	t.Run("built-in status clears stale workflow status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		ctx := context.WithValue(context.Background(), pkg.CtxKeyUserID, userID)
+
+		before := newIssue()
+		after := *before
+		after.Status = model.IssueStatusDone
+		after.WorkflowStatus = nil
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, before.ID, repository.IssueDetailProjection()).Return(before, nil)
+		issueRepo.EXPECT().Update(ctx, before.ID, repository.UpdateIssueOpts{
+			Status:         optional.Some(model.IssueStatusDone),
+			WorkflowStatus: optional.Null[string](),
+		}, repository.IssueDetailProjection()).Return(&after, nil)
+
+		s := newService(ctrl, ctx, issueRepo, nil)
+		s.searchService = mockSearchIndex(ctrl)
+		got, err := s.Update(ctx, before.ID, UpdateIssueOpts{Status: optional.Some(model.IssueStatusDone)})
+		require.NoError(t, err)
+		assert.Nil(t, got.WorkflowStatus)
+	})
+}
@@ -20,11 +20,12 @@ func NewCreateAttachmentOpts(belongsTo, createdBy model.ID) repository.CreateAtt
 }
 
 // NewRepositoryAttachment creates a repository.Attachment for mock returns.
-func NewRepositoryAttachment(createdBy model.ID) *repository.Attachment {
+func NewRepositoryAttachment(belongsTo, createdBy model.ID) *repository.Attachment {
 	return &repository.Attachment{
 		ID:        model.MustNewID(model.ResourceTypeAttachment),
 		Name:      pkg.GenerateRandomString(10),
 		FileID:    pkg.GenerateRandomString(10),
+		BelongsTo: belongsTo,
 		CreatedBy: createdBy,
 		CreatedAt: convert.ToPointer(time.Now().UTC()),
 	}
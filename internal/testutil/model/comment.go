@@ -19,9 +19,10 @@ func NewCreateCommentOpts(belongsTo, createdBy model.ID) repository.CreateCommen
 }
 
 // NewRepositoryComment creates a repository.Comment for mock returns.
-func NewRepositoryComment(createdBy model.ID) *repository.Comment {
+func NewRepositoryComment(belongsTo, createdBy model.ID) *repository.Comment {
 	return &repository.Comment{
 		ID:        model.MustNewID(model.ResourceTypeComment),
+		BelongsTo: belongsTo,
 		Content:   pkg.GenerateRandomString(10),
 		CreatedBy: createdBy,
 		CreatedAt: convert.ToPointer(time.Now().UTC()),
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// NewCreateComponentOpts creates repository.CreateComponentOpts for tests.
+func NewCreateComponentOpts(project model.ID, lead *model.ID) repository.CreateComponentOpts {
+	return repository.CreateComponentOpts{
+		Project:     project,
+		Name:        pkg.GenerateRandomString(10),
+		Description: pkg.GenerateRandomString(10),
+		Lead:        lead,
+	}
+}
+
+// NewRepositoryComponent creates a repository.Component for mock returns.
+func NewRepositoryComponent(project model.ID, lead *model.ID) *repository.Component {
+	opts := NewCreateComponentOpts(project, lead)
+	return &repository.Component{
+		ID:          model.MustNewID(model.ResourceTypeComponent),
+		Project:     project,
+		Name:        opts.Name,
+		Description: opts.Description,
+		Lead:        lead,
+		CreatedAt:   convert.ToPointer(time.Now().UTC()),
+	}
+}
@@ -10,20 +10,24 @@ import (
 )
 
 // NewCreateLabelOpts creates repository.CreateLabelOpts for tests.
-func NewCreateLabelOpts() repository.CreateLabelOpts {
+func NewCreateLabelOpts(scope model.ID) repository.CreateLabelOpts {
 	return repository.CreateLabelOpts{
+		Scope:       scope,
 		Name:        pkg.GenerateRandomString(10),
 		Description: pkg.GenerateRandomString(10),
+		Color:       "#1f6feb",
 	}
 }
 
 // NewRepositoryLabel creates a repository.Label for mock returns.
-func NewRepositoryLabel() *repository.Label {
-	opts := NewCreateLabelOpts()
+func NewRepositoryLabel(scope model.ID) *repository.Label {
+	opts := NewCreateLabelOpts(scope)
 	return &repository.Label{
 		ID:          model.MustNewID(model.ResourceTypeLabel),
 		Name:        opts.Name,
 		Description: opts.Description,
+		Color:       opts.Color,
+		Scope:       &scope,
 		CreatedAt:   convert.ToPointer(time.Now().UTC()),
 	}
 }
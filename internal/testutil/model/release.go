@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// NewCreateReleaseOpts creates repository.CreateReleaseOpts for tests.
+func NewCreateReleaseOpts(project model.ID) repository.CreateReleaseOpts {
+	return repository.CreateReleaseOpts{
+		Project:     project,
+		Name:        pkg.GenerateRandomString(10),
+		Description: pkg.GenerateRandomString(10),
+		TargetDate:  convert.ToPointer(time.Now().UTC().Add(14 * 24 * time.Hour).Truncate(time.Second)),
+	}
+}
+
+// NewRepositoryRelease creates a planned repository.Release for mock returns.
+func NewRepositoryRelease(project model.ID) *repository.Release {
+	opts := NewCreateReleaseOpts(project)
+	return &repository.Release{
+		ID:          model.MustNewID(model.ResourceTypeRelease),
+		Project:     project,
+		Name:        opts.Name,
+		Description: opts.Description,
+		Status:      model.ReleaseStatusPlanned,
+		TargetDate:  opts.TargetDate,
+		CreatedAt:   convert.ToPointer(time.Now().UTC()),
+	}
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// NewCreateSprintOpts creates repository.CreateSprintOpts for a two-week
+// sprint starting today.
+func NewCreateSprintOpts(project model.ID) repository.CreateSprintOpts {
+	start := time.Now().UTC().Truncate(24 * time.Hour)
+	return repository.CreateSprintOpts{
+		Project:   project,
+		Name:      pkg.GenerateRandomString(10),
+		Goal:      pkg.GenerateRandomString(10),
+		StartDate: start,
+		EndDate:   start.Add(14 * 24 * time.Hour),
+	}
+}
+
+// NewRepositorySprint creates a planned repository.Sprint for mock returns.
+func NewRepositorySprint(project model.ID) *repository.Sprint {
+	opts := NewCreateSprintOpts(project)
+	return &repository.Sprint{
+		ID:        model.MustNewID(model.ResourceTypeSprint),
+		Project:   project,
+		Name:      opts.Name,
+		Goal:      opts.Goal,
+		State:     model.SprintStatePlanned,
+		StartDate: opts.StartDate,
+		EndDate:   opts.EndDate,
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
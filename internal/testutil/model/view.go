@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+// NewCreateViewOpts creates repository.CreateViewOpts for tests.
+func NewCreateViewOpts(owner, scope model.ID) repository.CreateViewOpts {
+	return repository.CreateViewOpts{
+		Owner:       owner,
+		Scope:       scope,
+		Name:        pkg.GenerateRandomString(10),
+		Description: pkg.GenerateRandomString(10),
+		Filter: repository.ViewFilter{
+			Query:      "kind:bug",
+			Statuses:   []model.IssueStatus{model.IssueStatusOpen},
+			Priorities: []model.IssuePriority{model.IssuePriorityHigh},
+		},
+		Sort: repository.IssueListSort{
+			Field:     repository.IssueListSortFieldPriority,
+			Direction: repository.SortDirectionDesc,
+		},
+		Columns:  []model.ViewColumn{model.ViewColumnKey, model.ViewColumnTitle, model.ViewColumnPriority},
+		Grouping: model.ViewGroupingStatus,
+	}
+}
+
+// NewRepositoryView creates a repository.View for mock returns.
+func NewRepositoryView(owner, scope model.ID) *repository.View {
+	opts := NewCreateViewOpts(owner, scope)
+	return &repository.View{
+		ID:          model.MustNewID(model.ResourceTypeView),
+		Owner:       owner,
+		Scope:       scope,
+		Name:        opts.Name,
+		Description: opts.Description,
+		Filter:      opts.Filter,
+		Sort:        opts.Sort,
+		Columns:     opts.Columns,
+		Grouping:    opts.Grouping,
+		CreatedAt:   convert.ToPointer(time.Now().UTC()),
+	}
+}
@@ -52,6 +52,7 @@ type Neo4jContainerIntegrationTestSuite struct {
 	AssignmentRepo   *repository.Neo4jAssignmentRepository
 	AttachmentRepo   *repository.Neo4jAttachmentRepository
 	CommentRepo      *repository.Neo4jCommentRepository
+	ComponentRepo    *repository.Neo4jComponentRepository
 	DocumentRepo     *repository.Neo4jDocumentRepository
 	FolderRepo       *repository.Neo4jFolderRepository
 	IssueRepo        *repository.Neo4jIssueRepository
@@ -61,10 +62,13 @@ type Neo4jContainerIntegrationTestSuite struct {
 	OrganizationRepo *repository.Neo4jOrganizationRepository
 	PermissionRepo   *repository.Neo4jPermissionRepository
 	ProjectRepo      *repository.Neo4jProjectRepository
+	ReleaseRepo      *repository.Neo4jReleaseRepository
 	RoleRepo         *repository.Neo4jRoleRepository
+	SprintRepo       *repository.Neo4jSprintRepository
 	TeamRepo         *repository.Neo4jTeamRepository
 	TodoRepo         *repository.Neo4jTodoRepository
 	UserRepo         *repository.Neo4jUserRepository
+	ViewRepo         *repository.Neo4jViewRepository
 }
 
 func (s *Neo4jContainerIntegrationTestSuite) BootstrapNeo4jDatabase(ts *ContainerIntegrationTestSuite) {
@@ -88,6 +92,9 @@ func (s *Neo4jContainerIntegrationTestSuite) SetupNeo4j(ts *ContainerIntegration
 	s.CommentRepo, err = repository.NewNeo4jCommentRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
 	ts.Require().NoError(err)
 
+	s.ComponentRepo, err = repository.NewNeo4jComponentRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
+	ts.Require().NoError(err)
+
 	s.DocumentRepo, err = repository.NewNeo4jDocumentRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
 	ts.Require().NoError(err)
 
@@ -115,9 +122,15 @@ func (s *Neo4jContainerIntegrationTestSuite) SetupNeo4j(ts *ContainerIntegration
 	s.ProjectRepo, err = repository.NewNeo4jProjectRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
 	ts.Require().NoError(err)
 
+	s.ReleaseRepo, err = repository.NewNeo4jReleaseRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
+	ts.Require().NoError(err)
+
 	s.RoleRepo, err = repository.NewNeo4jRoleRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
 	ts.Require().NoError(err)
 
+	s.SprintRepo, err = repository.NewNeo4jSprintRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
+	ts.Require().NoError(err)
+
 	s.TeamRepo, err = repository.NewNeo4jTeamRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
 	ts.Require().NoError(err)
 
@@ -127,6 +140,9 @@ func (s *Neo4jContainerIntegrationTestSuite) SetupNeo4j(ts *ContainerIntegration
 	s.UserRepo, err = repository.NewNeo4jUserRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
 	ts.Require().NoError(err)
 
+	s.ViewRepo, err = repository.NewNeo4jViewRepository(repository.WithNeo4jDatabase(s.Neo4jDB))
+	ts.Require().NoError(err)
+
 	s.BootstrapNeo4jDatabase(ts)
 	s.CleanupNeo4j(ts)
 }
@@ -140,8 +156,18 @@ func (s *Neo4jContainerIntegrationTestSuite) CleanupNeo4j(ts *ContainerIntegrati
 type PgContainerIntegrationTestSuite struct {
 	PostgresDB *repository.PGDatabase
 
-	NotificationRepo    *repository.PGNotificationRepository
-	UserTokenRepository *repository.PGUserTokenRepository
+	NotificationRepo      *repository.PGNotificationRepository
+	UserTokenRepository   *repository.PGUserTokenRepository
+	WebhookRepo           *repository.PGWebhookRepository
+	IssueActivityRepo     *repository.PGIssueActivityRepository
+	WorkflowRepo          *repository.PGWorkflowRepository
+	CustomFieldRepo       *repository.PGCustomFieldRepository
+	WIPLimitRepo          *repository.PGWIPLimitRepository
+	SprintScopeChangeRepo *repository.PGSprintScopeChangeRepository
+	WorkLogRepo           *repository.PGWorkLogRepository
+	IssueTemplateRepo     *repository.PGIssueTemplateRepository
+	IssueRecurrenceRepo   *repository.PGIssueRecurrenceRepository
+	MentionRepo           *repository.PGMentionRepository
 }
 
 func (s *PgContainerIntegrationTestSuite) BootstrapPgDatabase(ts *ContainerIntegrationTestSuite) {
@@ -162,6 +188,36 @@ func (s *PgContainerIntegrationTestSuite) SetupPg(ts *ContainerIntegrationTestSu
 	s.UserTokenRepository, err = repository.NewUserTokenRepository(repository.WithPGDatabase(s.PostgresDB))
 	ts.Require().NoError(err)
 
+	s.WebhookRepo, err = repository.NewWebhookRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.IssueActivityRepo, err = repository.NewIssueActivityRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.WorkflowRepo, err = repository.NewWorkflowRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.CustomFieldRepo, err = repository.NewCustomFieldRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.WIPLimitRepo, err = repository.NewWIPLimitRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.SprintScopeChangeRepo, err = repository.NewSprintScopeChangeRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.WorkLogRepo, err = repository.NewWorkLogRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.IssueTemplateRepo, err = repository.NewIssueTemplateRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.IssueRecurrenceRepo, err = repository.NewIssueRecurrenceRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
+	s.MentionRepo, err = repository.NewMentionRepository(repository.WithPGDatabase(s.PostgresDB))
+	ts.Require().NoError(err)
+
 	s.BootstrapPgDatabase(ts)
 	s.CleanupPg(ts)
 }
@@ -3,12 +3,19 @@ package async
 import "errors"
 
 var (
-	ErrNoEmailService       = errors.New("no email service set")             // no email service set
-	ErrNoGraphDatabase      = errors.New("no graph database set")            // no graph database set
-	ErrNoQueueClient        = errors.New("no queue client set")              // no queue client set
-	ErrNoRateLimiter        = errors.New("no rate limiter set")              // no rate limiter set
-	ErrNoSearchService      = errors.New("no search service set")            // no search service set
-	ErrNoTaskHandler        = errors.New("no task handler set")              // no task handler set
-	ErrRateLimitExceeded    = errors.New("rate limit exceeded")              // rate limit exceeded
-	ErrTaskPayloadUnmarshal = errors.New("failed to unmarshal task payload") // failed to unmarshal task payload
+	ErrNoEmailService          = errors.New("no email service set")             // no email service set
+	ErrNoGraphDatabase         = errors.New("no graph database set")            // no graph database set
+	ErrNoIssueRepository       = errors.New("no issue repository set")          // no issue repository set
+	ErrNoIssueService          = errors.New("no issue service set")             // no issue service set
+	ErrNoNotificationService   = errors.New("no notification service set")      // no notification service set
+	ErrNoPermissionService     = errors.New("no permission service set")        // no permission service set
+	ErrNoQueueClient           = errors.New("no queue client set")              // no queue client set
+	ErrNoRateLimiter           = errors.New("no rate limiter set")              // no rate limiter set
+	ErrNoSearchService         = errors.New("no search service set")            // no search service set
+	ErrNoTaskHandler           = errors.New("no task handler set")              // no task handler set
+	ErrNoWebhookRepository     = errors.New("no webhook repository set")        // no webhook repository set
+	ErrRateLimitExceeded       = errors.New("rate limit exceeded")              // rate limit exceeded
+	ErrTaskPayloadUnmarshal    = errors.New("failed to unmarshal task payload") // failed to unmarshal task payload
+	ErrWebhookAddressForbidden = errors.New("webhook address is not public")    // the receiver address is not public
+	ErrWebhookDeliveryFailed   = errors.New("webhook delivery failed")          // the receiver did not accept the delivery
 )
@@ -34,6 +34,70 @@ func WithTaskSearchService(searchService service.SearchService) TaskHandlerOptio
 	}
 }
 
+// WithTaskNotificationService sets the notification service for the worker.
+func WithTaskNotificationService(notificationService service.NotificationService) TaskHandlerOption {
+	return func(t *baseTaskHandler) error {
+		if notificationService == nil {
+			return ErrNoNotificationService
+		}
+
+		t.notificationService = notificationService
+		return nil
+	}
+}
+
+// WithTaskPermissionService sets the permission service used to check the
+// access of the recipients of issue notifications.
+func WithTaskPermissionService(permissionService service.PermissionService) TaskHandlerOption {
+	return func(t *baseTaskHandler) error {
+		if permissionService == nil {
+			return ErrNoPermissionService
+		}
+
+		t.permissionService = permissionService
+		return nil
+	}
+}
+
+// WithTaskIssueService sets the issue service used to materialize the issue
+// recurrences.
+func WithTaskIssueService(issueService service.IssueService) TaskHandlerOption {
+	return func(t *baseTaskHandler) error {
+		if issueService == nil {
+			return ErrNoIssueService
+		}
+
+		t.issueService = issueService
+		return nil
+	}
+}
+
+// WithTaskIssueRepository sets the issue repository used to resolve the
+// recipients of issue notifications.
+func WithTaskIssueRepository(issueRepo repository.IssueRepository) TaskHandlerOption {
+	return func(t *baseTaskHandler) error {
+		if issueRepo == nil {
+			return ErrNoIssueRepository
+		}
+
+		t.issueRepo = issueRepo
+		return nil
+	}
+}
+
+// WithTaskWebhookRepository sets the webhook repository used to send webhook
+// deliveries.
+func WithTaskWebhookRepository(webhookRepo repository.WebhookRepository) TaskHandlerOption {
+	return func(t *baseTaskHandler) error {
+		if webhookRepo == nil {
+			return ErrNoWebhookRepository
+		}
+
+		t.webhookRepo = webhookRepo
+		return nil
+	}
+}
+
 // WithTaskGraphDatabase sets the graph database for search tasks.
 func WithTaskGraphDatabase(db *repository.Neo4jDatabase) TaskHandlerOption {
 	return func(t *baseTaskHandler) error {
@@ -97,11 +161,16 @@ type baseTaskHandler struct {
 	logger log.Logger
 	tracer tracing.Tracer
 
-	emailService     service.EmailService
-	searchService    service.SearchService
-	graphDB          *repository.Neo4jDatabase
-	queueClient      service.SearchTaskEnqueuer
-	reindexBatchSize int
+	emailService        service.EmailService
+	searchService       service.SearchService
+	notificationService service.NotificationService
+	permissionService   service.PermissionService
+	issueService        service.IssueService
+	issueRepo           repository.IssueRepository
+	webhookRepo         repository.WebhookRepository
+	graphDB             *repository.Neo4jDatabase
+	queueClient         service.SearchTaskEnqueuer
+	reindexBatchSize    int
 }
 
 // newBaseTaskHandler creates a new base task handler.
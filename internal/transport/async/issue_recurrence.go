@@ -0,0 +1,39 @@
+package async
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// IssueRecurrenceTaskHandler materializes the issues of the due occurrences of
+// the recurring issue templates.
+type IssueRecurrenceTaskHandler struct {
+	*baseTaskHandler
+}
+
+// ProcessTask creates the issues of the occurrences due by now. Every
+// occurrence is materialized at most once, so the task can run concurrently
+// and again after a restart of the worker without creating duplicates.
+func (h *IssueRecurrenceTaskHandler) ProcessTask(ctx context.Context, _ *asynq.Task) error {
+	ctx, span := h.tracer.Start(ctx, "transport.asynq.IssueRecurrenceTaskHandler/ProcessTask")
+	defer span.End()
+
+	_, err := h.issueService.MaterializeRecurrences(ctx, time.Now().UTC())
+	return err
+}
+
+// NewIssueRecurrenceTaskHandler creates a new issue recurrence task handler.
+func NewIssueRecurrenceTaskHandler(opts ...TaskHandlerOption) (*IssueRecurrenceTaskHandler, error) {
+	h, err := newBaseTaskHandler(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.issueService == nil {
+		return nil, ErrNoIssueService
+	}
+
+	return &IssueRecurrenceTaskHandler{h}, nil
+}
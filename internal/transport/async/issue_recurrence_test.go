@@ -0,0 +1,69 @@
+package async
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+)
+
+func TestNewIssueRecurrenceTaskHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	h, err := NewIssueRecurrenceTaskHandler(
+		WithTaskLogger(mock.NewMockLogger(nil)),
+		WithTaskTracer(mock.NewMockTracer(nil)),
+		WithTaskIssueService(service.NewMockIssueService(ctrl)),
+	)
+	require.NoError(t, err)
+	assert.NotNil(t, h)
+
+	_, err = NewIssueRecurrenceTaskHandler(WithTaskLogger(mock.NewMockLogger(nil)))
+	assert.ErrorIs(t, err, ErrNoIssueService)
+
+	_, err = NewIssueRecurrenceTaskHandler(WithTaskIssueService(nil))
+	assert.ErrorIs(t, err, ErrNoIssueService)
+}
+
+func TestIssueRecurrenceTaskHandler_ProcessTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	spanName := "transport.asynq.IssueRecurrenceTaskHandler/ProcessTask"
+
+	task, err := queue.NewIssueRecurrenceTask()
+	require.NoError(t, err)
+
+	t.Run("materializes due recurrences", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+
+		issueSvc := service.NewMockIssueService(ctrl)
+		issueSvc.EXPECT().MaterializeRecurrences(ctx, gomock.Any()).Return([]*service.Issue{{}}, nil)
+		base.issueService = issueSvc
+
+		require.NoError(t, (&IssueRecurrenceTaskHandler{base}).ProcessTask(ctx, task))
+	})
+
+	t.Run("returns materialize errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+
+		issueSvc := service.NewMockIssueService(ctrl)
+		issueSvc.EXPECT().MaterializeRecurrences(ctx, gomock.Any()).Return(nil, service.ErrIssueRecurrenceMaterialize)
+		base.issueService = issueSvc
+
+		err := (&IssueRecurrenceTaskHandler{base}).ProcessTask(ctx, task)
+		assert.ErrorIs(t, err, service.ErrIssueRecurrenceMaterialize)
+	})
+}
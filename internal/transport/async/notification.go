@@ -0,0 +1,159 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/hibiken/asynq"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+)
+
+// notificationDescriptionMinLength is the shortest description accepted by
+// the notification service.
+const notificationDescriptionMinLength = 5
+
+// IssueNotificationTaskHandler fans out an issue event to the watchers and
+// assignees of the issue by creating one notification per recipient. The
+// user who triggered the event and the users who cannot read the issue are
+// never notified.
+type IssueNotificationTaskHandler struct {
+	*baseTaskHandler
+}
+
+// ProcessTask resolves the recipients of the issue event and creates their
+// notifications. Events of deleted issues are dropped. A failed notification
+// is logged and does not fail the task, as a retry would notify the other
+// recipients again.
+func (h *IssueNotificationTaskHandler) ProcessTask(ctx context.Context, task *asynq.Task) error {
+	ctx, span := h.tracer.Start(ctx, "transport.asynq.IssueNotificationTaskHandler/ProcessTask")
+	defer span.End()
+
+	var payload queue.IssueEventTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return errors.Join(ErrTaskPayloadUnmarshal, err, asynq.SkipRetry)
+	}
+
+	actor, err := model.ParseCompositeID(payload.Actor)
+	if err != nil {
+		return errors.Join(ErrTaskPayloadUnmarshal, err, asynq.SkipRetry)
+	}
+
+	issueID, err := model.ParseCompositeID(payload.ResourceID)
+	if err != nil {
+		return errors.Join(ErrTaskPayloadUnmarshal, err, asynq.SkipRetry)
+	}
+
+	issue, err := h.issueRepo.Get(ctx, issueID, repository.IssueDetailProjection())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	watchers, err := h.issueRepo.GetWatchers(ctx, issueID)
+	if err != nil {
+		return err
+	}
+
+	title := issueNotificationTitle(task.Type(), issue)
+	description := issueNotificationDescription(issue)
+
+	for _, recipient := range issueNotificationRecipients(actor, issue, watchers) {
+		canRead, err := h.permissionService.Has(ctx, recipient, issueID, model.ActionIssueRead)
+		if err != nil || !canRead {
+			continue
+		}
+
+		_, err = h.notificationService.Create(ctx, service.CreateNotificationOpts{
+			Title:         title,
+			Description:   description,
+			Recipient:     recipient,
+			Event:         task.Type(),
+			Actor:         &actor,
+			Resource:      &issueID,
+			ChangedFields: payload.ChangedFields,
+		})
+		if err != nil {
+			h.logger.Warn(ctx, "failed to create issue notification",
+				log.WithError(err),
+				log.WithValue(recipient.Composite()),
+			)
+		}
+	}
+
+	return nil
+}
+
+// issueNotificationRecipients returns the watchers and assignees of the issue
+// without duplicates, excluding the actor.
+func issueNotificationRecipients(actor model.ID, issue *repository.Issue, watchers []*repository.User) []model.ID {
+	seen := map[model.ID]struct{}{actor: {}}
+	recipients := make([]model.ID, 0, len(watchers)+len(issue.Assignments))
+
+	add := func(id model.ID) {
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		recipients = append(recipients, id)
+	}
+
+	for _, watcher := range watchers {
+		add(watcher.ID)
+	}
+	for _, assignee := range issue.Assignments {
+		add(assignee.ID)
+	}
+
+	return recipients
+}
+
+func issueNotificationTitle(taskType string, issue *repository.Issue) string {
+	ref := issue.Key
+	if ref == "" {
+		ref = issue.Title
+	}
+
+	switch taskType {
+	case queue.TaskTypeNotificationIssueRelation.String():
+		return fmt.Sprintf("Relations of %s changed", ref)
+	case queue.TaskTypeNotificationIssueComment.String():
+		return fmt.Sprintf("New comment on %s", ref)
+	default:
+		return fmt.Sprintf("%s was updated", ref)
+	}
+}
+
+func issueNotificationDescription(issue *repository.Issue) string {
+	if len([]rune(issue.Title)) < notificationDescriptionMinLength {
+		return ""
+	}
+	return issue.Title
+}
+
+// NewIssueNotificationTaskHandler creates a new issue notification task
+// handler. The same handler serves every issue notification task type.
+func NewIssueNotificationTaskHandler(opts ...TaskHandlerOption) (*IssueNotificationTaskHandler, error) {
+	h, err := newBaseTaskHandler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if h.issueRepo == nil {
+		return nil, ErrNoIssueRepository
+	}
+	if h.notificationService == nil {
+		return nil, ErrNoNotificationService
+	}
+	if h.permissionService == nil {
+		return nil, ErrNoPermissionService
+	}
+	return &IssueNotificationTaskHandler{h}, nil
+}
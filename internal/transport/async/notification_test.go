@@ -0,0 +1,203 @@
+package async
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/tracing"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/testutil/mock"
+	testModel "github.com/opcotech/elemo/internal/testutil/model"
+)
+
+func TestIssueNotificationTaskHandler_ProcessTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	spanName := "transport.asynq.IssueNotificationTaskHandler/ProcessTask"
+	actorID := model.MustNewID(model.ResourceTypeUser)
+	watcherID := model.MustNewID(model.ResourceTypeUser)
+	assigneeID := model.MustNewID(model.ResourceTypeUser)
+
+	issue := testModel.NewRepositoryIssue(actorID)
+	issue.Key = "MOB-1"
+	issue.Assignments = []repository.PartialAssignee{
+		{ID: assigneeID, Kind: model.AssignmentKindAssignee},
+		{ID: watcherID, Kind: model.AssignmentKindReviewer},
+	}
+
+	t.Run("notifies watchers and assignees except actor", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+
+		revokedID := model.MustNewID(model.ResourceTypeUser)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+		issueRepo.EXPECT().GetWatchers(ctx, issue.ID).Return([]*repository.User{
+			{ID: actorID},
+			{ID: watcherID},
+			{ID: revokedID},
+		}, nil)
+
+		permSvc := service.NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, watcherID, issue.ID, model.ActionIssueRead).Return(true, nil)
+		permSvc.EXPECT().Has(ctx, assigneeID, issue.ID, model.ActionIssueRead).Return(true, nil)
+		permSvc.EXPECT().Has(ctx, revokedID, issue.ID, model.ActionIssueRead).Return(false, nil)
+
+		notificationSvc := service.NewMockNotificationService(ctrl)
+		for _, recipient := range []model.ID{watcherID, assigneeID} {
+			notificationSvc.EXPECT().Create(ctx, service.CreateNotificationOpts{
+				Title:         "MOB-1 was updated",
+				Description:   issue.Title,
+				Recipient:     recipient,
+				Event:         queue.TaskTypeNotificationIssueUpdated.String(),
+				Actor:         &actorID,
+				Resource:      &issue.ID,
+				ChangedFields: []string{"status"},
+			}).Return(&service.Notification{}, nil)
+		}
+
+		base.issueRepo = issueRepo
+		base.notificationService = notificationSvc
+		base.permissionService = permSvc
+
+		task, err := queue.NewIssueEventTask(queue.TaskTypeNotificationIssueUpdated, actorID, issue.ID, []string{"status"})
+		require.NoError(t, err)
+		require.NoError(t, (&IssueNotificationTaskHandler{base}).ProcessTask(ctx, task))
+	})
+
+	t.Run("logs failed notifications", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+
+		logger := mock.NewMockLogger(ctrl)
+		logger.EXPECT().Warn(ctx, "failed to create issue notification", gomock.Any(), gomock.Any()).Times(2)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+		issueRepo.EXPECT().GetWatchers(ctx, issue.ID).Return(nil, nil)
+
+		notificationSvc := service.NewMockNotificationService(ctrl)
+		notificationSvc.EXPECT().Create(ctx, gomock.Any()).Return(nil, assert.AnError).Times(2)
+
+		permSvc := service.NewMockPermissionService(ctrl)
+		permSvc.EXPECT().Has(ctx, gomock.Any(), issue.ID, model.ActionIssueRead).Return(true, nil).Times(2)
+
+		base.logger = logger
+		base.issueRepo = issueRepo
+		base.notificationService = notificationSvc
+		base.permissionService = permSvc
+
+		task, err := queue.NewIssueEventTask(queue.TaskTypeNotificationIssueComment, actorID, issue.ID, []string{"comments"})
+		require.NoError(t, err)
+		require.NoError(t, (&IssueNotificationTaskHandler{base}).ProcessTask(ctx, task))
+	})
+
+	t.Run("drops events of deleted issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(nil, repository.ErrNotFound)
+
+		base.issueRepo = issueRepo
+		base.notificationService = service.NewMockNotificationService(ctrl)
+
+		task, err := queue.NewIssueEventTask(queue.TaskTypeNotificationIssueRelation, actorID, issue.ID, []string{"relations"})
+		require.NoError(t, err)
+		require.NoError(t, (&IssueNotificationTaskHandler{base}).ProcessTask(ctx, task))
+	})
+
+	t.Run("returns watcher errors", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+
+		issueRepo := repository.NewMockIssueRepository(ctrl)
+		issueRepo.EXPECT().Get(ctx, issue.ID, repository.IssueDetailProjection()).Return(issue, nil)
+		issueRepo.EXPECT().GetWatchers(ctx, issue.ID).Return(nil, assert.AnError)
+
+		base.issueRepo = issueRepo
+		base.notificationService = service.NewMockNotificationService(ctrl)
+
+		task, err := queue.NewIssueEventTask(queue.TaskTypeNotificationIssueUpdated, actorID, issue.ID, nil)
+		require.NoError(t, err)
+		assert.ErrorIs(t, (&IssueNotificationTaskHandler{base}).ProcessTask(ctx, task), assert.AnError)
+	})
+
+	t.Run("invalid payload skips retry", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+		err := (&IssueNotificationTaskHandler{base}).ProcessTask(ctx, asynq.NewTask(
+			queue.TaskTypeNotificationIssueUpdated.String(),
+			[]byte(`{"actor":"invalid"}`),
+		))
+		assert.ErrorIs(t, err, ErrTaskPayloadUnmarshal)
+		assert.ErrorIs(t, err, asynq.SkipRetry)
+	})
+}
+
+func TestIssueNotificationTitle(t *testing.T) {
+	t.Parallel()
+
+	issue := &repository.Issue{Key: "MOB-1", Title: "Fix login"}
+	assert.Equal(t, "MOB-1 was updated", issueNotificationTitle(queue.TaskTypeNotificationIssueUpdated.String(), issue))
+	assert.Equal(t, "Relations of MOB-1 changed", issueNotificationTitle(queue.TaskTypeNotificationIssueRelation.String(), issue))
+	assert.Equal(t, "New comment on MOB-1", issueNotificationTitle(queue.TaskTypeNotificationIssueComment.String(), issue))
+	assert.Equal(t, "Fix login was updated", issueNotificationTitle(queue.TaskTypeNotificationIssueUpdated.String(), &repository.Issue{Title: "Fix login"}))
+}
+
+func TestNewIssueNotificationTaskHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	issueRepo := repository.NewMockIssueRepository(ctrl)
+	notificationSvc := service.NewMockNotificationService(ctrl)
+	permSvc := service.NewMockPermissionService(ctrl)
+
+	got, err := NewIssueNotificationTaskHandler(
+		WithTaskIssueRepository(issueRepo),
+		WithTaskNotificationService(notificationSvc),
+		WithTaskPermissionService(permSvc),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &IssueNotificationTaskHandler{&baseTaskHandler{
+		logger:              log.DefaultLogger(),
+		tracer:              tracing.NoopTracer(),
+		issueRepo:           issueRepo,
+		notificationService: notificationSvc,
+		permissionService:   permSvc,
+	}}, got)
+
+	_, err = NewIssueNotificationTaskHandler(WithTaskNotificationService(notificationSvc), WithTaskPermissionService(permSvc))
+	assert.ErrorIs(t, err, ErrNoIssueRepository)
+
+	_, err = NewIssueNotificationTaskHandler(WithTaskIssueRepository(issueRepo), WithTaskPermissionService(permSvc))
+	assert.ErrorIs(t, err, ErrNoNotificationService)
+
+	_, err = NewIssueNotificationTaskHandler(WithTaskIssueRepository(issueRepo), WithTaskNotificationService(notificationSvc))
+	assert.ErrorIs(t, err, ErrNoPermissionService)
+
+	_, err = NewIssueNotificationTaskHandler(WithTaskPermissionService(nil))
+	assert.ErrorIs(t, err, ErrNoPermissionService)
+
+	_, err = NewIssueNotificationTaskHandler(WithTaskIssueRepository(nil))
+	assert.ErrorIs(t, err, ErrNoIssueRepository)
+
+	_, err = NewIssueNotificationTaskHandler(WithTaskNotificationService(nil))
+	assert.ErrorIs(t, err, ErrNoNotificationService)
+}
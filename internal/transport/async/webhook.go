@@ -0,0 +1,204 @@
+package async
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/hibiken/asynq"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+const (
+	WebhookHeaderEvent     = "X-Elemo-Event"         // the event type of the delivery
+	WebhookHeaderDelivery  = "X-Elemo-Delivery"      // the ID of the delivery
+	WebhookHeaderSignature = "X-Elemo-Signature-256" // the HMAC-SHA256 signature of the body
+)
+
+// WebhookDeliveryTaskHandler sends webhook deliveries to their receivers.
+type WebhookDeliveryTaskHandler struct {
+	*baseTaskHandler
+	client *http.Client
+}
+
+// ProcessTask sends the delivery to the webhook URL and records the outcome
+// of the attempt. Non-2xx responses and transport errors fail the task, so it
+// is retried with an exponential backoff until the retries are exhausted.
+// Deliveries of disabled or deleted webhooks are dropped.
+func (h *WebhookDeliveryTaskHandler) ProcessTask(ctx context.Context, task *asynq.Task) error {
+	ctx, span := h.tracer.Start(ctx, "transport.asynq.WebhookDeliveryTaskHandler/ProcessTask")
+	defer span.End()
+
+	var payload queue.WebhookDeliveryTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return errors.Join(ErrTaskPayloadUnmarshal, err, asynq.SkipRetry)
+	}
+
+	deliveryID, err := model.ParseCompositeID(payload.DeliveryID)
+	if err != nil {
+		return errors.Join(ErrTaskPayloadUnmarshal, err, asynq.SkipRetry)
+	}
+
+	delivery, err := h.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	webhook, err := h.webhookRepo.Get(ctx, delivery.Webhook)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if !webhook.Enabled {
+		_, err := h.webhookRepo.RecordDeliveryAttempt(ctx, delivery.ID, repository.WebhookDeliveryAttempt{
+			Status: repository.WebhookDeliveryStatusFailed,
+			Error:  convert.ToPointer("webhook is disabled"),
+		})
+		return err
+	}
+
+	responseStatus, sendErr := h.send(ctx, webhook, delivery)
+
+	attempt := repository.WebhookDeliveryAttempt{
+		Status:         repository.WebhookDeliveryStatusSucceeded,
+		ResponseStatus: responseStatus,
+	}
+	if sendErr != nil {
+		attempt.Status = repository.WebhookDeliveryStatusPending
+		attempt.Error = convert.ToPointer(sendErr.Error())
+		if isLastAttempt(ctx) {
+			attempt.Status = repository.WebhookDeliveryStatusFailed
+		}
+	}
+
+	if _, err := h.webhookRepo.RecordDeliveryAttempt(ctx, delivery.ID, attempt); err != nil {
+		return errors.Join(sendErr, err)
+	}
+
+	return sendErr
+}
+
+// send posts the payload of the delivery to the webhook and returns the
+// response status, if the receiver responded.
+func (h *WebhookDeliveryTaskHandler) send(ctx context.Context, webhook *repository.Webhook, delivery *repository.WebhookDelivery) (*int, error) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Join(ErrWebhookDeliveryFailed, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHeaderEvent, delivery.Event)
+	req.Header.Set(WebhookHeaderDelivery, delivery.ID.String())
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(webhook.Secret, body))
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(ErrWebhookDeliveryFailed, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, errors.Join(ErrWebhookDeliveryFailed, fmt.Errorf("unexpected response status %d", resp.StatusCode))
+	}
+
+	return &resp.StatusCode, nil
+}
+
+// isLastAttempt reports whether the task being processed will not be
+// retried if it fails.
+func isLastAttempt(ctx context.Context) bool {
+	retried, ok := asynq.GetRetryCount(ctx)
+	if !ok {
+		return false
+	}
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	if !ok {
+		return false
+	}
+	return retried >= maxRetry
+}
+
+// SignWebhookPayload returns the value of the signature header for the
+// payload: the hex encoded HMAC-SHA256 of the payload keyed with the secret,
+// prefixed with "sha256=".
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookClient returns the HTTP client sending the deliveries. The client
+// connects to public addresses only and does not follow redirects, so the
+// receivers cannot make the worker reach internal services.
+func newWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: webhookDialControl,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookDialControl rejects the connections to loopback, private, link-local
+// and other non-public addresses. It is called with the resolved address, so
+// host names resolving to such addresses are rejected too.
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.Join(ErrWebhookAddressForbidden, err)
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return errors.Join(ErrWebhookAddressForbidden, fmt.Errorf("address %s is not public", addr))
+	}
+
+	return nil
+}
+
+// NewWebhookDeliveryTaskHandler creates a new webhook delivery task handler.
+func NewWebhookDeliveryTaskHandler(opts ...TaskHandlerOption) (*WebhookDeliveryTaskHandler, error) {
+	h, err := newBaseTaskHandler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if h.webhookRepo == nil {
+		return nil, ErrNoWebhookRepository
+	}
+	return &WebhookDeliveryTaskHandler{
+		baseTaskHandler: h,
+		client:          newWebhookClient(queue.WebhookDeliveryTaskTimeout),
+	}, nil
+}
@@ -0,0 +1,277 @@
+package async
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/pkg/tracing"
+	"github.com/opcotech/elemo/internal/queue"
+	"github.com/opcotech/elemo/internal/repository"
+)
+
+func TestWebhookDeliveryTaskHandler_ProcessTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	spanName := "transport.asynq.WebhookDeliveryTaskHandler/ProcessTask"
+	payload := `{"event":"issue.updated"}`
+
+	newFixtures := func(url string, enabled bool) (*repository.Webhook, *repository.WebhookDelivery) {
+		webhook := &repository.Webhook{
+			ID:      model.MustNewID(model.ResourceTypeWebhook),
+			Scope:   model.MustNewID(model.ResourceTypeOrganization),
+			URL:     url,
+			Secret:  "0123456789abcdef",
+			Events:  []string{"issue.updated"},
+			Enabled: enabled,
+		}
+		delivery := &repository.WebhookDelivery{
+			ID:      model.MustNewID(model.ResourceTypeWebhookDelivery),
+			Webhook: webhook.ID,
+			Event:   "issue.updated",
+			Payload: payload,
+			Status:  repository.WebhookDeliveryStatusPending,
+		}
+		return webhook, delivery
+	}
+
+	newHandler := func(ctrl *gomock.Controller, webhookRepo repository.WebhookRepository) *WebhookDeliveryTaskHandler {
+		base := testSearchHandlerBase(ctx, ctrl, spanName)
+		base.webhookRepo = webhookRepo
+		return &WebhookDeliveryTaskHandler{baseTaskHandler: base, client: http.DefaultClient}
+	}
+
+	t.Run("sends signed delivery", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		webhook, delivery := newFixtures(server.URL, true)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().GetDelivery(ctx, delivery.ID).Return(delivery, nil)
+		webhookRepo.EXPECT().Get(ctx, webhook.ID).Return(webhook, nil)
+		webhookRepo.EXPECT().RecordDeliveryAttempt(ctx, delivery.ID, repository.WebhookDeliveryAttempt{
+			Status:         repository.WebhookDeliveryStatusSucceeded,
+			ResponseStatus: convert.ToPointer(http.StatusNoContent),
+		}).Return(delivery, nil)
+
+		task, err := queue.NewWebhookDeliveryTask(delivery.ID)
+		require.NoError(t, err)
+		require.NoError(t, newHandler(ctrl, webhookRepo).ProcessTask(ctx, task))
+
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, payload, string(body))
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, "issue.updated", received.Header.Get(WebhookHeaderEvent))
+		assert.Equal(t, delivery.ID.String(), received.Header.Get(WebhookHeaderDelivery))
+		assert.Equal(t, SignWebhookPayload(webhook.Secret, []byte(payload)), received.Header.Get(WebhookHeaderSignature))
+	})
+
+	t.Run("records failed attempt and retries", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		webhook, delivery := newFixtures(server.URL, true)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().GetDelivery(ctx, delivery.ID).Return(delivery, nil)
+		webhookRepo.EXPECT().Get(ctx, webhook.ID).Return(webhook, nil)
+		webhookRepo.EXPECT().RecordDeliveryAttempt(ctx, delivery.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ model.ID, attempt repository.WebhookDeliveryAttempt) (*repository.WebhookDelivery, error) {
+				assert.Equal(t, repository.WebhookDeliveryStatusPending, attempt.Status)
+				assert.Equal(t, convert.ToPointer(http.StatusBadGateway), attempt.ResponseStatus)
+				assert.NotNil(t, attempt.Error)
+				return delivery, nil
+			},
+		)
+
+		task, err := queue.NewWebhookDeliveryTask(delivery.ID)
+		require.NoError(t, err)
+
+		err = newHandler(ctrl, webhookRepo).ProcessTask(ctx, task)
+		assert.ErrorIs(t, err, ErrWebhookDeliveryFailed)
+		assert.NotErrorIs(t, err, asynq.SkipRetry)
+	})
+
+	t.Run("drops delivery of disabled webhook", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		webhook, delivery := newFixtures("http://127.0.0.1:0", false)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().GetDelivery(ctx, delivery.ID).Return(delivery, nil)
+		webhookRepo.EXPECT().Get(ctx, webhook.ID).Return(webhook, nil)
+		webhookRepo.EXPECT().RecordDeliveryAttempt(ctx, delivery.ID, repository.WebhookDeliveryAttempt{
+			Status: repository.WebhookDeliveryStatusFailed,
+			Error:  convert.ToPointer("webhook is disabled"),
+		}).Return(delivery, nil)
+
+		task, err := queue.NewWebhookDeliveryTask(delivery.ID)
+		require.NoError(t, err)
+		require.NoError(t, newHandler(ctrl, webhookRepo).ProcessTask(ctx, task))
+	})
+
+	t.Run("drops deleted delivery", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		_, delivery := newFixtures("http://127.0.0.1:0", true)
+
+		webhookRepo := repository.NewMockWebhookRepository(ctrl)
+		webhookRepo.EXPECT().GetDelivery(ctx, delivery.ID).Return(nil, repository.ErrNotFound)
+
+		task, err := queue.NewWebhookDeliveryTask(delivery.ID)
+		require.NoError(t, err)
+		require.NoError(t, newHandler(ctrl, webhookRepo).ProcessTask(ctx, task))
+	})
+
+	t.Run("invalid payload skips retry", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		err := newHandler(ctrl, repository.NewMockWebhookRepository(ctrl)).ProcessTask(ctx, asynq.NewTask(
+			queue.TaskTypeWebhookDelivery.String(),
+			[]byte(`{"delivery_id":"invalid"}`),
+		))
+		assert.ErrorIs(t, err, ErrTaskPayloadUnmarshal)
+		assert.ErrorIs(t, err, asynq.SkipRetry)
+	})
+}
+
+func TestWebhookClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rejects loopback receivers", func(t *testing.T) {
+		t.Parallel()
+
+		var hits atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		_, err := newWebhookClient(time.Second).Post(server.URL, "application/json", nil)
+		assert.ErrorIs(t, err, ErrWebhookAddressForbidden)
+		assert.Zero(t, hits.Load())
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		t.Parallel()
+
+		var hits atomic.Int32
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			hits.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer target.Close()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+		}))
+		defer server.Close()
+
+		// The test servers listen on loopback, so the address check is
+		// disabled to reach them.
+		client := newWebhookClient(time.Second)
+		client.Transport.(*http.Transport).DialContext = (&net.Dialer{}).DialContext
+
+		resp, err := client.Post(server.URL, "application/json", nil)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+		assert.Zero(t, hits.Load())
+	})
+}
+
+func TestWebhookDialControl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		address string
+		wantErr error
+	}{
+		{address: "93.184.216.34:443"},
+		{address: "[2606:2800:220:1:248:1893:25c8:1946]:443"},
+		{address: "127.0.0.1:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "[::1]:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "10.0.0.1:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "172.16.0.1:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "192.168.1.1:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "169.254.169.254:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "[fe80::1]:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "[fd00::1]:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "[::ffff:127.0.0.1]:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "0.0.0.0:80", wantErr: ErrWebhookAddressForbidden},
+		{address: "invalid", wantErr: ErrWebhookAddressForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, webhookDialControl("tcp", tt.address, nil), tt.wantErr)
+		})
+	}
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	t.Parallel()
+
+	// Computed with: printf '{"event":"issue.updated"}' | openssl dgst -sha256 -hmac 0123456789abcdef
+	assert.Equal(t,
+		"sha256=43fc8cd32d1ca90dc5e1fb0143c49247ed62ba7d6a0641721cf569c1300da973",
+		SignWebhookPayload("0123456789abcdef", []byte(`{"event":"issue.updated"}`)),
+	)
+}
+
+func TestNewWebhookDeliveryTaskHandler(t *testing.T) {
+	t.Parallel()
+
+	webhookRepo := repository.NewMockWebhookRepository(gomock.NewController(t))
+
+	got, err := NewWebhookDeliveryTaskHandler(WithTaskWebhookRepository(webhookRepo))
+	require.NoError(t, err)
+	assert.Equal(t, &baseTaskHandler{
+		logger:      log.DefaultLogger(),
+		tracer:      tracing.NoopTracer(),
+		webhookRepo: webhookRepo,
+	}, got.baseTaskHandler)
+	assert.Equal(t, queue.WebhookDeliveryTaskTimeout, got.client.Timeout)
+	assert.NotNil(t, got.client.CheckRedirect)
+
+	_, err = NewWebhookDeliveryTaskHandler()
+	assert.ErrorIs(t, err, ErrNoWebhookRepository)
+
+	_, err = NewWebhookDeliveryTaskHandler(WithTaskWebhookRepository(nil))
+	assert.ErrorIs(t, err, ErrNoWebhookRepository)
+}
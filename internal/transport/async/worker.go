@@ -146,6 +146,7 @@ func NewWorker(opts ...WorkerOption) (*Worker, error) {
 			GroupMaxSize:             w.conf.GroupMaxSize,
 			Logger:                   log.NewSimpleLogger(w.logger),
 			LogLevel:                 logLevel,
+			RetryDelayFunc:           retryDelay,
 			IsFailure: func(err error) bool {
 				return !errors.Is(err, ErrRateLimitExceeded)
 			},
@@ -168,6 +169,15 @@ func NewWorker(opts ...WorkerOption) (*Worker, error) {
 	return w, nil
 }
 
+// retryDelay returns the delay before the nth retry of the task. Webhook
+// deliveries back off exponentially, other tasks use the default delay.
+func retryDelay(n int, err error, task *asynq.Task) time.Duration {
+	if task.Type() == queue.TaskTypeWebhookDelivery.String() {
+		return queue.WebhookDeliveryRetryDelay(n)
+	}
+	return asynq.DefaultRetryDelayFunc(n, err, task)
+}
+
 // NewWorkerMetricsServer creates a new metrics server to export prometheus
 // metrics.
 func NewWorkerMetricsServer(serverConfig *config.ServerConfig, tracer tracing.Tracer) (http.Handler, error) {
@@ -184,3 +184,15 @@ func TestWithWorkerTracer(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	task := asynq.NewTask(queue.TaskTypeWebhookDelivery.String(), nil)
+	for n := 0; n < 12; n++ {
+		assert.Equal(t, queue.WebhookDeliveryRetryDelay(n), retryDelay(n, assert.AnError, task))
+	}
+
+	delay := retryDelay(1, assert.AnError, asynq.NewTask(queue.TaskTypeSystemHealthCheck.String(), nil))
+	assert.Positive(t, delay)
+}
@@ -0,0 +1,493 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+const (
+	attachmentFormFile = "file"
+	attachmentFormName = "name"
+)
+
+var errAttachmentNoFile = errors.New("multipart form has no file part")
+
+// AttachmentController is the controller for issue and document attachment
+// endpoints.
+type AttachmentController interface {
+	V1IssueAttachmentsGet(ctx context.Context, request api.V1IssueAttachmentsGetRequestObject) (api.V1IssueAttachmentsGetResponseObject, error)
+	V1IssueAttachmentsCreate(ctx context.Context, request api.V1IssueAttachmentsCreateRequestObject) (api.V1IssueAttachmentsCreateResponseObject, error)
+	V1IssueAttachmentDownload(ctx context.Context, request api.V1IssueAttachmentDownloadRequestObject) (api.V1IssueAttachmentDownloadResponseObject, error)
+	V1IssueAttachmentUpdate(ctx context.Context, request api.V1IssueAttachmentUpdateRequestObject) (api.V1IssueAttachmentUpdateResponseObject, error)
+	V1IssueAttachmentDelete(ctx context.Context, request api.V1IssueAttachmentDeleteRequestObject) (api.V1IssueAttachmentDeleteResponseObject, error)
+	V1DocumentAttachmentsGet(ctx context.Context, request api.V1DocumentAttachmentsGetRequestObject) (api.V1DocumentAttachmentsGetResponseObject, error)
+	V1DocumentAttachmentsCreate(ctx context.Context, request api.V1DocumentAttachmentsCreateRequestObject) (api.V1DocumentAttachmentsCreateResponseObject, error)
+	V1DocumentAttachmentDownload(ctx context.Context, request api.V1DocumentAttachmentDownloadRequestObject) (api.V1DocumentAttachmentDownloadResponseObject, error)
+	V1DocumentAttachmentUpdate(ctx context.Context, request api.V1DocumentAttachmentUpdateRequestObject) (api.V1DocumentAttachmentUpdateResponseObject, error)
+	V1DocumentAttachmentDelete(ctx context.Context, request api.V1DocumentAttachmentDeleteRequestObject) (api.V1DocumentAttachmentDeleteResponseObject, error)
+}
+
+// attachmentController is the concrete implementation of AttachmentController.
+type attachmentController struct {
+	*baseController
+}
+
+func (c *attachmentController) V1IssueAttachmentsGet(ctx context.Context, request api.V1IssueAttachmentsGetRequestObject) (api.V1IssueAttachmentsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueAttachmentsGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueAttachmentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1IssueAttachmentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.attachmentService.ListBelongsTo(ctx, issueID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueAttachmentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueAttachmentsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueAttachmentsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueAttachmentsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueAttachmentsGet200JSONResponse(attachmentPageToDTO(page)), nil
+}
+
+func (c *attachmentController) V1IssueAttachmentsCreate(ctx context.Context, request api.V1IssueAttachmentsCreateRequestObject) (api.V1IssueAttachmentsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueAttachmentsCreate")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := readAttachmentUpload(request.Body)
+	if err != nil {
+		return api.V1IssueAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachment, err := c.attachmentService.Create(ctx, issueID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueAttachmentsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueAttachmentsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueAttachmentsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueAttachmentsCreate201JSONResponse(attachmentToDTO(attachment)), nil
+}
+
+func (c *attachmentController) V1IssueAttachmentDownload(ctx context.Context, request api.V1IssueAttachmentDownloadRequestObject) (api.V1IssueAttachmentDownloadResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueAttachmentDownload")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueAttachmentDownload400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachmentID, err := model.NewIDFromString(request.AttachmentId, model.ResourceTypeAttachment.String())
+	if err != nil {
+		return api.V1IssueAttachmentDownload400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	content, err := c.attachmentService.Open(ctx, issueID, attachmentID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueAttachmentDownload400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueAttachmentDownload403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueAttachmentDownload404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueAttachmentDownload500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueAttachmentDownload200ApplicationoctetStreamResponse{
+		Body:          content.Body,
+		ContentLength: content.Size,
+		Headers: api.V1IssueAttachmentDownload200ResponseHeaders{
+			ContentDisposition: attachmentContentDisposition(content.Name),
+		},
+	}, nil
+}
+
+func (c *attachmentController) V1IssueAttachmentUpdate(ctx context.Context, request api.V1IssueAttachmentUpdateRequestObject) (api.V1IssueAttachmentUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueAttachmentUpdate")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachmentID, err := model.NewIDFromString(request.AttachmentId, model.ResourceTypeAttachment.String())
+	if err != nil {
+		return api.V1IssueAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	attachment, err := c.attachmentService.Update(ctx, issueID, attachmentID, service.UpdateAttachmentOpts{
+		Name: request.Body.Name,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueAttachmentUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueAttachmentUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueAttachmentUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueAttachmentUpdate200JSONResponse(attachmentToDTO(attachment)), nil
+}
+
+func (c *attachmentController) V1IssueAttachmentDelete(ctx context.Context, request api.V1IssueAttachmentDeleteRequestObject) (api.V1IssueAttachmentDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueAttachmentDelete")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueAttachmentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachmentID, err := model.NewIDFromString(request.AttachmentId, model.ResourceTypeAttachment.String())
+	if err != nil {
+		return api.V1IssueAttachmentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.attachmentService.Delete(ctx, issueID, attachmentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueAttachmentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueAttachmentDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueAttachmentDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueAttachmentDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueAttachmentDelete204Response{}, nil
+}
+
+func (c *attachmentController) V1DocumentAttachmentsGet(ctx context.Context, request api.V1DocumentAttachmentsGetRequestObject) (api.V1DocumentAttachmentsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentAttachmentsGet")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentAttachmentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1DocumentAttachmentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.attachmentService.ListBelongsTo(ctx, documentID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentAttachmentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentAttachmentsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentAttachmentsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentAttachmentsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentAttachmentsGet200JSONResponse(attachmentPageToDTO(page)), nil
+}
+
+func (c *attachmentController) V1DocumentAttachmentsCreate(ctx context.Context, request api.V1DocumentAttachmentsCreateRequestObject) (api.V1DocumentAttachmentsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentAttachmentsCreate")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1DocumentAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := readAttachmentUpload(request.Body)
+	if err != nil {
+		return api.V1DocumentAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachment, err := c.attachmentService.Create(ctx, documentID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentAttachmentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentAttachmentsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentAttachmentsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentAttachmentsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentAttachmentsCreate201JSONResponse(attachmentToDTO(attachment)), nil
+}
+
+func (c *attachmentController) V1DocumentAttachmentDownload(ctx context.Context, request api.V1DocumentAttachmentDownloadRequestObject) (api.V1DocumentAttachmentDownloadResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentAttachmentDownload")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentAttachmentDownload400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachmentID, err := model.NewIDFromString(request.AttachmentId, model.ResourceTypeAttachment.String())
+	if err != nil {
+		return api.V1DocumentAttachmentDownload400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	content, err := c.attachmentService.Open(ctx, documentID, attachmentID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentAttachmentDownload400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentAttachmentDownload403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentAttachmentDownload404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentAttachmentDownload500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentAttachmentDownload200ApplicationoctetStreamResponse{
+		Body:          content.Body,
+		ContentLength: content.Size,
+		Headers: api.V1DocumentAttachmentDownload200ResponseHeaders{
+			ContentDisposition: attachmentContentDisposition(content.Name),
+		},
+	}, nil
+}
+
+func (c *attachmentController) V1DocumentAttachmentUpdate(ctx context.Context, request api.V1DocumentAttachmentUpdateRequestObject) (api.V1DocumentAttachmentUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentAttachmentUpdate")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachmentID, err := model.NewIDFromString(request.AttachmentId, model.ResourceTypeAttachment.String())
+	if err != nil {
+		return api.V1DocumentAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1DocumentAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	attachment, err := c.attachmentService.Update(ctx, documentID, attachmentID, service.UpdateAttachmentOpts{
+		Name: request.Body.Name,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentAttachmentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentAttachmentUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentAttachmentUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentAttachmentUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentAttachmentUpdate200JSONResponse(attachmentToDTO(attachment)), nil
+}
+
+func (c *attachmentController) V1DocumentAttachmentDelete(ctx context.Context, request api.V1DocumentAttachmentDeleteRequestObject) (api.V1DocumentAttachmentDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentAttachmentDelete")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentAttachmentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	attachmentID, err := model.NewIDFromString(request.AttachmentId, model.ResourceTypeAttachment.String())
+	if err != nil {
+		return api.V1DocumentAttachmentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.attachmentService.Delete(ctx, documentID, attachmentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentAttachmentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentAttachmentDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentAttachmentDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentAttachmentDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentAttachmentDelete204Response{}, nil
+}
+
+// readAttachmentUpload reads the file and the optional name part of an
+// attachment upload. The name defaults to the uploaded file name.
+func readAttachmentUpload(body *multipart.Reader) (service.CreateAttachmentOpts, error) {
+	var opts service.CreateAttachmentOpts
+	var name string
+	var hasFile bool
+
+	for {
+		part, err := body.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return service.CreateAttachmentOpts{}, err
+		}
+
+		switch part.FormName() {
+		case attachmentFormFile:
+			content, err := io.ReadAll(io.LimitReader(part, service.AttachmentMaxSize+1))
+			if err != nil {
+				return service.CreateAttachmentOpts{}, err
+			}
+			if len(content) > service.AttachmentMaxSize {
+				return service.CreateAttachmentOpts{}, service.ErrAttachmentTooLarge
+			}
+			opts.Name = part.FileName()
+			opts.Content = content
+			hasFile = true
+		case attachmentFormName:
+			value, err := io.ReadAll(io.LimitReader(part, 1024))
+			if err != nil {
+				return service.CreateAttachmentOpts{}, err
+			}
+			name = string(value)
+		}
+	}
+
+	if !hasFile {
+		return service.CreateAttachmentOpts{}, errAttachmentNoFile
+	}
+
+	if name != "" {
+		opts.Name = name
+	}
+
+	return opts, nil
+}
+
+func attachmentContentDisposition(name string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": name})
+}
+
+func attachmentToDTO(attachment *service.Attachment) api.Attachment {
+	createdAt := time.Time{}
+	if attachment.CreatedAt != nil {
+		createdAt = *attachment.CreatedAt
+	}
+
+	return api.Attachment{
+		Id:        attachment.ID.String(),
+		Name:      attachment.Name,
+		CreatedBy: attachment.CreatedBy.String(),
+		CreatedAt: createdAt,
+		UpdatedAt: attachment.UpdatedAt,
+	}
+}
+
+func attachmentPageToDTO(page service.Page[*service.Attachment]) api.AttachmentPage {
+	items := make([]api.Attachment, len(page.Items))
+	for i, attachment := range page.Items {
+		items[i] = attachmentToDTO(attachment)
+	}
+
+	return api.AttachmentPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+// NewAttachmentController creates a new AttachmentController.
+func NewAttachmentController(opts ...ControllerOption) (AttachmentController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.attachmentService == nil {
+		return nil, ErrNoAttachmentService
+	}
+
+	return &attachmentController{
+		baseController: controller,
+	}, nil
+}
@@ -0,0 +1,422 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestAttachmentController(t *testing.T, as service.AttachmentService) AttachmentController {
+	t.Helper()
+	c, err := NewAttachmentController(WithAttachmentService(as))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceAttachment(belongsTo model.ID) *service.Attachment {
+	return &service.Attachment{
+		ID:        model.MustNewID(model.ResourceTypeAttachment),
+		BelongsTo: belongsTo,
+		Name:      "screenshot.png",
+		CreatedBy: model.MustNewID(model.ResourceTypeUser),
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+// newTestMultipartReader builds a multipart body with the given form fields.
+// A field named "file" is written as a file part named fileName.
+func newTestMultipartReader(t *testing.T, fileName string, fields map[string][]byte) *multipart.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for name, value := range fields {
+		var part io.Writer
+		var err error
+		if name == attachmentFormFile {
+			part, err = w.CreateFormFile(name, fileName)
+		} else {
+			part, err = w.CreateFormField(name)
+		}
+		require.NoError(t, err)
+		_, err = part.Write(value)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return multipart.NewReader(&buf, w.Boundary())
+}
+
+func TestNewAttachmentController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewAttachmentController(WithAttachmentService(service.NewMockAttachmentService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing attachment service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewAttachmentController()
+		assert.ErrorIs(t, err, ErrNoAttachmentService)
+	})
+}
+
+func TestReadAttachmentUpload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file name is used by default", func(t *testing.T) {
+		t.Parallel()
+		body := newTestMultipartReader(t, "report.pdf", map[string][]byte{
+			attachmentFormFile: []byte("file-content"),
+		})
+
+		opts, err := readAttachmentUpload(body)
+		require.NoError(t, err)
+		assert.Equal(t, service.CreateAttachmentOpts{Name: "report.pdf", Content: []byte("file-content")}, opts)
+	})
+
+	t.Run("name field overrides file name", func(t *testing.T) {
+		t.Parallel()
+		body := newTestMultipartReader(t, "report.pdf", map[string][]byte{
+			attachmentFormFile: []byte("file-content"),
+			attachmentFormName: []byte("Quarterly report"),
+		})
+
+		opts, err := readAttachmentUpload(body)
+		require.NoError(t, err)
+		assert.Equal(t, "Quarterly report", opts.Name)
+	})
+
+	t.Run("missing file part", func(t *testing.T) {
+		t.Parallel()
+		body := newTestMultipartReader(t, "", map[string][]byte{
+			attachmentFormName: []byte("Quarterly report"),
+		})
+
+		_, err := readAttachmentUpload(body)
+		assert.ErrorIs(t, err, errAttachmentNoFile)
+	})
+
+	t.Run("file exceeding the size limit", func(t *testing.T) {
+		t.Parallel()
+		body := newTestMultipartReader(t, "large.bin", map[string][]byte{
+			attachmentFormFile: make([]byte, service.AttachmentMaxSize+1),
+		})
+
+		_, err := readAttachmentUpload(body)
+		assert.ErrorIs(t, err, service.ErrAttachmentTooLarge)
+	})
+}
+
+func TestAttachmentController_V1IssueAttachmentsGet(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	attachment := newServiceAttachment(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().ListBelongsTo(gomock.Any(), issueID, gomock.Any()).Return(service.Page[*service.Attachment]{
+			Items: []*service.Attachment{attachment},
+		}, nil)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1IssueAttachmentsGet(context.Background(), api.V1IssueAttachmentsGetRequestObject{
+			Id: issueID.String(),
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueAttachmentsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, attachment.ID.String(), got.Items[0].Id)
+		assert.Equal(t, attachment.Name, got.Items[0].Name)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().ListBelongsTo(gomock.Any(), issueID, gomock.Any()).Return(
+			service.Page[*service.Attachment]{},
+			errors.Join(service.ErrAttachmentGetAll, service.ErrNoPermission),
+		)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1IssueAttachmentsGet(context.Background(), api.V1IssueAttachmentsGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueAttachmentsGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestAttachmentController_V1IssueAttachmentsCreate(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	attachment := newServiceAttachment(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Create(gomock.Any(), issueID, service.CreateAttachmentOpts{
+			Name:    attachment.Name,
+			Content: []byte("file-content"),
+		}).Return(attachment, nil)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1IssueAttachmentsCreate(context.Background(), api.V1IssueAttachmentsCreateRequestObject{
+			Id: issueID.String(),
+			Body: newTestMultipartReader(t, attachment.Name, map[string][]byte{
+				attachmentFormFile: []byte("file-content"),
+			}),
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueAttachmentsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, attachment.ID.String(), got.Id)
+	})
+
+	t.Run("missing body", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestAttachmentController(t, service.NewMockAttachmentService(ctrl))
+		resp, err := c.V1IssueAttachmentsCreate(context.Background(), api.V1IssueAttachmentsCreateRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueAttachmentsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestAttachmentController(t, service.NewMockAttachmentService(ctrl))
+		resp, err := c.V1IssueAttachmentsCreate(context.Background(), api.V1IssueAttachmentsCreateRequestObject{
+			Id:   issueID.String(),
+			Body: newTestMultipartReader(t, "", map[string][]byte{attachmentFormName: []byte("name")}),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueAttachmentsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Create(gomock.Any(), issueID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrAttachmentCreate, service.ErrNoPermission),
+		)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1IssueAttachmentsCreate(context.Background(), api.V1IssueAttachmentsCreateRequestObject{
+			Id: issueID.String(),
+			Body: newTestMultipartReader(t, attachment.Name, map[string][]byte{
+				attachmentFormFile: []byte("file-content"),
+			}),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueAttachmentsCreate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestAttachmentController_V1DocumentAttachmentDownload(t *testing.T) {
+	t.Parallel()
+
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	attachmentID := model.MustNewID(model.ResourceTypeAttachment)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Open(gomock.Any(), documentID, attachmentID).Return(&service.AttachmentContent{
+			Name: "screenshot.png",
+			Size: 12,
+			Body: io.NopCloser(bytes.NewReader([]byte("file-content"))),
+		}, nil)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1DocumentAttachmentDownload(context.Background(), api.V1DocumentAttachmentDownloadRequestObject{
+			Id:           documentID.String(),
+			AttachmentId: attachmentID.String(),
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1DocumentAttachmentDownload200ApplicationoctetStreamResponse)
+		require.True(t, ok)
+		assert.Equal(t, int64(12), got.ContentLength)
+		assert.Equal(t, `attachment; filename=screenshot.png`, got.Headers.ContentDisposition)
+
+		data, err := io.ReadAll(got.Body)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("file-content"), data)
+	})
+
+	t.Run("bad attachment id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestAttachmentController(t, service.NewMockAttachmentService(ctrl))
+		resp, err := c.V1DocumentAttachmentDownload(context.Background(), api.V1DocumentAttachmentDownloadRequestObject{
+			Id:           documentID.String(),
+			AttachmentId: "bad",
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentAttachmentDownload400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Open(gomock.Any(), documentID, attachmentID).Return(
+			nil,
+			errors.Join(service.ErrAttachmentGet, repository.ErrNotFound),
+		)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1DocumentAttachmentDownload(context.Background(), api.V1DocumentAttachmentDownloadRequestObject{
+			Id:           documentID.String(),
+			AttachmentId: attachmentID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentAttachmentDownload404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestAttachmentController_V1DocumentAttachmentUpdate(t *testing.T) {
+	t.Parallel()
+
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	attachment := newServiceAttachment(documentID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Update(gomock.Any(), documentID, attachment.ID, service.UpdateAttachmentOpts{Name: attachment.Name}).Return(attachment, nil)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1DocumentAttachmentUpdate(context.Background(), api.V1DocumentAttachmentUpdateRequestObject{
+			Id:           documentID.String(),
+			AttachmentId: attachment.ID.String(),
+			Body:         &api.V1DocumentAttachmentUpdateJSONRequestBody{Name: attachment.Name},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1DocumentAttachmentUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, attachment.Name, got.Name)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Update(gomock.Any(), documentID, attachment.ID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrAttachmentUpdate, model.ErrInvalidAttachmentDetails),
+		)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1DocumentAttachmentUpdate(context.Background(), api.V1DocumentAttachmentUpdateRequestObject{
+			Id:           documentID.String(),
+			AttachmentId: attachment.ID.String(),
+			Body:         &api.V1DocumentAttachmentUpdateJSONRequestBody{Name: "a"},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentAttachmentUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestAttachmentController_V1IssueAttachmentDelete(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	attachmentID := model.MustNewID(model.ResourceTypeAttachment)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Delete(gomock.Any(), issueID, attachmentID).Return(nil)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1IssueAttachmentDelete(context.Background(), api.V1IssueAttachmentDeleteRequestObject{
+			Id:           issueID.String(),
+			AttachmentId: attachmentID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueAttachmentDelete204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("not the uploader", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		as.EXPECT().Delete(gomock.Any(), issueID, attachmentID).Return(
+			errors.Join(service.ErrAttachmentDelete, service.ErrNoPermission),
+		)
+
+		c := newTestAttachmentController(t, as)
+		resp, err := c.V1IssueAttachmentDelete(context.Background(), api.V1IssueAttachmentDeleteRequestObject{
+			Id:           issueID.String(),
+			AttachmentId: attachmentID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueAttachmentDelete403JSONResponse)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,364 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// BoardController is the controller for the board endpoints.
+type BoardController interface {
+	V1ProjectBoardGet(ctx context.Context, request api.V1ProjectBoardGetRequestObject) (api.V1ProjectBoardGetResponseObject, error)
+	V1ViewBoardGet(ctx context.Context, request api.V1ViewBoardGetRequestObject) (api.V1ViewBoardGetResponseObject, error)
+	V1ProjectWIPLimitsGet(ctx context.Context, request api.V1ProjectWIPLimitsGetRequestObject) (api.V1ProjectWIPLimitsGetResponseObject, error)
+	V1ProjectWIPLimitsUpdate(ctx context.Context, request api.V1ProjectWIPLimitsUpdateRequestObject) (api.V1ProjectWIPLimitsUpdateResponseObject, error)
+	V1IssueBoardMove(ctx context.Context, request api.V1IssueBoardMoveRequestObject) (api.V1IssueBoardMoveResponseObject, error)
+}
+
+// boardController is the concrete implementation of BoardController.
+type boardController struct {
+	*baseController
+}
+
+// boardParams are the query parameters selecting the columns of a board and
+// the page of their issues.
+type boardParams struct {
+	groupBy   *api.BoardGroupBy
+	column    *string
+	pageSize  *int
+	pageToken *string
+}
+
+func (c *boardController) V1ProjectBoardGet(ctx context.Context, request api.V1ProjectBoardGetRequestObject) (api.V1ProjectBoardGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectBoardGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectBoardGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	listOpts, err := issueListOptionsFromParams(request.Params.Q, request.Params.Query, nil, nil, nil, nil, nil)
+	if err != nil {
+		return api.V1ProjectBoardGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	board, err := c.board(ctx, projectID, listOpts, boardParams{
+		groupBy:   request.Params.GroupBy,
+		column:    request.Params.Column,
+		pageSize:  request.Params.PageSize,
+		pageToken: request.Params.PageToken,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectBoardGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectBoardGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectBoardGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectBoardGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectBoardGet200JSONResponse(board), nil
+}
+
+func (c *boardController) V1ViewBoardGet(ctx context.Context, request api.V1ViewBoardGetRequestObject) (api.V1ViewBoardGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewBoardGet")
+	defer span.End()
+
+	viewID, err := model.NewIDFromString(request.Id, model.ResourceTypeView.String())
+	if err != nil {
+		return api.V1ViewBoardGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	view, err := c.viewService.Get(ctx, viewID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewBoardGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewBoardGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewBoardGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewBoardGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	board, err := c.board(ctx, view.Scope, view.Options, boardParams{
+		groupBy:   request.Params.GroupBy,
+		column:    request.Params.Column,
+		pageSize:  request.Params.PageSize,
+		pageToken: request.Params.PageToken,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewBoardGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewBoardGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewBoardGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewBoardGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ViewBoardGet200JSONResponse(board), nil
+}
+
+func (c *boardController) V1ProjectWIPLimitsGet(ctx context.Context, request api.V1ProjectWIPLimitsGetRequestObject) (api.V1ProjectWIPLimitsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWIPLimitsGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWIPLimitsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	limits, err := c.boardService.GetWIPLimits(ctx, projectID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWIPLimitsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWIPLimitsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWIPLimitsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWIPLimitsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWIPLimitsGet200JSONResponse(wipLimitsToDTO(limits)), nil
+}
+
+func (c *boardController) V1ProjectWIPLimitsUpdate(ctx context.Context, request api.V1ProjectWIPLimitsUpdateRequestObject) (api.V1ProjectWIPLimitsUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWIPLimitsUpdate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWIPLimitsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectWIPLimitsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	limits := make([]model.WIPLimit, len(request.Body.Limits))
+	for i, limit := range request.Body.Limits {
+		limits[i] = model.WIPLimit{
+			GroupBy: model.BoardGroupBy(limit.GroupBy),
+			Column:  limit.Column,
+			Limit:   limit.Limit,
+		}
+	}
+
+	saved, err := c.boardService.SetWIPLimits(ctx, projectID, limits)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWIPLimitsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWIPLimitsUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWIPLimitsUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWIPLimitsUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWIPLimitsUpdate200JSONResponse(wipLimitsToDTO(saved)), nil
+}
+
+func (c *boardController) V1IssueBoardMove(ctx context.Context, request api.V1IssueBoardMoveRequestObject) (api.V1IssueBoardMoveResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueBoardMove")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueBoardMove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueBoardMove400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	// The card is positioned the same way as an issue in the backlog.
+	rankOpts, err := rankIssueJSONRequestBodyToRankIssueOpts(&api.V1IssueRankJSONRequestBody{
+		Before: request.Body.Before,
+		After:  request.Body.After,
+	})
+	if err != nil {
+		return api.V1IssueBoardMove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	move, err := c.issueService.MoveCard(ctx, issueID, service.MoveIssueCardOpts{
+		GroupBy: model.BoardGroupBy(request.Body.GroupBy),
+		Column:  request.Body.Column,
+		Before:  rankOpts.Before,
+		After:   rankOpts.After,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueBoardMove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueBoardMove403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueBoardMove404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueBoardMove500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueBoardMove200JSONResponse{
+		Issue:  issueToDTO(move.Issue),
+		Column: boardColumnSummaryToDTO(move.Column),
+	}, nil
+}
+
+// board lists the issues of the columns of the board of a project or
+// namespace matching the list options. A single column is listed if the
+// column is selected, which is required to continue listing a column.
+func (c *boardController) board(ctx context.Context, scope model.ID, opts service.IssueListOptions, params boardParams) (api.Board, error) {
+	groupBy := model.BoardGroupByStatus
+	if params.groupBy != nil {
+		groupBy = model.BoardGroupBy(*params.groupBy)
+	}
+
+	if params.pageToken != nil && params.column == nil {
+		return api.Board{}, errors.Join(service.ErrBoardColumn, errors.New("page_token requires column"))
+	}
+
+	pageSize := params.pageSize
+	if pageSize == nil {
+		pageSize = convert.ToPointer(DefaultBoardPageSize)
+	}
+	pageParams, err := cursorPageFromParams(pageSize, params.pageToken)
+	if err != nil {
+		return api.Board{}, err
+	}
+
+	columns, err := c.boardService.GetColumns(ctx, scope, groupBy)
+	if err != nil {
+		return api.Board{}, err
+	}
+
+	if params.column != nil {
+		var selected []*service.BoardColumn
+		for _, column := range columns {
+			if column.Value == *params.column {
+				selected = append(selected, column)
+			}
+		}
+		if len(selected) == 0 {
+			return api.Board{}, service.ErrBoardColumn
+		}
+		columns = selected
+	}
+
+	board := api.Board{
+		GroupBy: api.BoardGroupBy(groupBy),
+		Columns: make([]api.BoardColumn, len(columns)),
+	}
+
+	for i, column := range columns {
+		listCtx := service.WithIssueListOptions(ctx, opts.ForBoardColumn(groupBy, column.Value))
+
+		var page service.Page[*service.PartialIssue]
+		if scope.Type == model.ResourceTypeNamespace {
+			page, err = c.issueService.ListByNamespace(listCtx, scope, pageParams)
+		} else {
+			page, err = c.issueService.List(listCtx, scope, pageParams)
+		}
+		if err != nil {
+			return api.Board{}, err
+		}
+
+		issuesDTO := make([]api.PartialIssue, len(page.Items))
+		for j, issue := range page.Items {
+			issuesDTO[j] = partialIssueToDTO(issue)
+		}
+
+		summary := boardColumnSummaryToDTO(column)
+		board.Columns[i] = api.BoardColumn{
+			Value:       summary.Value,
+			IssueCount:  summary.IssueCount,
+			WipLimit:    summary.WipLimit,
+			WipExceeded: summary.WipExceeded,
+			Issues:      issuesDTO,
+			PageInfo:    pageInfoToDTO(page.PageInfo),
+		}
+	}
+
+	return board, nil
+}
+
+func boardColumnSummaryToDTO(column *service.BoardColumn) api.BoardColumnSummary {
+	return api.BoardColumnSummary{
+		Value:       column.Value,
+		IssueCount:  column.IssueCount,
+		WipLimit:    column.WIPLimit,
+		WipExceeded: column.WIPExceeded(),
+	}
+}
+
+func wipLimitsToDTO(limits model.WIPLimits) api.WIPLimits {
+	dto := api.WIPLimits{
+		Limits: make([]api.WIPLimit, len(limits)),
+	}
+
+	for i, limit := range limits {
+		dto.Limits[i] = api.WIPLimit{
+			GroupBy: api.BoardGroupBy(limit.GroupBy),
+			Column:  limit.Column,
+			Limit:   limit.Limit,
+		}
+	}
+
+	return dto
+}
+
+// NewBoardController creates a new BoardController.
+func NewBoardController(opts ...ControllerOption) (BoardController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.boardService == nil {
+		return nil, ErrNoBoardService
+	}
+
+	if controller.issueService == nil {
+		return nil, ErrNoIssueService
+	}
+
+	if controller.viewService == nil {
+		return nil, ErrNoViewService
+	}
+
+	return &boardController{
+		baseController: controller,
+	}, nil
+}
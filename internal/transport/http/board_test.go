@@ -0,0 +1,399 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestBoardController(t *testing.T, bs service.BoardService, is service.IssueService, vs service.ViewService) BoardController {
+	t.Helper()
+	c, err := NewBoardController(WithBoardService(bs), WithIssueService(is), WithViewService(vs))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceBoardColumns() []*service.BoardColumn {
+	return []*service.BoardColumn{
+		{Value: "open", IssueCount: convert.ToPointer(int64(1))},
+		{Value: "in progress", IssueCount: convert.ToPointer(int64(3)), WIPLimit: convert.ToPointer(2)},
+	}
+}
+
+func TestNewBoardController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewBoardController(
+			WithBoardService(service.NewMockBoardService(ctrl)),
+			WithIssueService(service.NewMockIssueService(ctrl)),
+			WithViewService(service.NewMockViewService(ctrl)),
+		)
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing board service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewBoardController()
+		assert.ErrorIs(t, err, ErrNoBoardService)
+	})
+
+	t.Run("missing issue service", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := NewBoardController(WithBoardService(service.NewMockBoardService(ctrl)))
+		assert.ErrorIs(t, err, ErrNoIssueService)
+	})
+
+	t.Run("missing view service", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := NewBoardController(
+			WithBoardService(service.NewMockBoardService(ctrl)),
+			WithIssueService(service.NewMockIssueService(ctrl)),
+		)
+		assert.ErrorIs(t, err, ErrNoViewService)
+	})
+}
+
+func TestBoardController_V1ProjectBoardGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		issue := newServicePartialIssue(newServiceIssue())
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().GetColumns(gomock.Any(), projectID, model.BoardGroupByStatus).Return(newServiceBoardColumns(), nil)
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().List(gomock.Any(), projectID, service.CursorPage{Size: DefaultBoardPageSize}).Return(service.Page[*service.PartialIssue]{
+			Items: []*service.PartialIssue{issue},
+		}, nil)
+		is.EXPECT().List(gomock.Any(), projectID, service.CursorPage{Size: DefaultBoardPageSize}).Return(service.Page[*service.PartialIssue]{
+			PageInfo: service.PageInfo{HasMore: true, NextPageToken: convert.ToPointer("next")},
+		}, nil)
+
+		c := newTestBoardController(t, bs, is, service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectBoardGet(context.Background(), api.V1ProjectBoardGetRequestObject{
+			Id:     projectID.String(),
+			Params: api.V1ProjectBoardGetParams{Query: convert.ToPointer("kind:bug")},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectBoardGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, api.BoardGroupByStatus, got.GroupBy)
+		require.Len(t, got.Columns, 2)
+		assert.Equal(t, "open", got.Columns[0].Value)
+		require.Len(t, got.Columns[0].Issues, 1)
+		assert.Equal(t, issue.ID.String(), got.Columns[0].Issues[0].Id)
+		assert.False(t, got.Columns[0].WipExceeded)
+		assert.Equal(t, int64(3), *got.Columns[1].IssueCount)
+		assert.Equal(t, 2, *got.Columns[1].WipLimit)
+		assert.True(t, got.Columns[1].WipExceeded)
+		assert.True(t, got.Columns[1].PageInfo.HasMore)
+	})
+
+	t.Run("single column", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().GetColumns(gomock.Any(), projectID, model.BoardGroupByStatus).Return(newServiceBoardColumns(), nil)
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().List(gomock.Any(), projectID, service.CursorPage{Size: 10, Token: convert.ToPointer("next")}).Return(service.Page[*service.PartialIssue]{}, nil)
+
+		c := newTestBoardController(t, bs, is, service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectBoardGet(context.Background(), api.V1ProjectBoardGetRequestObject{
+			Id: projectID.String(),
+			Params: api.V1ProjectBoardGetParams{
+				Column:    convert.ToPointer("in progress"),
+				PageSize:  convert.ToPointer(10),
+				PageToken: convert.ToPointer("next"),
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectBoardGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Columns, 1)
+		assert.Equal(t, "in progress", got.Columns[0].Value)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().GetColumns(gomock.Any(), projectID, model.BoardGroupByStatus).Return(newServiceBoardColumns(), nil)
+
+		c := newTestBoardController(t, bs, service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectBoardGet(context.Background(), api.V1ProjectBoardGetRequestObject{
+			Id:     projectID.String(),
+			Params: api.V1ProjectBoardGetParams{Column: convert.ToPointer("archived")},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectBoardGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("page token without column", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestBoardController(t, service.NewMockBoardService(ctrl), service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectBoardGet(context.Background(), api.V1ProjectBoardGetRequestObject{
+			Id:     projectID.String(),
+			Params: api.V1ProjectBoardGetParams{PageToken: convert.ToPointer("next")},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectBoardGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().GetColumns(gomock.Any(), projectID, model.BoardGroupByKind).Return(nil, errors.Join(service.ErrBoardGet, service.ErrNoPermission))
+
+		c := newTestBoardController(t, bs, service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectBoardGet(context.Background(), api.V1ProjectBoardGetRequestObject{
+			Id:     projectID.String(),
+			Params: api.V1ProjectBoardGetParams{GroupBy: convert.ToPointer(api.BoardGroupByKind)},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectBoardGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestBoardController_V1ViewBoardGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("namespace view", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		view := newServiceView(model.MustNewID(model.ResourceTypeNamespace))
+
+		vs := service.NewMockViewService(ctrl)
+		vs.EXPECT().Get(gomock.Any(), view.ID).Return(view, nil)
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().GetColumns(gomock.Any(), view.Scope, model.BoardGroupByPriority).Return([]*service.BoardColumn{
+			{Value: "high"},
+		}, nil)
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListByNamespace(gomock.Any(), view.Scope, gomock.Any()).Return(service.Page[*service.PartialIssue]{}, nil)
+
+		c := newTestBoardController(t, bs, is, vs)
+		resp, err := c.V1ViewBoardGet(context.Background(), api.V1ViewBoardGetRequestObject{
+			Id:     view.ID.String(),
+			Params: api.V1ViewBoardGetParams{GroupBy: convert.ToPointer(api.BoardGroupByPriority)},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ViewBoardGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Columns, 1)
+		assert.Nil(t, got.Columns[0].IssueCount)
+		assert.Nil(t, got.Columns[0].WipLimit)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		viewID := model.MustNewID(model.ResourceTypeView)
+
+		vs := service.NewMockViewService(ctrl)
+		vs.EXPECT().Get(gomock.Any(), viewID).Return(nil, errors.Join(service.ErrViewGet, repository.ErrNotFound))
+
+		c := newTestBoardController(t, service.NewMockBoardService(ctrl), service.NewMockIssueService(ctrl), vs)
+		resp, err := c.V1ViewBoardGet(context.Background(), api.V1ViewBoardGetRequestObject{Id: viewID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ViewBoardGet404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestBoardController_V1ProjectWIPLimitsGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	bs := service.NewMockBoardService(ctrl)
+	bs.EXPECT().GetWIPLimits(gomock.Any(), projectID).Return(model.WIPLimits{
+		{GroupBy: model.BoardGroupByStatus, Column: "in progress", Limit: 2},
+	}, nil)
+
+	c := newTestBoardController(t, bs, service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+	resp, err := c.V1ProjectWIPLimitsGet(context.Background(), api.V1ProjectWIPLimitsGetRequestObject{Id: projectID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1ProjectWIPLimitsGet200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, []api.WIPLimit{
+		{GroupBy: api.BoardGroupByStatus, Column: "in progress", Limit: 2},
+	}, got.Limits)
+}
+
+func TestBoardController_V1ProjectWIPLimitsUpdate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	limits := []model.WIPLimit{
+		{GroupBy: model.BoardGroupByWorkflowStatus, Column: "in_review", Limit: 3},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().SetWIPLimits(gomock.Any(), projectID, limits).Return(model.WIPLimits(limits), nil)
+
+		c := newTestBoardController(t, bs, service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectWIPLimitsUpdate(context.Background(), api.V1ProjectWIPLimitsUpdateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectWIPLimitsUpdateJSONRequestBody{
+				Limits: []api.WIPLimit{{GroupBy: api.BoardGroupByWorkflowStatus, Column: "in_review", Limit: 3}},
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectWIPLimitsUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Len(t, got.Limits, 1)
+	})
+
+	t.Run("invalid limits", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bs := service.NewMockBoardService(ctrl)
+		bs.EXPECT().SetWIPLimits(gomock.Any(), projectID, gomock.Any()).Return(nil, errors.Join(service.ErrWIPLimitSet, model.ErrInvalidWIPLimitDetails))
+
+		c := newTestBoardController(t, bs, service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+		resp, err := c.V1ProjectWIPLimitsUpdate(context.Background(), api.V1ProjectWIPLimitsUpdateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectWIPLimitsUpdateJSONRequestBody{
+				Limits: []api.WIPLimit{{GroupBy: api.BoardGroupByKind, Column: "chore", Limit: 3}},
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectWIPLimitsUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestBoardController_V1IssueBoardMove(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	afterID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().MoveCard(gomock.Any(), issue.ID, service.MoveIssueCardOpts{
+			GroupBy: model.BoardGroupByStatus,
+			Column:  "in progress",
+			After:   &afterID,
+		}).Return(&service.IssueCardMove{Issue: issue, Column: newServiceBoardColumns()[1]}, nil)
+
+		c := newTestBoardController(t, service.NewMockBoardService(ctrl), is, service.NewMockViewService(ctrl))
+		resp, err := c.V1IssueBoardMove(context.Background(), api.V1IssueBoardMoveRequestObject{
+			Id: issue.ID.String(),
+			Body: &api.V1IssueBoardMoveJSONRequestBody{
+				GroupBy: api.BoardGroupByStatus,
+				Column:  "in progress",
+				After:   convert.ToPointer(afterID.String()),
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueBoardMove200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, issue.ID.String(), got.Issue.Id)
+		assert.Equal(t, "in progress", got.Column.Value)
+		assert.True(t, got.Column.WipExceeded)
+	})
+
+	t.Run("invalid anchor", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestBoardController(t, service.NewMockBoardService(ctrl), service.NewMockIssueService(ctrl), service.NewMockViewService(ctrl))
+		resp, err := c.V1IssueBoardMove(context.Background(), api.V1IssueBoardMoveRequestObject{
+			Id: issue.ID.String(),
+			Body: &api.V1IssueBoardMoveJSONRequestBody{
+				GroupBy: api.BoardGroupByStatus,
+				Column:  "done",
+				Before:  convert.ToPointer("bad"),
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueBoardMove400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().MoveCard(gomock.Any(), issue.ID, gomock.Any()).Return(nil, errors.Join(service.ErrIssueMoveCard, service.ErrBoardColumn))
+
+		c := newTestBoardController(t, service.NewMockBoardService(ctrl), is, service.NewMockViewService(ctrl))
+		resp, err := c.V1IssueBoardMove(context.Background(), api.V1IssueBoardMoveRequestObject{
+			Id: issue.ID.String(),
+			Body: &api.V1IssueBoardMoveJSONRequestBody{
+				GroupBy: api.BoardGroupByKind,
+				Column:  "chore",
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueBoardMove400JSONResponse)
+		assert.True(t, ok)
+	})
+}
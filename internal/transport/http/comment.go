@@ -0,0 +1,345 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// CommentController is the controller for issue and document comment
+// endpoints.
+type CommentController interface {
+	V1IssueCommentsGet(ctx context.Context, request api.V1IssueCommentsGetRequestObject) (api.V1IssueCommentsGetResponseObject, error)
+	V1IssueCommentsCreate(ctx context.Context, request api.V1IssueCommentsCreateRequestObject) (api.V1IssueCommentsCreateResponseObject, error)
+	V1IssueCommentUpdate(ctx context.Context, request api.V1IssueCommentUpdateRequestObject) (api.V1IssueCommentUpdateResponseObject, error)
+	V1IssueCommentDelete(ctx context.Context, request api.V1IssueCommentDeleteRequestObject) (api.V1IssueCommentDeleteResponseObject, error)
+	V1DocumentCommentsGet(ctx context.Context, request api.V1DocumentCommentsGetRequestObject) (api.V1DocumentCommentsGetResponseObject, error)
+	V1DocumentCommentsCreate(ctx context.Context, request api.V1DocumentCommentsCreateRequestObject) (api.V1DocumentCommentsCreateResponseObject, error)
+	V1DocumentCommentUpdate(ctx context.Context, request api.V1DocumentCommentUpdateRequestObject) (api.V1DocumentCommentUpdateResponseObject, error)
+	V1DocumentCommentDelete(ctx context.Context, request api.V1DocumentCommentDeleteRequestObject) (api.V1DocumentCommentDeleteResponseObject, error)
+}
+
+// commentController is the concrete implementation of CommentController.
+type commentController struct {
+	*baseController
+}
+
+func (c *commentController) V1IssueCommentsGet(ctx context.Context, request api.V1IssueCommentsGetRequestObject) (api.V1IssueCommentsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueCommentsGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueCommentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1IssueCommentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.commentService.ListBelongsTo(ctx, issueID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueCommentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueCommentsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueCommentsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueCommentsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueCommentsGet200JSONResponse(commentPageToDTO(page)), nil
+}
+
+func (c *commentController) V1IssueCommentsCreate(ctx context.Context, request api.V1IssueCommentsCreateRequestObject) (api.V1IssueCommentsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueCommentsCreate")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueCommentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueCommentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	comment, err := c.commentService.Create(ctx, issueID, service.CreateCommentOpts{
+		Content: request.Body.Content,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueCommentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueCommentsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueCommentsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueCommentsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueCommentsCreate201JSONResponse(commentToDTO(comment)), nil
+}
+
+func (c *commentController) V1IssueCommentUpdate(ctx context.Context, request api.V1IssueCommentUpdateRequestObject) (api.V1IssueCommentUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueCommentUpdate")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	commentID, err := model.NewIDFromString(request.CommentId, model.ResourceTypeComment.String())
+	if err != nil {
+		return api.V1IssueCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	comment, err := c.commentService.Update(ctx, issueID, commentID, service.UpdateCommentOpts{
+		Content: request.Body.Content,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueCommentUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueCommentUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueCommentUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueCommentUpdate200JSONResponse(commentToDTO(comment)), nil
+}
+
+func (c *commentController) V1IssueCommentDelete(ctx context.Context, request api.V1IssueCommentDeleteRequestObject) (api.V1IssueCommentDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueCommentDelete")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueCommentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	commentID, err := model.NewIDFromString(request.CommentId, model.ResourceTypeComment.String())
+	if err != nil {
+		return api.V1IssueCommentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.commentService.Delete(ctx, issueID, commentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueCommentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueCommentDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueCommentDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueCommentDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueCommentDelete204Response{}, nil
+}
+
+func (c *commentController) V1DocumentCommentsGet(ctx context.Context, request api.V1DocumentCommentsGetRequestObject) (api.V1DocumentCommentsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentCommentsGet")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentCommentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1DocumentCommentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.commentService.ListBelongsTo(ctx, documentID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentCommentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentCommentsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentCommentsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentCommentsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentCommentsGet200JSONResponse(commentPageToDTO(page)), nil
+}
+
+func (c *commentController) V1DocumentCommentsCreate(ctx context.Context, request api.V1DocumentCommentsCreateRequestObject) (api.V1DocumentCommentsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentCommentsCreate")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentCommentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1DocumentCommentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	comment, err := c.commentService.Create(ctx, documentID, service.CreateCommentOpts{
+		Content: request.Body.Content,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentCommentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentCommentsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentCommentsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentCommentsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentCommentsCreate201JSONResponse(commentToDTO(comment)), nil
+}
+
+func (c *commentController) V1DocumentCommentUpdate(ctx context.Context, request api.V1DocumentCommentUpdateRequestObject) (api.V1DocumentCommentUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentCommentUpdate")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	commentID, err := model.NewIDFromString(request.CommentId, model.ResourceTypeComment.String())
+	if err != nil {
+		return api.V1DocumentCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1DocumentCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	comment, err := c.commentService.Update(ctx, documentID, commentID, service.UpdateCommentOpts{
+		Content: request.Body.Content,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentCommentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentCommentUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentCommentUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentCommentUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentCommentUpdate200JSONResponse(commentToDTO(comment)), nil
+}
+
+func (c *commentController) V1DocumentCommentDelete(ctx context.Context, request api.V1DocumentCommentDeleteRequestObject) (api.V1DocumentCommentDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentCommentDelete")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentCommentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	commentID, err := model.NewIDFromString(request.CommentId, model.ResourceTypeComment.String())
+	if err != nil {
+		return api.V1DocumentCommentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.commentService.Delete(ctx, documentID, commentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentCommentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentCommentDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentCommentDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentCommentDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentCommentDelete204Response{}, nil
+}
+
+func commentToDTO(comment *service.Comment) api.Comment {
+	createdAt := time.Time{}
+	if comment.CreatedAt != nil {
+		createdAt = *comment.CreatedAt
+	}
+
+	return api.Comment{
+		Id:        comment.ID.String(),
+		Content:   comment.Content,
+		CreatedBy: comment.CreatedBy.String(),
+		CreatedAt: createdAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
+
+func commentPageToDTO(page service.Page[*service.Comment]) api.CommentPage {
+	items := make([]api.Comment, len(page.Items))
+	for i, comment := range page.Items {
+		items[i] = commentToDTO(comment)
+	}
+
+	return api.CommentPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+// NewCommentController creates a new CommentController.
+func NewCommentController(opts ...ControllerOption) (CommentController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.commentService == nil {
+		return nil, ErrNoCommentService
+	}
+
+	return &commentController{
+		baseController: controller,
+	}, nil
+}
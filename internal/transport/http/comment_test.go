@@ -0,0 +1,324 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestCommentController(t *testing.T, cs service.CommentService) CommentController {
+	t.Helper()
+	c, err := NewCommentController(WithCommentService(cs))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceComment(belongsTo model.ID) *service.Comment {
+	return &service.Comment{
+		ID:        model.MustNewID(model.ResourceTypeComment),
+		BelongsTo: belongsTo,
+		Content:   "This looks good to me.",
+		CreatedBy: model.MustNewID(model.ResourceTypeUser),
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewCommentController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewCommentController(WithCommentService(service.NewMockCommentService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing comment service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewCommentController()
+		assert.ErrorIs(t, err, ErrNoCommentService)
+	})
+}
+
+func TestCommentController_V1IssueCommentsGet(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	comment := newServiceComment(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().ListBelongsTo(gomock.Any(), issueID, gomock.Any()).Return(service.Page[*service.Comment]{
+			Items: []*service.Comment{comment},
+		}, nil)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1IssueCommentsGet(context.Background(), api.V1IssueCommentsGetRequestObject{
+			Id: issueID.String(),
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueCommentsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, comment.ID.String(), got.Items[0].Id)
+		assert.Equal(t, comment.Content, got.Items[0].Content)
+		assert.Equal(t, comment.CreatedBy.String(), got.Items[0].CreatedBy)
+	})
+
+	t.Run("bad issue id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestCommentController(t, service.NewMockCommentService(ctrl))
+		resp, err := c.V1IssueCommentsGet(context.Background(), api.V1IssueCommentsGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueCommentsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().ListBelongsTo(gomock.Any(), issueID, gomock.Any()).Return(
+			service.Page[*service.Comment]{},
+			errors.Join(service.ErrCommentGetAll, service.ErrNoPermission),
+		)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1IssueCommentsGet(context.Background(), api.V1IssueCommentsGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueCommentsGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestCommentController_V1IssueCommentsCreate(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	comment := newServiceComment(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().Create(gomock.Any(), issueID, service.CreateCommentOpts{Content: comment.Content}).Return(comment, nil)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1IssueCommentsCreate(context.Background(), api.V1IssueCommentsCreateRequestObject{
+			Id:   issueID.String(),
+			Body: &api.V1IssueCommentsCreateJSONRequestBody{Content: comment.Content},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueCommentsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, comment.ID.String(), got.Id)
+	})
+
+	t.Run("missing body", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestCommentController(t, service.NewMockCommentService(ctrl))
+		resp, err := c.V1IssueCommentsCreate(context.Background(), api.V1IssueCommentsCreateRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueCommentsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid content", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().Create(gomock.Any(), issueID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrCommentCreate, model.ErrInvalidCommentDetails),
+		)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1IssueCommentsCreate(context.Background(), api.V1IssueCommentsCreateRequestObject{
+			Id:   issueID.String(),
+			Body: &api.V1IssueCommentsCreateJSONRequestBody{Content: "hi"},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueCommentsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestCommentController_V1IssueCommentUpdate(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	comment := newServiceComment(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().Update(gomock.Any(), issueID, comment.ID, service.UpdateCommentOpts{Content: comment.Content}).Return(comment, nil)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1IssueCommentUpdate(context.Background(), api.V1IssueCommentUpdateRequestObject{
+			Id:        issueID.String(),
+			CommentId: comment.ID.String(),
+			Body:      &api.V1IssueCommentUpdateJSONRequestBody{Content: comment.Content},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueCommentUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, comment.ID.String(), got.Id)
+	})
+
+	t.Run("bad comment id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestCommentController(t, service.NewMockCommentService(ctrl))
+		resp, err := c.V1IssueCommentUpdate(context.Background(), api.V1IssueCommentUpdateRequestObject{
+			Id:        issueID.String(),
+			CommentId: "bad",
+			Body:      &api.V1IssueCommentUpdateJSONRequestBody{Content: comment.Content},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueCommentUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("not the author", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().Update(gomock.Any(), issueID, comment.ID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrCommentUpdate, service.ErrNoPermission),
+		)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1IssueCommentUpdate(context.Background(), api.V1IssueCommentUpdateRequestObject{
+			Id:        issueID.String(),
+			CommentId: comment.ID.String(),
+			Body:      &api.V1IssueCommentUpdateJSONRequestBody{Content: comment.Content},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueCommentUpdate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestCommentController_V1DocumentCommentDelete(t *testing.T) {
+	t.Parallel()
+
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	comment := newServiceComment(documentID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().Delete(gomock.Any(), documentID, comment.ID).Return(nil)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1DocumentCommentDelete(context.Background(), api.V1DocumentCommentDeleteRequestObject{
+			Id:        documentID.String(),
+			CommentId: comment.ID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentCommentDelete204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().Delete(gomock.Any(), documentID, comment.ID).Return(
+			errors.Join(service.ErrCommentDelete, repository.ErrNotFound),
+		)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1DocumentCommentDelete(context.Background(), api.V1DocumentCommentDeleteRequestObject{
+			Id:        documentID.String(),
+			CommentId: comment.ID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentCommentDelete404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestCommentController_V1DocumentCommentsGet(t *testing.T) {
+	t.Parallel()
+
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	comment := newServiceComment(documentID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		cs.EXPECT().ListBelongsTo(gomock.Any(), documentID, gomock.Any()).Return(service.Page[*service.Comment]{
+			Items: []*service.Comment{comment},
+		}, nil)
+
+		c := newTestCommentController(t, cs)
+		resp, err := c.V1DocumentCommentsGet(context.Background(), api.V1DocumentCommentsGetRequestObject{
+			Id: documentID.String(),
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1DocumentCommentsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, comment.ID.String(), got.Items[0].Id)
+	})
+
+	t.Run("bad document id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestCommentController(t, service.NewMockCommentService(ctrl))
+		resp, err := c.V1DocumentCommentsGet(context.Background(), api.V1DocumentCommentsGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentCommentsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+}
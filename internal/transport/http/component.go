@@ -0,0 +1,280 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// ComponentController is the controller for the project component
+// endpoints.
+type ComponentController interface {
+	V1ProjectComponentsGet(ctx context.Context, request api.V1ProjectComponentsGetRequestObject) (api.V1ProjectComponentsGetResponseObject, error)
+	V1ProjectComponentsCreate(ctx context.Context, request api.V1ProjectComponentsCreateRequestObject) (api.V1ProjectComponentsCreateResponseObject, error)
+	V1ComponentGet(ctx context.Context, request api.V1ComponentGetRequestObject) (api.V1ComponentGetResponseObject, error)
+	V1ComponentUpdate(ctx context.Context, request api.V1ComponentUpdateRequestObject) (api.V1ComponentUpdateResponseObject, error)
+	V1ComponentDelete(ctx context.Context, request api.V1ComponentDeleteRequestObject) (api.V1ComponentDeleteResponseObject, error)
+}
+
+// componentController is the concrete implementation of ComponentController.
+type componentController struct {
+	*baseController
+}
+
+func (c *componentController) V1ProjectComponentsGet(ctx context.Context, request api.V1ProjectComponentsGetRequestObject) (api.V1ProjectComponentsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectComponentsGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectComponentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ProjectComponentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.componentService.List(ctx, projectID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectComponentsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectComponentsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectComponentsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectComponentsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectComponentsGet200JSONResponse(componentPageToDTO(page)), nil
+}
+
+func (c *componentController) V1ProjectComponentsCreate(ctx context.Context, request api.V1ProjectComponentsCreateRequestObject) (api.V1ProjectComponentsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectComponentsCreate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectComponentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectComponentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := createComponentJSONRequestBodyToCreateComponentOpts(request.Body)
+	if err != nil {
+		return api.V1ProjectComponentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	component, err := c.componentService.Create(ctx, projectID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectComponentsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectComponentsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectComponentsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectComponentsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectComponentsCreate201JSONResponse(componentToDTO(component)), nil
+}
+
+func (c *componentController) V1ComponentGet(ctx context.Context, request api.V1ComponentGetRequestObject) (api.V1ComponentGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ComponentGet")
+	defer span.End()
+
+	componentID, err := model.NewIDFromString(request.Id, model.ResourceTypeComponent.String())
+	if err != nil {
+		return api.V1ComponentGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	component, err := c.componentService.Get(ctx, componentID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ComponentGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ComponentGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ComponentGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ComponentGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ComponentGet200JSONResponse(componentToDTO(component)), nil
+}
+
+func (c *componentController) V1ComponentUpdate(ctx context.Context, request api.V1ComponentUpdateRequestObject) (api.V1ComponentUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ComponentUpdate")
+	defer span.End()
+
+	componentID, err := model.NewIDFromString(request.Id, model.ResourceTypeComponent.String())
+	if err != nil {
+		return api.V1ComponentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ComponentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := updateComponentJSONRequestBodyToUpdateComponentOpts(request.Body)
+	if err != nil {
+		return api.V1ComponentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	component, err := c.componentService.Update(ctx, componentID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ComponentUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ComponentUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ComponentUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ComponentUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ComponentUpdate200JSONResponse(componentToDTO(component)), nil
+}
+
+func (c *componentController) V1ComponentDelete(ctx context.Context, request api.V1ComponentDeleteRequestObject) (api.V1ComponentDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ComponentDelete")
+	defer span.End()
+
+	componentID, err := model.NewIDFromString(request.Id, model.ResourceTypeComponent.String())
+	if err != nil {
+		return api.V1ComponentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.componentService.Delete(ctx, componentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ComponentDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ComponentDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ComponentDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ComponentDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ComponentDelete204Response{}, nil
+}
+
+func createComponentJSONRequestBodyToCreateComponentOpts(body *api.V1ProjectComponentsCreateJSONRequestBody) (service.CreateComponentOpts, error) {
+	opts := service.CreateComponentOpts{
+		Name: body.Name,
+	}
+
+	if body.Description != nil {
+		opts.Description = *body.Description
+	}
+
+	if body.Lead != nil && *body.Lead != "" {
+		lead, err := model.NewIDFromString(*body.Lead, model.ResourceTypeUser.String())
+		if err != nil {
+			return service.CreateComponentOpts{}, err
+		}
+		opts.Lead = &lead
+	}
+
+	return opts, nil
+}
+
+func updateComponentJSONRequestBodyToUpdateComponentOpts(body *api.V1ComponentUpdateJSONRequestBody) (service.UpdateComponentOpts, error) {
+	opts := service.UpdateComponentOpts{
+		Name:        body.Name,
+		Description: body.Description,
+	}
+
+	if body.Lead.Defined {
+		if body.Lead.Value == nil {
+			opts.Lead = optional.Null[model.ID]()
+		} else {
+			lead, err := model.NewIDFromString(*body.Lead.Value, model.ResourceTypeUser.String())
+			if err != nil {
+				return service.UpdateComponentOpts{}, err
+			}
+			opts.Lead = optional.Some(lead)
+		}
+	}
+
+	return opts, nil
+}
+
+func componentToDTO(component *service.Component) api.Component {
+	createdAt := time.Time{}
+	if component.CreatedAt != nil {
+		createdAt = *component.CreatedAt
+	}
+
+	var lead *string
+	if component.Lead != nil {
+		lead = convert.ToPointer(component.Lead.String())
+	}
+
+	return api.Component{
+		Id:          component.ID.String(),
+		Project:     component.Project.String(),
+		Name:        component.Name,
+		Description: component.Description,
+		Lead:        lead,
+		CreatedAt:   createdAt,
+		UpdatedAt:   component.UpdatedAt,
+	}
+}
+
+func componentPageToDTO(page service.Page[*service.Component]) api.ComponentPage {
+	items := make([]api.Component, len(page.Items))
+	for i, component := range page.Items {
+		items[i] = componentToDTO(component)
+	}
+
+	return api.ComponentPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+// NewComponentController creates a new ComponentController.
+func NewComponentController(opts ...ControllerOption) (ComponentController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.componentService == nil {
+		return nil, ErrNoComponentService
+	}
+
+	return &componentController{
+		baseController: controller,
+	}, nil
+}
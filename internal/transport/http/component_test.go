@@ -0,0 +1,275 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestComponentController(t *testing.T, cs service.ComponentService) ComponentController {
+	t.Helper()
+	c, err := NewComponentController(WithComponentService(cs))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceComponent(projectID model.ID) *service.Component {
+	lead := model.MustNewID(model.ResourceTypeUser)
+	return &service.Component{
+		ID:        model.MustNewID(model.ResourceTypeComponent),
+		Project:   projectID,
+		Name:      "Backend",
+		Lead:      &lead,
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewComponentController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewComponentController(WithComponentService(service.NewMockComponentService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing component service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewComponentController()
+		assert.ErrorIs(t, err, ErrNoComponentService)
+	})
+}
+
+func TestComponentController_V1ProjectComponentsGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	component := newServiceComponent(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().List(gomock.Any(), projectID, gomock.Any()).Return(service.Page[*service.Component]{
+			Items: []*service.Component{component},
+		}, nil)
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ProjectComponentsGet(context.Background(), api.V1ProjectComponentsGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectComponentsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, component.ID.String(), got.Items[0].Id)
+		assert.Equal(t, component.Lead.String(), *got.Items[0].Lead)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().List(gomock.Any(), projectID, gomock.Any()).Return(
+			service.Page[*service.Component]{},
+			errors.Join(service.ErrComponentGetAll, service.ErrNoPermission),
+		)
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ProjectComponentsGet(context.Background(), api.V1ProjectComponentsGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectComponentsGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestComponentController_V1ProjectComponentsCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	component := newServiceComponent(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().Create(gomock.Any(), projectID, service.CreateComponentOpts{
+			Name: component.Name,
+			Lead: component.Lead,
+		}).Return(component, nil)
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ProjectComponentsCreate(context.Background(), api.V1ProjectComponentsCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectComponentsCreateJSONRequestBody{
+				Name: component.Name,
+				Lead: convert.ToPointer(component.Lead.String()),
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectComponentsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, component.ID.String(), got.Id)
+		assert.Equal(t, projectID.String(), got.Project)
+	})
+
+	t.Run("invalid lead", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestComponentController(t, service.NewMockComponentService(ctrl))
+		resp, err := c.V1ProjectComponentsCreate(context.Background(), api.V1ProjectComponentsCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectComponentsCreateJSONRequestBody{
+				Name: component.Name,
+				Lead: convert.ToPointer("bad"),
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectComponentsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("unlicensed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().Create(gomock.Any(), projectID, gomock.Any()).Return(nil, errors.Join(service.ErrComponentCreate, service.ErrQuotaExceeded))
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ProjectComponentsCreate(context.Background(), api.V1ProjectComponentsCreateRequestObject{
+			Id:   projectID.String(),
+			Body: &api.V1ProjectComponentsCreateJSONRequestBody{Name: component.Name},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectComponentsCreate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestComponentController_V1ComponentUpdate(t *testing.T) {
+	t.Parallel()
+
+	component := newServiceComponent(model.MustNewID(model.ResourceTypeProject))
+
+	t.Run("remove lead", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().Update(gomock.Any(), component.ID, service.UpdateComponentOpts{
+			Lead: optional.Null[model.ID](),
+		}).Return(&service.Component{ID: component.ID, Project: component.Project, Name: component.Name}, nil)
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ComponentUpdate(context.Background(), api.V1ComponentUpdateRequestObject{
+			Id:   component.ID.String(),
+			Body: &api.V1ComponentUpdateJSONRequestBody{Lead: optional.Null[string]()},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ComponentUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Nil(t, got.Lead)
+	})
+
+	t.Run("invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().Update(gomock.Any(), component.ID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrComponentUpdate, model.ErrInvalidComponentDetails),
+		)
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ComponentUpdate(context.Background(), api.V1ComponentUpdateRequestObject{
+			Id:   component.ID.String(),
+			Body: &api.V1ComponentUpdateJSONRequestBody{Name: optional.Some("")},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ComponentUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestComponentController_V1ComponentDelete(t *testing.T) {
+	t.Parallel()
+
+	componentID := model.MustNewID(model.ResourceTypeComponent)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().Delete(gomock.Any(), componentID).Return(nil)
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ComponentDelete(context.Background(), api.V1ComponentDeleteRequestObject{Id: componentID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ComponentDelete204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockComponentService(ctrl)
+		cs.EXPECT().Delete(gomock.Any(), componentID).Return(errors.Join(service.ErrComponentDelete, repository.ErrNotFound))
+
+		c := newTestComponentController(t, cs)
+		resp, err := c.V1ComponentDelete(context.Background(), api.V1ComponentDeleteRequestObject{Id: componentID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ComponentDelete404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueListOptionsFromParams_Components(t *testing.T) {
+	t.Parallel()
+
+	t.Run("filter by components", func(t *testing.T) {
+		t.Parallel()
+
+		componentID := model.MustNewID(model.ResourceTypeComponent)
+		opts, err := issueListOptionsFromParams(nil, nil, nil, nil, nil, nil, &api.IssueListComponent{componentID.String()})
+		require.NoError(t, err)
+		assert.Equal(t, []model.ID{componentID}, opts.Filter.Components)
+	})
+
+	t.Run("invalid component", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := issueListOptionsFromParams(nil, nil, nil, nil, nil, nil, &api.IssueListComponent{"bad"})
+		assert.Error(t, err)
+	})
+}
@@ -194,6 +194,19 @@ func WithNotificationService(notificationService service.NotificationService) Co
 	}
 }
 
+// WithEventService sets the event service for the controller.
+func WithEventService(eventService service.EventService) ControllerOption {
+	return func(c *baseController) error {
+		if eventService == nil {
+			return ErrNoEventService
+		}
+
+		c.eventService = eventService
+
+		return nil
+	}
+}
+
 // WithSearchService sets the search service for the controller.
 func WithSearchService(searchService service.SearchService) ControllerOption {
 	return func(c *baseController) error {
@@ -285,6 +298,153 @@ func WithLabelService(labelService service.LabelService) ControllerOption {
 	}
 }
 
+// WithAttachmentService sets the attachment service for the controller.
+func WithAttachmentService(attachmentService service.AttachmentService) ControllerOption {
+	return func(c *baseController) error {
+		if attachmentService == nil {
+			return ErrNoAttachmentService
+		}
+
+		c.attachmentService = attachmentService
+
+		return nil
+	}
+}
+
+// WithCommentService sets the comment service for the controller.
+func WithCommentService(commentService service.CommentService) ControllerOption {
+	return func(c *baseController) error {
+		if commentService == nil {
+			return ErrNoCommentService
+		}
+
+		c.commentService = commentService
+
+		return nil
+	}
+}
+
+// WithWebhookService sets the webhook service for the controller.
+func WithWebhookService(webhookService service.WebhookService) ControllerOption {
+	return func(c *baseController) error {
+		if webhookService == nil {
+			return ErrNoWebhookService
+		}
+
+		c.webhookService = webhookService
+		return nil
+	}
+}
+
+// WithWorkflowService sets the workflow service for the controller.
+func WithWorkflowService(workflowService service.WorkflowService) ControllerOption {
+	return func(c *baseController) error {
+		if workflowService == nil {
+			return ErrNoWorkflowService
+		}
+
+		c.workflowService = workflowService
+		return nil
+	}
+}
+
+// WithCustomFieldService sets the custom field service for the controller.
+func WithCustomFieldService(customFieldService service.CustomFieldService) ControllerOption {
+	return func(c *baseController) error {
+		if customFieldService == nil {
+			return ErrNoCustomFieldService
+		}
+
+		c.customFieldService = customFieldService
+		return nil
+	}
+}
+
+// WithComponentService sets the component service for the controller.
+func WithComponentService(componentService service.ComponentService) ControllerOption {
+	return func(c *baseController) error {
+		if componentService == nil {
+			return ErrNoComponentService
+		}
+
+		c.componentService = componentService
+		return nil
+	}
+}
+
+// WithViewService sets the view service for the controller.
+func WithViewService(viewService service.ViewService) ControllerOption {
+	return func(c *baseController) error {
+		if viewService == nil {
+			return ErrNoViewService
+		}
+
+		c.viewService = viewService
+		return nil
+	}
+}
+
+// WithBoardService sets the board service for the controller.
+func WithBoardService(boardService service.BoardService) ControllerOption {
+	return func(c *baseController) error {
+		if boardService == nil {
+			return ErrNoBoardService
+		}
+
+		c.boardService = boardService
+		return nil
+	}
+}
+
+// WithIssueTemplateService sets the issue template service for the
+// controller.
+func WithIssueTemplateService(issueTemplateService service.IssueTemplateService) ControllerOption {
+	return func(c *baseController) error {
+		if issueTemplateService == nil {
+			return ErrNoIssueTemplateService
+		}
+
+		c.issueTemplateService = issueTemplateService
+		return nil
+	}
+}
+
+// WithReleaseService sets the release service for the controller.
+func WithReleaseService(releaseService service.ReleaseService) ControllerOption {
+	return func(c *baseController) error {
+		if releaseService == nil {
+			return ErrNoReleaseService
+		}
+
+		c.releaseService = releaseService
+		return nil
+	}
+}
+
+// WithSprintService sets the sprint service for the controller.
+func WithSprintService(sprintService service.SprintService) ControllerOption {
+	return func(c *baseController) error {
+		if sprintService == nil {
+			return ErrNoSprintService
+		}
+
+		c.sprintService = sprintService
+		return nil
+	}
+}
+
+// WithWorkLogService sets the work log service for the controller.
+func WithWorkLogService(workLogService service.WorkLogService) ControllerOption {
+	return func(c *baseController) error {
+		if workLogService == nil {
+			return ErrNoWorkLogService
+		}
+
+		c.workLogService = workLogService
+		return nil
+	}
+}
+
 // baseController defines the dependencies that are required to be injected
 // into a controller.
 type baseController struct {
@@ -294,23 +454,36 @@ type baseController struct {
 
 	authProvider *authServer.Server
 
-	organizationService service.OrganizationService
-	namespaceService    service.NamespaceService
-	projectService      service.ProjectService
-	issueService        service.IssueService
-	documentService     service.DocumentService
-	folderService       service.FolderService
-	labelService        service.LabelService
-	roleService         service.RoleService
-	teamService         service.TeamService
-	userService         service.UserService
-	emailService        service.EmailService
-	todoService         service.TodoService
-	systemService       service.SystemService
-	licenseService      service.LicenseService
-	permissionService   service.PermissionService
-	notificationService service.NotificationService
-	searchService       service.SearchService
+	organizationService  service.OrganizationService
+	namespaceService     service.NamespaceService
+	projectService       service.ProjectService
+	issueService         service.IssueService
+	documentService      service.DocumentService
+	folderService        service.FolderService
+	labelService         service.LabelService
+	commentService       service.CommentService
+	attachmentService    service.AttachmentService
+	roleService          service.RoleService
+	teamService          service.TeamService
+	userService          service.UserService
+	emailService         service.EmailService
+	todoService          service.TodoService
+	systemService        service.SystemService
+	licenseService       service.LicenseService
+	permissionService    service.PermissionService
+	notificationService  service.NotificationService
+	eventService         service.EventService
+	searchService        service.SearchService
+	webhookService       service.WebhookService
+	workflowService      service.WorkflowService
+	customFieldService   service.CustomFieldService
+	componentService     service.ComponentService
+	issueTemplateService service.IssueTemplateService
+	viewService          service.ViewService
+	boardService         service.BoardService
+	releaseService       service.ReleaseService
+	sprintService        service.SprintService
+	workLogService       service.WorkLogService
 }
 
 // newController creates a new base controller with the given dependencies
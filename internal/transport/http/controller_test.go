@@ -80,6 +80,52 @@ func TestWithLabelService(t *testing.T) {
 	})
 }
 
+func TestWithAttachmentService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("set attachment service", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		as := service.NewMockAttachmentService(ctrl)
+		var c baseController
+		err := WithAttachmentService(as)(&c)
+		require.NoError(t, err)
+		assert.Equal(t, as, c.attachmentService)
+	})
+
+	t.Run("nil attachment service", func(t *testing.T) {
+		t.Parallel()
+		var c baseController
+		err := WithAttachmentService(nil)(&c)
+		assert.ErrorIs(t, err, ErrNoAttachmentService)
+	})
+}
+
+func TestWithCommentService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("set comment service", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCommentService(ctrl)
+		var c baseController
+		err := WithCommentService(cs)(&c)
+		require.NoError(t, err)
+		assert.Equal(t, cs, c.commentService)
+	})
+
+	t.Run("nil comment service", func(t *testing.T) {
+		t.Parallel()
+		var c baseController
+		err := WithCommentService(nil)(&c)
+		assert.ErrorIs(t, err, ErrNoCommentService)
+	})
+}
+
 func TestWithEmailService(t *testing.T) {
 	t.Parallel()
 
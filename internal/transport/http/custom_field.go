@@ -0,0 +1,151 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// CustomFieldController is the controller for the project custom field
+// endpoints.
+type CustomFieldController interface {
+	V1ProjectCustomFieldsGet(ctx context.Context, request api.V1ProjectCustomFieldsGetRequestObject) (api.V1ProjectCustomFieldsGetResponseObject, error)
+	V1ProjectCustomFieldsUpdate(ctx context.Context, request api.V1ProjectCustomFieldsUpdateRequestObject) (api.V1ProjectCustomFieldsUpdateResponseObject, error)
+}
+
+// customFieldController is the concrete implementation of
+// CustomFieldController.
+type customFieldController struct {
+	*baseController
+}
+
+func (c *customFieldController) V1ProjectCustomFieldsGet(ctx context.Context, request api.V1ProjectCustomFieldsGetRequestObject) (api.V1ProjectCustomFieldsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectCustomFieldsGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectCustomFieldsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	fields, err := c.customFieldService.List(ctx, projectID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectCustomFieldsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectCustomFieldsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectCustomFieldsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectCustomFieldsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectCustomFieldsGet200JSONResponse(customFieldsToDTO(fields)), nil
+}
+
+func (c *customFieldController) V1ProjectCustomFieldsUpdate(ctx context.Context, request api.V1ProjectCustomFieldsUpdateRequestObject) (api.V1ProjectCustomFieldsUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectCustomFieldsUpdate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectCustomFieldsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectCustomFieldsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	fields, err := customFieldsFromDTO(request.Body.Fields)
+	if err != nil {
+		return api.V1ProjectCustomFieldsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	saved, err := c.customFieldService.Set(ctx, projectID, fields)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectCustomFieldsUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectCustomFieldsUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectCustomFieldsUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectCustomFieldsUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectCustomFieldsUpdate200JSONResponse(customFieldsToDTO(saved)), nil
+}
+
+func customFieldsToDTO(fields model.CustomFields) api.CustomFields {
+	dto := api.CustomFields{
+		Fields: make([]api.CustomField, len(fields)),
+	}
+
+	for i, field := range fields {
+		dto.Fields[i] = api.CustomField{
+			Key:      field.Key,
+			Name:     field.Name,
+			Type:     api.CustomFieldType(field.Type.String()),
+			Required: convert.ToPointer(field.Required),
+		}
+		if len(field.Options) > 0 {
+			dto.Fields[i].Options = convert.ToPointer(field.Options)
+		}
+	}
+
+	return dto
+}
+
+// customFieldsFromDTO maps the custom fields of the request body to the
+// model. The fields themselves are validated by the service.
+func customFieldsFromDTO(fields []api.CustomField) ([]model.CustomField, error) {
+	parsed := make([]model.CustomField, len(fields))
+
+	for i, field := range fields {
+		var fieldType model.CustomFieldType
+		if err := fieldType.UnmarshalText([]byte(field.Type)); err != nil {
+			return nil, errors.Join(model.ErrInvalidCustomFieldDetails, err)
+		}
+
+		parsed[i] = model.CustomField{
+			Key:  field.Key,
+			Name: field.Name,
+			Type: fieldType,
+		}
+		if field.Options != nil {
+			parsed[i].Options = *field.Options
+		}
+		if field.Required != nil {
+			parsed[i].Required = *field.Required
+		}
+	}
+
+	return parsed, nil
+}
+
+// NewCustomFieldController creates a new CustomFieldController.
+func NewCustomFieldController(opts ...ControllerOption) (CustomFieldController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.customFieldService == nil {
+		return nil, ErrNoCustomFieldService
+	}
+
+	return &customFieldController{
+		baseController: controller,
+	}, nil
+}
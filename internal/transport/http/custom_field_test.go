@@ -0,0 +1,192 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestCustomFieldController(t *testing.T, cs service.CustomFieldService) CustomFieldController {
+	t.Helper()
+	c, err := NewCustomFieldController(WithCustomFieldService(cs))
+	require.NoError(t, err)
+	return c
+}
+
+func newModelCustomFields() model.CustomFields {
+	return model.CustomFields{
+		{Key: "customer", Name: "Customer", Type: model.CustomFieldTypeText, Required: true},
+		{Key: "platforms", Name: "Platforms", Type: model.CustomFieldTypeMultiSelect, Options: []string{"ios", "web"}},
+	}
+}
+
+func TestNewCustomFieldController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewCustomFieldController(WithCustomFieldService(service.NewMockCustomFieldService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing custom field service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewCustomFieldController()
+		assert.ErrorIs(t, err, ErrNoCustomFieldService)
+	})
+}
+
+func TestCustomFieldController_V1ProjectCustomFieldsGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCustomFieldService(ctrl)
+		cs.EXPECT().List(gomock.Any(), projectID).Return(newModelCustomFields(), nil)
+
+		c := newTestCustomFieldController(t, cs)
+		resp, err := c.V1ProjectCustomFieldsGet(context.Background(), api.V1ProjectCustomFieldsGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectCustomFieldsGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, []api.CustomField{
+			{Key: "customer", Name: "Customer", Type: api.CustomFieldTypeText, Required: convert.ToPointer(true)},
+			{Key: "platforms", Name: "Platforms", Type: api.CustomFieldTypeMultiSelect, Options: &[]string{"ios", "web"}, Required: convert.ToPointer(false)},
+		}, got.Fields)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCustomFieldService(ctrl)
+		cs.EXPECT().List(gomock.Any(), projectID).Return(nil, errors.Join(service.ErrCustomFieldGet, service.ErrNoPermission))
+
+		c := newTestCustomFieldController(t, cs)
+		resp, err := c.V1ProjectCustomFieldsGet(context.Background(), api.V1ProjectCustomFieldsGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectCustomFieldsGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestCustomFieldController_V1ProjectCustomFieldsUpdate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	body := &api.V1ProjectCustomFieldsUpdateJSONRequestBody{
+		Fields: []api.CustomField{
+			{Key: "customer", Name: "Customer", Type: api.CustomFieldTypeText, Required: convert.ToPointer(true)},
+			{Key: "platforms", Name: "Platforms", Type: api.CustomFieldTypeMultiSelect, Options: &[]string{"ios", "web"}},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCustomFieldService(ctrl)
+		cs.EXPECT().Set(gomock.Any(), projectID, []model.CustomField(newModelCustomFields())).Return(newModelCustomFields(), nil)
+
+		c := newTestCustomFieldController(t, cs)
+		resp, err := c.V1ProjectCustomFieldsUpdate(context.Background(), api.V1ProjectCustomFieldsUpdateRequestObject{
+			Id:   projectID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectCustomFieldsUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Len(t, got.Fields, 2)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestCustomFieldController(t, service.NewMockCustomFieldService(ctrl))
+		resp, err := c.V1ProjectCustomFieldsUpdate(context.Background(), api.V1ProjectCustomFieldsUpdateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectCustomFieldsUpdateJSONRequestBody{
+				Fields: []api.CustomField{{Key: "customer", Name: "Customer", Type: "color"}},
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectCustomFieldsUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("unlicensed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cs := service.NewMockCustomFieldService(ctrl)
+		cs.EXPECT().Set(gomock.Any(), projectID, gomock.Any()).Return(nil, errors.Join(service.ErrCustomFieldSet, service.ErrQuotaExceeded))
+
+		c := newTestCustomFieldController(t, cs)
+		resp, err := c.V1ProjectCustomFieldsUpdate(context.Background(), api.V1ProjectCustomFieldsUpdateRequestObject{
+			Id:   projectID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectCustomFieldsUpdate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueListOptionsFromParams_CustomFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("filter and sort by custom fields", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := issueListOptionsFromParams(nil, nil, nil, nil,
+			convert.ToPointer("custom_fields.points:desc"),
+			&api.IssueListCustomField{"platforms:ios", "customer:acme", "platforms:web"},
+			nil,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, repository.IssueListSortFieldCustom("points"), opts.Sort.Field)
+		assert.Equal(t, repository.SortDirectionDesc, opts.Sort.Direction)
+		assert.Equal(t, []repository.IssueListCustomFieldFilter{
+			{Key: "platforms", Values: []any{"ios", "web"}},
+			{Key: "customer", Values: []any{"acme"}},
+		}, opts.Filter.CustomFields)
+	})
+
+	t.Run("invalid custom field sort", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := issueListOptionsFromParams(nil, nil, nil, nil, convert.ToPointer("custom_fields.Points:desc"), nil, nil)
+		assert.ErrorIs(t, err, repository.ErrUnsupportedOrder)
+	})
+
+	t.Run("invalid custom field filter", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := issueListOptionsFromParams(nil, nil, nil, nil, nil, &api.IssueListCustomField{"platforms"}, nil)
+		assert.ErrorIs(t, err, model.ErrInvalidCustomFieldValue)
+	})
+}
@@ -81,6 +81,9 @@ func isInvalidPageError(err error) bool {
 func isIssueRelationBadRequest(err error) bool {
 	return errors.Is(err, service.ErrIssueSelfRelation) ||
 		errors.Is(err, service.ErrIssueReservedRelationKind) ||
+		errors.Is(err, service.ErrIssueRelationCycle) ||
+		errors.Is(err, service.ErrIssueDependencyDepth) ||
+		errors.Is(err, model.ErrIssueDependencyCycle) ||
 		errors.Is(err, model.ErrInvalidIssueRelationKind) ||
 		errors.Is(err, model.ErrInvalidID)
 }
@@ -100,6 +103,41 @@ func classifyServiceError(err error) int {
 		errors.Is(err, model.ErrInvalidIssueDetails),
 		errors.Is(err, model.ErrInvalidDocumentDetails),
 		errors.Is(err, model.ErrInvalidFolderDetails),
+		errors.Is(err, model.ErrInvalidCommentDetails),
+		errors.Is(err, model.ErrInvalidAttachmentDetails),
+		errors.Is(err, model.ErrInvalidLabelDetails),
+		errors.Is(err, model.ErrInvalidWebhookDetails),
+		errors.Is(err, model.ErrInvalidWorkflowDetails),
+		errors.Is(err, model.ErrInvalidCustomFieldDetails),
+		errors.Is(err, model.ErrInvalidCustomFieldValue),
+		errors.Is(err, model.ErrInvalidComponentDetails),
+		errors.Is(err, model.ErrInvalidReleaseDetails),
+		errors.Is(err, model.ErrInvalidSprintDetails),
+		errors.Is(err, model.ErrInvalidWorkLogDetails),
+		errors.Is(err, model.ErrInvalidIssueTemplateDetails),
+		errors.Is(err, model.ErrInvalidIssueRecurrenceDetails),
+		errors.Is(err, model.ErrInvalidViewDetails),
+		errors.Is(err, model.ErrInvalidWIPLimitDetails),
+		errors.Is(err, service.ErrIssueCustomFieldList),
+		errors.Is(err, service.ErrIssueComponent),
+		errors.Is(err, service.ErrIssueRelease),
+		errors.Is(err, service.ErrIssueSprint),
+		errors.Is(err, service.ErrIssueRankAnchor),
+		errors.Is(err, repository.ErrIssueRankAnchor),
+		errors.Is(err, service.ErrIssueBulkOperation),
+		errors.Is(err, service.ErrIssueBulkSize),
+		errors.Is(err, service.ErrIssueRollupSize),
+		errors.Is(err, service.ErrIssueQuery),
+		errors.Is(err, service.ErrIssueMoveProject),
+		errors.Is(err, service.ErrViewShare),
+		errors.Is(err, service.ErrViewShareScope),
+		errors.Is(err, service.ErrBoardColumn),
+		errors.Is(err, service.ErrBoardGroupBy),
+		errors.Is(err, service.ErrSprintClosed),
+		errors.Is(err, service.ErrTimesheetRange),
+		errors.Is(err, repository.ErrUnsupportedOrder),
+		errors.Is(err, service.ErrIssueWorkflowStatus),
+		errors.Is(err, service.ErrIssueWorkflowTransition),
 		errors.Is(err, model.ErrInvalidResourceType),
 		errors.Is(err, service.ErrNoUser),
 		errors.Is(err, repository.ErrFolderNameConflict),
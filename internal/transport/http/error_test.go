@@ -145,8 +145,42 @@ func TestClassifyServiceError(t *testing.T) {
 		{name: "invalid page size", err: repository.ErrInvalidPageSize, status: http.StatusBadRequest},
 		{name: "invalid cursor", err: repository.ErrInvalidCursor, status: http.StatusBadRequest},
 		{name: "self relation", err: service.ErrIssueSelfRelation, status: http.StatusBadRequest},
+		{name: "relation cycle", err: service.ErrIssueRelationCycle, status: http.StatusBadRequest},
+		{name: "invalid dependency graph depth", err: service.ErrIssueDependencyDepth, status: http.StatusBadRequest},
+		{name: "dependency cycle", err: model.ErrIssueDependencyCycle, status: http.StatusBadRequest},
 		{name: "invalid issue details", err: model.ErrInvalidIssueDetails, status: http.StatusBadRequest},
 		{name: "invalid folder details", err: model.ErrInvalidFolderDetails, status: http.StatusBadRequest},
+		{name: "invalid comment details", err: model.ErrInvalidCommentDetails, status: http.StatusBadRequest},
+		{name: "invalid attachment details", err: model.ErrInvalidAttachmentDetails, status: http.StatusBadRequest},
+		{name: "invalid label details", err: model.ErrInvalidLabelDetails, status: http.StatusBadRequest},
+		{name: "invalid workflow details", err: model.ErrInvalidWorkflowDetails, status: http.StatusBadRequest},
+		{name: "workflow transition", err: service.ErrIssueWorkflowTransition, status: http.StatusBadRequest},
+		{name: "invalid custom field value", err: model.ErrInvalidCustomFieldValue, status: http.StatusBadRequest},
+		{name: "invalid component details", err: model.ErrInvalidComponentDetails, status: http.StatusBadRequest},
+		{name: "component outside project", err: service.ErrIssueComponent, status: http.StatusBadRequest},
+		{name: "invalid release details", err: model.ErrInvalidReleaseDetails, status: http.StatusBadRequest},
+		{name: "release outside project", err: service.ErrIssueRelease, status: http.StatusBadRequest},
+		{name: "invalid sprint details", err: model.ErrInvalidSprintDetails, status: http.StatusBadRequest},
+		{name: "sprint outside project", err: service.ErrIssueSprint, status: http.StatusBadRequest},
+		{name: "closed sprint", err: service.ErrSprintClosed, status: http.StatusBadRequest},
+		{name: "missing rank anchor", err: service.ErrIssueRankAnchor, status: http.StatusBadRequest},
+		{name: "invalid rank anchor", err: repository.ErrIssueRankAnchor, status: http.StatusBadRequest},
+		{name: "invalid bulk operation", err: service.ErrIssueBulkOperation, status: http.StatusBadRequest},
+		{name: "too many bulk issues", err: service.ErrIssueBulkSize, status: http.StatusBadRequest},
+		{name: "too many rollup issues", err: service.ErrIssueRollupSize, status: http.StatusBadRequest},
+		{name: "invalid issue query", err: service.ErrIssueQuery, status: http.StatusBadRequest},
+		{name: "issue already in project", err: service.ErrIssueMoveProject, status: http.StatusBadRequest},
+		{name: "invalid work log details", err: model.ErrInvalidWorkLogDetails, status: http.StatusBadRequest},
+		{name: "invalid issue template details", err: model.ErrInvalidIssueTemplateDetails, status: http.StatusBadRequest},
+		{name: "invalid issue recurrence details", err: model.ErrInvalidIssueRecurrenceDetails, status: http.StatusBadRequest},
+		{name: "invalid timesheet range", err: service.ErrTimesheetRange, status: http.StatusBadRequest},
+		{name: "invalid view details", err: model.ErrInvalidViewDetails, status: http.StatusBadRequest},
+		{name: "view shared with user", err: service.ErrViewShare, status: http.StatusBadRequest},
+		{name: "view shared outside its organization", err: service.ErrViewShareScope, status: http.StatusBadRequest},
+		{name: "invalid WIP limit details", err: model.ErrInvalidWIPLimitDetails, status: http.StatusBadRequest},
+		{name: "invalid board column", err: service.ErrBoardColumn, status: http.StatusBadRequest},
+		{name: "invalid board grouping", err: service.ErrBoardGroupBy, status: http.StatusBadRequest},
+		{name: "unsupported order", err: repository.ErrUnsupportedOrder, status: http.StatusBadRequest},
 		{name: "folder name conflict", err: repository.ErrFolderNameConflict, status: http.StatusBadRequest},
 		{name: "folder cycle", err: repository.ErrFolderCycle, status: http.StatusBadRequest},
 		{name: "no permission", err: service.ErrNoPermission, status: http.StatusForbidden},
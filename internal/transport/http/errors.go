@@ -3,27 +3,40 @@ package http
 import "errors"
 
 var (
-	ErrAuthCredentials       = errors.New("invalid credentials")              // invalid credentials
-	ErrAuthNoPermission      = errors.New("no permission")                    // no permission
-	ErrInvalidSwagger        = errors.New("invalid swagger provided")         // invalid swagger provided
-	ErrNoAuthProvider        = errors.New("no auth provider provided")        // no auth provider provided
-	ErrNoDocumentService     = errors.New("no document service provided")     // no document service provided
-	ErrNoEmailService        = errors.New("no email service provided")        // no email service provided
-	ErrNoFolderService       = errors.New("no folder service provided")       // no folder service provided
-	ErrNoIssueService        = errors.New("no issue service provided")        // no issue service provided
-	ErrNoLabelService        = errors.New("no label service provided")        // no label service provided
-	ErrNoLicenseService      = errors.New("no license service provided")      // no license service provided
-	ErrNoLogger              = errors.New("no logger provided")               // no logger provided
-	ErrNoNamespaceService    = errors.New("no namespace service provided")    // no namespace service provided
-	ErrNoNotificationService = errors.New("no notification service provided") // no notification service provided
-	ErrNoOrganizationService = errors.New("no organization service provided") // no organization service provided
-	ErrNoPermissionService   = errors.New("no permission service provided")   // no permission service provided
-	ErrNoProjectService      = errors.New("no project service provided")      // no project service provided
-	ErrNoRoleService         = errors.New("no role service provided")         // no role service provided
-	ErrNoSearchService       = errors.New("no search service provided")       // no search service provided
-	ErrNoSystemService       = errors.New("no system service provided")       // no system service provided
-	ErrNoTeamService         = errors.New("no team service provided")         // no team service provided
-	ErrNoTodoService         = errors.New("no todo service provided")         // no todo service provided
-	ErrNoTracer              = errors.New("no tracer provided")               // no tracer provided
-	ErrNoUserService         = errors.New("no user service provided")         // no user service provided
+	ErrAuthCredentials        = errors.New("invalid credentials")                // invalid credentials
+	ErrAuthNoPermission       = errors.New("no permission")                      // no permission
+	ErrInvalidSwagger         = errors.New("invalid swagger provided")           // invalid swagger provided
+	ErrNoAttachmentService    = errors.New("no attachment service provided")     // no attachment service provided
+	ErrNoAuthProvider         = errors.New("no auth provider provided")          // no auth provider provided
+	ErrNoBoardService         = errors.New("no board service provided")          // no board service provided
+	ErrNoCommentService       = errors.New("no comment service provided")        // no comment service provided
+	ErrNoComponentService     = errors.New("no component service provided")      // no component service provided
+	ErrNoCustomFieldService   = errors.New("no custom field service provided")   // no custom field service provided
+	ErrNoDocumentService      = errors.New("no document service provided")       // no document service provided
+	ErrNoEmailService         = errors.New("no email service provided")          // no email service provided
+	ErrNoEventService         = errors.New("no event service provided")          // no event service provided
+	ErrNoFolderService        = errors.New("no folder service provided")         // no folder service provided
+	ErrNoIssueService         = errors.New("no issue service provided")          // no issue service provided
+	ErrNoIssueTemplateService = errors.New("no issue template service provided") // no issue template service provided
+	ErrNoLabelService         = errors.New("no label service provided")          // no label service provided
+	ErrNoLicenseService       = errors.New("no license service provided")        // no license service provided
+	ErrNoLogger               = errors.New("no logger provided")                 // no logger provided
+	ErrNoNamespaceService     = errors.New("no namespace service provided")      // no namespace service provided
+	ErrNoNotificationService  = errors.New("no notification service provided")   // no notification service provided
+	ErrNoOrganizationService  = errors.New("no organization service provided")   // no organization service provided
+	ErrNoPermissionService    = errors.New("no permission service provided")     // no permission service provided
+	ErrNoProjectService       = errors.New("no project service provided")        // no project service provided
+	ErrNoReleaseService       = errors.New("no release service provided")        // no release service provided
+	ErrNoRoleService          = errors.New("no role service provided")           // no role service provided
+	ErrNoSearchService        = errors.New("no search service provided")         // no search service provided
+	ErrNoSprintService        = errors.New("no sprint service provided")         // no sprint service provided
+	ErrNoSystemService        = errors.New("no system service provided")         // no system service provided
+	ErrNoTeamService          = errors.New("no team service provided")           // no team service provided
+	ErrNoTodoService          = errors.New("no todo service provided")           // no todo service provided
+	ErrNoWebhookService       = errors.New("no webhook service provided")        // no webhook service provided
+	ErrNoWorkflowService      = errors.New("no workflow service provided")       // no workflow service provided
+	ErrNoWorkLogService       = errors.New("no work log service provided")       // no work log service provided
+	ErrNoTracer               = errors.New("no tracer provided")                 // no tracer provided
+	ErrNoUserService          = errors.New("no user service provided")           // no user service provided
+	ErrNoViewService          = errors.New("no view service provided")           // no view service provided
 )
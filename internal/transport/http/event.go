@@ -0,0 +1,160 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/log"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+const (
+	PathEvents = "/v1/events"
+
+	// EventStreamHeartbeatInterval is the interval of the comments sent on an
+	// idle event stream to keep proxies from closing the connection.
+	EventStreamHeartbeatInterval = 30 * time.Second
+)
+
+// EventController is the controller for the live events endpoint.
+type EventController interface {
+	V1EventsGet(ctx context.Context, request api.V1EventsGetRequestObject) (api.V1EventsGetResponseObject, error)
+}
+
+// eventController is the concrete implementation of EventController.
+type eventController struct {
+	*baseController
+}
+
+func (c *eventController) V1EventsGet(ctx context.Context, request api.V1EventsGetRequestObject) (api.V1EventsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1EventsGet")
+	defer span.End()
+
+	var lastEventID int64
+	if request.Params.LastEventID != nil {
+		lastEventID = *request.Params.LastEventID
+	}
+
+	events, err := c.eventService.Subscribe(ctx, lastEventID)
+	if err != nil {
+		if errors.Is(err, service.ErrNoUser) || errors.Is(err, service.ErrInvalidEventID) {
+			return api.V1EventsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		}
+		return api.V1EventsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+			Message: err.Error(),
+		}}, nil
+	}
+
+	return eventStreamResponse{
+		ctx:       ctx,
+		events:    events,
+		heartbeat: EventStreamHeartbeatInterval,
+		logger:    c.logger,
+	}, nil
+}
+
+// eventStreamResponse writes the events as a server-sent events stream until
+// the client disconnects or the event channel is closed.
+type eventStreamResponse struct {
+	ctx       context.Context
+	events    <-chan *service.Event
+	heartbeat time.Duration
+	logger    log.Logger
+}
+
+func (r eventStreamResponse) VisitV1EventsGetResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+
+	// The stream outlives the write timeout of regular responses.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(r.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+		case event, ok := <-r.events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(eventToDTO(event))
+			if err != nil {
+				r.logger.Warn(r.ctx, "failed to encode event", log.WithError(err))
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return err
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// NewEventController creates a new EventController.
+func NewEventController(opts ...ControllerOption) (EventController, error) {
+	c, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	controller := &eventController{
+		baseController: c,
+	}
+
+	if controller.eventService == nil {
+		return nil, ErrNoEventService
+	}
+
+	return controller, nil
+}
+
+func eventToDTO(event *service.Event) api.Event {
+	var actor *string
+	if event.Actor != nil {
+		actor = convert.ToPointer(event.Actor.String())
+	}
+
+	changedFields := event.ChangedFields
+	if changedFields == nil {
+		changedFields = make([]string, 0)
+	}
+
+	return api.Event{
+		Id:            event.ID,
+		Type:          api.EventType(event.Type),
+		ResourceType:  event.Resource.Type.String(),
+		ResourceId:    event.Resource.String(),
+		Actor:         actor,
+		ChangedFields: changedFields,
+		CreatedAt:     event.CreatedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func TestEventToDTO(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().UTC()
+	actorID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("with actor", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, api.Event{
+			Id:            7,
+			Type:          api.EventTypeIssueUpdated,
+			ResourceType:  model.ResourceTypeIssue.String(),
+			ResourceId:    issueID.String(),
+			Actor:         convert.ToPointer(actorID.String()),
+			ChangedFields: []string{"title"},
+			CreatedAt:     createdAt,
+		}, eventToDTO(&service.Event{
+			ID:            7,
+			Type:          service.EventTypeIssueUpdated,
+			Resource:      issueID,
+			Actor:         &actorID,
+			ChangedFields: []string{"title"},
+			CreatedAt:     createdAt,
+		}))
+	})
+
+	t.Run("without actor", func(t *testing.T) {
+		t.Parallel()
+
+		got := eventToDTO(&service.Event{
+			ID:        8,
+			Type:      service.EventTypeIssueUpdated,
+			Resource:  issueID,
+			CreatedAt: createdAt,
+		})
+		assert.Nil(t, got.Actor)
+		assert.Equal(t, []string{}, got.ChangedFields)
+	})
+}
+
+func TestEventStreamResponse_VisitV1EventsGetResponse(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	events := make(chan *service.Event, 1)
+	events <- &service.Event{
+		ID:        3,
+		Type:      service.EventTypeIssueUpdated,
+		Resource:  issueID,
+		CreatedAt: createdAt,
+	}
+	close(events)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, eventStreamResponse{
+		ctx:       context.Background(),
+		events:    events,
+		heartbeat: time.Hour,
+	}.VisitV1EventsGetResponse(w))
+
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.True(t, w.Flushed)
+	assert.Equal(t,
+		"id: 3\nevent: issue.updated\ndata: "+
+			`{"actor":null,"changed_fields":[],"created_at":"2024-01-02T03:04:05Z","id":3,`+
+			`"resource_id":"`+issueID.String()+`","resource_type":"Issue","type":"issue.updated"}`+"\n\n",
+		w.Body.String(),
+	)
+}
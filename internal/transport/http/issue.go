@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/opcotech/elemo/internal/pkg/optional"
 	"github.com/opcotech/elemo/internal/repository"
 	"github.com/opcotech/elemo/internal/service"
@@ -24,10 +25,21 @@ type IssueController interface {
 	V1IssueGet(ctx context.Context, request api.V1IssueGetRequestObject) (api.V1IssueGetResponseObject, error)
 	V1IssueUpdate(ctx context.Context, request api.V1IssueUpdateRequestObject) (api.V1IssueUpdateResponseObject, error)
 	V1IssueDelete(ctx context.Context, request api.V1IssueDeleteRequestObject) (api.V1IssueDeleteResponseObject, error)
+	V1IssueRank(ctx context.Context, request api.V1IssueRankRequestObject) (api.V1IssueRankResponseObject, error)
+	V1IssueMove(ctx context.Context, request api.V1IssueMoveRequestObject) (api.V1IssueMoveResponseObject, error)
+	V1IssueClone(ctx context.Context, request api.V1IssueCloneRequestObject) (api.V1IssueCloneResponseObject, error)
+	V1IssuesBulk(ctx context.Context, request api.V1IssuesBulkRequestObject) (api.V1IssuesBulkResponseObject, error)
+	V1IssueActivityGet(ctx context.Context, request api.V1IssueActivityGetRequestObject) (api.V1IssueActivityGetResponseObject, error)
 	V1IssueRelationsGet(ctx context.Context, request api.V1IssueRelationsGetRequestObject) (api.V1IssueRelationsGetResponseObject, error)
 	V1IssueRelationsCreate(ctx context.Context, request api.V1IssueRelationsCreateRequestObject) (api.V1IssueRelationsCreateResponseObject, error)
 	V1IssueRelationUpdate(ctx context.Context, request api.V1IssueRelationUpdateRequestObject) (api.V1IssueRelationUpdateResponseObject, error)
 	V1IssueRelationDelete(ctx context.Context, request api.V1IssueRelationDeleteRequestObject) (api.V1IssueRelationDeleteResponseObject, error)
+	V1IssueDependencyGraphGet(ctx context.Context, request api.V1IssueDependencyGraphGetRequestObject) (api.V1IssueDependencyGraphGetResponseObject, error)
+	V1IssuesRollupsGet(ctx context.Context, request api.V1IssuesRollupsGetRequestObject) (api.V1IssuesRollupsGetResponseObject, error)
+	V1ProjectCriticalPathGet(ctx context.Context, request api.V1ProjectCriticalPathGetRequestObject) (api.V1ProjectCriticalPathGetResponseObject, error)
+	V1IssueWatchersGet(ctx context.Context, request api.V1IssueWatchersGetRequestObject) (api.V1IssueWatchersGetResponseObject, error)
+	V1IssueWatch(ctx context.Context, request api.V1IssueWatchRequestObject) (api.V1IssueWatchResponseObject, error)
+	V1IssueUnwatch(ctx context.Context, request api.V1IssueUnwatchRequestObject) (api.V1IssueUnwatchResponseObject, error)
 }
 
 // issueController is the concrete implementation of IssueController.
@@ -86,9 +98,12 @@ func (c *issueController) V1ProjectsIssuesGet(ctx context.Context, request api.V
 	}
 	listOpts, err := issueListOptionsFromParams(
 		request.Params.Q,
+		request.Params.Query,
 		request.Params.Status,
 		request.Params.Priority,
 		request.Params.Order,
+		request.Params.CustomField,
+		request.Params.Component,
 	)
 	if err != nil {
 		return api.V1ProjectsIssuesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
@@ -136,9 +151,12 @@ func (c *issueController) V1NamespacesIssuesGet(ctx context.Context, request api
 	}
 	listOpts, err := issueListOptionsFromParams(
 		request.Params.Q,
+		request.Params.Query,
 		request.Params.Status,
 		request.Params.Priority,
 		request.Params.Order,
+		nil,
+		request.Params.Component,
 	)
 	if err != nil {
 		return api.V1NamespacesIssuesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
@@ -186,9 +204,12 @@ func (c *issueController) V1UsersIssuesGet(ctx context.Context, request api.V1Us
 	}
 	listOpts, err := issueListOptionsFromParams(
 		request.Params.Q,
+		request.Params.Query,
 		request.Params.Status,
 		request.Params.Priority,
 		request.Params.Order,
+		nil,
+		request.Params.Component,
 	)
 	if err != nil {
 		return api.V1UsersIssuesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
@@ -344,6 +365,146 @@ func (c *issueController) V1IssueDelete(ctx context.Context, request api.V1Issue
 	return api.V1IssueDelete204Response{}, nil
 }
 
+func (c *issueController) V1IssueRank(ctx context.Context, request api.V1IssueRankRequestObject) (api.V1IssueRankResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueRank")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueRank400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueRank400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := rankIssueJSONRequestBodyToRankIssueOpts(request.Body)
+	if err != nil {
+		return api.V1IssueRank400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	issue, err := c.issueService.Rank(ctx, issueID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueRank400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueRank403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueRank404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueRank500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueRank200JSONResponse(issueToDTO(issue)), nil
+}
+
+func (c *issueController) V1IssueMove(ctx context.Context, request api.V1IssueMoveRequestObject) (api.V1IssueMoveResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueMove")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueMove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueMove400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	projectID, err := model.NewIDFromString(request.Body.ProjectId, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1IssueMove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	issue, err := c.issueService.Move(ctx, issueID, projectID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueMove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueMove403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueMove404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueMove500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueMove200JSONResponse(issueToDTO(issue)), nil
+}
+
+func (c *issueController) V1IssueClone(ctx context.Context, request api.V1IssueCloneRequestObject) (api.V1IssueCloneResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueClone")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueClone400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	var opts service.CloneIssueOpts
+	if request.Body != nil {
+		opts = cloneIssueJSONRequestBodyToCloneIssueOpts(request.Body)
+	}
+
+	issue, err := c.issueService.Clone(ctx, issueID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueClone400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueClone403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueClone404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueClone500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueClone201JSONResponse(issueToDTO(issue)), nil
+}
+
+func (c *issueController) V1IssuesBulk(ctx context.Context, request api.V1IssuesBulkRequestObject) (api.V1IssuesBulkResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssuesBulk")
+	defer span.End()
+
+	if request.Body == nil {
+		return api.V1IssuesBulk400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := bulkIssuesJSONRequestBodyToBulkIssueOpts(request.Body)
+	if err != nil {
+		return api.V1IssuesBulk400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	results, err := c.issueService.Bulk(ctx, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssuesBulk400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssuesBulk403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		default:
+			return api.V1IssuesBulk500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	items := make([]api.IssueBulkItem, len(results))
+	for i, result := range results {
+		items[i] = bulkIssueResultToDTO(opts.Operation, result)
+	}
+
+	return api.V1IssuesBulk200JSONResponse{Items: items}, nil
+}
+
 func (c *issueController) V1IssueRelationsGet(ctx context.Context, request api.V1IssueRelationsGetRequestObject) (api.V1IssueRelationsGetResponseObject, error) {
 	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueRelationsGet")
 	defer span.End()
@@ -385,6 +546,47 @@ func (c *issueController) V1IssueRelationsGet(ctx context.Context, request api.V
 	}, nil
 }
 
+func (c *issueController) V1IssueActivityGet(ctx context.Context, request api.V1IssueActivityGetRequestObject) (api.V1IssueActivityGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueActivityGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueActivityGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1IssueActivityGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.issueService.ListActivity(ctx, issueID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueActivityGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueActivityGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueActivityGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueActivityGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	items := make([]api.IssueActivity, len(page.Items))
+	for i, activity := range page.Items {
+		items[i] = issueActivityToDTO(activity)
+	}
+
+	return api.V1IssueActivityGet200JSONResponse{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}, nil
+}
+
 func (c *issueController) V1IssueRelationsCreate(ctx context.Context, request api.V1IssueRelationsCreateRequestObject) (api.V1IssueRelationsCreateResponseObject, error) {
 	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueRelationsCreate")
 	defer span.End()
@@ -499,6 +701,189 @@ func (c *issueController) V1IssueRelationDelete(ctx context.Context, request api
 	return api.V1IssueRelationDelete204Response{}, nil
 }
 
+func (c *issueController) V1IssueDependencyGraphGet(ctx context.Context, request api.V1IssueDependencyGraphGetRequestObject) (api.V1IssueDependencyGraphGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueDependencyGraphGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueDependencyGraphGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	depth := 0
+	if request.Params.Depth != nil {
+		depth = *request.Params.Depth
+	}
+
+	graph, err := c.issueService.GetDependencyGraph(ctx, issueID, depth)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueDependencyGraphGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueDependencyGraphGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueDependencyGraphGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueDependencyGraphGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueDependencyGraphGet200JSONResponse(issueDependencyGraphToDTO(graph)), nil
+}
+
+func (c *issueController) V1IssuesRollupsGet(ctx context.Context, request api.V1IssuesRollupsGetRequestObject) (api.V1IssuesRollupsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssuesRollupsGet")
+	defer span.End()
+
+	ids := make([]model.ID, len(request.Params.Ids))
+	for i, id := range request.Params.Ids {
+		issueID, err := model.NewIDFromString(id, model.ResourceTypeIssue.String())
+		if err != nil {
+			return api.V1IssuesRollupsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		}
+		ids[i] = issueID
+	}
+
+	rollups, err := c.issueService.GetRollups(ctx, ids)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssuesRollupsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssuesRollupsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		default:
+			return api.V1IssuesRollupsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	items := make([]api.IssueRollup, len(rollups))
+	for i, rollup := range rollups {
+		items[i] = issueRollupToDTO(rollup.ID, rollup.IssueRollup)
+	}
+
+	return api.V1IssuesRollupsGet200JSONResponse(items), nil
+}
+
+func (c *issueController) V1ProjectCriticalPathGet(ctx context.Context, request api.V1ProjectCriticalPathGetRequestObject) (api.V1ProjectCriticalPathGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectCriticalPathGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectCriticalPathGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	path, err := c.issueService.GetCriticalPath(ctx, projectID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectCriticalPathGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectCriticalPathGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectCriticalPathGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectCriticalPathGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectCriticalPathGet200JSONResponse(criticalPathToDTO(path)), nil
+}
+
+func (c *issueController) V1IssueWatchersGet(ctx context.Context, request api.V1IssueWatchersGetRequestObject) (api.V1IssueWatchersGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWatchersGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWatchersGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	watchers, err := c.issueService.GetWatchers(ctx, issueID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWatchersGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWatchersGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWatchersGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWatchersGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	items := make(api.V1IssueWatchersGet200JSONResponse, len(watchers))
+	for i, watcher := range watchers {
+		items[i] = partialUserToDTO(watcher)
+	}
+
+	return items, nil
+}
+
+func (c *issueController) V1IssueWatch(ctx context.Context, request api.V1IssueWatchRequestObject) (api.V1IssueWatchResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWatch")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWatch400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.issueService.Watch(ctx, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWatch400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWatch403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWatch404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWatch500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueWatch204Response{}, nil
+}
+
+func (c *issueController) V1IssueUnwatch(ctx context.Context, request api.V1IssueUnwatchRequestObject) (api.V1IssueUnwatchResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueUnwatch")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueUnwatch400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.issueService.Unwatch(ctx, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueUnwatch400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueUnwatch403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueUnwatch404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueUnwatch500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueUnwatch204Response{}, nil
+}
+
 // NewIssueController creates a new IssueController.
 func NewIssueController(opts ...ControllerOption) (IssueController, error) {
 	c, err := newController(opts...)
@@ -568,6 +953,30 @@ func createIssueJSONRequestBodyToCreateIssueOpts(body *api.V1ProjectsIssuesCreat
 		opts.Links = issueLinksFromAPI(*body.Links)
 	}
 
+	if body.CustomFields != nil {
+		opts.CustomFields = *body.CustomFields
+	}
+
+	if body.StoryPoints != nil {
+		opts.StoryPoints = body.StoryPoints
+	}
+
+	if body.OriginalEstimate != nil {
+		estimate, err := minutesFromAPI(*body.OriginalEstimate)
+		if err != nil {
+			return service.CreateIssueOpts{}, err
+		}
+		opts.OriginalEstimate = &estimate
+	}
+
+	if body.Components != nil {
+		components, err := componentIDsFromAPI(*body.Components)
+		if err != nil {
+			return service.CreateIssueOpts{}, err
+		}
+		opts.Components = components
+	}
+
 	if body.DueDate != nil {
 		opts.DueDate = body.DueDate
 	}
@@ -606,6 +1015,10 @@ func updateIssueJSONRequestBodyToUpdateIssueOpts(body *api.V1IssueUpdateJSONRequ
 		opts.Status = optional.Some(status)
 	}
 
+	if body.WorkflowStatus.Defined {
+		opts.WorkflowStatus = body.WorkflowStatus
+	}
+
 	if body.Priority != nil {
 		priority, err := model.IssuePriorityString(string(*body.Priority))
 		if err != nil {
@@ -630,6 +1043,30 @@ func updateIssueJSONRequestBodyToUpdateIssueOpts(body *api.V1IssueUpdateJSONRequ
 		}
 	}
 
+	if body.CustomFields != nil {
+		opts.CustomFields = make(map[string]any, len(*body.CustomFields))
+		for key, value := range *body.CustomFields {
+			if value == nil {
+				opts.CustomFields[key] = nil
+			} else {
+				opts.CustomFields[key] = *value
+			}
+		}
+	}
+
+	if body.StoryPoints.Defined {
+		opts.StoryPoints = body.StoryPoints
+	}
+
+	var err error
+	if opts.OriginalEstimate, err = optionalMinutesFromAPI(body.OriginalEstimate); err != nil {
+		return service.UpdateIssueOpts{}, err
+	}
+
+	if opts.RemainingEstimate, err = optionalMinutesFromAPI(body.RemainingEstimate); err != nil {
+		return service.UpdateIssueOpts{}, err
+	}
+
 	if body.DueDate.Defined {
 		opts.DueDate = body.DueDate
 	}
@@ -683,6 +1120,17 @@ func updateIssueJSONRequestBodyToUpdateIssueOpts(body *api.V1IssueUpdateJSONRequ
 		opts.Labels = optional.Some(labels)
 	}
 
+	if body.Components.Defined {
+		components := make([]model.ID, 0)
+		if body.Components.Value != nil {
+			var err error
+			if components, err = componentIDsFromAPI(*body.Components.Value); err != nil {
+				return service.UpdateIssueOpts{}, err
+			}
+		}
+		opts.Components = optional.Some(components)
+	}
+
 	if body.Parent.Defined {
 		if body.Parent.Value == nil {
 			opts.Parent = optional.Null[model.ID]()
@@ -698,6 +1146,108 @@ func updateIssueJSONRequestBodyToUpdateIssueOpts(body *api.V1IssueUpdateJSONRequ
 	return opts, nil
 }
 
+func rankIssueJSONRequestBodyToRankIssueOpts(body *api.V1IssueRankJSONRequestBody) (service.RankIssueOpts, error) {
+	var opts service.RankIssueOpts
+
+	if body.Before != nil {
+		before, err := model.NewIDFromString(*body.Before, model.ResourceTypeIssue.String())
+		if err != nil {
+			return service.RankIssueOpts{}, err
+		}
+		opts.Before = &before
+	}
+	if body.After != nil {
+		after, err := model.NewIDFromString(*body.After, model.ResourceTypeIssue.String())
+		if err != nil {
+			return service.RankIssueOpts{}, err
+		}
+		opts.After = &after
+	}
+
+	return opts, nil
+}
+
+func bulkIssuesJSONRequestBodyToBulkIssueOpts(body *api.V1IssuesBulkJSONRequestBody) (service.BulkIssueOpts, error) {
+	operation, err := service.BulkIssueOperationString(string(body.Operation))
+	if err != nil || operation == service.BulkIssueOperationUnknown {
+		return service.BulkIssueOpts{}, service.ErrIssueBulkOperation
+	}
+
+	opts := service.BulkIssueOpts{
+		IDs:       make([]model.ID, 0, len(body.Ids)),
+		Operation: operation,
+	}
+	for _, raw := range body.Ids {
+		id, err := model.NewIDFromString(raw, model.ResourceTypeIssue.String())
+		if err != nil {
+			return service.BulkIssueOpts{}, err
+		}
+		opts.IDs = append(opts.IDs, id)
+	}
+
+	if operation == service.BulkIssueOperationUpdate {
+		if body.Patch == nil {
+			return service.BulkIssueOpts{}, errors.New("patch is required by the update operation")
+		}
+		if opts.Update, err = updateIssueJSONRequestBodyToUpdateIssueOpts(body.Patch); err != nil {
+			return service.BulkIssueOpts{}, err
+		}
+	}
+
+	return opts, nil
+}
+
+// bulkIssueResultToDTO reports the result of an issue with the status the
+// operation would have returned if it was requested for the issue alone.
+func cloneIssueJSONRequestBodyToCloneIssueOpts(body *api.V1IssueCloneJSONRequestBody) service.CloneIssueOpts {
+	return service.CloneIssueOpts{
+		Subtasks:  body.Subtasks != nil && *body.Subtasks,
+		Relations: body.Relations != nil && *body.Relations,
+		Labels:    body.Labels != nil && *body.Labels,
+		Documents: body.Documents != nil && *body.Documents,
+	}
+}
+
+func bulkIssueResultToDTO(operation service.BulkIssueOperation, result *service.BulkIssueResult) api.IssueBulkItem {
+	item := api.IssueBulkItem{Id: result.ID.String()}
+
+	if result.Err != nil {
+		item.Status = classifyServiceError(result.Err)
+		switch item.Status {
+		case http.StatusBadRequest:
+			item.Message = convert.ToPointer(formatBadRequest(result.Err).Message)
+		case http.StatusForbidden:
+			item.Message = convert.ToPointer(permissionDenied.Message)
+		case http.StatusNotFound:
+			item.Message = convert.ToPointer(notFound.Message)
+		default:
+			item.Message = convert.ToPointer(result.Err.Error())
+		}
+		return item
+	}
+
+	item.Status = http.StatusNoContent
+	if operation == service.BulkIssueOperationUpdate {
+		item.Status = http.StatusOK
+		issue := issueToDTO(result.Issue)
+		item.Issue = &issue
+	}
+
+	return item
+}
+
+func componentIDsFromAPI(raw []string) ([]model.ID, error) {
+	components := make([]model.ID, 0, len(raw))
+	for _, component := range raw {
+		id, err := model.NewIDFromString(component, model.ResourceTypeComponent.String())
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, id)
+	}
+	return components, nil
+}
+
 func issueToDTO(issue *service.Issue) api.Issue {
 	createdAt := time.Time{}
 	if issue.CreatedAt != nil {
@@ -705,30 +1255,35 @@ func issueToDTO(issue *service.Issue) api.Issue {
 	}
 
 	i := api.Issue{
-		Id:              issue.ID.String(),
-		Key:             issue.Key,
-		NumericId:       int(issue.NumericID),
-		Kind:            api.IssueKind(issue.Kind.String()),
-		Title:           issue.Title,
-		Status:          api.IssueStatus(issue.Status.String()),
-		Priority:        api.IssuePriority(issue.Priority.String()),
-		Resolution:      api.IssueResolution(issue.Resolution.String()),
-		ReportedBy:      partialUserToDTO(issue.ReportedBy),
-		Assignees:       assignmentUsersByKind(issue.Assignments, model.AssignmentKindAssignee),
-		Reviewers:       assignmentUsersByKind(issue.Assignments, model.AssignmentKindReviewer),
-		Labels:          partialLabelsToDTO(issue.Labels),
-		Project:         partialProjectToDTO(issue.Project),
-		Namespace:       partialNamespaceToDTO(issue.Namespace),
-		CommentCount:    issue.CommentCount,
-		DocumentCount:   issue.DocumentCount,
-		AttachmentCount: issue.AttachmentCount,
-		WatcherCount:    issue.WatcherCount,
-		RelationCount:   issue.RelationCount,
-		Links:           issueLinksToAPI(issue.Links),
-		DueDate:         issue.DueDate,
-		StartDate:       issue.StartDate,
-		CreatedAt:       createdAt,
-		UpdatedAt:       issue.UpdatedAt,
+		Id:                issue.ID.String(),
+		Key:               issue.Key,
+		NumericId:         int(issue.NumericID),
+		Kind:              api.IssueKind(issue.Kind.String()),
+		Title:             issue.Title,
+		Status:            api.IssueStatus(issue.Status.String()),
+		WorkflowStatus:    issue.WorkflowStatus,
+		Priority:          api.IssuePriority(issue.Priority.String()),
+		Resolution:        api.IssueResolution(issue.Resolution.String()),
+		ReportedBy:        partialUserToDTO(issue.ReportedBy),
+		Assignees:         assignmentUsersByKind(issue.Assignments, model.AssignmentKindAssignee),
+		Reviewers:         assignmentUsersByKind(issue.Assignments, model.AssignmentKindReviewer),
+		Labels:            partialLabelsToDTO(issue.Labels),
+		Components:        partialComponentsToDTO(issue.Components),
+		Project:           partialProjectToDTO(issue.Project),
+		Namespace:         partialNamespaceToDTO(issue.Namespace),
+		CommentCount:      issue.CommentCount,
+		DocumentCount:     issue.DocumentCount,
+		AttachmentCount:   issue.AttachmentCount,
+		WatcherCount:      issue.WatcherCount,
+		RelationCount:     issue.RelationCount,
+		Links:             issueLinksToAPI(issue.Links),
+		StoryPoints:       issue.StoryPoints,
+		OriginalEstimate:  minutesToAPI(issue.OriginalEstimate),
+		RemainingEstimate: minutesToAPI(issue.RemainingEstimate),
+		DueDate:           issue.DueDate,
+		StartDate:         issue.StartDate,
+		CreatedAt:         createdAt,
+		UpdatedAt:         issue.UpdatedAt,
 	}
 
 	if issue.Description != "" {
@@ -744,6 +1299,15 @@ func issueToDTO(issue *service.Issue) api.Issue {
 		i.Links = make([]api.IssueLink, 0)
 	}
 
+	if len(issue.CustomFields) > 0 {
+		i.CustomFields = &issue.CustomFields
+	}
+
+	if issue.Rollup != nil {
+		rollup := issueRollupToDTO(issue.ID, *issue.Rollup)
+		i.Rollup = &rollup
+	}
+
 	return i
 }
 
@@ -783,11 +1347,101 @@ func issueRelationToDTO(relation *service.IssueRelation) api.IssueRelation {
 	}
 }
 
+func issueDependencyGraphToDTO(graph *service.IssueDependencyGraph) api.IssueDependencyGraph {
+	issues := make([]api.PartialIssue, len(graph.Issues))
+	for i, issue := range graph.Issues {
+		issues[i] = partialIssueToDTO(issue)
+	}
+
+	relations := make([]api.IssueDependency, len(graph.Relations))
+	for i, relation := range graph.Relations {
+		createdAt := time.Time{}
+		if relation.CreatedAt != nil {
+			createdAt = *relation.CreatedAt
+		}
+
+		relations[i] = api.IssueDependency{
+			Id:        relation.ID.String(),
+			Kind:      api.IssueRelationKind(relation.Kind.String()),
+			Source:    relation.Source.String(),
+			Target:    relation.Target.String(),
+			CreatedAt: createdAt,
+		}
+	}
+
+	return api.IssueDependencyGraph{
+		Issues:    issues,
+		Relations: relations,
+	}
+}
+
+func issueRollupToDTO(id model.ID, rollup model.IssueRollup) api.IssueRollup {
+	return api.IssueRollup{
+		Id:                id.String(),
+		TodoCount:         rollup.Todo,
+		InProgressCount:   rollup.InProgress,
+		DoneCount:         rollup.Done,
+		TotalCount:        rollup.Total(),
+		PercentDone:       rollup.PercentDone(),
+		StoryPoints:       rollup.StoryPoints,
+		OriginalEstimate:  minutesToAPI(rollup.OriginalEstimate),
+		RemainingEstimate: minutesToAPI(rollup.RemainingEstimate),
+		StartDate:         rollup.StartDate,
+		DueDate:           rollup.DueDate,
+	}
+}
+
+func criticalPathToDTO(path *service.CriticalPath) api.CriticalPath {
+	steps := make([]api.CriticalPathStep, len(path.Steps))
+	for i, step := range path.Steps {
+		steps[i] = api.CriticalPathStep{
+			Issue:          partialIssueToDTO(step.Issue),
+			Duration:       int(step.Duration.Minutes()),
+			EarliestStart:  int(step.EarliestStart.Minutes()),
+			EarliestFinish: int(step.EarliestFinish.Minutes()),
+		}
+	}
+
+	return api.CriticalPath{
+		Steps:    steps,
+		Duration: int(path.Duration.Minutes()),
+	}
+}
+
+func issueActivityToDTO(activity *service.IssueActivity) api.IssueActivity {
+	createdAt := time.Time{}
+	if activity.CreatedAt != nil {
+		createdAt = *activity.CreatedAt
+	}
+
+	var subject, actor *string
+	if activity.Subject != nil {
+		subject = convert.ToPointer(activity.Subject.String())
+	}
+	if activity.Actor != nil {
+		actor = convert.ToPointer(activity.Actor.String())
+	}
+
+	return api.IssueActivity{
+		Id:        activity.ID.String(),
+		Kind:      api.IssueActivityKind(activity.Kind),
+		Field:     activity.Field,
+		OldValue:  activity.OldValue,
+		NewValue:  activity.NewValue,
+		Subject:   subject,
+		Actor:     actor,
+		CreatedAt: createdAt,
+	}
+}
+
 func issueListOptionsFromParams(
 	q *api.IssueListQ,
+	query *api.IssueListQuery,
 	status *api.IssueListStatus,
 	priority *api.IssueListPriority,
-	order any,
+	order *api.IssueListOrder,
+	customFields *api.IssueListCustomField,
+	components *api.IssueListComponent,
 ) (service.IssueListOptions, error) {
 	opts := service.IssueListOptions{
 		Sort: repository.IssueListSort{
@@ -800,6 +1454,10 @@ func issueListOptionsFromParams(
 		opts.Filter.Text = strings.TrimSpace(string(*q))
 	}
 
+	if query != nil {
+		opts.Query = *query
+	}
+
 	if status != nil {
 		opts.Filter.Statuses = make([]model.IssueStatus, 0, len(*status))
 		for _, raw := range *status {
@@ -822,27 +1480,29 @@ func issueListOptionsFromParams(
 		}
 	}
 
-	var orderValue string
-	switch typed := order.(type) {
-	case *api.V1ProjectsIssuesGetParamsOrder:
-		if typed != nil {
-			orderValue = string(*typed)
-		}
-	case *api.V1NamespacesIssuesGetParamsOrder:
-		if typed != nil {
-			orderValue = string(*typed)
+	if customFields != nil {
+		for _, raw := range *customFields {
+			key, value, found := strings.Cut(raw, ":")
+			if !found || !model.IsCustomFieldKey(key) {
+				return service.IssueListOptions{}, model.ErrInvalidCustomFieldValue
+			}
+			opts.Filter.CustomFields = appendIssueListCustomFieldFilter(opts.Filter.CustomFields, key, value)
 		}
-	case *api.V1UsersIssuesGetParamsOrder:
-		if typed != nil {
-			orderValue = string(*typed)
+	}
+
+	if components != nil {
+		ids, err := componentIDsFromAPI(*components)
+		if err != nil {
+			return service.IssueListOptions{}, err
 		}
+		opts.Filter.Components = ids
 	}
 
-	if orderValue == "" {
+	if order == nil || *order == "" {
 		return opts, nil
 	}
 
-	field, directionRaw, found := strings.Cut(orderValue, ":")
+	field, directionRaw, found := strings.Cut(*order, ":")
 	if !found {
 		return service.IssueListOptions{}, repository.ErrUnsupportedOrder
 	}
@@ -865,7 +1525,11 @@ func issueListOptionsFromParams(
 	case "updated_at":
 		opts.Sort.Field = repository.IssueListSortFieldUpdatedAt
 	default:
-		return service.IssueListOptions{}, repository.ErrUnsupportedOrder
+		key, ok := strings.CutPrefix(field, "custom_fields.")
+		if !ok || !model.IsCustomFieldKey(key) {
+			return service.IssueListOptions{}, repository.ErrUnsupportedOrder
+		}
+		opts.Sort.Field = repository.IssueListSortFieldCustom(key)
 	}
 
 	switch directionRaw {
@@ -879,3 +1543,16 @@ func issueListOptionsFromParams(
 
 	return opts, nil
 }
+
+// appendIssueListCustomFieldFilter adds the value to the filter of the custom
+// field, matching the values of the same field with any.
+func appendIssueListCustomFieldFilter(filters []repository.IssueListCustomFieldFilter, key, value string) []repository.IssueListCustomFieldFilter {
+	for i := range filters {
+		if filters[i].Key == key {
+			filters[i].Values = append(filters[i].Values, value)
+			return filters
+		}
+	}
+
+	return append(filters, repository.IssueListCustomFieldFilter{Key: key, Values: []any{value}})
+}
@@ -0,0 +1,534 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// IssueTemplateController is the controller for the project issue template
+// endpoints.
+type IssueTemplateController interface {
+	V1ProjectIssueTemplatesGet(ctx context.Context, request api.V1ProjectIssueTemplatesGetRequestObject) (api.V1ProjectIssueTemplatesGetResponseObject, error)
+	V1ProjectIssueTemplatesCreate(ctx context.Context, request api.V1ProjectIssueTemplatesCreateRequestObject) (api.V1ProjectIssueTemplatesCreateResponseObject, error)
+	V1IssueTemplateGet(ctx context.Context, request api.V1IssueTemplateGetRequestObject) (api.V1IssueTemplateGetResponseObject, error)
+	V1IssueTemplateUpdate(ctx context.Context, request api.V1IssueTemplateUpdateRequestObject) (api.V1IssueTemplateUpdateResponseObject, error)
+	V1IssueTemplateDelete(ctx context.Context, request api.V1IssueTemplateDeleteRequestObject) (api.V1IssueTemplateDeleteResponseObject, error)
+	V1IssueTemplateIssuesCreate(ctx context.Context, request api.V1IssueTemplateIssuesCreateRequestObject) (api.V1IssueTemplateIssuesCreateResponseObject, error)
+	V1IssueTemplateRecurrenceGet(ctx context.Context, request api.V1IssueTemplateRecurrenceGetRequestObject) (api.V1IssueTemplateRecurrenceGetResponseObject, error)
+	V1IssueTemplateRecurrenceUpdate(ctx context.Context, request api.V1IssueTemplateRecurrenceUpdateRequestObject) (api.V1IssueTemplateRecurrenceUpdateResponseObject, error)
+	V1IssueTemplateRecurrenceDelete(ctx context.Context, request api.V1IssueTemplateRecurrenceDeleteRequestObject) (api.V1IssueTemplateRecurrenceDeleteResponseObject, error)
+}
+
+// issueTemplateController is the concrete implementation of
+// IssueTemplateController.
+type issueTemplateController struct {
+	*baseController
+}
+
+func (c *issueTemplateController) V1ProjectIssueTemplatesGet(ctx context.Context, request api.V1ProjectIssueTemplatesGetRequestObject) (api.V1ProjectIssueTemplatesGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectIssueTemplatesGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectIssueTemplatesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ProjectIssueTemplatesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.issueTemplateService.List(ctx, projectID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectIssueTemplatesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectIssueTemplatesGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectIssueTemplatesGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectIssueTemplatesGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectIssueTemplatesGet200JSONResponse(issueTemplatePageToDTO(page)), nil
+}
+
+func (c *issueTemplateController) V1ProjectIssueTemplatesCreate(ctx context.Context, request api.V1ProjectIssueTemplatesCreateRequestObject) (api.V1ProjectIssueTemplatesCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectIssueTemplatesCreate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectIssueTemplatesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectIssueTemplatesCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := createIssueTemplateJSONRequestBodyToCreateIssueTemplateOpts(request.Body)
+	if err != nil {
+		return api.V1ProjectIssueTemplatesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	template, err := c.issueTemplateService.Create(ctx, projectID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectIssueTemplatesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectIssueTemplatesCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectIssueTemplatesCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectIssueTemplatesCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectIssueTemplatesCreate201JSONResponse(issueTemplateToDTO(template)), nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateGet(ctx context.Context, request api.V1IssueTemplateGetRequestObject) (api.V1IssueTemplateGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateGet")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	template, err := c.issueTemplateService.Get(ctx, templateID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateGet200JSONResponse(issueTemplateToDTO(template)), nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateUpdate(ctx context.Context, request api.V1IssueTemplateUpdateRequestObject) (api.V1IssueTemplateUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateUpdate")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueTemplateUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := updateIssueTemplateJSONRequestBodyToUpdateIssueTemplateOpts(request.Body)
+	if err != nil {
+		return api.V1IssueTemplateUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	template, err := c.issueTemplateService.Update(ctx, templateID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateUpdate200JSONResponse(issueTemplateToDTO(template)), nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateDelete(ctx context.Context, request api.V1IssueTemplateDeleteRequestObject) (api.V1IssueTemplateDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateDelete")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.issueTemplateService.Delete(ctx, templateID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateDelete204Response{}, nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateIssuesCreate(ctx context.Context, request api.V1IssueTemplateIssuesCreateRequestObject) (api.V1IssueTemplateIssuesCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateIssuesCreate")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateIssuesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	var opts service.CreateIssueFromTemplateOpts
+	if request.Body != nil && request.Body.Title != nil {
+		opts.Title = *request.Body.Title
+	}
+
+	issue, err := c.issueService.CreateFromTemplate(ctx, templateID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateIssuesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateIssuesCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateIssuesCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateIssuesCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateIssuesCreate201JSONResponse(issueToDTO(issue)), nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateRecurrenceGet(ctx context.Context, request api.V1IssueTemplateRecurrenceGetRequestObject) (api.V1IssueTemplateRecurrenceGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateRecurrenceGet")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateRecurrenceGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	recurrence, err := c.issueTemplateService.GetRecurrence(ctx, templateID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateRecurrenceGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateRecurrenceGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateRecurrenceGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateRecurrenceGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateRecurrenceGet200JSONResponse(issueRecurrenceToDTO(recurrence)), nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateRecurrenceUpdate(ctx context.Context, request api.V1IssueTemplateRecurrenceUpdateRequestObject) (api.V1IssueTemplateRecurrenceUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateRecurrenceUpdate")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateRecurrenceUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueTemplateRecurrenceUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	recurrence, err := c.issueTemplateService.SetRecurrence(ctx, templateID, service.SetIssueRecurrenceOpts{
+		Rule:     request.Body.Rule,
+		StartsAt: request.Body.StartsAt,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateRecurrenceUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateRecurrenceUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateRecurrenceUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateRecurrenceUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateRecurrenceUpdate200JSONResponse(issueRecurrenceToDTO(recurrence)), nil
+}
+
+func (c *issueTemplateController) V1IssueTemplateRecurrenceDelete(ctx context.Context, request api.V1IssueTemplateRecurrenceDeleteRequestObject) (api.V1IssueTemplateRecurrenceDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTemplateRecurrenceDelete")
+	defer span.End()
+
+	templateID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssueTemplate.String())
+	if err != nil {
+		return api.V1IssueTemplateRecurrenceDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.issueTemplateService.DeleteRecurrence(ctx, templateID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTemplateRecurrenceDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTemplateRecurrenceDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTemplateRecurrenceDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTemplateRecurrenceDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTemplateRecurrenceDelete204Response{}, nil
+}
+
+func createIssueTemplateJSONRequestBodyToCreateIssueTemplateOpts(body *api.V1ProjectIssueTemplatesCreateJSONRequestBody) (service.CreateIssueTemplateOpts, error) {
+	kind, err := model.IssueKindString(string(body.Kind))
+	if err != nil {
+		return service.CreateIssueTemplateOpts{}, err
+	}
+
+	opts := service.CreateIssueTemplateOpts{
+		Name: body.Name,
+		Kind: kind,
+	}
+
+	if body.TitlePattern != nil {
+		opts.TitlePattern = *body.TitlePattern
+	}
+
+	if body.Description != nil {
+		opts.Description = *body.Description
+	}
+
+	if body.Priority != nil {
+		if opts.Priority, err = model.IssuePriorityString(string(*body.Priority)); err != nil {
+			return service.CreateIssueTemplateOpts{}, err
+		}
+	}
+
+	if body.Labels != nil {
+		if opts.Labels, err = issueTemplateLabelsFromDTO(*body.Labels); err != nil {
+			return service.CreateIssueTemplateOpts{}, err
+		}
+	}
+
+	if body.Children != nil {
+		if opts.Children, err = issueTemplateChildrenFromDTO(*body.Children); err != nil {
+			return service.CreateIssueTemplateOpts{}, err
+		}
+	}
+
+	return opts, nil
+}
+
+func updateIssueTemplateJSONRequestBodyToUpdateIssueTemplateOpts(body *api.V1IssueTemplateUpdateJSONRequestBody) (service.UpdateIssueTemplateOpts, error) {
+	opts := service.UpdateIssueTemplateOpts{
+		Name:         body.Name,
+		TitlePattern: body.TitlePattern,
+		Description:  body.Description,
+	}
+
+	if body.Kind != nil {
+		kind, err := model.IssueKindString(string(*body.Kind))
+		if err != nil {
+			return service.UpdateIssueTemplateOpts{}, err
+		}
+		opts.Kind = optional.Some(kind)
+	}
+
+	if body.Priority.Defined {
+		if body.Priority.Value == nil {
+			opts.Priority = optional.Null[model.IssuePriority]()
+		} else {
+			priority, err := model.IssuePriorityString(*body.Priority.Value)
+			if err != nil {
+				return service.UpdateIssueTemplateOpts{}, err
+			}
+			opts.Priority = optional.Some(priority)
+		}
+	}
+
+	if body.Labels != nil {
+		labels, err := issueTemplateLabelsFromDTO(*body.Labels)
+		if err != nil {
+			return service.UpdateIssueTemplateOpts{}, err
+		}
+		opts.Labels = optional.Some(labels)
+	}
+
+	if body.Children != nil {
+		children, err := issueTemplateChildrenFromDTO(*body.Children)
+		if err != nil {
+			return service.UpdateIssueTemplateOpts{}, err
+		}
+		opts.Children = optional.Some(children)
+	}
+
+	return opts, nil
+}
+
+func issueTemplateLabelsFromDTO(ids []string) ([]model.ID, error) {
+	labels := make([]model.ID, len(ids))
+	for i, id := range ids {
+		label, err := model.NewIDFromString(id, model.ResourceTypeLabel.String())
+		if err != nil {
+			return nil, err
+		}
+		labels[i] = label
+	}
+	return labels, nil
+}
+
+func issueTemplateChildrenFromDTO(dtos []api.IssueTemplateChild) ([]model.IssueTemplateChild, error) {
+	children := make([]model.IssueTemplateChild, len(dtos))
+	for i, dto := range dtos {
+		kind, err := model.IssueKindString(string(dto.Kind))
+		if err != nil {
+			return nil, err
+		}
+
+		children[i] = model.IssueTemplateChild{
+			Kind:         kind,
+			TitlePattern: dto.TitlePattern,
+		}
+
+		if dto.Description != nil {
+			children[i].Description = *dto.Description
+		}
+
+		if dto.Priority != nil {
+			if children[i].Priority, err = model.IssuePriorityString(string(*dto.Priority)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return children, nil
+}
+
+func issueTemplateToDTO(template *service.IssueTemplate) api.IssueTemplate {
+	createdAt := time.Time{}
+	if template.CreatedAt != nil {
+		createdAt = *template.CreatedAt
+	}
+
+	var priority *api.IssuePriority
+	if template.Priority != 0 {
+		priority = convert.ToPointer(api.IssuePriority(template.Priority.String()))
+	}
+
+	labels := make([]string, len(template.Labels))
+	for i, label := range template.Labels {
+		labels[i] = label.String()
+	}
+
+	children := make([]api.IssueTemplateChild, len(template.Children))
+	for i, child := range template.Children {
+		children[i] = api.IssueTemplateChild{
+			Kind:         api.IssueKind(child.Kind.String()),
+			TitlePattern: child.TitlePattern,
+		}
+		if child.Description != "" {
+			children[i].Description = convert.ToPointer(child.Description)
+		}
+		if child.Priority != 0 {
+			children[i].Priority = convert.ToPointer(api.IssuePriority(child.Priority.String()))
+		}
+	}
+
+	return api.IssueTemplate{
+		Id:           template.ID.String(),
+		Project:      template.Project.String(),
+		Name:         template.Name,
+		Kind:         api.IssueKind(template.Kind.String()),
+		TitlePattern: template.TitlePattern,
+		Description:  template.Description,
+		Priority:     priority,
+		Labels:       labels,
+		Children:     children,
+		CreatedAt:    createdAt,
+		UpdatedAt:    template.UpdatedAt,
+	}
+}
+
+func issueTemplatePageToDTO(page service.Page[*service.IssueTemplate]) api.IssueTemplatePage {
+	items := make([]api.IssueTemplate, len(page.Items))
+	for i, template := range page.Items {
+		items[i] = issueTemplateToDTO(template)
+	}
+
+	return api.IssueTemplatePage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+func issueRecurrenceToDTO(recurrence *service.IssueRecurrence) api.IssueRecurrence {
+	createdAt := time.Time{}
+	if recurrence.CreatedAt != nil {
+		createdAt = *recurrence.CreatedAt
+	}
+
+	return api.IssueRecurrence{
+		Template:         recurrence.Template.String(),
+		Rule:             recurrence.Rule,
+		StartsAt:         recurrence.StartsAt,
+		Creator:          recurrence.Creator.String(),
+		NextOccurrenceAt: recurrence.NextOccurrenceAt,
+		CreatedAt:        createdAt,
+		UpdatedAt:        recurrence.UpdatedAt,
+	}
+}
+
+// NewIssueTemplateController creates a new IssueTemplateController.
+func NewIssueTemplateController(opts ...ControllerOption) (IssueTemplateController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.issueTemplateService == nil {
+		return nil, ErrNoIssueTemplateService
+	}
+
+	if controller.issueService == nil {
+		return nil, ErrNoIssueService
+	}
+
+	return &issueTemplateController{
+		baseController: controller,
+	}, nil
+}
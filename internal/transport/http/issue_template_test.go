@@ -0,0 +1,461 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestIssueTemplateController(t *testing.T, ts service.IssueTemplateService, is service.IssueService) IssueTemplateController {
+	t.Helper()
+	c, err := NewIssueTemplateController(WithIssueTemplateService(ts), WithIssueService(is))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceIssueTemplate(projectID model.ID) *service.IssueTemplate {
+	return &service.IssueTemplate{
+		ID:           model.MustNewID(model.ResourceTypeIssueTemplate),
+		Project:      projectID,
+		Name:         "Release",
+		Kind:         model.IssueKindEpic,
+		TitlePattern: "Release {title}",
+		Description:  "Ship the release",
+		Priority:     model.IssuePriorityHigh,
+		Labels:       []model.ID{model.MustNewID(model.ResourceTypeLabel)},
+		Children: []model.IssueTemplateChild{
+			{Kind: model.IssueKindTask, TitlePattern: "Changelog for {title}"},
+		},
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewIssueTemplateController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewIssueTemplateController(
+			WithIssueTemplateService(service.NewMockIssueTemplateService(ctrl)),
+			WithIssueService(service.NewMockIssueService(ctrl)),
+		)
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing issue template service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewIssueTemplateController()
+		assert.ErrorIs(t, err, ErrNoIssueTemplateService)
+	})
+
+	t.Run("missing issue service", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := NewIssueTemplateController(WithIssueTemplateService(service.NewMockIssueTemplateService(ctrl)))
+		assert.ErrorIs(t, err, ErrNoIssueService)
+	})
+}
+
+func TestIssueTemplateController_V1ProjectIssueTemplatesCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	template := newServiceIssueTemplate(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().Create(gomock.Any(), projectID, service.CreateIssueTemplateOpts{
+			Name:         template.Name,
+			Kind:         template.Kind,
+			TitlePattern: template.TitlePattern,
+			Description:  template.Description,
+			Priority:     template.Priority,
+			Labels:       template.Labels,
+			Children:     template.Children,
+		}).Return(template, nil)
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1ProjectIssueTemplatesCreate(context.Background(), api.V1ProjectIssueTemplatesCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectIssueTemplatesCreateJSONRequestBody{
+				Name:         template.Name,
+				Kind:         api.IssueKindEpic,
+				TitlePattern: convert.ToPointer(template.TitlePattern),
+				Description:  convert.ToPointer(template.Description),
+				Priority:     convert.ToPointer(api.IssuePriorityHigh),
+				Labels:       &[]string{template.Labels[0].String()},
+				Children: &[]api.IssueTemplateChild{
+					{Kind: api.IssueKindTask, TitlePattern: "Changelog for {title}"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectIssueTemplatesCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, template.ID.String(), got.Id)
+		assert.Equal(t, api.IssuePriorityHigh, *got.Priority)
+		assert.Equal(t, []string{template.Labels[0].String()}, got.Labels)
+		assert.Equal(t, []api.IssueTemplateChild{{Kind: api.IssueKindTask, TitlePattern: "Changelog for {title}"}}, got.Children)
+	})
+
+	t.Run("bad child kind", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueTemplateController(t, service.NewMockIssueTemplateService(ctrl), service.NewMockIssueService(ctrl))
+		resp, err := c.V1ProjectIssueTemplatesCreate(context.Background(), api.V1ProjectIssueTemplatesCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectIssueTemplatesCreateJSONRequestBody{
+				Name:     template.Name,
+				Kind:     api.IssueKindEpic,
+				Children: &[]api.IssueTemplateChild{{Kind: "chore", TitlePattern: "Changelog"}},
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectIssueTemplatesCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("label out of scope", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().Create(gomock.Any(), projectID, gomock.Any()).
+			Return(nil, errors.Join(service.ErrIssueTemplateCreate, model.ErrInvalidIssueTemplateDetails, service.ErrLabelOutOfScope))
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1ProjectIssueTemplatesCreate(context.Background(), api.V1ProjectIssueTemplatesCreateRequestObject{
+			Id:   projectID.String(),
+			Body: &api.V1ProjectIssueTemplatesCreateJSONRequestBody{Name: template.Name, Kind: api.IssueKindEpic},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectIssueTemplatesCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueTemplateController_V1ProjectIssueTemplatesGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	template := newServiceIssueTemplate(projectID)
+
+	ts := service.NewMockIssueTemplateService(ctrl)
+	ts.EXPECT().List(gomock.Any(), projectID, gomock.Any()).Return(service.Page[*service.IssueTemplate]{
+		Items: []*service.IssueTemplate{template},
+	}, nil)
+
+	c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+	resp, err := c.V1ProjectIssueTemplatesGet(context.Background(), api.V1ProjectIssueTemplatesGetRequestObject{Id: projectID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1ProjectIssueTemplatesGet200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, template.ID.String(), got.Items[0].Id)
+}
+
+func TestIssueTemplateController_V1IssueTemplateGet(t *testing.T) {
+	t.Parallel()
+
+	template := newServiceIssueTemplate(model.MustNewID(model.ResourceTypeProject))
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().Get(gomock.Any(), template.ID).Return(template, nil)
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateGet(context.Background(), api.V1IssueTemplateGetRequestObject{Id: template.ID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueTemplateGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "Release {title}", got.TitlePattern)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().Get(gomock.Any(), template.ID).Return(nil, errors.Join(service.ErrIssueTemplateGet, repository.ErrNotFound))
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateGet(context.Background(), api.V1IssueTemplateGetRequestObject{Id: template.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateGet404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueTemplateController_V1IssueTemplateUpdate(t *testing.T) {
+	t.Parallel()
+
+	template := newServiceIssueTemplate(model.MustNewID(model.ResourceTypeProject))
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().Update(gomock.Any(), template.ID, service.UpdateIssueTemplateOpts{
+			Name:     optional.Some("Hotfix"),
+			Kind:     optional.Some(model.IssueKindBug),
+			Priority: optional.Null[model.IssuePriority](),
+			Labels:   optional.Some([]model.ID{}),
+		}).Return(template, nil)
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateUpdate(context.Background(), api.V1IssueTemplateUpdateRequestObject{
+			Id: template.ID.String(),
+			Body: &api.V1IssueTemplateUpdateJSONRequestBody{
+				Name:     optional.Some("Hotfix"),
+				Kind:     convert.ToPointer(api.IssueKindBug),
+				Priority: optional.Null[string](),
+				Labels:   &[]string{},
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateUpdate200JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("bad priority", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueTemplateController(t, service.NewMockIssueTemplateService(ctrl), service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateUpdate(context.Background(), api.V1IssueTemplateUpdateRequestObject{
+			Id:   template.ID.String(),
+			Body: &api.V1IssueTemplateUpdateJSONRequestBody{Priority: optional.Some("urgent")},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueTemplateController_V1IssueTemplateDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	templateID := model.MustNewID(model.ResourceTypeIssueTemplate)
+
+	ts := service.NewMockIssueTemplateService(ctrl)
+	ts.EXPECT().Delete(gomock.Any(), templateID).Return(errors.Join(service.ErrIssueTemplateDelete, service.ErrNoPermission))
+
+	c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+	resp, err := c.V1IssueTemplateDelete(context.Background(), api.V1IssueTemplateDeleteRequestObject{Id: templateID.String()})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1IssueTemplateDelete403JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestIssueTemplateController_V1IssueTemplateIssuesCreate(t *testing.T) {
+	t.Parallel()
+
+	templateID := model.MustNewID(model.ResourceTypeIssueTemplate)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		issue := newServiceIssue()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().CreateFromTemplate(gomock.Any(), templateID, service.CreateIssueFromTemplateOpts{Title: "1.4"}).Return(issue, nil)
+
+		c := newTestIssueTemplateController(t, service.NewMockIssueTemplateService(ctrl), is)
+		resp, err := c.V1IssueTemplateIssuesCreate(context.Background(), api.V1IssueTemplateIssuesCreateRequestObject{
+			Id:   templateID.String(),
+			Body: &api.V1IssueTemplateIssuesCreateJSONRequestBody{Title: convert.ToPointer("1.4")},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueTemplateIssuesCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, issue.ID.String(), got.Id)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueTemplateController(t, service.NewMockIssueTemplateService(ctrl), service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateIssuesCreate(context.Background(), api.V1IssueTemplateIssuesCreateRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateIssuesCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueTemplateController_V1IssueTemplateRecurrenceGet(t *testing.T) {
+	t.Parallel()
+
+	templateID := model.MustNewID(model.ResourceTypeIssueTemplate)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		next := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+		recurrence := &service.IssueRecurrence{
+			Template:         templateID,
+			Rule:             "FREQ=WEEKLY",
+			StartsAt:         next.AddDate(0, 0, -7),
+			Creator:          model.MustNewID(model.ResourceTypeUser),
+			NextOccurrenceAt: &next,
+			CreatedAt:        convert.ToPointer(time.Now().UTC()),
+		}
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().GetRecurrence(gomock.Any(), templateID).Return(recurrence, nil)
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateRecurrenceGet(context.Background(), api.V1IssueTemplateRecurrenceGetRequestObject{Id: templateID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueTemplateRecurrenceGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, templateID.String(), got.Template)
+		assert.Equal(t, "FREQ=WEEKLY", got.Rule)
+		assert.Equal(t, recurrence.Creator.String(), got.Creator)
+		assert.Equal(t, &next, got.NextOccurrenceAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().GetRecurrence(gomock.Any(), templateID).Return(nil, errors.Join(service.ErrIssueRecurrenceGet, repository.ErrNotFound))
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateRecurrenceGet(context.Background(), api.V1IssueTemplateRecurrenceGetRequestObject{Id: templateID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateRecurrenceGet404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueTemplateController_V1IssueTemplateRecurrenceUpdate(t *testing.T) {
+	t.Parallel()
+
+	templateID := model.MustNewID(model.ResourceTypeIssueTemplate)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		startsAt := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+		opts := service.SetIssueRecurrenceOpts{Rule: "FREQ=MONTHLY", StartsAt: &startsAt}
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().SetRecurrence(gomock.Any(), templateID, opts).Return(&service.IssueRecurrence{
+			Template:         templateID,
+			Rule:             opts.Rule,
+			StartsAt:         startsAt,
+			Creator:          model.MustNewID(model.ResourceTypeUser),
+			NextOccurrenceAt: &startsAt,
+			CreatedAt:        convert.ToPointer(time.Now().UTC()),
+		}, nil)
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateRecurrenceUpdate(context.Background(), api.V1IssueTemplateRecurrenceUpdateRequestObject{
+			Id:   templateID.String(),
+			Body: &api.V1IssueTemplateRecurrenceUpdateJSONRequestBody{Rule: opts.Rule, StartsAt: &startsAt},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueTemplateRecurrenceUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "FREQ=MONTHLY", got.Rule)
+		assert.Equal(t, startsAt, got.StartsAt)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ts := service.NewMockIssueTemplateService(ctrl)
+		ts.EXPECT().SetRecurrence(gomock.Any(), templateID, service.SetIssueRecurrenceOpts{Rule: "FREQ=HOURLY"}).
+			Return(nil, errors.Join(service.ErrIssueRecurrenceSet, model.ErrInvalidIssueRecurrenceDetails))
+
+		c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateRecurrenceUpdate(context.Background(), api.V1IssueTemplateRecurrenceUpdateRequestObject{
+			Id:   templateID.String(),
+			Body: &api.V1IssueTemplateRecurrenceUpdateJSONRequestBody{Rule: "FREQ=HOURLY"},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateRecurrenceUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("missing body", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueTemplateController(t, service.NewMockIssueTemplateService(ctrl), service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueTemplateRecurrenceUpdate(context.Background(), api.V1IssueTemplateRecurrenceUpdateRequestObject{Id: templateID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueTemplateRecurrenceUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueTemplateController_V1IssueTemplateRecurrenceDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	templateID := model.MustNewID(model.ResourceTypeIssueTemplate)
+
+	ts := service.NewMockIssueTemplateService(ctrl)
+	ts.EXPECT().DeleteRecurrence(gomock.Any(), templateID).Return(nil)
+
+	c := newTestIssueTemplateController(t, ts, service.NewMockIssueService(ctrl))
+	resp, err := c.V1IssueTemplateRecurrenceDelete(context.Background(), api.V1IssueTemplateRecurrenceDeleteRequestObject{Id: templateID.String()})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1IssueTemplateRecurrenceDelete204Response)
+	assert.True(t, ok)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -270,6 +271,30 @@ func TestIssueController_V1ProjectsIssuesGet(t *testing.T) {
 		assert.True(t, ok)
 	})
 
+	t.Run("invalid query", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		queryErr := &service.IssueQueryError{Errors: []service.IssueQuerySyntaxError{
+			{Position: 7, Length: 7, Message: `unknown status "started"`},
+		}}
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().List(gomock.Any(), projectID, gomock.Any()).Return(service.Page[*service.PartialIssue]{}, errors.Join(service.ErrIssueGetAll, queryErr))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1ProjectsIssuesGet(context.Background(), api.V1ProjectsIssuesGetRequestObject{
+			Id:     projectID.String(),
+			Params: api.V1ProjectsIssuesGetParams{Query: convert.ToPointer("status:started")},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectsIssuesGet400JSONResponse)
+		require.True(t, ok)
+		require.NotNil(t, got.Errors)
+		assert.Equal(t, []api.HTTPErrorDetail{{Message: `unknown status "started"`, Position: 7, Length: 7}}, *got.Errors)
+	})
+
 	t.Run("no permission", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
@@ -896,182 +921,102 @@ func TestIssueController_V1IssueDelete(t *testing.T) {
 	})
 }
 
-func TestIssueToDTO(t *testing.T) {
+func TestIssueController_V1IssueRank(t *testing.T) {
 	t.Parallel()
 
 	issue := newServiceIssue()
-	assigneeID := model.MustNewID(model.ResourceTypeUser)
-	reviewerID := model.MustNewID(model.ResourceTypeUser)
-	issue.Assignments = []service.PartialAssignee{
-		{ID: assigneeID, Kind: model.AssignmentKindAssignee, FirstName: "Ada", LastName: "Lovelace"},
-		{ID: reviewerID, Kind: model.AssignmentKindReviewer, FirstName: "Grace", LastName: "Hopper"},
-	}
-	project := &service.PartialProject{
-		ID:     model.MustNewID(model.ResourceTypeProject),
-		Key:    "ENG",
-		Name:   "Engineering",
-		Status: model.ProjectStatusActive,
-	}
-	namespace := &service.PartialNamespace{
-		ID:   model.MustNewID(model.ResourceTypeNamespace),
-		Name: "Platform",
-	}
-	issue.Project = project
-	issue.Namespace = namespace
-	parent := &service.PartialIssue{
-		ID:          model.MustNewID(model.ResourceTypeIssue),
-		Key:         "ENG-1",
-		NumericID:   1,
-		Kind:        model.IssueKindEpic,
-		Title:       "Parent epic",
-		Status:      model.IssueStatusOpen,
-		Priority:    model.IssuePriorityHigh,
-		Assignments: make([]service.PartialAssignee, 0),
-		Labels:      make([]service.PartialLabel, 0),
-	}
-	issue.Parent = parent
-
-	dto := issueToDTO(issue)
-	assert.Equal(t, issue.ID.String(), dto.Id)
-	assert.Equal(t, issue.Key, dto.Key)
-	assert.Equal(t, int(issue.NumericID), dto.NumericId)
-	assert.Equal(t, issue.Title, dto.Title)
-	assert.Equal(t, []api.PartialUser{{
-		Id:        assigneeID.String(),
-		FirstName: "Ada",
-		LastName:  "Lovelace",
-	}}, dto.Assignees)
-	assert.Equal(t, []api.PartialUser{{
-		Id:        reviewerID.String(),
-		FirstName: "Grace",
-		LastName:  "Hopper",
-	}}, dto.Reviewers)
-	require.NotNil(t, dto.Project)
-	assert.Equal(t, project.ID.String(), dto.Project.Id)
-	assert.Equal(t, project.Key, dto.Project.Key)
-	assert.Equal(t, project.Name, dto.Project.Name)
-	require.NotNil(t, dto.Namespace)
-	assert.Equal(t, namespace.ID.String(), dto.Namespace.Id)
-	assert.Equal(t, namespace.Name, dto.Namespace.Name)
-	require.NotNil(t, dto.Parent)
-	assert.Equal(t, parent.ID.String(), dto.Parent.Id)
-	assert.Equal(t, parent.Key, dto.Parent.Key)
-	assert.Equal(t, parent.Title, dto.Parent.Title)
-	assert.Empty(t, dto.Parent.Reviewers)
-	require.NotNil(t, dto.CommentCount)
-	assert.Equal(t, int64(0), *dto.CommentCount)
-	require.NotNil(t, dto.DocumentCount)
-	assert.Equal(t, int64(0), *dto.DocumentCount)
+	beforeID := model.MustNewID(model.ResourceTypeIssue)
+	before := beforeID.String()
 
-	t.Run("nil created at does not panic", func(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		issue := newServiceIssue()
-		issue.CreatedAt = nil
-		dto := issueToDTO(issue)
-		assert.True(t, dto.CreatedAt.IsZero())
-	})
-}
-
-func TestUpdateIssueJSONRequestBodyToUpdateIssueOpts(t *testing.T) {
-	t.Parallel()
-
-	assigneeID := model.MustNewID(model.ResourceTypeUser)
-	reviewerID := model.MustNewID(model.ResourceTypeUser)
-
-	t.Run("maps assignees and reviewers", func(t *testing.T) {
-		t.Parallel()
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Rank(gomock.Any(), issue.ID, service.RankIssueOpts{Before: &beforeID}).Return(issue, nil)
 
-		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
-			Assignees: optional.Some([]string{assigneeID.String()}),
-			Reviewers: optional.Some([]string{reviewerID.String()}),
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRank(context.Background(), api.V1IssueRankRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueRankJSONRequestBody{Before: &before},
 		})
 		require.NoError(t, err)
-		require.True(t, opts.Assignees.Defined)
-		require.NotNil(t, opts.Assignees.Value)
-		assert.Equal(t, []model.ID{assigneeID}, *opts.Assignees.Value)
-		require.True(t, opts.Reviewers.Defined)
-		require.NotNil(t, opts.Reviewers.Value)
-		assert.Equal(t, []model.ID{reviewerID}, *opts.Reviewers.Value)
+		got, ok := resp.(api.V1IssueRank200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, issue.ID.String(), got.Id)
 	})
 
-	t.Run("empty arrays clear assignments", func(t *testing.T) {
+	t.Run("nil body", func(t *testing.T) {
 		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
-			Assignees: optional.Some([]string{}),
-			Reviewers: optional.Some([]string{}),
-		})
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueRank(context.Background(), api.V1IssueRankRequestObject{Id: issue.ID.String()})
 		require.NoError(t, err)
-		require.True(t, opts.Assignees.Defined)
-		require.NotNil(t, opts.Assignees.Value)
-		assert.Empty(t, *opts.Assignees.Value)
-		require.True(t, opts.Reviewers.Defined)
-		require.NotNil(t, opts.Reviewers.Value)
-		assert.Empty(t, *opts.Reviewers.Value)
+		_, ok := resp.(api.V1IssueRank400JSONResponse)
+		assert.True(t, ok)
 	})
 
-	t.Run("omitted assignment fields stay undefined", func(t *testing.T) {
+	t.Run("bad anchor", func(t *testing.T) {
 		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
-			Title: optional.Some("Updated title"),
+		bad := "bad"
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueRank(context.Background(), api.V1IssueRankRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueRankJSONRequestBody{After: &bad},
 		})
 		require.NoError(t, err)
-		assert.False(t, opts.Assignees.Defined)
-		assert.False(t, opts.Reviewers.Defined)
+		_, ok := resp.(api.V1IssueRank400JSONResponse)
+		assert.True(t, ok)
 	})
 
-	t.Run("maps parent", func(t *testing.T) {
+	t.Run("anchor outside project", func(t *testing.T) {
 		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		parentID := model.MustNewID(model.ResourceTypeIssue)
-		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
-			Parent: optional.Some(parentID.String()),
-		})
-		require.NoError(t, err)
-		require.True(t, opts.Parent.Defined)
-		require.NotNil(t, opts.Parent.Value)
-		assert.Equal(t, parentID, *opts.Parent.Value)
-	})
-
-	t.Run("null parent clears", func(t *testing.T) {
-		t.Parallel()
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Rank(gomock.Any(), issue.ID, gomock.Any()).Return(nil, repository.ErrIssueRankAnchor)
 
-		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
-			Parent: optional.Null[string](),
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRank(context.Background(), api.V1IssueRankRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueRankJSONRequestBody{Before: &before},
 		})
 		require.NoError(t, err)
-		require.True(t, opts.Parent.Defined)
-		assert.Nil(t, opts.Parent.Value)
+		_, ok := resp.(api.V1IssueRank400JSONResponse)
+		assert.True(t, ok)
 	})
 
-	t.Run("omitted parent stays undefined", func(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
 		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
-			Title: optional.Some("Updated title"),
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Rank(gomock.Any(), issue.ID, gomock.Any()).Return(nil, repository.ErrNotFound)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRank(context.Background(), api.V1IssueRankRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueRankJSONRequestBody{Before: &before},
 		})
 		require.NoError(t, err)
-		assert.False(t, opts.Parent.Defined)
+		_, ok := resp.(api.V1IssueRank404JSONResponse)
+		assert.True(t, ok)
 	})
 }
 
-func newServiceIssueRelation(issue *service.Issue) *service.IssueRelation {
-	return &service.IssueRelation{
-		ID:        model.MustNewID(model.ResourceTypeIssueRelation),
-		Kind:      model.IssueRelationKindBlocks,
-		Direction: service.IssueRelationDirectionOutgoing,
-		Related:   newServicePartialIssue(issue),
-		CreatedAt: convert.ToPointer(time.Now().UTC()),
-	}
-}
-
-func TestIssueController_V1IssueRelationsGet(t *testing.T) {
+func TestIssueController_V1IssueMove(t *testing.T) {
 	t.Parallel()
 
 	issue := newServiceIssue()
-	relation := newServiceIssueRelation(issue)
+	projectID := model.MustNewID(model.ResourceTypeProject)
 
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
@@ -1079,121 +1024,950 @@ func TestIssueController_V1IssueRelationsGet(t *testing.T) {
 		defer ctrl.Finish()
 
 		is := service.NewMockIssueService(ctrl)
-		is.EXPECT().ListRelations(gomock.Any(), issue.ID, gomock.Any()).Return(service.Page[*service.IssueRelation]{
-			Items: []*service.IssueRelation{relation},
-		}, nil)
+		is.EXPECT().Move(gomock.Any(), issue.ID, projectID).Return(issue, nil)
 
 		c := newTestIssueController(t, is)
-		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{
-			Id:     issue.ID.String(),
-			Params: api.V1IssueRelationsGetParams{},
+		resp, err := c.V1IssueMove(context.Background(), api.V1IssueMoveRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueMoveJSONRequestBody{ProjectId: projectID.String()},
 		})
 		require.NoError(t, err)
-		got, ok := resp.(api.V1IssueRelationsGet200JSONResponse)
+		got, ok := resp.(api.V1IssueMove200JSONResponse)
 		require.True(t, ok)
-		require.Len(t, got.Items, 1)
-		assert.Equal(t, relation.ID.String(), got.Items[0].Id)
-		assert.Equal(t, api.IssueRelationKindBlocks, got.Items[0].Kind)
-		assert.Equal(t, api.IssueRelationDirectionOutgoing, got.Items[0].Direction)
+		assert.Equal(t, issue.ID.String(), got.Id)
 	})
 
-	t.Run("bad issue id", func(t *testing.T) {
+	t.Run("nil body", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
-		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{Id: "bad"})
+		resp, err := c.V1IssueMove(context.Background(), api.V1IssueMoveRequestObject{Id: issue.ID.String()})
 		require.NoError(t, err)
-		_, ok := resp.(api.V1IssueRelationsGet400JSONResponse)
+		_, ok := resp.(api.V1IssueMove400JSONResponse)
 		assert.True(t, ok)
 	})
 
-	t.Run("no permission", func(t *testing.T) {
+	t.Run("bad project id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueMove(context.Background(), api.V1IssueMoveRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueMoveJSONRequestBody{ProjectId: "bad"},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueMove400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("same project", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		is := service.NewMockIssueService(ctrl)
-		is.EXPECT().ListRelations(gomock.Any(), issue.ID, gomock.Any()).Return(service.Page[*service.IssueRelation]{}, service.ErrNoPermission)
+		is.EXPECT().Move(gomock.Any(), issue.ID, projectID).Return(nil, errors.Join(service.ErrIssueMove, service.ErrIssueMoveProject))
 
 		c := newTestIssueController(t, is)
-		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{Id: issue.ID.String()})
+		resp, err := c.V1IssueMove(context.Background(), api.V1IssueMoveRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueMoveJSONRequestBody{ProjectId: projectID.String()},
+		})
 		require.NoError(t, err)
-		_, ok := resp.(api.V1IssueRelationsGet403JSONResponse)
+		_, ok := resp.(api.V1IssueMove400JSONResponse)
 		assert.True(t, ok)
 	})
 
-	t.Run("not found", func(t *testing.T) {
+	t.Run("no permission", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		is := service.NewMockIssueService(ctrl)
-		is.EXPECT().ListRelations(gomock.Any(), issue.ID, gomock.Any()).Return(
-			service.Page[*service.IssueRelation]{},
-			errors.Join(service.ErrIssueGetRelations, repository.ErrNotFound),
-		)
+		is.EXPECT().Move(gomock.Any(), issue.ID, projectID).Return(nil, errors.Join(service.ErrIssueMove, service.ErrNoPermission))
 
 		c := newTestIssueController(t, is)
-		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{Id: issue.ID.String()})
+		resp, err := c.V1IssueMove(context.Background(), api.V1IssueMoveRequestObject{
+			Id:   issue.ID.String(),
+			Body: &api.V1IssueMoveJSONRequestBody{ProjectId: projectID.String()},
+		})
 		require.NoError(t, err)
-		_, ok := resp.(api.V1IssueRelationsGet404JSONResponse)
+		_, ok := resp.(api.V1IssueMove403JSONResponse)
 		assert.True(t, ok)
 	})
 }
 
-func TestIssueController_V1IssueRelationsCreate(t *testing.T) {
+func TestIssueController_V1IssueClone(t *testing.T) {
 	t.Parallel()
 
 	issue := newServiceIssue()
-	related := newServiceIssue()
-	relation := newServiceIssueRelation(related)
 
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
+		clone := newServiceIssue()
+
 		is := service.NewMockIssueService(ctrl)
-		is.EXPECT().AddRelation(gomock.Any(), issue.ID, related.ID, model.IssueRelationKindBlocks).Return(relation, nil)
+		is.EXPECT().Clone(gomock.Any(), issue.ID, service.CloneIssueOpts{Subtasks: true, Labels: true}).Return(clone, nil)
 
 		c := newTestIssueController(t, is)
-		resp, err := c.V1IssueRelationsCreate(context.Background(), api.V1IssueRelationsCreateRequestObject{
+		resp, err := c.V1IssueClone(context.Background(), api.V1IssueCloneRequestObject{
 			Id: issue.ID.String(),
-			Body: &api.V1IssueRelationsCreateJSONRequestBody{
-				RelatedId: related.ID.String(),
-				Kind:      api.IssueRelationKindBlocks,
+			Body: &api.V1IssueCloneJSONRequestBody{
+				Subtasks:  convert.ToPointer(true),
+				Relations: convert.ToPointer(false),
+				Labels:    convert.ToPointer(true),
 			},
 		})
 		require.NoError(t, err)
-		got, ok := resp.(api.V1IssueRelationsCreate201JSONResponse)
+		got, ok := resp.(api.V1IssueClone201JSONResponse)
 		require.True(t, ok)
-		assert.Equal(t, relation.ID.String(), got.Id)
-		assert.Equal(t, api.IssueRelationKindBlocks, got.Kind)
+		assert.Equal(t, clone.ID.String(), got.Id)
 	})
 
-	t.Run("self relation", func(t *testing.T) {
+	t.Run("nil body", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		is := service.NewMockIssueService(ctrl)
-		is.EXPECT().AddRelation(gomock.Any(), issue.ID, issue.ID, model.IssueRelationKindBlocks).Return(
-			nil,
-			errors.Join(service.ErrIssueAddRelation, service.ErrIssueSelfRelation),
-		)
+		is.EXPECT().Clone(gomock.Any(), issue.ID, service.CloneIssueOpts{}).Return(issue, nil)
 
 		c := newTestIssueController(t, is)
-		resp, err := c.V1IssueRelationsCreate(context.Background(), api.V1IssueRelationsCreateRequestObject{
-			Id: issue.ID.String(),
-			Body: &api.V1IssueRelationsCreateJSONRequestBody{
-				RelatedId: issue.ID.String(),
-				Kind:      api.IssueRelationKindBlocks,
-			},
-		})
+		resp, err := c.V1IssueClone(context.Background(), api.V1IssueCloneRequestObject{Id: issue.ID.String()})
 		require.NoError(t, err)
-		_, ok := resp.(api.V1IssueRelationsCreate400JSONResponse)
+		_, ok := resp.(api.V1IssueClone201JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueClone(context.Background(), api.V1IssueCloneRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueClone400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Clone(gomock.Any(), issue.ID, service.CloneIssueOpts{}).Return(nil, errors.Join(service.ErrIssueClone, service.ErrNoPermission))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueClone(context.Background(), api.V1IssueCloneRequestObject{Id: issue.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueClone403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssueDependencyGraphGet(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	blocked := newServiceIssue()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		relation := &model.IssueRelation{
+			ID:        model.MustNewID(model.ResourceTypeIssueRelation),
+			Source:    issue.ID,
+			Target:    blocked.ID,
+			Kind:      model.IssueRelationKindBlocks,
+			CreatedAt: convert.ToPointer(time.Now().UTC()),
+		}
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetDependencyGraph(gomock.Any(), issue.ID, 2).Return(&service.IssueDependencyGraph{
+			Issues:    []*service.PartialIssue{newServicePartialIssue(issue), newServicePartialIssue(blocked)},
+			Relations: []*model.IssueRelation{relation},
+		}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueDependencyGraphGet(context.Background(), api.V1IssueDependencyGraphGetRequestObject{
+			Id:     issue.ID.String(),
+			Params: api.V1IssueDependencyGraphGetParams{Depth: convert.ToPointer(2)},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueDependencyGraphGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Issues, 2)
+		assert.Equal(t, issue.ID.String(), got.Issues[0].Id)
+		assert.Equal(t, []api.IssueDependency{{
+			Id:        relation.ID.String(),
+			Kind:      api.IssueRelationKindBlocks,
+			Source:    issue.ID.String(),
+			Target:    blocked.ID.String(),
+			CreatedAt: *relation.CreatedAt,
+		}}, got.Relations)
+	})
+
+	t.Run("default depth", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetDependencyGraph(gomock.Any(), issue.ID, 0).Return(&service.IssueDependencyGraph{}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueDependencyGraphGet(context.Background(), api.V1IssueDependencyGraphGetRequestObject{Id: issue.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueDependencyGraphGet200JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueDependencyGraphGet(context.Background(), api.V1IssueDependencyGraphGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueDependencyGraphGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid depth", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetDependencyGraph(gomock.Any(), issue.ID, 20).Return(nil, errors.Join(service.ErrIssueGetDependencyGraph, service.ErrIssueDependencyDepth))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueDependencyGraphGet(context.Background(), api.V1IssueDependencyGraphGetRequestObject{
+			Id:     issue.ID.String(),
+			Params: api.V1IssueDependencyGraphGetParams{Depth: convert.ToPointer(20)},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueDependencyGraphGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetDependencyGraph(gomock.Any(), issue.ID, 0).Return(nil, errors.Join(service.ErrIssueGetDependencyGraph, service.ErrNoPermission))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueDependencyGraphGet(context.Background(), api.V1IssueDependencyGraphGetRequestObject{Id: issue.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueDependencyGraphGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssuesRollupsGet(t *testing.T) {
+	t.Parallel()
+
+	epicID := model.MustNewID(model.ResourceTypeIssue)
+	parentID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dueDate := time.Now().UTC()
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetRollups(gomock.Any(), []model.ID{epicID, parentID}).Return([]*service.IssueRollup{
+			{ID: epicID, IssueRollup: model.IssueRollup{Todo: 1, InProgress: 2, Done: 1, OriginalEstimate: convert.ToPointer(uint(90)), DueDate: &dueDate}},
+			{ID: parentID},
+		}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssuesRollupsGet(context.Background(), api.V1IssuesRollupsGetRequestObject{
+			Params: api.V1IssuesRollupsGetParams{Ids: []string{epicID.String(), parentID.String()}},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssuesRollupsGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, api.V1IssuesRollupsGet200JSONResponse{
+			{
+				Id:               epicID.String(),
+				TodoCount:        1,
+				InProgressCount:  2,
+				DoneCount:        1,
+				TotalCount:       4,
+				PercentDone:      25,
+				OriginalEstimate: convert.ToPointer(90),
+				DueDate:          &dueDate,
+			},
+			{Id: parentID.String()},
+		}, got)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssuesRollupsGet(context.Background(), api.V1IssuesRollupsGetRequestObject{
+			Params: api.V1IssuesRollupsGetParams{Ids: []string{"bad"}},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssuesRollupsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("too many issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetRollups(gomock.Any(), []model.ID{epicID}).Return(nil, errors.Join(service.ErrIssueGetRollups, service.ErrIssueRollupSize))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssuesRollupsGet(context.Background(), api.V1IssuesRollupsGetRequestObject{
+			Params: api.V1IssuesRollupsGetParams{Ids: []string{epicID.String()}},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssuesRollupsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetRollups(gomock.Any(), []model.ID{epicID}).Return(nil, errors.Join(service.ErrIssueGetRollups, assert.AnError))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssuesRollupsGet(context.Background(), api.V1IssuesRollupsGetRequestObject{
+			Params: api.V1IssuesRollupsGetParams{Ids: []string{epicID.String()}},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssuesRollupsGet500JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1ProjectCriticalPathGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		first, second := newServiceIssue(), newServiceIssue()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetCriticalPath(gomock.Any(), projectID).Return(&service.CriticalPath{
+			Steps: []*service.CriticalPathStep{
+				{Issue: newServicePartialIssue(first), Duration: 2 * time.Hour, EarliestFinish: 2 * time.Hour},
+				{Issue: newServicePartialIssue(second), Duration: 30 * time.Minute, EarliestStart: 2 * time.Hour, EarliestFinish: 150 * time.Minute},
+			},
+			Duration: 150 * time.Minute,
+		}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1ProjectCriticalPathGet(context.Background(), api.V1ProjectCriticalPathGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectCriticalPathGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, 150, got.Duration)
+		require.Len(t, got.Steps, 2)
+		assert.Equal(t, first.ID.String(), got.Steps[0].Issue.Id)
+		assert.Equal(t, 120, got.Steps[0].Duration)
+		assert.Equal(t, 120, got.Steps[1].EarliestStart)
+		assert.Equal(t, 150, got.Steps[1].EarliestFinish)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1ProjectCriticalPathGet(context.Background(), api.V1ProjectCriticalPathGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectCriticalPathGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetCriticalPath(gomock.Any(), projectID).Return(nil, errors.Join(service.ErrIssueGetCriticalPath, model.ErrIssueDependencyCycle))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1ProjectCriticalPathGet(context.Background(), api.V1ProjectCriticalPathGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectCriticalPathGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetCriticalPath(gomock.Any(), projectID).Return(nil, errors.Join(service.ErrIssueGetCriticalPath, service.ErrNoPermission))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1ProjectCriticalPathGet(context.Background(), api.V1ProjectCriticalPathGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectCriticalPathGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssuesBulk(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	deniedID := model.MustNewID(model.ResourceTypeIssue)
+	title := "updated title"
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Bulk(gomock.Any(), service.BulkIssueOpts{
+			IDs:       []model.ID{issue.ID, deniedID},
+			Operation: service.BulkIssueOperationUpdate,
+			Update:    service.UpdateIssueOpts{Title: optional.Some(title)},
+		}).Return([]*service.BulkIssueResult{
+			{ID: issue.ID, Issue: issue},
+			{ID: deniedID, Err: errors.Join(service.ErrIssueUpdate, service.ErrNoPermission)},
+		}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssuesBulk(context.Background(), api.V1IssuesBulkRequestObject{
+			Body: &api.V1IssuesBulkJSONRequestBody{
+				Ids:       []string{issue.ID.String(), deniedID.String()},
+				Operation: api.IssueBulkOperationUpdate,
+				Patch:     &api.IssuePatch{Title: optional.Some(title)},
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssuesBulk200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, http.StatusOK, got.Items[0].Status)
+		require.NotNil(t, got.Items[0].Issue)
+		assert.Equal(t, issue.ID.String(), got.Items[0].Issue.Id)
+		assert.Equal(t, http.StatusForbidden, got.Items[1].Status)
+		assert.Equal(t, deniedID.String(), got.Items[1].Id)
+		assert.Nil(t, got.Items[1].Issue)
+		assert.NotNil(t, got.Items[1].Message)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Bulk(gomock.Any(), service.BulkIssueOpts{
+			IDs:       []model.ID{issue.ID},
+			Operation: service.BulkIssueOperationDelete,
+		}).Return([]*service.BulkIssueResult{{ID: issue.ID}}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssuesBulk(context.Background(), api.V1IssuesBulkRequestObject{
+			Body: &api.V1IssuesBulkJSONRequestBody{
+				Ids:       []string{issue.ID.String()},
+				Operation: api.IssueBulkOperationDelete,
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssuesBulk200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, http.StatusNoContent, got.Items[0].Status)
+		assert.Nil(t, got.Items[0].Message)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		for name, body := range map[string]*api.V1IssuesBulkJSONRequestBody{
+			"nil body":          nil,
+			"unknown operation": {Ids: []string{issue.ID.String()}, Operation: "archive"},
+			"bad id":            {Ids: []string{"bad"}, Operation: api.IssueBulkOperationDelete},
+			"missing patch":     {Ids: []string{issue.ID.String()}, Operation: api.IssueBulkOperationUpdate},
+		} {
+			ctrl := gomock.NewController(t)
+			c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+			resp, err := c.V1IssuesBulk(context.Background(), api.V1IssuesBulkRequestObject{Body: body})
+			require.NoError(t, err, name)
+			_, ok := resp.(api.V1IssuesBulk400JSONResponse)
+			assert.True(t, ok, name)
+		}
+	})
+
+	t.Run("too many issues", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Bulk(gomock.Any(), gomock.Any()).Return(nil, errors.Join(service.ErrIssueBulk, service.ErrIssueBulkSize))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssuesBulk(context.Background(), api.V1IssuesBulkRequestObject{
+			Body: &api.V1IssuesBulkJSONRequestBody{
+				Ids:       []string{issue.ID.String()},
+				Operation: api.IssueBulkOperationDelete,
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssuesBulk400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueToDTO(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	assigneeID := model.MustNewID(model.ResourceTypeUser)
+	reviewerID := model.MustNewID(model.ResourceTypeUser)
+	issue.Assignments = []service.PartialAssignee{
+		{ID: assigneeID, Kind: model.AssignmentKindAssignee, FirstName: "Ada", LastName: "Lovelace"},
+		{ID: reviewerID, Kind: model.AssignmentKindReviewer, FirstName: "Grace", LastName: "Hopper"},
+	}
+	project := &service.PartialProject{
+		ID:     model.MustNewID(model.ResourceTypeProject),
+		Key:    "ENG",
+		Name:   "Engineering",
+		Status: model.ProjectStatusActive,
+	}
+	namespace := &service.PartialNamespace{
+		ID:   model.MustNewID(model.ResourceTypeNamespace),
+		Name: "Platform",
+	}
+	issue.Project = project
+	issue.Namespace = namespace
+	parent := &service.PartialIssue{
+		ID:          model.MustNewID(model.ResourceTypeIssue),
+		Key:         "ENG-1",
+		NumericID:   1,
+		Kind:        model.IssueKindEpic,
+		Title:       "Parent epic",
+		Status:      model.IssueStatusOpen,
+		Priority:    model.IssuePriorityHigh,
+		Assignments: make([]service.PartialAssignee, 0),
+		Labels:      make([]service.PartialLabel, 0),
+	}
+	issue.Parent = parent
+
+	dto := issueToDTO(issue)
+	assert.Equal(t, issue.ID.String(), dto.Id)
+	assert.Equal(t, issue.Key, dto.Key)
+	assert.Equal(t, int(issue.NumericID), dto.NumericId)
+	assert.Equal(t, issue.Title, dto.Title)
+	assert.Equal(t, []api.PartialUser{{
+		Id:        assigneeID.String(),
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+	}}, dto.Assignees)
+	assert.Equal(t, []api.PartialUser{{
+		Id:        reviewerID.String(),
+		FirstName: "Grace",
+		LastName:  "Hopper",
+	}}, dto.Reviewers)
+	require.NotNil(t, dto.Project)
+	assert.Equal(t, project.ID.String(), dto.Project.Id)
+	assert.Equal(t, project.Key, dto.Project.Key)
+	assert.Equal(t, project.Name, dto.Project.Name)
+	require.NotNil(t, dto.Namespace)
+	assert.Equal(t, namespace.ID.String(), dto.Namespace.Id)
+	assert.Equal(t, namespace.Name, dto.Namespace.Name)
+	require.NotNil(t, dto.Parent)
+	assert.Equal(t, parent.ID.String(), dto.Parent.Id)
+	assert.Equal(t, parent.Key, dto.Parent.Key)
+	assert.Equal(t, parent.Title, dto.Parent.Title)
+	assert.Empty(t, dto.Parent.Reviewers)
+	require.NotNil(t, dto.CommentCount)
+	assert.Equal(t, int64(0), *dto.CommentCount)
+	require.NotNil(t, dto.DocumentCount)
+	assert.Equal(t, int64(0), *dto.DocumentCount)
+
+	t.Run("nil created at does not panic", func(t *testing.T) {
+		t.Parallel()
+
+		issue := newServiceIssue()
+		issue.CreatedAt = nil
+		dto := issueToDTO(issue)
+		assert.True(t, dto.CreatedAt.IsZero())
+	})
+
+	t.Run("rollup", func(t *testing.T) {
+		t.Parallel()
+
+		issue := newServiceIssue()
+		assert.Nil(t, issueToDTO(issue).Rollup)
+
+		issue.Rollup = &model.IssueRollup{Todo: 1, Done: 1}
+		dto := issueToDTO(issue)
+		require.NotNil(t, dto.Rollup)
+		assert.Equal(t, issue.ID.String(), dto.Rollup.Id)
+		assert.Equal(t, 2, dto.Rollup.TotalCount)
+		assert.Equal(t, float64(50), dto.Rollup.PercentDone)
+	})
+}
+
+func TestUpdateIssueJSONRequestBodyToUpdateIssueOpts(t *testing.T) {
+	t.Parallel()
+
+	assigneeID := model.MustNewID(model.ResourceTypeUser)
+	reviewerID := model.MustNewID(model.ResourceTypeUser)
+
+	t.Run("maps assignees and reviewers", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			Assignees: optional.Some([]string{assigneeID.String()}),
+			Reviewers: optional.Some([]string{reviewerID.String()}),
+		})
+		require.NoError(t, err)
+		require.True(t, opts.Assignees.Defined)
+		require.NotNil(t, opts.Assignees.Value)
+		assert.Equal(t, []model.ID{assigneeID}, *opts.Assignees.Value)
+		require.True(t, opts.Reviewers.Defined)
+		require.NotNil(t, opts.Reviewers.Value)
+		assert.Equal(t, []model.ID{reviewerID}, *opts.Reviewers.Value)
+	})
+
+	t.Run("empty arrays clear assignments", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			Assignees: optional.Some([]string{}),
+			Reviewers: optional.Some([]string{}),
+		})
+		require.NoError(t, err)
+		require.True(t, opts.Assignees.Defined)
+		require.NotNil(t, opts.Assignees.Value)
+		assert.Empty(t, *opts.Assignees.Value)
+		require.True(t, opts.Reviewers.Defined)
+		require.NotNil(t, opts.Reviewers.Value)
+		assert.Empty(t, *opts.Reviewers.Value)
+	})
+
+	t.Run("omitted assignment fields stay undefined", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			Title: optional.Some("Updated title"),
+		})
+		require.NoError(t, err)
+		assert.False(t, opts.Assignees.Defined)
+		assert.False(t, opts.Reviewers.Defined)
+	})
+
+	t.Run("maps parent", func(t *testing.T) {
+		t.Parallel()
+
+		parentID := model.MustNewID(model.ResourceTypeIssue)
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			Parent: optional.Some(parentID.String()),
+		})
+		require.NoError(t, err)
+		require.True(t, opts.Parent.Defined)
+		require.NotNil(t, opts.Parent.Value)
+		assert.Equal(t, parentID, *opts.Parent.Value)
+	})
+
+	t.Run("null parent clears", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			Parent: optional.Null[string](),
+		})
+		require.NoError(t, err)
+		require.True(t, opts.Parent.Defined)
+		assert.Nil(t, opts.Parent.Value)
+	})
+
+	t.Run("omitted parent stays undefined", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			Title: optional.Some("Updated title"),
+		})
+		require.NoError(t, err)
+		assert.False(t, opts.Parent.Defined)
+	})
+
+	t.Run("maps workflow status", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			WorkflowStatus: optional.Some("in_review"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, optional.Some("in_review"), opts.WorkflowStatus)
+		assert.False(t, opts.Status.Defined)
+	})
+
+	t.Run("maps estimates", func(t *testing.T) {
+		t.Parallel()
+
+		opts, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			StoryPoints:       optional.Some(3.5),
+			OriginalEstimate:  optional.Null[int](),
+			RemainingEstimate: optional.Some(90),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, optional.Some(3.5), opts.StoryPoints)
+		assert.Equal(t, optional.Null[uint](), opts.OriginalEstimate)
+		assert.Equal(t, optional.Some(uint(90)), opts.RemainingEstimate)
+	})
+
+	t.Run("rejects negative estimates", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := updateIssueJSONRequestBodyToUpdateIssueOpts(&api.V1IssueUpdateJSONRequestBody{
+			RemainingEstimate: optional.Some(-1),
+		})
+		assert.ErrorIs(t, err, errNegativeMinutes)
+	})
+}
+
+func newServiceIssueRelation(issue *service.Issue) *service.IssueRelation {
+	return &service.IssueRelation{
+		ID:        model.MustNewID(model.ResourceTypeIssueRelation),
+		Kind:      model.IssueRelationKindBlocks,
+		Direction: service.IssueRelationDirectionOutgoing,
+		Related:   newServicePartialIssue(issue),
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestIssueController_V1IssueRelationsGet(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	relation := newServiceIssueRelation(issue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListRelations(gomock.Any(), issue.ID, gomock.Any()).Return(service.Page[*service.IssueRelation]{
+			Items: []*service.IssueRelation{relation},
+		}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{
+			Id:     issue.ID.String(),
+			Params: api.V1IssueRelationsGetParams{},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueRelationsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, relation.ID.String(), got.Items[0].Id)
+		assert.Equal(t, api.IssueRelationKindBlocks, got.Items[0].Kind)
+		assert.Equal(t, api.IssueRelationDirectionOutgoing, got.Items[0].Direction)
+	})
+
+	t.Run("bad issue id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueRelationsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListRelations(gomock.Any(), issue.ID, gomock.Any()).Return(service.Page[*service.IssueRelation]{}, service.ErrNoPermission)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{Id: issue.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueRelationsGet403JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListRelations(gomock.Any(), issue.ID, gomock.Any()).Return(
+			service.Page[*service.IssueRelation]{},
+			errors.Join(service.ErrIssueGetRelations, repository.ErrNotFound),
+		)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRelationsGet(context.Background(), api.V1IssueRelationsGetRequestObject{Id: issue.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueRelationsGet404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssueActivityGet(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	actor := model.MustNewID(model.ResourceTypeUser)
+	activity := &service.IssueActivity{
+		ID:        model.MustNewID(model.ResourceTypeIssueActivity),
+		Kind:      service.IssueActivityKindFieldChanged,
+		Field:     convert.ToPointer("status"),
+		OldValue:  []string{"open"},
+		NewValue:  []string{"done"},
+		Actor:     &actor,
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListActivity(gomock.Any(), issue.ID, gomock.Any()).Return(service.Page[*service.IssueActivity]{
+			Items: []*service.IssueActivity{activity},
+		}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueActivityGet(context.Background(), api.V1IssueActivityGetRequestObject{
+			Id:     issue.ID.String(),
+			Params: api.V1IssueActivityGetParams{},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueActivityGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, activity.ID.String(), got.Items[0].Id)
+		assert.Equal(t, api.IssueActivityKindFieldChanged, got.Items[0].Kind)
+		assert.Equal(t, []string{"done"}, got.Items[0].NewValue)
+		assert.Equal(t, convert.ToPointer(actor.String()), got.Items[0].Actor)
+		assert.Nil(t, got.Items[0].Subject)
+	})
+
+	t.Run("bad issue id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueActivityGet(context.Background(), api.V1IssueActivityGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueActivityGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListActivity(gomock.Any(), issue.ID, gomock.Any()).Return(service.Page[*service.IssueActivity]{}, service.ErrNoPermission)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueActivityGet(context.Background(), api.V1IssueActivityGetRequestObject{Id: issue.ID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueActivityGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssueRelationsCreate(t *testing.T) {
+	t.Parallel()
+
+	issue := newServiceIssue()
+	related := newServiceIssue()
+	relation := newServiceIssueRelation(related)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().AddRelation(gomock.Any(), issue.ID, related.ID, model.IssueRelationKindBlocks).Return(relation, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRelationsCreate(context.Background(), api.V1IssueRelationsCreateRequestObject{
+			Id: issue.ID.String(),
+			Body: &api.V1IssueRelationsCreateJSONRequestBody{
+				RelatedId: related.ID.String(),
+				Kind:      api.IssueRelationKindBlocks,
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueRelationsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, relation.ID.String(), got.Id)
+		assert.Equal(t, api.IssueRelationKindBlocks, got.Kind)
+	})
+
+	t.Run("self relation", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().AddRelation(gomock.Any(), issue.ID, issue.ID, model.IssueRelationKindBlocks).Return(
+			nil,
+			errors.Join(service.ErrIssueAddRelation, service.ErrIssueSelfRelation),
+		)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueRelationsCreate(context.Background(), api.V1IssueRelationsCreateRequestObject{
+			Id: issue.ID.String(),
+			Body: &api.V1IssueRelationsCreateJSONRequestBody{
+				RelatedId: issue.ID.String(),
+				Kind:      api.IssueRelationKindBlocks,
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueRelationsCreate400JSONResponse)
 		assert.True(t, ok)
 	})
 
@@ -1409,3 +2183,128 @@ func TestIssueController_V1IssueRelationDelete(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestIssueController_V1IssueWatchersGet(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		watcher := &service.PartialUser{
+			ID:        model.MustNewID(model.ResourceTypeUser),
+			FirstName: "Test",
+			LastName:  "User",
+		}
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetWatchers(gomock.Any(), issueID).Return([]*service.PartialUser{watcher}, nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueWatchersGet(context.Background(), api.V1IssueWatchersGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueWatchersGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got, 1)
+		assert.Equal(t, watcher.ID.String(), got[0].Id)
+	})
+
+	t.Run("bad issue id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueWatchersGet(context.Background(), api.V1IssueWatchersGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWatchersGet400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().GetWatchers(gomock.Any(), issueID).Return(nil, errors.Join(service.ErrIssueGetWatchers, service.ErrNoPermission))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueWatchersGet(context.Background(), api.V1IssueWatchersGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWatchersGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssueWatch(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Watch(gomock.Any(), issueID).Return(nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueWatch(context.Background(), api.V1IssueWatchRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWatch204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Watch(gomock.Any(), issueID).Return(errors.Join(service.ErrIssueWatch, repository.ErrNotFound))
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueWatch(context.Background(), api.V1IssueWatchRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWatch404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestIssueController_V1IssueUnwatch(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().Unwatch(gomock.Any(), issueID).Return(nil)
+
+		c := newTestIssueController(t, is)
+		resp, err := c.V1IssueUnwatch(context.Background(), api.V1IssueUnwatchRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueUnwatch204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("bad issue id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestIssueController(t, service.NewMockIssueService(ctrl))
+		resp, err := c.V1IssueUnwatch(context.Background(), api.V1IssueUnwatchRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueUnwatch400JSONResponse)
+		assert.True(t, ok)
+	})
+}
@@ -2,15 +2,32 @@ package http
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"time"
 
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/opcotech/elemo/internal/service"
 	"github.com/opcotech/elemo/internal/transport/http/api"
 )
 
-// LabelController is the controller for label endpoints.
+// LabelController is the controller for label endpoints, including the
+// organization and project label lists and the issue and document label
+// attachments.
 type LabelController interface {
 	V1LabelsGet(ctx context.Context, request api.V1LabelsGetRequestObject) (api.V1LabelsGetResponseObject, error)
+	V1LabelGet(ctx context.Context, request api.V1LabelGetRequestObject) (api.V1LabelGetResponseObject, error)
+	V1LabelUpdate(ctx context.Context, request api.V1LabelUpdateRequestObject) (api.V1LabelUpdateResponseObject, error)
+	V1LabelDelete(ctx context.Context, request api.V1LabelDeleteRequestObject) (api.V1LabelDeleteResponseObject, error)
+	V1OrganizationLabelsGet(ctx context.Context, request api.V1OrganizationLabelsGetRequestObject) (api.V1OrganizationLabelsGetResponseObject, error)
+	V1OrganizationLabelsCreate(ctx context.Context, request api.V1OrganizationLabelsCreateRequestObject) (api.V1OrganizationLabelsCreateResponseObject, error)
+	V1ProjectLabelsGet(ctx context.Context, request api.V1ProjectLabelsGetRequestObject) (api.V1ProjectLabelsGetResponseObject, error)
+	V1ProjectLabelsCreate(ctx context.Context, request api.V1ProjectLabelsCreateRequestObject) (api.V1ProjectLabelsCreateResponseObject, error)
+	V1IssueLabelAttach(ctx context.Context, request api.V1IssueLabelAttachRequestObject) (api.V1IssueLabelAttachResponseObject, error)
+	V1IssueLabelDetach(ctx context.Context, request api.V1IssueLabelDetachRequestObject) (api.V1IssueLabelDetachResponseObject, error)
+	V1DocumentLabelAttach(ctx context.Context, request api.V1DocumentLabelAttachRequestObject) (api.V1DocumentLabelAttachResponseObject, error)
+	V1DocumentLabelDetach(ctx context.Context, request api.V1DocumentLabelDetachRequestObject) (api.V1DocumentLabelDetachResponseObject, error)
 }
 
 // labelController is the concrete implementation of LabelController.
@@ -37,15 +54,353 @@ func (c *labelController) V1LabelsGet(ctx context.Context, request api.V1LabelsG
 		}}, nil
 	}
 
-	items := make([]api.Label, len(page.Items))
-	for i, label := range page.Items {
-		items[i] = labelToDTO(label)
+	return api.V1LabelsGet200JSONResponse(labelPageToDTO(page)), nil
+}
+
+func (c *labelController) V1OrganizationLabelsGet(ctx context.Context, request api.V1OrganizationLabelsGetRequestObject) (api.V1OrganizationLabelsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1OrganizationLabelsGet")
+	defer span.End()
+
+	organizationID, err := model.NewIDFromString(request.Id, model.ResourceTypeOrganization.String())
+	if err != nil {
+		return api.V1OrganizationLabelsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
 	}
 
-	return api.V1LabelsGet200JSONResponse{
-		Items:    items,
-		PageInfo: pageInfoToDTO(page.PageInfo),
-	}, nil
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1OrganizationLabelsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.labelService.ListForScope(ctx, organizationID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1OrganizationLabelsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1OrganizationLabelsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1OrganizationLabelsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1OrganizationLabelsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1OrganizationLabelsGet200JSONResponse(labelPageToDTO(page)), nil
+}
+
+func (c *labelController) V1OrganizationLabelsCreate(ctx context.Context, request api.V1OrganizationLabelsCreateRequestObject) (api.V1OrganizationLabelsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1OrganizationLabelsCreate")
+	defer span.End()
+
+	organizationID, err := model.NewIDFromString(request.Id, model.ResourceTypeOrganization.String())
+	if err != nil {
+		return api.V1OrganizationLabelsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1OrganizationLabelsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	label, err := c.labelService.Create(ctx, organizationID, createLabelOpts(request.Body.Name, request.Body.Description, request.Body.Color))
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1OrganizationLabelsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1OrganizationLabelsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1OrganizationLabelsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1OrganizationLabelsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1OrganizationLabelsCreate201JSONResponse(labelToDTO(label)), nil
+}
+
+func (c *labelController) V1ProjectLabelsGet(ctx context.Context, request api.V1ProjectLabelsGetRequestObject) (api.V1ProjectLabelsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectLabelsGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectLabelsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ProjectLabelsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.labelService.ListForScope(ctx, projectID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectLabelsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectLabelsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectLabelsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectLabelsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectLabelsGet200JSONResponse(labelPageToDTO(page)), nil
+}
+
+func (c *labelController) V1ProjectLabelsCreate(ctx context.Context, request api.V1ProjectLabelsCreateRequestObject) (api.V1ProjectLabelsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectLabelsCreate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectLabelsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectLabelsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	label, err := c.labelService.Create(ctx, projectID, createLabelOpts(request.Body.Name, request.Body.Description, request.Body.Color))
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectLabelsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectLabelsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectLabelsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectLabelsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectLabelsCreate201JSONResponse(labelToDTO(label)), nil
+}
+
+func (c *labelController) V1LabelGet(ctx context.Context, request api.V1LabelGetRequestObject) (api.V1LabelGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1LabelGet")
+	defer span.End()
+
+	labelID, err := model.NewIDFromString(request.Id, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1LabelGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	label, err := c.labelService.Get(ctx, labelID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1LabelGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1LabelGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1LabelGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1LabelGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1LabelGet200JSONResponse(labelToDTO(label)), nil
+}
+
+func (c *labelController) V1LabelUpdate(ctx context.Context, request api.V1LabelUpdateRequestObject) (api.V1LabelUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1LabelUpdate")
+	defer span.End()
+
+	labelID, err := model.NewIDFromString(request.Id, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1LabelUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1LabelUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	label, err := c.labelService.Update(ctx, labelID, service.UpdateLabelOpts{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Color:       request.Body.Color,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1LabelUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1LabelUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1LabelUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1LabelUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1LabelUpdate200JSONResponse(labelToDTO(label)), nil
+}
+
+func (c *labelController) V1LabelDelete(ctx context.Context, request api.V1LabelDeleteRequestObject) (api.V1LabelDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1LabelDelete")
+	defer span.End()
+
+	labelID, err := model.NewIDFromString(request.Id, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1LabelDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.labelService.Delete(ctx, labelID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1LabelDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1LabelDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1LabelDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1LabelDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1LabelDelete204Response{}, nil
+}
+
+func (c *labelController) V1IssueLabelAttach(ctx context.Context, request api.V1IssueLabelAttachRequestObject) (api.V1IssueLabelAttachResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueLabelAttach")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueLabelAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	labelID, err := model.NewIDFromString(request.LabelId, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1IssueLabelAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.labelService.AttachTo(ctx, labelID, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueLabelAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueLabelAttach403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueLabelAttach404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueLabelAttach500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueLabelAttach204Response{}, nil
+}
+
+func (c *labelController) V1IssueLabelDetach(ctx context.Context, request api.V1IssueLabelDetachRequestObject) (api.V1IssueLabelDetachResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueLabelDetach")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueLabelDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	labelID, err := model.NewIDFromString(request.LabelId, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1IssueLabelDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.labelService.DetachFrom(ctx, labelID, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueLabelDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueLabelDetach403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueLabelDetach404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueLabelDetach500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueLabelDetach204Response{}, nil
+}
+
+func (c *labelController) V1DocumentLabelAttach(ctx context.Context, request api.V1DocumentLabelAttachRequestObject) (api.V1DocumentLabelAttachResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentLabelAttach")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentLabelAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	labelID, err := model.NewIDFromString(request.LabelId, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1DocumentLabelAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.labelService.AttachTo(ctx, labelID, documentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentLabelAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentLabelAttach403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentLabelAttach404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentLabelAttach500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentLabelAttach204Response{}, nil
+}
+
+func (c *labelController) V1DocumentLabelDetach(ctx context.Context, request api.V1DocumentLabelDetachRequestObject) (api.V1DocumentLabelDetachResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1DocumentLabelDetach")
+	defer span.End()
+
+	documentID, err := model.NewIDFromString(request.Id, model.ResourceTypeDocument.String())
+	if err != nil {
+		return api.V1DocumentLabelDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	labelID, err := model.NewIDFromString(request.LabelId, model.ResourceTypeLabel.String())
+	if err != nil {
+		return api.V1DocumentLabelDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.labelService.DetachFrom(ctx, labelID, documentID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1DocumentLabelDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1DocumentLabelDetach403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1DocumentLabelDetach404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1DocumentLabelDetach500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1DocumentLabelDetach204Response{}, nil
 }
 
 func labelToDTO(label *service.Label) api.Label {
@@ -59,15 +414,55 @@ func labelToDTO(label *service.Label) api.Label {
 		createdAt = *label.CreatedAt
 	}
 
+	var color *string
+	if label.Color != "" {
+		color = &label.Color
+	}
+
+	var scope *string
+	if label.Scope != nil {
+		scope = convert.ToPointer(label.Scope.String())
+	}
+
 	return api.Label{
 		Id:          label.ID.String(),
 		Name:        label.Name,
 		Description: description,
+		Color:       color,
+		Scope:       scope,
 		CreatedAt:   createdAt,
 		UpdatedAt:   label.UpdatedAt,
 	}
 }
 
+func labelPageToDTO(page service.Page[*service.Label]) api.LabelPage {
+	items := make([]api.Label, len(page.Items))
+	for i, label := range page.Items {
+		items[i] = labelToDTO(label)
+	}
+
+	return api.LabelPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+func createLabelOpts(name string, description, color *string) service.CreateLabelOpts {
+	opts := service.CreateLabelOpts{
+		Name: name,
+	}
+
+	if description != nil {
+		opts.Description = *description
+	}
+
+	if color != nil {
+		opts.Color = *color
+	}
+
+	return opts
+}
+
 // NewLabelController creates a new LabelController.
 func NewLabelController(opts ...ControllerOption) (LabelController, error) {
 	controller, err := newController(opts...)
@@ -0,0 +1,347 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestLabelController(t *testing.T, ls service.LabelService) LabelController {
+	t.Helper()
+	c, err := NewLabelController(WithLabelService(ls))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceLabel(scope model.ID) *service.Label {
+	return &service.Label{
+		ID:        model.MustNewID(model.ResourceTypeLabel),
+		Name:      "frontend",
+		Color:     "#1f6feb",
+		Scope:     &scope,
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewLabelController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewLabelController(WithLabelService(service.NewMockLabelService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing label service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewLabelController()
+		assert.ErrorIs(t, err, ErrNoLabelService)
+	})
+}
+
+func TestLabelController_V1OrganizationLabelsCreate(t *testing.T) {
+	t.Parallel()
+
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	label := newServiceLabel(orgID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().Create(gomock.Any(), orgID, service.CreateLabelOpts{
+			Name:  label.Name,
+			Color: label.Color,
+		}).Return(label, nil)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1OrganizationLabelsCreate(context.Background(), api.V1OrganizationLabelsCreateRequestObject{
+			Id:   orgID.String(),
+			Body: &api.V1OrganizationLabelsCreateJSONRequestBody{Name: label.Name, Color: &label.Color},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1OrganizationLabelsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, label.ID.String(), got.Id)
+		assert.Equal(t, &label.Color, got.Color)
+		assert.Equal(t, convert.ToPointer(orgID.String()), got.Scope)
+	})
+
+	t.Run("missing body", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestLabelController(t, service.NewMockLabelService(ctrl))
+		resp, err := c.V1OrganizationLabelsCreate(context.Background(), api.V1OrganizationLabelsCreateRequestObject{Id: orgID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1OrganizationLabelsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().Create(gomock.Any(), orgID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrLabelCreate, service.ErrNoPermission),
+		)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1OrganizationLabelsCreate(context.Background(), api.V1OrganizationLabelsCreateRequestObject{
+			Id:   orgID.String(),
+			Body: &api.V1OrganizationLabelsCreateJSONRequestBody{Name: label.Name},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1OrganizationLabelsCreate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestLabelController_V1ProjectLabelsGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	label := newServiceLabel(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().ListForScope(gomock.Any(), projectID, gomock.Any()).Return(service.Page[*service.Label]{
+			Items: []*service.Label{label},
+		}, nil)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1ProjectLabelsGet(context.Background(), api.V1ProjectLabelsGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectLabelsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, label.ID.String(), got.Items[0].Id)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestLabelController(t, service.NewMockLabelService(ctrl))
+		resp, err := c.V1ProjectLabelsGet(context.Background(), api.V1ProjectLabelsGetRequestObject{Id: "bad"})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectLabelsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestLabelController_V1LabelUpdate(t *testing.T) {
+	t.Parallel()
+
+	label := newServiceLabel(model.MustNewID(model.ResourceTypeOrganization))
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().Update(gomock.Any(), label.ID, service.UpdateLabelOpts{
+			Color: optional.Some(label.Color),
+		}).Return(label, nil)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1LabelUpdate(context.Background(), api.V1LabelUpdateRequestObject{
+			Id:   label.ID.String(),
+			Body: &api.V1LabelUpdateJSONRequestBody{Color: optional.Some(label.Color)},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1LabelUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, label.ID.String(), got.Id)
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().Update(gomock.Any(), label.ID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrLabelUpdate, model.ErrInvalidLabelDetails),
+		)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1LabelUpdate(context.Background(), api.V1LabelUpdateRequestObject{
+			Id:   label.ID.String(),
+			Body: &api.V1LabelUpdateJSONRequestBody{Color: optional.Some("blue")},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1LabelUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestLabelController_V1LabelDelete(t *testing.T) {
+	t.Parallel()
+
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().Delete(gomock.Any(), labelID).Return(nil)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1LabelDelete(context.Background(), api.V1LabelDeleteRequestObject{Id: labelID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1LabelDelete204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().Delete(gomock.Any(), labelID).Return(errors.Join(service.ErrLabelDelete, repository.ErrNotFound))
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1LabelDelete(context.Background(), api.V1LabelDeleteRequestObject{Id: labelID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1LabelDelete404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestLabelController_V1IssueLabelAttach(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().AttachTo(gomock.Any(), labelID, issueID).Return(nil)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1IssueLabelAttach(context.Background(), api.V1IssueLabelAttachRequestObject{
+			Id:      issueID.String(),
+			LabelId: labelID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueLabelAttach204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("bad label id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestLabelController(t, service.NewMockLabelService(ctrl))
+		resp, err := c.V1IssueLabelAttach(context.Background(), api.V1IssueLabelAttachRequestObject{
+			Id:      issueID.String(),
+			LabelId: "bad",
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueLabelAttach400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("label out of scope", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().AttachTo(gomock.Any(), labelID, issueID).Return(
+			errors.Join(service.ErrLabelAttach, model.ErrInvalidLabelDetails, service.ErrLabelOutOfScope),
+		)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1IssueLabelAttach(context.Background(), api.V1IssueLabelAttachRequestObject{
+			Id:      issueID.String(),
+			LabelId: labelID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueLabelAttach400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestLabelController_V1DocumentLabelDetach(t *testing.T) {
+	t.Parallel()
+
+	documentID := model.MustNewID(model.ResourceTypeDocument)
+	labelID := model.MustNewID(model.ResourceTypeLabel)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().DetachFrom(gomock.Any(), labelID, documentID).Return(nil)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1DocumentLabelDetach(context.Background(), api.V1DocumentLabelDetachRequestObject{
+			Id:      documentID.String(),
+			LabelId: labelID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentLabelDetach204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ls := service.NewMockLabelService(ctrl)
+		ls.EXPECT().DetachFrom(gomock.Any(), labelID, documentID).Return(
+			errors.Join(service.ErrLabelDetach, service.ErrNoPermission),
+		)
+
+		c := newTestLabelController(t, ls)
+		resp, err := c.V1DocumentLabelDetach(context.Background(), api.V1DocumentLabelDetachRequestObject{
+			Id:      documentID.String(),
+			LabelId: labelID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1DocumentLabelDetach403JSONResponse)
+		assert.True(t, ok)
+	})
+}
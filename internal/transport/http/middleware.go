@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -125,6 +126,21 @@ func WithTracedMiddleware(tracer tracing.Tracer, middleware func(next http.Handl
 	}
 }
 
+// WithSkippedPaths returns a middleware that applies the given middleware to
+// every request, except the requests of the given paths.
+func WithSkippedPaths(middleware func(next http.Handler) http.Handler, paths ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		wrapped := middleware(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(paths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			wrapped.ServeHTTP(w, r)
+		})
+	}
+}
+
 // WithRequestLogger returns a middleware that logs the request.
 //
 // The middleware depends on WithLogger. To use this middleware, you must call
@@ -83,3 +83,24 @@ func httpDurationHandlerLabels(t *testing.T) []string {
 	}
 	return handlers
 }
+
+func TestWithSkippedPaths(t *testing.T) {
+	t.Parallel()
+
+	wrapped := func(_ http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+	}
+	handler := WithSkippedPaths(wrapped, PathEvents)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, PathEvents, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/issues", nil))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+}
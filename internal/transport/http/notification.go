@@ -6,6 +6,7 @@ import (
 
 	"github.com/opcotech/elemo/internal/model"
 	"github.com/opcotech/elemo/internal/pkg"
+	"github.com/opcotech/elemo/internal/pkg/convert"
 	"github.com/opcotech/elemo/internal/service"
 	"github.com/opcotech/elemo/internal/transport/http/api"
 )
@@ -175,13 +176,32 @@ func NewNotificationController(opts ...ControllerOption) (NotificationController
 }
 
 func notificationToDTO(notification *service.Notification) api.Notification {
+	var actor *string
+	if notification.Actor != nil {
+		actor = convert.ToPointer(notification.Actor.String())
+	}
+
+	var resource *string
+	if notification.Resource != nil {
+		resource = convert.ToPointer(notification.Resource.String())
+	}
+
+	changedFields := notification.ChangedFields
+	if changedFields == nil {
+		changedFields = make([]string, 0)
+	}
+
 	return api.Notification{
-		Id:          notification.ID.String(),
-		Title:       notification.Title,
-		Description: notification.Description,
-		Recipient:   notification.Recipient.String(),
-		Read:        notification.Read,
-		CreatedAt:   *notification.CreatedAt,
-		UpdatedAt:   notification.UpdatedAt,
+		Id:            notification.ID.String(),
+		Title:         notification.Title,
+		Description:   notification.Description,
+		Recipient:     notification.Recipient.String(),
+		Read:          notification.Read,
+		Event:         notification.Event,
+		Actor:         actor,
+		ResourceId:    resource,
+		ChangedFields: changedFields,
+		CreatedAt:     *notification.CreatedAt,
+		UpdatedAt:     notification.UpdatedAt,
 	}
 }
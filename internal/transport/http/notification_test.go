@@ -0,0 +1,64 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func TestNotificationToDTO(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().UTC()
+	recipientID := model.MustNewID(model.ResourceTypeUser)
+	actorID := model.MustNewID(model.ResourceTypeUser)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("with event payload", func(t *testing.T) {
+		t.Parallel()
+
+		notification := &service.Notification{
+			ID:            model.MustNewID(model.ResourceTypeNotification),
+			Title:         "MOB-1 was updated",
+			Description:   "Fix login",
+			Recipient:     recipientID,
+			Event:         "notification:issue_updated",
+			Actor:         &actorID,
+			Resource:      &issueID,
+			ChangedFields: []string{"status"},
+			CreatedAt:     &createdAt,
+		}
+
+		assert.Equal(t, api.Notification{
+			Id:            notification.ID.String(),
+			Title:         notification.Title,
+			Description:   notification.Description,
+			Recipient:     recipientID.String(),
+			Event:         notification.Event,
+			Actor:         convert.ToPointer(actorID.String()),
+			ResourceId:    convert.ToPointer(issueID.String()),
+			ChangedFields: []string{"status"},
+			CreatedAt:     createdAt,
+		}, notificationToDTO(notification))
+	})
+
+	t.Run("without event payload", func(t *testing.T) {
+		t.Parallel()
+
+		got := notificationToDTO(&service.Notification{
+			ID:        model.MustNewID(model.ResourceTypeNotification),
+			Title:     "Welcome",
+			Recipient: recipientID,
+			CreatedAt: &createdAt,
+		})
+		assert.Nil(t, got.Actor)
+		assert.Nil(t, got.ResourceId)
+		assert.Equal(t, []string{}, got.ChangedFields)
+	})
+}
@@ -7,6 +7,9 @@ import (
 
 const DefaultPageSize = 100
 
+// DefaultBoardPageSize is the number of issues listed per board column.
+const DefaultBoardPageSize = 25
+
 func cursorPageFromParams(pageSize *int, pageToken *string) (service.CursorPage, error) {
 	size := DefaultPageSize
 	if pageSize != nil {
@@ -301,6 +301,17 @@ func partialLabelsToDTO(labels []service.PartialLabel) []api.PartialLabel {
 	return out
 }
 
+func partialComponentsToDTO(components []service.PartialComponent) *[]api.PartialComponent {
+	out := make([]api.PartialComponent, len(components))
+	for i, component := range components {
+		out[i] = api.PartialComponent{
+			Id:   component.ID.String(),
+			Name: component.Name,
+		}
+	}
+	return &out
+}
+
 func partialProjectToDTO(project *service.PartialProject) *api.PartialProject {
 	if project == nil {
 		return nil
@@ -337,22 +348,24 @@ func partialIssueToDTO(issue *service.PartialIssue) api.PartialIssue {
 	}
 
 	ni := api.PartialIssue{
-		Id:        issue.ID.String(),
-		Key:       issue.Key,
-		NumericId: int(issue.NumericID),
-		Kind:      api.IssueKind(issue.Kind.String()),
-		Title:     issue.Title,
-		Status:    api.IssueStatus(issue.Status.String()),
-		Priority:  api.IssuePriority(issue.Priority.String()),
-		Assignees: assignmentUsersByKind(issue.Assignments, model.AssignmentKindAssignee),
-		Reviewers: assignmentUsersByKind(issue.Assignments, model.AssignmentKindReviewer),
-		Labels:    partialLabelsToDTO(issue.Labels),
-		Project:   partialProjectToDTO(issue.Project),
-		Namespace: partialNamespaceToDTO(issue.Namespace),
-		DueDate:   issue.DueDate,
-		StartDate: issue.StartDate,
-		CreatedAt: createdAt,
-		UpdatedAt: issue.UpdatedAt,
+		Id:             issue.ID.String(),
+		Key:            issue.Key,
+		NumericId:      int(issue.NumericID),
+		Kind:           api.IssueKind(issue.Kind.String()),
+		Title:          issue.Title,
+		Status:         api.IssueStatus(issue.Status.String()),
+		WorkflowStatus: issue.WorkflowStatus,
+		Priority:       api.IssuePriority(issue.Priority.String()),
+		Assignees:      assignmentUsersByKind(issue.Assignments, model.AssignmentKindAssignee),
+		Reviewers:      assignmentUsersByKind(issue.Assignments, model.AssignmentKindReviewer),
+		Labels:         partialLabelsToDTO(issue.Labels),
+		Components:     partialComponentsToDTO(issue.Components),
+		Project:        partialProjectToDTO(issue.Project),
+		Namespace:      partialNamespaceToDTO(issue.Namespace),
+		DueDate:        issue.DueDate,
+		StartDate:      issue.StartDate,
+		CreatedAt:      createdAt,
+		UpdatedAt:      issue.UpdatedAt,
 	}
 
 	if issue.ReportedBy != nil {
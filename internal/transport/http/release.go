@@ -0,0 +1,371 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// ReleaseController is the controller for the project release and issue fix
+// version endpoints.
+type ReleaseController interface {
+	V1ProjectReleasesGet(ctx context.Context, request api.V1ProjectReleasesGetRequestObject) (api.V1ProjectReleasesGetResponseObject, error)
+	V1ProjectReleasesCreate(ctx context.Context, request api.V1ProjectReleasesCreateRequestObject) (api.V1ProjectReleasesCreateResponseObject, error)
+	V1ReleaseGet(ctx context.Context, request api.V1ReleaseGetRequestObject) (api.V1ReleaseGetResponseObject, error)
+	V1ReleaseUpdate(ctx context.Context, request api.V1ReleaseUpdateRequestObject) (api.V1ReleaseUpdateResponseObject, error)
+	V1ReleaseDelete(ctx context.Context, request api.V1ReleaseDeleteRequestObject) (api.V1ReleaseDeleteResponseObject, error)
+	V1ReleaseNotesGet(ctx context.Context, request api.V1ReleaseNotesGetRequestObject) (api.V1ReleaseNotesGetResponseObject, error)
+	V1IssueReleaseAttach(ctx context.Context, request api.V1IssueReleaseAttachRequestObject) (api.V1IssueReleaseAttachResponseObject, error)
+	V1IssueReleaseDetach(ctx context.Context, request api.V1IssueReleaseDetachRequestObject) (api.V1IssueReleaseDetachResponseObject, error)
+}
+
+// releaseController is the concrete implementation of ReleaseController.
+type releaseController struct {
+	*baseController
+}
+
+func (c *releaseController) V1ProjectReleasesGet(ctx context.Context, request api.V1ProjectReleasesGetRequestObject) (api.V1ProjectReleasesGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectReleasesGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectReleasesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ProjectReleasesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.releaseService.List(ctx, projectID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectReleasesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectReleasesGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectReleasesGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectReleasesGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectReleasesGet200JSONResponse(releasePageToDTO(page)), nil
+}
+
+func (c *releaseController) V1ProjectReleasesCreate(ctx context.Context, request api.V1ProjectReleasesCreateRequestObject) (api.V1ProjectReleasesCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectReleasesCreate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectReleasesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectReleasesCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	release, err := c.releaseService.Create(ctx, projectID, createReleaseJSONRequestBodyToCreateReleaseOpts(request.Body))
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectReleasesCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectReleasesCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectReleasesCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectReleasesCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectReleasesCreate201JSONResponse(releaseToDTO(release)), nil
+}
+
+func (c *releaseController) V1ReleaseGet(ctx context.Context, request api.V1ReleaseGetRequestObject) (api.V1ReleaseGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ReleaseGet")
+	defer span.End()
+
+	releaseID, err := model.NewIDFromString(request.Id, model.ResourceTypeRelease.String())
+	if err != nil {
+		return api.V1ReleaseGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	release, err := c.releaseService.Get(ctx, releaseID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ReleaseGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ReleaseGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ReleaseGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ReleaseGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ReleaseGet200JSONResponse(releaseToDTO(release)), nil
+}
+
+func (c *releaseController) V1ReleaseUpdate(ctx context.Context, request api.V1ReleaseUpdateRequestObject) (api.V1ReleaseUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ReleaseUpdate")
+	defer span.End()
+
+	releaseID, err := model.NewIDFromString(request.Id, model.ResourceTypeRelease.String())
+	if err != nil {
+		return api.V1ReleaseUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ReleaseUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := updateReleaseJSONRequestBodyToUpdateReleaseOpts(request.Body)
+	if err != nil {
+		return api.V1ReleaseUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	release, err := c.releaseService.Update(ctx, releaseID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ReleaseUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ReleaseUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ReleaseUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ReleaseUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ReleaseUpdate200JSONResponse(releaseToDTO(release)), nil
+}
+
+func (c *releaseController) V1ReleaseDelete(ctx context.Context, request api.V1ReleaseDeleteRequestObject) (api.V1ReleaseDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ReleaseDelete")
+	defer span.End()
+
+	releaseID, err := model.NewIDFromString(request.Id, model.ResourceTypeRelease.String())
+	if err != nil {
+		return api.V1ReleaseDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.releaseService.Delete(ctx, releaseID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ReleaseDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ReleaseDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ReleaseDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ReleaseDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ReleaseDelete204Response{}, nil
+}
+
+func (c *releaseController) V1ReleaseNotesGet(ctx context.Context, request api.V1ReleaseNotesGetRequestObject) (api.V1ReleaseNotesGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ReleaseNotesGet")
+	defer span.End()
+
+	releaseID, err := model.NewIDFromString(request.Id, model.ResourceTypeRelease.String())
+	if err != nil {
+		return api.V1ReleaseNotesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	notes, err := c.releaseService.Notes(ctx, releaseID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ReleaseNotesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ReleaseNotesGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ReleaseNotesGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ReleaseNotesGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ReleaseNotesGet200JSONResponse(api.ReleaseNotes{
+		Release:  notes.Release.ID.String(),
+		Name:     notes.Release.Name,
+		Markdown: notes.Markdown,
+	}), nil
+}
+
+func (c *releaseController) V1IssueReleaseAttach(ctx context.Context, request api.V1IssueReleaseAttachRequestObject) (api.V1IssueReleaseAttachResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueReleaseAttach")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueReleaseAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	releaseID, err := model.NewIDFromString(request.ReleaseId, model.ResourceTypeRelease.String())
+	if err != nil {
+		return api.V1IssueReleaseAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.releaseService.AttachTo(ctx, releaseID, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueReleaseAttach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueReleaseAttach403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueReleaseAttach404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueReleaseAttach500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueReleaseAttach204Response{}, nil
+}
+
+func (c *releaseController) V1IssueReleaseDetach(ctx context.Context, request api.V1IssueReleaseDetachRequestObject) (api.V1IssueReleaseDetachResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueReleaseDetach")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueReleaseDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	releaseID, err := model.NewIDFromString(request.ReleaseId, model.ResourceTypeRelease.String())
+	if err != nil {
+		return api.V1IssueReleaseDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.releaseService.DetachFrom(ctx, releaseID, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueReleaseDetach400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueReleaseDetach403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueReleaseDetach404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueReleaseDetach500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueReleaseDetach204Response{}, nil
+}
+
+func createReleaseJSONRequestBodyToCreateReleaseOpts(body *api.V1ProjectReleasesCreateJSONRequestBody) service.CreateReleaseOpts {
+	opts := service.CreateReleaseOpts{
+		Name:       body.Name,
+		TargetDate: body.TargetDate,
+	}
+
+	if body.Description != nil {
+		opts.Description = *body.Description
+	}
+
+	return opts
+}
+
+func updateReleaseJSONRequestBodyToUpdateReleaseOpts(body *api.V1ReleaseUpdateJSONRequestBody) (service.UpdateReleaseOpts, error) {
+	opts := service.UpdateReleaseOpts{
+		Name:        body.Name,
+		Description: body.Description,
+		TargetDate:  body.TargetDate,
+	}
+
+	if body.Status != nil {
+		status, err := model.ReleaseStatusString(string(*body.Status))
+		if err != nil {
+			return service.UpdateReleaseOpts{}, err
+		}
+		opts.Status = optional.Some(status)
+	}
+
+	return opts, nil
+}
+
+func releaseToDTO(release *service.Release) api.Release {
+	createdAt := time.Time{}
+	if release.CreatedAt != nil {
+		createdAt = *release.CreatedAt
+	}
+
+	dto := api.Release{
+		Id:          release.ID.String(),
+		Project:     release.Project.String(),
+		Name:        release.Name,
+		Description: release.Description,
+		Status:      api.ReleaseStatus(release.Status.String()),
+		TargetDate:  release.TargetDate,
+		ReleasedAt:  release.ReleasedAt,
+		CreatedAt:   createdAt,
+		UpdatedAt:   release.UpdatedAt,
+	}
+
+	if release.UnresolvedIssues != nil {
+		issues := make([]api.PartialIssue, len(release.UnresolvedIssues))
+		for i, issue := range release.UnresolvedIssues {
+			issues[i] = partialIssueToDTO(issue)
+		}
+		dto.UnresolvedIssues = convert.ToPointer(issues)
+	}
+
+	return dto
+}
+
+func releasePageToDTO(page service.Page[*service.Release]) api.ReleasePage {
+	items := make([]api.Release, len(page.Items))
+	for i, release := range page.Items {
+		items[i] = releaseToDTO(release)
+	}
+
+	return api.ReleasePage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+// NewReleaseController creates a new ReleaseController.
+func NewReleaseController(opts ...ControllerOption) (ReleaseController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.releaseService == nil {
+		return nil, ErrNoReleaseService
+	}
+
+	return &releaseController{
+		baseController: controller,
+	}, nil
+}
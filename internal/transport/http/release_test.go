@@ -0,0 +1,270 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestReleaseController(t *testing.T, rs service.ReleaseService) ReleaseController {
+	t.Helper()
+	c, err := NewReleaseController(WithReleaseService(rs))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceRelease(projectID model.ID) *service.Release {
+	return &service.Release{
+		ID:         model.MustNewID(model.ResourceTypeRelease),
+		Project:    projectID,
+		Name:       "v1.0.0",
+		Status:     model.ReleaseStatusPlanned,
+		TargetDate: convert.ToPointer(time.Now().UTC().Add(24 * time.Hour)),
+		CreatedAt:  convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewReleaseController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewReleaseController(WithReleaseService(service.NewMockReleaseService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing release service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewReleaseController()
+		assert.ErrorIs(t, err, ErrNoReleaseService)
+	})
+}
+
+func TestReleaseController_V1ProjectReleasesGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	release := newServiceRelease(projectID)
+
+	rs := service.NewMockReleaseService(ctrl)
+	rs.EXPECT().List(gomock.Any(), projectID, gomock.Any()).Return(service.Page[*service.Release]{
+		Items: []*service.Release{release},
+	}, nil)
+
+	c := newTestReleaseController(t, rs)
+	resp, err := c.V1ProjectReleasesGet(context.Background(), api.V1ProjectReleasesGetRequestObject{Id: projectID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1ProjectReleasesGet200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, release.ID.String(), got.Items[0].Id)
+	assert.Equal(t, api.ReleaseStatusPlanned, got.Items[0].Status)
+	assert.Nil(t, got.Items[0].UnresolvedIssues)
+}
+
+func TestReleaseController_V1ProjectReleasesCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	release := newServiceRelease(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rs := service.NewMockReleaseService(ctrl)
+		rs.EXPECT().Create(gomock.Any(), projectID, service.CreateReleaseOpts{
+			Name:       release.Name,
+			TargetDate: release.TargetDate,
+		}).Return(release, nil)
+
+		c := newTestReleaseController(t, rs)
+		resp, err := c.V1ProjectReleasesCreate(context.Background(), api.V1ProjectReleasesCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectReleasesCreateJSONRequestBody{
+				Name:       release.Name,
+				TargetDate: release.TargetDate,
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectReleasesCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, release.ID.String(), got.Id)
+		assert.Equal(t, projectID.String(), got.Project)
+	})
+
+	t.Run("unlicensed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rs := service.NewMockReleaseService(ctrl)
+		rs.EXPECT().Create(gomock.Any(), projectID, gomock.Any()).Return(nil, errors.Join(service.ErrReleaseCreate, service.ErrQuotaExceeded))
+
+		c := newTestReleaseController(t, rs)
+		resp, err := c.V1ProjectReleasesCreate(context.Background(), api.V1ProjectReleasesCreateRequestObject{
+			Id:   projectID.String(),
+			Body: &api.V1ProjectReleasesCreateJSONRequestBody{Name: release.Name},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectReleasesCreate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestReleaseController_V1ReleaseUpdate(t *testing.T) {
+	t.Parallel()
+
+	release := newServiceRelease(model.MustNewID(model.ResourceTypeProject))
+
+	t.Run("mark as released", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		unresolved := &service.PartialIssue{
+			ID:     model.MustNewID(model.ResourceTypeIssue),
+			Key:    "ELM-1",
+			Kind:   model.IssueKindBug,
+			Title:  "Fix login",
+			Status: model.IssueStatusOpen,
+		}
+		released := *release
+		released.Status = model.ReleaseStatusReleased
+		released.UnresolvedIssues = []*service.PartialIssue{unresolved}
+
+		rs := service.NewMockReleaseService(ctrl)
+		rs.EXPECT().Update(gomock.Any(), release.ID, service.UpdateReleaseOpts{
+			Status: optional.Some(model.ReleaseStatusReleased),
+		}).Return(&released, nil)
+
+		c := newTestReleaseController(t, rs)
+		resp, err := c.V1ReleaseUpdate(context.Background(), api.V1ReleaseUpdateRequestObject{
+			Id:   release.ID.String(),
+			Body: &api.V1ReleaseUpdateJSONRequestBody{Status: convert.ToPointer(api.ReleaseStatusReleased)},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ReleaseUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, api.ReleaseStatusReleased, got.Status)
+		require.NotNil(t, got.UnresolvedIssues)
+		require.Len(t, *got.UnresolvedIssues, 1)
+		assert.Equal(t, unresolved.Key, (*got.UnresolvedIssues)[0].Key)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestReleaseController(t, service.NewMockReleaseService(ctrl))
+		resp, err := c.V1ReleaseUpdate(context.Background(), api.V1ReleaseUpdateRequestObject{
+			Id:   release.ID.String(),
+			Body: &api.V1ReleaseUpdateJSONRequestBody{Status: convert.ToPointer(api.ReleaseStatus("shipped"))},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ReleaseUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestReleaseController_V1ReleaseNotesGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	release := newServiceRelease(model.MustNewID(model.ResourceTypeProject))
+	markdown := "# v1.0.0\n\n## Bugs\n\n- ELM-1: Fix login\n"
+
+	rs := service.NewMockReleaseService(ctrl)
+	rs.EXPECT().Notes(gomock.Any(), release.ID).Return(&service.ReleaseNotes{Release: release, Markdown: markdown}, nil)
+
+	c := newTestReleaseController(t, rs)
+	resp, err := c.V1ReleaseNotesGet(context.Background(), api.V1ReleaseNotesGetRequestObject{Id: release.ID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1ReleaseNotesGet200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, api.ReleaseNotes{Release: release.ID.String(), Name: release.Name, Markdown: markdown}, api.ReleaseNotes(got))
+}
+
+func TestReleaseController_V1IssueReleaseAttach(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	releaseID := model.MustNewID(model.ResourceTypeRelease)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rs := service.NewMockReleaseService(ctrl)
+		rs.EXPECT().AttachTo(gomock.Any(), releaseID, issueID).Return(nil)
+
+		c := newTestReleaseController(t, rs)
+		resp, err := c.V1IssueReleaseAttach(context.Background(), api.V1IssueReleaseAttachRequestObject{
+			Id:        issueID.String(),
+			ReleaseId: releaseID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueReleaseAttach204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("release of another project", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rs := service.NewMockReleaseService(ctrl)
+		rs.EXPECT().AttachTo(gomock.Any(), releaseID, issueID).Return(errors.Join(service.ErrReleaseAttach, service.ErrIssueRelease))
+
+		c := newTestReleaseController(t, rs)
+		resp, err := c.V1IssueReleaseAttach(context.Background(), api.V1IssueReleaseAttachRequestObject{
+			Id:        issueID.String(),
+			ReleaseId: releaseID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueReleaseAttach400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestReleaseController_V1ReleaseDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	releaseID := model.MustNewID(model.ResourceTypeRelease)
+
+	rs := service.NewMockReleaseService(ctrl)
+	rs.EXPECT().Delete(gomock.Any(), releaseID).Return(errors.Join(service.ErrReleaseDelete, repository.ErrNotFound))
+
+	c := newTestReleaseController(t, rs)
+	resp, err := c.V1ReleaseDelete(context.Background(), api.V1ReleaseDeleteRequestObject{Id: releaseID.String()})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1ReleaseDelete404JSONResponse)
+	assert.True(t, ok)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/goccy/go-json"
 
 	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/service"
 	"github.com/opcotech/elemo/internal/transport/http/api"
 )
 
@@ -21,9 +22,24 @@ var (
 )
 
 func formatBadRequest(err error) api.N400JSONResponse {
-	return api.N400JSONResponse{
+	resp := api.N400JSONResponse{
 		Message: fmt.Sprintf("The provided input is invalid. %s", err.Error()),
 	}
+
+	var queryErr *service.IssueQueryError
+	if errors.As(err, &queryErr) {
+		details := make([]api.HTTPErrorDetail, len(queryErr.Errors))
+		for i, detail := range queryErr.Errors {
+			details[i] = api.HTTPErrorDetail{
+				Message:  detail.Message,
+				Position: detail.Position,
+				Length:   detail.Length,
+			}
+		}
+		resp.Errors = &details
+	}
+
+	return resp
 }
 
 func setCommonHeaders(w http.ResponseWriter) {
@@ -61,12 +61,25 @@ type server struct {
 	DocumentController
 	FolderController
 	LabelController
+	CommentController
+	AttachmentController
 	UserController
 	TodoController
 	SystemController
 	PermissionController
 	NotificationController
+	EventController
 	SearchController
+	WebhookController
+	WorkflowController
+	CustomFieldController
+	ComponentController
+	IssueTemplateController
+	ReleaseController
+	SprintController
+	WorkLogController
+	ViewController
+	BoardController
 }
 
 func (s *server) InternalErrorHandler(err error) *authErrors.Response {
@@ -134,6 +147,14 @@ func NewServer(opts ...ControllerOption) (StrictServer, error) {
 		return nil, err
 	}
 
+	if s.CommentController, err = NewCommentController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.AttachmentController, err = NewAttachmentController(opts...); err != nil {
+		return nil, err
+	}
+
 	if s.UserController, err = NewUserController(opts...); err != nil {
 		return nil, err
 	}
@@ -154,10 +175,54 @@ func NewServer(opts ...ControllerOption) (StrictServer, error) {
 		return nil, err
 	}
 
+	if s.EventController, err = NewEventController(opts...); err != nil {
+		return nil, err
+	}
+
 	if s.SearchController, err = NewSearchController(opts...); err != nil {
 		return nil, err
 	}
 
+	if s.WebhookController, err = NewWebhookController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.WorkflowController, err = NewWorkflowController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.CustomFieldController, err = NewCustomFieldController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.ComponentController, err = NewComponentController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.IssueTemplateController, err = NewIssueTemplateController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.ReleaseController, err = NewReleaseController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.SprintController, err = NewSprintController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.WorkLogController, err = NewWorkLogController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.ViewController, err = NewViewController(opts...); err != nil {
+		return nil, err
+	}
+
+	if s.BoardController, err = NewBoardController(opts...); err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
@@ -196,7 +261,9 @@ func NewRouter(strictServer StrictServer, serverConfig *config.ServerConfig, tra
 	router.Use(
 		WithPrometheusMetrics,
 		WithOtelTracer,
-		middleware.ThrottleBacklog(throttleLimit, throttleBacklog, throttleTimeout),
+		// Event streams are long-lived, so they would hold a throttle slot for
+		// as long as the client is connected.
+		WithSkippedPaths(middleware.ThrottleBacklog(throttleLimit, throttleBacklog, throttleTimeout), PathEvents),
 		middleware.RequestID,
 		// Single trusted reverse-proxy hop: use the rightmost X-Forwarded-For
 		// entry. Falls back to RemoteAddr in request logging when unset.
@@ -0,0 +1,396 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// SprintController is the controller for the project sprint endpoints.
+type SprintController interface {
+	V1ProjectSprintsGet(ctx context.Context, request api.V1ProjectSprintsGetRequestObject) (api.V1ProjectSprintsGetResponseObject, error)
+	V1ProjectSprintsCreate(ctx context.Context, request api.V1ProjectSprintsCreateRequestObject) (api.V1ProjectSprintsCreateResponseObject, error)
+	V1SprintGet(ctx context.Context, request api.V1SprintGetRequestObject) (api.V1SprintGetResponseObject, error)
+	V1SprintUpdate(ctx context.Context, request api.V1SprintUpdateRequestObject) (api.V1SprintUpdateResponseObject, error)
+	V1SprintDelete(ctx context.Context, request api.V1SprintDeleteRequestObject) (api.V1SprintDeleteResponseObject, error)
+	V1SprintIssueAdd(ctx context.Context, request api.V1SprintIssueAddRequestObject) (api.V1SprintIssueAddResponseObject, error)
+	V1SprintIssueRemove(ctx context.Context, request api.V1SprintIssueRemoveRequestObject) (api.V1SprintIssueRemoveResponseObject, error)
+	V1SprintBurndownGet(ctx context.Context, request api.V1SprintBurndownGetRequestObject) (api.V1SprintBurndownGetResponseObject, error)
+}
+
+// sprintController is the concrete implementation of SprintController.
+type sprintController struct {
+	*baseController
+}
+
+func (c *sprintController) V1ProjectSprintsGet(ctx context.Context, request api.V1ProjectSprintsGetRequestObject) (api.V1ProjectSprintsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectSprintsGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectSprintsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ProjectSprintsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.sprintService.List(ctx, projectID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectSprintsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectSprintsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectSprintsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectSprintsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectSprintsGet200JSONResponse(sprintPageToDTO(page)), nil
+}
+
+func (c *sprintController) V1ProjectSprintsCreate(ctx context.Context, request api.V1ProjectSprintsCreateRequestObject) (api.V1ProjectSprintsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectSprintsCreate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectSprintsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectSprintsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	sprint, err := c.sprintService.Create(ctx, projectID, createSprintJSONRequestBodyToCreateSprintOpts(request.Body))
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectSprintsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectSprintsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectSprintsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectSprintsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectSprintsCreate201JSONResponse(sprintToDTO(sprint)), nil
+}
+
+func (c *sprintController) V1SprintGet(ctx context.Context, request api.V1SprintGetRequestObject) (api.V1SprintGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1SprintGet")
+	defer span.End()
+
+	sprintID, err := model.NewIDFromString(request.Id, model.ResourceTypeSprint.String())
+	if err != nil {
+		return api.V1SprintGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	sprint, err := c.sprintService.Get(ctx, sprintID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1SprintGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1SprintGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1SprintGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1SprintGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1SprintGet200JSONResponse(sprintToDTO(sprint)), nil
+}
+
+func (c *sprintController) V1SprintUpdate(ctx context.Context, request api.V1SprintUpdateRequestObject) (api.V1SprintUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1SprintUpdate")
+	defer span.End()
+
+	sprintID, err := model.NewIDFromString(request.Id, model.ResourceTypeSprint.String())
+	if err != nil {
+		return api.V1SprintUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1SprintUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := updateSprintJSONRequestBodyToUpdateSprintOpts(request.Body)
+	if err != nil {
+		return api.V1SprintUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	sprint, err := c.sprintService.Update(ctx, sprintID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1SprintUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1SprintUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1SprintUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1SprintUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1SprintUpdate200JSONResponse(sprintToDTO(sprint)), nil
+}
+
+func (c *sprintController) V1SprintDelete(ctx context.Context, request api.V1SprintDeleteRequestObject) (api.V1SprintDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1SprintDelete")
+	defer span.End()
+
+	sprintID, err := model.NewIDFromString(request.Id, model.ResourceTypeSprint.String())
+	if err != nil {
+		return api.V1SprintDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.sprintService.Delete(ctx, sprintID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1SprintDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1SprintDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1SprintDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1SprintDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1SprintDelete204Response{}, nil
+}
+
+func (c *sprintController) V1SprintIssueAdd(ctx context.Context, request api.V1SprintIssueAddRequestObject) (api.V1SprintIssueAddResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1SprintIssueAdd")
+	defer span.End()
+
+	sprintID, err := model.NewIDFromString(request.Id, model.ResourceTypeSprint.String())
+	if err != nil {
+		return api.V1SprintIssueAdd400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	issueID, err := model.NewIDFromString(request.IssueId, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1SprintIssueAdd400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.sprintService.AddIssue(ctx, sprintID, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1SprintIssueAdd400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1SprintIssueAdd403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1SprintIssueAdd404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1SprintIssueAdd500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1SprintIssueAdd204Response{}, nil
+}
+
+func (c *sprintController) V1SprintIssueRemove(ctx context.Context, request api.V1SprintIssueRemoveRequestObject) (api.V1SprintIssueRemoveResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1SprintIssueRemove")
+	defer span.End()
+
+	sprintID, err := model.NewIDFromString(request.Id, model.ResourceTypeSprint.String())
+	if err != nil {
+		return api.V1SprintIssueRemove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	issueID, err := model.NewIDFromString(request.IssueId, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1SprintIssueRemove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.sprintService.RemoveIssue(ctx, sprintID, issueID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1SprintIssueRemove400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1SprintIssueRemove403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1SprintIssueRemove404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1SprintIssueRemove500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1SprintIssueRemove204Response{}, nil
+}
+
+func (c *sprintController) V1SprintBurndownGet(ctx context.Context, request api.V1SprintBurndownGetRequestObject) (api.V1SprintBurndownGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1SprintBurndownGet")
+	defer span.End()
+
+	sprintID, err := model.NewIDFromString(request.Id, model.ResourceTypeSprint.String())
+	if err != nil {
+		return api.V1SprintBurndownGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	burndown, err := c.sprintService.Burndown(ctx, sprintID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1SprintBurndownGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1SprintBurndownGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1SprintBurndownGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1SprintBurndownGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1SprintBurndownGet200JSONResponse(sprintBurndownToDTO(burndown)), nil
+}
+
+func createSprintJSONRequestBodyToCreateSprintOpts(body *api.V1ProjectSprintsCreateJSONRequestBody) service.CreateSprintOpts {
+	opts := service.CreateSprintOpts{
+		Name:      body.Name,
+		StartDate: body.StartDate,
+		EndDate:   body.EndDate,
+	}
+
+	if body.Goal != nil {
+		opts.Goal = *body.Goal
+	}
+
+	return opts
+}
+
+func updateSprintJSONRequestBodyToUpdateSprintOpts(body *api.V1SprintUpdateJSONRequestBody) (service.UpdateSprintOpts, error) {
+	opts := service.UpdateSprintOpts{
+		Name: body.Name,
+		Goal: body.Goal,
+	}
+
+	if body.State != nil {
+		state, err := model.SprintStateString(string(*body.State))
+		if err != nil {
+			return service.UpdateSprintOpts{}, err
+		}
+		opts.State = optional.Some(state)
+	}
+	if body.StartDate != nil {
+		opts.StartDate = optional.Some(*body.StartDate)
+	}
+	if body.EndDate != nil {
+		opts.EndDate = optional.Some(*body.EndDate)
+	}
+
+	return opts, nil
+}
+
+func sprintToDTO(sprint *service.Sprint) api.Sprint {
+	createdAt := time.Time{}
+	if sprint.CreatedAt != nil {
+		createdAt = *sprint.CreatedAt
+	}
+
+	dto := api.Sprint{
+		Id:        sprint.ID.String(),
+		Project:   sprint.Project.String(),
+		Name:      sprint.Name,
+		Goal:      sprint.Goal,
+		State:     api.SprintState(sprint.State.String()),
+		StartDate: sprint.StartDate,
+		EndDate:   sprint.EndDate,
+		ClosedAt:  sprint.ClosedAt,
+		CreatedAt: createdAt,
+		UpdatedAt: sprint.UpdatedAt,
+	}
+
+	if sprint.NextSprint != nil {
+		dto.NextSprint = convert.ToPointer(sprint.NextSprint.String())
+	}
+
+	if sprint.MovedIssues != nil {
+		issues := make([]api.PartialIssue, len(sprint.MovedIssues))
+		for i, issue := range sprint.MovedIssues {
+			issues[i] = partialIssueToDTO(issue)
+		}
+		dto.MovedIssues = convert.ToPointer(issues)
+	}
+
+	return dto
+}
+
+func sprintPageToDTO(page service.Page[*service.Sprint]) api.SprintPage {
+	items := make([]api.Sprint, len(page.Items))
+	for i, sprint := range page.Items {
+		items[i] = sprintToDTO(sprint)
+	}
+
+	return api.SprintPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+func sprintBurndownToDTO(burndown *service.SprintBurndown) api.SprintBurndown {
+	points := make([]api.SprintBurndownPoint, len(burndown.Points))
+	for i, point := range burndown.Points {
+		points[i] = api.SprintBurndownPoint{
+			Date:      point.Date,
+			Scope:     point.Scope,
+			Completed: point.Completed,
+			Remaining: point.Remaining,
+			Added:     point.Added,
+			Removed:   point.Removed,
+		}
+	}
+
+	return api.SprintBurndown{
+		Sprint: burndown.Sprint.ID.String(),
+		Points: points,
+	}
+}
+
+// NewSprintController creates a new SprintController.
+func NewSprintController(opts ...ControllerOption) (SprintController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.sprintService == nil {
+		return nil, ErrNoSprintService
+	}
+
+	return &sprintController{
+		baseController: controller,
+	}, nil
+}
@@ -0,0 +1,285 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestSprintController(t *testing.T, ss service.SprintService) SprintController {
+	t.Helper()
+	c, err := NewSprintController(WithSprintService(ss))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceSprint(projectID model.ID) *service.Sprint {
+	start := time.Now().UTC().Truncate(24 * time.Hour)
+	return &service.Sprint{
+		ID:        model.MustNewID(model.ResourceTypeSprint),
+		Project:   projectID,
+		Name:      "Sprint 1",
+		State:     model.SprintStatePlanned,
+		StartDate: start,
+		EndDate:   start.Add(14 * 24 * time.Hour),
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewSprintController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewSprintController(WithSprintService(service.NewMockSprintService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing sprint service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewSprintController()
+		assert.ErrorIs(t, err, ErrNoSprintService)
+	})
+}
+
+func TestSprintController_V1ProjectSprintsCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	sprint := newServiceSprint(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ss := service.NewMockSprintService(ctrl)
+		ss.EXPECT().Create(gomock.Any(), projectID, service.CreateSprintOpts{
+			Name:      sprint.Name,
+			StartDate: sprint.StartDate,
+			EndDate:   sprint.EndDate,
+		}).Return(sprint, nil)
+
+		c := newTestSprintController(t, ss)
+		resp, err := c.V1ProjectSprintsCreate(context.Background(), api.V1ProjectSprintsCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectSprintsCreateJSONRequestBody{
+				Name:      sprint.Name,
+				StartDate: sprint.StartDate,
+				EndDate:   sprint.EndDate,
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectSprintsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, sprint.ID.String(), got.Id)
+		assert.Equal(t, api.SprintStatePlanned, got.State)
+		assert.Nil(t, got.MovedIssues)
+	})
+
+	t.Run("invalid dates", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ss := service.NewMockSprintService(ctrl)
+		ss.EXPECT().Create(gomock.Any(), projectID, gomock.Any()).Return(nil, errors.Join(service.ErrSprintCreate, model.ErrInvalidSprintDetails))
+
+		c := newTestSprintController(t, ss)
+		resp, err := c.V1ProjectSprintsCreate(context.Background(), api.V1ProjectSprintsCreateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectSprintsCreateJSONRequestBody{
+				Name:      sprint.Name,
+				StartDate: sprint.EndDate,
+				EndDate:   sprint.StartDate,
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectSprintsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestSprintController_V1SprintUpdate(t *testing.T) {
+	t.Parallel()
+
+	sprint := newServiceSprint(model.MustNewID(model.ResourceTypeProject))
+
+	t.Run("close sprint", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		moved := &service.PartialIssue{
+			ID:     model.MustNewID(model.ResourceTypeIssue),
+			Key:    "ELM-1",
+			Kind:   model.IssueKindTask,
+			Title:  "Write docs",
+			Status: model.IssueStatusInProgress,
+		}
+		next := model.MustNewID(model.ResourceTypeSprint)
+		closed := *sprint
+		closed.State = model.SprintStateClosed
+		closed.NextSprint = &next
+		closed.MovedIssues = []*service.PartialIssue{moved}
+
+		ss := service.NewMockSprintService(ctrl)
+		ss.EXPECT().Update(gomock.Any(), sprint.ID, service.UpdateSprintOpts{
+			State: optional.Some(model.SprintStateClosed),
+		}).Return(&closed, nil)
+
+		c := newTestSprintController(t, ss)
+		resp, err := c.V1SprintUpdate(context.Background(), api.V1SprintUpdateRequestObject{
+			Id:   sprint.ID.String(),
+			Body: &api.V1SprintUpdateJSONRequestBody{State: convert.ToPointer(api.SprintStateClosed)},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1SprintUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, api.SprintStateClosed, got.State)
+		assert.Equal(t, convert.ToPointer(next.String()), got.NextSprint)
+		require.NotNil(t, got.MovedIssues)
+		require.Len(t, *got.MovedIssues, 1)
+		assert.Equal(t, moved.Key, (*got.MovedIssues)[0].Key)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestSprintController(t, service.NewMockSprintService(ctrl))
+		resp, err := c.V1SprintUpdate(context.Background(), api.V1SprintUpdateRequestObject{
+			Id:   sprint.ID.String(),
+			Body: &api.V1SprintUpdateJSONRequestBody{State: convert.ToPointer(api.SprintState("done"))},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1SprintUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestSprintController_V1SprintIssueAdd(t *testing.T) {
+	t.Parallel()
+
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ss := service.NewMockSprintService(ctrl)
+		ss.EXPECT().AddIssue(gomock.Any(), sprintID, issueID).Return(nil)
+
+		c := newTestSprintController(t, ss)
+		resp, err := c.V1SprintIssueAdd(context.Background(), api.V1SprintIssueAddRequestObject{
+			Id:      sprintID.String(),
+			IssueId: issueID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1SprintIssueAdd204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("closed sprint", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ss := service.NewMockSprintService(ctrl)
+		ss.EXPECT().AddIssue(gomock.Any(), sprintID, issueID).Return(errors.Join(service.ErrSprintAddIssue, service.ErrSprintClosed))
+
+		c := newTestSprintController(t, ss)
+		resp, err := c.V1SprintIssueAdd(context.Background(), api.V1SprintIssueAddRequestObject{
+			Id:      sprintID.String(),
+			IssueId: issueID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1SprintIssueAdd400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestSprintController_V1SprintIssueRemove(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	ss := service.NewMockSprintService(ctrl)
+	ss.EXPECT().RemoveIssue(gomock.Any(), sprintID, issueID).Return(errors.Join(service.ErrSprintRemoveIssue, service.ErrNoPermission))
+
+	c := newTestSprintController(t, ss)
+	resp, err := c.V1SprintIssueRemove(context.Background(), api.V1SprintIssueRemoveRequestObject{
+		Id:      sprintID.String(),
+		IssueId: issueID.String(),
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1SprintIssueRemove403JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestSprintController_V1SprintBurndownGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sprint := newServiceSprint(model.MustNewID(model.ResourceTypeProject))
+	point := service.SprintBurndownPoint{Date: sprint.StartDate, Scope: 5, Completed: 2, Remaining: 3, Added: 1}
+
+	ss := service.NewMockSprintService(ctrl)
+	ss.EXPECT().Burndown(gomock.Any(), sprint.ID).Return(&service.SprintBurndown{
+		Sprint: sprint,
+		Points: []service.SprintBurndownPoint{point},
+	}, nil)
+
+	c := newTestSprintController(t, ss)
+	resp, err := c.V1SprintBurndownGet(context.Background(), api.V1SprintBurndownGetRequestObject{Id: sprint.ID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1SprintBurndownGet200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, api.SprintBurndown{
+		Sprint: sprint.ID.String(),
+		Points: []api.SprintBurndownPoint{{Date: point.Date, Scope: 5, Completed: 2, Remaining: 3, Added: 1}},
+	}, api.SprintBurndown(got))
+}
+
+func TestSprintController_V1SprintDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sprintID := model.MustNewID(model.ResourceTypeSprint)
+
+	ss := service.NewMockSprintService(ctrl)
+	ss.EXPECT().Delete(gomock.Any(), sprintID).Return(errors.Join(service.ErrSprintDelete, repository.ErrNotFound))
+
+	c := newTestSprintController(t, ss)
+	resp, err := c.V1SprintDelete(context.Background(), api.V1SprintDeleteRequestObject{Id: sprintID.String()})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1SprintDelete404JSONResponse)
+	assert.True(t, ok)
+}
@@ -0,0 +1,461 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// ViewController is the controller for the saved issue view endpoints.
+type ViewController interface {
+	V1ViewsGet(ctx context.Context, request api.V1ViewsGetRequestObject) (api.V1ViewsGetResponseObject, error)
+	V1ViewsCreate(ctx context.Context, request api.V1ViewsCreateRequestObject) (api.V1ViewsCreateResponseObject, error)
+	V1ViewGet(ctx context.Context, request api.V1ViewGetRequestObject) (api.V1ViewGetResponseObject, error)
+	V1ViewUpdate(ctx context.Context, request api.V1ViewUpdateRequestObject) (api.V1ViewUpdateResponseObject, error)
+	V1ViewDelete(ctx context.Context, request api.V1ViewDeleteRequestObject) (api.V1ViewDeleteResponseObject, error)
+	V1ViewIssuesGet(ctx context.Context, request api.V1ViewIssuesGetRequestObject) (api.V1ViewIssuesGetResponseObject, error)
+}
+
+// viewController is the concrete implementation of ViewController.
+type viewController struct {
+	*baseController
+}
+
+func (c *viewController) V1ViewsGet(ctx context.Context, request api.V1ViewsGetRequestObject) (api.V1ViewsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewsGet")
+	defer span.End()
+
+	var scope *model.ID
+	if request.Params.Scope != nil || request.Params.ScopeType != nil {
+		if request.Params.Scope == nil || request.Params.ScopeType == nil {
+			return api.V1ViewsGet400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("scope and scope_type are required together"))}, nil
+		}
+		id, err := model.NewIDFromString(*request.Params.Scope, string(*request.Params.ScopeType))
+		if err != nil {
+			return api.V1ViewsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		}
+		scope = &id
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ViewsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.viewService.List(ctx, scope, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ViewsGet200JSONResponse(viewPageToDTO(page)), nil
+}
+
+func (c *viewController) V1ViewsCreate(ctx context.Context, request api.V1ViewsCreateRequestObject) (api.V1ViewsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewsCreate")
+	defer span.End()
+
+	if request.Body == nil {
+		return api.V1ViewsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	scope, err := model.NewIDFromString(request.Body.Scope.Id, string(request.Body.Scope.ResourceType))
+	if err != nil {
+		return api.V1ViewsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	opts, err := createViewJSONRequestBodyToCreateViewOpts(request.Body)
+	if err != nil {
+		return api.V1ViewsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	view, err := c.viewService.Create(ctx, scope, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ViewsCreate201JSONResponse(viewToDTO(view)), nil
+}
+
+func (c *viewController) V1ViewGet(ctx context.Context, request api.V1ViewGetRequestObject) (api.V1ViewGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewGet")
+	defer span.End()
+
+	viewID, err := model.NewIDFromString(request.Id, model.ResourceTypeView.String())
+	if err != nil {
+		return api.V1ViewGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	view, err := c.viewService.Get(ctx, viewID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ViewGet200JSONResponse(viewToDTO(view)), nil
+}
+
+func (c *viewController) V1ViewUpdate(ctx context.Context, request api.V1ViewUpdateRequestObject) (api.V1ViewUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewUpdate")
+	defer span.End()
+
+	viewID, err := model.NewIDFromString(request.Id, model.ResourceTypeView.String())
+	if err != nil {
+		return api.V1ViewUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ViewUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := updateViewJSONRequestBodyToUpdateViewOpts(request.Body)
+	if err != nil {
+		return api.V1ViewUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	view, err := c.viewService.Update(ctx, viewID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ViewUpdate200JSONResponse(viewToDTO(view)), nil
+}
+
+func (c *viewController) V1ViewDelete(ctx context.Context, request api.V1ViewDeleteRequestObject) (api.V1ViewDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewDelete")
+	defer span.End()
+
+	viewID, err := model.NewIDFromString(request.Id, model.ResourceTypeView.String())
+	if err != nil {
+		return api.V1ViewDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.viewService.Delete(ctx, viewID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ViewDelete204Response{}, nil
+}
+
+func (c *viewController) V1ViewIssuesGet(ctx context.Context, request api.V1ViewIssuesGetRequestObject) (api.V1ViewIssuesGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ViewIssuesGet")
+	defer span.End()
+
+	viewID, err := model.NewIDFromString(request.Id, model.ResourceTypeView.String())
+	if err != nil {
+		return api.V1ViewIssuesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ViewIssuesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.issueService.ListByView(ctx, viewID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ViewIssuesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ViewIssuesGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ViewIssuesGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ViewIssuesGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	issuesDTO := make([]api.PartialIssue, len(page.Items))
+	for i, issue := range page.Items {
+		issuesDTO[i] = partialIssueToDTO(issue)
+	}
+
+	return api.V1ViewIssuesGet200JSONResponse{
+		Items:    issuesDTO,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}, nil
+}
+
+// viewFilterToIssueListOptions parses the filter and order of a view the same
+// way as the query parameters of the issue lists.
+func viewFilterToIssueListOptions(filter *api.ViewFilter, order *string) (service.IssueListOptions, error) {
+	if filter == nil {
+		return issueListOptionsFromParams(nil, nil, nil, nil, order, nil, nil)
+	}
+	return issueListOptionsFromParams(
+		filter.Q,
+		filter.Query,
+		filter.Status,
+		filter.Priority,
+		order,
+		filter.CustomField,
+		filter.Component,
+	)
+}
+
+func viewColumnsFromAPI(columns []api.ViewColumn) []model.ViewColumn {
+	out := make([]model.ViewColumn, len(columns))
+	for i, column := range columns {
+		out[i] = model.ViewColumn(column)
+	}
+	return out
+}
+
+func viewPrincipalsFromAPI(principals []api.ViewPrincipal) ([]model.ID, error) {
+	out := make([]model.ID, len(principals))
+	for i, principal := range principals {
+		id, err := model.NewIDFromString(principal.Id, string(principal.ResourceType))
+		if err != nil {
+			return nil, err
+		}
+		out[i] = id
+	}
+	return out, nil
+}
+
+func createViewJSONRequestBodyToCreateViewOpts(body *api.V1ViewsCreateJSONRequestBody) (service.CreateViewOpts, error) {
+	opts := service.CreateViewOpts{
+		Name: body.Name,
+	}
+
+	if body.Description != nil {
+		opts.Description = *body.Description
+	}
+
+	listOpts, err := viewFilterToIssueListOptions(body.Filter, body.Order)
+	if err != nil {
+		return service.CreateViewOpts{}, err
+	}
+	opts.Options = listOpts
+
+	if body.Columns != nil {
+		opts.Columns = viewColumnsFromAPI(*body.Columns)
+	}
+
+	if body.Grouping != nil {
+		opts.Grouping = model.ViewGrouping(*body.Grouping)
+	}
+
+	if body.SharedWith != nil {
+		if opts.SharedWith, err = viewPrincipalsFromAPI(*body.SharedWith); err != nil {
+			return service.CreateViewOpts{}, err
+		}
+	}
+
+	return opts, nil
+}
+
+func updateViewJSONRequestBodyToUpdateViewOpts(body *api.V1ViewUpdateJSONRequestBody) (service.UpdateViewOpts, error) {
+	opts := service.UpdateViewOpts{
+		Name:        body.Name,
+		Description: body.Description,
+	}
+
+	if body.Filter != nil {
+		listOpts, err := viewFilterToIssueListOptions(body.Filter, nil)
+		if err != nil {
+			return service.UpdateViewOpts{}, err
+		}
+		opts.Filter = optional.Some(listOpts.Filter)
+		opts.Query = optional.Some(listOpts.Query)
+	}
+
+	if body.Order != nil {
+		listOpts, err := viewFilterToIssueListOptions(nil, body.Order)
+		if err != nil {
+			return service.UpdateViewOpts{}, err
+		}
+		opts.Sort = optional.Some(listOpts.Sort)
+	}
+
+	if body.Columns != nil {
+		opts.Columns = optional.Some(viewColumnsFromAPI(*body.Columns))
+	}
+
+	if body.Grouping != nil {
+		opts.Grouping = optional.Some(model.ViewGrouping(*body.Grouping))
+	}
+
+	if body.SharedWith != nil {
+		principals, err := viewPrincipalsFromAPI(*body.SharedWith)
+		if err != nil {
+			return service.UpdateViewOpts{}, err
+		}
+		opts.SharedWith = optional.Some(principals)
+	}
+
+	return opts, nil
+}
+
+// issueListOrderToDTO formats the sort of an issue list in the
+// `field:direction` format of the order query parameter.
+func issueListOrderToDTO(sort repository.IssueListSort) string {
+	return string(sort.Field) + ":" + strings.ToLower(sort.Direction.String())
+}
+
+func viewFilterToDTO(opts service.IssueListOptions) api.ViewFilter {
+	statuses := make([]api.IssueStatus, len(opts.Filter.Statuses))
+	for i, status := range opts.Filter.Statuses {
+		statuses[i] = api.IssueStatus(status.String())
+	}
+
+	priorities := make([]api.IssuePriority, len(opts.Filter.Priorities))
+	for i, priority := range opts.Filter.Priorities {
+		priorities[i] = api.IssuePriority(priority.String())
+	}
+
+	components := make([]string, len(opts.Filter.Components))
+	for i, component := range opts.Filter.Components {
+		components[i] = component.String()
+	}
+
+	customFields := make([]string, 0, len(opts.Filter.CustomFields))
+	for _, filter := range opts.Filter.CustomFields {
+		for _, value := range filter.Values {
+			customFields = append(customFields, filter.Key+":"+fmt.Sprint(value))
+		}
+	}
+
+	return api.ViewFilter{
+		Q:           convert.ToPointer(opts.Filter.Text),
+		Query:       convert.ToPointer(opts.Query),
+		Status:      &statuses,
+		Priority:    &priorities,
+		Component:   &components,
+		CustomField: &customFields,
+	}
+}
+
+func viewToDTO(view *service.View) api.View {
+	createdAt := time.Time{}
+	if view.CreatedAt != nil {
+		createdAt = *view.CreatedAt
+	}
+
+	columns := make([]api.ViewColumn, len(view.Columns))
+	for i, column := range view.Columns {
+		columns[i] = api.ViewColumn(column)
+	}
+
+	sharedWith := make([]api.ViewPrincipal, len(view.SharedWith))
+	for i, principal := range view.SharedWith {
+		sharedWith[i] = api.ViewPrincipal{
+			Id:           principal.String(),
+			ResourceType: api.ViewPrincipalResourceType(principal.Type.String()),
+		}
+	}
+
+	return api.View{
+		Id:    view.ID.String(),
+		Owner: view.Owner.String(),
+		Scope: api.ViewScope{
+			Id:           view.Scope.String(),
+			ResourceType: api.ViewScopeResourceType(view.Scope.Type.String()),
+		},
+		Name:        view.Name,
+		Description: view.Description,
+		Filter:      viewFilterToDTO(view.Options),
+		Order:       issueListOrderToDTO(view.Options.Sort),
+		Columns:     columns,
+		Grouping:    api.ViewGrouping(view.Grouping),
+		SharedWith:  sharedWith,
+		CreatedAt:   createdAt,
+		UpdatedAt:   view.UpdatedAt,
+	}
+}
+
+func viewPageToDTO(page service.Page[*service.View]) api.ViewPage {
+	items := make([]api.View, len(page.Items))
+	for i, view := range page.Items {
+		items[i] = viewToDTO(view)
+	}
+
+	return api.ViewPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+// NewViewController creates a new ViewController.
+func NewViewController(opts ...ControllerOption) (ViewController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.viewService == nil {
+		return nil, ErrNoViewService
+	}
+
+	if controller.issueService == nil {
+		return nil, ErrNoIssueService
+	}
+
+	return &viewController{
+		baseController: controller,
+	}, nil
+}
@@ -0,0 +1,338 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestViewController(t *testing.T, vs service.ViewService, is service.IssueService) ViewController {
+	t.Helper()
+	c, err := NewViewController(WithViewService(vs), WithIssueService(is))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceView(scope model.ID) *service.View {
+	return &service.View{
+		ID:    model.MustNewID(model.ResourceTypeView),
+		Owner: model.MustNewID(model.ResourceTypeUser),
+		Scope: scope,
+		Name:  "My open bugs",
+		Options: service.IssueListOptions{
+			Filter: repository.IssueListFilter{
+				Statuses: []model.IssueStatus{model.IssueStatusOpen},
+				CustomFields: []repository.IssueListCustomFieldFilter{
+					{Key: "severity", Values: []any{"critical", "major"}},
+				},
+			},
+			Sort: repository.IssueListSort{
+				Field:     repository.IssueListSortFieldPriority,
+				Direction: repository.SortDirectionDesc,
+			},
+			Query: "kind:bug assignee:me",
+		},
+		Columns:    []model.ViewColumn{model.ViewColumnKey, model.ViewColumnTitle},
+		Grouping:   model.ViewGroupingStatus,
+		SharedWith: []model.ID{model.MustNewID(model.ResourceTypeTeam)},
+		CreatedAt:  convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewViewController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewViewController(
+			WithViewService(service.NewMockViewService(ctrl)),
+			WithIssueService(service.NewMockIssueService(ctrl)),
+		)
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing view service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewViewController()
+		assert.ErrorIs(t, err, ErrNoViewService)
+	})
+
+	t.Run("missing issue service", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := NewViewController(WithViewService(service.NewMockViewService(ctrl)))
+		assert.ErrorIs(t, err, ErrNoIssueService)
+	})
+}
+
+func TestViewController_V1ViewsGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	view := newServiceView(projectID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		vs := service.NewMockViewService(ctrl)
+		vs.EXPECT().List(gomock.Any(), &projectID, gomock.Any()).Return(service.Page[*service.View]{
+			Items: []*service.View{view},
+		}, nil)
+
+		c := newTestViewController(t, vs, service.NewMockIssueService(ctrl))
+		resp, err := c.V1ViewsGet(context.Background(), api.V1ViewsGetRequestObject{
+			Params: api.V1ViewsGetParams{
+				Scope:     convert.ToPointer(projectID.String()),
+				ScopeType: convert.ToPointer(api.V1ViewsGetParamsScopeTypeProject),
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ViewsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, view.ID.String(), got.Items[0].Id)
+		assert.Equal(t, api.ViewScope{Id: projectID.String(), ResourceType: api.ViewScopeResourceTypeProject}, got.Items[0].Scope)
+		assert.Equal(t, "priority:desc", got.Items[0].Order)
+		assert.Equal(t, []string{"severity:critical", "severity:major"}, *got.Items[0].Filter.CustomField)
+		assert.Equal(t, api.ViewPrincipalResourceTypeTeam, got.Items[0].SharedWith[0].ResourceType)
+	})
+
+	t.Run("scope without type", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestViewController(t, service.NewMockViewService(ctrl), service.NewMockIssueService(ctrl))
+		resp, err := c.V1ViewsGet(context.Background(), api.V1ViewsGetRequestObject{
+			Params: api.V1ViewsGetParams{Scope: convert.ToPointer(projectID.String())},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ViewsGet400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestViewController_V1ViewsCreate(t *testing.T) {
+	t.Parallel()
+
+	namespaceID := model.MustNewID(model.ResourceTypeNamespace)
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	view := newServiceView(namespaceID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		vs := service.NewMockViewService(ctrl)
+		vs.EXPECT().Create(gomock.Any(), namespaceID, service.CreateViewOpts{
+			Name: view.Name,
+			Options: service.IssueListOptions{
+				Filter: repository.IssueListFilter{Priorities: []model.IssuePriority{model.IssuePriorityHigh}},
+				Sort: repository.IssueListSort{
+					Field:     repository.IssueListSortFieldDueDate,
+					Direction: repository.SortDirectionAsc,
+				},
+				Query: "assignee:me",
+			},
+			Columns:    []model.ViewColumn{model.ViewColumnKey, model.ViewColumnDueDate},
+			Grouping:   model.ViewGroupingProject,
+			SharedWith: []model.ID{orgID},
+		}).Return(view, nil)
+
+		c := newTestViewController(t, vs, service.NewMockIssueService(ctrl))
+		resp, err := c.V1ViewsCreate(context.Background(), api.V1ViewsCreateRequestObject{
+			Body: &api.V1ViewsCreateJSONRequestBody{
+				Scope: api.ViewScope{Id: namespaceID.String(), ResourceType: api.ViewScopeResourceTypeNamespace},
+				Name:  view.Name,
+				Filter: &api.ViewFilter{
+					Query:    convert.ToPointer("assignee:me"),
+					Priority: &[]api.IssuePriority{api.IssuePriorityHigh},
+				},
+				Order:      convert.ToPointer("due_date:asc"),
+				Columns:    &[]api.ViewColumn{api.ViewColumnKey, api.ViewColumnDueDate},
+				Grouping:   convert.ToPointer(api.ViewGroupingProject),
+				SharedWith: &[]api.ViewPrincipal{{Id: orgID.String(), ResourceType: api.ViewPrincipalResourceTypeOrganization}},
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ViewsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, view.ID.String(), got.Id)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestViewController(t, service.NewMockViewService(ctrl), service.NewMockIssueService(ctrl))
+		resp, err := c.V1ViewsCreate(context.Background(), api.V1ViewsCreateRequestObject{
+			Body: &api.V1ViewsCreateJSONRequestBody{
+				Scope: api.ViewScope{Id: namespaceID.String(), ResourceType: api.ViewScopeResourceTypeNamespace},
+				Name:  view.Name,
+				Order: convert.ToPointer("estimate:asc"),
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ViewsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("shared with a user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		vs := service.NewMockViewService(ctrl)
+		vs.EXPECT().Create(gomock.Any(), namespaceID, gomock.Any()).Return(nil, errors.Join(service.ErrViewCreate, service.ErrViewShare))
+
+		c := newTestViewController(t, vs, service.NewMockIssueService(ctrl))
+		resp, err := c.V1ViewsCreate(context.Background(), api.V1ViewsCreateRequestObject{
+			Body: &api.V1ViewsCreateJSONRequestBody{
+				Scope: api.ViewScope{Id: namespaceID.String(), ResourceType: api.ViewScopeResourceTypeNamespace},
+				Name:  view.Name,
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ViewsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestViewController_V1ViewUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	view := newServiceView(model.MustNewID(model.ResourceTypeProject))
+
+	vs := service.NewMockViewService(ctrl)
+	vs.EXPECT().Update(gomock.Any(), view.ID, service.UpdateViewOpts{
+		Name: optional.Some("Triage"),
+		Sort: optional.Some(repository.IssueListSort{
+			Field:     repository.IssueListSortFieldCreatedAt,
+			Direction: repository.SortDirectionDesc,
+		}),
+		SharedWith: optional.Some([]model.ID{}),
+	}).Return(view, nil)
+
+	c := newTestViewController(t, vs, service.NewMockIssueService(ctrl))
+	resp, err := c.V1ViewUpdate(context.Background(), api.V1ViewUpdateRequestObject{
+		Id: view.ID.String(),
+		Body: &api.V1ViewUpdateJSONRequestBody{
+			Name:       optional.Some("Triage"),
+			Order:      convert.ToPointer("created_at:desc"),
+			SharedWith: &[]api.ViewPrincipal{},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1ViewUpdate200JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestViewController_V1ViewDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	viewID := model.MustNewID(model.ResourceTypeView)
+
+	vs := service.NewMockViewService(ctrl)
+	vs.EXPECT().Delete(gomock.Any(), viewID).Return(errors.Join(service.ErrViewDelete, service.ErrNoPermission))
+
+	c := newTestViewController(t, vs, service.NewMockIssueService(ctrl))
+	resp, err := c.V1ViewDelete(context.Background(), api.V1ViewDeleteRequestObject{Id: viewID.String()})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1ViewDelete403JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestViewController_V1ViewIssuesGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("run view", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		viewID := model.MustNewID(model.ResourceTypeView)
+		issue := &service.PartialIssue{
+			ID:     model.MustNewID(model.ResourceTypeIssue),
+			Title:  "Login fails",
+			Status: model.IssueStatusOpen,
+		}
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListByView(gomock.Any(), viewID, service.CursorPage{Size: 10}).Return(service.Page[*service.PartialIssue]{
+			Items: []*service.PartialIssue{issue},
+		}, nil)
+
+		c := newTestViewController(t, service.NewMockViewService(ctrl), is)
+		resp, err := c.V1ViewIssuesGet(context.Background(), api.V1ViewIssuesGetRequestObject{
+			Id:     viewID.String(),
+			Params: api.V1ViewIssuesGetParams{PageSize: convert.ToPointer(10)},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ViewIssuesGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, issue.ID.String(), got.Items[0].Id)
+	})
+
+	t.Run("view not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		viewID := model.MustNewID(model.ResourceTypeView)
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListByView(gomock.Any(), viewID, gomock.Any()).Return(service.Page[*service.PartialIssue]{}, errors.Join(service.ErrIssueGetAll, repository.ErrNotFound))
+
+		c := newTestViewController(t, service.NewMockViewService(ctrl), is)
+		resp, err := c.V1ViewIssuesGet(context.Background(), api.V1ViewIssuesGetRequestObject{Id: viewID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ViewIssuesGet404JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("view not readable", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		viewID := model.MustNewID(model.ResourceTypeView)
+
+		is := service.NewMockIssueService(ctrl)
+		is.EXPECT().ListByView(gomock.Any(), viewID, gomock.Any()).Return(service.Page[*service.PartialIssue]{}, errors.Join(service.ErrIssueGetAll, service.ErrNoPermission))
+
+		c := newTestViewController(t, service.NewMockViewService(ctrl), is)
+		resp, err := c.V1ViewIssuesGet(context.Background(), api.V1ViewIssuesGetRequestObject{Id: viewID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ViewIssuesGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
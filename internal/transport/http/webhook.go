@@ -0,0 +1,419 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// WebhookController is the controller for webhook endpoints, including the
+// organization and project webhook lists and the delivery log.
+type WebhookController interface {
+	V1OrganizationWebhooksGet(ctx context.Context, request api.V1OrganizationWebhooksGetRequestObject) (api.V1OrganizationWebhooksGetResponseObject, error)
+	V1OrganizationWebhooksCreate(ctx context.Context, request api.V1OrganizationWebhooksCreateRequestObject) (api.V1OrganizationWebhooksCreateResponseObject, error)
+	V1ProjectWebhooksGet(ctx context.Context, request api.V1ProjectWebhooksGetRequestObject) (api.V1ProjectWebhooksGetResponseObject, error)
+	V1ProjectWebhooksCreate(ctx context.Context, request api.V1ProjectWebhooksCreateRequestObject) (api.V1ProjectWebhooksCreateResponseObject, error)
+	V1WebhookGet(ctx context.Context, request api.V1WebhookGetRequestObject) (api.V1WebhookGetResponseObject, error)
+	V1WebhookUpdate(ctx context.Context, request api.V1WebhookUpdateRequestObject) (api.V1WebhookUpdateResponseObject, error)
+	V1WebhookDelete(ctx context.Context, request api.V1WebhookDeleteRequestObject) (api.V1WebhookDeleteResponseObject, error)
+	V1WebhookDeliveriesGet(ctx context.Context, request api.V1WebhookDeliveriesGetRequestObject) (api.V1WebhookDeliveriesGetResponseObject, error)
+	V1WebhookDeliveryRedeliver(ctx context.Context, request api.V1WebhookDeliveryRedeliverRequestObject) (api.V1WebhookDeliveryRedeliverResponseObject, error)
+}
+
+// webhookController is the concrete implementation of WebhookController.
+type webhookController struct {
+	*baseController
+}
+
+func (c *webhookController) V1OrganizationWebhooksGet(ctx context.Context, request api.V1OrganizationWebhooksGetRequestObject) (api.V1OrganizationWebhooksGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1OrganizationWebhooksGet")
+	defer span.End()
+
+	organizationID, err := model.NewIDFromString(request.Id, model.ResourceTypeOrganization.String())
+	if err != nil {
+		return api.V1OrganizationWebhooksGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1OrganizationWebhooksGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.webhookService.ListForScope(ctx, organizationID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1OrganizationWebhooksGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1OrganizationWebhooksGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1OrganizationWebhooksGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1OrganizationWebhooksGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1OrganizationWebhooksGet200JSONResponse(webhookPageToDTO(page)), nil
+}
+
+func (c *webhookController) V1OrganizationWebhooksCreate(ctx context.Context, request api.V1OrganizationWebhooksCreateRequestObject) (api.V1OrganizationWebhooksCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1OrganizationWebhooksCreate")
+	defer span.End()
+
+	organizationID, err := model.NewIDFromString(request.Id, model.ResourceTypeOrganization.String())
+	if err != nil {
+		return api.V1OrganizationWebhooksCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1OrganizationWebhooksCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	webhook, err := c.webhookService.Create(ctx, organizationID, createWebhookOpts(request.Body.Url, request.Body.Secret, request.Body.Events, request.Body.Enabled))
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1OrganizationWebhooksCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1OrganizationWebhooksCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1OrganizationWebhooksCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1OrganizationWebhooksCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1OrganizationWebhooksCreate201JSONResponse(webhookToDTO(webhook)), nil
+}
+
+func (c *webhookController) V1ProjectWebhooksGet(ctx context.Context, request api.V1ProjectWebhooksGetRequestObject) (api.V1ProjectWebhooksGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWebhooksGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWebhooksGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1ProjectWebhooksGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.webhookService.ListForScope(ctx, projectID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWebhooksGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWebhooksGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWebhooksGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWebhooksGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWebhooksGet200JSONResponse(webhookPageToDTO(page)), nil
+}
+
+func (c *webhookController) V1ProjectWebhooksCreate(ctx context.Context, request api.V1ProjectWebhooksCreateRequestObject) (api.V1ProjectWebhooksCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWebhooksCreate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWebhooksCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectWebhooksCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	webhook, err := c.webhookService.Create(ctx, projectID, createWebhookOpts(request.Body.Url, request.Body.Secret, request.Body.Events, request.Body.Enabled))
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWebhooksCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWebhooksCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWebhooksCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWebhooksCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWebhooksCreate201JSONResponse(webhookToDTO(webhook)), nil
+}
+
+func (c *webhookController) V1WebhookGet(ctx context.Context, request api.V1WebhookGetRequestObject) (api.V1WebhookGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1WebhookGet")
+	defer span.End()
+
+	webhookID, err := model.NewIDFromString(request.Id, model.ResourceTypeWebhook.String())
+	if err != nil {
+		return api.V1WebhookGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	webhook, err := c.webhookService.Get(ctx, webhookID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1WebhookGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1WebhookGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1WebhookGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1WebhookGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1WebhookGet200JSONResponse(webhookToDTO(webhook)), nil
+}
+
+func (c *webhookController) V1WebhookUpdate(ctx context.Context, request api.V1WebhookUpdateRequestObject) (api.V1WebhookUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1WebhookUpdate")
+	defer span.End()
+
+	webhookID, err := model.NewIDFromString(request.Id, model.ResourceTypeWebhook.String())
+	if err != nil {
+		return api.V1WebhookUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1WebhookUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	webhook, err := c.webhookService.Update(ctx, webhookID, service.UpdateWebhookOpts{
+		URL:     request.Body.Url,
+		Secret:  request.Body.Secret,
+		Events:  request.Body.Events,
+		Enabled: request.Body.Enabled,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1WebhookUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1WebhookUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1WebhookUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1WebhookUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1WebhookUpdate200JSONResponse(webhookToDTO(webhook)), nil
+}
+
+func (c *webhookController) V1WebhookDelete(ctx context.Context, request api.V1WebhookDeleteRequestObject) (api.V1WebhookDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1WebhookDelete")
+	defer span.End()
+
+	webhookID, err := model.NewIDFromString(request.Id, model.ResourceTypeWebhook.String())
+	if err != nil {
+		return api.V1WebhookDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.webhookService.Delete(ctx, webhookID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1WebhookDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1WebhookDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1WebhookDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1WebhookDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1WebhookDelete204Response{}, nil
+}
+
+func (c *webhookController) V1WebhookDeliveriesGet(ctx context.Context, request api.V1WebhookDeliveriesGetRequestObject) (api.V1WebhookDeliveriesGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1WebhookDeliveriesGet")
+	defer span.End()
+
+	webhookID, err := model.NewIDFromString(request.Id, model.ResourceTypeWebhook.String())
+	if err != nil {
+		return api.V1WebhookDeliveriesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1WebhookDeliveriesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.webhookService.ListDeliveries(ctx, webhookID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1WebhookDeliveriesGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1WebhookDeliveriesGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1WebhookDeliveriesGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1WebhookDeliveriesGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1WebhookDeliveriesGet200JSONResponse(webhookDeliveryPageToDTO(page)), nil
+}
+
+func (c *webhookController) V1WebhookDeliveryRedeliver(ctx context.Context, request api.V1WebhookDeliveryRedeliverRequestObject) (api.V1WebhookDeliveryRedeliverResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1WebhookDeliveryRedeliver")
+	defer span.End()
+
+	webhookID, err := model.NewIDFromString(request.Id, model.ResourceTypeWebhook.String())
+	if err != nil {
+		return api.V1WebhookDeliveryRedeliver400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	deliveryID, err := model.NewIDFromString(request.DeliveryId, model.ResourceTypeWebhookDelivery.String())
+	if err != nil {
+		return api.V1WebhookDeliveryRedeliver400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	delivery, err := c.webhookService.Redeliver(ctx, webhookID, deliveryID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1WebhookDeliveryRedeliver400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1WebhookDeliveryRedeliver403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1WebhookDeliveryRedeliver404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1WebhookDeliveryRedeliver500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1WebhookDeliveryRedeliver202JSONResponse(webhookDeliveryToDTO(delivery)), nil
+}
+
+func webhookToDTO(webhook *service.Webhook) api.Webhook {
+	createdAt := time.Time{}
+	if webhook.CreatedAt != nil {
+		createdAt = *webhook.CreatedAt
+	}
+
+	events := make([]api.WebhookEvent, len(webhook.Events))
+	for i, event := range webhook.Events {
+		events[i] = api.WebhookEvent(event)
+	}
+
+	return api.Webhook{
+		Id:        webhook.ID.String(),
+		Scope:     webhook.Scope.String(),
+		Url:       webhook.URL,
+		Events:    events,
+		Enabled:   webhook.Enabled,
+		CreatedAt: createdAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+func webhookPageToDTO(page service.Page[*service.Webhook]) api.WebhookPage {
+	items := make([]api.Webhook, len(page.Items))
+	for i, webhook := range page.Items {
+		items[i] = webhookToDTO(webhook)
+	}
+
+	return api.WebhookPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+func webhookDeliveryToDTO(delivery *service.WebhookDelivery) api.WebhookDelivery {
+	createdAt := time.Time{}
+	if delivery.CreatedAt != nil {
+		createdAt = *delivery.CreatedAt
+	}
+
+	return api.WebhookDelivery{
+		Id:             delivery.ID.String(),
+		Webhook:        delivery.Webhook.String(),
+		Event:          api.WebhookEvent(delivery.Event),
+		Payload:        delivery.Payload,
+		Status:         api.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      createdAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+func webhookDeliveryPageToDTO(page service.Page[*service.WebhookDelivery]) api.WebhookDeliveryPage {
+	items := make([]api.WebhookDelivery, len(page.Items))
+	for i, delivery := range page.Items {
+		items[i] = webhookDeliveryToDTO(delivery)
+	}
+
+	return api.WebhookDeliveryPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+// createWebhookOpts converts the create request to service options. Webhooks
+// are enabled unless the request says otherwise.
+func createWebhookOpts(url, secret string, events []api.WebhookEvent, enabled *bool) service.CreateWebhookOpts {
+	opts := service.CreateWebhookOpts{
+		URL:     url,
+		Secret:  secret,
+		Events:  make([]string, len(events)),
+		Enabled: true,
+	}
+
+	for i, event := range events {
+		opts.Events[i] = string(event)
+	}
+
+	if enabled != nil {
+		opts.Enabled = *enabled
+	}
+
+	return opts
+}
+
+// NewWebhookController creates a new WebhookController.
+func NewWebhookController(opts ...ControllerOption) (WebhookController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.webhookService == nil {
+		return nil, ErrNoWebhookService
+	}
+
+	return &webhookController{
+		baseController: controller,
+	}, nil
+}
@@ -0,0 +1,336 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestWebhookController(t *testing.T, ws service.WebhookService) WebhookController {
+	t.Helper()
+	c, err := NewWebhookController(WithWebhookService(ws))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceWebhook(scope model.ID) *service.Webhook {
+	return &service.Webhook{
+		ID:        model.MustNewID(model.ResourceTypeWebhook),
+		Scope:     scope,
+		URL:       "https://example.com/hooks/elemo",
+		Events:    []string{service.EventTypeIssueUpdated},
+		Enabled:   true,
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewWebhookController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewWebhookController(WithWebhookService(service.NewMockWebhookService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing webhook service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewWebhookController()
+		assert.ErrorIs(t, err, ErrNoWebhookService)
+	})
+}
+
+func TestWebhookController_V1OrganizationWebhooksCreate(t *testing.T) {
+	t.Parallel()
+
+	orgID := model.MustNewID(model.ResourceTypeOrganization)
+	webhook := newServiceWebhook(orgID)
+	body := &api.V1OrganizationWebhooksCreateJSONRequestBody{
+		Url:    webhook.URL,
+		Secret: "0123456789abcdef",
+		Events: []api.WebhookEvent{api.WebhookEventIssueUpdated},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Create(gomock.Any(), orgID, service.CreateWebhookOpts{
+			URL:     webhook.URL,
+			Secret:  body.Secret,
+			Events:  []string{service.EventTypeIssueUpdated},
+			Enabled: true,
+		}).Return(webhook, nil)
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1OrganizationWebhooksCreate(context.Background(), api.V1OrganizationWebhooksCreateRequestObject{
+			Id:   orgID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1OrganizationWebhooksCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, webhook.ID.String(), got.Id)
+		assert.Equal(t, orgID.String(), got.Scope)
+		assert.Equal(t, []api.WebhookEvent{api.WebhookEventIssueUpdated}, got.Events)
+	})
+
+	t.Run("invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Create(gomock.Any(), orgID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrWebhookCreate, model.ErrInvalidWebhookDetails),
+		)
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1OrganizationWebhooksCreate(context.Background(), api.V1OrganizationWebhooksCreateRequestObject{
+			Id:   orgID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1OrganizationWebhooksCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Create(gomock.Any(), orgID, gomock.Any()).Return(
+			nil,
+			errors.Join(service.ErrWebhookCreate, service.ErrNoPermission),
+		)
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1OrganizationWebhooksCreate(context.Background(), api.V1OrganizationWebhooksCreateRequestObject{
+			Id:   orgID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1OrganizationWebhooksCreate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWebhookController_V1ProjectWebhooksGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	webhook := newServiceWebhook(projectID)
+
+	ws := service.NewMockWebhookService(ctrl)
+	ws.EXPECT().ListForScope(gomock.Any(), projectID, gomock.Any()).Return(service.Page[*service.Webhook]{
+		Items: []*service.Webhook{webhook},
+	}, nil)
+
+	c := newTestWebhookController(t, ws)
+	resp, err := c.V1ProjectWebhooksGet(context.Background(), api.V1ProjectWebhooksGetRequestObject{Id: projectID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1ProjectWebhooksGet200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, webhook.ID.String(), got.Items[0].Id)
+}
+
+func TestWebhookController_V1WebhookUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	webhook := newServiceWebhook(model.MustNewID(model.ResourceTypeOrganization))
+	webhook.Enabled = false
+
+	ws := service.NewMockWebhookService(ctrl)
+	ws.EXPECT().Update(gomock.Any(), webhook.ID, service.UpdateWebhookOpts{
+		Enabled: optional.Some(false),
+	}).Return(webhook, nil)
+
+	c := newTestWebhookController(t, ws)
+	resp, err := c.V1WebhookUpdate(context.Background(), api.V1WebhookUpdateRequestObject{
+		Id:   webhook.ID.String(),
+		Body: &api.V1WebhookUpdateJSONRequestBody{Enabled: optional.Some(false)},
+	})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1WebhookUpdate200JSONResponse)
+	require.True(t, ok)
+	assert.False(t, got.Enabled)
+}
+
+func TestWebhookController_V1WebhookDelete(t *testing.T) {
+	t.Parallel()
+
+	webhookID := model.MustNewID(model.ResourceTypeWebhook)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Delete(gomock.Any(), webhookID).Return(nil)
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1WebhookDelete(context.Background(), api.V1WebhookDeleteRequestObject{Id: webhookID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1WebhookDelete204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Delete(gomock.Any(), webhookID).Return(errors.Join(service.ErrWebhookDelete, repository.ErrNotFound))
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1WebhookDelete(context.Background(), api.V1WebhookDeleteRequestObject{Id: webhookID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1WebhookDelete404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWebhookController_V1WebhookDeliveriesGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	webhookID := model.MustNewID(model.ResourceTypeWebhook)
+	delivery := &service.WebhookDelivery{
+		ID:             model.MustNewID(model.ResourceTypeWebhookDelivery),
+		Webhook:        webhookID,
+		Event:          service.EventTypeIssueUpdated,
+		Payload:        `{"event":"issue.updated"}`,
+		Status:         service.WebhookDeliveryStatusFailed,
+		Attempts:       9,
+		ResponseStatus: convert.ToPointer(http.StatusBadGateway),
+		Error:          convert.ToPointer("unexpected response status 502"),
+		CreatedAt:      convert.ToPointer(time.Now().UTC()),
+	}
+
+	ws := service.NewMockWebhookService(ctrl)
+	ws.EXPECT().ListDeliveries(gomock.Any(), webhookID, gomock.Any()).Return(service.Page[*service.WebhookDelivery]{
+		Items: []*service.WebhookDelivery{delivery},
+	}, nil)
+
+	c := newTestWebhookController(t, ws)
+	resp, err := c.V1WebhookDeliveriesGet(context.Background(), api.V1WebhookDeliveriesGetRequestObject{Id: webhookID.String()})
+	require.NoError(t, err)
+	got, ok := resp.(api.V1WebhookDeliveriesGet200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, []api.WebhookDelivery{{
+		Id:             delivery.ID.String(),
+		Webhook:        webhookID.String(),
+		Event:          api.WebhookEventIssueUpdated,
+		Payload:        delivery.Payload,
+		Status:         api.WebhookDeliveryStatusFailed,
+		Attempts:       9,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      *delivery.CreatedAt,
+	}}, got.Items)
+}
+
+func TestWebhookController_V1WebhookDeliveryRedeliver(t *testing.T) {
+	t.Parallel()
+
+	webhookID := model.MustNewID(model.ResourceTypeWebhook)
+	deliveryID := model.MustNewID(model.ResourceTypeWebhookDelivery)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		redelivery := &service.WebhookDelivery{
+			ID:        model.MustNewID(model.ResourceTypeWebhookDelivery),
+			Webhook:   webhookID,
+			Event:     service.EventTypeIssueUpdated,
+			Status:    service.WebhookDeliveryStatusPending,
+			CreatedAt: convert.ToPointer(time.Now().UTC()),
+		}
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Redeliver(gomock.Any(), webhookID, deliveryID).Return(redelivery, nil)
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1WebhookDeliveryRedeliver(context.Background(), api.V1WebhookDeliveryRedeliverRequestObject{
+			Id:         webhookID.String(),
+			DeliveryId: deliveryID.String(),
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1WebhookDeliveryRedeliver202JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, redelivery.ID.String(), got.Id)
+		assert.Equal(t, api.WebhookDeliveryStatusPending, got.Status)
+	})
+
+	t.Run("pending delivery", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWebhookService(ctrl)
+		ws.EXPECT().Redeliver(gomock.Any(), webhookID, deliveryID).Return(nil, errors.Join(
+			service.ErrWebhookRedeliver,
+			model.ErrInvalidWebhookDetails,
+			service.ErrWebhookDeliveryPending,
+		))
+
+		c := newTestWebhookController(t, ws)
+		resp, err := c.V1WebhookDeliveryRedeliver(context.Background(), api.V1WebhookDeliveryRedeliverRequestObject{
+			Id:         webhookID.String(),
+			DeliveryId: deliveryID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1WebhookDeliveryRedeliver400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid delivery id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestWebhookController(t, service.NewMockWebhookService(ctrl))
+		resp, err := c.V1WebhookDeliveryRedeliver(context.Background(), api.V1WebhookDeliveryRedeliverRequestObject{
+			Id:         webhookID.String(),
+			DeliveryId: "invalid",
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1WebhookDeliveryRedeliver400JSONResponse)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,392 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+var errNegativeMinutes = errors.New("minutes must not be negative")
+
+// WorkLogController is the controller for issue work log, time tracking and
+// timesheet endpoints.
+type WorkLogController interface {
+	V1IssueWorkLogsGet(ctx context.Context, request api.V1IssueWorkLogsGetRequestObject) (api.V1IssueWorkLogsGetResponseObject, error)
+	V1IssueWorkLogsCreate(ctx context.Context, request api.V1IssueWorkLogsCreateRequestObject) (api.V1IssueWorkLogsCreateResponseObject, error)
+	V1IssueWorkLogGet(ctx context.Context, request api.V1IssueWorkLogGetRequestObject) (api.V1IssueWorkLogGetResponseObject, error)
+	V1IssueWorkLogUpdate(ctx context.Context, request api.V1IssueWorkLogUpdateRequestObject) (api.V1IssueWorkLogUpdateResponseObject, error)
+	V1IssueWorkLogDelete(ctx context.Context, request api.V1IssueWorkLogDeleteRequestObject) (api.V1IssueWorkLogDeleteResponseObject, error)
+	V1IssueTimeTrackingGet(ctx context.Context, request api.V1IssueTimeTrackingGetRequestObject) (api.V1IssueTimeTrackingGetResponseObject, error)
+	V1UserTimesheetGet(ctx context.Context, request api.V1UserTimesheetGetRequestObject) (api.V1UserTimesheetGetResponseObject, error)
+}
+
+// workLogController is the concrete implementation of WorkLogController.
+type workLogController struct {
+	*baseController
+}
+
+func (c *workLogController) V1IssueWorkLogsGet(ctx context.Context, request api.V1IssueWorkLogsGetRequestObject) (api.V1IssueWorkLogsGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWorkLogsGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWorkLogsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	pageParams, err := cursorPageFromParams(request.Params.PageSize, request.Params.PageToken)
+	if err != nil {
+		return api.V1IssueWorkLogsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	page, err := c.workLogService.List(ctx, issueID, pageParams)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWorkLogsGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWorkLogsGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWorkLogsGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWorkLogsGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueWorkLogsGet200JSONResponse(workLogPageToDTO(page)), nil
+}
+
+func (c *workLogController) V1IssueWorkLogsCreate(ctx context.Context, request api.V1IssueWorkLogsCreateRequestObject) (api.V1IssueWorkLogsCreateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWorkLogsCreate")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWorkLogsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueWorkLogsCreate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	duration, err := minutesFromAPI(request.Body.Duration)
+	if err != nil {
+		return api.V1IssueWorkLogsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	opts := service.CreateWorkLogOpts{
+		Duration: duration,
+		Date:     request.Body.Date,
+	}
+	if request.Body.Note != nil {
+		opts.Note = *request.Body.Note
+	}
+
+	workLog, err := c.workLogService.Create(ctx, issueID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWorkLogsCreate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWorkLogsCreate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWorkLogsCreate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWorkLogsCreate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueWorkLogsCreate201JSONResponse(workLogToDTO(workLog)), nil
+}
+
+func (c *workLogController) V1IssueWorkLogGet(ctx context.Context, request api.V1IssueWorkLogGetRequestObject) (api.V1IssueWorkLogGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWorkLogGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWorkLogGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workLogID, err := model.NewIDFromString(request.WorkLogId, model.ResourceTypeWorkLog.String())
+	if err != nil {
+		return api.V1IssueWorkLogGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workLog, err := c.workLogService.Get(ctx, issueID, workLogID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWorkLogGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWorkLogGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWorkLogGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWorkLogGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueWorkLogGet200JSONResponse(workLogToDTO(workLog)), nil
+}
+
+func (c *workLogController) V1IssueWorkLogUpdate(ctx context.Context, request api.V1IssueWorkLogUpdateRequestObject) (api.V1IssueWorkLogUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWorkLogUpdate")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWorkLogUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workLogID, err := model.NewIDFromString(request.WorkLogId, model.ResourceTypeWorkLog.String())
+	if err != nil {
+		return api.V1IssueWorkLogUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1IssueWorkLogUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	duration, err := optionalMinutesFromAPI(request.Body.Duration)
+	if err != nil {
+		return api.V1IssueWorkLogUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workLog, err := c.workLogService.Update(ctx, issueID, workLogID, service.UpdateWorkLogOpts{
+		Duration: duration,
+		Date:     request.Body.Date,
+		Note:     request.Body.Note,
+	})
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWorkLogUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWorkLogUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWorkLogUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWorkLogUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueWorkLogUpdate200JSONResponse(workLogToDTO(workLog)), nil
+}
+
+func (c *workLogController) V1IssueWorkLogDelete(ctx context.Context, request api.V1IssueWorkLogDeleteRequestObject) (api.V1IssueWorkLogDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueWorkLogDelete")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueWorkLogDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workLogID, err := model.NewIDFromString(request.WorkLogId, model.ResourceTypeWorkLog.String())
+	if err != nil {
+		return api.V1IssueWorkLogDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.workLogService.Delete(ctx, issueID, workLogID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueWorkLogDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueWorkLogDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueWorkLogDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueWorkLogDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueWorkLogDelete204Response{}, nil
+}
+
+func (c *workLogController) V1IssueTimeTrackingGet(ctx context.Context, request api.V1IssueTimeTrackingGetRequestObject) (api.V1IssueTimeTrackingGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1IssueTimeTrackingGet")
+	defer span.End()
+
+	issueID, err := model.NewIDFromString(request.Id, model.ResourceTypeIssue.String())
+	if err != nil {
+		return api.V1IssueTimeTrackingGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	tracking, err := c.workLogService.TimeTracking(ctx, issueID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1IssueTimeTrackingGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1IssueTimeTrackingGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1IssueTimeTrackingGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1IssueTimeTrackingGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1IssueTimeTrackingGet200JSONResponse(issueTimeTrackingToDTO(tracking)), nil
+}
+
+func (c *workLogController) V1UserTimesheetGet(ctx context.Context, request api.V1UserTimesheetGetRequestObject) (api.V1UserTimesheetGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1UserTimesheetGet")
+	defer span.End()
+
+	userID, err := model.NewIDFromString(request.Id, model.ResourceTypeUser.String())
+	if err != nil {
+		return api.V1UserTimesheetGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	timesheet, err := c.workLogService.Timesheet(ctx, userID, request.Params.From, request.Params.To)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1UserTimesheetGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1UserTimesheetGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1UserTimesheetGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1UserTimesheetGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1UserTimesheetGet200JSONResponse(timesheetToDTO(timesheet)), nil
+}
+
+func minutesFromAPI(minutes int) (uint, error) {
+	if minutes < 0 {
+		return 0, errNegativeMinutes
+	}
+	return uint(minutes), nil
+}
+
+func optionalMinutesFromAPI(o api.Optional[int]) (optional.Optional[uint], error) {
+	if !o.Defined {
+		return optional.None[uint](), nil
+	}
+	if o.Value == nil {
+		return optional.Null[uint](), nil
+	}
+	minutes, err := minutesFromAPI(*o.Value)
+	if err != nil {
+		return optional.None[uint](), err
+	}
+	return optional.Some(minutes), nil
+}
+
+func minutesToAPI(minutes *uint) *int {
+	if minutes == nil {
+		return nil
+	}
+	value := int(*minutes)
+	return &value
+}
+
+func workLogToDTO(workLog *service.WorkLog) api.WorkLog {
+	createdAt := time.Time{}
+	if workLog.CreatedAt != nil {
+		createdAt = *workLog.CreatedAt
+	}
+
+	return api.WorkLog{
+		Id:        workLog.ID.String(),
+		Issue:     workLog.Issue.String(),
+		User:      workLog.User.String(),
+		Duration:  int(workLog.Duration),
+		Date:      workLog.Date,
+		Note:      workLog.Note,
+		CreatedAt: createdAt,
+		UpdatedAt: workLog.UpdatedAt,
+	}
+}
+
+func workLogPageToDTO(page service.Page[*service.WorkLog]) api.WorkLogPage {
+	items := make([]api.WorkLog, len(page.Items))
+	for i, workLog := range page.Items {
+		items[i] = workLogToDTO(workLog)
+	}
+
+	return api.WorkLogPage{
+		Items:    items,
+		PageInfo: pageInfoToDTO(page.PageInfo),
+	}
+}
+
+func timeTrackingToDTO(tracking service.TimeTracking) api.TimeTracking {
+	return api.TimeTracking{
+		StoryPoints:       tracking.StoryPoints,
+		OriginalEstimate:  int(tracking.OriginalEstimate),
+		RemainingEstimate: int(tracking.RemainingEstimate),
+		TimeSpent:         int(tracking.TimeSpent),
+	}
+}
+
+func issueTimeTrackingToDTO(tracking *service.IssueTimeTracking) api.IssueTimeTracking {
+	return api.IssueTimeTracking{
+		Issue:    tracking.Issue.String(),
+		Subtasks: tracking.Subtasks,
+		Own:      timeTrackingToDTO(tracking.Own),
+		Total:    timeTrackingToDTO(tracking.Total),
+	}
+}
+
+func timesheetToDTO(timesheet *service.Timesheet) api.Timesheet {
+	issues := make([]api.TimesheetIssue, len(timesheet.Issues))
+	for i, issue := range timesheet.Issues {
+		issues[i] = api.TimesheetIssue{
+			Issue:    issue.Issue.String(),
+			Duration: int(issue.Duration),
+		}
+	}
+
+	entries := make([]api.WorkLog, len(timesheet.Entries))
+	for i, workLog := range timesheet.Entries {
+		entries[i] = workLogToDTO(workLog)
+	}
+
+	return api.Timesheet{
+		User:     timesheet.User.String(),
+		From:     timesheet.From,
+		To:       timesheet.To,
+		Duration: int(timesheet.Duration),
+		Issues:   issues,
+		Entries:  entries,
+	}
+}
+
+// NewWorkLogController creates a new WorkLogController.
+func NewWorkLogController(opts ...ControllerOption) (WorkLogController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.workLogService == nil {
+		return nil, ErrNoWorkLogService
+	}
+
+	return &workLogController{
+		baseController: controller,
+	}, nil
+}
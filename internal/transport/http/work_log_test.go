@@ -0,0 +1,351 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/pkg/optional"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestWorkLogController(t *testing.T, ws service.WorkLogService) WorkLogController {
+	t.Helper()
+	c, err := NewWorkLogController(WithWorkLogService(ws))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceWorkLog(issueID model.ID) *service.WorkLog {
+	return &service.WorkLog{
+		ID:        model.MustNewID(model.ResourceTypeWorkLog),
+		Issue:     issueID,
+		User:      model.MustNewID(model.ResourceTypeUser),
+		Duration:  90,
+		Date:      time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Note:      "Implementation",
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewWorkLogController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewWorkLogController(WithWorkLogService(service.NewMockWorkLogService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing work log service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewWorkLogController()
+		assert.ErrorIs(t, err, ErrNoWorkLogService)
+	})
+}
+
+func TestWorkLogController_V1IssueWorkLogsCreate(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	workLog := newServiceWorkLog(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Create(gomock.Any(), issueID, service.CreateWorkLogOpts{
+			Duration: workLog.Duration,
+			Date:     workLog.Date,
+			Note:     workLog.Note,
+		}).Return(workLog, nil)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogsCreate(context.Background(), api.V1IssueWorkLogsCreateRequestObject{
+			Id: issueID.String(),
+			Body: &api.V1IssueWorkLogsCreateJSONRequestBody{
+				Duration: int(workLog.Duration),
+				Date:     workLog.Date,
+				Note:     &workLog.Note,
+			},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueWorkLogsCreate201JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, workLog.ID.String(), got.Id)
+		assert.Equal(t, 90, got.Duration)
+		assert.Equal(t, workLog.User.String(), got.User)
+	})
+
+	t.Run("negative duration", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestWorkLogController(t, service.NewMockWorkLogService(ctrl))
+		resp, err := c.V1IssueWorkLogsCreate(context.Background(), api.V1IssueWorkLogsCreateRequestObject{
+			Id:   issueID.String(),
+			Body: &api.V1IssueWorkLogsCreateJSONRequestBody{Duration: -5, Date: workLog.Date},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Create(gomock.Any(), issueID, gomock.Any()).Return(nil, errors.Join(service.ErrWorkLogCreate, model.ErrInvalidWorkLogDetails))
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogsCreate(context.Background(), api.V1IssueWorkLogsCreateRequestObject{
+			Id:   issueID.String(),
+			Body: &api.V1IssueWorkLogsCreateJSONRequestBody{Duration: 2000, Date: workLog.Date},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogsCreate400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWorkLogController_V1IssueWorkLogsGet(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	workLog := newServiceWorkLog(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().List(gomock.Any(), issueID, gomock.Any()).Return(service.Page[*service.WorkLog]{
+			Items: []*service.WorkLog{workLog},
+		}, nil)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogsGet(context.Background(), api.V1IssueWorkLogsGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueWorkLogsGet200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, workLog.ID.String(), got.Items[0].Id)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().List(gomock.Any(), issueID, gomock.Any()).Return(
+			service.Page[*service.WorkLog]{},
+			errors.Join(service.ErrWorkLogGetAll, service.ErrNoPermission),
+		)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogsGet(context.Background(), api.V1IssueWorkLogsGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogsGet403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWorkLogController_V1IssueWorkLogUpdate(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	workLog := newServiceWorkLog(issueID)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Update(gomock.Any(), issueID, workLog.ID, service.UpdateWorkLogOpts{
+			Duration: optional.Some(uint(90)),
+			Note:     optional.Null[string](),
+		}).Return(workLog, nil)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogUpdate(context.Background(), api.V1IssueWorkLogUpdateRequestObject{
+			Id:        issueID.String(),
+			WorkLogId: workLog.ID.String(),
+			Body: &api.V1IssueWorkLogUpdateJSONRequestBody{
+				Duration: optional.Some(90),
+				Note:     optional.Null[string](),
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogUpdate200JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Update(gomock.Any(), issueID, workLog.ID, gomock.Any()).Return(nil, errors.Join(service.ErrWorkLogUpdate, repository.ErrNotFound))
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogUpdate(context.Background(), api.V1IssueWorkLogUpdateRequestObject{
+			Id:        issueID.String(),
+			WorkLogId: workLog.ID.String(),
+			Body:      &api.V1IssueWorkLogUpdateJSONRequestBody{},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogUpdate404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWorkLogController_V1IssueWorkLogDelete(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+	workLogID := model.MustNewID(model.ResourceTypeWorkLog)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Delete(gomock.Any(), issueID, workLogID).Return(nil)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueWorkLogDelete(context.Background(), api.V1IssueWorkLogDeleteRequestObject{
+			Id:        issueID.String(),
+			WorkLogId: workLogID.String(),
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogDelete204Response)
+		assert.True(t, ok)
+	})
+
+	t.Run("bad work log id", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestWorkLogController(t, service.NewMockWorkLogService(ctrl))
+		resp, err := c.V1IssueWorkLogDelete(context.Background(), api.V1IssueWorkLogDeleteRequestObject{
+			Id:        issueID.String(),
+			WorkLogId: "bad",
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1IssueWorkLogDelete400JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWorkLogController_V1IssueTimeTrackingGet(t *testing.T) {
+	t.Parallel()
+
+	issueID := model.MustNewID(model.ResourceTypeIssue)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().TimeTracking(gomock.Any(), issueID).Return(&service.IssueTimeTracking{
+			Issue:    issueID,
+			Subtasks: 2,
+			Own:      service.TimeTracking{StoryPoints: 3, OriginalEstimate: 120, RemainingEstimate: 60, TimeSpent: 90},
+			Total:    service.TimeTracking{StoryPoints: 8, OriginalEstimate: 480, RemainingEstimate: 200, TimeSpent: 300},
+		}, nil)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1IssueTimeTrackingGet(context.Background(), api.V1IssueTimeTrackingGetRequestObject{Id: issueID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1IssueTimeTrackingGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, api.V1IssueTimeTrackingGet200JSONResponse{
+			Issue:    issueID.String(),
+			Subtasks: 2,
+			Own:      api.TimeTracking{StoryPoints: 3, OriginalEstimate: 120, RemainingEstimate: 60, TimeSpent: 90},
+			Total:    api.TimeTracking{StoryPoints: 8, OriginalEstimate: 480, RemainingEstimate: 200, TimeSpent: 300},
+		}, got)
+	})
+}
+
+func TestWorkLogController_V1UserTimesheetGet(t *testing.T) {
+	t.Parallel()
+
+	userID := model.MustNewID(model.ResourceTypeUser)
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 6)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workLog := newServiceWorkLog(model.MustNewID(model.ResourceTypeIssue))
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Timesheet(gomock.Any(), userID, from, to).Return(&service.Timesheet{
+			User:     userID,
+			From:     from,
+			To:       to,
+			Duration: workLog.Duration,
+			Issues:   []service.TimesheetIssue{{Issue: workLog.Issue, Duration: workLog.Duration}},
+			Entries:  []*service.WorkLog{workLog},
+		}, nil)
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1UserTimesheetGet(context.Background(), api.V1UserTimesheetGetRequestObject{
+			Id:     userID.String(),
+			Params: api.V1UserTimesheetGetParams{From: from, To: to},
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1UserTimesheetGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, 90, got.Duration)
+		require.Len(t, got.Issues, 1)
+		assert.Equal(t, workLog.Issue.String(), got.Issues[0].Issue)
+		require.Len(t, got.Entries, 1)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkLogService(ctrl)
+		ws.EXPECT().Timesheet(gomock.Any(), userID, to, from).Return(nil, errors.Join(service.ErrTimesheetGet, service.ErrTimesheetRange))
+
+		c := newTestWorkLogController(t, ws)
+		resp, err := c.V1UserTimesheetGet(context.Background(), api.V1UserTimesheetGetRequestObject{
+			Id:     userID.String(),
+			Params: api.V1UserTimesheetGetParams{From: to, To: from},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1UserTimesheetGet400JSONResponse)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+// WorkflowController is the controller for the project workflow endpoints.
+type WorkflowController interface {
+	V1ProjectWorkflowGet(ctx context.Context, request api.V1ProjectWorkflowGetRequestObject) (api.V1ProjectWorkflowGetResponseObject, error)
+	V1ProjectWorkflowUpdate(ctx context.Context, request api.V1ProjectWorkflowUpdateRequestObject) (api.V1ProjectWorkflowUpdateResponseObject, error)
+	V1ProjectWorkflowDelete(ctx context.Context, request api.V1ProjectWorkflowDeleteRequestObject) (api.V1ProjectWorkflowDeleteResponseObject, error)
+}
+
+// workflowController is the concrete implementation of WorkflowController.
+type workflowController struct {
+	*baseController
+}
+
+func (c *workflowController) V1ProjectWorkflowGet(ctx context.Context, request api.V1ProjectWorkflowGetRequestObject) (api.V1ProjectWorkflowGetResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWorkflowGet")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWorkflowGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workflow, err := c.workflowService.Get(ctx, projectID)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWorkflowGet400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWorkflowGet403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWorkflowGet404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWorkflowGet500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWorkflowGet200JSONResponse(workflowToDTO(workflow)), nil
+}
+
+func (c *workflowController) V1ProjectWorkflowUpdate(ctx context.Context, request api.V1ProjectWorkflowUpdateRequestObject) (api.V1ProjectWorkflowUpdateResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWorkflowUpdate")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWorkflowUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+	if request.Body == nil {
+		return api.V1ProjectWorkflowUpdate400JSONResponse{N400JSONResponse: formatBadRequest(errors.New("request body is required"))}, nil
+	}
+
+	opts, err := setWorkflowOpts(request.Body.Statuses, request.Body.Transitions)
+	if err != nil {
+		return api.V1ProjectWorkflowUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	workflow, err := c.workflowService.Set(ctx, projectID, opts)
+	if err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWorkflowUpdate400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWorkflowUpdate403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWorkflowUpdate404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWorkflowUpdate500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWorkflowUpdate200JSONResponse(workflowToDTO(workflow)), nil
+}
+
+func (c *workflowController) V1ProjectWorkflowDelete(ctx context.Context, request api.V1ProjectWorkflowDeleteRequestObject) (api.V1ProjectWorkflowDeleteResponseObject, error) {
+	ctx, span := c.tracer.Start(ctx, "transport.http.handler/V1ProjectWorkflowDelete")
+	defer span.End()
+
+	projectID, err := model.NewIDFromString(request.Id, model.ResourceTypeProject.String())
+	if err != nil {
+		return api.V1ProjectWorkflowDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+	}
+
+	if err := c.workflowService.Delete(ctx, projectID); err != nil {
+		switch classifyServiceError(err) {
+		case http.StatusBadRequest:
+			return api.V1ProjectWorkflowDelete400JSONResponse{N400JSONResponse: formatBadRequest(err)}, nil
+		case http.StatusForbidden:
+			return api.V1ProjectWorkflowDelete403JSONResponse{N403JSONResponse: permissionDenied}, nil
+		case http.StatusNotFound:
+			return api.V1ProjectWorkflowDelete404JSONResponse{N404JSONResponse: notFound}, nil
+		default:
+			return api.V1ProjectWorkflowDelete500JSONResponse{N500JSONResponse: api.N500JSONResponse{
+				Message: err.Error(),
+			}}, nil
+		}
+	}
+
+	return api.V1ProjectWorkflowDelete204Response{}, nil
+}
+
+func workflowToDTO(workflow *service.Workflow) api.Workflow {
+	statuses := make([]api.WorkflowStatus, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		statuses[i] = api.WorkflowStatus{
+			Key:      status.Key,
+			Name:     status.Name,
+			Category: api.WorkflowStatusCategory(status.Category.String()),
+		}
+	}
+
+	transitions := make([]api.WorkflowTransition, len(workflow.Transitions))
+	for i, transition := range workflow.Transitions {
+		transitions[i] = api.WorkflowTransition{
+			From: transition.From,
+			To:   transition.To,
+		}
+	}
+
+	dto := api.Workflow{
+		Statuses:    statuses,
+		Transitions: transitions,
+		UpdatedAt:   workflow.UpdatedAt,
+	}
+	if workflow.CreatedAt != nil {
+		dto.CreatedAt = *workflow.CreatedAt
+	}
+
+	return dto
+}
+
+// setWorkflowOpts maps the request body of the workflow update to service
+// options. The workflow itself is validated by the service.
+func setWorkflowOpts(statuses []api.WorkflowStatus, transitions []api.WorkflowTransition) (service.SetWorkflowOpts, error) {
+	opts := service.SetWorkflowOpts{
+		Statuses:    make([]model.WorkflowStatus, len(statuses)),
+		Transitions: make([]model.WorkflowTransition, len(transitions)),
+	}
+
+	for i, status := range statuses {
+		var category model.WorkflowStatusCategory
+		if err := category.UnmarshalText([]byte(status.Category)); err != nil {
+			return service.SetWorkflowOpts{}, errors.Join(model.ErrInvalidWorkflowDetails, err)
+		}
+
+		opts.Statuses[i] = model.WorkflowStatus{
+			Key:      status.Key,
+			Name:     status.Name,
+			Category: category,
+		}
+	}
+
+	for i, transition := range transitions {
+		opts.Transitions[i] = model.WorkflowTransition{
+			From: transition.From,
+			To:   transition.To,
+		}
+	}
+
+	return opts, nil
+}
+
+// NewWorkflowController creates a new WorkflowController.
+func NewWorkflowController(opts ...ControllerOption) (WorkflowController, error) {
+	controller, err := newController(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if controller.workflowService == nil {
+		return nil, ErrNoWorkflowService
+	}
+
+	return &workflowController{
+		baseController: controller,
+	}, nil
+}
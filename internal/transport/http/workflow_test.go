@@ -0,0 +1,189 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/opcotech/elemo/internal/model"
+	"github.com/opcotech/elemo/internal/pkg/convert"
+	"github.com/opcotech/elemo/internal/repository"
+	"github.com/opcotech/elemo/internal/service"
+	"github.com/opcotech/elemo/internal/transport/http/api"
+)
+
+func newTestWorkflowController(t *testing.T, ws service.WorkflowService) WorkflowController {
+	t.Helper()
+	c, err := NewWorkflowController(WithWorkflowService(ws))
+	require.NoError(t, err)
+	return c
+}
+
+func newServiceWorkflow(project model.ID) *service.Workflow {
+	return &service.Workflow{
+		Project: project,
+		Statuses: []model.WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: model.WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: model.WorkflowStatusCategoryInProgress},
+		},
+		Transitions: []model.WorkflowTransition{
+			{From: "backlog", To: "in_review"},
+		},
+		CreatedAt: convert.ToPointer(time.Now().UTC()),
+	}
+}
+
+func TestNewWorkflowController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c, err := NewWorkflowController(WithWorkflowService(service.NewMockWorkflowService(ctrl)))
+		require.NoError(t, err)
+		assert.NotNil(t, c)
+	})
+
+	t.Run("missing workflow service", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewWorkflowController()
+		assert.ErrorIs(t, err, ErrNoWorkflowService)
+	})
+}
+
+func TestWorkflowController_V1ProjectWorkflowGet(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkflowService(ctrl)
+		ws.EXPECT().Get(gomock.Any(), projectID).Return(newServiceWorkflow(projectID), nil)
+
+		c := newTestWorkflowController(t, ws)
+		resp, err := c.V1ProjectWorkflowGet(context.Background(), api.V1ProjectWorkflowGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectWorkflowGet200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, []api.WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: api.WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: api.WorkflowStatusCategoryInProgress},
+		}, got.Statuses)
+		assert.Equal(t, []api.WorkflowTransition{{From: "backlog", To: "in_review"}}, got.Transitions)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkflowService(ctrl)
+		ws.EXPECT().Get(gomock.Any(), projectID).Return(nil, errors.Join(service.ErrWorkflowGet, repository.ErrNotFound))
+
+		c := newTestWorkflowController(t, ws)
+		resp, err := c.V1ProjectWorkflowGet(context.Background(), api.V1ProjectWorkflowGetRequestObject{Id: projectID.String()})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectWorkflowGet404JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWorkflowController_V1ProjectWorkflowUpdate(t *testing.T) {
+	t.Parallel()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+	workflow := newServiceWorkflow(projectID)
+	body := &api.V1ProjectWorkflowUpdateJSONRequestBody{
+		Statuses: []api.WorkflowStatus{
+			{Key: "backlog", Name: "Backlog", Category: api.WorkflowStatusCategoryTodo},
+			{Key: "in_review", Name: "In review", Category: api.WorkflowStatusCategoryInProgress},
+		},
+		Transitions: []api.WorkflowTransition{{From: "backlog", To: "in_review"}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkflowService(ctrl)
+		ws.EXPECT().Set(gomock.Any(), projectID, service.SetWorkflowOpts{
+			Statuses:    workflow.Statuses,
+			Transitions: workflow.Transitions,
+		}).Return(workflow, nil)
+
+		c := newTestWorkflowController(t, ws)
+		resp, err := c.V1ProjectWorkflowUpdate(context.Background(), api.V1ProjectWorkflowUpdateRequestObject{
+			Id:   projectID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		got, ok := resp.(api.V1ProjectWorkflowUpdate200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, body.Statuses, got.Statuses)
+	})
+
+	t.Run("invalid category", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := newTestWorkflowController(t, service.NewMockWorkflowService(ctrl))
+		resp, err := c.V1ProjectWorkflowUpdate(context.Background(), api.V1ProjectWorkflowUpdateRequestObject{
+			Id: projectID.String(),
+			Body: &api.V1ProjectWorkflowUpdateJSONRequestBody{
+				Statuses: []api.WorkflowStatus{{Key: "backlog", Name: "Backlog", Category: "blocked"}},
+			},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectWorkflowUpdate400JSONResponse)
+		assert.True(t, ok)
+	})
+
+	t.Run("feature not licensed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ws := service.NewMockWorkflowService(ctrl)
+		ws.EXPECT().Set(gomock.Any(), projectID, gomock.Any()).Return(nil, errors.Join(service.ErrWorkflowSet, service.ErrQuotaExceeded))
+
+		c := newTestWorkflowController(t, ws)
+		resp, err := c.V1ProjectWorkflowUpdate(context.Background(), api.V1ProjectWorkflowUpdateRequestObject{
+			Id:   projectID.String(),
+			Body: body,
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.V1ProjectWorkflowUpdate403JSONResponse)
+		assert.True(t, ok)
+	})
+}
+
+func TestWorkflowController_V1ProjectWorkflowDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := model.MustNewID(model.ResourceTypeProject)
+
+	ws := service.NewMockWorkflowService(ctrl)
+	ws.EXPECT().Delete(gomock.Any(), projectID).Return(nil)
+
+	c := newTestWorkflowController(t, ws)
+	resp, err := c.V1ProjectWorkflowDelete(context.Background(), api.V1ProjectWorkflowDeleteRequestObject{Id: projectID.String()})
+	require.NoError(t, err)
+	_, ok := resp.(api.V1ProjectWorkflowDelete204Response)
+	assert.True(t, ok)
+}
@@ -57,6 +57,10 @@ func (noopSearchService) EnqueueIndex(_ context.Context, _ model.ID) error {
 	return nil
 }
 
+func (noopSearchService) EnqueueIndexIDs(_ context.Context, _ ...model.ID) error {
+	return nil
+}
+
 func (noopSearchService) IndexIDs(_ context.Context, _ *repository.Neo4jDatabase, _ ...model.ID) error {
 	return nil
 }
@@ -65,6 +69,10 @@ func (noopSearchService) Delete(_ context.Context, _ model.ID) error {
 	return nil
 }
 
+func (noopSearchService) DeleteIDs(_ context.Context, _ ...model.ID) error {
+	return nil
+}
+
 func (noopSearchService) DeleteByScope(_ context.Context, _ model.ID) error {
 	return nil
 }
@@ -47,7 +47,7 @@ func wipe(ctx context.Context, d *deps, queriesDir string) error {
 	}
 	if _, err := d.relDB.Pool().Exec(
 		ctx,
-		"TRUNCATE TABLE user_tokens, notifications RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE user_tokens, notifications, webhooks, webhook_deliveries, issue_activities, project_workflows, project_custom_fields, project_wip_limits, sprint_scope_changes, work_logs, issue_templates, issue_recurrences, issue_recurrence_occurrences, mentions RESTART IDENTITY CASCADE",
 	); err != nil {
 		return fmt.Errorf("truncate postgres tokens: %w", err)
 	}
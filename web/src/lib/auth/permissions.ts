@@ -27,6 +27,9 @@ export const Action = {
   DocumentUpdate: "document.update",
   DocumentDelete: "document.delete",
   FolderCreate: "folder.create",
+  LabelManage: "label.manage",
+  LabelAttach: "label.attach",
+  WebhookManage: "webhook.manage",
   RoleManage: "role.manage",
   TeamManage: "team.manage",
   PermissionManage: "permission.manage",
@@ -56,6 +59,8 @@ export enum ResourceType {
   Todo = "Todo",
   User = "User",
   UserToken = "UserToken",
+  Webhook = "Webhook",
+  WebhookDelivery = "WebhookDelivery",
 }
 
 export function withResourceType(